@@ -219,6 +219,21 @@ var (
 	Pi_resource_group_id            string
 )
 
+var (
+	Pi_dr_source_instance_id       string
+	Pi_dr_target_cloud_instance_id string
+	Pi_dr_target_zone              string
+	Pi_dr_target_image_id          string
+	Pi_dr_target_network_id        string
+)
+
+var (
+	Pi_migration_target_cloud_instance_id string
+	Pi_migration_target_zone              string
+	Pi_migration_source_network_id        string
+	Pi_migration_target_network_id        string
+)
+
 var (
 	Pi_capture_storage_image_path       string
 	Pi_capture_cloud_storage_access_key string
@@ -1116,6 +1131,61 @@ func init() {
 		fmt.Println("[WARN] Set the environment variable PI_RESOURCE_GROUP_ID for testing ibm_pi_workspace resource else it is set to default value ''")
 	}
 
+	// Added for resource dr failover testing
+	Pi_dr_source_instance_id = os.Getenv("PI_DR_SOURCE_INSTANCE_ID")
+	if Pi_dr_source_instance_id == "" {
+		Pi_dr_source_instance_id = "terraform-test-power"
+		fmt.Println("[INFO] Set the environment variable PI_DR_SOURCE_INSTANCE_ID for testing ibm_pi_dr_failover resource else it is set to default value 'terraform-test-power'")
+	}
+
+	Pi_dr_target_cloud_instance_id = os.Getenv("PI_DR_TARGET_CLOUDINSTANCE_ID")
+	if Pi_dr_target_cloud_instance_id == "" {
+		Pi_dr_target_cloud_instance_id = "terraform-test-power"
+		fmt.Println("[INFO] Set the environment variable PI_DR_TARGET_CLOUDINSTANCE_ID for testing ibm_pi_dr_failover resource else it is set to default value 'terraform-test-power'")
+	}
+
+	Pi_dr_target_zone = os.Getenv("PI_DR_TARGET_ZONE")
+	if Pi_dr_target_zone == "" {
+		Pi_dr_target_zone = ""
+		fmt.Println("[WARN] Set the environment variable PI_DR_TARGET_ZONE for testing ibm_pi_dr_failover resource else it is set to default value ''")
+	}
+
+	Pi_dr_target_image_id = os.Getenv("PI_DR_TARGET_IMAGE_ID")
+	if Pi_dr_target_image_id == "" {
+		Pi_dr_target_image_id = "terraform-test-power"
+		fmt.Println("[INFO] Set the environment variable PI_DR_TARGET_IMAGE_ID for testing ibm_pi_dr_failover resource else it is set to default value 'terraform-test-power'")
+	}
+
+	Pi_dr_target_network_id = os.Getenv("PI_DR_TARGET_NETWORK_ID")
+	if Pi_dr_target_network_id == "" {
+		Pi_dr_target_network_id = "terraform-test-power"
+		fmt.Println("[INFO] Set the environment variable PI_DR_TARGET_NETWORK_ID for testing ibm_pi_dr_failover resource else it is set to default value 'terraform-test-power'")
+	}
+
+	Pi_migration_target_cloud_instance_id = os.Getenv("PI_MIGRATION_TARGET_CLOUDINSTANCE_ID")
+	if Pi_migration_target_cloud_instance_id == "" {
+		Pi_migration_target_cloud_instance_id = "terraform-test-power"
+		fmt.Println("[INFO] Set the environment variable PI_MIGRATION_TARGET_CLOUDINSTANCE_ID for testing ibm_pi_instance_migration resource else it is set to default value 'terraform-test-power'")
+	}
+
+	Pi_migration_target_zone = os.Getenv("PI_MIGRATION_TARGET_ZONE")
+	if Pi_migration_target_zone == "" {
+		Pi_migration_target_zone = ""
+		fmt.Println("[WARN] Set the environment variable PI_MIGRATION_TARGET_ZONE for testing ibm_pi_instance_migration resource else it is set to default value ''")
+	}
+
+	Pi_migration_source_network_id = os.Getenv("PI_MIGRATION_SOURCE_NETWORK_ID")
+	if Pi_migration_source_network_id == "" {
+		Pi_migration_source_network_id = "terraform-test-power"
+		fmt.Println("[INFO] Set the environment variable PI_MIGRATION_SOURCE_NETWORK_ID for testing ibm_pi_instance_migration resource else it is set to default value 'terraform-test-power'")
+	}
+
+	Pi_migration_target_network_id = os.Getenv("PI_MIGRATION_TARGET_NETWORK_ID")
+	if Pi_migration_target_network_id == "" {
+		Pi_migration_target_network_id = "terraform-test-power"
+		fmt.Println("[INFO] Set the environment variable PI_MIGRATION_TARGET_NETWORK_ID for testing ibm_pi_instance_migration resource else it is set to default value 'terraform-test-power'")
+	}
+
 	WorkspaceID = os.Getenv("SCHEMATICS_WORKSPACE_ID")
 	if WorkspaceID == "" {
 		WorkspaceID = "us-south.workspace.tf-acc-test-schematics-state-test.392cd99f"
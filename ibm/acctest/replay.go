@@ -0,0 +1,578 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Replay modes, selected with the IC_REPLAY_MODE environment variable. Without it
+// a test replays its cassette when one exists and is skipped otherwise.
+const (
+	ReplayModeRecord = "record"
+	ReplayModeReplay = "replay"
+	ReplayModeOff    = "off"
+)
+
+const (
+	replayCassetteDir = "testdata/cassettes"
+	replayIAMTokenKey = "IBMCLOUD_IAM_API_ENDPOINT"
+	replayAPIKey      = "replay"
+)
+
+// ReplayEndpoints are the upstream endpoints of the services which are redirected to
+// the replay server, {region} is replaced by the test region. The provider reads
+// the endpoints through endpoints_file_path, except for IBMCLOUD_COS_ENDPOINT which is
+// only read from the environment.
+var ReplayEndpoints = map[string]string{
+	"IBMCLOUD_IAM_API_ENDPOINT":                 "https://iam.cloud.ibm.com",
+	"IBMCLOUD_IS_NG_API_ENDPOINT":               "https://{region}.iaas.cloud.ibm.com/v1",
+	"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT": "https://resource-controller.cloud.ibm.com",
+	"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT": "https://resource-controller.cloud.ibm.com",
+	"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT":    "https://globalcatalog.cloud.ibm.com",
+	"IBMCLOUD_GS_API_ENDPOINT":                  "https://api.global-search-tagging.cloud.ibm.com",
+	"IBMCLOUD_GT_API_ENDPOINT":                  "https://tags.global-search-tagging.cloud.ibm.com",
+	"IBMCLOUD_COS_CONFIG_ENDPOINT":              "https://config.cloud-object-storage.cloud.ibm.com/v1",
+	"IBMCLOUD_COS_ENDPOINT":                     "https://s3.{region}.cloud-object-storage.appdomain.cloud",
+	"IBMCLOUD_CS_API_ENDPOINT":                  "https://containers.cloud.ibm.com/global",
+	"IBMCLOUD_TG_API_ENDPOINT":                  "https://transit.cloud.ibm.com/v1",
+	"IBMCLOUD_DL_API_ENDPOINT":                  "https://directlink.cloud.ibm.com/v1",
+	"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT":         "https://api.dns-svcs.cloud.ibm.com/v1",
+	"IBMCLOUD_KP_API_ENDPOINT":                  "https://{region}.kms.cloud.ibm.com",
+	"IBMCLOUD_SCHEMATICS_API_ENDPOINT":          "https://schematics.cloud.ibm.com",
+}
+
+// replayEnvOnlyEndpoints are not read from endpoints_file_path
+var replayEnvOnlyEndpoints = map[string]bool{
+	"IBMCLOUD_COS_ENDPOINT": true,
+}
+
+// Cassette holds the HTTP traffic recorded for a single test
+type Cassette struct {
+	// Claims of the recorded IAM access token, used to mint the replayed tokens
+	Identity map[string]interface{} `json:"identity,omitempty"`
+	// Values returned by Replay.RandIntRange and Replay.RandString
+	Variables    []string       `json:"variables,omitempty"`
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response
+type Interaction struct {
+	Method      string `json:"method"`
+	Path        string `json:"path"`
+	Query       string `json:"query,omitempty"`
+	RequestBody string `json:"request_body,omitempty"`
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body,omitempty"`
+}
+
+func (i *Interaction) key() string {
+	return i.Method + " " + i.Path + "?" + i.Query
+}
+
+// Replay records the IAM and service traffic of an acceptance test into a cassette
+// and replays it through a local server, so that the test runs without credentials.
+//
+//	func TestAccIBMISVPC_replay(t *testing.T) {
+//		r := acc.NewReplay(t)
+//		name := fmt.Sprintf("tf-vpc-%d", r.RandIntRange(10, 100))
+//		r.Test(t, resource.TestCase{
+//			PreCheck:  func() { r.PreCheck(t) },
+//			Providers: acc.TestAccProviders,
+//			Steps:     []resource.TestStep{...},
+//		})
+//	}
+//
+// Record the cassette once with IC_REPLAY_MODE=record and the usual acceptance test
+// credentials, and commit the file written to testdata/cassettes.
+type Replay struct {
+	Mode string
+
+	t            *testing.T
+	server       *httptest.Server
+	cassettePath string
+	region       string
+	upstreams    map[string]string
+	client       *http.Client
+
+	mu        sync.Mutex
+	cassette  *Cassette
+	played    map[int]bool
+	cursor    int
+	variables int
+	misses    []string
+}
+
+// NewReplay starts the replay server of the test and points the provider at it
+func NewReplay(t *testing.T) *Replay {
+	t.Helper()
+	return newReplay(t, filepath.Join(replayCassetteDir, strings.ReplaceAll(t.Name(), "/", "_")+".json"))
+}
+
+func newReplay(t *testing.T, cassettePath string) *Replay {
+	t.Helper()
+	r := &Replay{
+		t:            t,
+		cassettePath: cassettePath,
+		region:       Region(),
+		cassette:     &Cassette{},
+		played:       map[int]bool{},
+		cursor:       -1,
+		client:       &http.Client{Timeout: 5 * time.Minute},
+	}
+	r.upstreams = make(map[string]string, len(ReplayEndpoints))
+	for key, endpoint := range ReplayEndpoints {
+		r.upstreams[key] = strings.ReplaceAll(endpoint, "{region}", r.region)
+	}
+
+	r.Mode = os.Getenv("IC_REPLAY_MODE")
+	switch r.Mode {
+	case ReplayModeOff:
+		return r
+	case ReplayModeRecord:
+		if os.Getenv("IC_API_KEY") == "" {
+			t.Fatal("IC_API_KEY must be set to record a cassette")
+		}
+	case ReplayModeReplay, "":
+		if err := r.load(); err != nil {
+			if r.Mode == "" && os.IsNotExist(err) {
+				t.Skipf("No cassette %s, record it with IC_REPLAY_MODE=record", r.cassettePath)
+			}
+			t.Fatalf("Error reading cassette %s: %s", r.cassettePath, err)
+		}
+		r.Mode = ReplayModeReplay
+	default:
+		t.Fatalf("IC_REPLAY_MODE must be one of %q, %q or %q", ReplayModeRecord, ReplayModeReplay, ReplayModeOff)
+	}
+
+	r.server = httptest.NewServer(http.HandlerFunc(r.serveHTTP))
+	t.Cleanup(r.stop)
+	r.setEnv()
+	return r
+}
+
+// URL returns the address of the replay server for an endpoint key
+func (r *Replay) URL(key string) string {
+	return r.server.URL + "/" + key
+}
+
+// PreCheck configures TestAccProvider against the replay server
+func (r *Replay) PreCheck(t *testing.T) {
+	if r.Mode == ReplayModeOff {
+		TestAccPreCheck(t)
+		return
+	}
+	diags := TestAccProvider.Configure(context.Background(), terraformsdk.NewResourceConfigRaw(nil))
+	if diags.HasError() {
+		t.Fatalf("configuring provider: %s", diags[0].Summary)
+	}
+}
+
+// Test runs the test case, in replay mode it runs without TF_ACC being set and is
+// skipped when no Terraform CLI is available, rather than downloading one
+func (r *Replay) Test(t *testing.T, c resource.TestCase) {
+	if r.Mode == ReplayModeReplay {
+		if !replayTerraformAvailable() {
+			t.Skip("No Terraform CLI, set TF_ACC_TERRAFORM_PATH or add terraform to the PATH to replay the cassette")
+		}
+		resource.UnitTest(t, c)
+		return
+	}
+	resource.Test(t, c)
+}
+
+// RandIntRange returns a random int, which is recorded so that resource names match on replay
+func (r *Replay) RandIntRange(min, max int) int {
+	v, err := strconv.Atoi(r.variable(func() string {
+		return strconv.Itoa(acctest.RandIntRange(min, max))
+	}))
+	if err != nil {
+		r.t.Fatalf("Error reading cassette variable: %s", err)
+	}
+	return v
+}
+
+// RandString returns a random alphanumeric string, which is recorded so that resource names match on replay
+func (r *Replay) RandString(length int) string {
+	return r.variable(func() string {
+		return acctest.RandString(length)
+	})
+}
+
+// Env returns the value of an environment variable, which is recorded so that
+// account specific values such as a resource group ID are not needed on replay
+func (r *Replay) Env(key string) string {
+	return r.variable(func() string {
+		v := os.Getenv(key)
+		if v == "" && r.Mode == ReplayModeRecord {
+			r.t.Fatalf("%s must be set to record a cassette", key)
+		}
+		return v
+	})
+}
+
+func (r *Replay) variable(generate func() string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.Mode == ReplayModeReplay {
+		if r.variables >= len(r.cassette.Variables) {
+			r.t.Fatalf("Cassette %s has no more recorded variables", r.cassettePath)
+		}
+		v := r.cassette.Variables[r.variables]
+		r.variables++
+		return v
+	}
+	v := generate()
+	r.cassette.Variables = append(r.cassette.Variables, v)
+	return v
+}
+
+func (r *Replay) setEnv() {
+	endpoints := map[string]interface{}{}
+	for key := range r.upstreams {
+		if replayEnvOnlyEndpoints[key] {
+			r.t.Setenv(key, r.URL(key))
+			continue
+		}
+		// Environment overrides take precedence over the endpoints file
+		r.t.Setenv(key, "")
+		regions := map[string]interface{}{r.region: r.URL(key)}
+		endpoints[key] = map[string]interface{}{
+			"public":  regions,
+			"private": regions,
+		}
+	}
+	content, err := json.Marshal(endpoints)
+	if err != nil {
+		r.t.Fatalf("Error writing endpoints file: %s", err)
+	}
+	file := filepath.Join(r.t.TempDir(), "endpoints.json")
+	if err := os.WriteFile(file, content, 0600); err != nil {
+		r.t.Fatalf("Error writing endpoints file: %s", err)
+	}
+	r.t.Setenv("IC_ENDPOINTS_FILE_PATH", file)
+	r.t.Setenv("IBMCLOUD_ENDPOINTS_FILE_PATH", file)
+	r.t.Setenv("IC_VISIBILITY", "public")
+	r.t.Setenv("IBMCLOUD_VISIBILITY", "public")
+
+	if r.Mode == ReplayModeReplay {
+		r.t.Setenv("IC_API_KEY", replayAPIKey)
+		r.t.Setenv("IC_IAM_TOKEN", "")
+		r.t.Setenv("IC_IAM_REFRESH_TOKEN", "")
+		r.t.Setenv("IC_IAM_PROFILE_ID", "")
+	}
+}
+
+func (r *Replay) load() error {
+	content, err := os.ReadFile(r.cassettePath)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(content, r.cassette)
+}
+
+func (r *Replay) stop() {
+	r.server.Close()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, miss := range r.misses {
+		r.t.Errorf("No recorded interaction for %s in %s", miss, r.cassettePath)
+	}
+	if r.Mode != ReplayModeRecord || r.t.Failed() || r.t.Skipped() {
+		return
+	}
+	// the bodies are kept readable, XML bodies are not escaped
+	var content bytes.Buffer
+	encoder := json.NewEncoder(&content)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(r.cassette); err != nil {
+		r.t.Errorf("Error writing cassette %s: %s", r.cassettePath, err)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(r.cassettePath), 0755); err != nil {
+		r.t.Errorf("Error writing cassette %s: %s", r.cassettePath, err)
+		return
+	}
+	if err := os.WriteFile(r.cassettePath, content.Bytes(), 0644); err != nil {
+		r.t.Errorf("Error writing cassette %s: %s", r.cassettePath, err)
+	}
+}
+
+func (r *Replay) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	key, path := splitReplayPath(req.URL.Path)
+	upstream, ok := r.upstreams[key]
+	if !ok {
+		http.Error(w, fmt.Sprintf("replay: unknown endpoint %q", key), http.StatusBadGateway)
+		return
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	isToken := key == replayIAMTokenKey && path == "/identity/token"
+
+	if r.Mode == ReplayModeReplay {
+		if isToken {
+			r.serveToken(w)
+			return
+		}
+		r.serveRecorded(w, req, key+path)
+		return
+	}
+
+	interaction, err := r.forward(req, upstream+path, body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	interaction.Path = key + path
+	if isToken {
+		// Tokens are minted on replay, only the identity is kept
+		r.recordIdentity(interaction.Body)
+	} else {
+		if strings.HasPrefix(req.Header.Get("Content-Type"), "application/json") {
+			interaction.RequestBody = string(body)
+		}
+		r.mu.Lock()
+		r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+		r.mu.Unlock()
+	}
+	writeInteraction(w, interaction)
+}
+
+func (r *Replay) forward(req *http.Request, target string, body []byte) (*Interaction, error) {
+	if req.URL.RawQuery != "" {
+		target += "?" + req.URL.RawQuery
+	}
+	upstreamReq, err := http.NewRequestWithContext(req.Context(), req.Method, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range req.Header {
+		if name == "Accept-Encoding" {
+			continue
+		}
+		upstreamReq.Header[name] = values
+	}
+	resp, err := r.client.Do(upstreamReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &Interaction{
+		Method:      req.Method,
+		Query:       req.URL.Query().Encode(),
+		Status:      resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+		Body:        string(respBody),
+	}, nil
+}
+
+func (r *Replay) serveRecorded(w http.ResponseWriter, req *http.Request, path string) {
+	key := req.Method + " " + path + "?" + req.URL.Query().Encode()
+
+	r.mu.Lock()
+	interaction := r.match(key, isReplayRead(req.Method, path))
+	if interaction == nil {
+		r.misses = append(r.misses, key)
+		r.mu.Unlock()
+		http.Error(w, fmt.Sprintf("replay: no recorded interaction for %s", key), http.StatusNotImplemented)
+		return
+	}
+	r.mu.Unlock()
+
+	writeInteraction(w, interaction)
+}
+
+// match returns the recorded interaction to replay for key. The changes are
+// replayed in the recorded order. The reads are replayed from the interactions
+// recorded between the last replayed change and the next one, in order and
+// repeating the last one, since a resource may be read or polled more often than
+// when recording.
+func (r *Replay) match(key string, read bool) *Interaction {
+	interactions := r.cassette.Interactions
+	if !read {
+		// The first change after the cursor, otherwise the first one not replayed
+		// yet, when requests were sent in a different order
+		found := -1
+		for i, interaction := range interactions {
+			if r.played[i] || interaction.key() != key {
+				continue
+			}
+			if found < 0 || i > r.cursor {
+				found = i
+			}
+			if i > r.cursor {
+				break
+			}
+		}
+		if found < 0 {
+			return nil
+		}
+		r.played[found] = true
+		if found > r.cursor {
+			r.cursor = found
+		}
+		return interactions[found]
+	}
+
+	next := len(interactions)
+	for i := r.cursor + 1; i < len(interactions); i++ {
+		if !isReplayRead(interactions[i].Method, interactions[i].Path) {
+			next = i
+			break
+		}
+	}
+	last := -1
+	for i := r.cursor + 1; i < next; i++ {
+		if interactions[i].key() != key {
+			continue
+		}
+		if !r.played[i] {
+			r.played[i] = true
+			return interactions[i]
+		}
+		last = i
+	}
+	if last >= 0 {
+		return interactions[last]
+	}
+	// Not read since the last change, the latest earlier read still holds,
+	// otherwise the first later one
+	for i := r.cursor; i >= 0; i-- {
+		if interactions[i].key() == key {
+			return interactions[i]
+		}
+	}
+	for i := next; i < len(interactions); i++ {
+		if interactions[i].key() == key {
+			return interactions[i]
+		}
+	}
+	return nil
+}
+
+func (r *Replay) recordIdentity(body string) {
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.Unmarshal([]byte(body), &token); err != nil || token.AccessToken == "" {
+		return
+	}
+	parts := strings.Split(token.AccessToken, ".")
+	if len(parts) != 3 {
+		return
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return
+	}
+	claims := map[string]interface{}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return
+	}
+	identity := map[string]interface{}{}
+	for _, claim := range []string{"id", "iam_id", "sub", "sub_type", "iss", "account"} {
+		if v, ok := claims[claim]; ok {
+			identity[claim] = v
+		}
+	}
+	r.mu.Lock()
+	r.cassette.Identity = identity
+	r.mu.Unlock()
+}
+
+func (r *Replay) serveToken(w http.ResponseWriter) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+	}
+	for k, v := range r.cassette.Identity {
+		claims[k] = v
+	}
+	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(replayAPIKey))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token":  accessToken,
+		"refresh_token": replayAPIKey,
+		"token_type":    "Bearer",
+		"expires_in":    3600,
+		"expiration":    now.Add(time.Hour).Unix(),
+	})
+}
+
+func writeInteraction(w http.ResponseWriter, interaction *Interaction) {
+	if interaction.ContentType != "" {
+		w.Header().Set("Content-Type", interaction.ContentType)
+	}
+	w.WriteHeader(interaction.Status)
+	io.WriteString(w, interaction.Body)
+}
+
+// replayReadPaths are the paths of the POST requests which only read, such as
+// the global search of the tags of a resource
+var replayReadPaths = []string{"/v3/resources/search"}
+
+func isReplayRead(method, path string) bool {
+	if method == http.MethodGet || method == http.MethodHead {
+		return true
+	}
+	for _, p := range replayReadPaths {
+		if method == http.MethodPost && strings.HasSuffix(path, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func replayTerraformAvailable() bool {
+	if path := os.Getenv("TF_ACC_TERRAFORM_PATH"); path != "" {
+		_, err := os.Stat(path)
+		return err == nil
+	}
+	_, err := exec.LookPath("terraform")
+	return err == nil
+}
+
+// splitReplayPath splits /<endpoint key>/<path> into the endpoint key and path
+func splitReplayPath(p string) (string, string) {
+	p = strings.TrimPrefix(p, "/")
+	if i := strings.Index(p, "/"); i >= 0 {
+		return p[:i], p[i:]
+	}
+	return p, ""
+}
@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	jwt "github.com/golang-jwt/jwt"
+)
+
+func replayTestUpstream(t *testing.T) *httptest.Server {
+	var polls, deleted int32
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/identity/token":
+			token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+				"id":      "IBMid-test",
+				"email":   "test@ibm.com",
+				"iss":     "https://iam.cloud.ibm.com/identity",
+				"account": map[string]interface{}{"bss": "test-account"},
+			}).SignedString([]byte("upstream"))
+			fmt.Fprintf(w, `{"access_token": %q, "refresh_token": "upstream"}`, token)
+		case "/v1/vpcs/r006-test":
+			if req.Method == http.MethodDelete {
+				atomic.StoreInt32(&deleted, 1)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			if atomic.LoadInt32(&deleted) == 1 {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"errors": [{"code": "not_found"}]}`)
+				return
+			}
+			status := "pending"
+			if atomic.AddInt32(&polls, 1) > 1 {
+				status = "available"
+			}
+			fmt.Fprintf(w, `{"id": "r006-test", "status": %q}`, status)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errors": [{"code": "not_found"}]}`)
+		}
+	}))
+	t.Cleanup(upstream.Close)
+
+	endpoints := ReplayEndpoints
+	ReplayEndpoints = map[string]string{
+		"IBMCLOUD_IAM_API_ENDPOINT":   upstream.URL,
+		"IBMCLOUD_IS_NG_API_ENDPOINT": upstream.URL + "/v1",
+	}
+	t.Cleanup(func() { ReplayEndpoints = endpoints })
+	return upstream
+}
+
+func replayTestGet(t *testing.T, url string) (int, string) {
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("Error calling replay server: %s", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(body)
+}
+
+func replayTestDelete(t *testing.T, url string) int {
+	req, _ := http.NewRequest(http.MethodDelete, url, nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Error calling replay server: %s", err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func replayTestToken(t *testing.T, r *Replay) jwt.MapClaims {
+	resp, err := http.Post(r.URL("IBMCLOUD_IAM_API_ENDPOINT")+"/identity/token", "application/x-www-form-urlencoded", strings.NewReader("apikey=secret"))
+	if err != nil {
+		t.Fatalf("Error calling replay server: %s", err)
+	}
+	defer resp.Body.Close()
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		t.Fatalf("Error decoding token: %s", err)
+	}
+	parsed, _ := jwt.Parse(token.AccessToken, nil)
+	if parsed == nil {
+		t.Fatalf("Error parsing token %q", token.AccessToken)
+	}
+	return parsed.Claims.(jwt.MapClaims)
+}
+
+func TestReplayRecordAndReplay(t *testing.T) {
+	upstream := replayTestUpstream(t)
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+	var recorded int
+
+	t.Run("record", func(t *testing.T) {
+		t.Setenv("IC_REPLAY_MODE", ReplayModeRecord)
+		t.Setenv("IC_API_KEY", "secret")
+		r := newReplay(t, cassette)
+		recorded = r.RandIntRange(10, 100)
+		t.Setenv("IC_REPLAY_TEST_GROUP", "group-a")
+		if v := r.Env("IC_REPLAY_TEST_GROUP"); v != "group-a" {
+			t.Errorf("Expected environment variable group-a, got %s", v)
+		}
+
+		if claims := replayTestToken(t, r); claims["id"] != "IBMid-test" {
+			t.Errorf("Expected the upstream token, got %v", claims)
+		}
+		for _, expected := range []string{"pending", "available"} {
+			_, body := replayTestGet(t, r.URL("IBMCLOUD_IS_NG_API_ENDPOINT")+"/vpcs/r006-test?version=2024-01-01&generation=2")
+			if !strings.Contains(body, expected) {
+				t.Errorf("Expected %s VPC, got %s", expected, body)
+			}
+		}
+	})
+	upstream.Close()
+
+	t.Run("replay", func(t *testing.T) {
+		t.Setenv("IC_REPLAY_MODE", ReplayModeReplay)
+		r := newReplay(t, cassette)
+		if v := r.RandIntRange(10, 100); v != recorded {
+			t.Errorf("Expected recorded variable %d, got %d", recorded, v)
+		}
+		t.Setenv("IC_REPLAY_TEST_GROUP", "")
+		if v := r.Env("IC_REPLAY_TEST_GROUP"); v != "group-a" {
+			t.Errorf("Expected recorded environment variable group-a, got %s", v)
+		}
+
+		claims := replayTestToken(t, r)
+		if claims["id"] != "IBMid-test" || claims["account"].(map[string]interface{})["bss"] != "test-account" {
+			t.Errorf("Expected the recorded identity, got %v", claims)
+		}
+		if _, ok := claims["email"]; ok {
+			t.Error("Expected the email claim not to be recorded")
+		}
+		// Query parameters match in any order and the last poll is repeated
+		for _, expected := range []string{"pending", "available", "available"} {
+			status, body := replayTestGet(t, r.URL("IBMCLOUD_IS_NG_API_ENDPOINT")+"/vpcs/r006-test?generation=2&version=2024-01-01")
+			if status != http.StatusOK || !strings.Contains(body, expected) {
+				t.Errorf("Expected %s VPC, got %d %s", expected, status, body)
+			}
+		}
+	})
+}
+
+func TestReplayWithoutCassette(t *testing.T) {
+	replayTestUpstream(t)
+	t.Setenv("IC_REPLAY_MODE", "")
+	cassette := filepath.Join(t.TempDir(), "missing.json")
+
+	var skipped bool
+	t.Run("skip", func(t *testing.T) {
+		defer func() { skipped = t.Skipped() }()
+		newReplay(t, cassette)
+	})
+	if !skipped {
+		t.Error("Expected the test to be skipped without a cassette")
+	}
+}
+
+func TestReplayReadsBetweenChanges(t *testing.T) {
+	upstream := replayTestUpstream(t)
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+
+	t.Run("record", func(t *testing.T) {
+		t.Setenv("IC_REPLAY_MODE", ReplayModeRecord)
+		t.Setenv("IC_API_KEY", "secret")
+		r := newReplay(t, cassette)
+		url := r.URL("IBMCLOUD_IS_NG_API_ENDPOINT") + "/vpcs/r006-test"
+		replayTestGet(t, url)
+		replayTestGet(t, url)
+		replayTestDelete(t, url)
+		replayTestGet(t, url)
+	})
+	upstream.Close()
+
+	t.Run("replay", func(t *testing.T) {
+		t.Setenv("IC_REPLAY_MODE", ReplayModeReplay)
+		r := newReplay(t, cassette)
+		url := r.URL("IBMCLOUD_IS_NG_API_ENDPOINT") + "/vpcs/r006-test"
+		// The VPC is read more often than when recording, without reading the
+		// response recorded after its deletion
+		for _, expected := range []string{"pending", "available", "available", "available"} {
+			if _, body := replayTestGet(t, url); !strings.Contains(body, expected) {
+				t.Errorf("Expected %s VPC, got %s", expected, body)
+			}
+		}
+		if status := replayTestDelete(t, url); status != http.StatusNoContent {
+			t.Errorf("Expected the recorded deletion, got %d", status)
+		}
+		for i := 0; i < 2; i++ {
+			if status, _ := replayTestGet(t, url); status != http.StatusNotFound {
+				t.Errorf("Expected the deleted VPC, got %d", status)
+			}
+		}
+	})
+}
@@ -14,6 +14,7 @@ import (
 	gohttp "net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	// Added code for the Power Colo Offering
@@ -108,6 +109,7 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/api/usermanagement/usermanagementv2"
 	"github.com/IBM-Cloud/bluemix-go/authentication"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/bluemix-go/endpoints"
 	"github.com/IBM-Cloud/bluemix-go/http"
 	"github.com/IBM-Cloud/bluemix-go/rest"
 	bxsession "github.com/IBM-Cloud/bluemix-go/session"
@@ -120,6 +122,7 @@ import (
 	"github.com/IBM/ibm-hpcs-uko-sdk/ukov4"
 	scc "github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	httptransport "github.com/go-openapi/runtime/client"
 )
 
 // RetryAPIDelay - retry api delay
@@ -193,6 +196,21 @@ type Config struct {
 	Zone          string
 	Visibility    string
 	EndpointsFile string
+
+	// Provider level default_tags and ignore_tags
+	Tags *TagsConfig
+
+	// Provider level retry block, defaults to DefaultRetryPolicy(RetryCount)
+	Retry *RetryPolicy
+
+	// Provider level assume_trusted_profile block
+	AssumeTrustedProfile *TrustedProfile
+
+	// Provider level endpoints block, by service, see EndpointServices
+	Endpoints map[string]string
+
+	// Provider level HTTP proxy, TLS and connection settings
+	Transport *TransportConfig
 }
 
 // Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -230,6 +248,7 @@ type ClientSession interface {
 	ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error)
 	SoftLayerSession() *slsession.Session
 	IBMPISession() (*ibmpisession.IBMPISession, error)
+	IBMPISessionForZone(zone string) (*ibmpisession.IBMPISession, error)
 	UserManagementAPI() (usermanagementv2.UserManagementAPI, error)
 	PushServiceV1() (*pushservicev1.PushServiceV1, error)
 	EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error)
@@ -237,6 +256,7 @@ type ClientSession interface {
 	KeyProtectAPI() (*kp.Client, error)
 	KeyManagementAPI() (*kp.Client, error)
 	VpcV1API() (*vpc.VpcV1, error)
+	VpcV1APIForRegion(region string) (*vpc.VpcV1, error)
 	VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error)
 	APIGateway() (*apigateway.ApiGatewayControllerApiV1, error)
 	PrivateDNSClientSession() (*dns.DnsSvcsV1, error)
@@ -299,37 +319,67 @@ type ClientSession interface {
 	ProjectV1() (*project.ProjectV1, error)
 	UsageReportsV4() (*usagereportsv4.UsageReportsV4, error)
 	MqcloudV1() (*mqcloudv1.MqcloudV1, error)
+	TagsConfig() *TagsConfig
+	ServiceEndpoints() map[string]string
+	RetryPolicy() *RetryPolicy
 }
 
 type clientSession struct {
 	session *Session
 
-	appidErr error
-	appidAPI *appid.AppIDManagementV4
+	// Shared by the service clients, which are configured on first use
+	config        *Config
+	fileMap       map[string]interface{}
+	iamURL        string
+	authenticator core.Authenticator
+	tagsConfig    *TagsConfig
+	retryPolicy   *RetryPolicy
+
+	// Endpoints resolved by the clients configured so far, see ServiceEndpoints
+	endpoints      map[string]string
+	endpointsMutex sync.Mutex
 
-	apigatewayErr error
-	apigatewayAPI *apigateway.ApiGatewayControllerApiV1
+	// Transport of the provider HTTP settings, nil when the provider does not set any, in which
+	// case every client keeps its default transport
+	httpTransport *gohttp.Transport
 
-	accountConfigErr     error
-	bmxAccountServiceAPI accountv2.AccountServiceAPI
+	// Set when assuming a trusted profile, refreshes the token of every client
+	trustedProfileAuthenticator tokenAuthenticator
 
-	accountV1ConfigErr     error
-	bmxAccountv1ServiceAPI accountv1.AccountServiceAPI
+	appidErr     error
+	appidAPI     *appid.AppIDManagementV4
+	appIDAPIOnce sync.Once
+
+	apigatewayErr  error
+	apigatewayAPI  *apigateway.ApiGatewayControllerApiV1
+	apiGatewayOnce sync.Once
+
+	accountConfigErr       error
+	bmxAccountServiceAPI   accountv2.AccountServiceAPI
+	bluemixAcccountAPIOnce sync.Once
+
+	accountV1ConfigErr       error
+	bmxAccountv1ServiceAPI   accountv1.AccountServiceAPI
+	bluemixAcccountv1APIOnce sync.Once
 
 	bmxUserDetails  *UserConfig
 	bmxUserFetchErr error
 
-	csConfigErr  error
-	csServiceAPI containerv1.ContainerServiceAPI
+	csConfigErr      error
+	csServiceAPI     containerv1.ContainerServiceAPI
+	containerAPIOnce sync.Once
 
-	csv2ConfigErr  error
-	csv2ServiceAPI containerv2.ContainerServiceAPI
+	csv2ConfigErr       error
+	csv2ServiceAPI      containerv2.ContainerServiceAPI
+	vpcContainerAPIOnce sync.Once
 
 	containerRegistryClientErr error
 	containerRegistryClient    *containerregistryv1.ContainerRegistryV1
+	containerRegistryV1Once    sync.Once
 
 	cfConfigErr  error
 	cfServiceAPI mccpv2.MccpServiceAPI
+	mccpAPIOnce  sync.Once
 
 	cisConfigErr  error
 	cisServiceAPI cisv1.CisServiceAPI
@@ -339,346 +389,455 @@ type clientSession struct {
 
 	globalSearchConfigErr  error
 	globalSearchServiceAPI globalsearchv2.GlobalSearchServiceAPI
+	globalSearchAPIOnce    sync.Once
 
 	globalTaggingConfigErr  error
 	globalTaggingServiceAPI globaltaggingv3.GlobalTaggingServiceAPI
+	globalTaggingAPIOnce    sync.Once
 
 	globalTaggingConfigErrV1  error
 	globalTaggingServiceAPIV1 globaltaggingv1.GlobalTaggingV1
+	globalTaggingAPIv1Once    sync.Once
 
 	globalSearchConfigErrV2  error
 	globalSearchServiceAPIV2 searchv2.GlobalSearchV2
+	globalSearchAPIV2Once    sync.Once
 
 	ibmCloudShellClient    *ibmcloudshellv1.IBMCloudShellV1
 	ibmCloudShellClientErr error
+	ibmCloudShellV1Once    sync.Once
 
-	userManagementErr error
-	userManagementAPI usermanagementv2.UserManagementAPI
+	userManagementErr     error
+	userManagementAPI     usermanagementv2.UserManagementAPI
+	userManagementAPIOnce sync.Once
 
 	icdConfigErr  error
 	icdServiceAPI icdv4.ICDServiceAPI
+	icdAPIOnce    sync.Once
 
 	cloudDatabasesClientErr error
 	cloudDatabasesClient    *clouddatabasesv5.CloudDatabasesV5
+	cloudDatabasesV5Once    sync.Once
 
 	resourceControllerConfigErr  error
 	resourceControllerServiceAPI controller.ResourceControllerAPI
+	resourceControllerAPIOnce    sync.Once
 
 	resourceControllerConfigErrv2  error
 	resourceControllerServiceAPIv2 controllerv2.ResourceControllerAPIV2
+	resourceControllerAPIV2Once    sync.Once
 
 	resourceManagementConfigErrv2  error
 	resourceManagementServiceAPIv2 managementv2.ResourceManagementAPIv2
+	resourceManagementAPIv2Once    sync.Once
 
 	resourceCatalogConfigErr  error
 	resourceCatalogServiceAPI catalog.ResourceCatalogAPI
+	resourceCatalogAPIOnce    sync.Once
 
-	ibmpiConfigErr error
-	ibmpiSession   *ibmpisession.IBMPISession
+	ibmpiConfigErr   error
+	ibmpiSession     *ibmpisession.IBMPISession
+	ibmPISessionOnce sync.Once
 
-	kpErr error
-	kpAPI *kp.API
+	// Power sessions of the zones other than the provider zone, see IBMPISessionForZone
+	ibmpiZoneSessions      map[string]*ibmpisession.IBMPISession
+	ibmpiZoneSessionsMutex sync.Mutex
 
-	kmsErr error
-	kmsAPI *kp.API
+	kpErr             error
+	kpAPI             *kp.API
+	keyProtectAPIOnce sync.Once
 
-	hpcsEndpointErr error
-	hpcsEndpointAPI hpcs.HPCSV2
+	kmsErr               error
+	kmsAPI               *kp.API
+	keyManagementAPIOnce sync.Once
+
+	hpcsEndpointErr     error
+	hpcsEndpointAPI     hpcs.HPCSV2
+	hpcsEndpointAPIOnce sync.Once
 
 	ukoClient    *ukov4.UkoV4
 	ukoClientErr error
+	ukoV4Once    sync.Once
 
-	pDNSClient *dns.DnsSvcsV1
-	pDNSErr    error
+	pDNSClient                  *dns.DnsSvcsV1
+	pDNSErr                     error
+	privateDNSClientSessionOnce sync.Once
 
 	bluemixSessionErr error
 
 	pushServiceClient    *pushservicev1.PushServiceV1
 	pushServiceClientErr error
+	pushServiceV1Once    sync.Once
 
 	eventNotificationsApiClient    *eventnotificationsv1.EventNotificationsV1
 	eventNotificationsApiClientErr error
+	eventNotificationsApiV1Once    sync.Once
 
 	appConfigurationClient    *appconfigurationv1.AppConfigurationV1
 	appConfigurationClientErr error
-
-	vpcErr     error
-	vpcAPI     *vpc.VpcV1
-	vpcbetaErr error
-	vpcBetaAPI *vpcbeta.VpcbetaV1
-
-	directlinkAPI *dl.DirectLinkV1
-	directlinkErr error
-	dlProviderAPI *dlProviderV2.DirectLinkProviderV2
-	dlProviderErr error
-
-	cosConfigErr error
-	cosConfigAPI *cosconfig.ResourceConfigurationV1
-
-	transitgatewayAPI *tg.TransitGatewayApisV1
-	transitgatewayErr error
-
-	functionIAMNamespaceAPI functions.FunctionServiceAPI
-	functionIAMNamespaceErr error
+	appConfigurationV1Once    sync.Once
+
+	vpcErr           error
+	vpcAPI           *vpc.VpcV1
+	vpcV1APIOnce     sync.Once
+	vpcbetaErr       error
+	vpcBetaAPI       *vpcbeta.VpcbetaV1
+	vpcV1BetaAPIOnce sync.Once
+
+	directlinkAPI               *dl.DirectLinkV1
+	directlinkErr               error
+	directlinkV1APIOnce         sync.Once
+	dlProviderAPI               *dlProviderV2.DirectLinkProviderV2
+	dlProviderErr               error
+	directlinkProviderV2APIOnce sync.Once
+
+	cosConfigErr       error
+	cosConfigAPI       *cosconfig.ResourceConfigurationV1
+	cosConfigV1APIOnce sync.Once
+
+	transitgatewayAPI       *tg.TransitGatewayApisV1
+	transitgatewayErr       error
+	transitGatewayV1APIOnce sync.Once
+
+	functionIAMNamespaceAPI     functions.FunctionServiceAPI
+	functionIAMNamespaceErr     error
+	functionIAMNamespaceAPIOnce sync.Once
 
 	// CIS Zones
-	cisZonesErr      error
-	cisZonesV1Client *ciszonesv1.ZonesV1
+	cisZonesErr                 error
+	cisZonesV1Client            *ciszonesv1.ZonesV1
+	cisZonesV1ClientSessionOnce sync.Once
 
 	// CIS Alerts
-	cisAlertsClient *cisalertsv1.AlertsV1
-	cisAlertsErr    error
+	cisAlertsClient      *cisalertsv1.AlertsV1
+	cisAlertsErr         error
+	cisAlertsSessionOnce sync.Once
 
 	// CIS Authenticated Origin Pull
-	cisOriginAuthClient  *cisoriginpull.AuthenticatedOriginPullApiV1
-	cisOriginAuthPullErr error
+	cisOriginAuthClient    *cisoriginpull.AuthenticatedOriginPullApiV1
+	cisOriginAuthPullErr   error
+	cisOrigAuthSessionOnce sync.Once
 
 	// CIS dns service options
-	cisDNSErr           error
-	cisDNSRecordsClient *cisdnsrecordsv1.DnsRecordsV1
+	cisDNSErr                     error
+	cisDNSRecordsClient           *cisdnsrecordsv1.DnsRecordsV1
+	cisDNSRecordClientSessionOnce sync.Once
 
 	// CIS dns bulk service options
-	cisDNSBulkErr          error
-	cisDNSRecordBulkClient *cisdnsbulkv1.DnsRecordBulkV1
+	cisDNSBulkErr                     error
+	cisDNSRecordBulkClient            *cisdnsbulkv1.DnsRecordBulkV1
+	cisDNSRecordBulkClientSessionOnce sync.Once
 
 	// CIS Global Load Balancer Pool service options
-	cisGLBPoolErr    error
-	cisGLBPoolClient *cisglbpoolv0.GlobalLoadBalancerPoolsV0
+	cisGLBPoolErr               error
+	cisGLBPoolClient            *cisglbpoolv0.GlobalLoadBalancerPoolsV0
+	cisGLBPoolClientSessionOnce sync.Once
 
 	// CIS GLB service options
-	cisGLBErr    error
-	cisGLBClient *cisglbv1.GlobalLoadBalancerV1
+	cisGLBErr               error
+	cisGLBClient            *cisglbv1.GlobalLoadBalancerV1
+	cisGLBClientSessionOnce sync.Once
 
 	// CIS GLB health check service options
-	cisGLBHealthCheckErr    error
-	cisGLBHealthCheckClient *cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1
+	cisGLBHealthCheckErr               error
+	cisGLBHealthCheckClient            *cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1
+	cisGLBHealthCheckClientSessionOnce sync.Once
 
 	// CIS IP service options
-	cisIPErr    error
-	cisIPClient *cisipv1.CisIpApiV1
+	cisIPErr               error
+	cisIPClient            *cisipv1.CisIpApiV1
+	cisIPClientSessionOnce sync.Once
 
 	// CIS Zone Rate Limits service options
-	cisRLErr    error
-	cisRLClient *cisratelimitv1.ZoneRateLimitsV1
+	cisRLErr               error
+	cisRLClient            *cisratelimitv1.ZoneRateLimitsV1
+	cisRLClientSessionOnce sync.Once
 
 	// CIS Page Rules service options
-	cisPageRuleErr    error
-	cisPageRuleClient *cispagerulev1.PageRuleApiV1
+	cisPageRuleErr               error
+	cisPageRuleClient            *cispagerulev1.PageRuleApiV1
+	cisPageRuleClientSessionOnce sync.Once
 
 	// CIS Edge Functions service options
-	cisEdgeFunctionErr    error
-	cisEdgeFunctionClient *cisedgefunctionv1.EdgeFunctionsApiV1
+	cisEdgeFunctionErr               error
+	cisEdgeFunctionClient            *cisedgefunctionv1.EdgeFunctionsApiV1
+	cisEdgeFunctionClientSessionOnce sync.Once
 
 	// CIS SSL certificate service options
-	cisSSLErr    error
-	cisSSLClient *cissslv1.SslCertificateApiV1
+	cisSSLErr               error
+	cisSSLClient            *cissslv1.SslCertificateApiV1
+	cisSSLClientSessionOnce sync.Once
 
 	// CIS WAF Package service options
-	cisWAFPackageErr    error
-	cisWAFPackageClient *ciswafpackagev1.WafRulePackagesApiV1
+	cisWAFPackageErr               error
+	cisWAFPackageClient            *ciswafpackagev1.WafRulePackagesApiV1
+	cisWAFPackageClientSessionOnce sync.Once
 
 	// CIS Zone Setting service options
-	cisDomainSettingsErr    error
-	cisDomainSettingsClient *cisdomainsettingsv1.ZonesSettingsV1
+	cisDomainSettingsErr               error
+	cisDomainSettingsClient            *cisdomainsettingsv1.ZonesSettingsV1
+	cisDomainSettingsClientSessionOnce sync.Once
 
 	// CIS Routing service options
-	cisRoutingErr    error
-	cisRoutingClient *cisroutingv1.RoutingV1
+	cisRoutingErr               error
+	cisRoutingClient            *cisroutingv1.RoutingV1
+	cisRoutingClientSessionOnce sync.Once
 
 	// CIS WAF Group service options
-	cisWAFGroupErr    error
-	cisWAFGroupClient *ciswafgroupv1.WafRuleGroupsApiV1
+	cisWAFGroupErr               error
+	cisWAFGroupClient            *ciswafgroupv1.WafRuleGroupsApiV1
+	cisWAFGroupClientSessionOnce sync.Once
 
 	// CIS Caching service options
-	cisCacheErr    error
-	cisCacheClient *ciscachev1.CachingApiV1
+	cisCacheErr               error
+	cisCacheClient            *ciscachev1.CachingApiV1
+	cisCacheClientSessionOnce sync.Once
 
 	// CIS Custom Pages service options
-	cisCustomPageErr    error
-	cisCustomPageClient *ciscustompagev1.CustomPagesV1
+	cisCustomPageErr               error
+	cisCustomPageClient            *ciscustompagev1.CustomPagesV1
+	cisCustomPageClientSessionOnce sync.Once
 
 	// CIS Firewall Access rule service option
-	cisAccessRuleErr    error
-	cisAccessRuleClient *cisaccessrulev1.ZoneFirewallAccessRulesV1
+	cisAccessRuleErr               error
+	cisAccessRuleClient            *cisaccessrulev1.ZoneFirewallAccessRulesV1
+	cisAccessRuleClientSessionOnce sync.Once
 
 	// CIS User Agent Blocking Rule service option
-	cisUARuleErr    error
-	cisUARuleClient *cisuarulev1.UserAgentBlockingRulesV1
+	cisUARuleErr               error
+	cisUARuleClient            *cisuarulev1.UserAgentBlockingRulesV1
+	cisUARuleClientSessionOnce sync.Once
 
 	// CIS Firewall Lockdwon Rule service option
-	cisLockdownErr    error
-	cisLockdownClient *cislockdownv1.ZoneLockdownV1
+	cisLockdownErr               error
+	cisLockdownClient            *cislockdownv1.ZoneLockdownV1
+	cisLockdownClientSessionOnce sync.Once
 
 	// CIS LogpushJobs service option
-	cisLogpushJobsClient *cislogpushjobsapiv1.LogpushJobsApiV1
-	cisLogpushJobsErr    error
+	cisLogpushJobsClient      *cislogpushjobsapiv1.LogpushJobsApiV1
+	cisLogpushJobsErr         error
+	cisLogpushJobsSessionOnce sync.Once
 
 	// CIS Range app service option
-	cisRangeAppErr    error
-	cisRangeAppClient *cisrangeappv1.RangeApplicationsV1
+	cisRangeAppErr               error
+	cisRangeAppClient            *cisrangeappv1.RangeApplicationsV1
+	cisRangeAppClientSessionOnce sync.Once
 
 	// CIS WAF rule service options
-	cisWAFRuleErr    error
-	cisWAFRuleClient *ciswafrulev1.WafRulesApiV1
+	cisWAFRuleErr               error
+	cisWAFRuleClient            *ciswafrulev1.WafRulesApiV1
+	cisWAFRuleClientSessionOnce sync.Once
 	// IAM Identity Option
-	iamIdentityErr error
-	iamIdentityAPI *iamidentity.IamIdentityV1
+	iamIdentityErr       error
+	iamIdentityAPI       *iamidentity.IamIdentityV1
+	iamIdentityV1APIOnce sync.Once
 
 	// Resource Manager Option
-	resourceManagerErr error
-	resourceManagerAPI *resourcemanager.ResourceManagerV2
+	resourceManagerErr       error
+	resourceManagerAPI       *resourcemanager.ResourceManagerV2
+	resourceManagerV2APIOnce sync.Once
 
 	// Catalog Management Option
 	catalogManagementClient    *catalogmanagementv1.CatalogManagementV1
 	catalogManagementClientErr error
+	catalogManagementV1Once    sync.Once
 
 	enterpriseManagementClient    *enterprisemanagementv1.EnterpriseManagementV1
 	enterpriseManagementClientErr error
+	enterpriseManagementV1Once    sync.Once
 
 	// Resource Controller Option
-	resourceControllerErr   error
-	resourceControllerAPI   *resourcecontroller.ResourceControllerV2
-	secretsManagerClient    *secretsmanagerv2.SecretsManagerV2
-	secretsManagerClientErr error
+	resourceControllerErr       error
+	resourceControllerAPI       *resourcecontroller.ResourceControllerV2
+	resourceControllerV2APIOnce sync.Once
+	secretsManagerClient        *secretsmanagerv2.SecretsManagerV2
+	secretsManagerClientErr     error
+	secretsManagerV2Once        sync.Once
 
 	// Schematics service options
 	schematicsClient    *schematicsv1.SchematicsV1
 	schematicsClientErr error
+	schematicsV1Once    sync.Once
 
 	// Satellite service
-	satelliteClient    *kubernetesserviceapiv1.KubernetesServiceApiV1
-	satelliteClientErr error
+	satelliteClient            *kubernetesserviceapiv1.KubernetesServiceApiV1
+	satelliteClientErr         error
+	satelliteClientSessionOnce sync.Once
 
 	// IAM Policy Management
-	iamPolicyManagementErr error
-	iamPolicyManagementAPI *iampolicymanagement.IamPolicyManagementV1
+	iamPolicyManagementErr       error
+	iamPolicyManagementAPI       *iampolicymanagement.IamPolicyManagementV1
+	iamPolicyManagementV1APIOnce sync.Once
 
 	// IAM Access Groups
-	iamAccessGroupsErr error
-	iamAccessGroupsAPI *iamaccessgroups.IamAccessGroupsV2
+	iamAccessGroupsErr    error
+	iamAccessGroupsAPI    *iamaccessgroups.IamAccessGroupsV2
+	iamAccessGroupsV2Once sync.Once
 
 	// MTLS Session options
-	cisMtlsClient *cismtlsv1.MtlsV1
-	cisMtlsErr    error
+	cisMtlsClient      *cismtlsv1.MtlsV1
+	cisMtlsErr         error
+	cisMtlsSessionOnce sync.Once
 
 	// Bot Management options
-	cisBotManagementClient *cisbotmanagementv1.BotManagementV1
-	cisBotManagementErr    error
+	cisBotManagementClient      *cisbotmanagementv1.BotManagementV1
+	cisBotManagementErr         error
+	cisBotManagementSessionOnce sync.Once
 
 	// Bot Analytics options
-	cisBotAnalyticsClient *cisbotanalyticsv1.BotAnalyticsV1
-	cisBotAnalyticsErr    error
+	cisBotAnalyticsClient      *cisbotanalyticsv1.BotAnalyticsV1
+	cisBotAnalyticsErr         error
+	cisBotAnalyticsSessionOnce sync.Once
 
 	// CIS Webhooks options
-	cisWebhooksClient *ciswebhooksv1.WebhooksV1
-	cisWebhooksErr    error
+	cisWebhooksClient     *ciswebhooksv1.WebhooksV1
+	cisWebhooksErr        error
+	cisWebhookSessionOnce sync.Once
 
 	// CIS Filters options
-	cisFiltersClient *cisfiltersv1.FiltersV1
-	cisFiltersErr    error
+	cisFiltersClient      *cisfiltersv1.FiltersV1
+	cisFiltersErr         error
+	cisFiltersSessionOnce sync.Once
 
 	// CIS FirewallRules options
-	cisFirewallRulesClient *cisfirewallrulesv1.FirewallRulesV1
-	cisFirewallRulesErr    error
+	cisFirewallRulesClient      *cisfirewallrulesv1.FirewallRulesV1
+	cisFirewallRulesErr         error
+	cisFirewallRulesSessionOnce sync.Once
 
 	// Atracker
 	atrackerClientV2    *atrackerv2.AtrackerV2
 	atrackerClientV2Err error
+	atrackerV2Once      sync.Once
 
 	// Metrics Router
 	metricsRouterClient    *metricsrouterv3.MetricsRouterV3
 	metricsRouterClientErr error
+	metricsRouterV3Once    sync.Once
 
 	// Satellite link service
-	satelliteLinkClient    *satellitelinkv1.SatelliteLinkV1
-	satelliteLinkClientErr error
+	satelliteLinkClient           *satellitelinkv1.SatelliteLinkV1
+	satelliteLinkClientErr        error
+	satellitLinkClientSessionOnce sync.Once
 
-	esSchemaRegistryClient *schemaregistryv1.SchemaregistryV1
-	esSchemaRegistryErr    error
+	esSchemaRegistryClient      *schemaregistryv1.SchemaregistryV1
+	esSchemaRegistryErr         error
+	esSchemaRegistrySessionOnce sync.Once
 
 	// Security and Compliance Center (SCC)
 	securityAndComplianceCenterClient    *scc.SecurityAndComplianceCenterApiV3
 	securityAndComplianceCenterClientErr error
+	securityAndComplianceCenterV3Once    sync.Once
 
 	// context Based Restrictions (CBR)
 	contextBasedRestrictionsClient    *contextbasedrestrictionsv1.ContextBasedRestrictionsV1
 	contextBasedRestrictionsClientErr error
+	contextBasedRestrictionsV1Once    sync.Once
 
 	// CD Toolchain
 	cdToolchainClient    *cdtoolchainv2.CdToolchainV2
 	cdToolchainClientErr error
+	cdToolchainV2Once    sync.Once
 
 	// CD Tekton Pipeline
 	cdTektonPipelineClient    *cdtektonpipelinev2.CdTektonPipelineV2
 	cdTektonPipelineClientErr error
+	cdTektonPipelineV2Once    sync.Once
 
 	// Code Engine options
 	codeEngineClient    *codeengine.CodeEngineV2
 	codeEngineClientErr error
+	codeEngineV2Once    sync.Once
 
 	// Project options
 	projectClient    *project.ProjectV1
 	projectClientErr error
+	projectV1Once    sync.Once
 
 	// Usage Reports options
 	usageReportsClient    *usagereportsv4.UsageReportsV4
 	usageReportsClientErr error
+	usageReportsV4Once    sync.Once
 
 	mqcloudClient    *mqcloudv1.MqcloudV1
 	mqcloudClientErr error
+	mqcloudV1Once    sync.Once
 }
 
 // Usage Reports
-func (session clientSession) UsageReportsV4() (*usagereportsv4.UsageReportsV4, error) {
+func (session *clientSession) UsageReportsV4() (*usagereportsv4.UsageReportsV4, error) {
+	session.lazyConfigure(&session.usageReportsV4Once, session.configureUsageReportsV4)
 	return session.usageReportsClient, session.usageReportsClientErr
 }
 
 // AppIDAPI provides AppID Service APIs ...
-func (session clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+func (session *clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+	session.lazyConfigure(&session.appIDAPIOnce, session.configureAppIDAPI)
 	return session.appidAPI, session.appidErr
 }
 
-func (session clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+func (session *clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+	session.lazyConfigure(&session.catalogManagementV1Once, session.configureCatalogManagementV1)
 	return session.catalogManagementClient, session.catalogManagementClientErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+	sess.lazyConfigure(&sess.bluemixAcccountAPIOnce, sess.configureBluemixAcccountAPI)
 	return sess.bmxAccountServiceAPI, sess.accountConfigErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+	sess.lazyConfigure(&sess.bluemixAcccountv1APIOnce, sess.configureBluemixAcccountv1API)
 	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
 }
 
 // BluemixSession to provide the Bluemix Session
-func (sess clientSession) BluemixSession() (*bxsession.Session, error) {
+func (sess *clientSession) BluemixSession() (*bxsession.Session, error) {
+	if sess.trustedProfileAuthenticator != nil && sess.session.BluemixSession != nil {
+		// The shared session is not modified, the callers get a copy with the current token
+		token, err := sess.trustedProfileAuthenticator.GetToken()
+		if err != nil {
+			return nil, err
+		}
+		config := sess.session.BluemixSession.Config.Copy()
+		config.IAMAccessToken = "Bearer " + token
+		return &bxsession.Session{Config: config}, sess.bluemixSessionErr
+	}
 	return sess.session.BluemixSession, sess.bluemixSessionErr
 }
 
 // BluemixUserDetails ...
-func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
+func (sess *clientSession) BluemixUserDetails() (*UserConfig, error) {
 	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
+// TagsConfig provides the provider level default_tags and ignore_tags
+func (sess *clientSession) TagsConfig() *TagsConfig {
+	return sess.tagsConfig
+}
+
 // ContainerAPI provides Container Service APIs ...
-func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+func (sess *clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+	sess.lazyConfigure(&sess.containerAPIOnce, sess.configureContainerAPI)
 	return sess.csServiceAPI, sess.csConfigErr
 }
 
 // VpcContainerAPI provides v2Container Service APIs ...
-func (sess clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+func (sess *clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+	sess.lazyConfigure(&sess.vpcContainerAPIOnce, sess.configureVpcContainerAPI)
 	return sess.csv2ServiceAPI, sess.csv2ConfigErr
 }
 
 // ContainerRegistryV1 provides Container Registry Service APIs ...
-func (session clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+func (session *clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+	session.lazyConfigure(&session.containerRegistryV1Once, session.configureContainerRegistryV1)
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
 // SchematicsAPI provides schematics Service APIs ...
-func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+func (sess *clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+	sess.lazyConfigure(&sess.schematicsV1Once, sess.configureSchematicsV1)
 	if sess.schematicsClientErr != nil {
 		return sess.schematicsClient, sess.schematicsClientErr
 	}
@@ -687,193 +846,276 @@ func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
 }
 
 // FunctionClient ...
-func (sess clientSession) FunctionClient() (*whisk.Client, error) {
+func (sess *clientSession) FunctionClient() (*whisk.Client, error) {
 	return sess.functionClient, sess.functionConfigErr
 }
 
 // GlobalSearchAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+func (sess *clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+	sess.lazyConfigure(&sess.globalSearchAPIOnce, sess.configureGlobalSearchAPI)
 	return sess.globalSearchServiceAPI, sess.globalSearchConfigErr
 }
 
 // GlobalTaggingAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+func (sess *clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+	sess.lazyConfigure(&sess.globalTaggingAPIOnce, sess.configureGlobalTaggingAPI)
 	return sess.globalTaggingServiceAPI, sess.globalTaggingConfigErr
 }
 
 // GlobalTaggingAPIV1 provides Platform-go Global Tagging  APIs ...
-func (sess clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+func (sess *clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+	sess.lazyConfigure(&sess.globalTaggingAPIv1Once, sess.configureGlobalTaggingAPIv1)
 	return sess.globalTaggingServiceAPIV1, sess.globalTaggingConfigErrV1
 }
 
 // GlobalSearchAPIV2 provides Platform-go Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
+func (sess *clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
+	sess.lazyConfigure(&sess.globalSearchAPIV2Once, sess.configureGlobalSearchAPIV2)
 	return sess.globalSearchServiceAPIV2, sess.globalSearchConfigErrV2
 }
 
 // HpcsEndpointAPI provides Hpcs Endpoint generator APIs ...
-func (sess clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+func (sess *clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+	sess.lazyConfigure(&sess.hpcsEndpointAPIOnce, sess.configureHpcsEndpointAPI)
 	return sess.hpcsEndpointAPI, sess.hpcsEndpointErr
 }
 
 // UKO
-func (session clientSession) UkoV4() (*ukov4.UkoV4, error) {
-	return session.ukoClient, session.ukoClientErr
+func (session *clientSession) UkoV4() (*ukov4.UkoV4, error) {
+	session.lazyConfigure(&session.ukoV4Once, session.configureUkoV4)
+	if session.ukoClientErr != nil {
+		return session.ukoClient, session.ukoClientErr
+	}
+	return session.ukoClient.Clone(), nil
 }
 
 // UserManagementAPI provides User management APIs ...
-func (sess clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+func (sess *clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+	sess.lazyConfigure(&sess.userManagementAPIOnce, sess.configureUserManagementAPI)
 	return sess.userManagementAPI, sess.userManagementErr
 }
 
 // IAM Policy Management
-func (sess clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+func (sess *clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+	sess.lazyConfigure(&sess.iamPolicyManagementV1APIOnce, sess.configureIAMPolicyManagementV1API)
 	return sess.iamPolicyManagementAPI, sess.iamPolicyManagementErr
 }
 
 // IAMAccessGroupsV2 provides IAM AG APIs ...
-func (sess clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+func (sess *clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+	sess.lazyConfigure(&sess.iamAccessGroupsV2Once, sess.configureIAMAccessGroupsV2)
 	return sess.iamAccessGroupsAPI, sess.iamAccessGroupsErr
 }
 
 // IBM Cloud Shell
-func (session clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+func (session *clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+	session.lazyConfigure(&session.ibmCloudShellV1Once, session.configureIBMCloudShellV1)
 	return session.ibmCloudShellClient, session.ibmCloudShellClientErr
 }
 
 // IcdAPI provides IBM Cloud Databases APIs ...
-func (sess clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+func (sess *clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+	sess.lazyConfigure(&sess.icdAPIOnce, sess.configureICDAPI)
 	return sess.icdServiceAPI, sess.icdConfigErr
 }
 
 // The IBM Cloud Databases API
-func (session clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+func (session *clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+	session.lazyConfigure(&session.cloudDatabasesV5Once, session.configureCloudDatabasesV5)
 	return session.cloudDatabasesClient, session.cloudDatabasesClientErr
 }
 
 // MccpAPI provides Multi Cloud Controller Proxy APIs ...
-func (sess clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+func (sess *clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+	sess.lazyConfigure(&sess.mccpAPIOnce, sess.configureMccpAPI)
 	return sess.cfServiceAPI, sess.cfConfigErr
 }
 
 // ResourceCatalogAPI ...
-func (sess clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+func (sess *clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+	sess.lazyConfigure(&sess.resourceCatalogAPIOnce, sess.configureResourceCatalogAPI)
 	return sess.resourceCatalogServiceAPI, sess.resourceCatalogConfigErr
 }
 
 // ResourceManagementAPIv2 ...
-func (sess clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+func (sess *clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+	sess.lazyConfigure(&sess.resourceManagementAPIv2Once, sess.configureResourceManagementAPIv2)
 	return sess.resourceManagementServiceAPIv2, sess.resourceManagementConfigErrv2
 }
 
 // ResourceControllerAPI ...
-func (sess clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+func (sess *clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+	sess.lazyConfigure(&sess.resourceControllerAPIOnce, sess.configureResourceControllerAPI)
 	return sess.resourceControllerServiceAPI, sess.resourceControllerConfigErr
 }
 
 // ResourceControllerAPIv2 ...
-func (sess clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+func (sess *clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+	sess.lazyConfigure(&sess.resourceControllerAPIV2Once, sess.configureResourceControllerAPIV2)
 	return sess.resourceControllerServiceAPIv2, sess.resourceControllerConfigErrv2
 }
 
 // SoftLayerSession providers SoftLayer Session
-func (sess clientSession) SoftLayerSession() *slsession.Session {
+func (sess *clientSession) SoftLayerSession() *slsession.Session {
 	return sess.session.SoftLayerSession
 }
 
 // apigatewayAPI provides API Gateway APIs
-func (sess clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+func (sess *clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+	sess.lazyConfigure(&sess.apiGatewayOnce, sess.configureAPIGateway)
 	return sess.apigatewayAPI, sess.apigatewayErr
 }
 
-func (session clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+func (session *clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+	session.lazyConfigure(&session.pushServiceV1Once, session.configurePushServiceV1)
 	return session.pushServiceClient, session.pushServiceClientErr
 }
 
-func (session clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+func (session *clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+	session.lazyConfigure(&session.eventNotificationsApiV1Once, session.configureEventNotificationsApiV1)
 	return session.eventNotificationsApiClient, session.eventNotificationsApiClientErr
 }
 
-func (session clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+func (session *clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+	session.lazyConfigure(&session.appConfigurationV1Once, session.configureAppConfigurationV1)
 	return session.appConfigurationClient, session.appConfigurationClientErr
 }
 
-func (sess clientSession) KeyProtectAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyProtectAPI() (*kp.Client, error) {
+	sess.lazyConfigure(&sess.keyProtectAPIOnce, sess.configureKeyProtectAPI)
 	return sess.kpAPI, sess.kpErr
 }
 
-func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyManagementAPI() (*kp.Client, error) {
+	sess.lazyConfigure(&sess.keyManagementAPIOnce, sess.configureKeyManagementAPI)
 	if sess.kmsErr == nil {
 		var clientConfig *kp.ClientConfig
 		if sess.kmsAPI.Config.APIKey != "" {
 			clientConfig = &kp.ClientConfig{
-				BaseURL:  EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, sess.kmsAPI.Config.BaseURL),
+				BaseURL:  sess.endpoint("kms", sess.kmsAPI.Config.BaseURL),
 				APIKey:   sess.kmsAPI.Config.APIKey, // pragma: allowlist secret
 				Verbose:  kp.VerboseFailOnly,
 				TokenURL: sess.kmsAPI.Config.TokenURL,
 			}
 		} else {
 			clientConfig = &kp.ClientConfig{
-				BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, sess.kmsAPI.Config.BaseURL),
+				BaseURL:       sess.endpoint("kms", sess.kmsAPI.Config.BaseURL),
 				Authorization: sess.session.BluemixSession.Config.IAMAccessToken, // pragma: allowlist secret
 				Verbose:       kp.VerboseFailOnly,
 				TokenURL:      sess.kmsAPI.Config.TokenURL,
 			}
 		}
 
-		kpClient, err := kp.New(*clientConfig, DefaultTransport())
+		kpClient, err := kp.New(*clientConfig, sess.serviceTransport("kms"))
 		if err != nil {
-			sess.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
+			return nil, fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 		}
 		return kpClient, nil
 	}
 	return sess.kmsAPI, sess.kmsErr
 }
 
-func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
+func (sess *clientSession) VpcV1API() (*vpc.VpcV1, error) {
+	sess.lazyConfigure(&sess.vpcV1APIOnce, sess.configureVpcV1API)
 	return sess.vpcAPI, sess.vpcErr
 }
 
-func (sess clientSession) VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error) {
+// VpcV1APIForRegion returns a VPC client for region, which shares the authenticator and the
+// retries of VpcV1API. The vpc endpoint override of the provider only applies to its own region.
+func (sess *clientSession) VpcV1APIForRegion(region string) (*vpc.VpcV1, error) {
+	vpcClient, err := sess.VpcV1API()
+	if err != nil || vpcClient == nil || region == "" || region == sess.config.Region {
+		return vpcClient, err
+	}
+	regionClient := vpcClient.Clone()
+	if err = regionClient.SetServiceURL(sess.vpcURLForRegion(region)); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error occured while configuring vpc service for region %s: %q", region, err)
+	}
+	return regionClient, nil
+}
+
+func (sess *clientSession) VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error) {
+	sess.lazyConfigure(&sess.vpcV1BetaAPIOnce, sess.configureVpcV1BetaAPI)
 	return sess.vpcBetaAPI, sess.vpcbetaErr
 }
 
-func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+func (sess *clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+	sess.lazyConfigure(&sess.directlinkV1APIOnce, sess.configureDirectlinkV1API)
 	return sess.directlinkAPI, sess.directlinkErr
 }
 
-func (sess clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+func (sess *clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+	sess.lazyConfigure(&sess.directlinkProviderV2APIOnce, sess.configureDirectlinkProviderV2API)
 	return sess.dlProviderAPI, sess.dlProviderErr
 }
 
-func (sess clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+func (sess *clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+	sess.lazyConfigure(&sess.cosConfigV1APIOnce, sess.configureCosConfigV1API)
 	return sess.cosConfigAPI, sess.cosConfigErr
 }
 
-func (sess clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+func (sess *clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+	sess.lazyConfigure(&sess.transitGatewayV1APIOnce, sess.configureTransitGatewayV1API)
 	return sess.transitgatewayAPI, sess.transitgatewayErr
 }
 
 // Session to the Power Colo Service
 
-func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+func (sess *clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+	sess.lazyConfigure(&sess.ibmPISessionOnce, sess.configureIBMPISession)
 	return sess.ibmpiSession, sess.ibmpiConfigErr
 }
 
+// IBMPISessionForZone returns a Power session for zone, which shares the authenticator and the
+// retries of IBMPISession and is created once per zone. The power endpoint override of the
+// provider applies to the region of the zone, see piURLForZone.
+func (sess *clientSession) IBMPISessionForZone(zone string) (*ibmpisession.IBMPISession, error) {
+	piSession, err := sess.IBMPISession()
+	if err != nil || piSession == nil || zone == "" || zone == sess.config.Zone {
+		return piSession, err
+	}
+	sess.ibmpiZoneSessionsMutex.Lock()
+	defer sess.ibmpiZoneSessionsMutex.Unlock()
+	if zoneSession, ok := sess.ibmpiZoneSessions[zone]; ok {
+		return zoneSession, nil
+	}
+	ibmPIOptions := &ibmpisession.IBMPIOptions{
+		Authenticator: sess.authenticator,
+		Debug:         os.Getenv("TF_LOG") != "",
+		Region:        piRegionFromZone(zone),
+		URL:           sess.piURLForZone(zone),
+		UserAccount:   sess.bmxUserDetails.UserAccount,
+		Zone:          zone,
+	}
+	zoneSession, err := ibmpisession.NewIBMPISession(ibmPIOptions)
+	if err != nil {
+		return nil, fmt.Errorf("Error occured while configuring ibmpisession for zone %s: %q", zone, err)
+	}
+	sess.enablePIRetries(zoneSession)
+	if sess.ibmpiZoneSessions == nil {
+		sess.ibmpiZoneSessions = map[string]*ibmpisession.IBMPISession{}
+	}
+	sess.ibmpiZoneSessions[zone] = zoneSession
+	return zoneSession, nil
+}
+
 // Private DNS Service
 
-func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+func (sess *clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+	sess.lazyConfigure(&sess.privateDNSClientSessionOnce, sess.configurePrivateDNSClientSession)
 	return sess.pDNSClient, sess.pDNSErr
 }
 
 // Session to the Namespace cloud function
 
-func (sess clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+func (sess *clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+	sess.lazyConfigure(&sess.functionIAMNamespaceAPIOnce, sess.configureFunctionIAMNamespaceAPI)
 	return sess.functionIAMNamespaceAPI, sess.functionIAMNamespaceErr
 }
 
 // CIS Zones Service
-func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+func (sess *clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+	sess.lazyConfigure(&sess.cisZonesV1ClientSessionOnce, sess.configureCisZonesV1ClientSession)
 	if sess.cisZonesErr != nil {
 		return sess.cisZonesV1Client, sess.cisZonesErr
 	}
@@ -881,7 +1123,8 @@ func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error)
 }
 
 // CIS DNS Service
-func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+func (sess *clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+	sess.lazyConfigure(&sess.cisDNSRecordClientSessionOnce, sess.configureCisDNSRecordClientSession)
 	if sess.cisDNSErr != nil {
 		return sess.cisDNSRecordsClient, sess.cisDNSErr
 	}
@@ -889,7 +1132,8 @@ func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecor
 }
 
 // CIS DNS Bulk Service
-func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+func (sess *clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+	sess.lazyConfigure(&sess.cisDNSRecordBulkClientSessionOnce, sess.configureCisDNSRecordBulkClientSession)
 	if sess.cisDNSBulkErr != nil {
 		return sess.cisDNSRecordBulkClient, sess.cisDNSBulkErr
 	}
@@ -897,7 +1141,8 @@ func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsReco
 }
 
 // CIS GLB Pool
-func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+func (sess *clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+	sess.lazyConfigure(&sess.cisGLBPoolClientSessionOnce, sess.configureCisGLBPoolClientSession)
 	if sess.cisGLBPoolErr != nil {
 		return sess.cisGLBPoolClient, sess.cisGLBPoolErr
 	}
@@ -905,7 +1150,8 @@ func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBal
 }
 
 // CIS GLB
-func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+func (sess *clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+	sess.lazyConfigure(&sess.cisGLBClientSessionOnce, sess.configureCisGLBClientSession)
 	if sess.cisGLBErr != nil {
 		return sess.cisGLBClient, sess.cisGLBErr
 	}
@@ -913,7 +1159,8 @@ func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1,
 }
 
 // CIS GLB Health Check/Monitor
-func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+func (sess *clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+	sess.lazyConfigure(&sess.cisGLBHealthCheckClientSessionOnce, sess.configureCisGLBHealthCheckClientSession)
 	if sess.cisGLBHealthCheckErr != nil {
 		return sess.cisGLBHealthCheckClient, sess.cisGLBHealthCheckErr
 	}
@@ -921,7 +1168,8 @@ func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1
 }
 
 // CIS Zone Rate Limits
-func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+func (sess *clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+	sess.lazyConfigure(&sess.cisRLClientSessionOnce, sess.configureCisRLClientSession)
 	if sess.cisRLErr != nil {
 		return sess.cisRLClient, sess.cisRLErr
 	}
@@ -929,7 +1177,8 @@ func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1
 }
 
 // CIS IP
-func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+func (sess *clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+	sess.lazyConfigure(&sess.cisIPClientSessionOnce, sess.configureCisIPClientSession)
 	if sess.cisIPErr != nil {
 		return sess.cisIPClient, sess.cisIPErr
 	}
@@ -937,7 +1186,8 @@ func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
 }
 
 // CIS Page Rules
-func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+func (sess *clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+	sess.lazyConfigure(&sess.cisPageRuleClientSessionOnce, sess.configureCisPageRuleClientSession)
 	if sess.cisPageRuleErr != nil {
 		return sess.cisPageRuleClient, sess.cisPageRuleErr
 	}
@@ -945,7 +1195,8 @@ func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApi
 }
 
 // CIS Edge Function
-func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+func (sess *clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+	sess.lazyConfigure(&sess.cisEdgeFunctionClientSessionOnce, sess.configureCisEdgeFunctionClientSession)
 	if sess.cisEdgeFunctionErr != nil {
 		return sess.cisEdgeFunctionClient, sess.cisEdgeFunctionErr
 	}
@@ -953,7 +1204,8 @@ func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.Edg
 }
 
 // CIS SSL certificate
-func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+func (sess *clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+	sess.lazyConfigure(&sess.cisSSLClientSessionOnce, sess.configureCisSSLClientSession)
 	if sess.cisSSLErr != nil {
 		return sess.cisSSLClient, sess.cisSSLErr
 	}
@@ -961,7 +1213,8 @@ func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1,
 }
 
 // CIS WAF Packages
-func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+func (sess *clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+	sess.lazyConfigure(&sess.cisWAFPackageClientSessionOnce, sess.configureCisWAFPackageClientSession)
 	if sess.cisWAFPackageErr != nil {
 		return sess.cisWAFPackageClient, sess.cisWAFPackageErr
 	}
@@ -969,7 +1222,8 @@ func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRule
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+func (sess *clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+	sess.lazyConfigure(&sess.cisDomainSettingsClientSessionOnce, sess.configureCisDomainSettingsClientSession)
 	if sess.cisDomainSettingsErr != nil {
 		return sess.cisDomainSettingsClient, sess.cisDomainSettingsErr
 	}
@@ -977,7 +1231,8 @@ func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1
 }
 
 // CIS Alerts
-func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+func (sess *clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+	sess.lazyConfigure(&sess.cisAlertsSessionOnce, sess.configureCisAlertsSession)
 	if sess.cisAlertsErr != nil {
 		return sess.cisAlertsClient, sess.cisAlertsErr
 	}
@@ -985,7 +1240,8 @@ func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
 }
 
 // CIS Routing
-func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+func (sess *clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+	sess.lazyConfigure(&sess.cisRoutingClientSessionOnce, sess.configureCisRoutingClientSession)
 	if sess.cisRoutingErr != nil {
 		return sess.cisRoutingClient, sess.cisRoutingErr
 	}
@@ -993,7 +1249,8 @@ func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, er
 }
 
 // CIS WAF Group
-func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+func (sess *clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+	sess.lazyConfigure(&sess.cisWAFGroupClientSessionOnce, sess.configureCisWAFGroupClientSession)
 	if sess.cisWAFGroupErr != nil {
 		return sess.cisWAFGroupClient, sess.cisWAFGroupErr
 	}
@@ -1001,7 +1258,8 @@ func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGrou
 }
 
 // CIS Cache service
-func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+func (sess *clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+	sess.lazyConfigure(&sess.cisCacheClientSessionOnce, sess.configureCisCacheClientSession)
 	if sess.cisCacheErr != nil {
 		return sess.cisCacheClient, sess.cisCacheErr
 	}
@@ -1009,7 +1267,8 @@ func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, err
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+func (sess *clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+	sess.lazyConfigure(&sess.cisCustomPageClientSessionOnce, sess.configureCisCustomPageClientSession)
 	if sess.cisCustomPageErr != nil {
 		return sess.cisCustomPageClient, sess.cisCustomPageErr
 	}
@@ -1017,7 +1276,8 @@ func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomP
 }
 
 // CIS Firewall access rule
-func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+func (sess *clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+	sess.lazyConfigure(&sess.cisAccessRuleClientSessionOnce, sess.configureCisAccessRuleClientSession)
 	if sess.cisAccessRuleErr != nil {
 		return sess.cisAccessRuleClient, sess.cisAccessRuleErr
 	}
@@ -1025,7 +1285,8 @@ func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFir
 }
 
 // CIS User Agent Blocking rule
-func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+func (sess *clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+	sess.lazyConfigure(&sess.cisUARuleClientSessionOnce, sess.configureCisUARuleClientSession)
 	if sess.cisUARuleErr != nil {
 		return sess.cisUARuleClient, sess.cisUARuleErr
 	}
@@ -1033,7 +1294,8 @@ func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlocki
 }
 
 // CIS Firewall Lockdown rule
-func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+func (sess *clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+	sess.lazyConfigure(&sess.cisLockdownClientSessionOnce, sess.configureCisLockdownClientSession)
 	if sess.cisLockdownErr != nil {
 		return sess.cisLockdownClient, sess.cisLockdownErr
 	}
@@ -1041,7 +1303,8 @@ func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdow
 }
 
 // CIS Range app rule
-func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+func (sess *clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+	sess.lazyConfigure(&sess.cisRangeAppClientSessionOnce, sess.configureCisRangeAppClientSession)
 	if sess.cisRangeAppErr != nil {
 		return sess.cisRangeAppClient, sess.cisRangeAppErr
 	}
@@ -1049,7 +1312,8 @@ func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplic
 }
 
 // CIS WAF Rule
-func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+func (sess *clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+	sess.lazyConfigure(&sess.cisWAFRuleClientSessionOnce, sess.configureCisWAFRuleClientSession)
 	if sess.cisWAFRuleErr != nil {
 		return sess.cisWAFRuleClient, sess.cisWAFRuleErr
 	}
@@ -1057,7 +1321,8 @@ func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1
 }
 
 // CIS Authenticated Origin Pull
-func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+func (sess *clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+	sess.lazyConfigure(&sess.cisOrigAuthSessionOnce, sess.configureCisOrigAuthSession)
 	if sess.cisOriginAuthPullErr != nil {
 		return sess.cisOriginAuthClient, sess.cisOriginAuthPullErr
 	}
@@ -1065,43 +1330,51 @@ func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOrig
 }
 
 // IAM Identity Session
-func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+func (sess *clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+	sess.lazyConfigure(&sess.iamIdentityV1APIOnce, sess.configureIAMIdentityV1API)
 	return sess.iamIdentityAPI, sess.iamIdentityErr
 }
 
 // ResourceMAanger Session
-func (sess clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+func (sess *clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+	sess.lazyConfigure(&sess.resourceManagerV2APIOnce, sess.configureResourceManagerV2API)
 	return sess.resourceManagerAPI, sess.resourceManagerErr
 }
 
-func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+func (session *clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+	session.lazyConfigure(&session.enterpriseManagementV1Once, session.configureEnterpriseManagementV1)
 	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
 }
 
 // ResourceController Session
-func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+func (sess *clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+	sess.lazyConfigure(&sess.resourceControllerV2APIOnce, sess.configureResourceControllerV2API)
 	return sess.resourceControllerAPI, sess.resourceControllerErr
 }
 
 // IBM Cloud Secrets Manager V2 Basic API
-func (session clientSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
+func (session *clientSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
+	session.lazyConfigure(&session.secretsManagerV2Once, session.configureSecretsManagerV2)
 	return session.secretsManagerClient, session.secretsManagerClientErr
 }
 
 // Satellite Link
-func (session clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+func (session *clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+	session.lazyConfigure(&session.satellitLinkClientSessionOnce, session.configureSatellitLinkClientSession)
 	return session.satelliteLinkClient, session.satelliteLinkClientErr
 }
 
 var cloudEndpoint = "cloud.ibm.com"
 
 // Session to the Satellite client
-func (sess clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+func (sess *clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+	sess.lazyConfigure(&sess.satelliteClientSessionOnce, sess.configureSatelliteClientSession)
 	return sess.satelliteClient, sess.satelliteClientErr
 }
 
 // CIS LogPushJob
-func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+func (sess *clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+	sess.lazyConfigure(&sess.cisLogpushJobsSessionOnce, sess.configureCisLogpushJobsSession)
 	if sess.cisLogpushJobsErr != nil {
 		return sess.cisLogpushJobsClient, sess.cisLogpushJobsErr
 	}
@@ -1109,7 +1382,8 @@ func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJ
 }
 
 // CIS MTLS session
-func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+func (sess *clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+	sess.lazyConfigure(&sess.cisMtlsSessionOnce, sess.configureCisMtlsSession)
 	if sess.cisMtlsErr != nil {
 		return sess.cisMtlsClient, sess.cisMtlsErr
 	}
@@ -1117,7 +1391,8 @@ func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
 }
 
 // CIS Bot Management
-func (sess clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotManagementV1, error) {
+func (sess *clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotManagementV1, error) {
+	sess.lazyConfigure(&sess.cisBotManagementSessionOnce, sess.configureCisBotManagementSession)
 	if sess.cisBotManagementErr != nil {
 		return sess.cisBotManagementClient, sess.cisBotManagementErr
 	}
@@ -1125,7 +1400,8 @@ func (sess clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotMana
 }
 
 // CIS Bot Analytics
-func (sess clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyticsV1, error) {
+func (sess *clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyticsV1, error) {
+	sess.lazyConfigure(&sess.cisBotAnalyticsSessionOnce, sess.configureCisBotAnalyticsSession)
 	if sess.cisBotAnalyticsErr != nil {
 		return sess.cisBotAnalyticsClient, sess.cisBotAnalyticsErr
 	}
@@ -1133,7 +1409,8 @@ func (sess clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyt
 }
 
 // CIS Webhooks
-func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+func (sess *clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+	sess.lazyConfigure(&sess.cisWebhookSessionOnce, sess.configureCisWebhookSession)
 	if sess.cisWebhooksErr != nil {
 		return sess.cisWebhooksClient, sess.cisWebhooksErr
 	}
@@ -1141,7 +1418,8 @@ func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error)
 }
 
 // CIS Filters
-func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+func (sess *clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+	sess.lazyConfigure(&sess.cisFiltersSessionOnce, sess.configureCisFiltersSession)
 	if sess.cisFiltersErr != nil {
 		return sess.cisFiltersClient, sess.cisFiltersErr
 	}
@@ -1149,7 +1427,8 @@ func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
 }
 
 // CIS FirewallRules
-func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+func (sess *clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+	sess.lazyConfigure(&sess.cisFirewallRulesSessionOnce, sess.configureCisFirewallRulesSession)
 	if sess.cisFirewallRulesErr != nil {
 		return sess.cisFirewallRulesClient, sess.cisFirewallRulesErr
 	}
@@ -1157,51 +1436,64 @@ func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.Firewal
 }
 
 // Activity Tracker API
-func (session clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+func (session *clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+	session.lazyConfigure(&session.atrackerV2Once, session.configureAtrackerV2)
 	return session.atrackerClientV2, session.atrackerClientV2Err
 }
 
 // Metrics Router API Version 3
-func (session clientSession) MetricsRouterV3() (*metricsrouterv3.MetricsRouterV3, error) {
+func (session *clientSession) MetricsRouterV3() (*metricsrouterv3.MetricsRouterV3, error) {
+	session.lazyConfigure(&session.metricsRouterV3Once, session.configureMetricsRouterV3)
 	return session.metricsRouterClient, session.metricsRouterClientErr
 }
 
-func (session clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
-	return session.esSchemaRegistryClient, session.esSchemaRegistryErr
+func (session *clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+	session.lazyConfigure(&session.esSchemaRegistrySessionOnce, session.configureESschemaRegistrySession)
+	if session.esSchemaRegistryErr != nil {
+		return session.esSchemaRegistryClient, session.esSchemaRegistryErr
+	}
+	return session.esSchemaRegistryClient.Clone(), nil
 }
 
 // Security and Compliance center Admin API
-func (session clientSession) SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error) {
+func (session *clientSession) SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error) {
+	session.lazyConfigure(&session.securityAndComplianceCenterV3Once, session.configureSecurityAndComplianceCenterV3)
 	return session.securityAndComplianceCenterClient, session.securityAndComplianceCenterClientErr
 }
 
 // Context Based Restrictions
-func (session clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+func (session *clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+	session.lazyConfigure(&session.contextBasedRestrictionsV1Once, session.configureContextBasedRestrictionsV1)
 	return session.contextBasedRestrictionsClient, session.contextBasedRestrictionsClientErr
 }
 
 // CD Toolchain
-func (session clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+func (session *clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+	session.lazyConfigure(&session.cdToolchainV2Once, session.configureCdToolchainV2)
 	return session.cdToolchainClient, session.cdToolchainClientErr
 }
 
 // CD Tekton Pipeline
-func (session clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+func (session *clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+	session.lazyConfigure(&session.cdTektonPipelineV2Once, session.configureCdTektonPipelineV2)
 	return session.cdTektonPipelineClient, session.cdTektonPipelineClientErr
 }
 
 // Code Engine
-func (session clientSession) CodeEngineV2() (*codeengine.CodeEngineV2, error) {
+func (session *clientSession) CodeEngineV2() (*codeengine.CodeEngineV2, error) {
+	session.lazyConfigure(&session.codeEngineV2Once, session.configureCodeEngineV2)
 	return session.codeEngineClient, session.codeEngineClientErr
 }
 
 // Projects API Specification
-func (session clientSession) ProjectV1() (*project.ProjectV1, error) {
+func (session *clientSession) ProjectV1() (*project.ProjectV1, error) {
+	session.lazyConfigure(&session.projectV1Once, session.configureProjectV1)
 	return session.projectClient, session.projectClientErr
 }
 
 // MQ on Cloud
-func (session clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
+func (session *clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
+	session.lazyConfigure(&session.mqcloudV1Once, session.configureMqcloudV1)
 	if session.mqcloudClientErr != nil {
 		sessionMqcloudClient := session.mqcloudClient
 		sessionMqcloudClient.EnableRetries(0, 0)
@@ -1212,13 +1504,51 @@ func (session clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
 
 // ClientSession configures and returns a fully initialized ClientSession
 func (c *Config) ClientSession() (interface{}, error) {
-	sess, err := newSession(c)
+	var httpTransport *gohttp.Transport
+	if c.Transport != nil {
+		transport, err := c.Transport.NewTransport()
+		if err != nil {
+			return nil, err
+		}
+		httpTransport = transport
+	}
+
+	// An iam_profile_id set with an API key, e.g. by a profile of the config file, is assumed with the API key
+	if c.AssumeTrustedProfile == nil && c.IAMTrustedProfileID != "" && c.IAMToken == "" && c.BluemixAPIKey != "" {
+		c.AssumeTrustedProfile = &TrustedProfile{ID: c.IAMTrustedProfileID}
+	}
+
+	var trustedProfileAuthenticator tokenAuthenticator
+	if c.AssumeTrustedProfile != nil {
+		profileAuthenticator, err := c.trustedProfileAuthenticator(c.endpoint("iam", c.defaultIAMURL()), httpTransport)
+		if err != nil {
+			return nil, err
+		}
+		authenticator := &sharedTokenAuthenticator{tokenAuthenticator: profileAuthenticator}
+		token, err := authenticator.GetToken()
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error assuming trusted profile %s: %s", c.AssumeTrustedProfile.Identifier(), err)
+		}
+		// The session is configured with the token of the profile, the authenticator refreshes it
+		c.IAMToken = "Bearer " + token
+		c.IAMRefreshToken = ""
+		c.IAMTrustedProfileID = c.AssumeTrustedProfile.Identifier()
+		c.BluemixAPIKey = ""
+		trustedProfileAuthenticator = authenticator
+	}
+
+	sess, err := newSession(c, httpTransport, trustedProfileAuthenticator)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
 	session := clientSession{
-		session: sess,
+		session:                     sess,
+		config:                      c,
+		tagsConfig:                  c.Tags,
+		retryPolicy:                 c.retryPolicy(),
+		httpTransport:               httpTransport,
+		trustedProfileAuthenticator: trustedProfileAuthenticator,
 	}
 
 	if sess.BluemixSession == nil {
@@ -1305,14 +1635,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.projectClientErr = errEmptyBluemixCredentials
 		session.mqcloudClientErr = errEmptyBluemixCredentials
 
-		return session, nil
+		return &session, nil
 	}
 
 	if sess.BluemixSession.Config.BluemixAPIKey != "" {
 		err = authenticateAPIKey(sess.BluemixSession)
 		if err != nil {
 			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
+				if err == nil || !isRetryable(err, session.retryPolicy) {
 					break
 				}
 				time.Sleep(c.RetryDelay)
@@ -1327,7 +1657,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		err = authenticateCF(sess.BluemixSession)
 		if err != nil {
 			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
+				if err == nil || !isRetryable(err, session.retryPolicy) {
 					break
 				}
 				time.Sleep(c.RetryDelay)
@@ -1344,7 +1674,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		err := RefreshToken(sess.BluemixSession)
 		if err != nil {
 			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
+				if err == nil || !isRetryable(err, session.retryPolicy) {
 					break
 				}
 				time.Sleep(c.RetryDelay)
@@ -1388,161 +1718,262 @@ func (c *Config) ClientSession() (interface{}, error) {
 			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
 		}
 	}
-	accv1API, err := accountv1.New(sess.BluemixSession)
+
+	iamURL := c.defaultIAMURL()
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
+	}
+
+	iamURL = session.endpoint("iam", iamURL)
+	var authenticator core.Authenticator
+
+	if trustedProfileAuthenticator != nil {
+		authenticator = trustedProfileAuthenticator
+	} else if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
+		if c.BluemixAPIKey != "" {
+			authenticator = &core.IamAuthenticator{
+				ApiKey: c.BluemixAPIKey,
+				URL:    iamURL,
+			}
+		} else {
+			// Construct the IamAuthenticator with the IAM refresh token.
+			authenticator = &core.IamAuthenticator{
+				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
+				ClientId:     "bx",
+				ClientSecret: "bx",
+				URL:          iamURL,
+			}
+		}
+	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
+		authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
+		}
+	} else {
+		authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
+		}
+	}
+
+	if os.Getenv("TF_LOG") != "" {
+		logDestination := log.Writer()
+		goLogger := log.New(logDestination, "", log.LstdFlags)
+		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
+	}
+
+	// setting UserAgent for vpc-go-sdk common
+	common.UserAgent = fmt.Sprintf("terraform-provider-ibm/%s", version.Version)
+
+	// The IAM token requests are retried with the iam retry policy
+	if iamAuthenticator, ok := authenticator.(*core.IamAuthenticator); ok {
+		iamAuthenticator.Client = &gohttp.Client{
+			Transport: NewRetryTransport(session.retryPolicy.ForService("iam"), transportOr(session.httpTransport, core.DefaultHTTPClient().Transport)),
+			Timeout:   30 * time.Second,
+		}
+	}
+
+	// Service clients are configured on first use, see lazyConfigure
+	session.fileMap = fileMap
+	session.iamURL = iamURL
+	session.authenticator = authenticator
+	return &session, nil
+}
+
+// defaultIAMURL returns the IAM endpoint of the visibility
+func (c *Config) defaultIAMURL() string {
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
+		if c.Region == "us-south" || c.Region == "us-east" {
+			return ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+		}
+		return ContructEndpoint("private.iam", cloudEndpoint)
+	}
+	return iamidentity.DefaultServiceURL
+}
+
+// lazyConfigure runs configure the first time the client it builds is requested.
+// It is a no-op when no IBM Cloud credentials were provided, in which case
+// ClientSession has already set errEmptyBluemixCredentials on every client.
+func (session *clientSession) lazyConfigure(once *sync.Once, configure func()) {
+	once.Do(func() {
+		if session.session.BluemixSession == nil {
+			return
+		}
+		configure()
+	})
+}
+
+// vpcURL returns the endpoint shared by the VPC and VPC beta clients
+func (session *clientSession) vpcURL() string {
+	return session.vpcURLForRegion(session.config.Region)
+}
+
+// vpcURLForRegion returns the VPC endpoint of region for the visibility of the provider
+func (session *clientSession) vpcURLForRegion(region string) string {
+	c := session.config
+	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", region), fmt.Sprintf("%s/v1", cloudEndpoint))
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
+		vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", region), fmt.Sprintf("%s/v1", cloudEndpoint))
+	}
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		vpcurl = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", region, vpcurl)
+	}
+	return vpcurl
+}
+
+// cisEndpoint returns the endpoint shared by all the CIS clients
+func (session *clientSession) cisEndpoint() string {
+	c := session.config
+	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		cisURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
+	}
+	return session.endpoint("cis", cisURL)
+}
+
+// Bluemix Account v1 Service
+func (session *clientSession) configureBluemixAcccountv1API() {
+	accv1API, err := accountv1.New(session.bluemixSession("account", endpoints.EndpointLocator.AccountManagementEndpoint))
 	if err != nil {
 		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
 	}
 	session.bmxAccountv1ServiceAPI = accv1API
+}
 
-	accAPI, err := accountv2.New(sess.BluemixSession)
+// Bluemix Account v2 Service
+func (session *clientSession) configureBluemixAcccountAPI() {
+	accAPI, err := accountv2.New(session.bluemixSession("account", endpoints.EndpointLocator.AccountManagementEndpoint))
 	if err != nil {
 		session.accountConfigErr = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
 	}
 	session.bmxAccountServiceAPI = accAPI
+}
 
-	cfAPI, err := mccpv2.New(sess.BluemixSession)
+// MCCP Service
+func (session *clientSession) configureMccpAPI() {
+	cfAPI, err := mccpv2.New(session.bluemixSession("mccp", endpoints.EndpointLocator.MCCPAPIEndpoint))
 	if err != nil {
 		session.cfConfigErr = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
 	}
 	session.cfServiceAPI = cfAPI
+}
 
-	clusterAPI, err := containerv1.New(sess.BluemixSession)
+// CONTAINER Service
+func (session *clientSession) configureContainerAPI() {
+	clusterAPI, err := containerv1.New(session.bluemixSession("container", endpoints.EndpointLocator.ContainerEndpoint))
 	if err != nil {
 		session.csConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
 	}
 	session.csServiceAPI = clusterAPI
+}
 
-	v2clusterAPI, err := containerv2.New(sess.BluemixSession)
+// VPC CONTAINER Service
+func (session *clientSession) configureVpcContainerAPI() {
+	v2clusterAPI, err := containerv2.New(session.bluemixSession("container", endpoints.EndpointLocator.ContainerEndpoint))
 	if err != nil {
 		session.csv2ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
 	}
 	session.csv2ServiceAPI = v2clusterAPI
+}
 
-	hpcsAPI, err := hpcs.New(sess.BluemixSession)
+// HPCS Endpoint Service
+func (session *clientSession) configureHpcsEndpointAPI() {
+	hpcsAPI, err := hpcs.New(session.bluemixSession("hpcs", endpoints.EndpointLocator.HpcsEndpoint))
 	if err != nil {
 		session.hpcsEndpointErr = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
 	}
 	session.hpcsEndpointAPI = hpcsAPI
+}
 
+// KEY PROTECT Service
+func (session *clientSession) configureKeyProtectAPI() {
+	c := session.config
 	kpurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kpurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kpurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		kpurl = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
 	}
 	var options kp.ClientConfig
 	if c.BluemixAPIKey != "" {
 		options = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
+			BaseURL: session.endpoint("kms", kpurl),
+			APIKey:  session.session.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
 			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
 			Verbose: kp.VerboseFailOnly,
 		}
 	} else {
 		options = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-			Authorization: sess.BluemixSession.Config.IAMAccessToken,
+			BaseURL:       session.endpoint("kms", kpurl),
+			Authorization: session.session.BluemixSession.Config.IAMAccessToken,
 			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
 			Verbose: kp.VerboseFailOnly,
 		}
 	}
-	kpAPIclient, err := kp.New(options, DefaultTransport())
+	kpAPIclient, err := kp.New(options, session.serviceTransport("kms"))
 	if err != nil {
 		session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 	}
 	session.kpAPI = kpAPIclient
+}
 
-	iamURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
-	}
-
-	// KEY MANAGEMENT Service
+// KEY MANAGEMENT Service
+func (session *clientSession) configureKeyManagementAPI() {
+	c := session.config
 	kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kmsurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kmsurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		kmsurl = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
 	}
 	var kmsOptions kp.ClientConfig
 	if c.BluemixAPIKey != "" {
 		kmsOptions = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
+			BaseURL: session.endpoint("kms", kmsurl),
+			APIKey:  session.session.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
 			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
 			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+			TokenURL: session.endpoint("iam", session.iamURL) + "/identity/token",
 		}
 	} else {
 		kmsOptions = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-			Authorization: sess.BluemixSession.Config.IAMAccessToken,
+			BaseURL:       session.endpoint("kms", kmsurl),
+			Authorization: session.session.BluemixSession.Config.IAMAccessToken,
 			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
 			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+			TokenURL: session.endpoint("iam", session.iamURL) + "/identity/token",
 		}
 	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
+	kmsAPIclient, err := kp.New(kmsOptions, session.serviceTransport("kms"))
 	if err != nil {
 		session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
 	}
 	session.kmsAPI = kmsAPIclient
+}
 
-	var authenticator core.Authenticator
-
-	if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
-		if c.BluemixAPIKey != "" {
-			authenticator = &core.IamAuthenticator{
-				ApiKey: c.BluemixAPIKey,
-				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		} else {
-			// Construct the IamAuthenticator with the IAM refresh token.
-			authenticator = &core.IamAuthenticator{
-				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
-				ClientId:     "bx",
-				ClientSecret: "bx",
-				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		}
-	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
-		}
-	} else {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
-		}
-	}
-
+// PROJECT Service
+func (session *clientSession) configureProjectV1() {
+	c := session.config
+	var err error
 	projectEndpoint := project.DefaultServiceURL
 	// Construct an "options" struct for creating the service client.
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		projectEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PROJECT_API_ENDPOINT", c.Region, project.DefaultServiceURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		projectEndpoint = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_PROJECT_API_ENDPOINT", c.Region, project.DefaultServiceURL)
 	}
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		session.projectClientErr = fmt.Errorf("Project Service API does not support private endpoints")
 	}
 	// Construct an "options" struct for creating the service client.
 	projectClientOptions := &project.ProjectV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PROJECT_API_ENDPOINT"}, projectEndpoint),
-		Authenticator: authenticator,
+		URL:           session.endpoint("project", projectEndpoint),
+		Authenticator: session.authenticator,
 	}
 
 	// Construct the service client.
 	session.projectClient, err = project.NewProjectV1(projectClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.projectClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.projectClient.Service, "project")
 		// Add custom header for analytics
 		session.projectClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1550,17 +1981,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.projectClientErr = fmt.Errorf("Error occurred while configuring Projects API Specification service: %q", err)
 	}
+}
 
+// HPCS UKO Service
+func (session *clientSession) configureUkoV4() {
+	var err error
 	// Construct an "options" struct for creating the service client.
 	ukoClientOptions := &ukov4.UkoV4Options{
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 
 	// Construct the service client.
 	session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
 	if err == nil {
+		// The endpoint of the instance is used unless the endpoint is set
+		if url := session.endpoint("hpcs_uko", ""); url != "" {
+			session.ukoClient.SetServiceURL(url)
+		}
 		// Enable retries for API calls
-		session.ukoClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.ukoClient.Service, "hpcs")
 		// Add custom header for analytics
 		session.ukoClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1568,31 +2007,39 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.ukoClientErr = fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
 	}
+}
 
-	// APPID Service
+// APPID Service
+func (session *clientSession) configureAppIDAPI() {
+	c := session.config
 	appIDEndpoint := fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
 	if c.Visibility == "private" {
 		session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appIDEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", c.Region, appIDEndpoint)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		appIDEndpoint = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", c.Region, appIDEndpoint)
 	}
 	appIDClientOptions := &appid.AppIDManagementV4Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT"}, appIDEndpoint),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("appid", appIDEndpoint),
 	}
 	appIDClient, err := appid.NewAppIDManagementV4(appIDClientOptions)
 	if err != nil {
 		session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
 	}
 	if appIDClient != nil && appIDClient.Service != nil {
-		appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(appIDClient.Service, "appid")
 		appIDClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.appidAPI = appIDClient
+}
 
+// CONTEXT BASED RESTRICTIONS Service
+func (session *clientSession) configureContextBasedRestrictionsV1() {
+	c := session.config
+	var err error
 	// Construct an "options" struct for creating Context Based Restrictions service client.
 	cbrURL := contextbasedrestrictionsv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -1602,19 +2049,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			cbrURL = ContructEndpoint("private.cbr", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cbrURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", c.Region, cbrURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		cbrURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", c.Region, cbrURL)
 	}
 	contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("context_based_restrictions", cbrURL),
 	}
 
 	// Construct the service client.
 	session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
 	if err == nil && session.contextBasedRestrictionsClient != nil {
 		// Enable retries for API calls
-		session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.contextBasedRestrictionsClient.Service, "context_based_restrictions")
 		// Add custom header for analytics
 		session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1622,8 +2069,11 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
 	}
+}
 
-	// // Usage Reports Service Client
+// Usage Reports Service Client
+func (session *clientSession) configureUsageReportsV4() {
+	c := session.config
 	usageReportsURL := usagereportsv4.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -1641,36 +2091,40 @@ func (c *Config) ClientSession() (interface{}, error) {
 			usageReportsURL = usagereportsv4.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		usageReportsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_USAGE_REPORTS_API_ENDPOINT", c.Region, usageReportsURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		usageReportsURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_USAGE_REPORTS_API_ENDPOINT", c.Region, usageReportsURL)
 	}
 	usageReportsClientOptions := &usagereportsv4.UsageReportsV4Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT"}, usageReportsURL),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("usage_reports", usageReportsURL),
 	}
 	usageReportsClient, err := usagereportsv4.NewUsageReportsV4(usageReportsClientOptions)
 	if err != nil {
 		session.usageReportsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Usage Reports API service: %q", err)
 	}
 	if usageReportsClient != nil && usageReportsClient.Service != nil {
-		usageReportsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(usageReportsClient.Service, "usage_reports")
 		usageReportsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.usageReportsClient = usageReportsClient
+}
 
-	// CATALOG MANAGEMENT Service
+// CATALOG MANAGEMENT Service
+func (session *clientSession) configureCatalogManagementV1() {
+	c := session.config
+	var err error
 	catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
 	if c.Visibility == "private" {
 		session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		catalogManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Region, catalogManagementURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		catalogManagementURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Region, catalogManagementURL)
 	}
 	catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
-		Authenticator: authenticator,
+		URL:           session.endpoint("catalog_management", catalogManagementURL),
+		Authenticator: session.authenticator,
 	}
 	// Construct the service client.
 	session.catalogManagementClient, err = catalogmanagementv1.NewCatalogManagementV1(catalogManagementClientOptions)
@@ -1679,14 +2133,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
 		// Enable retries for API calls
-		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.catalogManagementClient.Service, "catalog_management")
 		// Add custom header for analytics
 		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// ATRACKER Version 2
+// ATRACKER Version 2
+func (session *clientSession) configureAtrackerV2() {
+	c := session.config
+	var err error
 	var atrackerClientV2URL string
 	var atrackerURLV2Err error
 
@@ -1701,12 +2159,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if atrackerURLV2Err != nil {
 		atrackerClientV2URL = atrackerv2.DefaultServiceURL
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		atrackerClientV2URL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientV2URL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		atrackerClientV2URL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientV2URL)
 	}
 	atrackerClientV2Options := &atrackerv2.AtrackerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientV2URL),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("atracker", atrackerClientV2URL),
 	}
 	// If we provide IBMCLOUD_ATRACKER_API_ENDPOINT, then ignore any missing region url, or should use the default.
 	// This should technically never happen as we default this for v2
@@ -1716,7 +2174,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
 	if err == nil {
 		// Enable retries for API calls
-		session.atrackerClientV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.atrackerClientV2.Service, "atracker")
 		// Add custom header for analytics
 		session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1724,7 +2182,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.atrackerClientV2Err = fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
 	}
+}
 
+// METRICS ROUTER Service
+func (session *clientSession) configureMetricsRouterV3() {
+	c := session.config
+	var err error
 	// Construct an "options" struct for creating the service client for Metrics Router
 	var metricsRouterClientURL string
 	var metricsRouterURLV3Err error
@@ -1740,19 +2203,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if metricsRouterURLV3Err != nil {
 		metricsRouterClientURL = metricsrouterv3.DefaultServiceURL
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		metricsRouterClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_METRICS_ROUTING_API_ENDPOINT", c.Region, metricsRouterClientURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		metricsRouterClientURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_METRICS_ROUTING_API_ENDPOINT", c.Region, metricsRouterClientURL)
 	}
 	metricsRouterClientOptions := &metricsrouterv3.MetricsRouterV3Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_METRICS_ROUTING_API_ENDPOINT"}, metricsRouterClientURL),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("metrics_router", metricsRouterClientURL),
 	}
 
 	// Construct the service client.
 	session.metricsRouterClient, err = metricsrouterv3.NewMetricsRouterV3(metricsRouterClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.metricsRouterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.metricsRouterClient.Service, "metrics_router")
 		// Add custom header for analytics
 		session.metricsRouterClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1760,23 +2223,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.metricsRouterClientErr = fmt.Errorf("Error occurred while configuring Metrics Router API Version 3 service: %q", err)
 	}
+}
 
-	// SCC (Security and Compliance Center) Service
+// SCC (Security and Compliance Center) Service
+func (session *clientSession) configureSecurityAndComplianceCenterV3() {
+	c := session.config
+	var err error
 	sccApiClientURL := scc.DefaultServiceURL
 	// Construct the service options.
 	if regionURL, sccRegionErr := scc.GetServiceURLForRegion(c.Region); sccRegionErr == nil {
 		sccApiClientURL = regionURL
 	}
 	sccApiClientOptions := &scc.SecurityAndComplianceCenterApiV3Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCC_API_ENDPOINT"}, sccApiClientURL),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("scc", sccApiClientURL),
 	}
 
 	// Construct the service client.
 	session.securityAndComplianceCenterClient, err = scc.NewSecurityAndComplianceCenterApiV3(sccApiClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.securityAndComplianceCenterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.securityAndComplianceCenterClient.Service, "scc")
 		// Add custom header for analytics
 		session.securityAndComplianceCenterClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1784,19 +2251,22 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.securityAndComplianceCenterClientErr = fmt.Errorf("Error occurred while configuring Security And Compliance Center service: %q", err)
 	}
+}
 
-	// SCHEMATICS Service
+// SCHEMATICS Service
+func (session *clientSession) configureSchematicsV1() {
+	c := session.config
 	// schematicsEndpoint := "https://schematics.cloud.ibm.com"
 	schematicsEndpoint := ContructEndpoint(fmt.Sprintf("%s.schematics", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		schematicsEndpoint = ContructEndpoint(fmt.Sprintf("private-%s.schematics", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		schematicsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCHEMATICS_API_ENDPOINT", c.Region, schematicsEndpoint)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		schematicsEndpoint = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_SCHEMATICS_API_ENDPOINT", c.Region, schematicsEndpoint)
 	}
 	schematicsClientOptions := &schematicsv1.SchematicsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("schematics", schematicsEndpoint),
 	}
 	// Construct the service client.
 	schematicsClient, err := schematicsv1.NewSchematicsV1(schematicsClientOptions)
@@ -1805,64 +2275,67 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	// Enable retries for API calls
 	if schematicsClient != nil && schematicsClient.Service != nil {
-		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(schematicsClient.Service, "schematics")
 		schematicsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.schematicsClient = schematicsClient
+}
 
-	// VPC Service
-	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		vpcurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
-	}
+// VPC Service
+func (session *clientSession) configureVpcV1API() {
+	vpcurl := session.vpcURL()
 	vpcoptions := &vpc.VpcV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
-		Authenticator: authenticator,
+		URL:           session.endpoint("vpc", vpcurl),
+		Authenticator: session.authenticator,
 	}
 	vpcclient, err := vpc.NewVpcV1(vpcoptions)
 	if err != nil {
 		session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
 	}
 	if vpcclient != nil && vpcclient.Service != nil {
-		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(vpcclient.Service, "vpc")
 		vpcclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.vpcAPI = vpcclient
+}
 
+// VPC Beta Service
+func (session *clientSession) configureVpcV1BetaAPI() {
+	vpcurl := session.vpcURL()
 	vpcbetaoptions := &vpcbeta.VpcbetaV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
-		Authenticator: authenticator,
+		URL:           session.endpoint("vpc", vpcurl),
+		Authenticator: session.authenticator,
 	}
 	vpcbetaclient, err := vpcbeta.NewVpcbetaV1(vpcbetaoptions)
 	if err != nil {
 		session.vpcbetaErr = fmt.Errorf("[ERROR] Error occured while configuring vpc beta service: %q", err)
 	}
 	if vpcbetaclient != nil && vpcbetaclient.Service != nil {
-		vpcbetaclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(vpcbetaclient.Service, "vpc")
 		vpcbetaclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.vpcBetaAPI = vpcbetaclient
+}
 
-	// PUSH NOTIFICATIONS Service
+// PUSH NOTIFICATIONS Service
+func (session *clientSession) configurePushServiceV1() {
+	c := session.config
 	pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
 	if c.Visibility == "private" {
 		session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pnurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PUSH_API_ENDPOINT", c.Region, pnurl)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		pnurl = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_PUSH_API_ENDPOINT", c.Region, pnurl)
 	}
 	pushNotificationOptions := &pushservicev1.PushServiceV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
-		Authenticator: authenticator,
+		URL:           session.endpoint("push_notifications", pnurl),
+		Authenticator: session.authenticator,
 	}
 	pnclient, err := pushservicev1.NewPushServiceV1(pushNotificationOptions)
 	if err != nil {
@@ -1870,26 +2343,30 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if pnclient != nil && pnclient.Service != nil {
 		// Enable retries for API calls
-		pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(pnclient.Service, "push_notifications")
 		pnclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.pushServiceClient = pnclient
+}
 
-	// event notifications
+// event notifications
+func (session *clientSession) configureEventNotificationsApiV1() {
+	c := session.config
+	var err error
 	enurl := fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
 
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		enurl = fmt.Sprintf("https://private.%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
 	}
 
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", c.Region, enurl)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		enurl = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", c.Region, enurl)
 	}
 	enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, enurl),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("event_notifications", enurl),
 	}
 	// Construct the service client.
 	session.eventNotificationsApiClient, err = eventnotificationsv1.NewEventNotificationsV1(enClientOptions)
@@ -1899,35 +2376,41 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
 		// Enable retries for API calls
-		session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.eventNotificationsApiClient.Service, "event_notifications")
 		session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// APP CONFIGURATION Service
+// APP CONFIGURATION Service
+func (session *clientSession) configureAppConfigurationV1() {
+	c := session.config
 	appconfigurl := ContructEndpoint(fmt.Sprintf("%s", c.Region), fmt.Sprintf("%s.apprapp.", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		appconfigurl = ContructEndpoint(fmt.Sprintf("%s.private", c.Region), fmt.Sprintf("%s.apprapp", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APP_CONFIG_ENDPOINT", c.Region, appconfigurl)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		appconfigurl = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_APP_CONFIG_ENDPOINT", c.Region, appconfigurl)
 	}
 	appConfigurationClientOptions := &appconfigurationv1.AppConfigurationV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_ENDPOINT"}, appconfigurl),
-		Authenticator: authenticator,
+		URL:           session.endpoint("app_configuration", appconfigurl),
+		Authenticator: session.authenticator,
 	}
 
 	appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
 	if appConfigClient != nil {
 		// Enable retries for API calls
-		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(appConfigClient.Service, "app_configuration")
 		session.appConfigurationClient = appConfigClient
 	} else {
 		session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
 	}
+}
 
-	// CONTAINER REGISTRY Service
+// CONTAINER REGISTRY Service
+func (session *clientSession) configureContainerRegistryV1() {
+	c := session.config
 	// Construct an "options" struct for creating the service client.
 	containerRegistryClientURL, err := containerregistryv1.GetServiceURLForRegion(c.Region)
 	if err != nil {
@@ -1939,13 +2422,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 			containerRegistryClientURL, _ = GetPrivateServiceURLForRegion("global")
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerRegistryClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CR_API_ENDPOINT", c.Region, containerRegistryClientURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		containerRegistryClientURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_CR_API_ENDPOINT", c.Region, containerRegistryClientURL)
 	}
 	containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
-		Account:       core.StringPtr(userConfig.UserAccount),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("container_registry", containerRegistryClientURL),
+		Account:       core.StringPtr(session.bmxUserDetails.UserAccount),
 	}
 	// Construct the service client.
 	session.containerRegistryClient, err = containerregistryv1.NewContainerRegistryV1(containerRegistryClientOptions)
@@ -1954,41 +2437,53 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
 		// Enable retries for API calls
-		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.containerRegistryClient.Service, "container_registry")
 		// Add custom header for analytics
 		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// OBJECT STORAGE Service
+// OBJECT STORAGE Service
+func (session *clientSession) configureCosConfigV1API() {
+	c := session.config
 	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		cosconfigurl = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
 	}
 	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosconfigurl),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("cos_config", cosconfigurl),
 	}
 	cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
 	if err != nil {
 		session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
 	}
 	session.cosConfigAPI = cosconfigclient
+}
 
-	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
+// Global Search Bluemix-go
+func (session *clientSession) configureGlobalSearchAPI() {
+	globalSearchAPI, err := globalsearchv2.New(session.bluemixSession("global_search", endpoints.EndpointLocator.GlobalSearchEndpoint))
 	if err != nil {
 		session.globalSearchConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
 	}
 	session.globalSearchServiceAPI = globalSearchAPI
-	// Global Tagging Bluemix-go
-	globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
+}
+
+// Global Tagging Bluemix-go
+func (session *clientSession) configureGlobalTaggingAPI() {
+	globalTaggingAPI, err := globaltaggingv3.New(session.bluemixSession("global_tagging", endpoints.EndpointLocator.GlobalTaggingEndpoint))
 	if err != nil {
 		session.globalTaggingConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
 	}
 	session.globalTaggingServiceAPI = globalTaggingAPI
+}
 
-	// GLOBAL TAGGING Service
+// GLOBAL TAGGING Service
+func (session *clientSession) configureGlobalTaggingAPIv1() {
+	c := session.config
 	globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		var globalTaggingRegion string
@@ -1999,12 +2494,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 		globalTaggingEndpoint = ContructEndpoint(fmt.Sprintf("tags.private.%s", globalTaggingRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalTaggingEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GT_API_ENDPOINT", c.Region, globalTaggingEndpoint)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		globalTaggingEndpoint = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_GT_API_ENDPOINT", c.Region, globalTaggingEndpoint)
 	}
 	globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
-		Authenticator: authenticator,
+		URL:           session.endpoint("global_tagging", globalTaggingEndpoint),
+		Authenticator: session.authenticator,
 	}
 	globalTaggingAPIV1, err := globaltaggingv1.NewGlobalTaggingV1(globalTaggingV1Options)
 	if err != nil {
@@ -2012,12 +2507,16 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
 		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
-		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.globalTaggingServiceAPIV1.Service, "global_tagging")
 		session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
-	// GLOBAL TAGGING Service
+}
+
+// GLOBAL SEARCH Service
+func (session *clientSession) configureGlobalSearchAPIV2() {
+	c := session.config
 	globalSearchEndpoint := "https://api.global-search-tagging.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		var globalSearchRegion string
@@ -2028,31 +2527,39 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 		globalSearchEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s", globalSearchRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalSearchEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GS_API_ENDPOINT", c.Region, searchv2.DefaultServiceURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		globalSearchEndpoint = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_GS_API_ENDPOINT", c.Region, searchv2.DefaultServiceURL)
 	}
 	globalSearchV2Options := &searchv2.GlobalSearchV2Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_GS_API_ENDPOINT"}, globalSearchEndpoint),
-		Authenticator: authenticator,
+		URL:           session.endpoint("global_search", globalSearchEndpoint),
+		Authenticator: session.authenticator,
 	}
 	globalSearchAPIV2, err := searchv2.NewGlobalSearchV2(globalSearchV2Options)
 	if err != nil {
-		session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
+		session.globalSearchConfigErrV2 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
 	}
 	if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
 		session.globalSearchServiceAPIV2 = *globalSearchAPIV2
-		session.globalSearchServiceAPIV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.globalSearchServiceAPIV2.Service, "global_search")
 		session.globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	icdAPI, err := icdv4.New(sess.BluemixSession)
+// ICD Service
+func (session *clientSession) configureICDAPI() {
+	icdAPI, err := icdv4.New(session.bluemixSession("icd", endpoints.EndpointLocator.ICDEndpoint))
 	if err != nil {
 		session.icdConfigErr = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
 	}
 	session.icdServiceAPI = icdAPI
+}
 
+// CLOUD DATABASES Service
+func (session *clientSession) configureCloudDatabasesV5() {
+	c := session.config
+	var err error
 	var cloudDatabasesEndpoint string
 
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -2063,15 +2570,15 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	// Construct an "options" struct for creating the service client.
 	cloudDatabasesClientOptions := &clouddatabasesv5.CloudDatabasesV5Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DATABASES_API_ENDPOINT"}, cloudDatabasesEndpoint),
-		Authenticator: authenticator,
+		URL:           session.endpoint("cloud_databases", cloudDatabasesEndpoint),
+		Authenticator: session.authenticator,
 	}
 
 	// Construct the service client.
 	session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cloudDatabasesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cloudDatabasesClient.Service, "cloud_databases")
 		// Add custom header for analytics
 		session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2079,53 +2586,74 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
 	}
+}
 
-	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
+// RESOURCE CATALOG Service
+func (session *clientSession) configureResourceCatalogAPI() {
+	resourceCatalogAPI, err := catalog.New(session.bluemixSession("resource_catalog", endpoints.EndpointLocator.ResourceCatalogEndpoint))
 	if err != nil {
 		session.resourceCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
 	}
 	session.resourceCatalogServiceAPI = resourceCatalogAPI
+}
 
-	resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
+// RESOURCE MANAGEMENT v2 Service
+func (session *clientSession) configureResourceManagementAPIv2() {
+	resourceManagementAPIv2, err := managementv2.New(session.bluemixSession("resource_manager", endpoints.EndpointLocator.ResourceManagementEndpoint))
 	if err != nil {
 		session.resourceManagementConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
 	}
 	session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+}
 
-	resourceControllerAPI, err := controller.New(sess.BluemixSession)
+// RESOURCE CONTROLLER v1 Service
+func (session *clientSession) configureResourceControllerAPI() {
+	resourceControllerAPI, err := controller.New(session.bluemixSession("resource_controller", endpoints.EndpointLocator.ResourceControllerEndpoint))
 	if err != nil {
 		session.resourceControllerConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	session.resourceControllerServiceAPI = resourceControllerAPI
+}
 
-	ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
+// RESOURCE CONTROLLER v2 Service
+func (session *clientSession) configureResourceControllerAPIV2() {
+	ResourceControllerAPIv2, err := controllerv2.New(session.bluemixSession("resource_controller", endpoints.EndpointLocator.ResourceControllerEndpoint))
 	if err != nil {
 		session.resourceControllerConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
 	}
 	session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+}
 
-	userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
+// USER MANAGEMENT Service
+func (session *clientSession) configureUserManagementAPI() {
+	userManagementAPI, err := usermanagementv2.New(session.bluemixSession("user_management", endpoints.EndpointLocator.UserManagementEndpoint))
 	if err != nil {
 		session.userManagementErr = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
 	}
 	session.userManagementAPI = userManagementAPI
+}
 
-	namespaceFunction, err := functions.New(sess.BluemixSession)
+// FUNCTIONS NAMESPACE Service
+func (session *clientSession) configureFunctionIAMNamespaceAPI() {
+	namespaceFunction, err := functions.New(session.bluemixSession("functions", endpoints.EndpointLocator.FunctionsEndpoint))
 	if err != nil {
 		session.functionIAMNamespaceErr = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
 	}
 	session.functionIAMNamespaceAPI = namespaceFunction
+}
 
-	//  API GATEWAY service
+// API GATEWAY service
+func (session *clientSession) configureAPIGateway() {
+	c := session.config
 	apicurl := ContructEndpoint(fmt.Sprintf("api.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		apicurl = ContructEndpoint(fmt.Sprintf("api.private.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		apicurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_API_GATEWAY_ENDPOINT", c.Region, apicurl)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		apicurl = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_API_GATEWAY_ENDPOINT", c.Region, apicurl)
 	}
 	APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_API_GATEWAY_ENDPOINT"}, apicurl),
+		URL:           session.endpoint("api_gateway", apicurl),
 		Authenticator: &core.NoAuthAuthenticator{},
 	}
 	apigatewayAPI, err := apigateway.NewApiGatewayControllerApiV1(APIGatewayControllerAPIV1Options)
@@ -2133,58 +2661,102 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.apigatewayErr = fmt.Errorf("[ERROR] Error occured while configuring  APIGateway service: %q", err)
 	}
 	session.apigatewayAPI = apigatewayAPI
+}
 
-	// POWER SYSTEMS Service
+// POWER SYSTEMS Service
+func (session *clientSession) configureIBMPISession() {
+	c := session.config
 	piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
 	ibmPIOptions := &ibmpisession.IBMPIOptions{
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 		Debug:         os.Getenv("TF_LOG") != "",
 		Region:        c.Region,
-		URL:           EnvFallBack([]string{"IBMCLOUD_PI_API_ENDPOINT"}, piURL),
-		UserAccount:   userConfig.UserAccount,
+		URL:           session.endpoint("power", piURL),
+		UserAccount:   session.bmxUserDetails.UserAccount,
 		Zone:          c.Zone,
 	}
 	ibmpisession, err := ibmpisession.NewIBMPISession(ibmPIOptions)
 	if err != nil {
 		session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
+	} else {
+		session.enablePIRetries(ibmpisession)
 	}
 	session.ibmpiSession = ibmpisession
+}
+
+// piURLForZone returns the Power endpoint of the region of zone. The power endpoint override of
+// the provider, e.g. a private endpoint, is used with the provider region replaced by the
+// region of zone.
+func (session *clientSession) piURLForZone(zone string) string {
+	region := piRegionFromZone(zone)
+	piURL := session.config.endpoint("power", "")
+	if piURL == "" {
+		return ContructEndpoint(region, "power-iaas.cloud.ibm.com")
+	}
+	if c := session.config; c.Region != "" && c.Region != region {
+		piURL = strings.Replace(piURL, "."+c.Region+".", "."+region+".", 1)
+		piURL = strings.Replace(piURL, "://"+c.Region+".", "://"+region+".", 1)
+	}
+	return piURL
+}
+
+// piRegionFromZone returns the region of a Power zone or datacenter, e.g. dal of dal12 and
+// us-south of us-south-1, as the Power client does
+func piRegionFromZone(zone string) string {
+	if strings.Contains(zone, "-") {
+		return strings.TrimRight(strings.TrimRight(zone, "0123456789"), "-")
+	}
+	return strings.TrimRight(zone, "0123456789")
+}
 
-	// PRIVATE DNS Service
+// enablePIRetries sends the requests of the Power session through the provider HTTP settings,
+// retrying them with the retry policy of the power service
+func (session *clientSession) enablePIRetries(piSession *ibmpisession.IBMPISession) {
+	if runtime, ok := piSession.Power.Transport.(*httptransport.Runtime); ok {
+		runtime.Transport = NewRetryTransport(session.retryPolicy.ForService("power"), transportOr(session.httpTransport, runtime.Transport))
+	}
+}
+
+// PRIVATE DNS Service
+func (session *clientSession) configurePrivateDNSClientSession() {
+	c := session.config
 	pdnsURL := dns.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		pdnsURL = ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pdnsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Region, pdnsURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		pdnsURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Region, pdnsURL)
 	}
 	dnsOptions := &dns.DnsSvcsV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
-		Authenticator: authenticator,
+		URL:           session.endpoint("dns_services", pdnsURL),
+		Authenticator: session.authenticator,
 	}
 	session.pDNSClient, session.pDNSErr = dns.NewDnsSvcsV1(dnsOptions)
 	if session.pDNSErr != nil {
 		session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
 	}
 	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
-		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.pDNSClient.Service, "dns_services")
 		session.pDNSClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// DIRECT LINK Service
+// DIRECT LINK Service
+func (session *clientSession) configureDirectlinkV1API() {
+	c := session.config
 	ver := time.Now().Format("2006-01-02")
 	dlURL := dl.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		dlURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_API_ENDPOINT", c.Region, dlURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		dlURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_DL_API_ENDPOINT", c.Region, dlURL)
 	}
 	directlinkOptions := &dl.DirectLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
-		Authenticator: authenticator,
+		URL:           session.endpoint("direct_link", dlURL),
+		Authenticator: session.authenticator,
 		Version:       &ver,
 	}
 	session.directlinkAPI, session.directlinkErr = dl.NewDirectLinkV1(directlinkOptions)
@@ -2192,23 +2764,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
 	}
 	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
-		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.directlinkAPI.Service, "direct_link")
 		session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// DIRECT LINK PROVIDER Service
+// DIRECT LINK PROVIDER Service
+func (session *clientSession) configureDirectlinkProviderV2API() {
+	c := session.config
+	ver := time.Now().Format("2006-01-02")
 	dlproviderURL := dlProviderV2.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		dlproviderURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlproviderURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Region, dlproviderURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		dlproviderURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Region, dlproviderURL)
 	}
 	directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
-		Authenticator: authenticator,
+		URL:           session.endpoint("direct_link_provider", dlproviderURL),
+		Authenticator: session.authenticator,
 		Version:       &ver,
 	}
 	session.dlProviderAPI, session.dlProviderErr = dlProviderV2.NewDirectLinkProviderV2(directLinkProviderV2Options)
@@ -2216,23 +2792,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
 	}
 	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
-		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.dlProviderAPI.Service, "direct_link")
 		session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// TRANSIT GATEWAY Service
+// TRANSIT GATEWAY Service
+func (session *clientSession) configureTransitGatewayV1API() {
+	c := session.config
 	tgURL := tg.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		tgURL = ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		tgURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TG_API_ENDPOINT", c.Region, tgURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		tgURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_TG_API_ENDPOINT", c.Region, tgURL)
 	}
 	transitgatewayOptions := &tg.TransitGatewayApisV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
-		Authenticator: authenticator,
+		URL:           session.endpoint("transit_gateway", tgURL),
+		Authenticator: session.authenticator,
 		Version:       CreateVersionDate(),
 	}
 	session.transitgatewayAPI, session.transitgatewayErr = tg.NewTransitGatewayApisV1(transitgatewayOptions)
@@ -2240,52 +2819,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
 	}
 	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
-		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.transitgatewayAPI.Service, "transit_gateway")
 		// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
 		// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		// })
 	}
+}
 
-	// CIS Service instances starts here.
-	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
-	if c.Visibility == "private" {
-		// cisURL = ContructEndpoint("api.private.cis", cloudEndpoint)
-		session.cisZonesErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDNSBulkErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBPoolErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBHealthCheckErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisIPErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisPageRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisEdgeFunctionErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisSSLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFPackageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDomainSettingsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRoutingErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFGroupErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCacheErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCustomPageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisAccessRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisUARuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisLockdownErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRangeAppErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWebhooksErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisMtlsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
-	}
-	cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
-
-	// IBM Network CIS Zones service
+// IBM Network CIS Zones service
+func (session *clientSession) configureCisZonesV1ClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisZonesV1Client, session.cisZonesErr = ciszonesv1.NewZonesV1(cisZonesV1Opt)
 	if session.cisZonesErr != nil {
@@ -2294,36 +2841,42 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisZonesErr)
 	}
 	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
-		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisZonesV1Client.Service, "cis")
 		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS DNS Record service
+// IBM Network CIS DNS Record service
+func (session *clientSession) configureCisDNSRecordClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisDNSRecordsClient, session.cisDNSErr = cisdnsrecordsv1.NewDnsRecordsV1(cisDNSRecordsOpt)
 	if session.cisDNSErr != nil {
 		session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
 	}
 	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
-		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisDNSRecordsClient.Service, "cis")
 		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS DNS Record bulk service
+// IBM Network CIS DNS Record bulk service
+func (session *clientSession) configureCisDNSRecordBulkClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisDNSRecordBulkClient, session.cisDNSBulkErr = cisdnsbulkv1.NewDnsRecordBulkV1(cisDNSRecordBulkOpt)
 	if session.cisDNSBulkErr != nil {
@@ -2332,17 +2885,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisDNSBulkErr)
 	}
 	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
-		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisDNSRecordBulkClient.Service, "cis")
 		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Global load balancer pool
+// IBM Network CIS Global load balancer pool
+func (session *clientSession) configureCisGLBPoolClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisGLBPoolClient, session.cisGLBPoolErr = cisglbpoolv0.NewGlobalLoadBalancerPoolsV0(cisGLBPoolOpt)
 	if session.cisGLBPoolErr != nil {
@@ -2350,16 +2906,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisGLBPoolErr)
 	}
 	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
-		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisGLBPoolClient.Service, "cis")
 		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Global load balancer
+// IBM Network CIS Global load balancer
+func (session *clientSession) configureCisGLBClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
 		URL:            cisEndPoint,
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
 	}
@@ -2369,17 +2928,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisGLBErr)
 	}
 	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
-		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisGLBClient.Service, "cis")
 		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Global load balancer health check/monitor
+// IBM Network CIS Global load balancer health check/monitor
+func (session *clientSession) configureCisGLBHealthCheckClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisGLBHealthCheckClient, session.cisGLBHealthCheckErr = cisglbhealthcheckv1.NewGlobalLoadBalancerMonitorV1(cisGLBHealthCheckOpt)
 	if session.cisGLBHealthCheckErr != nil {
@@ -2387,16 +2949,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisGLBHealthCheckErr)
 	}
 	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
-		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisGLBHealthCheckClient.Service, "cis")
 		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS IP
+// IBM Network CIS IP
+func (session *clientSession) configureCisIPClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisIPOpt := &cisipv1.CisIpApiV1Options{
 		URL:           cisEndPoint,
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisIPClient, session.cisIPErr = cisipv1.NewCisIpApiV1(cisIPOpt)
 	if session.cisIPErr != nil {
@@ -2404,18 +2969,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisIPErr)
 	}
 	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
-		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisIPClient.Service, "cis")
 		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Zone Rate Limit
+// IBM Network CIS Zone Rate Limit
+func (session *clientSession) configureCisRLClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisRLClient, session.cisRLErr = cisratelimitv1.NewZoneRateLimitsV1(cisRLOpt)
 	if session.cisRLErr != nil {
@@ -2424,16 +2992,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRLErr)
 	}
 	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
-		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisRLClient.Service, "cis")
 		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
-	// IBM Network CIS Alerts
+}
+
+// IBM Network CIS Alerts
+func (session *clientSession) configureCisAlertsSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisAlertsOpt := &cisalertsv1.AlertsV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisAlertsClient, session.cisAlertsErr = cisalertsv1.NewAlertsV1(cisAlertsOpt)
 	if session.cisAlertsErr != nil {
@@ -2441,18 +3013,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisAlertsErr)
 	}
 	if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
-		session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisAlertsClient.Service, "cis")
 		session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Page Rules
+// IBM Network CIS Page Rules
+func (session *clientSession) configureCisPageRuleClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
 		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisPageRuleClient, session.cisPageRuleErr = cispagerulev1.NewPageRuleApiV1(cisPageRuleOpt)
 	if session.cisPageRuleErr != nil {
@@ -2461,18 +3036,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisPageRuleErr)
 	}
 	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
-		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisPageRuleClient.Service, "cis")
 		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Edge Function
+// IBM Network CIS Edge Function
+func (session *clientSession) configureCisEdgeFunctionClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisEdgeFunctionClient, session.cisEdgeFunctionErr = cisedgefunctionv1.NewEdgeFunctionsApiV1(cisEdgeFunctionOpt)
 	if session.cisEdgeFunctionErr != nil {
@@ -2480,18 +3058,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisEdgeFunctionErr)
 	}
 	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
-		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisEdgeFunctionClient.Service, "cis")
 		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS SSL certificate
+// IBM Network CIS SSL certificate
+func (session *clientSession) configureCisSSLClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 
 	session.cisSSLClient, session.cisSSLErr = cissslv1.NewSslCertificateApiV1(cisSSLOpt)
@@ -2500,18 +3081,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisSSLErr)
 	}
 	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
-		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisSSLClient.Service, "cis")
 		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS WAF Package
+// IBM Network CIS WAF Package
+func (session *clientSession) configureCisWAFPackageClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
 		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisWAFPackageClient, session.cisWAFPackageErr = ciswafpackagev1.NewWafRulePackagesApiV1(cisWAFPackageOpt)
 	if session.cisWAFPackageErr != nil {
@@ -2519,18 +3103,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFPackageErr)
 	}
 	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
-		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisWAFPackageClient.Service, "cis")
 		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Domain settings
+// IBM Network CIS Domain settings
+func (session *clientSession) configureCisDomainSettingsClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisDomainSettingsClient, session.cisDomainSettingsErr = cisdomainsettingsv1.NewZonesSettingsV1(cisDomainSettingsOpt)
 	if session.cisDomainSettingsErr != nil {
@@ -2538,18 +3125,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisDomainSettingsErr)
 	}
 	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
-		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisDomainSettingsClient.Service, "cis")
 		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Routing
+// IBM Network CIS Routing
+func (session *clientSession) configureCisRoutingClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisRoutingOpt := &cisroutingv1.RoutingV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisRoutingClient, session.cisRoutingErr = cisroutingv1.NewRoutingV1(cisRoutingOpt)
 	if session.cisRoutingErr != nil {
@@ -2557,18 +3147,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRoutingErr)
 	}
 	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
-		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisRoutingClient.Service, "cis")
 		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS WAF Group
+// IBM Network CIS WAF Group
+func (session *clientSession) configureCisWAFGroupClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
 		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisWAFGroupClient, session.cisWAFGroupErr = ciswafgroupv1.NewWafRuleGroupsApiV1(cisWAFGroupOpt)
 	if session.cisWAFGroupErr != nil {
@@ -2576,18 +3169,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFGroupErr)
 	}
 	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
-		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisWAFGroupClient.Service, "cis")
 		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Cache service
+// IBM Network CIS Cache service
+func (session *clientSession) configureCisCacheClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisCacheOpt := &ciscachev1.CachingApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
 		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisCacheClient, session.cisCacheErr = ciscachev1.NewCachingApiV1(cisCacheOpt)
 	if session.cisCacheErr != nil {
@@ -2595,18 +3191,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisCacheErr)
 	}
 	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
-		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisCacheClient.Service, "cis")
 		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Custom pages service
+// IBM Network CIS Custom pages service
+func (session *clientSession) configureCisCustomPageClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 
 	session.cisCustomPageClient, session.cisCustomPageErr = ciscustompagev1.NewCustomPagesV1(cisCustomPageOpt)
@@ -2615,18 +3214,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisCustomPageErr)
 	}
 	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
-		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisCustomPageClient.Service, "cis")
 		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Firewall Access rule
+// IBM Network CIS Firewall Access rule
+func (session *clientSession) configureCisAccessRuleClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisAccessRuleClient, session.cisAccessRuleErr = cisaccessrulev1.NewZoneFirewallAccessRulesV1(cisAccessRuleOpt)
 	if session.cisAccessRuleErr != nil {
@@ -2634,18 +3236,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisAccessRuleErr)
 	}
 	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
-		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisAccessRuleClient.Service, "cis")
 		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Firewall User Agent Blocking rule
+// IBM Network CIS Firewall User Agent Blocking rule
+func (session *clientSession) configureCisUARuleClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisUARuleClient, session.cisUARuleErr = cisuarulev1.NewUserAgentBlockingRulesV1(cisUARuleOpt)
 	if session.cisUARuleErr != nil {
@@ -2653,18 +3258,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisUARuleErr)
 	}
 	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
-		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisUARuleClient.Service, "cis")
 		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Firewall Lockdown rule
+// IBM Network CIS Firewall Lockdown rule
+func (session *clientSession) configureCisLockdownClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisLockdownClient, session.cisLockdownErr = cislockdownv1.NewZoneLockdownV1(cisLockdownOpt)
 	if session.cisLockdownErr != nil {
@@ -2672,18 +3280,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisLockdownErr)
 	}
 	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
-		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisLockdownClient.Service, "cis")
 		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Range Application rule
+// IBM Network CIS Range Application rule
+func (session *clientSession) configureCisRangeAppClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisRangeAppClient, session.cisRangeAppErr = cisrangeappv1.NewRangeApplicationsV1(cisRangeAppOpt)
 	if session.cisRangeAppErr != nil {
@@ -2691,18 +3302,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRangeAppErr)
 	}
 	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
-		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisRangeAppClient.Service, "cis")
 		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS WAF Rule Service
+// IBM Network CIS WAF Rule Service
+func (session *clientSession) configureCisWAFRuleClientSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
 		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisWAFRuleClient, session.cisWAFRuleErr = ciswafrulev1.NewWafRulesApiV1(cisWAFRuleOpt)
 	if session.cisWAFRuleErr != nil {
@@ -2711,19 +3325,22 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFRuleErr)
 	}
 	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
-		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisWAFRuleClient.Service, "cis")
 		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS LogpushJobs
+// IBM Network CIS LogpushJobs
+func (session *clientSession) configureCisLogpushJobsSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisLogpushJobOpt := &cislogpushjobsapiv1.LogpushJobsApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
 		ZoneID:        core.StringPtr(""),
 		Dataset:       core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisLogpushJobsClient, session.cisLogpushJobsErr = cislogpushjobsapiv1.NewLogpushJobsApiV1(cisLogpushJobOpt)
 	if session.cisLogpushJobsErr != nil {
@@ -2731,17 +3348,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisLogpushJobsErr)
 	}
 	if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
-		session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisLogpushJobsClient.Service, "cis")
 		session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM MTLS Session
+// IBM MTLS Session
+func (session *clientSession) configureCisMtlsSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisMtlsOpt := &cismtlsv1.MtlsV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisMtlsClient, session.cisMtlsErr = cismtlsv1.NewMtlsV1(cisMtlsOpt)
 	if session.cisMtlsErr != nil {
@@ -2749,18 +3369,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisMtlsErr)
 	}
 	if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
-		session.cisMtlsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisMtlsClient.Service, "cis")
 		session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Bot Management
+// IBM Bot Management
+func (session *clientSession) configureCisBotManagementSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisBotManagementOpt := &cisbotmanagementv1.BotManagementV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisBotManagementClient, session.cisBotManagementErr = cisbotmanagementv1.NewBotManagementV1(cisBotManagementOpt)
 	if session.cisBotManagementErr != nil {
@@ -2768,18 +3391,21 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisBotManagementErr)
 	}
 	if session.cisBotManagementClient != nil && session.cisBotManagementClient.Service != nil {
-		session.cisBotManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisBotManagementClient.Service, "cis")
 		session.cisBotManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Bot Analytics
+// IBM Bot Analytics
+func (session *clientSession) configureCisBotAnalyticsSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisBotAnalyticsOpt := &cisbotanalyticsv1.BotAnalyticsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 	}
 	session.cisBotAnalyticsClient, session.cisBotAnalyticsErr = cisbotanalyticsv1.NewBotAnalyticsV1(cisBotAnalyticsOpt)
 	if session.cisBotAnalyticsErr != nil {
@@ -2787,17 +3413,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisBotAnalyticsErr)
 	}
 	if session.cisBotAnalyticsClient != nil && session.cisBotAnalyticsClient.Service != nil {
-		session.cisBotAnalyticsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisBotAnalyticsClient.Service, "cis")
 		session.cisBotAnalyticsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Webhooks
+// IBM Network CIS Webhooks
+func (session *clientSession) configureCisWebhookSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisWebhooksOpt := &ciswebhooksv1.WebhooksV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisWebhooksClient, session.cisWebhooksErr = ciswebhooksv1.NewWebhooksV1(cisWebhooksOpt)
 	if session.cisWebhooksErr != nil {
@@ -2805,15 +3434,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWebhooksErr)
 	}
 	if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
-		session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisWebhooksClient.Service, "cis")
 		session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
-	// IBM Network CIS Filters
+}
+
+// IBM Network CIS Filters
+func (session *clientSession) configureCisFiltersSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
 		URL:           cisEndPoint,
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisFiltersClient, session.cisFiltersErr = cisfiltersv1.NewFiltersV1(cisFiltersOpt)
 	if session.cisFiltersErr != nil {
@@ -2821,16 +3454,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisFiltersErr)
 	}
 	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
-		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisFiltersClient.Service, "cis")
 		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Firewall rules
+// IBM Network CIS Firewall rules
+func (session *clientSession) configureCisFirewallRulesSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisFirewallrulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
 		URL:           cisEndPoint,
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.cisFirewallRulesClient, session.cisFirewallRulesErr = cisfirewallrulesv1.NewFirewallRulesV1(cisFirewallrulesOpt)
 	if session.cisFirewallRulesErr != nil {
@@ -2838,16 +3474,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisFirewallRulesErr)
 	}
 	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
-		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisFirewallRulesClient.Service, "cis")
 		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Authenticated Origin Pull
+// IBM Network CIS Authenticated Origin Pull
+func (session *clientSession) configureCisOrigAuthSession() {
+	cisEndPoint := session.cisEndpoint()
 	cisOriginAuthOptions := &cisoriginpull.AuthenticatedOriginPullApiV1Options{
 		URL:            cisEndPoint,
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 		Crn:            core.StringPtr(""),
 		ZoneIdentifier: core.StringPtr(""),
 	}
@@ -2859,13 +3498,16 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisOriginAuthPullErr)
 	}
 	if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
-		session.cisOriginAuthClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisOriginAuthClient.Service, "cis")
 		session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IAM IDENTITY Service
+// IAM IDENTITY Service
+func (session *clientSession) configureIAMIdentityV1API() {
+	c := session.config
 	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
 	iamIdenityURL := iamidentity.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -2875,26 +3517,29 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamIdenityURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamIdenityURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamIdenityURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		iamIdenityURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamIdenityURL)
 	}
 	iamIdentityOptions := &iamidentity.IamIdentityV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamIdenityURL),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("iam", iamIdenityURL),
 	}
 	iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
 	if err != nil {
 		session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
 	}
 	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
-		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(iamIdentityClient.Service, "iam_identity")
 		iamIdentityClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamIdentityAPI = iamIdentityClient
+}
 
-	// IAM POLICY MANAGEMENT Service
+// IAM POLICY MANAGEMENT Service
+func (session *clientSession) configureIAMPolicyManagementV1API() {
+	c := session.config
 	iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2903,26 +3548,29 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamPolicyManagementURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamPolicyManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamPolicyManagementURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		iamPolicyManagementURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamPolicyManagementURL)
 	}
 	iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("iam", iamPolicyManagementURL),
 	}
 	iamPolicyManagementClient, err := iampolicymanagement.NewIamPolicyManagementV1(iamPolicyManagementOptions)
 	if err != nil {
 		session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
 	}
 	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
-		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(iamPolicyManagementClient.Service, "iam_policy_management")
 		iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamPolicyManagementAPI = iamPolicyManagementClient
+}
 
-	// IAM ACCESS GROUP
+// IAM ACCESS GROUP
+func (session *clientSession) configureIAMAccessGroupsV2() {
+	c := session.config
 	iamAccessGroupsURL := iamaccessgroups.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2931,26 +3579,29 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamAccessGroupsURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamAccessGroupsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamAccessGroupsURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		iamAccessGroupsURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamAccessGroupsURL)
 	}
 	iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamAccessGroupsURL),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("iam", iamAccessGroupsURL),
 	}
 	iamAccessGroupsClient, err := iamaccessgroups.NewIamAccessGroupsV2(iamAccessGroupsOptions)
 	if err != nil {
 		session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
 	}
 	if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
-		iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(iamAccessGroupsClient.Service, "iam_access_groups")
 		iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamAccessGroupsAPI = iamAccessGroupsClient
+}
 
-	// RESOURCE MANAGEMENT Service
+// RESOURCE MANAGEMENT Service
+func (session *clientSession) configureResourceManagerV2API() {
+	c := session.config
 	rmURL := resourcemanager.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2967,46 +3618,53 @@ func (c *Config) ClientSession() (interface{}, error) {
 			rmURL = resourcemanager.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rmURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", c.Region, rmURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		rmURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", c.Region, rmURL)
 	}
 	resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("resource_manager", rmURL),
 	}
 	resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
 	if err != nil {
 		session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
 	}
 	if resourceManagerClient != nil && resourceManagerClient.Service != nil {
-		resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(resourceManagerClient.Service, "resource_manager")
 		resourceManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.resourceManagerAPI = resourceManagerClient
+}
 
-	// CLOUD SHELL Service
+// CLOUD SHELL Service
+func (session *clientSession) configureIBMCloudShellV1() {
+	c := session.config
+	var err error
 	cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		cloudShellUrl = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
 	}
 	ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT"}, cloudShellUrl),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("cloud_shell", cloudShellUrl),
 	}
 	session.ibmCloudShellClient, err = ibmcloudshellv1.NewIBMCloudShellV1(ibmCloudShellClientOptions)
 	if err != nil {
 		session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
 	}
 	if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
-		session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.ibmCloudShellClient.Service, "cloud_shell")
 		session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// ENTERPRISE Service
+// ENTERPRISE Service
+func (session *clientSession) configureEnterpriseManagementV1() {
+	c := session.config
 	enterpriseURL := enterprisemanagementv1.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
@@ -3024,26 +3682,29 @@ func (c *Config) ClientSession() (interface{}, error) {
 			enterpriseURL = enterprisemanagementv1.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enterpriseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ENTERPRISE_API_ENDPOINT", c.Region, enterpriseURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		enterpriseURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_ENTERPRISE_API_ENDPOINT", c.Region, enterpriseURL)
 	}
 	enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("enterprise", enterpriseURL),
 	}
 	enterpriseManagementClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(enterpriseManagementClientOptions)
 	if err != nil {
 		session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
 	}
 	if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
-		enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(enterpriseManagementClient.Service, "enterprise")
 		enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.enterpriseManagementClient = enterpriseManagementClient
+}
 
-	// RESOURCE CONTROLLER Service
+// RESOURCE CONTROLLER Service
+func (session *clientSession) configureResourceControllerV2API() {
+	c := session.config
 	rcURL := resourcecontroller.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -3060,26 +3721,30 @@ func (c *Config) ClientSession() (interface{}, error) {
 			rcURL = resourcecontroller.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rcURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", c.Region, rcURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		rcURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", c.Region, rcURL)
 	}
 	resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("resource_controller", rcURL),
 	}
 	resourceControllerClient, err := resourcecontroller.NewResourceControllerV2(resourceControllerOptions)
 	if err != nil {
 		session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	if resourceControllerClient != nil && resourceControllerClient.Service != nil {
-		resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(resourceControllerClient.Service, "resource_controller")
 		resourceControllerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.resourceControllerAPI = resourceControllerClient
+}
 
-	// SECRETS MANAGER Service V2
+// SECRETS MANAGER Service V2
+func (session *clientSession) configureSecretsManagerV2() {
+	c := session.config
+	var err error
 	// Construct an "options" struct for creating the service client.
 	var smBaseUrl string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -3089,15 +3754,15 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 
 	secretsManagerClientOptionsV2 := &secretsmanagerv2.SecretsManagerV2Options{
-		Authenticator: authenticator,
-		URL:           smBaseUrl,
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("secrets_manager", smBaseUrl),
 	}
 
 	// Construct the service client.
 	session.secretsManagerClient, err = secretsmanagerv2.NewSecretsManagerV2UsingExternalConfig(secretsManagerClientOptionsV2)
 	if err == nil {
 		// Enable retries for API calls
-		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.secretsManagerClient.Service, "secrets_manager")
 		// Add custom header for analytics
 		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3105,18 +3770,22 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.secretsManagerClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Secrets Manager Basic API service: %q", err)
 	}
+}
 
-	// SATELLITE Service
+// SATELLITE Service
+func (session *clientSession) configureSatelliteClientSession() {
+	c := session.config
+	var err error
 	containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		containerEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_API_ENDPOINT", c.Region, containerEndpoint)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		containerEndpoint = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_SATELLITE_API_ENDPOINT", c.Region, containerEndpoint)
 	}
 	kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
-		Authenticator: authenticator,
+		URL:           session.endpoint("satellite", containerEndpoint),
+		Authenticator: session.authenticator,
 	}
 	session.satelliteClient, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(kubernetesServiceV1Options)
 	if err != nil {
@@ -3125,24 +3794,28 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	// Enable retries for API calls
 	if session.satelliteClient != nil && session.satelliteClient.Service != nil {
-		session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.satelliteClient.Service, "satellite")
 		session.satelliteClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// SATELLITE LINK Service
+// SATELLITE LINK Service
+func (session *clientSession) configureSatellitLinkClientSession() {
+	c := session.config
+	var err error
 	// Construct an "options" struct for creating the service client.
 	satelliteLinkEndpoint := satellitelinkv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		satelliteLinkEndpoint = ContructEndpoint("private.api.link.satellite", cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		satelliteLinkEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Region, satelliteLinkEndpoint)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		satelliteLinkEndpoint = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Region, satelliteLinkEndpoint)
 	}
 	satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkEndpoint),
-		Authenticator: authenticator,
+		URL:           session.endpoint("satellite_link", satelliteLinkEndpoint),
+		Authenticator: session.authenticator,
 	}
 	session.satelliteLinkClient, err = satellitelinkv1.NewSatelliteLinkV1(satelliteLinkClientOptions)
 	if err != nil {
@@ -3150,27 +3823,40 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
 		// Enable retries for API calls
-		session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.satelliteLinkClient.Service, "satellite")
 		// Add custom header for analytics
 		session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+// EVENT STREAMS SCHEMA REGISTRY Service
+func (session *clientSession) configureESschemaRegistrySession() {
+	var err error
 	esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
-		Authenticator: authenticator,
+		Authenticator: session.authenticator,
 	}
 	session.esSchemaRegistryClient, err = schemaregistryv1.NewSchemaregistryV1(esSchemaRegistryV1Options)
 	if err != nil {
 		session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
 	}
 	if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
-		session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		// The kafka_http_url of the instance is used unless the endpoint is set
+		if url := session.endpoint("event_streams_schema_registry", ""); url != "" {
+			session.esSchemaRegistryClient.SetServiceURL(url)
+		}
+		session.enableRetries(session.esSchemaRegistryClient.Service, "event_streams")
 		session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+// CD TOOLCHAIN Service
+func (session *clientSession) configureCdToolchainV2() {
+	c := session.config
+	var err error
 	// Construct an "options" struct for creating the service client.
 	var cdToolchainClientURL string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -3184,19 +3870,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		cdToolchainClientURL = cdtoolchainv2.DefaultServiceURL
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdToolchainClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TOOLCHAIN_ENDPOINT", c.Region, cdToolchainClientURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		cdToolchainClientURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_TOOLCHAIN_ENDPOINT", c.Region, cdToolchainClientURL)
 	}
 	cdToolchainClientOptions := &cdtoolchainv2.CdToolchainV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_TOOLCHAIN_ENDPOINT"}, cdToolchainClientURL),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("cd_toolchain", cdToolchainClientURL),
 	}
 
 	// Construct the service client.
 	session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cdToolchainClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cdToolchainClient.Service, "cd_toolchain")
 		// Add custom header for analytics
 		session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3204,7 +3890,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
 	}
+}
 
+// CD TEKTON PIPELINE Service
+func (session *clientSession) configureCdTektonPipelineV2() {
+	c := session.config
+	var err error
 	// Construct an "options" struct for creating the tekton pipeline service client.
 	var cdTektonPipelineClientURL string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -3218,18 +3909,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		cdTektonPipelineClientURL = cdtektonpipelinev2.DefaultServiceURL
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdTektonPipelineClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", c.Region, cdTektonPipelineClientURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		cdTektonPipelineClientURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", c.Region, cdTektonPipelineClientURL)
 	}
 	cdTektonPipelineClientOptions := &cdtektonpipelinev2.CdTektonPipelineV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT"}, cdTektonPipelineClientURL),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("cd_tekton_pipeline", cdTektonPipelineClientURL),
 	}
 	// Construct the service client.
 	session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cdTektonPipelineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cdTektonPipelineClient.Service, "cd_tekton_pipeline")
 		// Add custom header for analytics
 		session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3237,27 +3928,31 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cdTektonPipelineClientErr = fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
 	}
+}
 
-	// MQ Cloud Service Configuration
+// MQ Cloud Service Configuration
+func (session *clientSession) configureMqcloudV1() {
+	c := session.config
+	var err error
 	mqCloudURL := ContructEndpoint(fmt.Sprintf("api.%s.mq2", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		mqCloudURL = ContructEndpoint(fmt.Sprintf("api.private.%s.mq2", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		mqCloudURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT", c.Region, mqCloudURL)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		mqCloudURL = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT", c.Region, mqCloudURL)
 	}
 	accept_language := os.Getenv("IBMCLOUD_MQCLOUD_ACCEPT_LANGUAGE")
 	mqcloudClientOptions := &mqcloudv1.MqcloudV1Options{
-		Authenticator:  authenticator,
+		Authenticator:  session.authenticator,
 		AcceptLanguage: core.StringPtr(accept_language),
-		URL:            EnvFallBack([]string{"IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT"}, mqCloudURL),
+		URL:            session.endpoint("mqcloud", mqCloudURL),
 	}
 
 	// Construct the service client for MQ Cloud.
 	session.mqcloudClient, err = mqcloudv1.NewMqcloudV1(mqcloudClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.mqcloudClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.mqcloudClient.Service, "mqcloud")
 		// Add custom header for analytics
 		session.mqcloudClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3265,25 +3960,30 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.mqcloudClientErr = fmt.Errorf("Error occurred while configuring MQ on Cloud service: %q", err)
 	}
+}
 
+// CODE ENGINE Service
+func (session *clientSession) configureCodeEngineV2() {
+	c := session.config
+	var err error
 	// Construct the service options.
 	codeEngineEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.codeengine", c.Region), cloudEndpoint+"/v2")
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		codeEngineEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s.codeengine", c.Region), cloudEndpoint+"/v2")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		codeEngineEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CODE_ENGINE_API_ENDPOINT", c.Region, codeEngineEndpoint)
+	if session.fileMap != nil && c.Visibility != "public-and-private" {
+		codeEngineEndpoint = fileFallBack(session.fileMap, c.Visibility, "IBMCLOUD_CODE_ENGINE_API_ENDPOINT", c.Region, codeEngineEndpoint)
 	}
 	codeEngineClientOptions := &codeengine.CodeEngineV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CODE_ENGINE_API_ENDPOINT"}, codeEngineEndpoint),
+		Authenticator: session.authenticator,
+		URL:           session.endpoint("code_engine", codeEngineEndpoint),
 	}
 
 	// Construct the service client.
 	session.codeEngineClient, err = codeengine.NewCodeEngineV2(codeEngineClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.codeEngineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.codeEngineClient.Service, "code_engine")
 		// Add custom header for analytics
 		session.codeEngineClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3291,16 +3991,6 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.codeEngineClientErr = fmt.Errorf("Error occurred while configuring Code Engine service: %q", err)
 	}
-
-	if os.Getenv("TF_LOG") != "" {
-		logDestination := log.Writer()
-		goLogger := log.New(logDestination, "", log.LstdFlags)
-		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
-	}
-
-	// setting UserAgent for vpc-go-sdk common
-	common.UserAgent = fmt.Sprintf("terraform-provider-ibm/%s", version.Version)
-	return session, nil
 }
 
 // CreateVersionDate requires mandatory version attribute. Any date from 2019-12-13 up to the currentdate may be provided. Specify the current date to request the latest version.
@@ -3309,7 +3999,7 @@ func CreateVersionDate() *string {
 	return &version
 }
 
-func newSession(c *Config) (*Session, error) {
+func newSession(c *Config, httpTransport *gohttp.Transport, trustedProfileAuthenticator tokenAuthenticator) (*Session, error) {
 	ibmSession := &Session{}
 
 	softlayerSession := &slsession.Session{
@@ -3332,6 +4022,12 @@ func newSession(c *Config) (*Session, error) {
 		softlayerSession.APIKey = c.SoftLayerAPIKey
 		softlayerSession.UserName = c.SoftLayerUserName
 	}
+	if httpTransport != nil || trustedProfileAuthenticator != nil {
+		softlayerSession.HTTPClient = &gohttp.Client{
+			Transport: newTokenTransport(trustedProfileAuthenticator, transportOr(httpTransport, gohttp.DefaultTransport)),
+			Timeout:   c.SoftLayerTimeout,
+		}
+	}
 	softlayerSession.AppendUserAgent(fmt.Sprintf("terraform-provider-ibm/%s", version.Version))
 	ibmSession.SoftLayerSession = softlayerSession
 
@@ -3342,6 +4038,9 @@ func newSession(c *Config) (*Session, error) {
 		return nil, fmt.Errorf("iam_token and iam_profile_id must be provided")
 	}
 
+	// The requests are retried by the transport of bluemixHTTPClient
+	bluemixRetries := 0
+
 	if c.IAMToken != "" {
 		log.Println("Configuring IBM Cloud Session with token")
 		var sess *bxsession.Session
@@ -3354,10 +4053,11 @@ func newSession(c *Config) (*Session, error) {
 			Region:        c.Region,
 			ResourceGroup: c.ResourceGroup,
 			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
+			MaxRetries:    &bluemixRetries,
 			Visibility:    c.Visibility,
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+			HTTPClient:    c.bluemixHTTPClient(httpTransport, trustedProfileAuthenticator),
 		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
@@ -3377,10 +4077,11 @@ func newSession(c *Config) (*Session, error) {
 			Region:        c.Region,
 			ResourceGroup: c.ResourceGroup,
 			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
+			MaxRetries:    &bluemixRetries,
 			Visibility:    c.Visibility,
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+			HTTPClient:    c.bluemixHTTPClient(httpTransport, trustedProfileAuthenticator),
 		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
@@ -3512,10 +4213,54 @@ func DefaultTransport() gohttp.RoundTripper {
 	return transport
 }
 
-func isRetryable(err error) bool {
+// serviceTransport returns the transport of the clients which do not use the IBM go-sdk-core,
+// retrying the failed requests with the retry policy of the service
+func (session *clientSession) serviceTransport(service string) gohttp.RoundTripper {
+	return NewRetryTransport(session.retryPolicy.ForService(service), newTokenTransport(session.trustedProfileAuthenticator, transportOr(session.httpTransport, DefaultTransport())))
+}
+
+// bluemixHTTPClient returns the client of the Bluemix session, which uses the provider HTTP settings
+// and the trusted profile, and retries the requests with the retry policy of the bluemix service
+func (c *Config) bluemixHTTPClient(httpTransport *gohttp.Transport, trustedProfileAuthenticator tokenAuthenticator) *gohttp.Client {
+	return &gohttp.Client{
+		Transport: NewRetryTransport(c.retryPolicy().ForService("bluemix"), newTokenTransport(trustedProfileAuthenticator, http.NewTraceLoggingTransport(transportOr(httpTransport, gohttp.DefaultTransport)))),
+		Timeout:   c.BluemixTimeout,
+	}
+}
+
+// retryPolicy returns the retry policy set by the provider retry block
+func (c *Config) retryPolicy() *RetryPolicy {
+	if c.Retry != nil {
+		return c.Retry
+	}
+	return DefaultRetryPolicy(c.RetryCount)
+}
+
+// RetryPolicy returns the retry policy set by the provider retry block
+func (session *clientSession) RetryPolicy() *RetryPolicy {
+	return session.retryPolicy
+}
+
+// enableRetries retries the requests of the service client with the retry policy of the service,
+// in place of the go-sdk-core retries
+func (session *clientSession) enableRetries(service *core.BaseService, name string) {
+	client := service.GetHTTPClient()
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	}
+	service.DisableRetries()
+	service.SetHTTPClient(&gohttp.Client{
+		Transport:     NewRetryTransport(session.retryPolicy.ForService(name), transportOr(session.httpTransport, client.Transport)),
+		CheckRedirect: client.CheckRedirect,
+		Jar:           client.Jar,
+		Timeout:       client.Timeout,
+	})
+}
+
+// isRetryable reports whether the failed request is retried with the iam retry policy
+func isRetryable(err error, policy *RetryPolicy) bool {
 	if bmErr, ok := err.(bmxerror.RequestFailure); ok {
-		switch bmErr.StatusCode() {
-		case 408, 504, 599, 429, 500, 502, 520, 503:
+		if _, _, _, ok := policy.ForService("iam").retries(bmErr.StatusCode()); ok {
 			return true
 		}
 	}
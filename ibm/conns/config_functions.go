@@ -27,7 +27,7 @@ func FunctionClient(c *bluemix.Config) (*whisk.Client, error) {
 		return nil, err
 	}
 
-	functionsClient, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+	functionsClient, err := whisk.NewClient(bluemixClient(c), &whisk.Config{
 		Host:    u.Host,
 		Version: "v1",
 	})
@@ -35,6 +35,15 @@ func FunctionClient(c *bluemix.Config) (*whisk.Client, error) {
 	return functionsClient, err
 }
 
+// bluemixClient returns the client of the Bluemix session, which has the provider HTTP settings, or
+// http.DefaultClient
+func bluemixClient(c *bluemix.Config) *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
 // getBaseURL ..
 func getBaseURL(region string) string {
 	baseEndpoint := fmt.Sprintf(DefaultServiceURL)
@@ -52,9 +61,9 @@ func getBaseURL(region string) string {
  * iam-based namespace don't have an auth key and needs only iam token for authorization.
  *
  */
-func SetupOpenWhiskClientConfig(namespace string, sess *bxsession.Session, functionNamespace functions.FunctionServiceAPI) (*whisk.Client, error) {
+func SetupOpenWhiskClientConfig(namespace string, sess *bxsession.Session, functionNamespace functions.FunctionServiceAPI, retryPolicy *RetryPolicy) (*whisk.Client, error) {
 	u, _ := url.Parse(fmt.Sprintf("https://%s.functions.cloud.ibm.com/api", sess.Config.Region))
-	wskClient, _ := whisk.NewClient(http.DefaultClient, &whisk.Config{
+	wskClient, _ := whisk.NewClient(bluemixClient(sess.Config), &whisk.Config{
 		Host:    u.Host,
 		Version: "v1",
 	})
@@ -84,8 +93,8 @@ func SetupOpenWhiskClientConfig(namespace string, sess *bxsession.Session, funct
 
 				err := RefreshToken(sess)
 				if err != nil {
-					for count := sess.Config.MaxRetries; *count >= 0; *count-- {
-						if err == nil || !isRetryable(err) {
+					for count := retryPolicy.ForService("iam").MaxRetries; count >= 0; count-- {
+						if err == nil || !isRetryable(err, retryPolicy) {
 							break
 						}
 						err = RefreshToken(sess)
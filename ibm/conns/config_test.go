@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	httptransport "github.com/go-openapi/runtime/client"
+	jwt "github.com/golang-jwt/jwt"
+)
+
+func testIAMToken(t *testing.T) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"id":      "IBMid-test",
+		"email":   "test@ibm.com",
+		"iss":     "https://iam.cloud.ibm.com/identity",
+		"account": map[string]interface{}{"bss": "test-account"},
+	})
+	signed, err := token.SignedString([]byte("test"))
+	if err != nil {
+		t.Fatalf("Error signing test token: %s", err)
+	}
+	return "Bearer " + signed
+}
+
+func TestClientSessionWithoutCredentials(t *testing.T) {
+	c := &Config{Region: "us-south"}
+	meta, err := c.ClientSession()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	sess := meta.(ClientSession)
+	if _, err := sess.VpcV1API(); err != errEmptyBluemixCredentials {
+		t.Errorf("Expected errEmptyBluemixCredentials, got %v", err)
+	}
+	if _, err := sess.CisZonesV1ClientSession(); err != errEmptyBluemixCredentials {
+		t.Errorf("Expected errEmptyBluemixCredentials, got %v", err)
+	}
+}
+
+func TestClientSessionLazyConfiguration(t *testing.T) {
+	c := &Config{
+		Region:              "us-south",
+		IAMToken:            testIAMToken(t),
+		IAMTrustedProfileID: "Profile-test",
+	}
+	meta, err := c.ClientSession()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	sess := meta.(*clientSession)
+	if sess.vpcAPI != nil || sess.schematicsClient != nil {
+		t.Fatal("Expected service clients to be configured on first use")
+	}
+
+	var wg sync.WaitGroup
+	clients := make([]interface{}, 10)
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			client, err := sess.VpcV1API()
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+			}
+			clients[i] = client
+		}(i)
+	}
+	wg.Wait()
+	for _, client := range clients {
+		if client == nil || client != clients[0] {
+			t.Fatal("Expected every caller to get the same VPC client")
+		}
+	}
+	if sess.schematicsClient != nil {
+		t.Error("Expected Schematics client to stay unconfigured")
+	}
+}
+
+func TestClientSessionProfileIDWithAPIKey(t *testing.T) {
+	profileToken := testIAMToken(t)
+	var assumed int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Form.Get("grant_type") {
+		case "urn:ibm:params:oauth:grant-type:apikey":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "eyJhbGciOiJub25lIn0.eyJleHAiOjQxMDI0NDQ4MDAsImlhdCI6MTcwMDAwMDAwMH0.",
+				"expires_in":   3600,
+				"expiration":   4102444800,
+			})
+		case "urn:ibm:params:oauth:grant-type:assume":
+			assumed++
+			if r.Form.Get("profile_id") != "Profile-test" {
+				t.Errorf("Unexpected assume request %v", r.Form)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"access_token": profileToken[len("Bearer "):], "expires_in": 3600})
+		default:
+			t.Errorf("Unexpected grant type %s", r.Form.Get("grant_type"))
+		}
+	}))
+	defer server.Close()
+
+	// The settings of a profile of the config file setting both iam_profile_id and ibmcloud_api_key
+	c := &Config{
+		Region:              "us-south",
+		BluemixAPIKey:       "key",
+		IAMTrustedProfileID: "Profile-test",
+		Endpoints:           map[string]string{"iam": server.URL},
+	}
+	if _, err := c.ClientSession(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if assumed != 1 {
+		t.Errorf("Expected the profile to be assumed with the API key, got %d assume requests", assumed)
+	}
+	if c.AssumeTrustedProfile == nil || c.AssumeTrustedProfile.ID != "Profile-test" {
+		t.Errorf("Expected iam_profile_id to be assumed, got %+v", c.AssumeTrustedProfile)
+	}
+	if c.IAMToken != profileToken || c.BluemixAPIKey != "" {
+		t.Error("Expected the session to be configured with the token of the profile")
+	}
+}
+
+func TestIBMPISessionForZone(t *testing.T) {
+	sess := &clientSession{
+		config: &Config{
+			Region:    "dal",
+			Zone:      "dal12",
+			Endpoints: map[string]string{"power": "https://private.dal.power-iaas.cloud.ibm.com"},
+		},
+		authenticator:  &core.BearerTokenAuthenticator{BearerToken: "token"},
+		bmxUserDetails: &UserConfig{UserAccount: "test-account"},
+		retryPolicy:    DefaultRetryPolicy(2),
+	}
+	sess.ibmPISessionOnce.Do(sess.configureIBMPISession)
+
+	piSession, err := sess.IBMPISession()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if zoneSession, _ := sess.IBMPISessionForZone("dal12"); zoneSession != piSession {
+		t.Error("Expected the session of the provider zone")
+	}
+	zoneSession, err := sess.IBMPISessionForZone("wdc06")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if again, _ := sess.IBMPISessionForZone("wdc06"); again != zoneSession {
+		t.Error("Expected the session of the zone to be created once")
+	}
+	runtime, ok := zoneSession.Power.Transport.(*httptransport.Runtime)
+	if !ok {
+		t.Fatalf("Unexpected transport %T", zoneSession.Power.Transport)
+	}
+	if runtime.Host != "private.wdc.power-iaas.cloud.ibm.com" {
+		t.Errorf("Expected the power endpoint of the region of the zone, got %s", runtime.Host)
+	}
+	if _, ok := runtime.Transport.(*retryTransport); !ok {
+		t.Errorf("Expected the requests of the zone to be retried, got %T", runtime.Transport)
+	}
+}
+
+func TestPIRegionFromZone(t *testing.T) {
+	for zone, region := range map[string]string{"dal12": "dal", "wdc06": "wdc", "us-south-1": "us-south", "us-east": "us-east"} {
+		if got := piRegionFromZone(zone); got != region {
+			t.Errorf("piRegionFromZone(%q) = %q, want %q", zone, got, region)
+		}
+	}
+}
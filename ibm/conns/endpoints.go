@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"os"
+
+	"github.com/IBM-Cloud/bluemix-go/endpoints"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+)
+
+// endpointService is a service of the provider endpoints block
+type endpointService struct {
+	// Argument of the endpoints block
+	name string
+	// Environment variable and key of the endpoints file
+	env string
+	// Configures the client of the service, which resolves its endpoint
+	configure func(session *clientSession)
+}
+
+// endpointServices returns the services whose endpoint can be set in the provider endpoints block
+func endpointServices() []endpointService {
+	return []endpointService{
+		{"account", "IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.bluemixAcccountAPIOnce, s.configureBluemixAcccountAPI) }},
+		{"api_gateway", "IBMCLOUD_API_GATEWAY_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.apiGatewayOnce, s.configureAPIGateway) }},
+		{"app_configuration", "IBMCLOUD_APP_CONFIG_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.appConfigurationV1Once, s.configureAppConfigurationV1) }},
+		{"appid", "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.appIDAPIOnce, s.configureAppIDAPI) }},
+		{"atracker", "IBMCLOUD_ATRACKER_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.atrackerV2Once, s.configureAtrackerV2) }},
+		{"catalog_management", "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", func(s *clientSession) {
+			s.lazyConfigure(&s.catalogManagementV1Once, s.configureCatalogManagementV1)
+		}},
+		{"cd_tekton_pipeline", "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.cdTektonPipelineV2Once, s.configureCdTektonPipelineV2) }},
+		{"cd_toolchain", "IBMCLOUD_TOOLCHAIN_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.cdToolchainV2Once, s.configureCdToolchainV2) }},
+		{"cis", "IBMCLOUD_CIS_API_ENDPOINT", func(s *clientSession) {
+			s.lazyConfigure(&s.cisZonesV1ClientSessionOnce, s.configureCisZonesV1ClientSession)
+		}},
+		{"cloud_databases", "IBMCLOUD_DATABASES_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.cloudDatabasesV5Once, s.configureCloudDatabasesV5) }},
+		{"cloud_shell", "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.ibmCloudShellV1Once, s.configureIBMCloudShellV1) }},
+		{"code_engine", "IBMCLOUD_CODE_ENGINE_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.codeEngineV2Once, s.configureCodeEngineV2) }},
+		{"container", "IBMCLOUD_CS_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.containerAPIOnce, s.configureContainerAPI) }},
+		{"container_registry", "IBMCLOUD_CR_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.containerRegistryV1Once, s.configureContainerRegistryV1) }},
+		{"context_based_restrictions", "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", func(s *clientSession) {
+			s.lazyConfigure(&s.contextBasedRestrictionsV1Once, s.configureContextBasedRestrictionsV1)
+		}},
+		{"cos_config", "IBMCLOUD_COS_CONFIG_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.cosConfigV1APIOnce, s.configureCosConfigV1API) }},
+		{"direct_link", "IBMCLOUD_DL_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.directlinkV1APIOnce, s.configureDirectlinkV1API) }},
+		{"direct_link_provider", "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", func(s *clientSession) {
+			s.lazyConfigure(&s.directlinkProviderV2APIOnce, s.configureDirectlinkProviderV2API)
+		}},
+		{"dns_services", "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", func(s *clientSession) {
+			s.lazyConfigure(&s.privateDNSClientSessionOnce, s.configurePrivateDNSClientSession)
+		}},
+		{"enterprise", "IBMCLOUD_ENTERPRISE_API_ENDPOINT", func(s *clientSession) {
+			s.lazyConfigure(&s.enterpriseManagementV1Once, s.configureEnterpriseManagementV1)
+		}},
+		{"event_notifications", "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", func(s *clientSession) {
+			s.lazyConfigure(&s.eventNotificationsApiV1Once, s.configureEventNotificationsApiV1)
+		}},
+		{"event_streams_schema_registry", "IBMCLOUD_ES_SCHEMA_REGISTRY_ENDPOINT", func(s *clientSession) {
+			s.lazyConfigure(&s.esSchemaRegistrySessionOnce, s.configureESschemaRegistrySession)
+		}},
+		{"functions", "IBMCLOUD_FUNCTIONS_API_ENDPOINT", func(s *clientSession) {
+			s.lazyConfigure(&s.functionIAMNamespaceAPIOnce, s.configureFunctionIAMNamespaceAPI)
+		}},
+		{"global_search", "IBMCLOUD_GS_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.globalSearchAPIV2Once, s.configureGlobalSearchAPIV2) }},
+		{"global_tagging", "IBMCLOUD_GT_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.globalTaggingAPIv1Once, s.configureGlobalTaggingAPIv1) }},
+		{"hpcs", "IBMCLOUD_HPCS_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.hpcsEndpointAPIOnce, s.configureHpcsEndpointAPI) }},
+		{"hpcs_uko", "IBMCLOUD_HPCS_UKO_URL", func(s *clientSession) { s.lazyConfigure(&s.ukoV4Once, s.configureUkoV4) }},
+		// The IAM endpoint is resolved by ClientSession, for the authenticator
+		{"iam", "IBMCLOUD_IAM_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.iamIdentityV1APIOnce, s.configureIAMIdentityV1API) }},
+		{"icd", "IBMCLOUD_ICD_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.icdAPIOnce, s.configureICDAPI) }},
+		{"kms", "IBMCLOUD_KP_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.keyManagementAPIOnce, s.configureKeyManagementAPI) }},
+		{"mccp", "IBMCLOUD_MCCP_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.mccpAPIOnce, s.configureMccpAPI) }},
+		{"metrics_router", "IBMCLOUD_METRICS_ROUTING_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.metricsRouterV3Once, s.configureMetricsRouterV3) }},
+		{"mqcloud", "IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.mqcloudV1Once, s.configureMqcloudV1) }},
+		{"power", "IBMCLOUD_PI_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.ibmPISessionOnce, s.configureIBMPISession) }},
+		{"project", "IBMCLOUD_PROJECT_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.projectV1Once, s.configureProjectV1) }},
+		{"push_notifications", "IBMCLOUD_PUSH_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.pushServiceV1Once, s.configurePushServiceV1) }},
+		{"resource_catalog", "IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT", func(s *clientSession) {
+			s.lazyConfigure(&s.resourceCatalogAPIOnce, s.configureResourceCatalogAPI)
+		}},
+		{"resource_controller", "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", func(s *clientSession) {
+			s.lazyConfigure(&s.resourceControllerV2APIOnce, s.configureResourceControllerV2API)
+		}},
+		{"resource_manager", "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", func(s *clientSession) {
+			s.lazyConfigure(&s.resourceManagerV2APIOnce, s.configureResourceManagerV2API)
+		}},
+		{"satellite", "IBMCLOUD_SATELLITE_API_ENDPOINT", func(s *clientSession) {
+			s.lazyConfigure(&s.satelliteClientSessionOnce, s.configureSatelliteClientSession)
+		}},
+		{"satellite_link", "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", func(s *clientSession) {
+			s.lazyConfigure(&s.satellitLinkClientSessionOnce, s.configureSatellitLinkClientSession)
+		}},
+		{"scc", "IBMCLOUD_SCC_API_ENDPOINT", func(s *clientSession) {
+			s.lazyConfigure(&s.securityAndComplianceCenterV3Once, s.configureSecurityAndComplianceCenterV3)
+		}},
+		{"schematics", "IBMCLOUD_SCHEMATICS_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.schematicsV1Once, s.configureSchematicsV1) }},
+		{"secrets_manager", "IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.secretsManagerV2Once, s.configureSecretsManagerV2) }},
+		{"transit_gateway", "IBMCLOUD_TG_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.transitGatewayV1APIOnce, s.configureTransitGatewayV1API) }},
+		{"usage_reports", "IBMCLOUD_USAGE_REPORTS_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.usageReportsV4Once, s.configureUsageReportsV4) }},
+		{"user_management", "IBMCLOUD_USER_MANAGEMENT_ENDPOINT", func(s *clientSession) {
+			s.lazyConfigure(&s.userManagementAPIOnce, s.configureUserManagementAPI)
+		}},
+		{"vpc", "IBMCLOUD_IS_NG_API_ENDPOINT", func(s *clientSession) { s.lazyConfigure(&s.vpcV1APIOnce, s.configureVpcV1API) }},
+	}
+}
+
+// EndpointServices returns the services of the provider endpoints block
+func EndpointServices() []string {
+	services := endpointServices()
+	names := make([]string, 0, len(services))
+	for _, s := range services {
+		names = append(names, s.name)
+	}
+	return names
+}
+
+// EndpointEnv returns the environment variable of the endpoint of the service
+func EndpointEnv(name string) string {
+	for _, s := range endpointServices() {
+		if s.name == name {
+			return s.env
+		}
+	}
+	return ""
+}
+
+// endpoint returns the endpoint of the service set in the provider endpoints block, else in the
+// environment variable of the service, else url, which is the endpoint of the endpoints file or
+// the default endpoint of the visibility
+func (c *Config) endpoint(name, url string) string {
+	if v := c.Endpoints[name]; v != "" {
+		return v
+	}
+	if v := os.Getenv(EndpointEnv(name)); v != "" {
+		return v
+	}
+	return url
+}
+
+// endpoint resolves the endpoint of the service and records it for ServiceEndpoints
+func (session *clientSession) endpoint(name, url string) string {
+	url = session.config.endpoint(name, url)
+	if url == "" {
+		return url
+	}
+	session.endpointsMutex.Lock()
+	defer session.endpointsMutex.Unlock()
+	if session.endpoints == nil {
+		session.endpoints = map[string]string{}
+	}
+	session.endpoints[name] = url
+	return url
+}
+
+// bluemixSession returns the Bluemix session of the bluemix-go client of the service, whose endpoint is
+// resolved like the endpoints of the other services. locate returns the endpoint of the environment
+// variable, the endpoints file or the visibility found by bluemix-go
+func (session *clientSession) bluemixSession(name string, locate func(endpoints.EndpointLocator) (string, error)) *bxsession.Session {
+	bmxSession := session.session.BluemixSession
+	url, err := locate(bmxSession.Config.EndpointLocator)
+	if err != nil {
+		// The client reports the error, unless the endpoint is set in the endpoints block
+		url = ""
+	}
+	if url = session.endpoint(name, url); url == "" {
+		return bmxSession
+	}
+	config := bmxSession.Config.Copy()
+	config.Endpoint = &url
+	return &bxsession.Session{Config: config}
+}
+
+// ServiceEndpoints returns the resolved endpoint of every service of the endpoints block,
+// configuring the clients which were not used yet
+func (session *clientSession) ServiceEndpoints() map[string]string {
+	for _, s := range endpointServices() {
+		s.configure(session)
+	}
+	session.endpointsMutex.Lock()
+	defer session.endpointsMutex.Unlock()
+	endpoints := make(map[string]string, len(session.endpoints))
+	for k, v := range session.endpoints {
+		endpoints[k] = v
+	}
+	return endpoints
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"os"
+	"testing"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/endpoints"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+)
+
+func TestEndpointPrecedence(t *testing.T) {
+	os.Setenv("IBMCLOUD_IS_NG_API_ENDPOINT", "https://vpc.env.example.com/v1")
+	defer os.Unsetenv("IBMCLOUD_IS_NG_API_ENDPOINT")
+
+	c := &Config{}
+	if url := c.endpoint("vpc", "https://us-south.iaas.cloud.ibm.com/v1"); url != "https://vpc.env.example.com/v1" {
+		t.Errorf("Expected the environment variable to override the default endpoint, got %s", url)
+	}
+	if url := c.endpoint("cis", "https://api.cis.cloud.ibm.com"); url != "https://api.cis.cloud.ibm.com" {
+		t.Errorf("Expected the default endpoint, got %s", url)
+	}
+
+	c.Endpoints = map[string]string{"vpc": "http://localhost:8080/v1"}
+	session := &clientSession{config: c}
+	if url := session.endpoint("vpc", "https://us-south.iaas.cloud.ibm.com/v1"); url != "http://localhost:8080/v1" {
+		t.Errorf("Expected the endpoints block to take precedence, got %s", url)
+	}
+	if session.endpoints["vpc"] != "http://localhost:8080/v1" {
+		t.Errorf("Expected the resolved endpoint to be recorded, got %v", session.endpoints)
+	}
+}
+
+func TestEndpointServices(t *testing.T) {
+	seen := map[string]bool{}
+	for _, name := range EndpointServices() {
+		if seen[name] {
+			t.Errorf("Duplicate endpoint service %s", name)
+		}
+		seen[name] = true
+		if EndpointEnv(name) == "" {
+			t.Errorf("No environment variable for the endpoint service %s", name)
+		}
+	}
+}
+
+func TestBluemixSessionEndpoint(t *testing.T) {
+	bmxSession := &bxsession.Session{Config: &bluemix.Config{
+		Region:          "us-south",
+		EndpointLocator: endpoints.NewEndpointLocator("us-south", "public", ""),
+	}}
+	c := &Config{Endpoints: map[string]string{"container": "http://localhost:8080"}}
+	session := &clientSession{config: c, session: &Session{BluemixSession: bmxSession}}
+
+	sess := session.bluemixSession("container", endpoints.EndpointLocator.ContainerEndpoint)
+	if sess.Config.Endpoint == nil || *sess.Config.Endpoint != "http://localhost:8080" {
+		t.Errorf("Expected the endpoints block to set the endpoint of the client, got %v", sess.Config.Endpoint)
+	}
+	if bmxSession.Config.Endpoint != nil {
+		t.Error("Expected the shared Bluemix session to be kept")
+	}
+
+	sess = session.bluemixSession("resource_catalog", endpoints.EndpointLocator.ResourceCatalogEndpoint)
+	if sess.Config.Endpoint == nil || *sess.Config.Endpoint != "https://globalcatalog.cloud.ibm.com" {
+		t.Errorf("Expected the default endpoint of bluemix-go, got %v", sess.Config.Endpoint)
+	}
+	resolved := session.endpoints
+	if resolved["container"] != "http://localhost:8080" || resolved["resource_catalog"] != "https://globalcatalog.cloud.ibm.com" {
+		t.Errorf("Expected the resolved endpoints to be recorded, got %v", resolved)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultProfile is the profile read from the config file when the provider profile is not set
+const DefaultProfile = "default"
+
+// Profile holds the provider settings of a named profile of a config file
+type Profile struct {
+	APIKey          string
+	IAMToken        string
+	IAMRefreshToken string
+	IAMProfileID    string
+	Region          string
+	Zone            string
+	ResourceGroup   string
+	Visibility      string
+	EndpointsFile   string
+	ClassicUsername string
+	ClassicAPIKey   string
+}
+
+// DefaultConfigFile returns ~/.ibmcloud/credentials, the config file read when the provider
+// profile is set without config_file
+func DefaultConfigFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".ibmcloud", "credentials")
+}
+
+// LoadProfile reads the named profile of the config file. The file is either an INI file of
+// profiles using the provider argument names:
+//
+//	[prod]
+//	ibmcloud_api_key = ...
+//	region           = eu-de
+//
+// or the config.json of the IBM Cloud CLI, from which the IAM tokens, the region and the
+// resource group of the logged in session are read.
+func LoadProfile(file, profile string) (*Profile, error) {
+	if file == "" {
+		file = DefaultConfigFile()
+	}
+	if strings.HasPrefix(file, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			file = filepath.Join(home, file[2:])
+		}
+	}
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading config file %s: %s", file, err)
+	}
+	if profile == "" {
+		profile = DefaultProfile
+	}
+	if trimmed := bytes.TrimSpace(content); len(trimmed) > 0 && trimmed[0] == '{' {
+		if profile != DefaultProfile {
+			return nil, fmt.Errorf("[ERROR] The IBM Cloud CLI config file %s has no profile %s, only the logged in session can be read from it", file, profile)
+		}
+		return parseCLIConfig(file, content)
+	}
+
+	settings, err := parseProfiles(content, profile)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing config file %s: %s", file, err)
+	}
+	if settings == nil {
+		return nil, fmt.Errorf("[ERROR] Profile %s not found in config file %s", profile, file)
+	}
+	return &Profile{
+		APIKey:          settings["ibmcloud_api_key"],
+		IAMToken:        settings["iam_token"],
+		IAMRefreshToken: settings["iam_refresh_token"],
+		IAMProfileID:    settings["iam_profile_id"],
+		Region:          settings["region"],
+		Zone:            settings["zone"],
+		ResourceGroup:   settings["resource_group"],
+		Visibility:      settings["visibility"],
+		EndpointsFile:   settings["endpoints_file_path"],
+		ClassicUsername: settings["iaas_classic_username"],
+		ClassicAPIKey:   settings["iaas_classic_api_key"],
+	}, nil
+}
+
+// parseProfiles returns the settings of the profile, or nil when the profile is not in the file
+func parseProfiles(content []byte, profile string) (map[string]string, error) {
+	var settings map[string]string
+	current := ""
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+		if strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]") {
+			current = strings.TrimSpace(strings.TrimPrefix(text[1:len(text)-1], "profile "))
+			if current == profile && settings == nil {
+				settings = map[string]string{}
+			}
+			continue
+		}
+		key, value, found := strings.Cut(text, "=")
+		if !found {
+			return nil, fmt.Errorf("line %d is neither a [profile] nor a key = value", line)
+		}
+		if current == profile {
+			settings[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"`)
+		}
+	}
+	return settings, scanner.Err()
+}
+
+// cliConfig is the part of the IBM Cloud CLI config.json read by the provider
+type cliConfig struct {
+	IAMToken        string
+	IAMRefreshToken string
+	Region          string
+	ResourceGroup   struct {
+		GUID string
+	}
+}
+
+func parseCLIConfig(file string, content []byte) (*Profile, error) {
+	config := cliConfig{}
+	if err := json.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing IBM Cloud CLI config file %s: %s", file, err)
+	}
+	return &Profile{
+		IAMToken:        config.IAMToken,
+		IAMRefreshToken: config.IAMRefreshToken,
+		Region:          config.Region,
+		ResourceGroup:   config.ResourceGroup.GUID,
+	}, nil
+}
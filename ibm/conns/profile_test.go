@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeProfileTestFile(t *testing.T, content string) string {
+	file := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(file, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestLoadProfile(t *testing.T) {
+	file := writeProfileTestFile(t, `
+# dev account
+[default]
+ibmcloud_api_key = dev-key
+region = us-south
+
+[profile prod]
+ibmcloud_api_key = "prod-key"
+region           = eu-de
+resource_group   = 1234
+visibility       = private
+iaas_classic_username = classic-user
+iaas_classic_api_key  = classic-key
+`)
+
+	profile, err := LoadProfile(file, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.APIKey != "dev-key" || profile.Region != "us-south" || profile.ResourceGroup != "" {
+		t.Errorf("Unexpected default profile %+v", profile)
+	}
+
+	profile, err = LoadProfile(file, "prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Profile{APIKey: "prod-key", Region: "eu-de", ResourceGroup: "1234", Visibility: "private", ClassicUsername: "classic-user", ClassicAPIKey: "classic-key"}
+	if *profile != expected {
+		t.Errorf("Expected profile %+v, got %+v", expected, profile)
+	}
+
+	if _, err := LoadProfile(file, "stage"); err == nil || !strings.Contains(err.Error(), "Profile stage not found") {
+		t.Errorf("Expected a missing profile error, got %v", err)
+	}
+}
+
+func TestLoadProfileFromCLIConfig(t *testing.T) {
+	file := writeProfileTestFile(t, `{
+  "IAMToken": "Bearer token",
+  "IAMRefreshToken": "refresh",
+  "Region": "jp-tok",
+  "ResourceGroup": {"GUID": "5678", "Name": "Default"}
+}`)
+
+	profile, err := LoadProfile(file, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Profile{IAMToken: "Bearer token", IAMRefreshToken: "refresh", Region: "jp-tok", ResourceGroup: "5678"}
+	if *profile != expected {
+		t.Errorf("Expected profile %+v, got %+v", expected, profile)
+	}
+	if _, err := LoadProfile(file, "prod"); err == nil {
+		t.Error("Expected an error for a named profile of the CLI config")
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"crypto/x509"
+	"errors"
+	"io"
+	"log"
+	"math/rand"
+	gohttp "net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultRetryStatusCodes are the HTTP status codes retried when the provider retry block does not set them
+var DefaultRetryStatusCodes = []int{408, 429, 500, 502, 503, 504, 520, 599}
+
+const (
+	// DefaultRetryMinDelay is the delay before the first retry
+	DefaultRetryMinDelay = 1 * time.Second
+	// DefaultRetryMaxDelay caps the exponential backoff and the Retry-After header
+	DefaultRetryMaxDelay = 30 * time.Second
+)
+
+// RetryPolicy holds the provider level retry settings
+type RetryPolicy struct {
+	MaxRetries int
+	// The delay doubles on every retry, from MinDelay up to MaxDelay
+	MinDelay time.Duration
+	MaxDelay time.Duration
+	// Jitter randomizes the second half of every delay, so parallel requests do not retry in lockstep
+	Jitter      bool
+	StatusCodes []int
+
+	// Overrides for the given status codes, which are retried even if not in StatusCodes
+	Statuses map[int]RetryStatusPolicy
+	// Overrides for the given services, for example vpc or iam
+	Services map[string]*RetryPolicy
+}
+
+// RetryStatusPolicy overrides the retry settings for an HTTP status code
+type RetryStatusPolicy struct {
+	MaxRetries int
+	MinDelay   time.Duration
+	MaxDelay   time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used when the provider retry block is not set
+func DefaultRetryPolicy(maxRetries int) *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:  maxRetries,
+		MinDelay:    DefaultRetryMinDelay,
+		MaxDelay:    DefaultRetryMaxDelay,
+		Jitter:      true,
+		StatusCodes: DefaultRetryStatusCodes,
+	}
+}
+
+// ForService returns the retry policy of the given service
+func (p *RetryPolicy) ForService(service string) *RetryPolicy {
+	if p == nil {
+		return DefaultRetryPolicy(0)
+	}
+	if servicePolicy, ok := p.Services[service]; ok && servicePolicy != nil {
+		return servicePolicy
+	}
+	return p
+}
+
+// retries returns the retry count and the delays of a status code, ok is false when it is not retried
+func (p *RetryPolicy) retries(statusCode int) (maxRetries int, minDelay, maxDelay time.Duration, ok bool) {
+	if status, found := p.Statuses[statusCode]; found {
+		minDelay, maxDelay = status.MinDelay, status.MaxDelay
+		if minDelay == 0 {
+			minDelay = p.MinDelay
+		}
+		if maxDelay == 0 {
+			maxDelay = p.MaxDelay
+		}
+		return status.MaxRetries, minDelay, maxDelay, true
+	}
+	for _, code := range p.StatusCodes {
+		if code == statusCode {
+			return p.MaxRetries, p.MinDelay, p.MaxDelay, true
+		}
+	}
+	return 0, 0, 0, false
+}
+
+// IsRetryableStatus reports whether the requests failing with the status code are retried
+func (p *RetryPolicy) IsRetryableStatus(statusCode int) bool {
+	maxRetries, _, _, ok := p.retries(statusCode)
+	return ok && maxRetries > 0
+}
+
+var (
+	jitterRand  = rand.New(rand.NewSource(time.Now().UnixNano()))
+	jitterMutex sync.Mutex
+)
+
+// Backoff returns the delay before the retry following the given attempt, starting at 0.
+// The Retry-After header of the response is honored, up to the maximum delay.
+func (p *RetryPolicy) Backoff(attempt int, resp *gohttp.Response) time.Duration {
+	minDelay, maxDelay := p.MinDelay, p.MaxDelay
+	if resp != nil {
+		if _, statusMinDelay, statusMaxDelay, ok := p.retries(resp.StatusCode); ok {
+			minDelay, maxDelay = statusMinDelay, statusMaxDelay
+		}
+		if delay, ok := retryAfter(resp); ok {
+			if delay > maxDelay {
+				return maxDelay
+			}
+			return delay
+		}
+	}
+
+	delay := minDelay
+	for i := 0; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	if p.Jitter && delay > 1 {
+		jitterMutex.Lock()
+		delay = delay/2 + time.Duration(jitterRand.Int63n(int64(delay/2)))
+		jitterMutex.Unlock()
+	}
+	return delay
+}
+
+func retryAfter(resp *gohttp.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := gohttp.ParseTime(value); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// nonIdempotentRetryStatusCodes are the status codes the POST requests are retried on, the
+// server rejected them before processing them. A POST failing with a network error or another
+// status code may have created the resource, so it is not retried.
+var nonIdempotentRetryStatusCodes = []int{429, 503}
+
+// NewRetryTransport returns a transport retrying the requests failed with a retryable status
+// code or a network error, following the retry policy. The POST requests are only retried on
+// the 429 and 503 status codes.
+func NewRetryTransport(policy *RetryPolicy, transport gohttp.RoundTripper) gohttp.RoundTripper {
+	if policy == nil {
+		policy = DefaultRetryPolicy(0)
+	}
+	return &retryTransport{policy: policy, transport: transport}
+}
+
+type retryTransport struct {
+	policy    *RetryPolicy
+	transport gohttp.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	// The body is read again on every attempt
+	getBody := req.GetBody
+	if req.Body != nil && req.Body != gohttp.NoBody && getBody == nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		getBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		req.Body, _ = getBody()
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && getBody != nil {
+			attemptReq = req.Clone(req.Context())
+			body, err := getBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq.Body = body
+		}
+
+		resp, err := t.transport.RoundTrip(attemptReq)
+		maxRetries := t.maxRetries(req, resp, err)
+		if attempt >= maxRetries {
+			return resp, err
+		}
+
+		delay := t.policy.Backoff(attempt, resp)
+		if resp != nil {
+			log.Printf("[DEBUG] Retrying %s %s in %s after status code %d, retry %d of %d", req.Method, req.URL.Redacted(), delay, resp.StatusCode, attempt+1, maxRetries)
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		} else {
+			log.Printf("[DEBUG] Retrying %s %s in %s after error %s, retry %d of %d", req.Method, req.URL.Redacted(), delay, err, attempt+1, maxRetries)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// maxRetries returns how many times the request is retried after the response or the error
+func (t *retryTransport) maxRetries(req *gohttp.Request, resp *gohttp.Response, err error) int {
+	if req.Method == gohttp.MethodPost && (err != nil || !containsStatusCode(nonIdempotentRetryStatusCodes, resp.StatusCode)) {
+		return 0
+	}
+	if err != nil {
+		if req.Context().Err() != nil {
+			return 0
+		}
+		var unknownAuthority x509.UnknownAuthorityError
+		var hostname x509.HostnameError
+		if errors.As(err, &unknownAuthority) || errors.As(err, &hostname) {
+			return 0
+		}
+		return t.policy.MaxRetries
+	}
+	maxRetries, _, _, ok := t.policy.retries(resp.StatusCode)
+	if !ok {
+		return 0
+	}
+	return maxRetries
+}
+
+func containsStatusCode(statusCodes []int, statusCode int) bool {
+	for _, code := range statusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{MinDelay: time.Second, MaxDelay: 10 * time.Second, StatusCodes: []int{429}}
+	for attempt, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second} {
+		if delay := policy.Backoff(attempt, nil); delay != expected {
+			t.Errorf("Attempt %d: expected %s, got %s", attempt, expected, delay)
+		}
+	}
+
+	resp := &http.Response{StatusCode: 429, Header: http.Header{"Retry-After": {"7"}}}
+	if delay := policy.Backoff(0, resp); delay != 7*time.Second {
+		t.Errorf("Expected the Retry-After delay, got %s", delay)
+	}
+	resp.Header.Set("Retry-After", "120")
+	if delay := policy.Backoff(0, resp); delay != 10*time.Second {
+		t.Errorf("Expected the Retry-After delay capped at the max delay, got %s", delay)
+	}
+
+	policy.Jitter = true
+	for i := 0; i < 100; i++ {
+		if delay := policy.Backoff(2, nil); delay < 2*time.Second || delay >= 4*time.Second {
+			t.Fatalf("Expected a jittered delay between 2s and 4s, got %s", delay)
+		}
+	}
+}
+
+func TestRetryPolicyOverrides(t *testing.T) {
+	policy := DefaultRetryPolicy(5)
+	policy.Statuses = map[int]RetryStatusPolicy{409: {MaxRetries: 2}, 503: {MaxRetries: 0}}
+	policy.Services = map[string]*RetryPolicy{"iam": {MaxRetries: 1, StatusCodes: []int{500}}}
+
+	if !policy.IsRetryableStatus(409) || policy.IsRetryableStatus(503) || !policy.IsRetryableStatus(429) || policy.IsRetryableStatus(404) {
+		t.Error("Unexpected retryable status codes")
+	}
+	if iam := policy.ForService("iam"); iam.MaxRetries != 1 || iam.IsRetryableStatus(429) {
+		t.Errorf("Expected the iam override, got %+v", iam)
+	}
+	if policy.ForService("vpc") != policy {
+		t.Error("Expected the provider policy for the services without override")
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("Expected the request body on every attempt, got %q", body)
+		}
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer server.Close()
+
+	policy := &RetryPolicy{MaxRetries: 3, MinDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond, StatusCodes: DefaultRetryStatusCodes}
+	client := &http.Client{Transport: NewRetryTransport(policy, http.DefaultTransport)}
+	resp, err := client.Post(server.URL, "text/plain", io.NopCloser(strings.NewReader("payload")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated || calls != 3 {
+		t.Errorf("Expected a success after 2 retries, got %d after %d calls", resp.StatusCode, calls)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	policy.MaxRetries = 1
+	resp, err = client.Post(server.URL, "text/plain", strings.NewReader("payload"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || calls != 2 {
+		t.Errorf("Expected the last failure after 1 retry, got %d after %d calls", resp.StatusCode, calls)
+	}
+}
+
+func TestRetryTransportPost(t *testing.T) {
+	var calls int32
+	var status int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(int(atomic.LoadInt32(&status)))
+	}))
+	defer server.Close()
+
+	policy := &RetryPolicy{MaxRetries: 2, MinDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond, StatusCodes: DefaultRetryStatusCodes}
+	client := &http.Client{Transport: NewRetryTransport(policy, http.DefaultTransport)}
+	for _, tc := range []struct {
+		method string
+		status int32
+		calls  int32
+	}{
+		{method: http.MethodPost, status: http.StatusTooManyRequests, calls: 3},
+		{method: http.MethodPost, status: http.StatusServiceUnavailable, calls: 3},
+		{method: http.MethodPost, status: http.StatusInternalServerError, calls: 1},
+		{method: http.MethodPost, status: http.StatusGatewayTimeout, calls: 1},
+		{method: http.MethodGet, status: http.StatusInternalServerError, calls: 3},
+		{method: http.MethodDelete, status: http.StatusGatewayTimeout, calls: 3},
+	} {
+		atomic.StoreInt32(&calls, 0)
+		atomic.StoreInt32(&status, tc.status)
+		req, _ := http.NewRequest(tc.method, server.URL, strings.NewReader("payload"))
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if calls != tc.calls {
+			t.Errorf("%s with status %d: expected %d calls, got %d", tc.method, tc.status, tc.calls, calls)
+		}
+	}
+
+	// A POST failing with a network error may have been received
+	var sent int32
+	failing := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&sent, 1)
+		return nil, io.ErrUnexpectedEOF
+	})
+	client = &http.Client{Transport: NewRetryTransport(policy, failing)}
+	if _, err := client.Post(server.URL, "text/plain", strings.NewReader("payload")); err == nil || sent != 1 {
+		t.Errorf("Expected the POST not to be retried after a network error, got %v after %d calls", err, sent)
+	}
+	atomic.StoreInt32(&sent, 0)
+	if _, err := client.Get(server.URL); err == nil || sent != 3 {
+		t.Errorf("Expected the GET to be retried after a network error, got %v after %d calls", err, sent)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"strings"
+)
+
+// TagsConfig holds the provider level default_tags and ignore_tags settings
+type TagsConfig struct {
+	// Tags attached to every taggable resource
+	DefaultTags       []string
+	DefaultAccessTags []string
+
+	// Tags which are attached by other tooling and never managed by the provider
+	IgnoreKeys        []string
+	IgnoreKeyPrefixes []string
+}
+
+// Defaults returns the default tags for the given tag type
+func (t *TagsConfig) Defaults(tagType string) []string {
+	if t == nil {
+		return nil
+	}
+	switch strings.TrimSpace(tagType) {
+	case "", "user":
+		return t.DefaultTags
+	case "access":
+		return t.DefaultAccessTags
+	}
+	return nil
+}
+
+// IsDefault reports whether tag is one of the default tags for the given tag type
+func (t *TagsConfig) IsDefault(tag, tagType string) bool {
+	for _, v := range t.Defaults(tagType) {
+		if strings.EqualFold(v, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsIgnored reports whether the key of tag (the part before the first ':')
+// matches one of the ignored keys or key prefixes
+func (t *TagsConfig) IsIgnored(tag string) bool {
+	if t == nil {
+		return false
+	}
+	key := tag
+	if i := strings.Index(tag, ":"); i >= 0 {
+		key = tag[:i]
+	}
+	key = strings.TrimSpace(key)
+	for _, k := range t.IgnoreKeys {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+	for _, p := range t.IgnoreKeyPrefixes {
+		if strings.HasPrefix(strings.ToLower(key), strings.ToLower(p)) {
+			return true
+		}
+	}
+	return false
+}
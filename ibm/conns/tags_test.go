@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestTagsConfigIsIgnored(t *testing.T) {
+	c := &TagsConfig{
+		IgnoreKeys:        []string{"schematics"},
+		IgnoreKeyPrefixes: []string{"sys-"},
+	}
+	cases := map[string]bool{
+		"schematics:workspace-id": true,
+		"Schematics":              true,
+		"sys-owner:team":          true,
+		"SYS-created":             true,
+		"env:schematics":          false,
+		"owner:sys-team":          false,
+		"schematicsx:1":           false,
+	}
+	for tag, expected := range cases {
+		if actual := c.IsIgnored(tag); actual != expected {
+			t.Errorf("IsIgnored(%q): expected %t, got %t", tag, expected, actual)
+		}
+	}
+
+	var nilConfig *TagsConfig
+	if nilConfig.IsIgnored("schematics:1") {
+		t.Error("Expected a nil config to ignore nothing")
+	}
+}
+
+func TestTagsConfigDefaults(t *testing.T) {
+	c := &TagsConfig{
+		DefaultTags:       []string{"env:prod", "owner:team"},
+		DefaultAccessTags: []string{"project:billing"},
+	}
+	if !c.IsDefault("ENV:prod", "") || !c.IsDefault("owner:team", "user") {
+		t.Error("Expected user tags to be defaults")
+	}
+	if c.IsDefault("env:prod", "access") || !c.IsDefault("project:billing", "access") {
+		t.Error("Expected access tags to be looked up separately")
+	}
+	if len(c.Defaults("service")) != 0 {
+		t.Error("Expected no default service tags")
+	}
+}
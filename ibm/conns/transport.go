@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	gohttp "net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// TransportConfig holds the provider level HTTP settings, applied to the clients of every service
+type TransportConfig struct {
+	// Proxy of the HTTP and HTTPS requests, defaults to the HTTP_PROXY and HTTPS_PROXY environment variables
+	HTTPProxy string
+	// Hosts reached without the proxy, defaults to the NO_PROXY environment variable
+	NoProxy string
+	// PEM file of the CAs trusted in addition to the system CAs, such as the CA of a TLS-intercepting proxy
+	CABundleFile string
+	// PEM files of the client certificate and key, for the mTLS endpoints
+	ClientCertificateFile string
+	ClientKeyFile         string
+	// Connection pooling, the connections are closed after every request unless KeepAlive is set
+	KeepAlive           bool
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	IdleConnTimeout     time.Duration
+}
+
+// DefaultIdleConnTimeout is the delay after which the idle connections are closed when it is not configured
+const DefaultIdleConnTimeout = 90 * time.Second
+
+// IsSet reports whether a setting differs from its default, the clients keep their default transport otherwise
+func (t *TransportConfig) IsSet() bool {
+	return t.HTTPProxy != "" || t.NoProxy != "" || t.CABundleFile != "" || t.ClientCertificateFile != "" || t.ClientKeyFile != "" ||
+		t.KeepAlive || t.MaxIdleConns != 0 || t.MaxIdleConnsPerHost != 0 || t.IdleConnTimeout != DefaultIdleConnTimeout
+}
+
+// NewTransport returns the transport of the settings
+func (t *TransportConfig) NewTransport() (*gohttp.Transport, error) {
+	proxy := gohttp.ProxyFromEnvironment
+	if t.HTTPProxy != "" || t.NoProxy != "" {
+		proxyConfig := httpproxy.FromEnvironment()
+		if t.HTTPProxy != "" {
+			proxyConfig.HTTPProxy = t.HTTPProxy
+			proxyConfig.HTTPSProxy = t.HTTPProxy
+		}
+		if t.NoProxy != "" {
+			proxyConfig.NoProxy = t.NoProxy
+		}
+		proxyFunc := proxyConfig.ProxyFunc()
+		proxy = func(r *gohttp.Request) (*url.URL, error) {
+			return proxyFunc(r.URL)
+		}
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if t.CABundleFile != "" {
+		pem, err := os.ReadFile(t.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error reading CA bundle file %s: %s", t.CABundleFile, err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("[ERROR] No PEM certificate found in CA bundle file %s", t.CABundleFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if t.ClientCertificateFile != "" || t.ClientKeyFile != "" {
+		if t.ClientCertificateFile == "" || t.ClientKeyFile == "" {
+			return nil, fmt.Errorf("[ERROR] client_certificate_file and client_key_file must be set together")
+		}
+		certificate, err := tls.LoadX509KeyPair(t.ClientCertificateFile, t.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error loading client certificate %s: %s", t.ClientCertificateFile, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	transport := &gohttp.Transport{
+		Proxy:               proxy,
+		TLSClientConfig:     tlsConfig,
+		TLSHandshakeTimeout: 20 * time.Second,
+		DisableKeepAlives:   !t.KeepAlive,
+		MaxIdleConns:        t.MaxIdleConns,
+		MaxIdleConnsPerHost: t.MaxIdleConnsPerHost,
+		IdleConnTimeout:     t.IdleConnTimeout,
+	}
+	if !t.KeepAlive {
+		transport.MaxIdleConnsPerHost = -1
+	}
+	return transport, nil
+}
+
+// transportOr returns transport, the transport of the provider HTTP settings, or defaultTransport
+// when the provider does not set any
+func transportOr(transport *gohttp.Transport, defaultTransport gohttp.RoundTripper) gohttp.RoundTripper {
+	if transport != nil {
+		return transport
+	}
+	return defaultTransport
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTransportConfigCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	if _, err := (&http.Client{Transport: mustNewTransport(t, &TransportConfig{})}).Get(server.URL); err == nil {
+		t.Fatal("Expected the certificate of the test server to be untrusted")
+	}
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := (&http.Client{Transport: mustNewTransport(t, &TransportConfig{CABundleFile: caFile})}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("Expected the CA bundle to be trusted: %s", err)
+	}
+	resp.Body.Close()
+
+	if err := os.WriteFile(caFile, []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (&TransportConfig{CABundleFile: caFile}).NewTransport(); err == nil || !strings.Contains(err.Error(), "No PEM certificate") {
+		t.Errorf("Expected an invalid CA bundle error, got %v", err)
+	}
+	if _, err := (&TransportConfig{ClientCertificateFile: caFile}).NewTransport(); err == nil {
+		t.Error("Expected client_key_file to be required with client_certificate_file")
+	}
+}
+
+func TestTransportConfigProxy(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.Host)
+	}))
+	defer proxy.Close()
+
+	transport := mustNewTransport(t, &TransportConfig{HTTPProxy: proxy.URL, NoProxy: "internal.example.com"})
+	for _, url := range []string{"http://vpc.example.com/v1", "http://internal.example.com"} {
+		if resp, err := (&http.Client{Transport: transport}).Get(url); err == nil {
+			resp.Body.Close()
+		}
+	}
+	if len(proxied) != 1 || proxied[0] != "vpc.example.com" {
+		t.Errorf("Expected only the request to vpc.example.com to be proxied, got %v", proxied)
+	}
+	if !transport.DisableKeepAlives || transport.MaxIdleConnsPerHost != -1 {
+		t.Error("Expected the connections to be closed after every request without keep_alive")
+	}
+}
+
+func TestTransportConfigIsSet(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config TransportConfig
+		want   bool
+	}{
+		{name: "defaults", config: TransportConfig{IdleConnTimeout: DefaultIdleConnTimeout}},
+		{name: "proxy", config: TransportConfig{HTTPProxy: "http://proxy.example.com", IdleConnTimeout: DefaultIdleConnTimeout}, want: true},
+		{name: "keep alive", config: TransportConfig{KeepAlive: true, IdleConnTimeout: DefaultIdleConnTimeout}, want: true},
+		{name: "max idle connections", config: TransportConfig{MaxIdleConns: 10, IdleConnTimeout: DefaultIdleConnTimeout}, want: true},
+		{name: "max idle connections per host", config: TransportConfig{MaxIdleConnsPerHost: 4, IdleConnTimeout: DefaultIdleConnTimeout}, want: true},
+		{name: "idle connection timeout", config: TransportConfig{IdleConnTimeout: 30 * time.Second}, want: true},
+		{name: "no idle connection timeout", config: TransportConfig{}, want: true},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.config.IsSet(); got != tc.want {
+			t.Errorf("%s: IsSet() = %t, want %t", tc.name, got, tc.want)
+		}
+	}
+}
+
+func mustNewTransport(t *testing.T, config *TransportConfig) *http.Transport {
+	transport, err := config.NewTransport()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return transport
+}
@@ -0,0 +1,296 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	gohttp "net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// TrustedProfile is the provider assume_trusted_profile block
+type TrustedProfile struct {
+	ID   string
+	CRN  string
+	Name string
+	// Account of the profile, required with Name when assuming the profile with an API key
+	AccountID string
+	// Compute resource token file, defaults to IBM_CR_TOKEN_FILENAME and the IKS service account token files
+	CRTokenFile string
+}
+
+// Identifier returns the ID, CRN or name of the profile
+func (p *TrustedProfile) Identifier() string {
+	for _, v := range []string{p.ID, p.CRN, p.Name} {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// The compute resource token files of the IKS pods, read when no token file is configured
+var defaultCRTokenFiles = []string{"/var/run/secrets/tokens/vault-token", "/var/run/secrets/tokens/sa-token"}
+
+// tokenAuthenticator is an authenticator which refreshes its IAM access token
+type tokenAuthenticator interface {
+	core.Authenticator
+	GetToken() (string, error)
+}
+
+// trustedProfileAuthenticator returns the authenticator assuming the trusted profile: with the API key
+// when it is set, else with the compute resource token of the IKS pod or the VPC instance metadata
+// service
+func (c *Config) trustedProfileAuthenticator(iamURL string, httpTransport *gohttp.Transport) (tokenAuthenticator, error) {
+	profile := c.AssumeTrustedProfile
+	client := &gohttp.Client{
+		Transport: NewRetryTransport(c.retryPolicy().ForService("iam"), transportOr(httpTransport, core.DefaultHTTPClient().Transport)),
+		Timeout:   30 * time.Second,
+	}
+
+	var authenticator tokenAuthenticator
+	if c.BluemixAPIKey != "" {
+		log.Printf("[INFO] Assuming trusted profile %s with the API key", profile.Identifier())
+		authenticator = &IamAssumeAuthenticator{
+			ApiKey:      c.BluemixAPIKey,
+			ProfileID:   profile.ID,
+			ProfileCRN:  profile.CRN,
+			ProfileName: profile.Name,
+			AccountID:   profile.AccountID,
+			URL:         iamURL,
+			Client:      client,
+		}
+	} else if crTokenFile := crTokenFilename(profile.CRTokenFile); crTokenFile != "" {
+		if profile.CRN != "" {
+			return nil, fmt.Errorf("[ERROR] Error assuming trusted profile %s: profile_crn is not supported with the compute resource token %s, set profile_id or profile_name", profile.Identifier(), crTokenFile)
+		}
+		log.Printf("[INFO] Assuming trusted profile %s with the compute resource token %s", profile.Identifier(), crTokenFile)
+		authenticator = &core.ContainerAuthenticator{
+			CRTokenFilename: crTokenFile,
+			IAMProfileID:    profile.ID,
+			IAMProfileName:  profile.Name,
+			URL:             iamURL,
+			Client:          client,
+		}
+	} else {
+		if profile.Name != "" {
+			return nil, fmt.Errorf("[ERROR] Error assuming trusted profile %s: profile_name is not supported with the VPC instance identity token, set profile_id or profile_crn", profile.Identifier())
+		}
+		log.Printf("[INFO] Assuming trusted profile %s with the VPC instance identity token", profile.Identifier())
+		authenticator = &core.VpcInstanceAuthenticator{
+			IAMProfileID:  profile.ID,
+			IAMProfileCRN: profile.CRN,
+			URL:           os.Getenv("IBMCLOUD_VPC_METADATA_ENDPOINT"),
+			Client:        client,
+		}
+	}
+	if err := authenticator.Validate(); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error assuming trusted profile %s: %s", profile.Identifier(), err)
+	}
+	return authenticator, nil
+}
+
+// crTokenFilename returns the compute resource token file, or "" when the provider does not run in a
+// compute resource with a token file
+func crTokenFilename(file string) string {
+	if file == "" {
+		file = os.Getenv("IBM_CR_TOKEN_FILENAME")
+	}
+	if file != "" {
+		return file
+	}
+	for _, f := range defaultCRTokenFiles {
+		if _, err := os.Stat(f); err == nil {
+			return f
+		}
+	}
+	return ""
+}
+
+// sharedTokenAuthenticator is the trusted profile authenticator shared by the clients of every service,
+// which requests the token under a mutex so that it is refreshed once when it expires
+type sharedTokenAuthenticator struct {
+	tokenAuthenticator
+	mutex sync.Mutex
+}
+
+// GetToken returns the token of the trusted profile
+func (a *sharedTokenAuthenticator) GetToken() (string, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.tokenAuthenticator.GetToken()
+}
+
+// Authenticate adds the token of the trusted profile to the request
+func (a *sharedTokenAuthenticator) Authenticate(request *gohttp.Request) error {
+	token, err := a.GetToken()
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// The headers of the IAM token sent by the bluemix-go, SoftLayer and Key Protect clients
+var tokenHeaders = []string{"Authorization", "X-Auth-User-Token"}
+
+// tokenTransport replaces the IAM token of the requests with the token of the trusted profile. The
+// bluemix-go, SoftLayer and Key Protect clients copy the token of the session when they are configured
+// and cannot refresh the token of a trusted profile
+type tokenTransport struct {
+	authenticator tokenAuthenticator
+	transport     gohttp.RoundTripper
+}
+
+// newTokenTransport returns the transport setting the token of the authenticator, or transport when
+// no trusted profile is assumed
+func newTokenTransport(authenticator tokenAuthenticator, transport gohttp.RoundTripper) gohttp.RoundTripper {
+	if authenticator == nil {
+		return transport
+	}
+	return &tokenTransport{authenticator: authenticator, transport: transport}
+}
+
+// RoundTrip sends the request with the current token of the trusted profile
+func (t *tokenTransport) RoundTrip(request *gohttp.Request) (*gohttp.Response, error) {
+	var token string
+	for _, header := range tokenHeaders {
+		if !strings.HasPrefix(request.Header.Get(header), "Bearer ") {
+			continue
+		}
+		if token == "" {
+			var err error
+			if token, err = t.authenticator.GetToken(); err != nil {
+				return nil, err
+			}
+			// The request of the client must not be modified
+			request = request.Clone(request.Context())
+		}
+		request.Header.Set(header, "Bearer "+token)
+	}
+	return t.transport.RoundTrip(request)
+}
+
+// IamAssumeAuthenticator exchanges the IAM token of an API key for the token of a trusted profile,
+// which it refreshes before it expires
+type IamAssumeAuthenticator struct {
+	ApiKey      string
+	ProfileID   string
+	ProfileCRN  string
+	ProfileName string
+	AccountID   string
+	URL         string
+	Client      *gohttp.Client
+
+	apiKeyAuthenticator *core.IamAuthenticator
+	token               string
+	expiration          time.Time
+	mutex               sync.Mutex
+}
+
+// AuthenticationType returns the authentication type of the authenticator
+func (a *IamAssumeAuthenticator) AuthenticationType() string {
+	return "iamAssume"
+}
+
+// Validate checks that the API key and exactly one of the profile ID, CRN and name are set
+func (a *IamAssumeAuthenticator) Validate() error {
+	if a.ApiKey == "" {
+		return fmt.Errorf("the API key is required to assume a trusted profile")
+	}
+	set := 0
+	for _, v := range []string{a.ProfileID, a.ProfileCRN, a.ProfileName} {
+		if v != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return fmt.Errorf("exactly one of profile_id, profile_crn and profile_name must be set")
+	}
+	if a.ProfileName != "" && a.AccountID == "" {
+		return fmt.Errorf("account_id is required with profile_name")
+	}
+	return nil
+}
+
+// Authenticate adds the token of the trusted profile to the request
+func (a *IamAssumeAuthenticator) Authenticate(request *gohttp.Request) error {
+	token, err := a.GetToken()
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// GetToken returns the token of the trusted profile, requesting a new one when less than a fifth of
+// its lifetime is left
+func (a *IamAssumeAuthenticator) GetToken() (string, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.token != "" && time.Now().Before(a.expiration) {
+		return a.token, nil
+	}
+	if a.apiKeyAuthenticator == nil {
+		a.apiKeyAuthenticator = &core.IamAuthenticator{ApiKey: a.ApiKey, URL: a.URL, Client: a.Client}
+	}
+	apiKeyToken, err := a.apiKeyAuthenticator.GetToken()
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{
+		"grant_type":   {"urn:ibm:params:oauth:grant-type:assume"},
+		"access_token": {apiKeyToken},
+	}
+	switch {
+	case a.ProfileID != "":
+		form.Set("profile_id", a.ProfileID)
+	case a.ProfileCRN != "":
+		form.Set("profile_crn", a.ProfileCRN)
+	default:
+		form.Set("profile_name", a.ProfileName)
+		form.Set("account", a.AccountID)
+	}
+	client := a.Client
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	}
+	iamURL := a.URL
+	if iamURL == "" {
+		iamURL = "https://iam.cloud.ibm.com"
+	}
+	resp, err := client.PostForm(strings.TrimSuffix(iamURL, "/")+"/identity/token", form)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != gohttp.StatusOK {
+		return "", fmt.Errorf("error assuming the trusted profile, status code %d: %s", resp.StatusCode, body)
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", fmt.Errorf("error parsing the trusted profile token: %s", err)
+	}
+	if token.ExpiresIn <= 0 {
+		token.ExpiresIn = 3600
+	}
+	a.token = token.AccessToken
+	a.expiration = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second * 4 / 5)
+	return a.token, nil
+}
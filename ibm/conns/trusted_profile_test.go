@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestIamAssumeAuthenticator(t *testing.T) {
+	var assumed int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Form.Get("grant_type") {
+		case "urn:ibm:params:oauth:grant-type:apikey":
+			// The API key token must be a JWT for the IamAuthenticator to read its expiration
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "eyJhbGciOiJub25lIn0.eyJleHAiOjQxMDI0NDQ4MDAsImlhdCI6MTcwMDAwMDAwMH0.",
+				"expires_in":   3600,
+				"expiration":   4102444800,
+			})
+		case "urn:ibm:params:oauth:grant-type:assume":
+			assumed++
+			if r.Form.Get("profile_name") != "ci" || r.Form.Get("account") != "acct" || r.Form.Get("access_token") == "" {
+				t.Errorf("Unexpected assume request %v", r.Form)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "profile-token", "expires_in": 3600})
+		default:
+			t.Errorf("Unexpected grant type %s", r.Form.Get("grant_type"))
+		}
+	}))
+	defer server.Close()
+
+	authenticator := &IamAssumeAuthenticator{ApiKey: "key", ProfileName: "ci", AccountID: "acct", URL: server.URL}
+	if err := authenticator.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		request, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+		if err := authenticator.Authenticate(request); err != nil {
+			t.Fatal(err)
+		}
+		if h := request.Header.Get("Authorization"); h != "Bearer profile-token" {
+			t.Errorf("Expected the profile token, got %s", h)
+		}
+	}
+	if assumed != 1 {
+		t.Errorf("Expected the cached token to be reused, got %d assume requests", assumed)
+	}
+
+	// The token is refreshed once it is about to expire
+	authenticator.expiration = time.Now().Add(-time.Second)
+	if _, err := authenticator.GetToken(); err != nil {
+		t.Fatal(err)
+	}
+	if assumed != 2 {
+		t.Errorf("Expected the expired token to be refreshed, got %d assume requests", assumed)
+	}
+
+	if err := (&IamAssumeAuthenticator{ApiKey: "key", ProfileName: "ci"}).Validate(); err == nil {
+		t.Error("Expected account_id to be required with profile_name")
+	}
+}
+
+func TestTrustedProfileAuthenticator(t *testing.T) {
+	c := &Config{BluemixAPIKey: "key", AssumeTrustedProfile: &TrustedProfile{ID: "Profile-1"}}
+	authenticator, err := c.trustedProfileAuthenticator("https://iam.example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := authenticator.(*IamAssumeAuthenticator); !ok {
+		t.Errorf("Expected the API key to be exchanged, got %T", authenticator)
+	}
+
+	tokenFile := filepath.Join(t.TempDir(), "cr-token")
+	if err := os.WriteFile(tokenFile, []byte("cr-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	c = &Config{AssumeTrustedProfile: &TrustedProfile{ID: "Profile-1", CRTokenFile: tokenFile}}
+	authenticator, err = c.trustedProfileAuthenticator("https://iam.example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if container, ok := authenticator.(*core.ContainerAuthenticator); !ok || container.CRTokenFilename != tokenFile {
+		t.Errorf("Expected the compute resource token to be used, got %+v", authenticator)
+	}
+
+	c = &Config{AssumeTrustedProfile: &TrustedProfile{CRN: "crn:v1:bluemix:public:iam-identity::a/acct::profile:Profile-1", CRTokenFile: tokenFile}}
+	if _, err = c.trustedProfileAuthenticator("https://iam.example.com", nil); err == nil {
+		t.Error("Expected profile_crn to be rejected with the compute resource token")
+	}
+
+	c = &Config{AssumeTrustedProfile: &TrustedProfile{CRN: "crn:v1:bluemix:public:iam-identity::a/acct::profile:Profile-1"}}
+	if os.Getenv("IBM_CR_TOKEN_FILENAME") == "" {
+		authenticator, err = c.trustedProfileAuthenticator("https://iam.example.com", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := authenticator.(*core.VpcInstanceAuthenticator); !ok {
+			t.Errorf("Expected the VPC instance metadata service to be used, got %T", authenticator)
+		}
+
+		c = &Config{AssumeTrustedProfile: &TrustedProfile{Name: "ci"}}
+		if _, err = c.trustedProfileAuthenticator("https://iam.example.com", nil); err == nil {
+			t.Error("Expected profile_name to be rejected with the VPC instance identity token")
+		}
+	}
+}
+
+// countingAuthenticator returns a new token on every request
+type countingAuthenticator struct {
+	core.NoAuthAuthenticator
+	tokens int
+}
+
+func (a *countingAuthenticator) GetToken() (string, error) {
+	a.tokens++
+	return fmt.Sprintf("token-%d", a.tokens), nil
+}
+
+func TestTokenTransport(t *testing.T) {
+	var headers []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Clone())
+	}))
+	defer server.Close()
+
+	authenticator := &sharedTokenAuthenticator{tokenAuthenticator: &countingAuthenticator{}}
+	client := &http.Client{Transport: newTokenTransport(authenticator, http.DefaultTransport)}
+	for _, header := range []string{"Authorization", "X-Auth-User-Token"} {
+		request, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		request.Header.Set(header, "Bearer stale")
+		if _, err := client.Do(request); err != nil {
+			t.Fatal(err)
+		}
+		if h := request.Header.Get(header); h != "Bearer stale" {
+			t.Errorf("Expected the request of the client to be kept, got %s", h)
+		}
+	}
+	if h := headers[0].Get("Authorization"); h != "Bearer token-1" {
+		t.Errorf("Expected the current token, got %s", h)
+	}
+	if h := headers[1].Get("X-Auth-User-Token"); h != "Bearer token-2" {
+		t.Errorf("Expected the current token, got %s", h)
+	}
+
+	// The requests without an IAM token, such as the UAA requests, are sent as they are
+	request, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	request.Header.Set("Authorization", "Basic Yng6Yng=")
+	if _, err := client.Do(request); err != nil {
+		t.Fatal(err)
+	}
+	if h := headers[2].Get("Authorization"); h != "Basic Yng6Yng=" {
+		t.Errorf("Expected the basic authorization to be kept, got %s", h)
+	}
+
+	if transport := newTokenTransport(nil, http.DefaultTransport); transport != http.DefaultTransport {
+		t.Errorf("Expected the transport to be kept without a trusted profile, got %T", transport)
+	}
+}
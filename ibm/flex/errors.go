@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev3 "github.com/IBM/go-sdk-core/v3/core"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// ServiceError is an error returned by an IBM Cloud API, with the details IBM Cloud support
+// asks for: the trace, the error codes and the request and correlation IDs.
+type ServiceError struct {
+	Summary       string
+	Message       string
+	StatusCode    int
+	Trace         string
+	Codes         []string
+	MoreInfo      []string
+	Targets       []string
+	RequestID     string
+	CorrelationID string
+	// AttributePath is the argument the error is about, if known
+	AttributePath cty.Path
+	Err           error
+}
+
+// NewServiceError builds a ServiceError from the error and the response of an IBM Cloud SDK call.
+// The summary describes the operation which failed, for example "Error creating VPC".
+func NewServiceError(summary string, err error, response *core.DetailedResponse) *ServiceError {
+	serviceError := &ServiceError{
+		Summary: strings.TrimPrefix(summary, "[ERROR] "),
+		Err:     err,
+	}
+	if err != nil {
+		serviceError.Message = err.Error()
+	}
+	if response == nil {
+		return serviceError
+	}
+	serviceError.StatusCode = response.StatusCode
+	if response.Headers != nil {
+		serviceError.RequestID = response.Headers.Get("X-Request-Id")
+		serviceError.CorrelationID = response.Headers.Get("X-Correlation-Id")
+	}
+
+	result, ok := response.Result.(map[string]interface{})
+	if !ok && len(response.RawResult) > 0 {
+		json.Unmarshal(response.RawResult, &result)
+	}
+	for _, key := range []string{"trace", "trace_id", "incidentID"} {
+		if trace, ok := result[key].(string); ok && trace != "" {
+			serviceError.Trace = trace
+			break
+		}
+	}
+	errors, _ := result["errors"].([]interface{})
+	for _, e := range errors {
+		apiError, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if code, ok := apiError["code"].(string); ok && code != "" {
+			serviceError.Codes = append(serviceError.Codes, code)
+		}
+		if moreInfo, ok := apiError["more_info"].(string); ok && moreInfo != "" {
+			serviceError.MoreInfo = append(serviceError.MoreInfo, moreInfo)
+		}
+		if target, ok := apiError["target"].(map[string]interface{}); ok {
+			if name, ok := target["name"].(string); ok && name != "" {
+				serviceError.Targets = append(serviceError.Targets, name)
+			}
+		}
+		if message, ok := apiError["message"].(string); ok && serviceError.Message == "" {
+			serviceError.Message = message
+		}
+	}
+	return serviceError
+}
+
+// NewServiceErrorV3 is NewServiceError for the SDKs built on version 3 of the IBM Go SDK core
+func NewServiceErrorV3(summary string, err error, response *corev3.DetailedResponse) *ServiceError {
+	if response == nil {
+		return NewServiceError(summary, err, nil)
+	}
+	return NewServiceError(summary, err, &core.DetailedResponse{
+		StatusCode: response.StatusCode,
+		Headers:    response.Headers,
+		Result:     response.Result,
+	})
+}
+
+// WithAttribute sets the path of the argument which failed, so that Terraform
+// points at it in the configuration, for example cty.GetAttrPath("profile")
+func (e *ServiceError) WithAttribute(path cty.Path) *ServiceError {
+	e.AttributePath = path
+	return e
+}
+
+// Detail lists the status code, the error codes, the trace and the request IDs
+func (e *ServiceError) Detail() string {
+	var lines []string
+	if e.StatusCode != 0 {
+		lines = append(lines, fmt.Sprintf("Status code: %d", e.StatusCode))
+	}
+	if len(e.Codes) > 0 {
+		lines = append(lines, fmt.Sprintf("Error code: %s", strings.Join(e.Codes, ", ")))
+	}
+	if len(e.Targets) > 0 {
+		lines = append(lines, fmt.Sprintf("Target: %s", strings.Join(e.Targets, ", ")))
+	}
+	if len(e.MoreInfo) > 0 {
+		lines = append(lines, fmt.Sprintf("More info: %s", strings.Join(e.MoreInfo, ", ")))
+	}
+	if e.Trace != "" {
+		lines = append(lines, fmt.Sprintf("Trace: %s", e.Trace))
+	}
+	if e.RequestID != "" {
+		lines = append(lines, fmt.Sprintf("X-Request-Id: %s", e.RequestID))
+	}
+	if e.CorrelationID != "" {
+		lines = append(lines, fmt.Sprintf("X-Correlation-Id: %s", e.CorrelationID))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (e *ServiceError) summary() string {
+	if e.Message == "" {
+		return e.Summary
+	}
+	if e.Summary == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Summary, e.Message)
+}
+
+func (e *ServiceError) Error() string {
+	if detail := e.Detail(); detail != "" {
+		return fmt.Sprintf("%s\n%s", e.summary(), detail)
+	}
+	return e.summary()
+}
+
+func (e *ServiceError) Unwrap() error {
+	return e.Err
+}
+
+// Diagnostic returns the error as a Terraform diagnostic
+func (e *ServiceError) Diagnostic() diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       e.summary(),
+		Detail:        e.Detail(),
+		AttributePath: e.AttributePath,
+	}
+}
+
+// Diagnostics returns the error as Terraform diagnostics, to be returned by the CRUD functions
+func (e *ServiceError) Diagnostics() diag.Diagnostics {
+	return diag.Diagnostics{e.Diagnostic()}
+}
+
+// DiagFromErr is diag.FromErr keeping the detail of the ServiceError wrapped by err, if any
+func DiagFromErr(err error) diag.Diagnostics {
+	var serviceError *ServiceError
+	if errors.As(err, &serviceError) {
+		if serviceError == err {
+			return serviceError.Diagnostics()
+		}
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       strings.TrimSuffix(strings.TrimPrefix(err.Error(), "[ERROR] "), "\n"+serviceError.Detail()),
+			Detail:        serviceError.Detail(),
+			AttributePath: serviceError.AttributePath,
+		}}
+	}
+	return diag.FromErr(err)
+}
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	corev3 "github.com/IBM/go-sdk-core/v3/core"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestNewServiceError(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("X-Request-Id", "request-1")
+	headers.Set("X-Correlation-Id", "correlation-1")
+	response := &core.DetailedResponse{
+		StatusCode: 400,
+		Headers:    headers,
+		Result: map[string]interface{}{
+			"trace": "trace-1",
+			"errors": []interface{}{
+				map[string]interface{}{
+					"code":      "validation_invalid_zone",
+					"message":   "The zone is invalid",
+					"more_info": "https://cloud.ibm.com/docs/vpc",
+					"target":    map[string]interface{}{"name": "zone.name", "type": "field"},
+				},
+			},
+		},
+	}
+	serviceError := NewServiceError("[ERROR] Error creating instance", errors.New("The zone is invalid"), response)
+
+	d := serviceError.Diagnostic()
+	if d.Severity != diag.Error || d.Summary != "Error creating instance: The zone is invalid" {
+		t.Errorf("Unexpected diagnostic summary %q", d.Summary)
+	}
+	for _, expected := range []string{"Status code: 400", "Error code: validation_invalid_zone", "Target: zone.name", "More info: https://cloud.ibm.com/docs/vpc", "Trace: trace-1", "X-Request-Id: request-1", "X-Correlation-Id: correlation-1"} {
+		if !strings.Contains(d.Detail, expected) {
+			t.Errorf("Expected %q in the diagnostic detail %q", expected, d.Detail)
+		}
+	}
+	if !strings.Contains(serviceError.Error(), "X-Request-Id: request-1") {
+		t.Errorf("Expected the request ID in the error %q", serviceError.Error())
+	}
+}
+
+func TestNewServiceErrorWithoutResponse(t *testing.T) {
+	err := errors.New("connection refused")
+	serviceError := NewServiceError("Error getting VPC", err, nil)
+	if serviceError.Error() != "Error getting VPC: connection refused" {
+		t.Errorf("Unexpected error %q", serviceError.Error())
+	}
+	if !errors.Is(serviceError, err) {
+		t.Error("Expected the service error to wrap the SDK error")
+	}
+}
+
+func TestNewServiceErrorV3(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("X-Request-Id", "request-1")
+	response := &corev3.DetailedResponse{
+		StatusCode: 404,
+		Headers:    headers,
+		Result:     map[string]interface{}{"trace": "trace-1"},
+	}
+	detail := NewServiceErrorV3("Error getting endpoint", errors.New("Not Found"), response).Detail()
+	if detail != "Status code: 404\nTrace: trace-1\nX-Request-Id: request-1" {
+		t.Errorf("Unexpected detail %q", detail)
+	}
+	if NewServiceErrorV3("Error getting endpoint", errors.New("Not Found"), nil).Detail() != "" {
+		t.Error("Expected no detail without a response")
+	}
+}
+
+func TestDiagFromErr(t *testing.T) {
+	serviceError := NewServiceError("Error getting VPC", errors.New("Not Found"), &core.DetailedResponse{StatusCode: 404})
+
+	d := DiagFromErr(serviceError)
+	if len(d) != 1 || d[0].Summary != "Error getting VPC: Not Found" || d[0].Detail != "Status code: 404" {
+		t.Errorf("Unexpected diagnostics %#v", d)
+	}
+	d = DiagFromErr(fmt.Errorf("[ERROR] Error waiting for VPC: %w", serviceError))
+	if len(d) != 1 || d[0].Summary != "Error waiting for VPC: Error getting VPC: Not Found" || d[0].Detail != "Status code: 404" {
+		t.Errorf("Unexpected diagnostics for a wrapped error %#v", d)
+	}
+	d = DiagFromErr(errors.New("timeout"))
+	if len(d) != 1 || d[0].Summary != "timeout" || d[0].Detail != "" {
+		t.Errorf("Unexpected diagnostics for an error %#v", d)
+	}
+}
+
+func TestServiceErrorWithAttribute(t *testing.T) {
+	path := cty.GetAttrPath("primary_ip").IndexInt(0).GetAttr("auto_delete")
+	serviceError := NewServiceError("Error updating reserved IP", errors.New("Bad Request"), &core.DetailedResponse{StatusCode: 400}).WithAttribute(path)
+
+	d := serviceError.Diagnostics()
+	if len(d) != 1 || !d[0].AttributePath.Equals(path) {
+		t.Errorf("Expected the attribute path in the diagnostic %#v", d)
+	}
+	d = DiagFromErr(fmt.Errorf("[ERROR] Error updating virtual network interface: %w", serviceError))
+	if len(d) != 1 || !d[0].AttributePath.Equals(path) {
+		t.Errorf("Expected the attribute path in the diagnostic of a wrapped error %#v", d)
+	}
+	if d := NewServiceError("Error getting VPC", errors.New("Not Found"), nil).Diagnostic(); d.AttributePath != nil {
+		t.Errorf("Expected no attribute path %#v", d.AttributePath)
+	}
+}
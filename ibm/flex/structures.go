@@ -2448,7 +2448,7 @@ func GetGlobalTagsUsingSearchAPI(meta interface{}, resourceID, resourceType, tag
 	options.SetFields([]string{"access_tags", "tags", "service_tags"})
 	result, resp, err := gsClient.Search(&options)
 	if err != nil {
-		return nil, fmt.Errorf("[ERROR] Error to query the tags for the resource: %s %s", err, resp)
+		return nil, NewServiceError("Error to query the tags for the resource", err, resp)
 	}
 	var taglist []string
 	var t interface{}
@@ -2460,12 +2460,16 @@ func GetGlobalTagsUsingSearchAPI(meta interface{}, resourceID, resourceType, tag
 		} else {
 			t = result.Items[0].GetProperty("tags")
 		}
+		tagsConfig := providerTagsConfig(meta)
 		switch reflect.TypeOf(t).Kind() {
 		case reflect.Slice:
 			s := reflect.ValueOf(t)
 
 			for i := 0; i < s.Len(); i++ {
 				t := fmt.Sprintf("%s", (s.Index(i)))
+				if tagsConfig.IsIgnored(t) {
+					continue
+				}
 				taglist = append(taglist, t)
 			}
 		}
@@ -2473,6 +2477,162 @@ func GetGlobalTagsUsingSearchAPI(meta interface{}, resourceID, resourceType, tag
 	return NewStringSet(ResourceIBMVPCHash, taglist), nil
 }
 
+func providerTagsConfig(meta interface{}) *conns.TagsConfig {
+	if sess, ok := meta.(conns.ClientSession); ok {
+		return sess.TagsConfig()
+	}
+	return nil
+}
+
+// ResourceTagsAll and ResourceAccessTagsAll are the attributes of the user and
+// access tags attached to a resource, including the provider default_tags
+const (
+	ResourceTagsAll       = "tags_all"
+	ResourceAccessTagsAll = "access_tags_all"
+)
+
+// tagsAllKey returns the attribute of all the attached tags of tagType
+func tagsAllKey(tagType string) string {
+	if strings.TrimSpace(tagType) == "access" {
+		return ResourceAccessTagsAll
+	}
+	return ResourceTagsAll
+}
+
+// HasTagsToAttach reports whether tags are attached to a new resource: its
+// own tags, the provider default_tags or the IC_ENV_TAGS tags
+func HasTagsToAttach(d *schema.ResourceData, meta interface{}, tagsKey string) bool {
+	if _, ok := d.GetOk(tagsKey); ok {
+		return true
+	}
+	return os.Getenv("IC_ENV_TAGS") != "" || len(providerTagsConfig(meta).Defaults("user")) > 0
+}
+
+// HasAccessTagsToAttach reports whether access tags are attached to a new
+// resource: its own access tags or the provider default access tags
+func HasAccessTagsToAttach(d *schema.ResourceData, meta interface{}, accessTagsKey string) bool {
+	if _, ok := d.GetOk(accessTagsKey); ok {
+		return true
+	}
+	return len(providerTagsConfig(meta).Defaults("access")) > 0
+}
+
+// TagsHasChange reports whether the tags attached to a resource must be
+// updated, because its tags or its planned tags_all changed
+func TagsHasChange(d *schema.ResourceData, tagsKey string) bool {
+	return d.HasChange(tagsKey) || d.HasChange(ResourceTagsAll)
+}
+
+// AccessTagsHasChange reports whether the access tags attached to a resource
+// must be updated, because its access tags or its planned access_tags_all changed
+func AccessTagsHasChange(d *schema.ResourceData, accessTagsKey string) bool {
+	return d.HasChange(accessTagsKey) || d.HasChange(ResourceAccessTagsAll)
+}
+
+// SetTagsAll sets tags_all from the tags attached to a resource, so that a
+// drift of the default tags shows in the plan
+func SetTagsAll(d *schema.ResourceData, tags interface{}) {
+	setTagsAll(d, ResourceTagsAll, tags)
+}
+
+// SetAccessTagsAll sets access_tags_all from the access tags attached to a resource
+func SetAccessTagsAll(d *schema.ResourceData, tags interface{}) {
+	setTagsAll(d, ResourceAccessTagsAll, tags)
+}
+
+func setTagsAll(d *schema.ResourceData, key string, tags interface{}) {
+	if tags == nil {
+		return
+	}
+	if list, ok := tags.([]string); ok {
+		tags = NewStringSet(ResourceIBMVPCHash, list)
+	}
+	if err := d.Set(key, tags); err != nil {
+		log.Printf("[ERROR] Error setting %s: %s", key, err)
+	}
+}
+
+// SetResourceTags sets the tags of tagType attached to a resource: tagsKey
+// keeps the configured tags only, without the provider default_tags the
+// resource does not configure itself, and tags_all or access_tags_all gets all
+// of them. This keeps the default tags from showing as a drift of tagsKey.
+// The tags matching the provider ignore_tags are left out of both.
+func SetResourceTags(d *schema.ResourceData, meta interface{}, tagsKey, tagType string, tags interface{}) {
+	if tags == nil {
+		return
+	}
+	list, ok := tags.([]string)
+	if !ok {
+		list = ExpandStringList(tags.(*schema.Set).List())
+	}
+	tagsConfig := providerTagsConfig(meta)
+	configured := NewStringSet(ResourceIBMVPCHash, []string{})
+	if v, ok := d.Get(tagsKey).(*schema.Set); ok {
+		configured = v
+	}
+	attached := NewStringSet(ResourceIBMVPCHash, []string{})
+	kept := NewStringSet(ResourceIBMVPCHash, []string{})
+	for _, v := range list {
+		if tagsConfig.IsIgnored(v) {
+			continue
+		}
+		attached.Add(v)
+		if tagsConfig.IsDefault(v, tagType) && !configured.Contains(v) {
+			continue
+		}
+		kept.Add(v)
+	}
+	if err := d.Set(tagsKey, kept); err != nil {
+		log.Printf("[ERROR] Error setting %s: %s", tagsKey, err)
+	}
+	setTagsAll(d, tagsAllKey(tagType), attached)
+}
+
+// FilterIgnoredTags returns tags without the tags matching the provider ignore_tags
+func FilterIgnoredTags(meta interface{}, tags []string) []string {
+	tagsConfig := providerTagsConfig(meta)
+	filtered := make([]string, 0, len(tags))
+	for _, v := range tags {
+		if !tagsConfig.IsIgnored(v) {
+			filtered = append(filtered, v)
+		}
+	}
+	return filtered
+}
+
+// WithDefaultUserTags returns the user tags of a resource which sets its
+// user_tags itself, merged with the provider default_tags
+func WithDefaultUserTags(meta interface{}, tags []string) []string {
+	merged := append(make([]string, 0, len(tags)), tags...)
+	set := NewStringSet(ResourceIBMVPCHash, tags)
+	for _, v := range providerTagsConfig(meta).Defaults("user") {
+		if !set.Contains(v) {
+			set.Add(v)
+			merged = append(merged, v)
+		}
+	}
+	return merged
+}
+
+// mergeProviderTags adds the provider default_tags to the tags to attach and keeps
+// the default and ignored tags out of the tags to detach
+func mergeProviderTags(meta interface{}, tagType string, add, remove []string) ([]string, []string) {
+	tagsConfig := providerTagsConfig(meta)
+	for _, v := range tagsConfig.Defaults(tagType) {
+		if !NewStringSet(ResourceIBMVPCHash, add).Contains(v) {
+			add = append(add, v)
+		}
+	}
+	keep := make([]string, 0, len(remove))
+	for _, v := range remove {
+		if tagsConfig.IsDefault(v, tagType) || tagsConfig.IsIgnored(v) {
+			continue
+		}
+		keep = append(keep, v)
+	}
+	return add, keep
+}
+
 func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, resourceID, resourceType, tagType string) error {
 	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
 	if err != nil {
@@ -2516,6 +2676,7 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 			add = append(add, envTags...)
 		}
 	}
+	add, remove = mergeProviderTags(meta, tagType, add, remove)
 
 	if len(remove) > 0 {
 		detachTagOptions := &globaltaggingv1.DetachTagOptions{}
@@ -2530,7 +2691,7 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 
 		_, resp, err := gtClient.DetachTag(detachTagOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error detaching database tags %v: %s\n%s", remove, err, resp)
+			return NewServiceError(fmt.Sprintf("Error detaching database tags %v", remove), err, resp)
 		}
 		for _, v := range remove {
 			delTagOptions := &globaltaggingv1.DeleteTagOptions{
@@ -2538,7 +2699,7 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 			}
 			_, resp, err := gtClient.DeleteTag(delTagOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error deleting database tag %v: %s\n%s", v, err, resp)
+				return NewServiceError(fmt.Sprintf("Error deleting database tag %v", v), err, resp)
 			}
 		}
 	}
@@ -2556,7 +2717,7 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 
 		_, resp, err := gtClient.AttachTag(AttachTagOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating database tags %v : %s\n%s", add, err, resp)
+			return NewServiceError(fmt.Sprintf("Error updating database tags %v", add), err, resp)
 		}
 	}
 
@@ -2616,6 +2777,7 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 		envTags = strings.Split(schematicTags, ",")
 		add = append(add, envTags...)
 	}
+	add, remove = mergeProviderTags(meta, "user", add, remove)
 
 	if len(remove) > 0 {
 		_, err := gtClient.Tags().DetachTags(resourceCRN, remove)
@@ -2659,7 +2821,11 @@ func FlattenSSLCiphers(ciphers []datatypes.Network_LBaaS_SSLCipher) *schema.Set
 	return NewStringSet(schema.HashString, c)
 }
 
-func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
+func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	tagsConfig := providerTagsConfig(meta)
+	if err := resourceTagsAllCustomizeDiff(diff, tagsConfig); err != nil {
+		return err
+	}
 
 	if diff.Id() != "" && diff.HasChange("tags") {
 		o, n := diff.GetChange("tags")
@@ -2674,10 +2840,79 @@ func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
 				return diff.Clear("tags")
 			}
 		}
+		if len(addInt) == 0 && isProviderManagedTags(tagsConfig, "user", removeInt) {
+			log.Printf("[DEBUG] Suppressing the diff of the default and ignored tags %v", removeInt)
+			if err := diff.Clear("tags"); err != nil {
+				return err
+			}
+		}
+	}
+	if diff.Id() != "" && diff.HasChange("access_tags") {
+		o, n := diff.GetChange("access_tags")
+		removeInt := o.(*schema.Set).Difference(n.(*schema.Set)).List()
+		addInt := n.(*schema.Set).Difference(o.(*schema.Set)).List()
+		if len(addInt) == 0 && isProviderManagedTags(tagsConfig, "access", removeInt) {
+			log.Printf("[DEBUG] Suppressing the diff of the default and ignored access tags %v", removeInt)
+			return diff.Clear("access_tags")
+		}
 	}
 	return nil
 }
 
+// isProviderManagedTags reports whether every tag is either a provider default tag
+// or matches the provider ignore_tags
+func isProviderManagedTags(tagsConfig *conns.TagsConfig, tagType string, tags []interface{}) bool {
+	if len(tags) == 0 {
+		return false
+	}
+	for _, v := range tags {
+		if !tagsConfig.IsDefault(v.(string), tagType) && !tagsConfig.IsIgnored(v.(string)) {
+			return false
+		}
+	}
+	return true
+}
+
+// resourceTagsAllCustomizeDiff plans tags_all and access_tags_all as the
+// configured tags merged with the provider default_tags, which are the tag
+// sets attached to the resource on apply
+func resourceTagsAllCustomizeDiff(diff *schema.ResourceDiff, tagsConfig *conns.TagsConfig) error {
+	if err := planTagsAll(diff, tagsConfig, "tags", "user"); err != nil {
+		return err
+	}
+	return planTagsAll(diff, tagsConfig, "access_tags", "access")
+}
+
+func planTagsAll(diff *schema.ResourceDiff, tagsConfig *conns.TagsConfig, tagsKey, tagType string) error {
+	key := tagsAllKey(tagType)
+	// Only the resources exporting tags_all or access_tags_all get it planned
+	oldTagsAll, _ := diff.GetChange(key)
+	if oldTagsAll == nil {
+		return nil
+	}
+	if !diff.NewValueKnown(tagsKey) {
+		return diff.SetNewComputed(key)
+	}
+	var tagsAll []string
+	if v, ok := diff.GetOk(tagsKey); ok {
+		tagsAll = ExpandStringList(v.(*schema.Set).List())
+	}
+	tagsAll = append(tagsAll, tagsConfig.Defaults(tagType)...)
+	if v := os.Getenv("IC_ENV_TAGS"); v != "" && tagType == "user" {
+		tagsAll = append(tagsAll, strings.Split(v, ",")...)
+	}
+	newSet := NewStringSet(ResourceIBMVPCHash, []string{})
+	for _, tag := range tagsAll {
+		if !tagsConfig.IsIgnored(tag) {
+			newSet.Add(tag)
+		}
+	}
+	if oldTagsAll.(*schema.Set).Equal(newSet) {
+		return nil
+	}
+	return diff.SetNew(key, newSet)
+}
+
 func ResourceValidateAccessTags(diff *schema.ResourceDiff, meta interface{}) error {
 
 	if value, ok := diff.GetOkExists("access_tags"); ok {
@@ -3062,7 +3297,7 @@ func DefaultResourceGroup(meta interface{}) (string, error) {
 	}
 	grpList, resp, err := rMgtClient.ListResourceGroups(&resourceGroupList)
 	if err != nil || grpList == nil || grpList.Resources == nil {
-		return "", fmt.Errorf("[ERROR] Error retrieving resource group: %s %s", err, resp)
+		return "", NewServiceError("Error retrieving resource group", err, resp)
 	}
 	if len(grpList.Resources) <= 0 {
 		return "", fmt.Errorf("[ERROR] The default resource group could not be found. Make sure you have required permissions to access the resource group")
@@ -3306,37 +3541,6 @@ func FlattenSatelliteZones(zones *schema.Set) []string {
 	return zoneList
 }
 
-// error object
-type ServiceErrorResponse struct {
-	Message    string
-	StatusCode int
-	Result     interface{}
-}
-
-func BeautifyError(err error, response *core.DetailedResponse) *ServiceErrorResponse {
-	var (
-		statusCode int
-		result     interface{}
-	)
-	if response != nil {
-		statusCode = response.StatusCode
-		result = response.Result
-	}
-	return &ServiceErrorResponse{
-		Message:    err.Error(),
-		StatusCode: statusCode,
-		Result:     result,
-	}
-}
-
-func (response *ServiceErrorResponse) String() string {
-	output, err := json.MarshalIndent(response, "", "    ")
-	if err == nil {
-		return fmt.Sprintf("%+v\n", string(output))
-	}
-	return fmt.Sprintf("Error : %#v", response)
-}
-
 // IAM Policy Management
 func GetResourceAttribute(name string, r iampolicymanagementv1.PolicyResource) *string {
 	for _, a := range r.Attributes {
@@ -4268,7 +4472,7 @@ func FetchResourceInstanceDetails(d *schema.ResourceData, meta interface{}, inst
 	instance, response, err := resourceControllerClient.GetResourceInstance(&getResourceOpts)
 	if err != nil {
 		log.Printf("[DEBUG] Error retrieving resource instance: %s\n%s", err, response)
-		return fmt.Errorf("Error retrieving resource instance: %s\n%s", err, response)
+		return NewServiceError("Error retrieving resource instance", err, response)
 	}
 	if strings.Contains(*instance.State, "removed") {
 		log.Printf("[DEBUG] Error retrieving resource instance details: Resource has been removed")
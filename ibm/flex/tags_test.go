@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type tagsTestSession struct {
+	conns.ClientSession
+	tagsConfig *conns.TagsConfig
+}
+
+func (s tagsTestSession) TagsConfig() *conns.TagsConfig {
+	return s.tagsConfig
+}
+
+func TestSetResourceTags(t *testing.T) {
+	tagsSchema := &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Set:      ResourceIBMVPCHash,
+	}
+	resourceSchema := map[string]*schema.Schema{
+		"tags":                tagsSchema,
+		ResourceTagsAll:       tagsSchema,
+		"access_tags":         tagsSchema,
+		ResourceAccessTagsAll: tagsSchema,
+	}
+	meta := tagsTestSession{tagsConfig: &conns.TagsConfig{
+		DefaultTags:       []string{"env:prod", "owner:network"},
+		DefaultAccessTags: []string{"project:a"},
+		IgnoreKeys:        []string{"schematics"},
+	}}
+
+	testCases := []struct {
+		name       string
+		tagsKey    string
+		tagType    string
+		configured []interface{}
+		attached   interface{}
+		tags       []string
+		tagsAll    []string
+	}{
+		{
+			name:       "default tags only in tags_all",
+			tagsKey:    "tags",
+			tagType:    "user",
+			configured: []interface{}{"app:web"},
+			attached:   []string{"app:web", "env:prod", "owner:network"},
+			tags:       []string{"app:web"},
+			tagsAll:    []string{"app:web", "env:prod", "owner:network"},
+		},
+		{
+			name:       "configured default tag kept in tags",
+			tagsKey:    "tags",
+			tagType:    "user",
+			configured: []interface{}{"env:prod"},
+			attached:   []string{"env:prod", "owner:network"},
+			tags:       []string{"env:prod"},
+			tagsAll:    []string{"env:prod", "owner:network"},
+		},
+		{
+			name:     "ignored tags left out",
+			tagsKey:  "tags",
+			tagType:  "user",
+			attached: NewStringSet(ResourceIBMVPCHash, []string{"schematics:ws", "app:web", "env:prod"}),
+			tags:     []string{"app:web"},
+			tagsAll:  []string{"app:web", "env:prod"},
+		},
+		{
+			name:       "access tags",
+			tagsKey:    "access_tags",
+			tagType:    "access",
+			configured: []interface{}{"project:b"},
+			attached:   []string{"project:a", "project:b"},
+			tags:       []string{"project:b"},
+			tagsAll:    []string{"project:a", "project:b"},
+		},
+	}
+
+	for _, tc := range testCases {
+		d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{tc.tagsKey: tc.configured})
+		SetResourceTags(d, meta, tc.tagsKey, tc.tagType, tc.attached)
+
+		if got := tagsTestList(d.Get(tc.tagsKey)); !reflect.DeepEqual(got, tc.tags) {
+			t.Errorf("%s: %s = %v, want %v", tc.name, tc.tagsKey, got, tc.tags)
+		}
+		if got := tagsTestList(d.Get(tagsAllKey(tc.tagType))); !reflect.DeepEqual(got, tc.tagsAll) {
+			t.Errorf("%s: %s = %v, want %v", tc.name, tagsAllKey(tc.tagType), got, tc.tagsAll)
+		}
+	}
+}
+
+func tagsTestList(v interface{}) []string {
+	list := ExpandStringList(v.(*schema.Set).List())
+	sort.Strings(list)
+	return list
+}
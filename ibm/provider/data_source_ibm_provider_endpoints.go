@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceIBMProviderEndpoints reports the endpoints resolved by the provider from its endpoints
+// block, the environment variables, the endpoints file and the visibility
+func DataSourceIBMProviderEndpoints() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMProviderEndpointsRead,
+
+		Schema: map[string]*schema.Schema{
+			"endpoints": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The endpoint used by the provider, by service",
+			},
+		},
+	}
+}
+
+func dataSourceIBMProviderEndpointsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if _, err := meta.(conns.ClientSession).BluemixSession(); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(time.Now().UTC().String())
+	if err := d.Set("endpoints", meta.(conns.ClientSession).ServiceEndpoints()); err != nil {
+		return diag.Errorf("[ERROR] Error setting endpoints: %s", err)
+	}
+	return nil
+}
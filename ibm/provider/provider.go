@@ -4,11 +4,13 @@
 package provider
 
 import (
+	"fmt"
 	"os"
 	"sync"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/apigateway"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appconfiguration"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appid"
@@ -55,6 +57,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Provider returns a *schema.Provider.
@@ -215,6 +218,233 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of the file of the named profiles, defaults to ~/.ibmcloud/credentials. The IBM Cloud CLI config.json is also supported",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_CONFIG_FILE", "IBMCLOUD_CONFIG_FILE"}, nil),
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The profile of the config file, whose settings are used when not set in the provider block or the environment",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_PROFILE", "IBMCLOUD_PROFILE"}, nil),
+			},
+			"http_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The proxy of the API calls, defaults to the HTTPS_PROXY and HTTP_PROXY environment variables",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_HTTP_PROXY", "IBMCLOUD_HTTP_PROXY"}, nil),
+			},
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comma separated hosts, domains and CIDRs reached without the proxy, defaults to the NO_PROXY environment variable",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_NO_PROXY", "IBMCLOUD_NO_PROXY"}, nil),
+			},
+			"ca_bundle_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM file of the CAs trusted in addition to the system CAs, such as the CA of a TLS-intercepting proxy",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_CA_BUNDLE_FILE", "IBMCLOUD_CA_BUNDLE_FILE"}, nil),
+			},
+			"client_certificate_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client_key_file"},
+				Description:  "PEM file of the client certificate presented to the endpoints requiring mTLS",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_CLIENT_CERTIFICATE_FILE", "IBMCLOUD_CLIENT_CERTIFICATE_FILE"}, nil),
+			},
+			"client_key_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client_certificate_file"},
+				Description:  "PEM file of the key of the client certificate",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_CLIENT_KEY_FILE", "IBMCLOUD_CLIENT_KEY_FILE"}, nil),
+			},
+			"keep_alive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Reuse the connections of the API calls, which are closed after every call by default",
+			},
+			"max_idle_connections": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of idle connections kept open with keep_alive, 0 means no limit",
+			},
+			"max_idle_connections_per_host": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of idle connections kept open per host with keep_alive, defaults to 2",
+			},
+			"idle_connection_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(conns.DefaultIdleConnTimeout / time.Second),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The delay in seconds after which the idle connections are closed, 0 means no limit",
+			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Endpoints of the services, which take precedence over the endpoints file and the environment variables",
+				Elem: &schema.Resource{
+					Schema: providerEndpointsSchema(),
+				},
+			},
+			"assume_trusted_profile": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Trusted profile assumed with the API key, or with the compute resource token of the IKS pod or VPC instance running the provider",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"profile_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"assume_trusted_profile.0.profile_id", "assume_trusted_profile.0.profile_crn", "assume_trusted_profile.0.profile_name"},
+							Description:  "The ID of the trusted profile",
+						},
+						"profile_crn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"assume_trusted_profile.0.profile_id", "assume_trusted_profile.0.profile_crn", "assume_trusted_profile.0.profile_name"},
+							Description:  "The CRN of the trusted profile, not supported with the compute resource token",
+						},
+						"profile_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"assume_trusted_profile.0.profile_id", "assume_trusted_profile.0.profile_crn", "assume_trusted_profile.0.profile_name"},
+							Description:  "The name of the trusted profile, account_id is required with the API key, not supported with the VPC instance identity token",
+						},
+						"account_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The account of the trusted profile named profile_name",
+						},
+						"cr_token_file": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The compute resource token file, used when no API key is set, defaults to IBM_CR_TOKEN_FILENAME. Without token file, the token of the VPC instance metadata service is used",
+						},
+					},
+				},
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags attached to every resource which supports tags",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_resource_tag", "tags")},
+							Set:         flex.ResourceIBMVPCHash,
+							Description: "User tags merged into the tags of every taggable resource",
+						},
+						"access_tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_resource_tag", "tags")},
+							Set:         flex.ResourceIBMVPCHash,
+							Description: "Access tags merged into the access_tags of every taggable resource",
+						},
+					},
+				},
+			},
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags attached by other tooling which are never reported as drift or detached",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Tag keys to ignore, the key of a tag is the part before the first ':'",
+						},
+						"key_prefixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Tag key prefixes to ignore",
+						},
+					},
+				},
+			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Retry policy of the API calls, with exponential backoff",
+				Elem: &schema.Resource{
+					Schema: providerRetrySchema(map[string]*schema.Schema{
+						"jitter": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Randomize the delays, so parallel API calls do not retry at the same time",
+						},
+						"status": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Retry settings of an HTTP status code, which is retried even if not in status_codes",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"code": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(100, 599),
+										Description:  "The HTTP status code",
+									},
+									"max_retries": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+										Description:  "The retry count of the API calls failing with the status code, 0 disables the retries",
+									},
+									"min_delay": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  "The delay in seconds before the first retry, defaults to the min_delay of the retry block",
+									},
+									"max_delay": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  "The maximum delay in seconds between two retries, defaults to the max_delay of the retry block",
+									},
+								},
+							},
+						},
+						"service": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Retry settings of a service, which default to the settings of the retry block",
+							Elem: &schema.Resource{
+								Schema: providerRetrySchema(map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The name of the service, for example vpc, iam or resource_controller",
+									},
+								}),
+							},
+						},
+					}),
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -348,6 +578,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_access_group_template_assignment":     iamaccessgroup.DataSourceIBMIAMAccessGroupTemplateAssignment(),
 			"ibm_iam_account_settings":                     iamidentity.DataSourceIBMIAMAccountSettings(),
 			"ibm_iam_auth_token":                           iamidentity.DataSourceIBMIAMAuthToken(),
+			"ibm_provider_endpoints":                       DataSourceIBMProviderEndpoints(),
 			"ibm_iam_role_actions":                         iampolicy.DataSourceIBMIAMRoleAction(),
 			"ibm_iam_users":                                iamidentity.DataSourceIBMIAMUsers(),
 			"ibm_iam_roles":                                iampolicy.DataSourceIBMIAMRole(),
@@ -505,6 +736,8 @@ func Provider() *schema.Provider {
 			"ibm_is_vpc_dns_resolution_binding":      vpc.DataSourceIBMIsVPCDnsResolutionBinding(),
 			"ibm_is_vpc_dns_resolution_bindings":     vpc.DataSourceIBMIsVPCDnsResolutionBindings(),
 			"ibm_is_vpcs":                            vpc.DataSourceIBMISVPCs(),
+			"ibm_is_vpc_topology":                    vpc.DataSourceIBMIsVPCTopology(),
+			"ibm_is_vpc_available_cidrs":             vpc.DataSourceIBMIsVPCAvailableCIDRs(),
 			"ibm_is_vpn_gateway":                     vpc.DataSourceIBMISVPNGateway(),
 			"ibm_is_vpn_gateways":                    vpc.DataSourceIBMISVPNGateways(),
 			"ibm_is_vpc_address_prefixes":            vpc.DataSourceIbmIsVpcAddressPrefixes(),
@@ -529,6 +762,7 @@ func Provider() *schema.Provider {
 			"ibm_is_operating_systems":               vpc.DataSourceIBMISOperatingSystems(),
 			"ibm_is_network_acls":                    vpc.DataSourceIBMIsNetworkAcls(),
 			"ibm_is_network_acl":                     vpc.DataSourceIBMIsNetworkACL(),
+			"ibm_is_network_acl_evaluate":            vpc.DataSourceIBMIsNetworkACLEvaluate(),
 			"ibm_is_network_acl_rule":                vpc.DataSourceIBMISNetworkACLRule(),
 			"ibm_is_network_acl_rules":               vpc.DataSourceIBMISNetworkACLRules(),
 			"ibm_lbaas":                              classicinfrastructure.DataSourceIBMLbaas(),
@@ -555,6 +789,8 @@ func Provider() *schema.Provider {
 			"ibm_app_config_snapshot":                appconfiguration.DataSourceIBMAppConfigSnapshot(),
 			"ibm_app_config_snapshots":               appconfiguration.DataSourceIBMAppConfigSnapshots(),
 
+			"ibm_is_vpn_gateway_connection_peer_config": vpc.DataSourceIBMISVPNGatewayConnectionPeerConfig(),
+
 			"ibm_resource_quota":    resourcecontroller.DataSourceIBMResourceQuota(),
 			"ibm_resource_group":    resourcemanager.DataSourceIBMResourceGroup(),
 			"ibm_resource_instance": resourcecontroller.DataSourceIBMResourceInstance(),
@@ -1043,6 +1279,7 @@ func Provider() *schema.Provider {
 			"ibm_is_bare_metal_server_network_interface_allow_float": vpc.ResourceIBMIsBareMetalServerNetworkInterfaceAllowFloat(),
 			"ibm_is_bare_metal_server_network_interface_floating_ip": vpc.ResourceIBMIsBareMetalServerNetworkInterfaceFloatingIp(),
 			"ibm_is_bare_metal_server_network_interface":             vpc.ResourceIBMIsBareMetalServerNetworkInterface(),
+			"ibm_is_bare_metal_server_network_attachment":            vpc.ResourceIBMIsBareMetalServerNetworkAttachment(),
 			"ibm_is_bare_metal_server":                               vpc.ResourceIBMIsBareMetalServer(),
 
 			"ibm_is_dedicated_host":                         vpc.ResourceIbmIsDedicatedHost(),
@@ -1054,6 +1291,7 @@ func Provider() *schema.Provider {
 			"ibm_is_instance":                               vpc.ResourceIBMISInstance(),
 			"ibm_is_instance_action":                        vpc.ResourceIBMISInstanceAction(),
 			"ibm_is_instance_network_interface":             vpc.ResourceIBMIsInstanceNetworkInterface(),
+			"ibm_is_instance_network_attachment":            vpc.ResourceIBMIsInstanceNetworkAttachment(),
 			"ibm_is_instance_network_interface_floating_ip": vpc.ResourceIBMIsInstanceNetworkInterfaceFloatingIp(),
 			"ibm_is_instance_disk_management":               vpc.ResourceIBMISInstanceDiskManagement(),
 			"ibm_is_instance_group":                         vpc.ResourceIBMISInstanceGroup(),
@@ -1062,7 +1300,9 @@ func Provider() *schema.Provider {
 			"ibm_is_instance_group_manager_policy":          vpc.ResourceIBMISInstanceGroupManagerPolicy(),
 			"ibm_is_instance_group_manager_action":          vpc.ResourceIBMISInstanceGroupManagerAction(),
 			"ibm_is_instance_volume_attachment":             vpc.ResourceIBMISInstanceVolumeAttachment(),
+			"ibm_is_instance_restore":                       vpc.ResourceIBMISInstanceRestore(),
 			"ibm_is_virtual_endpoint_gateway":               vpc.ResourceIBMISEndpointGateway(),
+			"ibm_is_virtual_network_interface":              vpc.ResourceIBMIsVirtualNetworkInterface(),
 			"ibm_is_virtual_endpoint_gateway_ip":            vpc.ResourceIBMISEndpointGatewayIP(),
 			"ibm_is_instance_template":                      vpc.ResourceIBMISInstanceTemplate(),
 			"ibm_is_ike_policy":                             vpc.ResourceIBMISIKEPolicy(),
@@ -1073,6 +1313,7 @@ func Provider() *schema.Provider {
 			"ibm_is_lb_listener_policy_rule":                vpc.ResourceIBMISLBListenerPolicyRule(),
 			"ibm_is_lb_pool":                                vpc.ResourceIBMISLBPool(),
 			"ibm_is_lb_pool_member":                         vpc.ResourceIBMISLBPoolMember(),
+			"ibm_is_lb_pool_members":                        vpc.ResourceIBMISLBPoolMembers(),
 			"ibm_is_network_acl":                            vpc.ResourceIBMISNetworkACL(),
 			"ibm_is_network_acl_rule":                       vpc.ResourceIBMISNetworkACLRule(),
 			"ibm_is_public_gateway":                         vpc.ResourceIBMISPublicGateway(),
@@ -1090,6 +1331,7 @@ func Provider() *schema.Provider {
 			"ibm_is_ssh_key":                                vpc.ResourceIBMISSSHKey(),
 			"ibm_is_snapshot":                               vpc.ResourceIBMSnapshot(),
 			"ibm_is_snapshot_consistency_group":             vpc.ResourceIBMIsSnapshotConsistencyGroup(),
+			"ibm_is_snapshot_copy":                          vpc.ResourceIBMISSnapshotCopy(),
 			"ibm_is_volume":                                 vpc.ResourceIBMISVolume(),
 			"ibm_is_vpn_gateway":                            vpc.ResourceIBMISVPNGateway(),
 			"ibm_is_vpn_gateway_connection":                 vpc.ResourceIBMISVPNGatewayConnection(),
@@ -1162,11 +1404,14 @@ func Provider() *schema.Provider {
 			"ibm_pi_volume_group":                    power.ResourceIBMPIVolumeGroup(),
 			"ibm_pi_volume_clone":                    power.ResourceIBMPIVolumeClone(),
 			"ibm_pi_volume_group_action":             power.ResourceIBMPIVolumeGroupAction(),
+			"ibm_pi_dr_failover":                     power.ResourceIBMPIDRFailover(),
 			"ibm_pi_network":                         power.ResourceIBMPINetwork(),
 			"ibm_pi_instance":                        power.ResourceIBMPIInstance(),
+			"ibm_pi_instance_migration":              power.ResourceIBMPIInstanceMigration(),
 			"ibm_pi_instance_action":                 power.ResourceIBMPIInstanceAction(),
 			"ibm_pi_volume_attach":                   power.ResourceIBMPIVolumeAttach(),
 			"ibm_pi_capture":                         power.ResourceIBMPICapture(),
+			"ibm_pi_capture_policy":                  power.ResourceIBMPICapturePolicy(),
 			"ibm_pi_image":                           power.ResourceIBMPIImage(),
 			"ibm_pi_image_export":                    power.ResourceIBMPIImageExport(),
 			"ibm_pi_snapshot":                        power.ResourceIBMPISnapshot(),
@@ -1491,9 +1736,10 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_backup_policy_plan": vpc.ResourceIBMIsBackupPolicyPlanValidator(),
 
 				// bare_metal_server
-				"ibm_is_bare_metal_server_disk":              vpc.ResourceIBMIsBareMetalServerDiskValidator(),
-				"ibm_is_bare_metal_server_network_interface": vpc.ResourceIBMIsBareMetalServerNetworkInterfaceValidator(),
-				"ibm_is_bare_metal_server":                   vpc.ResourceIBMIsBareMetalServerValidator(),
+				"ibm_is_bare_metal_server_disk":               vpc.ResourceIBMIsBareMetalServerDiskValidator(),
+				"ibm_is_bare_metal_server_network_interface":  vpc.ResourceIBMIsBareMetalServerNetworkInterfaceValidator(),
+				"ibm_is_bare_metal_server_network_attachment": vpc.ResourceIBMIsBareMetalServerNetworkAttachmentValidator(),
+				"ibm_is_bare_metal_server":                    vpc.ResourceIBMIsBareMetalServerValidator(),
 
 				"ibm_is_dedicated_host_group":             vpc.ResourceIbmIsDedicatedHostGroupValidator(),
 				"ibm_is_dedicated_host":                   vpc.ResourceIbmIsDedicatedHostValidator(),
@@ -1512,6 +1758,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_instance":                         vpc.ResourceIBMISInstanceValidator(),
 				"ibm_is_instance_action":                  vpc.ResourceIBMISInstanceActionValidator(),
 				"ibm_is_instance_network_interface":       vpc.ResourceIBMIsInstanceNetworkInterfaceValidator(),
+				"ibm_is_instance_network_attachment":      vpc.ResourceIBMIsInstanceNetworkAttachmentValidator(),
 				"ibm_is_instance_disk_management":         vpc.ResourceIBMISInstanceDiskManagementValidator(),
 				"ibm_is_instance_volume_attachment":       vpc.ResourceIBMISInstanceVolumeAttachmentValidator(),
 				"ibm_is_ipsec_policy":                     vpc.ResourceIBMISIPSECValidator(),
@@ -1519,6 +1766,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_lb_listener_policy":               vpc.ResourceIBMISLBListenerPolicyValidator(),
 				"ibm_is_lb_listener":                      vpc.ResourceIBMISLBListenerValidator(),
 				"ibm_is_lb_pool_member":                   vpc.ResourceIBMISLBPoolMemberValidator(),
+				"ibm_is_lb_pool_members":                  vpc.ResourceIBMISLBPoolMembersValidator(),
 				"ibm_is_lb_pool":                          vpc.ResourceIBMISLBPoolValidator(),
 				"ibm_is_lb":                               vpc.ResourceIBMISLBValidator(),
 				"ibm_is_network_acl":                      vpc.ResourceIBMISNetworkACLValidator(),
@@ -1557,9 +1805,11 @@ func Validator() validate.ValidatorDict {
 				"ibm_resource_instance":                   resourcecontroller.ResourceIBMResourceInstanceValidator(),
 				"ibm_resource_key":                        resourcecontroller.ResourceIBMResourceKeyValidator(),
 				"ibm_is_virtual_endpoint_gateway":         vpc.ResourceIBMISEndpointGatewayValidator(),
+				"ibm_is_virtual_network_interface":        vpc.ResourceIBMIsVirtualNetworkInterfaceValidator(),
 				"ibm_resource_tag":                        globaltagging.ResourceIBMResourceTagValidator(),
 				"ibm_satellite_location":                  satellite.ResourceIBMSatelliteLocationValidator(),
 				"ibm_satellite_cluster":                   satellite.ResourceIBMSatelliteClusterValidator(),
+				"ibm_pi_instance":                         power.ResourceIBMPIInstanceValidator(),
 				"ibm_pi_volume":                           power.ResourceIBMPIVolumeValidator(),
 				"ibm_atracker_target":                     atracker.ResourceIBMAtrackerTargetValidator(),
 				"ibm_atracker_route":                      atracker.ResourceIBMAtrackerRouteValidator(),
@@ -1670,6 +1920,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_snapshot_consistency_group": vpc.DataSourceIBMISSnapshotConsistencyGroupValidator(),
 				"ibm_is_snapshot":                   vpc.DataSourceIBMISSnapshotValidator(),
 				"ibm_is_images":                     vpc.DataSourceIBMISImagesValidator(),
+				"ibm_is_network_acl_evaluate":       vpc.DataSourceIBMIsNetworkACLEvaluateValidator(),
 				"ibm_dl_offering_speeds":            directlink.DataSourceIBMDLOfferingSpeedsValidator(),
 				"ibm_dl_routers":                    directlink.DataSourceIBMDLRoutersValidator(),
 				"ibm_resource_instance":             resourcecontroller.DataSourceIBMResourceInstanceValidator(),
@@ -1680,6 +1931,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_bare_metal_server": vpc.DataSourceIBMIsBareMetalServerValidator(),
 
 				"ibm_is_vpc":                      vpc.DataSourceIBMISVpcValidator(),
+				"ibm_is_vpc_available_cidrs":      vpc.DataSourceIBMIsVPCAvailableCIDRsValidator(),
 				"ibm_is_volume":                   vpc.DataSourceIBMISVolumeValidator(),
 				"ibm_cis_webhooks":                cis.DataSourceIBMCISAlertWebhooksValidator(),
 				"ibm_cis_alerts":                  cis.DataSourceIBMCISAlertsValidator(),
@@ -1817,6 +2069,39 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	resourceGrp := d.Get("resource_group").(string)
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
+
+	// The settings of the profile apply when neither the provider block nor the environment set them
+	if profile, err := loadProviderProfile(d); err != nil {
+		return nil, err
+	} else if profile != nil {
+		if bluemixAPIKey == "" && iamToken == "" {
+			bluemixAPIKey = profile.APIKey
+			if bluemixAPIKey == "" {
+				iamToken, iamRefreshToken = profile.IAMToken, profile.IAMRefreshToken
+			}
+		}
+		if iamTrustedProfileId == "" {
+			iamTrustedProfileId = profile.IAMProfileID
+		}
+		if softlayerUsername == "" && softlayerAPIKey == "" {
+			softlayerUsername, softlayerAPIKey = profile.ClassicUsername, profile.ClassicAPIKey
+		}
+		if file == "" {
+			file = profile.EndpointsFile
+		}
+		if resourceGrp == "" {
+			resourceGrp = profile.ResourceGroup
+		}
+		if zone == "" {
+			zone = profile.Zone
+		}
+		if profile.Region != "" && !isProviderArgumentSet(d, "region", "us-south", "IC_REGION", "IBMCLOUD_REGION", "BM_REGION", "BLUEMIX_REGION") {
+			region = profile.Region
+		}
+		if profile.Visibility != "" && !isProviderArgumentSet(d, "visibility", "public", "IC_VISIBILITY", "IBMCLOUD_VISIBILITY") {
+			visibility = profile.Visibility
+		}
+	}
 	retryCount := d.Get("max_retries").(int)
 	wskNameSpace := d.Get("function_namespace").(string)
 	riaasEndPoint := d.Get("riaas_endpoint").(string)
@@ -1849,7 +2134,201 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Visibility:           visibility,
 		EndpointsFile:        file,
 		IAMTrustedProfileID:  iamTrustedProfileId,
+		Tags:                 expandProviderTagsConfig(d),
+		Retry:                expandProviderRetryPolicy(d, retryCount),
+		AssumeTrustedProfile: expandProviderTrustedProfile(d),
+		Endpoints:            expandProviderEndpoints(d),
+		Transport:            expandProviderTransportConfig(d),
 	}
 
 	return config.ClientSession()
 }
+
+// providerEndpointsSchema returns an optional endpoint per service of conns.EndpointServices
+func providerEndpointsSchema() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{}
+	for _, service := range conns.EndpointServices() {
+		s[service] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  fmt.Sprintf("The endpoint of the %s service, which takes precedence over %s", service, conns.EndpointEnv(service)),
+		}
+	}
+	return s
+}
+
+// expandProviderEndpoints returns the endpoints block, by service
+func expandProviderEndpoints(d *schema.ResourceData) map[string]string {
+	v, ok := d.GetOk("endpoints")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+	endpoints := map[string]string{}
+	for service, endpoint := range v.([]interface{})[0].(map[string]interface{}) {
+		if endpoint.(string) != "" {
+			endpoints[service] = endpoint.(string)
+		}
+	}
+	return endpoints
+}
+
+// expandProviderTransportConfig returns the HTTP settings of the provider, or nil when none is set
+func expandProviderTransportConfig(d *schema.ResourceData) *conns.TransportConfig {
+	t := &conns.TransportConfig{
+		HTTPProxy:             d.Get("http_proxy").(string),
+		NoProxy:               d.Get("no_proxy").(string),
+		CABundleFile:          d.Get("ca_bundle_file").(string),
+		ClientCertificateFile: d.Get("client_certificate_file").(string),
+		ClientKeyFile:         d.Get("client_key_file").(string),
+		KeepAlive:             d.Get("keep_alive").(bool),
+		MaxIdleConns:          d.Get("max_idle_connections").(int),
+		MaxIdleConnsPerHost:   d.Get("max_idle_connections_per_host").(int),
+		IdleConnTimeout:       time.Duration(d.Get("idle_connection_timeout").(int)) * time.Second,
+	}
+	if !t.IsSet() {
+		return nil
+	}
+	return t
+}
+
+// expandProviderTrustedProfile returns the assume_trusted_profile block, or nil when it is not set
+func expandProviderTrustedProfile(d *schema.ResourceData) *conns.TrustedProfile {
+	v, ok := d.GetOk("assume_trusted_profile")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+	m := v.([]interface{})[0].(map[string]interface{})
+	return &conns.TrustedProfile{
+		ID:          m["profile_id"].(string),
+		CRN:         m["profile_crn"].(string),
+		Name:        m["profile_name"].(string),
+		AccountID:   m["account_id"].(string),
+		CRTokenFile: m["cr_token_file"].(string),
+	}
+}
+
+// loadProviderProfile returns the profile of the config file, or nil when neither config_file nor profile are set
+func loadProviderProfile(d *schema.ResourceData) (*conns.Profile, error) {
+	file := d.Get("config_file").(string)
+	profile := d.Get("profile").(string)
+	if file == "" && profile == "" {
+		return nil, nil
+	}
+	p, err := conns.LoadProfile(file, profile)
+	if err != nil {
+		return nil, err
+	}
+	if p.Visibility != "" {
+		if _, errs := validate.ValidateAllowedStringValues([]string{"public", "private", "public-and-private"})(p.Visibility, "visibility"); len(errs) > 0 {
+			return nil, errs[0]
+		}
+	}
+	return p, nil
+}
+
+// isProviderArgumentSet reports whether the argument is set in one of its environment variables,
+// or in the provider block to a value other than its default value
+func isProviderArgumentSet(d *schema.ResourceData, key, defaultValue string, envs ...string) bool {
+	for _, env := range envs {
+		if os.Getenv(env) != "" {
+			return true
+		}
+	}
+	return d.Get(key).(string) != defaultValue
+}
+
+// providerRetrySchema adds the settings shared by the retry block and its service overrides
+func providerRetrySchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["max_retries"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "The retry count of the API calls, defaults to the provider max_retries",
+	}
+	s["min_delay"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "The delay in seconds before the first retry, doubled on every retry",
+	}
+	s["max_delay"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "The maximum delay in seconds between two retries, which also caps the Retry-After header",
+	}
+	s["status_codes"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(100, 599)},
+		Description: "The HTTP status codes which are retried",
+	}
+	return s
+}
+
+// expandProviderRetryPolicy returns the policy of the retry block, based on the provider max_retries
+func expandProviderRetryPolicy(d *schema.ResourceData, retryCount int) *conns.RetryPolicy {
+	policy := conns.DefaultRetryPolicy(retryCount)
+	v, ok := d.GetOk("retry")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return policy
+	}
+	retry := v.([]interface{})[0].(map[string]interface{})
+	expandRetryPolicySettings(policy, retry)
+	policy.Jitter = retry["jitter"].(bool)
+
+	for _, s := range retry["status"].([]interface{}) {
+		status := s.(map[string]interface{})
+		if policy.Statuses == nil {
+			policy.Statuses = map[int]conns.RetryStatusPolicy{}
+		}
+		policy.Statuses[status["code"].(int)] = conns.RetryStatusPolicy{
+			MaxRetries: status["max_retries"].(int),
+			MinDelay:   time.Duration(status["min_delay"].(int)) * time.Second,
+			MaxDelay:   time.Duration(status["max_delay"].(int)) * time.Second,
+		}
+	}
+	for _, s := range retry["service"].([]interface{}) {
+		service := s.(map[string]interface{})
+		servicePolicy := *policy
+		servicePolicy.Services = nil
+		expandRetryPolicySettings(&servicePolicy, service)
+		if policy.Services == nil {
+			policy.Services = map[string]*conns.RetryPolicy{}
+		}
+		policy.Services[service["name"].(string)] = &servicePolicy
+	}
+	return policy
+}
+
+// expandRetryPolicySettings overrides the policy with the settings which are set
+func expandRetryPolicySettings(policy *conns.RetryPolicy, settings map[string]interface{}) {
+	if v := settings["max_retries"].(int); v > 0 {
+		policy.MaxRetries = v
+	}
+	if v := settings["min_delay"].(int); v > 0 {
+		policy.MinDelay = time.Duration(v) * time.Second
+	}
+	if v := settings["max_delay"].(int); v > 0 {
+		policy.MaxDelay = time.Duration(v) * time.Second
+	}
+	if v := settings["status_codes"].(*schema.Set); v.Len() > 0 {
+		policy.StatusCodes = flex.ExpandIntList(v.List())
+	}
+}
+
+func expandProviderTagsConfig(d *schema.ResourceData) *conns.TagsConfig {
+	tagsConfig := &conns.TagsConfig{}
+	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		defaultTags := v.([]interface{})[0].(map[string]interface{})
+		tagsConfig.DefaultTags = flex.ExpandStringList(defaultTags["tags"].(*schema.Set).List())
+		tagsConfig.DefaultAccessTags = flex.ExpandStringList(defaultTags["access_tags"].(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		ignoreTags := v.([]interface{})[0].(map[string]interface{})
+		tagsConfig.IgnoreKeys = flex.ExpandStringList(ignoreTags["keys"].(*schema.Set).List())
+		tagsConfig.IgnoreKeyPrefixes = flex.ExpandStringList(ignoreTags["key_prefixes"].(*schema.Set).List())
+	}
+	return tagsConfig
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	apigatewaysdk "github.com/IBM/apigateway-go-sdk/apigatewaycontrollerapiv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -128,7 +129,7 @@ func dataSourceIBMApiGatewayRead(d *schema.ResourceData, meta interface{}) error
 	payload.ServiceInstanceCrn = &serviceInstanceCrn
 	allendpoints, response, err := endpointservice.GetAllEndpoints(payload)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting All Endpoint: %s,%s", err, response)
+		return flex.NewServiceErrorV3("Error Getting All Endpoint", err, response)
 	}
 	endpointsMap := make([]map[string]interface{}, 0, len(*allendpoints))
 
@@ -162,7 +163,7 @@ func dataSourceIBMApiGatewayRead(d *schema.ResourceData, meta interface{}) error
 		}
 		allsubscriptions, response, err := endpointservice.GetAllSubscriptions(SubscriptionPayload)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Getting All Endpoint: %s %s", err, response)
+			return flex.NewServiceErrorV3("Error Getting All Endpoint", err, response)
 		}
 		subscriptionMap := make([]map[string]interface{}, 0, len(*allsubscriptions))
 		for _, subscription := range *allsubscriptions {
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	apigatewaysdk "github.com/IBM/apigateway-go-sdk/apigatewaycontrollerapiv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -116,7 +117,7 @@ func resourceIBMApiGatewayEndpointSubscriptionCreate(d *schema.ResourceData, met
 
 	result, response, err := endpointservice.CreateSubscription(payload)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating Subscription: %s %s", err, response)
+		return flex.NewServiceErrorV3("Error creating Subscription", err, response)
 	}
 	d.SetId(fmt.Sprintf("%s//%s", *result.ArtifactID, *result.ClientID))
 
@@ -155,7 +156,7 @@ func resourceIBMApiGatewayEndpointSubscriptionGet(d *schema.ResourceData, meta i
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Subscription: %s\n%s", err, response)
+		return flex.NewServiceErrorV3("Error Getting Subscription", err, response)
 	}
 	d.Set("artifact_id", result.ArtifactID)
 	d.Set("client_id", result.ClientID)
@@ -216,13 +217,13 @@ func resourceIBMApiGatewayEndpointSubscriptionUpdate(d *schema.ResourceData, met
 		}
 		_, SecretResponse, err := endpointservice.AddSubscriptionSecret(secretpayload)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Adding Secret to Subscription: %s,%s", err, SecretResponse)
+			return flex.NewServiceErrorV3("Error Adding Secret to Subscription", err, SecretResponse)
 		}
 	}
 	if update {
 		_, response, err := endpointservice.UpdateSubscription(payload)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating Subscription: %s,%s", err, response)
+			return flex.NewServiceErrorV3("Error updating Subscription", err, response)
 		}
 	}
 	return resourceIBMApiGatewayEndpointSubscriptionGet(d, meta)
@@ -255,7 +256,7 @@ func resourceIBMApiGatewayEndpointSubscriptionDelete(d *schema.ResourceData, met
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting Subscription: %s\n%s", err, response)
+		return flex.NewServiceErrorV3("Error deleting Subscription", err, response)
 	}
 	d.SetId("")
 
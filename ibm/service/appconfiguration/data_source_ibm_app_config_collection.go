@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMAppConfigCollection() *schema.Resource {
@@ -141,7 +143,7 @@ func dataSourceIbmAppConfigCollectionRead(d *schema.ResourceData, meta interface
 
 	result, response, err := appconfigClient.GetCollection(options)
 	if err != nil {
-		return fmt.Errorf("GetCollection failed %s\n%s", err, response)
+		return flex.NewServiceError("GetCollection failed", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", guid, *result.CollectionID))
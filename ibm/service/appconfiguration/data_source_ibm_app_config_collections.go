@@ -11,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMAppConfigCollections() *schema.Resource {
@@ -205,7 +207,7 @@ func dataSourceIbmAppConfigCollectionsRead(d *schema.ResourceData, meta interfac
 		result, response, err := appconfigClient.ListCollections(options)
 		collectionsList = result
 		if err != nil {
-			return fmt.Errorf("ListCollections failed %s\n%s", err, response)
+			return flex.NewServiceError("ListCollections failed", err, response)
 		}
 		if isLimit {
 			offset = 0
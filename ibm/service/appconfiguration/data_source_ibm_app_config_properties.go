@@ -11,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMAppConfigProperties() *schema.Resource {
@@ -256,7 +258,7 @@ func dataSourceIbmAppConfigPropertiesRead(d *schema.ResourceData, meta interface
 		result, response, err := appconfigClient.ListProperties(options)
 		propertiesList = result
 		if err != nil {
-			return fmt.Errorf("ListProperties failed %s\n%s", err, response)
+			return flex.NewServiceError("ListProperties failed", err, response)
 		}
 		if isLimit {
 			offset = 0
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMAppConfigProperty() *schema.Resource {
@@ -158,7 +160,7 @@ func dataSourceIbmAppConfigPropertyRead(d *schema.ResourceData, meta interface{}
 	property, response, err := appconfigClient.GetProperty(options)
 
 	if err != nil {
-		return fmt.Errorf("GetProperty failed %s\n%s", err, response)
+		return flex.NewServiceError("GetProperty failed", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", guid, *options.EnvironmentID, *property.PropertyID))
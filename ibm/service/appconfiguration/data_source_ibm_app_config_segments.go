@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMAppConfigSegments() *schema.Resource {
@@ -215,7 +217,7 @@ func dataSourceIbmAppConfigSegmentsRead(d *schema.ResourceData, meta interface{}
 		segmentsList = result
 		if err != nil {
 			log.Printf("[DEBUG] ListSegments failed %s\n%s", err, response)
-			return fmt.Errorf("ListSegments failed %s\n%s", err, response)
+			return flex.NewServiceError("ListSegments failed", err, response)
 		}
 		if isLimit {
 			offset = 0
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMAppConfigSnapshots() *schema.Resource {
@@ -191,7 +193,7 @@ func dataSourceIbmAppConfigSnapshotsRead(d *schema.ResourceData, meta interface{
 		result, response, err := appconfigClient.ListSnapshots(options)
 		shapshotsList = result
 		if err != nil {
-			return fmt.Errorf("ListSnapshots failed %s\n%s", err, response)
+			return flex.NewServiceError("ListSnapshots failed", err, response)
 		}
 		if isLimit {
 			offset = 0
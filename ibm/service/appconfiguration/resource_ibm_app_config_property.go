@@ -193,7 +193,7 @@ func resourceIbmIbmAppConfigPropertyCreate(d *schema.ResourceData, meta interfac
 
 	result, response, err := appconfigClient.CreateProperty(options)
 	if err != nil {
-		return fmt.Errorf("CreateProperty failed %s\n%s", err, response)
+		return flex.NewServiceError("CreateProperty failed", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", guid, *options.EnvironmentID, *result.PropertyID))
@@ -225,7 +225,7 @@ func resourceIbmIbmAppConfigPropertyRead(d *schema.ResourceData, meta interface{
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 		}
-		return fmt.Errorf("[DEBUG] GetProperty failed %s\n%s", err, response)
+		return flex.NewServiceError("GetProperty failed", err, response)
 	}
 
 	d.Set("guid", parts[0])
@@ -358,7 +358,7 @@ func resourceIbmIbmAppConfigPropertyUpdate(d *schema.ResourceData, meta interfac
 		}
 		_, response, err := appconfigClient.UpdateProperty(options)
 		if err != nil {
-			return fmt.Errorf("UpdateProperty failed %s\n%s", err, response)
+			return flex.NewServiceError("UpdateProperty failed", err, response)
 		}
 
 		return resourceIbmIbmAppConfigPropertyRead(d, meta)
@@ -122,7 +122,7 @@ func resourceIbmIbmAppConfigSegmentCreate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("CreateSegment failed %s\n%s", err, response)
-		return fmt.Errorf("CreateSegment failed %s\n%s", err, response)
+		return flex.NewServiceError("CreateSegment failed", err, response)
 	}
 	d.SetId(fmt.Sprintf("%s/%s", guid, *segment.SegmentID))
 	return resourceIbmIbmAppConfigSegmentRead(d, meta)
@@ -163,7 +163,7 @@ func resourceIbmIbmAppConfigSegmentRead(d *schema.ResourceData, meta interface{}
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 		}
-		return fmt.Errorf("[DEBUG] GetSegment failed %s\n%s", err, response)
+		return flex.NewServiceError("GetSegment failed", err, response)
 	}
 
 	d.Set("guid", parts[0])
@@ -294,7 +294,7 @@ func resourceIbmIbmAppConfigSegmentDelete(d *schema.ResourceData, meta interface
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] DeleteSegment failed %s\n%s", err, response)
+		return flex.NewServiceError("DeleteSegment failed", err, response)
 	}
 
 	d.SetId("")
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -63,7 +64,7 @@ func dataSourceIBMAppIDApplicationRolesRead(ctx context.Context, d *schema.Resou
 	})
 
 	if err != nil {
-		return diag.Errorf("Error getting AppID application roles: %s\n%s", err, resp)
+		return flex.NewServiceError("Error getting AppID application roles", err, resp).Diagnostics()
 	}
 
 	if err := d.Set("roles", flattenAppIDApplicationRoles(roles.Roles)); err != nil {
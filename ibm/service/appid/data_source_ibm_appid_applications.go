@@ -6,6 +6,7 @@ import (
 	"sort"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -81,7 +82,7 @@ func dataSourceIBMAppIDApplicationsRead(ctx context.Context, d *schema.ResourceD
 	})
 
 	if err != nil {
-		return diag.Errorf("Error listing AppID applications: %s\n%s", err, resp)
+		return flex.NewServiceError("Error listing AppID applications", err, resp).Diagnostics()
 	}
 
 	applicationList := make([]interface{}, len(apps.Applications))
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -76,7 +77,7 @@ func dataSourceIBMAppIDCloudDirectoryTemplateRead(ctx context.Context, d *schema
 	})
 
 	if err != nil {
-		return diag.Errorf("Error loading AppID Cloud Directory template: %s\n%s", err, resp)
+		return flex.NewServiceError("Error loading AppID Cloud Directory template", err, resp).Diagnostics()
 	}
 
 	if template.Subject != nil {
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -113,7 +114,7 @@ func dataSourceIBMAppIDCloudDirectoryUserRead(ctx context.Context, d *schema.Res
 	})
 
 	if err != nil {
-		return diag.Errorf("Error getting AppID Cloud Directory user: %s\n%s", err, resp)
+		return flex.NewServiceError("Error getting AppID Cloud Directory user", err, resp).Diagnostics()
 	}
 
 	d.Set("tenant_id", tenantID)
@@ -157,7 +158,7 @@ func dataSourceIBMAppIDCloudDirectoryUserRead(ctx context.Context, d *schema.Res
 
 	if err != nil {
 		log.Printf("[DEBUG] Error getting AppID user attributes: %s\n%s", err, resp)
-		return diag.Errorf("Error getting AppID user attributes: %s", err)
+		return flex.NewServiceError("Error getting AppID user attributes", err, resp).Diagnostics()
 	}
 
 	if attr.Sub != nil {
@@ -110,7 +110,7 @@ func dataSourceIBMAppIDIDPSAMLRead(ctx context.Context, d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return diag.Errorf("Error loading SAML IDP: %s\n%s", err, resp)
+		return flex.NewServiceError("Error loading SAML IDP", err, resp).Diagnostics()
 	}
 
 	d.Set("is_active", *saml.IsActive)
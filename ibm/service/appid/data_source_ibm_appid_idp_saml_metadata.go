@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -42,7 +43,7 @@ func dataSourceIBMAppIDIDPSAMLMetadataRead(ctx context.Context, d *schema.Resour
 	})
 
 	if err != nil {
-		return diag.Errorf("Error loading AppID SAML metadata: %s\n%s", err, resp)
+		return flex.NewServiceError("Error loading AppID SAML metadata", err, resp).Diagnostics()
 	}
 
 	if err := d.Set("metadata", metadata); err != nil {
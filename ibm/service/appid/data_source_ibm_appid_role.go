@@ -76,7 +76,7 @@ func dataSourceIBMAppIDRoleRead(ctx context.Context, d *schema.ResourceData, met
 	})
 
 	if err != nil {
-		return diag.Errorf("Error loading AppID role: %s\n%s", err, resp)
+		return flex.NewServiceError("Error loading AppID role", err, resp).Diagnostics()
 	}
 
 	d.Set("name", *role.Name)
@@ -6,6 +6,7 @@ import (
 	"sort"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -81,7 +82,7 @@ func dataSourceIBMAppIDRolesRead(ctx context.Context, d *schema.ResourceData, me
 	})
 
 	if err != nil {
-		return diag.Errorf("Error listing AppID roles: %s\n%s", err, resp)
+		return flex.NewServiceError("Error listing AppID roles", err, resp).Diagnostics()
 	}
 
 	roleList := make([]interface{}, len(roles.Roles))
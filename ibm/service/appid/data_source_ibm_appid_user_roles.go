@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -66,7 +67,7 @@ func dataSourceIBMAppIDUserRolesRead(ctx context.Context, d *schema.ResourceData
 
 	if err != nil {
 		log.Printf("[DEBUG] Error getting AppID user roles: %s\n%s", err, resp)
-		return diag.Errorf("Error getting AppID user roles: %s", err)
+		return flex.NewServiceError("Error getting AppID user roles", err, resp).Diagnostics()
 	}
 
 	if roles.Roles != nil {
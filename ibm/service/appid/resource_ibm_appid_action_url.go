@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -75,7 +76,7 @@ func resourceIBMAppIDActionURLRead(ctx context.Context, d *schema.ResourceData,
 			return nil
 		}
 
-		return diag.Errorf("Error getting AppID actionURL: %s\n%s", err, resp)
+		return flex.NewServiceError("Error getting AppID actionURL", err, resp).Diagnostics()
 	}
 
 	if cfg.ActionURL != nil {
@@ -108,7 +109,7 @@ func resourceIBMAppIDActionURLCreate(ctx context.Context, d *schema.ResourceData
 	_, resp, err := appIDClient.SetCloudDirectoryActionWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error setting AppID Cloud Directory action URL: %s\n%s", err, resp)
+		return flex.NewServiceError("Error setting AppID Cloud Directory action URL", err, resp).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", tenantID, action))
@@ -132,7 +133,7 @@ func resourceIBMAppIDActionURLDelete(ctx context.Context, d *schema.ResourceData
 	})
 
 	if err != nil {
-		return diag.Errorf("Error deleting AppID Cloud Directory action URL: %s\n%s", err, resp)
+		return flex.NewServiceError("Error deleting AppID Cloud Directory action URL", err, resp).Diagnostics()
 	}
 
 	d.SetId("")
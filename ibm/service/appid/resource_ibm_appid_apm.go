@@ -6,6 +6,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -140,7 +141,7 @@ func resourceIBMAppIDAPMRead(ctx context.Context, d *schema.ResourceData, meta i
 			return nil
 		}
 
-		return diag.Errorf("Error getting AppID APM configuration: %s\n%s", err, resp)
+		return flex.NewServiceError("Error getting AppID APM configuration", err, resp).Diagnostics()
 	}
 
 	if apm.AdvancedPasswordManagement != nil {
@@ -198,7 +199,7 @@ func resourceIBMAppIDAPMCreate(ctx context.Context, d *schema.ResourceData, meta
 	_, resp, err := appIDClient.SetCloudDirectoryAdvancedPasswordManagementWithContext(ctx, config)
 
 	if err != nil {
-		return diag.Errorf("Error updating AppID APM configuration: %s\n%s", err, resp)
+		return flex.NewServiceError("Error updating AppID APM configuration", err, resp).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -290,7 +291,7 @@ func resourceIBMAppIDAPMDelete(ctx context.Context, d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return diag.Errorf("Error resetting AppID APM configuration: %s\n%s", err, resp)
+		return flex.NewServiceError("Error resetting AppID APM configuration", err, resp).Diagnostics()
 	}
 
 	d.SetId("")
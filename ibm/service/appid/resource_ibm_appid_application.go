@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -92,7 +93,7 @@ func resourceIBMAppIDApplicationCreate(ctx context.Context, d *schema.ResourceDa
 	app, resp, err := appIDClient.RegisterApplicationWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error creating AppID application: %s\n%s", err, resp)
+		return flex.NewServiceError("Error creating AppID application", err, resp).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", tenantID, *app.ClientID))
@@ -129,7 +130,7 @@ func resourceIBMAppIDApplicationRead(ctx context.Context, d *schema.ResourceData
 			return nil
 		}
 
-		return diag.Errorf("Error getting AppID application: %s\n%s", err, resp)
+		return flex.NewServiceError("Error getting AppID application", err, resp).Diagnostics()
 	}
 
 	if app.Name != nil {
@@ -181,7 +182,7 @@ func resourceIBMAppIDApplicationUpdate(ctx context.Context, d *schema.ResourceDa
 		})
 
 		if err != nil {
-			return diag.Errorf("Error updating AppID application: %s\n%s", err, resp)
+			return flex.NewServiceError("Error updating AppID application", err, resp).Diagnostics()
 		}
 	}
 
@@ -204,7 +205,7 @@ func resourceIBMAppIDApplicationDelete(ctx context.Context, d *schema.ResourceDa
 	})
 
 	if err != nil {
-		return diag.Errorf("Error deleting AppID application: %s\n%s", err, resp)
+		return flex.NewServiceError("Error deleting AppID application", err, resp).Diagnostics()
 	}
 
 	d.SetId("")
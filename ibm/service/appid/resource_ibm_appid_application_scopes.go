@@ -67,7 +67,7 @@ func resourceIBMAppIDApplicationScopesCreate(ctx context.Context, d *schema.Reso
 	_, resp, err := appIDClient.PutApplicationsScopesWithContext(ctx, scopeOpts)
 
 	if err != nil {
-		return diag.Errorf("Error setting application scopes: %s\n%s", err, resp)
+		return flex.NewServiceError("Error setting application scopes", err, resp).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", tenantID, clientID))
@@ -104,7 +104,7 @@ func resourceIBMAppIDApplicationScopesRead(ctx context.Context, d *schema.Resour
 			return nil
 		}
 
-		return diag.Errorf("Error getting AppID application scopes: %s\n%s", err, resp)
+		return flex.NewServiceError("Error getting AppID application scopes", err, resp).Diagnostics()
 	}
 
 	if err := d.Set("scopes", scopes.Scopes); err != nil {
@@ -137,7 +137,7 @@ func resourceIBMAppIDApplicationScopesUpdate(ctx context.Context, d *schema.Reso
 	_, resp, err := appIDClient.PutApplicationsScopesWithContext(ctx, scopeOpts)
 
 	if err != nil {
-		return diag.Errorf("Error updating application scopes: %s\n%s", err, resp)
+		return flex.NewServiceError("Error updating application scopes", err, resp).Diagnostics()
 	}
 
 	return resourceIBMAppIDApplicationScopesRead(ctx, d, meta)
@@ -162,7 +162,7 @@ func resourceIBMAppIDApplicationScopesDelete(ctx context.Context, d *schema.Reso
 	_, resp, err := appIDClient.PutApplicationsScopesWithContext(ctx, scopeOpts)
 
 	if err != nil {
-		return diag.Errorf("Error clearing application scopes: %s\n%s", err, resp)
+		return flex.NewServiceError("Error clearing application scopes", err, resp).Diagnostics()
 	}
 
 	d.SetId("")
@@ -8,6 +8,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -155,7 +156,7 @@ func resourceIBMAppIDCloudDirectoryUserRead(ctx context.Context, d *schema.Resou
 			return nil
 		}
 
-		return diag.Errorf("Error getting AppID Cloud Directory user: %s", err)
+		return flex.NewServiceError("Error getting AppID Cloud Directory user", err, resp).Diagnostics()
 	}
 
 	d.Set("tenant_id", tenantID)
@@ -199,7 +200,7 @@ func resourceIBMAppIDCloudDirectoryUserRead(ctx context.Context, d *schema.Resou
 	})
 
 	if err != nil {
-		return diag.Errorf("Error getting AppID user attributes: %s\n%s", err, resp)
+		return flex.NewServiceError("Error getting AppID user attributes", err, resp).Diagnostics()
 	}
 
 	if attr.Sub != nil {
@@ -247,7 +248,7 @@ func resourceIBMAppIDCloudDirectoryUserCreate(ctx context.Context, d *schema.Res
 	user, resp, err := appIDClient.StartSignUpWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error creating AppID Cloud Directory user: %s\n%s", err, resp)
+		return flex.NewServiceError("Error creating AppID Cloud Directory user", err, resp).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", tenantID, *user.ID))
@@ -270,7 +271,7 @@ func resourceIBMAppIDCloudDirectoryUserDelete(ctx context.Context, d *schema.Res
 	})
 
 	if err != nil {
-		return diag.Errorf("Error deleting AppID Cloud Directory user: %s\n%s", err, resp)
+		return flex.NewServiceError("Error deleting AppID Cloud Directory user", err, resp).Diagnostics()
 	}
 
 	d.SetId("")
@@ -314,7 +315,7 @@ func resourceIBMAppIDCloudDirectoryUserUpdate(ctx context.Context, d *schema.Res
 	_, resp, err := appIDClient.UpdateCloudDirectoryUserWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error updating AppID Cloud Directory user: %s\n%s", err, resp)
+		return flex.NewServiceError("Error updating AppID Cloud Directory user", err, resp).Diagnostics()
 	}
 
 	if d.HasChanges("password") {
@@ -327,7 +328,7 @@ func resourceIBMAppIDCloudDirectoryUserUpdate(ctx context.Context, d *schema.Res
 		})
 
 		if err != nil {
-			return diag.Errorf("Error updating AppID Cloud Directory user: %s\n%s", err, resp)
+			return flex.NewServiceError("Error updating AppID Cloud Directory user", err, resp).Diagnostics()
 		}
 	}
 
@@ -98,7 +98,7 @@ func resourceIBMAppIDIDPCloudDirectoryRead(ctx context.Context, d *schema.Resour
 			return nil
 		}
 
-		return diag.Errorf("Error loading AppID Cloud Directory IDP: %s\n%s", err, resp)
+		return flex.NewServiceError("Error loading AppID Cloud Directory IDP", err, resp).Diagnostics()
 	}
 
 	d.Set("is_active", *config.IsActive)
@@ -169,7 +169,7 @@ func resourceIBMAppIDIDPCloudDirectoryCreate(ctx context.Context, d *schema.Reso
 	_, resp, err := appIDClient.SetCloudDirectoryIDPWithContext(ctx, config)
 
 	if err != nil {
-		return diag.Errorf("Error applying AppID Cloud Directory IDP configuration: %s\n%s", err, resp)
+		return flex.NewServiceError("Error applying AppID Cloud Directory IDP configuration", err, resp).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -195,7 +195,7 @@ func resourceIBMAppIDIDPCloudDirectoryDelete(ctx context.Context, d *schema.Reso
 	_, resp, err := appIDClient.SetCloudDirectoryIDPWithContext(ctx, config)
 
 	if err != nil {
-		return diag.Errorf("Error resetting AppID Cloud Directory IDP configuration: %s\n%s", err, resp)
+		return flex.NewServiceError("Error resetting AppID Cloud Directory IDP configuration", err, resp).Diagnostics()
 	}
 
 	d.SetId("")
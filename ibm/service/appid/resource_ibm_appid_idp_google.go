@@ -6,6 +6,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -83,7 +84,7 @@ func resourceIBMAppIDIDPGoogleRead(ctx context.Context, d *schema.ResourceData,
 			return nil
 		}
 
-		return diag.Errorf("Error loading AppID Google IDP: %s\n%s", err, resp)
+		return flex.NewServiceError("Error loading AppID Google IDP", err, resp).Diagnostics()
 	}
 
 	d.Set("is_active", *gg.IsActive)
@@ -127,7 +128,7 @@ func resourceIBMAppIDIDPGoogleCreate(ctx context.Context, d *schema.ResourceData
 	_, resp, err := appIDClient.SetGoogleIDPWithContext(ctx, config)
 
 	if err != nil {
-		return diag.Errorf("Error applying AppID Google IDP configuration: %s\n%s", err, resp)
+		return flex.NewServiceError("Error applying AppID Google IDP configuration", err, resp).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -148,7 +149,7 @@ func resourceIBMAppIDIDPGoogleDelete(ctx context.Context, d *schema.ResourceData
 	_, resp, err := appIDClient.SetGoogleIDPWithContext(ctx, config)
 
 	if err != nil {
-		return diag.Errorf("Error resetting AppID Google IDP configuration: %s\n%s", err, resp)
+		return flex.NewServiceError("Error resetting AppID Google IDP configuration", err, resp).Diagnostics()
 	}
 
 	d.SetId("")
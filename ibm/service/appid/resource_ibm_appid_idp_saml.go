@@ -133,7 +133,7 @@ func resourceIBMAppIDIDPSAMLRead(ctx context.Context, d *schema.ResourceData, me
 			return nil
 		}
 
-		return diag.Errorf("Error loading AppID SAML IDP: %s\n%s", err, resp)
+		return flex.NewServiceError("Error loading AppID SAML IDP", err, resp).Diagnostics()
 	}
 
 	d.Set("is_active", *saml.IsActive)
@@ -173,7 +173,7 @@ func resourceIBMAppIDIDPSAMLCreate(ctx context.Context, d *schema.ResourceData,
 	_, resp, err := appIDClient.SetSAMLIDPWithContext(ctx, config)
 
 	if err != nil {
-		return diag.Errorf("Error applying SAML IDP configuration: %s\n%s", err, resp)
+		return flex.NewServiceError("Error applying SAML IDP configuration", err, resp).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -266,7 +266,7 @@ func resourceIBMAppIDIDPSAMLDelete(ctx context.Context, d *schema.ResourceData,
 	_, resp, err := appIDClient.SetSAMLIDPWithContext(ctx, config)
 
 	if err != nil {
-		return diag.Errorf("Error resetting SAML IDP configuration: %s\n%s", err, resp)
+		return flex.NewServiceError("Error resetting SAML IDP configuration", err, resp).Diagnostics()
 	}
 
 	d.SetId("")
@@ -60,7 +60,7 @@ func resourceIBMAppIDLanguagesRead(ctx context.Context, d *schema.ResourceData,
 			return nil
 		}
 
-		return diag.Errorf("Error getting AppID languages: %s\n%s", err, resp)
+		return flex.NewServiceError("Error getting AppID languages", err, resp).Diagnostics()
 	}
 
 	d.Set("languages", langs.Languages)
@@ -87,7 +87,7 @@ func resourceIBMAppIDLanguagesCreate(ctx context.Context, d *schema.ResourceData
 	resp, err := appIDClient.UpdateLocalizationWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error updating AppID languages: %s\n%s", err, resp)
+		return flex.NewServiceError("Error updating AppID languages", err, resp).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -112,7 +112,7 @@ func resourceIBMAppIDLanguagesDelete(ctx context.Context, d *schema.ResourceData
 	resp, err := appIDClient.UpdateLocalizationWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error resetting AppID languages: %s\n%s", err, resp)
+		return flex.NewServiceError("Error resetting AppID languages", err, resp).Diagnostics()
 	}
 
 	d.SetId("")
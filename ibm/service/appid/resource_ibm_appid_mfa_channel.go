@@ -5,6 +5,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -79,7 +80,7 @@ func resourceIBMAppIDMFAChannelRead(ctx context.Context, d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return diag.Errorf("Error getting AppID MFA channels: %s\n%s", err, resp)
+		return flex.NewServiceError("Error getting AppID MFA channels", err, resp).Diagnostics()
 	}
 
 	for _, channel := range ch.Channels {
@@ -133,7 +134,7 @@ func resourceIBMAppIDMFAChannelCreate(ctx context.Context, d *schema.ResourceDat
 	_, resp, err := appIDClient.UpdateChannelWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error updating AppID MFA configuration: %s\n%s", err, resp)
+		return flex.NewServiceError("Error updating AppID MFA configuration", err, resp).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -166,7 +167,7 @@ func resourceIBMAppIDMFAChannelDelete(ctx context.Context, d *schema.ResourceDat
 	_, resp, err := appIDClient.UpdateChannelWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error resetting AppID MFA configuration: %s\n%s", err, resp)
+		return flex.NewServiceError("Error resetting AppID MFA configuration", err, resp).Diagnostics()
 	}
 
 	d.SetId("")
@@ -7,6 +7,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -68,7 +69,7 @@ func resourceIBMAppIDPasswordRegexRead(ctx context.Context, d *schema.ResourceDa
 			return nil
 		}
 
-		return diag.Errorf("Error loading AppID Cloud Directory password regex: %s\n%s", err, resp)
+		return flex.NewServiceError("Error loading AppID Cloud Directory password regex", err, resp).Diagnostics()
 	}
 
 	if pw.Base64EncodedRegex != nil {
@@ -110,7 +111,7 @@ func resourceIBMAppIDPasswordRegexCreate(ctx context.Context, d *schema.Resource
 	_, resp, err := appIDClient.SetCloudDirectoryPasswordRegexWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error setting AppID Cloud Directory password regex: %s\n%s", err, resp)
+		return flex.NewServiceError("Error setting AppID Cloud Directory password regex", err, resp).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -139,7 +140,7 @@ func resourceIBMAppIDPasswordRegexDelete(ctx context.Context, d *schema.Resource
 	_, resp, err := appIDClient.SetCloudDirectoryPasswordRegexWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error resetting AppID Cloud Directory password regex: %s\n%s", err, resp)
+		return flex.NewServiceError("Error resetting AppID Cloud Directory password regex", err, resp).Diagnostics()
 	}
 
 	d.SetId("")
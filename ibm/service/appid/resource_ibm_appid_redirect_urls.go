@@ -52,7 +52,7 @@ func resourceIBMAppIDRedirectURLsRead(ctx context.Context, d *schema.ResourceDat
 		TenantID: &tenantID,
 	})
 	if err != nil {
-		return diag.Errorf("Error loading AppID Cloud Directory redirect urls: %s\n%s", err, resp)
+		return flex.NewServiceError("Error loading AppID Cloud Directory redirect urls", err, resp).Diagnostics()
 	}
 
 	if err := d.Set("urls", urls.RedirectUris); err != nil {
@@ -83,7 +83,7 @@ func resourceIBMAppIDRedirectURLsCreate(ctx context.Context, d *schema.ResourceD
 	})
 
 	if err != nil {
-		return diag.Errorf("Error updating AppID Cloud Directory redirect URLs: %s\n%s", err, resp)
+		return flex.NewServiceError("Error updating AppID Cloud Directory redirect URLs", err, resp).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -109,7 +109,7 @@ func resourceIBMAppIDRedirectURLsUpdate(ctx context.Context, d *schema.ResourceD
 	})
 
 	if err != nil {
-		return diag.Errorf("Error updating AppID Cloud Directory redirect URLs: %s\n%s", err, resp)
+		return flex.NewServiceError("Error updating AppID Cloud Directory redirect URLs", err, resp).Diagnostics()
 	}
 
 	return resourceIBMAppIDRedirectURLsRead(ctx, d, meta)
@@ -132,7 +132,7 @@ func resourceIBMAppIDRedirectURLsDelete(ctx context.Context, d *schema.ResourceD
 	})
 
 	if err != nil {
-		return diag.Errorf("Error resetting AppID Cloud Directory redirect URLs: %s\n%s", err, resp)
+		return flex.NewServiceError("Error resetting AppID Cloud Directory redirect URLs", err, resp).Diagnostics()
 	}
 
 	d.SetId("")
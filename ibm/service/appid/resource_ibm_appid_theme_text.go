@@ -6,6 +6,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -57,7 +58,7 @@ func resourceIBMAppIDThemeTextRead(ctx context.Context, d *schema.ResourceData,
 			return nil
 		}
 
-		return diag.Errorf("Error getting AppID theme text: %s\n%s", err, resp)
+		return flex.NewServiceError("Error getting AppID theme text", err, resp).Diagnostics()
 	}
 
 	if text.TabTitle != nil {
@@ -91,7 +92,7 @@ func resourceIBMAppIDThemeTextCreate(ctx context.Context, d *schema.ResourceData
 	resp, err := appIDClient.PostThemeTextWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error setting AppID theme text: %s\n%s", err, resp)
+		return flex.NewServiceError("Error setting AppID theme text", err, resp).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -121,7 +122,7 @@ func resourceIBMAppIDThemeTextDelete(ctx context.Context, d *schema.ResourceData
 	resp, err := appIDClient.PostThemeTextWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error resetting AppID theme text: %s\n%s", err, resp)
+		return flex.NewServiceError("Error resetting AppID theme text", err, resp).Diagnostics()
 	}
 
 	d.SetId("")
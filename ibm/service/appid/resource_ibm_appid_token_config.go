@@ -8,6 +8,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -124,7 +125,7 @@ func resourceIBMAppIDTokenConfigCreate(ctx context.Context, d *schema.ResourceDa
 	_, resp, err := appidClient.PutTokensConfigWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("Error updating AppID token configuration: %s\n%s", err, resp)
+		return flex.NewServiceError("Error updating AppID token configuration", err, resp).Diagnostics()
 	}
 
 	d.SetId(tenantID)
@@ -153,7 +154,7 @@ func resourceIBMAppIDTokenConfigRead(ctx context.Context, d *schema.ResourceData
 			return nil
 		}
 
-		return diag.Errorf("Error reading AppID token configuration: %s\n%s", err, response)
+		return flex.NewServiceError("Error reading AppID token configuration", err, response).Diagnostics()
 	}
 
 	if tokenConfig.Access != nil {
@@ -315,7 +316,7 @@ func resourceIBMAppIDTokenConfigDelete(ctx context.Context, d *schema.ResourceDa
 	_, resp, err := appidClient.PutTokensConfigWithContext(ctx, config)
 
 	if err != nil {
-		return diag.Errorf("Error resetting AppID token configuration: %s\n%s", err, resp)
+		return flex.NewServiceError("Error resetting AppID token configuration", err, resp).Diagnostics()
 	}
 
 	d.SetId("")
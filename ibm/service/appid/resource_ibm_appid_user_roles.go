@@ -73,7 +73,7 @@ func resourceIBMAppIDUserRolesRead(ctx context.Context, d *schema.ResourceData,
 
 	if err != nil {
 		log.Printf("[DEBUG] Error getting AppID user roles: %s\n%s", err, resp)
-		return diag.Errorf("Error getting AppID user roles: %s", err)
+		return flex.NewServiceError("Error getting AppID user roles", err, resp).Diagnostics()
 	}
 
 	if roles.Roles != nil {
@@ -108,7 +108,7 @@ func resourceIBMAppIDUserRolesCreate(ctx context.Context, d *schema.ResourceData
 
 	if err != nil {
 		log.Printf("[DEBUG] Error updating AppID user roles: %s\n%s", err, resp)
-		return diag.Errorf("Error updating AppID user roles: %s", err)
+		return flex.NewServiceError("Error updating AppID user roles", err, resp).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", tenantID, subject))
@@ -137,7 +137,7 @@ func resourceIBMAppIDUserRolesDelete(ctx context.Context, d *schema.ResourceData
 
 	if err != nil {
 		log.Printf("[DEBUG] Error deleting AppID user roles: %s\n%s", err, resp)
-		return diag.Errorf("Error deleting AppID user roles: %s", err)
+		return flex.NewServiceError("Error deleting AppID user roles", err, resp).Diagnostics()
 	}
 
 	d.SetId("")
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMAtrackerRoutes() *schema.Resource {
@@ -127,7 +129,7 @@ func dataSourceIBMAtrackerRoutesRead(context context.Context, d *schema.Resource
 	routeList, response, err := atrackerClientv2.ListRoutesWithContext(context, listRoutesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListRoutesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListRoutesWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ListRoutesWithContext failed", err, response).Diagnostics()
 	}
 
 	// Use the provided filter argument and construct a new list with only the requested resource(s)
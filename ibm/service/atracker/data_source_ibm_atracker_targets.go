@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMAtrackerTargets() *schema.Resource {
@@ -250,7 +252,7 @@ func DataSourceIBMAtrackerTargetsRead(context context.Context, d *schema.Resourc
 	targetList, response, err := atrackerClientv2.ListTargetsWithContext(context, listTargetsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListTargetsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListTargetsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ListTargetsWithContext failed", err, response).Diagnostics()
 	}
 
 	// Use the provided filter argument and construct a new list with only the requested resource(s)
@@ -139,7 +139,7 @@ func resourceIBMAtrackerRouteCreate(context context.Context, d *schema.ResourceD
 	route, response, err := atrackerClient.CreateRouteWithContext(context, createRouteOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateRouteWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateRouteWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*route.ID)
@@ -163,7 +163,7 @@ func resourceIBMAtrackerRouteRead(context context.Context, d *schema.ResourceDat
 
 	if err != nil && response != nil && response.StatusCode != 404 {
 		log.Printf("[DEBUG] GetRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetRouteWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetRouteWithContext failed", err, response).Diagnostics()
 	}
 	if err == nil && response != nil {
 		if err = d.Set("name", route.Name); err != nil {
@@ -219,7 +219,7 @@ func resourceIBMAtrackerRouteUpdate(context context.Context, d *schema.ResourceD
 	_, response, err := atrackerClient.ReplaceRouteWithContext(context, replaceRouteOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ReplaceRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ReplaceRouteWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ReplaceRouteWithContext failed", err, response).Diagnostics()
 	}
 	return resourceIBMAtrackerRouteRead(context, d, meta)
 }
@@ -237,7 +237,7 @@ func resourceIBMAtrackerRouteDelete(context context.Context, d *schema.ResourceD
 	response, err := atrackerClient.DeleteRouteWithContext(context, deleteRouteOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteRouteWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteRouteWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
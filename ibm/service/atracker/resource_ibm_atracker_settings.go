@@ -114,7 +114,7 @@ func resourceIBMAtrackerSettingsCreate(context context.Context, d *schema.Resour
 	settings, response, err := atrackerClient.PutSettingsWithContext(context, putSettingsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] PutSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("PutSettingsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("PutSettingsWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*settings.MetadataRegionPrimary)
@@ -136,7 +136,7 @@ func resourceIBMAtrackerSettingsRead(context context.Context, d *schema.Resource
 			return nil
 		}
 		log.Printf("[DEBUG] GetSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSettingsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSettingsWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("metadata_region_primary", settings.MetadataRegionPrimary); err != nil {
@@ -196,7 +196,7 @@ func resourceIBMAtrackerSettingsUpdate(context context.Context, d *schema.Resour
 		if err != nil {
 			log.Printf("[DEBUG] PutSettingsWithContext failed %s\n%s", err, response)
 			log.Printf("[DEBUG] PutSettingsWithContext failed %v\n", putSettingsOptions)
-			return diag.FromErr(fmt.Errorf("PutSettingsWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("PutSettingsWithContext failed", err, response).Diagnostics()
 		}
 		d.SetId(*setting.MetadataRegionPrimary)
 	}
@@ -226,7 +226,7 @@ func resourceIBMAtrackerSettingsDelete(context context.Context, d *schema.Resour
 	settings, getResponse, err := atrackerClient.GetSettingsWithContext(context, &atrackerv2.GetSettingsOptions{})
 	if err != nil {
 		log.Printf("[DEBUG] PutSettingsWithContext with GetSettingsWithContext failed %s\n%s", err, getResponse)
-		return diag.FromErr(fmt.Errorf("GetSettingsWithContext failed %s\n%s", err, getResponse))
+		return flex.NewServiceError("GetSettingsWithContext failed", err, getResponse).Diagnostics()
 	}
 	putSettingsOptions := &atrackerv2.PutSettingsOptions{}
 
@@ -238,7 +238,7 @@ func resourceIBMAtrackerSettingsDelete(context context.Context, d *schema.Resour
 	_, response, err := atrackerClient.PutSettingsWithContext(context, putSettingsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] PutSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("PutSettingsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("PutSettingsWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
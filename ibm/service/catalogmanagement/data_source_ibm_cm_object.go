@@ -207,7 +207,7 @@ func dataSourceIBMCmObjectRead(context context.Context, d *schema.ResourceData,
 	catalogObject, response, err := catalogManagementClient.GetObjectWithContext(context, getObjectOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetObjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetObjectWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetObjectWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*getObjectOptions.ObjectIdentifier)
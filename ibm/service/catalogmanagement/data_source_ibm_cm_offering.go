@@ -2475,7 +2475,7 @@ func dataSourceIBMCmOfferingRead(context context.Context, d *schema.ResourceData
 	offering, response, err := catalogManagementClient.GetOfferingWithContext(context, getOfferingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetOfferingWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*getOfferingOptions.OfferingID)
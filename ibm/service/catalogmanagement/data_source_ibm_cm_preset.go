@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
 )
 
@@ -66,7 +67,7 @@ func dataSourceIBMCmPresetRead(context context.Context, d *schema.ResourceData,
 	catalogObject, response, err := catalogManagementClient.GetObjectWithContext(context, getObjectOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetObjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetObjectWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetObjectWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(presetID)
@@ -1625,7 +1625,7 @@ func dataSourceIBMCmVersionRead(context context.Context, d *schema.ResourceData,
 	offering, response, err := catalogManagementClient.GetVersionWithContext(context, getVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetVersionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetVersionWithContext failed", err, response).Diagnostics()
 	}
 	version := offering.Kinds[0].Versions[0]
 
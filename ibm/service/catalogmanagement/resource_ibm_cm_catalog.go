@@ -502,7 +502,7 @@ func resourceIBMCmCatalogCreate(context context.Context, d *schema.ResourceData,
 	catalog, response, err := catalogManagementClient.CreateCatalogWithContext(context, createCatalogOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateCatalogWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateCatalogWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateCatalogWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*catalog.ID)
@@ -526,7 +526,7 @@ func resourceIBMCmCatalogRead(context context.Context, d *schema.ResourceData, m
 			return nil
 		}
 		log.Printf("[DEBUG] GetCatalogWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetCatalogWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetCatalogWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("rev", catalog.Rev); err != nil {
@@ -643,7 +643,7 @@ func resourceIBMCmCatalogUpdate(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetCatalogWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetCatalogWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetCatalogWithContext failed", err, response).Diagnostics()
 	}
 
 	replaceCatalogOptions := &catalogmanagementv1.ReplaceCatalogOptions{}
@@ -720,7 +720,7 @@ func resourceIBMCmCatalogUpdate(context context.Context, d *schema.ResourceData,
 	_, response, err = catalogManagementClient.ReplaceCatalogWithContext(context, replaceCatalogOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ReplaceCatalogWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ReplaceCatalogWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ReplaceCatalogWithContext failed", err, response).Diagnostics()
 	}
 
 	return resourceIBMCmCatalogRead(context, d, meta)
@@ -739,7 +739,7 @@ func resourceIBMCmCatalogDelete(context context.Context, d *schema.ResourceData,
 	response, err := catalogManagementClient.DeleteCatalogWithContext(context, deleteCatalogOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteCatalogWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteCatalogWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteCatalogWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
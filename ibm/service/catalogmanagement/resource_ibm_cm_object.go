@@ -222,7 +222,7 @@ func resourceIBMCmObjectCreate(context context.Context, d *schema.ResourceData,
 	catalogObject, response, err := catalogManagementClient.CreateObjectWithContext(context, createObjectOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateObjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateObjectWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateObjectWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*catalogObject.ID)
@@ -273,7 +273,7 @@ func resourceIBMCmObjectCreate(context context.Context, d *schema.ResourceData,
 		catalogObject, response, err = catalogManagementClient.ReplaceObjectWithContext(context, replaceObjectOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ReplaceObjectWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceObjectWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ReplaceObjectWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -298,7 +298,7 @@ func resourceIBMCmObjectRead(context context.Context, d *schema.ResourceData, me
 			return nil
 		}
 		log.Printf("[DEBUG] GetObjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetObjectWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetObjectWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("catalog_id", getObjectOptions.CatalogIdentifier); err != nil {
@@ -405,7 +405,7 @@ func resourceIBMCmObjectUpdate(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetObjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetObjectWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetObjectWithContext failed", err, response).Diagnostics()
 	}
 
 	replaceObjectOptions := &catalogmanagementv1.ReplaceObjectOptions{}
@@ -506,7 +506,7 @@ func resourceIBMCmObjectUpdate(context context.Context, d *schema.ResourceData,
 	_, response, err = catalogManagementClient.ReplaceObjectWithContext(context, replaceObjectOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ReplaceObjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ReplaceObjectWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ReplaceObjectWithContext failed", err, response).Diagnostics()
 	}
 
 	return resourceIBMCmObjectRead(context, d, meta)
@@ -526,7 +526,7 @@ func resourceIBMCmObjectDelete(context context.Context, d *schema.ResourceData,
 	response, err := catalogManagementClient.DeleteObjectWithContext(context, deleteObjectOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteObjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteObjectWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteObjectWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
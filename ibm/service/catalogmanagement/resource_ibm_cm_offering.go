@@ -2585,7 +2585,7 @@ func resourceIBMCmOfferingCreate(context context.Context, d *schema.ResourceData
 				return nil
 			}
 			log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("GetOfferingWithContext failed", err, response).Diagnostics()
 		}
 
 		d.SetId(*offering.ID)
@@ -2791,7 +2791,7 @@ func resourceIBMCmOfferingCreate(context context.Context, d *schema.ResourceData
 	offering, response, err := catalogManagementClient.CreateOfferingWithContext(context, createOfferingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateOfferingWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateOfferingWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*offering.ID)
@@ -2822,7 +2822,7 @@ func resourceIBMCmOfferingRead(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetOfferingWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("catalog_id", getOfferingOptions.CatalogIdentifier); err != nil {
@@ -3086,7 +3086,7 @@ func resourceIBMCmOfferingUpdate(context context.Context, d *schema.ResourceData
 			return nil
 		}
 		log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetOfferingWithContext failed", err, response).Diagnostics()
 	}
 
 	updateOfferingOptions.SetCatalogIdentifier(*offering.CatalogID)
@@ -3559,7 +3559,7 @@ func resourceIBMCmOfferingUpdate(context context.Context, d *schema.ResourceData
 			_, response, err = catalogManagementClient.DeleteOfferingAccessListWithContext(context, &deleteOfferingAccessListOptions)
 			if err != nil {
 				log.Printf("[DEBUG] DeleteOfferingAccessListWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("DeleteOfferingAccessListWithContext failed %s\n%s", err, response))
+				return flex.NewServiceError("DeleteOfferingAccessListWithContext failed", err, response).Diagnostics()
 			}
 		}
 
@@ -3572,7 +3572,7 @@ func resourceIBMCmOfferingUpdate(context context.Context, d *schema.ResourceData
 			_, response, err = catalogManagementClient.AddOfferingAccessListWithContext(context, &addOfferingAccessListOptions)
 			if err != nil {
 				log.Printf("[DEBUG] AddOfferingAccessListWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("AddOfferingAccessListWithContext failed %s\n%s", err, response))
+				return flex.NewServiceError("AddOfferingAccessListWithContext failed", err, response).Diagnostics()
 			}
 		}
 	}
@@ -3601,7 +3601,7 @@ func resourceIBMCmOfferingUpdate(context context.Context, d *schema.ResourceData
 		_, response, err = catalogManagementClient.ShareOfferingWithContext(context, &shareOfferingOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ShareOfferingWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ShareOfferingWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ShareOfferingWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -3614,7 +3614,7 @@ func resourceIBMCmOfferingUpdate(context context.Context, d *schema.ResourceData
 		response, err := catalogManagementClient.DeprecateOfferingWithContext(context, deprecateOfferingOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateOfferingWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateOfferingWithContext failed trying to deprecate offering - %s\n%s", err, response))
+			return flex.NewServiceError("UpdateOfferingWithContext failed trying to deprecate offering -", err, response).Diagnostics()
 		}
 	}
 
@@ -3622,7 +3622,7 @@ func resourceIBMCmOfferingUpdate(context context.Context, d *schema.ResourceData
 		_, response, err := catalogManagementClient.UpdateOfferingWithContext(context, updateOfferingOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateOfferingWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateOfferingWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateOfferingWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -3643,7 +3643,7 @@ func resourceIBMCmOfferingDelete(context context.Context, d *schema.ResourceData
 	response, err := catalogManagementClient.DeleteOfferingWithContext(context, deleteOfferingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteOfferingWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteOfferingWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -5874,7 +5874,7 @@ func handleShareOfferingAfterCreate(catalogManagementClient *catalogmanagementv1
 			_, response, err := catalogManagementClient.AddOfferingAccessListWithContext(context, &addOfferingAccessListOptions)
 			if err != nil {
 				log.Printf("[DEBUG] AddOfferingAccessListWithContext failed %s\n%s", err, response)
-				return fmt.Errorf("AddOfferingAccessListWithContext failed %s\n%s", err, response)
+				return flex.NewServiceError("AddOfferingAccessListWithContext failed", err, response)
 			}
 		}
 	}
@@ -5903,7 +5903,7 @@ func handleShareOfferingAfterCreate(catalogManagementClient *catalogmanagementv1
 		_, response, err := catalogManagementClient.ShareOfferingWithContext(context, &shareOfferingOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ShareOfferingWithContext failed %s\n%s", err, response)
-			return fmt.Errorf("ShareOfferingWithContext failed %s\n%s", err, response)
+			return flex.NewServiceError("ShareOfferingWithContext failed", err, response)
 		}
 	}
 
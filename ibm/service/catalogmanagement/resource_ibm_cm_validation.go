@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
 )
@@ -189,7 +190,7 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 				return nil
 			}
 			log.Printf("[DEBUG] GetVersionWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetVersionWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("GetVersionWithContext failed", err, response).Diagnostics()
 		}
 
 		version = offering.Kinds[0].Versions[0]
@@ -248,7 +249,7 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 	response, err := catalogManagementClient.ValidateInstallWithContext(context, validateInstallOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ValidateInstallWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ValidateInstallWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ValidateInstallWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*validateInstallOptions.VersionLocID)
@@ -259,7 +260,7 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 	result, response, err := catalogManagementClient.GetValidationStatusWithContext(context, validationStatusOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetValidationStatusWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetValidationStatusWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetValidationStatusWithContext failed", err, response).Diagnostics()
 	}
 
 	status := *result.State
@@ -282,7 +283,7 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 		result, response, err = catalogManagementClient.GetValidationStatusWithContext(context, validationStatusOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetValidationStatusWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetValidationStatusWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("GetValidationStatusWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -315,7 +316,7 @@ func resourceIBMCmValidationRead(context context.Context, d *schema.ResourceData
 			return nil
 		}
 		log.Printf("[DEBUG] GetVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetVersionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetVersionWithContext failed", err, response).Diagnostics()
 	}
 
 	version := offering.Kinds[0].Versions[0]
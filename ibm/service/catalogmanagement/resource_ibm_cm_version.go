@@ -1814,13 +1814,13 @@ func resourceIBMCmVersionCreate(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetOfferingWithContext failed", err, response).Diagnostics()
 	}
 
 	offering, response, err := catalogManagementClient.ImportOfferingVersionWithContext(context, importOfferingVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ImportOfferingVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ImportOfferingVersionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ImportOfferingVersionWithContext failed", err, response).Diagnostics()
 	}
 
 	activeVersion, err := getVersionFromOffering(oldOffering, offering)
@@ -2016,7 +2016,7 @@ func resourceIBMCmVersionCreate(context context.Context, d *schema.ResourceData,
 		_, response, err := catalogManagementClient.UpdateOfferingWithContext(context, updateOfferingOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateOfferingWithContext failed in ibm_cm_version update %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateOfferingWithContext failed in ibm_cm_version update %s\n%s", err, response))
+			return flex.NewServiceError("UpdateOfferingWithContext failed in ibm_cm_version update", err, response).Diagnostics()
 		}
 	}
 
@@ -2040,7 +2040,7 @@ func resourceIBMCmVersionRead(context context.Context, d *schema.ResourceData, m
 			return nil
 		}
 		log.Printf("[DEBUG] GetVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetVersionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetVersionWithContext failed", err, response).Diagnostics()
 	}
 
 	version := offering.Kinds[0].Versions[0]
@@ -2305,7 +2305,7 @@ func resourceIBMCmVersionUpdate(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetVersionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetVersionWithContext failed", err, response).Diagnostics()
 	}
 	activeVersion := partialOffering.Kinds[0].Versions[0]
 
@@ -2320,7 +2320,7 @@ func resourceIBMCmVersionUpdate(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetOfferingWithContext failed", err, response).Diagnostics()
 	}
 
 	updateOfferingOptions := &catalogmanagementv1.UpdateOfferingOptions{}
@@ -2508,7 +2508,7 @@ func resourceIBMCmVersionUpdate(context context.Context, d *schema.ResourceData,
 		_, response, err := catalogManagementClient.UpdateOfferingWithContext(context, updateOfferingOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateOfferingWithContext failed in ibm_cm_version update %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateOfferingWithContext failed in ibm_cm_version update %s\n%s", err, response))
+			return flex.NewServiceError("UpdateOfferingWithContext failed in ibm_cm_version update", err, response).Diagnostics()
 		}
 	}
 
@@ -2520,7 +2520,7 @@ func resourceIBMCmVersionUpdate(context context.Context, d *schema.ResourceData,
 		response, err := catalogManagementClient.SetDeprecateVersionWithContext(context, setDeprecateVersionOptions)
 		if err != nil {
 			log.Printf("[DEBUG] SetDeprecateVersionWithContext failed in ibm_cm_version  %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("SetDeprecateVersionWithContext failed trying to deprecate version - %s\n%s", err, response))
+			return flex.NewServiceError("SetDeprecateVersionWithContext failed trying to deprecate version -", err, response).Diagnostics()
 		}
 	}
 
@@ -2544,7 +2544,7 @@ func resourceIBMCmVersionDelete(context context.Context, d *schema.ResourceData,
 	response, err := catalogManagementClient.DeleteVersionWithContext(context, deleteVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteVersionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteVersionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
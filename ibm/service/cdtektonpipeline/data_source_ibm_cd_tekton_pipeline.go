@@ -515,7 +515,7 @@ func dataSourceIBMCdTektonPipelineRead(context context.Context, d *schema.Resour
 	tektonPipeline, response, err := cdTektonPipelineClient.GetTektonPipelineWithContext(context, getTektonPipelineOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetTektonPipelineWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelineWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetTektonPipelineWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s", *getTektonPipelineOptions.ID))
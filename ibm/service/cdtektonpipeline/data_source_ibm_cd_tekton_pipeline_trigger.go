@@ -277,7 +277,7 @@ func dataSourceIBMCdTektonPipelineTriggerRead(context context.Context, d *schema
 	TriggerIntf, response, err := cdTektonPipelineClient.GetTektonPipelineTriggerWithContext(context, getTektonPipelineTriggerOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetTektonPipelineTriggerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelineTriggerWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetTektonPipelineTriggerWithContext failed", err, response).Diagnostics()
 	}
 	trigger := TriggerIntf.(*cdtektonpipelinev2.Trigger)
 
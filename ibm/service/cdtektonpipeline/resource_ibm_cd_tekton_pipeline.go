@@ -573,7 +573,7 @@ func resourceIBMCdTektonPipelineCreate(context context.Context, d *schema.Resour
 	tektonPipeline, response, err := cdTektonPipelineClient.CreateTektonPipelineWithContext(context, createTektonPipelineOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateTektonPipelineWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTektonPipelineWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateTektonPipelineWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*tektonPipeline.ID)
@@ -598,7 +598,7 @@ func resourceIBMCdTektonPipelineRead(context context.Context, d *schema.Resource
 			return nil
 		}
 		log.Printf("[DEBUG] GetTektonPipelineWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelineWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetTektonPipelineWithContext failed", err, response).Diagnostics()
 	}
 
 	if !core.IsNil(tektonPipeline.Worker) {
@@ -747,7 +747,7 @@ func resourceIBMCdTektonPipelineUpdate(context context.Context, d *schema.Resour
 		_, response, err := cdTektonPipelineClient.UpdateTektonPipelineWithContext(context, updateTektonPipelineOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateTektonPipelineWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateTektonPipelineWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateTektonPipelineWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -767,7 +767,7 @@ func resourceIBMCdTektonPipelineDelete(context context.Context, d *schema.Resour
 	response, err := cdTektonPipelineClient.DeleteTektonPipelineWithContext(context, deleteTektonPipelineOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteTektonPipelineWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTektonPipelineWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteTektonPipelineWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
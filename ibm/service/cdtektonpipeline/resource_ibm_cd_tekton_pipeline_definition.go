@@ -146,7 +146,7 @@ func resourceIBMCdTektonPipelineDefinitionCreate(context context.Context, d *sch
 	definition, response, err := cdTektonPipelineClient.CreateTektonPipelineDefinitionWithContext(context, createTektonPipelineDefinitionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateTektonPipelineDefinitionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTektonPipelineDefinitionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateTektonPipelineDefinitionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createTektonPipelineDefinitionOptions.PipelineID, *definition.ID))
@@ -177,7 +177,7 @@ func resourceIBMCdTektonPipelineDefinitionRead(context context.Context, d *schem
 			return nil
 		}
 		log.Printf("[DEBUG] GetTektonPipelineDefinitionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelineDefinitionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetTektonPipelineDefinitionWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("pipeline_id", getTektonPipelineDefinitionOptions.PipelineID); err != nil {
@@ -237,7 +237,7 @@ func resourceIBMCdTektonPipelineDefinitionUpdate(context context.Context, d *sch
 		_, response, err := cdTektonPipelineClient.ReplaceTektonPipelineDefinitionWithContext(context, replaceTektonPipelineDefinitionOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ReplaceTektonPipelineDefinitionWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceTektonPipelineDefinitionWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ReplaceTektonPipelineDefinitionWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -263,7 +263,7 @@ func resourceIBMCdTektonPipelineDefinitionDelete(context context.Context, d *sch
 	response, err := cdTektonPipelineClient.DeleteTektonPipelineDefinitionWithContext(context, deleteTektonPipelineDefinitionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteTektonPipelineDefinitionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTektonPipelineDefinitionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteTektonPipelineDefinitionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -157,7 +157,7 @@ func resourceIBMCdTektonPipelinePropertyCreate(context context.Context, d *schem
 	property, response, err := cdTektonPipelineClient.CreateTektonPipelinePropertiesWithContext(context, createTektonPipelinePropertiesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateTektonPipelinePropertiesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTektonPipelinePropertiesWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateTektonPipelinePropertiesWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createTektonPipelinePropertiesOptions.PipelineID, *property.Name))
@@ -188,7 +188,7 @@ func resourceIBMCdTektonPipelinePropertyRead(context context.Context, d *schema.
 			return nil
 		}
 		log.Printf("[DEBUG] GetTektonPipelinePropertyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelinePropertyWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetTektonPipelinePropertyWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("pipeline_id", getTektonPipelinePropertyOptions.PipelineID); err != nil {
@@ -285,7 +285,7 @@ func resourceIBMCdTektonPipelinePropertyUpdate(context context.Context, d *schem
 		_, response, err := cdTektonPipelineClient.ReplaceTektonPipelinePropertyWithContext(context, replaceTektonPipelinePropertyOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ReplaceTektonPipelinePropertyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceTektonPipelinePropertyWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ReplaceTektonPipelinePropertyWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -311,7 +311,7 @@ func resourceIBMCdTektonPipelinePropertyDelete(context context.Context, d *schem
 	response, err := cdTektonPipelineClient.DeleteTektonPipelinePropertyWithContext(context, deleteTektonPipelinePropertyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteTektonPipelinePropertyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTektonPipelinePropertyWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteTektonPipelinePropertyWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -413,7 +413,7 @@ func resourceIBMCdTektonPipelineTriggerCreate(context context.Context, d *schema
 	triggerIntf, response, err := cdTektonPipelineClient.CreateTektonPipelineTriggerWithContext(context, createTektonPipelineTriggerOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateTektonPipelineTriggerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTektonPipelineTriggerWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateTektonPipelineTriggerWithContext failed", err, response).Diagnostics()
 	}
 
 	trigger := triggerIntf.(*cdtektonpipelinev2.Trigger)
@@ -445,7 +445,7 @@ func resourceIBMCdTektonPipelineTriggerRead(context context.Context, d *schema.R
 			return nil
 		}
 		log.Printf("[DEBUG] GetTektonPipelineTriggerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelineTriggerWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetTektonPipelineTriggerWithContext failed", err, response).Diagnostics()
 	}
 
 	trigger := triggerIntf.(*cdtektonpipelinev2.Trigger)
@@ -667,7 +667,7 @@ func resourceIBMCdTektonPipelineTriggerUpdate(context context.Context, d *schema
 		_, response, err := cdTektonPipelineClient.UpdateTektonPipelineTriggerWithContext(context, updateTektonPipelineTriggerOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateTektonPipelineTriggerWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateTektonPipelineTriggerWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateTektonPipelineTriggerWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -693,7 +693,7 @@ func resourceIBMCdTektonPipelineTriggerDelete(context context.Context, d *schema
 	response, err := cdTektonPipelineClient.DeleteTektonPipelineTriggerWithContext(context, deleteTektonPipelineTriggerOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteTektonPipelineTriggerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTektonPipelineTriggerWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteTektonPipelineTriggerWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -174,7 +174,7 @@ func resourceIBMCdTektonPipelineTriggerPropertyCreate(context context.Context, d
 	triggerProperty, response, err := cdTektonPipelineClient.CreateTektonPipelineTriggerPropertiesWithContext(context, createTektonPipelineTriggerPropertiesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateTektonPipelineTriggerPropertiesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTektonPipelineTriggerPropertiesWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateTektonPipelineTriggerPropertiesWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", *createTektonPipelineTriggerPropertiesOptions.PipelineID, *createTektonPipelineTriggerPropertiesOptions.TriggerID, *triggerProperty.Name))
@@ -206,7 +206,7 @@ func resourceIBMCdTektonPipelineTriggerPropertyRead(context context.Context, d *
 			return nil
 		}
 		log.Printf("[DEBUG] GetTektonPipelineTriggerPropertyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTektonPipelineTriggerPropertyWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetTektonPipelineTriggerPropertyWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("pipeline_id", getTektonPipelineTriggerPropertyOptions.PipelineID); err != nil {
@@ -311,7 +311,7 @@ func resourceIBMCdTektonPipelineTriggerPropertyUpdate(context context.Context, d
 		_, response, err := cdTektonPipelineClient.ReplaceTektonPipelineTriggerPropertyWithContext(context, replaceTektonPipelineTriggerPropertyOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ReplaceTektonPipelineTriggerPropertyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceTektonPipelineTriggerPropertyWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ReplaceTektonPipelineTriggerPropertyWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -338,7 +338,7 @@ func resourceIBMCdTektonPipelineTriggerPropertyDelete(context context.Context, d
 	response, err := cdTektonPipelineClient.DeleteTektonPipelineTriggerPropertyWithContext(context, deleteTektonPipelineTriggerPropertyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteTektonPipelineTriggerPropertyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTektonPipelineTriggerPropertyWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteTektonPipelineTriggerPropertyWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -163,7 +163,7 @@ func dataSourceIBMCdToolchainToolArtifactoryRead(context context.Context, d *sch
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetToolByIDWithContext failed", err, response).Diagnostics()
 	}
 
 	if *toolchainTool.ToolTypeID != "artifactory" {
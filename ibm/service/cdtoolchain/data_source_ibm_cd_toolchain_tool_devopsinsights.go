@@ -103,7 +103,7 @@ func dataSourceIBMCdToolchainToolDevopsinsightsRead(context context.Context, d *
 	toolchainTool, response, err := cdToolchainClient.GetToolByIDWithContext(context, getToolByIDOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetToolByIDWithContext failed", err, response).Diagnostics()
 	}
 
 	if *toolchainTool.ToolTypeID != "draservicebroker" {
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -31,7 +30,7 @@ func ResourceIBMCdToolchain() *schema.Resource {
 		Importer:      &schema.ResourceImporter{},
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -104,6 +103,13 @@ func ResourceIBMCdToolchain() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_cd_toolchain", "tags"),
 				Description:  "Toolchain tags.",
 			},
+			"tags_all": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
 		},
 	}
 }
@@ -168,13 +174,12 @@ func resourceIBMCdToolchainCreate(context context.Context, d *schema.ResourceDat
 	toolchainPost, response, err := cdToolchainClient.CreateToolchainWithContext(context, createToolchainOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolchainWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolchainWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateToolchainWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*toolchainPost.ID)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, "tags") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *toolchainPost.CRN)
 		if err != nil {
@@ -217,7 +222,7 @@ func resourceIBMCdToolchainRead(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolchainByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolchainByIDWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetToolchainByIDWithContext failed", err, response).Diagnostics()
 	}
 
 	tags, err := flex.GetTagsUsingCRN(meta, *toolchain.CRN)
@@ -225,7 +230,7 @@ func resourceIBMCdToolchainRead(context context.Context, d *schema.ResourceData,
 		log.Printf(
 			"Error on get of toolchain (%s) tags: %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	flex.SetResourceTags(d, meta, "tags", "user", tags)
 
 	if err = d.Set("name", toolchain.Name); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
@@ -290,7 +295,7 @@ func resourceIBMCdToolchainUpdate(context context.Context, d *schema.ResourceDat
 		hasChange = true
 	}
 
-	if d.HasChange("tags") {
+	if flex.TagsHasChange(d, "tags") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string))
 		if err != nil {
@@ -304,7 +309,7 @@ func resourceIBMCdToolchainUpdate(context context.Context, d *schema.ResourceDat
 		_, response, err := cdToolchainClient.UpdateToolchainWithContext(context, updateToolchainOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolchainWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolchainWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateToolchainWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -324,7 +329,7 @@ func resourceIBMCdToolchainDelete(context context.Context, d *schema.ResourceDat
 	response, err := cdToolchainClient.DeleteToolchainWithContext(context, deleteToolchainOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolchainWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolchainWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteToolchainWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
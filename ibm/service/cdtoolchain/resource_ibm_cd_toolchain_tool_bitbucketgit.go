@@ -286,7 +286,7 @@ func resourceIBMCdToolchainToolBitbucketgitCreate(context context.Context, d *sc
 	toolchainToolPost, response, err := cdToolchainClient.CreateToolWithContext(context, createToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateToolWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createToolOptions.ToolchainID, *toolchainToolPost.ID))
@@ -331,7 +331,7 @@ func resourceIBMCdToolchainToolBitbucketgitRead(context context.Context, d *sche
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetToolByIDWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("toolchain_id", toolchainTool.ToolchainID); err != nil {
@@ -423,7 +423,7 @@ func resourceIBMCdToolchainToolBitbucketgitUpdate(context context.Context, d *sc
 		_, response, err := cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateToolWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -449,7 +449,7 @@ func resourceIBMCdToolchainToolBitbucketgitDelete(context context.Context, d *sc
 	response, err := cdToolchainClient.DeleteToolWithContext(context, deleteToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteToolWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -144,7 +144,7 @@ func resourceIBMCdToolchainToolDevopsinsightsCreate(context context.Context, d *
 	toolchainToolPost, response, err := cdToolchainClient.CreateToolWithContext(context, createToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateToolWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateToolWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createToolOptions.ToolchainID, *toolchainToolPost.ID))
@@ -189,7 +189,7 @@ func resourceIBMCdToolchainToolDevopsinsightsRead(context context.Context, d *sc
 			return nil
 		}
 		log.Printf("[DEBUG] GetToolByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetToolByIDWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetToolByIDWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("toolchain_id", toolchainTool.ToolchainID); err != nil {
@@ -266,7 +266,7 @@ func resourceIBMCdToolchainToolDevopsinsightsUpdate(context context.Context, d *
 		_, response, err := cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateToolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateToolWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateToolWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -292,7 +292,7 @@ func resourceIBMCdToolchainToolDevopsinsightsDelete(context context.Context, d *
 	response, err := cdToolchainClient.DeleteToolWithContext(context, deleteToolOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteToolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteToolWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteToolWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
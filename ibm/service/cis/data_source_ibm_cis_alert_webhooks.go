@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -88,7 +89,7 @@ func dataIBMCISWebhookRead(d *schema.ResourceData, meta interface{}) error {
 
 	result, resp, err := sess.ListWebhooks(opt)
 	if err != nil || result == nil {
-		return fmt.Errorf("[ERROR] Error Listing all Webhooks %q: %s %s", d.Id(), err, resp)
+		return flex.NewServiceError(fmt.Sprintf("Error Listing all Webhooks %q", d.Id()), err, resp)
 	}
 
 	webhooks := make([]map[string]interface{}, 0)
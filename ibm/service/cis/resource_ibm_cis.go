@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"log"
 	"net/url"
-	"os"
 	"strings"
 	"time"
 
@@ -51,7 +50,7 @@ func ResourceIBMCISInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -109,6 +108,13 @@ func ResourceIBMCISInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_cis", "tags")},
 				Set:      flex.ResourceIBMVPCHash,
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
 
 			"status": {
 				Type:        schema.TypeString,
@@ -234,10 +240,9 @@ func ResourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 
 	instance, response, err := rsConClient.CreateResourceInstance(&rsInst)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating resource instance: %s %s", err, response)
+		return flex.NewServiceError("Error creating resource instance", err, response)
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, "tags") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -278,7 +283,7 @@ func ResourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s %s", err, response)
+		return flex.NewServiceError("Error retrieving resource instance", err, response)
 	}
 	if strings.Contains(*instance.State, "removed") {
 		log.Printf("[WARN] Removing instance from TF state because it's now in removed state")
@@ -290,7 +295,7 @@ func ResourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 		log.Printf(
 			"Error on get of ibm cis tags (%s) tags: %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	flex.SetResourceTags(d, meta, "tags", "user", tags)
 	d.Set("name", *instance.Name)
 	d.Set("status", *instance.State)
 	d.Set("resource_group_id", *instance.ResourceGroupID)
@@ -371,7 +376,7 @@ func ResourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	}
 
-	if d.HasChange("tags") {
+	if flex.TagsHasChange(d, "tags") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, instanceID)
 		if err != nil {
@@ -382,7 +387,7 @@ func ResourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	_, response, err := rsConClient.UpdateResourceInstance(&updateReq)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating resource instance: %s %s", err, response)
+		return flex.NewServiceError("Error updating resource instance", err, response)
 	}
 
 	_, err = waitForCISInstanceUpdate(d, meta)
@@ -414,7 +419,7 @@ func ResourceIBMCISInstanceDelete(d *schema.ResourceData, meta interface{}) erro
 			log.Printf("[WARN] Resource instance already deleted %s\n %s", err, response)
 			err = nil
 		} else {
-			return fmt.Errorf("[ERROR] Error deleting resource instance: %s %s", err, response)
+			return flex.NewServiceError("Error deleting resource instance", err, response)
 		}
 	}
 
@@ -444,7 +449,7 @@ func ResourceIBMCISInstanceExists(d *schema.ResourceData, meta interface{}) (boo
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("[ERROR] Error getting cis instance: %s %s", err, response)
+		return false, flex.NewServiceError("Error getting cis instance", err, response)
 	}
 	if instance != nil && (strings.Contains(*instance.State, "removed") || strings.Contains(*instance.State, cisInstanceReclamation)) {
 		log.Printf("[WARN] Removing instance from state because it's in removed or pending_reclamation state")
@@ -473,12 +478,12 @@ func waitForCISInstanceCreate(d *schema.ResourceData, meta interface{}, instance
 			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %v %s", d.Id(), err, response)
+					return nil, "", flex.NewServiceError(fmt.Sprintf("The resource instance %s does not exist anymore", d.Id()), err, response)
 				}
 				return nil, "", err
 			}
 			if *instance.State == CisInstanceFailStatus {
-				return instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed: %v %s", d.Id(), err, response)
+				return instance, *instance.State, flex.NewServiceError(fmt.Sprintf("The resource instance %s failed", d.Id()), err, response)
 			}
 			return instance, *instance.State, nil
 		},
@@ -508,12 +513,12 @@ func waitForCISInstanceUpdate(d *schema.ResourceData, meta interface{}) (interfa
 			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %v %s", d.Id(), err, response)
+					return nil, "", flex.NewServiceError(fmt.Sprintf("The resource instance %s does not exist anymore", d.Id()), err, response)
 				}
 				return nil, "", err
 			}
 			if *instance.State == CisInstanceFailStatus {
-				return instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed: %v %s", d.Id(), err, response)
+				return instance, *instance.State, flex.NewServiceError(fmt.Sprintf("The resource instance %s failed", d.Id()), err, response)
 			}
 			return instance, *instance.State, nil
 		},
@@ -547,7 +552,7 @@ func waitForCISInstanceDelete(d *schema.ResourceData, meta interface{}) (interfa
 				return nil, "", err
 			}
 			if *instance.State == CisInstanceFailStatus {
-				return instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed to delete: %v %s", d.Id(), err, response)
+				return instance, *instance.State, flex.NewServiceError(fmt.Sprintf("The resource instance %s failed to delete", d.Id()), err, response)
 			}
 			return instance, *instance.State, nil
 		},
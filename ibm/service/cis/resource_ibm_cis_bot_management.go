@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -111,7 +112,7 @@ func ResourceIBMCISBotManagementUpdate(d *schema.ResourceData, meta interface{})
 
 		_, resp, err := cisClient.UpdateBotManagement(opt)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating BotManagement with error: %s %s", err, resp)
+			return flex.NewServiceError("Error updating BotManagement", err, resp)
 		}
 	}
 	return dataSourceIBMCISBotManagementRead(d, meta)
@@ -121,7 +121,7 @@ func ResourceIBMCISEdgeFunctionsActionRead(d *schema.ResourceData, meta interfac
 	opt := cisClient.NewGetEdgeFunctionsActionOptions(scriptName)
 	result, resp, err := cisClient.GetEdgeFunctionsAction(opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error: %v", resp)
+		return flex.NewServiceError("Error getting edge function action script", err, resp)
 	}
 
 	// read script content
@@ -163,7 +163,7 @@ func ResourceIBMCISEdgeFunctionsActionExists(d *schema.ResourceData, meta interf
 			log.Printf("Edge functions action script is not found")
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error: %v", response)
+		return false, flex.NewServiceError("Error getting edge function action script", err, response)
 	}
 	return true, nil
 }
@@ -181,7 +181,7 @@ func ResourceIBMCISEdgeFunctionsActionDelete(d *schema.ResourceData, meta interf
 	opt := cisClient.NewDeleteEdgeFunctionsActionOptions(scriptName)
 	_, response, err := cisClient.DeleteEdgeFunctionsAction(opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in edge function action script deletion: %v", response)
+		return flex.NewServiceError("Error in edge function action script deletion", err, response)
 	}
 	return nil
 }
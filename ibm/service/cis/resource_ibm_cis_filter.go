@@ -103,7 +103,7 @@ func ResourceIBMCISFilterCreate(d *schema.ResourceData, meta interface{}) error
 
 	result, resp, err := cisClient.CreateFilter(opt)
 	if err != nil || result == nil {
-		return fmt.Errorf("[ERROR] Error creating Filter for zone %q: %s %s", zoneID, err, resp)
+		return flex.NewServiceError(fmt.Sprintf("Error creating Filter for zone %q", zoneID), err, resp)
 	}
 	d.SetId(flex.ConvertCisToTfThreeVar(*result.Result[0].ID, zoneID, crn))
 	return ResourceIBMCISFilterRead(d, meta)
@@ -133,7 +133,7 @@ func ResourceIBMCISFilterRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error finding GetFilter %q: %s %s", d.Id(), err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error finding GetFilter %q", d.Id()), err, response)
 	}
 	if result.Result != nil {
 		d.Set(cisID, crn)
@@ -188,7 +188,7 @@ func ResourceIBMCISFilterUpdate(d *schema.ResourceData, meta interface{}) error
 
 		result, resp, err := cisClient.UpdateFilters(opt)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating Filter for zone %q: %s %s", zoneID, err, resp)
+			return flex.NewServiceError(fmt.Sprintf("Error updating Filter for zone %q", zoneID), err, resp)
 		}
 
 		if *result.Result[0].ID == "" {
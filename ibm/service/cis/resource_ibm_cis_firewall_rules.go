@@ -158,7 +158,7 @@ func ResourceIBMCISFirewallrulesRead(context context.Context, d *schema.Resource
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error reading the firewall rules %s:%s", err, response))
+		return flex.NewServiceError("Error reading the firewall rules", err, response).Diagnostics()
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -249,7 +249,7 @@ func ResourceIBMCISFirewallrulesDelete(context context.Context, d *schema.Resour
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting the  custom resolver %s:%s", err, response))
+		return flex.NewServiceError("Error deleting the  custom resolver", err, response).Diagnostics()
 	}
 
 	if id, ok := d.GetOk(cisFilterID); ok {
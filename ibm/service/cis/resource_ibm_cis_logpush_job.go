@@ -188,7 +188,7 @@ func ResourceIBMCISLogpushJobRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error While Reading the Logpushjobs for LogDNA %s:%s", err, response)
+		return flex.NewServiceError("Error While Reading the Logpushjobs for LogDNA", err, response)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -248,7 +248,7 @@ func ResourceIBMCISLogpushJobUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 		result, resp, err := sess.UpdateLogpushJobV2(options)
 		if err != nil || result == nil {
-			return fmt.Errorf("[ERROR] Error While Updating the Logpushjobs for LogDNA  %v, %v", err, resp)
+			return flex.NewServiceError("Error While Updating the Logpushjobs for LogDNA", err, resp)
 		}
 	}
 	return ResourceIBMCISLogpushJobRead(d, meta)
@@ -274,7 +274,7 @@ func ResourceIBMCISLogpushJobDelete(d *schema.ResourceData, meta interface{}) er
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error While Deleting the Logpushjob for LogDNA %s:%s", err, response)
+		return flex.NewServiceError("Error While Deleting the Logpushjob for LogDNA", err, response)
 	}
 	d.SetId("")
 	return nil
@@ -128,7 +128,7 @@ func resourceIBMCISMtlsCreate(context context.Context, d *schema.ResourceData, m
 
 	result, resp, err := sess.CreateAccessCertificate(options)
 	if err != nil || result == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error creating MTLS access certificate %v", resp))
+		return flex.NewServiceError("Error creating MTLS access certificate", err, resp).Diagnostics()
 	}
 
 	d.SetId(flex.ConvertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
@@ -154,7 +154,7 @@ func resourceIBMCISMtlsRead(context context.Context, d *schema.ResourceData, met
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error While reading MTLS access certificate %v:%v:%v", err, response, result))
+		return flex.NewServiceError("Error While reading MTLS access certificate", err, response).Diagnostics()
 	}
 
 	d.Set(cisID, crn)
@@ -194,7 +194,7 @@ func resourceIBMCISMtlsUpdate(context context.Context, d *schema.ResourceData, m
 
 		_, updateResp, updateErr := sess.UpdateAccessCertificate(updateOption)
 		if updateErr != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error while updating the MTLS cert options %v", updateResp))
+			return flex.NewServiceError("Error while updating the MTLS cert options", updateErr, updateResp).Diagnostics()
 		}
 	}
 
@@ -216,7 +216,7 @@ func resourceIBMCISMtlsDelete(context context.Context, d *schema.ResourceData, m
 	_, delResp, delErr := sess.DeleteAccessCertificate(delOpt)
 	if delErr != nil {
 
-		return diag.FromErr(fmt.Errorf("[ERROR] Error While deleting the MTLS cert : %v", delResp))
+		return flex.NewServiceError("Error While deleting the MTLS cert", delErr, delResp).Diagnostics()
 	}
 
 	return nil
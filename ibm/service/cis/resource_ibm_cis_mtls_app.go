@@ -169,7 +169,7 @@ func resourceIBMCISMtlsAppCreate(context context.Context, d *schema.ResourceData
 	resultApp, responseApp, operationErrApp := sess.CreateAccessApplication(OptionsApp)
 
 	if operationErrApp != nil || resultApp == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error creating access application  %v %v", operationErrApp, responseApp))
+		return flex.NewServiceError("Error creating access application", operationErrApp, responseApp).Diagnostics()
 	}
 
 	d.SetId(flex.ConvertCisToTfThreeVar(*resultApp.Result.ID, zoneID, crn))
@@ -218,7 +218,7 @@ func resourceIBMCISMtlsAppCreate(context context.Context, d *schema.ResourceData
 	resultPolicy, responsePolicy, operationErrPolicy := sess.CreateAccessPolicy(optionsPolicy)
 
 	if operationErrPolicy != nil || resultPolicy == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error creating app policy  %v", responsePolicy))
+		return flex.NewServiceError("Error creating app policy", operationErrPolicy, responsePolicy).Diagnostics()
 	}
 
 	d.SetId(flex.ConvertCisToTfFourVar(*resultApp.Result.ID, *resultPolicy.Result.ID, zoneID, crn))
@@ -237,14 +237,14 @@ func resourceIBMCISMtlsAppRead(context context.Context, d *schema.ResourceData,
 	getAppResult, getAppResp, getAppErr := sess.GetAccessApplication(getAppOptions)
 
 	if getAppErr != nil || getAppResult == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting app deatil  %v", getAppResp))
+		return flex.NewServiceError("Error getting app deatil", getAppErr, getAppResp).Diagnostics()
 	}
 
 	getPolicyOptions := sess.NewGetAccessPolicyOptions(zoneID, appID, policyID)
 	getPolicyResult, getPolicyResp, getPolicyErr := sess.GetAccessPolicy(getPolicyOptions)
 
 	if getPolicyErr != nil || getPolicyResult == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting Policy  detail  %v", getPolicyResp))
+		return flex.NewServiceError("Error getting Policy detail", getPolicyErr, getPolicyResp).Diagnostics()
 	}
 
 	d.Set(cisID, crn)
@@ -325,13 +325,13 @@ func resourceIBMCISMtlsAppDelete(context context.Context, d *schema.ResourceData
 	delOptPolicy := sess.NewDeleteAccessPolicyOptions(zoneID, appID, policyID)
 	_, delRespPolicy, delErrPolicy := sess.DeleteAccessPolicy(delOptPolicy)
 	if delErrPolicy != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error While deleting the policy :%v", delRespPolicy))
+		return flex.NewServiceError("Error While deleting the policy", delErrPolicy, delRespPolicy).Diagnostics()
 	}
 
 	delAccOpt := sess.NewDeleteAccessApplicationOptions(zoneID, appID)
 	_, delAccResp, delAccErr := sess.DeleteAccessApplication(delAccOpt)
 	if delAccErr != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error While deleting the app :%v", delAccResp))
+		return flex.NewServiceError("Error While deleting the app", delAccErr, delAccResp).Diagnostics()
 	}
 
 	return nil
@@ -166,7 +166,7 @@ func resourceIBMCISOriginAuthPullCreate(context context.Context, d *schema.Resou
 
 		result, resp, opErr := sess.UploadZoneOriginPullCertificate(options)
 		if opErr != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error while uploading certificate zone level %v", resp))
+			return flex.NewServiceError("Error while uploading certificate zone level", opErr, resp).Diagnostics()
 		}
 
 		d.SetId(flex.ConvertCisToTfFourVar(*result.Result.ID, level_val, zoneID, crn))
@@ -177,7 +177,7 @@ func resourceIBMCISOriginAuthPullCreate(context context.Context, d *schema.Resou
 		options.SetPrivateKey(key_val)
 		result, resp, opErr := sess.UploadHostnameOriginPullCertificate(options)
 		if opErr != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error while uploading certificate host level %v", resp))
+			return flex.NewServiceError("Error while uploading certificate host level", opErr, resp).Diagnostics()
 		}
 
 		d.SetId(flex.ConvertCisToTfFourVar(*result.Result.ID, level_val, zoneID, crn))
@@ -210,7 +210,7 @@ func resourceIBMCISOriginAuthPullRead(context context.Context, d *schema.Resourc
 		result, response, err := sess.GetZoneOriginPullCertificate(getOptions)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error while getting detail of zone origin auth pull %v:%v", err, response))
+			return flex.NewServiceError("Error while getting detail of zone origin auth pull", err, response).Diagnostics()
 		}
 		d.Set(cisOriginAuthID, *result.Result.ID)
 		d.Set(cisOriginAuthCertContent, *result.Result.Certificate)
@@ -226,7 +226,7 @@ func resourceIBMCISOriginAuthPullRead(context context.Context, d *schema.Resourc
 		result, response, err := sess.GetHostnameOriginPullCertificate(getOptions)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error while getting detail of host origin auth pull %v:%v", err, response))
+			return flex.NewServiceError("Error while getting detail of host origin auth pull", err, response).Diagnostics()
 		}
 		d.Set(cisOriginAuthID, *result.Result.ID)
 		d.Set(cisOriginAuthCertContent, *result.Result.Certificate)
@@ -267,7 +267,7 @@ func resourceIBMCISOriginAuthPullUpdate(context context.Context, d *schema.Resou
 			_, response, err := sess.SetZoneOriginPullSettings(updateOption)
 
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error while updaing the zone origin auth pull setting %v:%v", err, response))
+				return flex.NewServiceError("Error while updaing the zone origin auth pull setting", err, response).Diagnostics()
 			}
 
 		}
@@ -288,7 +288,7 @@ func resourceIBMCISOriginAuthPullUpdate(context context.Context, d *schema.Resou
 			setOption.SetConfig([]authenticatedoriginpullapiv1.HostnameOriginPullSettings{*model})
 			_, setResp, setErr := sess.SetHostnameOriginPullSettings(setOption)
 			if setErr != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error while updaing the host origin auth pull setting %v:%v", setErr, setResp))
+				return flex.NewServiceError("Error while updaing the host origin auth pull setting", setErr, setResp).Diagnostics()
 			}
 
 		}
@@ -319,7 +319,7 @@ func resourceIBMCISOriginAuthPullDelete(context context.Context, d *schema.Resou
 		_, resp, err := sess.DeleteZoneOriginPullCertificate(delOpt)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error while deleting the certificate zone level %v: %v", certID, resp))
+			return flex.NewServiceError(fmt.Sprintf("Error while deleting the certificate zone level %v", certID), err, resp).Diagnostics()
 		}
 
 	} else {
@@ -327,7 +327,7 @@ func resourceIBMCISOriginAuthPullDelete(context context.Context, d *schema.Resou
 		_, resp, err := sess.DeleteHostnameOriginPullCertificate(delOpt)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error while deleting the certificate host level %v: %v", certID, resp))
+			return flex.NewServiceError(fmt.Sprintf("Error while deleting the certificate host level %v", certID), err, resp).Diagnostics()
 		}
 
 	}
@@ -277,7 +277,7 @@ func ResourceIBMCISRangeAppCreate(d *schema.ResourceData, meta interface{}) erro
 
 	result, resp, err := cisClient.CreateRangeApp(opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Failed to create range application: %v", resp)
+		return flex.NewServiceError("Failed to create range application", err, resp)
 	}
 	d.SetId(flex.ConvertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
 	return ResourceIBMCISRangeAppRead(d, meta)
@@ -296,7 +296,7 @@ func ResourceIBMCISRangeAppRead(d *schema.ResourceData, meta interface{}) error
 	opt := cisClient.NewGetRangeAppOptions(rangeAppID)
 	result, resp, err := cisClient.GetRangeApp(opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Failed to read range application: %v", resp)
+		return flex.NewServiceError("Failed to read range application", err, resp)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -382,7 +382,7 @@ func ResourceIBMCISRangeAppUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 		_, resp, err := cisClient.UpdateRangeApp(opt)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Failed to update range application: %v", resp)
+			return flex.NewServiceError("Failed to update range application", err, resp)
 		}
 	}
 	return ResourceIBMCISRangeAppRead(d, meta)
@@ -400,7 +400,7 @@ func ResourceIBMCISRangeAppDelete(d *schema.ResourceData, meta interface{}) erro
 	opt := cisClient.NewDeleteRangeAppOptions(rangeAppID)
 	_, resp, err := cisClient.DeleteRangeApp(opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Failed to delete range application: %v", resp)
+		return flex.NewServiceError("Failed to delete range application", err, resp)
 	}
 	return nil
 }
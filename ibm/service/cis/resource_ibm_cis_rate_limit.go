@@ -409,7 +409,7 @@ func ResourceIBMCISRateLimitCreate(d *schema.ResourceData, meta interface{}) err
 	//creating rate limit rule
 	result, resp, err := cisClient.CreateZoneRateLimits(opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Failed to create RateLimit: %v", resp)
+		return flex.NewServiceError("Failed to create RateLimit", err, resp)
 	}
 	record := result.Result
 	d.SetId(flex.ConvertCisToTfThreeVar(*record.ID, zoneID, cisID))
@@ -430,7 +430,7 @@ func ResourceIBMCISRateLimitRead(d *schema.ResourceData, meta interface{}) error
 	opt := cisClient.NewGetRateLimitOptions(recordID)
 	result, resp, err := cisClient.GetRateLimit(opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Failed to read RateLimit: %v", resp)
+		return flex.NewServiceError("Failed to read RateLimit", err, resp)
 	}
 
 	rule := result.Result
@@ -504,7 +504,7 @@ func ResourceIBMCISRateLimitUpdate(d *schema.ResourceData, meta interface{}) err
 		opt.SetBypass(byPass)
 		_, resp, err := cisClient.UpdateRateLimit(opt)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Failed to update RateLimit: %v", resp)
+			return flex.NewServiceError("Failed to update RateLimit", err, resp)
 		}
 	}
 	d.SetId(flex.ConvertCisToTfThreeVar(recordID, zoneID, cisID))
@@ -523,7 +523,7 @@ func ResourceIBMCISRateLimitDelete(d *schema.ResourceData, meta interface{}) err
 	opt := cisClient.NewDeleteZoneRateLimitOptions(recordID)
 	_, resp, err := cisClient.DeleteZoneRateLimit(opt)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Failed to delete RateLimit: %v", resp)
+		return flex.NewServiceError("Failed to delete RateLimit", err, resp)
 	}
 	return nil
 }
@@ -126,7 +126,7 @@ func dataSourceIBMCloudShellAccountSettingsRead(context context.Context, d *sche
 	accountSettings, response, err := ibmCloudShellClient.GetAccountSettingsWithContext(context, getAccountSettingsOptions)
 	if err != nil || accountSettings == nil {
 		log.Printf("[DEBUG] GetAccountSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAccountSettingsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetAccountSettingsWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*accountSettings.AccountID)
@@ -272,7 +272,7 @@ func dataSourceIbmCodeEngineAppRead(context context.Context, d *schema.ResourceD
 	app, response, err := codeEngineClient.GetAppWithContext(context, getAppOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetAppWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAppWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetAppWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *getAppOptions.ProjectID, *getAppOptions.Name))
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/code-engine-go-sdk/codeenginev2"
 )
 
@@ -92,7 +93,7 @@ func dataSourceIbmCodeEngineBindingRead(context context.Context, d *schema.Resou
 	binding, response, err := codeEngineClient.GetBindingWithContext(context, getBindingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetBindingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetBindingWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetBindingWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *getBindingOptions.ProjectID, *getBindingOptions.ID))
@@ -148,7 +148,7 @@ func dataSourceIbmCodeEngineBuildRead(context context.Context, d *schema.Resourc
 	build, response, err := codeEngineClient.GetBuildWithContext(context, getBuildOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetBuildWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetBuildWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetBuildWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *getBuildOptions.ProjectID, *getBuildOptions.Name))
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/code-engine-go-sdk/codeenginev2"
 )
 
@@ -81,7 +82,7 @@ func dataSourceIbmCodeEngineConfigMapRead(context context.Context, d *schema.Res
 	configMap, response, err := codeEngineClient.GetConfigMapWithContext(context, getConfigMapOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetConfigMapWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConfigMapWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetConfigMapWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *getConfigMapOptions.ProjectID, *getConfigMapOptions.Name))
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/code-engine-go-sdk/codeenginev2"
 )
 
@@ -131,7 +132,7 @@ func dataSourceIbmCodeEngineDomainMappingRead(context context.Context, d *schema
 	domainMapping, response, err := codeEngineClient.GetDomainMappingWithContext(context, getDomainMappingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetDomainMappingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetDomainMappingWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetDomainMappingWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *getDomainMappingOptions.ProjectID, *getDomainMappingOptions.Name))
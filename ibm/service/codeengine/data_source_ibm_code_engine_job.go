@@ -213,7 +213,7 @@ func dataSourceIbmCodeEngineJobRead(context context.Context, d *schema.ResourceD
 	job, response, err := codeEngineClient.GetJobWithContext(context, getJobOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetJobWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetJobWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *getJobOptions.ProjectID, *getJobOptions.Name))
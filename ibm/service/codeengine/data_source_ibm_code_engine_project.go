@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/code-engine-go-sdk/codeenginev2"
 )
 
@@ -87,7 +88,7 @@ func dataSourceIbmCodeEngineProjectRead(context context.Context, d *schema.Resou
 	project, response, err := codeEngineClient.GetProjectWithContext(context, getProjectOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetProjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProjectWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetProjectWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s", *getProjectOptions.ID))
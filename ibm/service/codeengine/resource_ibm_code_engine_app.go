@@ -483,7 +483,7 @@ func resourceIbmCodeEngineAppCreate(context context.Context, d *schema.ResourceD
 	app, response, err := codeEngineClient.CreateAppWithContext(context, createAppOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateAppWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateAppWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateAppWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createAppOptions.ProjectID, *app.Name))
@@ -519,13 +519,13 @@ func waitForIbmCodeEngineAppCreate(d *schema.ResourceData, meta interface{}) (in
 			stateObj, response, err := codeEngineClient.GetApp(getAppOptions)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("The instance %s does not exist anymore: %s\n%s", "getAppOptions", err, response)
+					return nil, "", flex.NewServiceError(fmt.Sprintf("The instance %s does not exist anymore", "getAppOptions"), err, response)
 				}
 				return nil, "", err
 			}
 			failStates := map[string]bool{"failure": true, "failed": true}
 			if failStates[*stateObj.Status] {
-				return stateObj, *stateObj.Status, fmt.Errorf("The instance %s failed: %s\n%s", "getAppOptions", err, response)
+				return stateObj, *stateObj.Status, flex.NewServiceError(fmt.Sprintf("The instance %s failed", "getAppOptions"), err, response)
 			}
 			return stateObj, *stateObj.Status, nil
 		},
@@ -560,7 +560,7 @@ func resourceIbmCodeEngineAppRead(context context.Context, d *schema.ResourceDat
 			return nil
 		}
 		log.Printf("[DEBUG] GetAppWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAppWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetAppWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("project_id", app.ProjectID); err != nil {
@@ -878,7 +878,7 @@ func resourceIbmCodeEngineAppUpdate(context context.Context, d *schema.ResourceD
 		_, response, err := codeEngineClient.UpdateAppWithContext(context, updateAppOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateAppWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateAppWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateAppWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -913,13 +913,13 @@ func waitForIbmCodeEngineAppUpdate(d *schema.ResourceData, meta interface{}) (in
 			stateObj, response, err := codeEngineClient.GetApp(getAppOptions)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("The instance %s does not exist anymore: %s\n%s", "getAppOptions", err, response)
+					return nil, "", flex.NewServiceError(fmt.Sprintf("The instance %s does not exist anymore", "getAppOptions"), err, response)
 				}
 				return nil, "", err
 			}
 			failStates := map[string]bool{"failed": true, "warning": true}
 			if failStates[*stateObj.Status] {
-				return stateObj, *stateObj.Status, fmt.Errorf("The instance %s failed: %s\n%s", "getAppOptions", err, response)
+				return stateObj, *stateObj.Status, flex.NewServiceError(fmt.Sprintf("The instance %s failed", "getAppOptions"), err, response)
 			}
 			return stateObj, *stateObj.Status, nil
 		},
@@ -950,7 +950,7 @@ func resourceIbmCodeEngineAppDelete(context context.Context, d *schema.ResourceD
 	response, err := codeEngineClient.DeleteAppWithContext(context, deleteAppOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteAppWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteAppWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteAppWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
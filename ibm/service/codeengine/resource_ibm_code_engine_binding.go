@@ -154,7 +154,7 @@ func resourceIbmCodeEngineBindingCreate(context context.Context, d *schema.Resou
 	binding, response, err := codeEngineClient.CreateBindingWithContext(context, createBindingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateBindingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateBindingWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateBindingWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createBindingOptions.ProjectID, *binding.ID))
@@ -185,7 +185,7 @@ func resourceIbmCodeEngineBindingRead(context context.Context, d *schema.Resourc
 			return nil
 		}
 		log.Printf("[DEBUG] GetBindingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetBindingWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetBindingWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("project_id", binding.ProjectID); err != nil {
@@ -247,7 +247,7 @@ func resourceIbmCodeEngineBindingDelete(context context.Context, d *schema.Resou
 	response, err := codeEngineClient.DeleteBindingWithContext(context, deleteBindingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteBindingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteBindingWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteBindingWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -327,7 +327,7 @@ func resourceIbmCodeEngineBuildCreate(context context.Context, d *schema.Resourc
 	build, response, err := codeEngineClient.CreateBuildWithContext(context, createBuildOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateBuildWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateBuildWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateBuildWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createBuildOptions.ProjectID, *build.Name))
@@ -358,7 +358,7 @@ func resourceIbmCodeEngineBuildRead(context context.Context, d *schema.ResourceD
 			return nil
 		}
 		log.Printf("[DEBUG] GetBuildWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetBuildWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetBuildWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("project_id", build.ProjectID); err != nil {
@@ -543,7 +543,7 @@ func resourceIbmCodeEngineBuildUpdate(context context.Context, d *schema.Resourc
 		_, response, err := codeEngineClient.UpdateBuildWithContext(context, updateBuildOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateBuildWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateBuildWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateBuildWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -569,7 +569,7 @@ func resourceIbmCodeEngineBuildDelete(context context.Context, d *schema.Resourc
 	response, err := codeEngineClient.DeleteBuildWithContext(context, deleteBuildOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteBuildWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteBuildWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteBuildWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
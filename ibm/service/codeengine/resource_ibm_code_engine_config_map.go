@@ -128,7 +128,7 @@ func resourceIbmCodeEngineConfigMapCreate(context context.Context, d *schema.Res
 	configMap, response, err := codeEngineClient.CreateConfigMapWithContext(context, createConfigMapOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateConfigMapWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateConfigMapWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateConfigMapWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createConfigMapOptions.ProjectID, *configMap.Name))
@@ -159,7 +159,7 @@ func resourceIbmCodeEngineConfigMapRead(context context.Context, d *schema.Resou
 			return nil
 		}
 		log.Printf("[DEBUG] GetConfigMapWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConfigMapWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetConfigMapWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("project_id", configMap.ProjectID); err != nil {
@@ -243,7 +243,7 @@ func resourceIbmCodeEngineConfigMapUpdate(context context.Context, d *schema.Res
 		_, response, err := codeEngineClient.ReplaceConfigMapWithContext(context, replaceConfigMapOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ReplaceConfigMapWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceConfigMapWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ReplaceConfigMapWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -269,7 +269,7 @@ func resourceIbmCodeEngineConfigMapDelete(context context.Context, d *schema.Res
 	response, err := codeEngineClient.DeleteConfigMapWithContext(context, deleteConfigMapOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteConfigMapWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteConfigMapWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteConfigMapWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -198,7 +198,7 @@ func resourceIbmCodeEngineDomainMappingCreate(context context.Context, d *schema
 	domainMapping, response, err := codeEngineClient.CreateDomainMappingWithContext(context, createDomainMappingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateDomainMappingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateDomainMappingWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateDomainMappingWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createDomainMappingOptions.ProjectID, *domainMapping.Name))
@@ -234,13 +234,13 @@ func waitForIbmCodeEngineDomainMappingCreate(d *schema.ResourceData, meta interf
 			stateObj, response, err := codeEngineClient.GetDomainMapping(getDomainMappingOptions)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("The instance %s does not exist anymore: %s\n%s", "getDomainMappingOptions", err, response)
+					return nil, "", flex.NewServiceError(fmt.Sprintf("The instance %s does not exist anymore", "getDomainMappingOptions"), err, response)
 				}
 				return nil, "", err
 			}
 			failStates := map[string]bool{"failure": true, "failed": true}
 			if failStates[*stateObj.Status] {
-				return stateObj, *stateObj.Status, fmt.Errorf("The instance %s failed: %s\n%s", "getDomainMappingOptions", err, response)
+				return stateObj, *stateObj.Status, flex.NewServiceError(fmt.Sprintf("The instance %s failed", "getDomainMappingOptions"), err, response)
 			}
 			return stateObj, *stateObj.Status, nil
 		},
@@ -275,7 +275,7 @@ func resourceIbmCodeEngineDomainMappingRead(context context.Context, d *schema.R
 			return nil
 		}
 		log.Printf("[DEBUG] GetDomainMappingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetDomainMappingWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetDomainMappingWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("project_id", domainMapping.ProjectID); err != nil {
@@ -400,7 +400,7 @@ func resourceIbmCodeEngineDomainMappingUpdate(context context.Context, d *schema
 		_, response, err := codeEngineClient.UpdateDomainMappingWithContext(context, updateDomainMappingOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateDomainMappingWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateDomainMappingWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateDomainMappingWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -426,7 +426,7 @@ func resourceIbmCodeEngineDomainMappingDelete(context context.Context, d *schema
 	response, err := codeEngineClient.DeleteDomainMappingWithContext(context, deleteDomainMappingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteDomainMappingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteDomainMappingWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteDomainMappingWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
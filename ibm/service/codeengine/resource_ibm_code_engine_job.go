@@ -415,7 +415,7 @@ func resourceIbmCodeEngineJobCreate(context context.Context, d *schema.ResourceD
 	job, response, err := codeEngineClient.CreateJobWithContext(context, createJobOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateJobWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateJobWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createJobOptions.ProjectID, *job.Name))
@@ -446,7 +446,7 @@ func resourceIbmCodeEngineJobRead(context context.Context, d *schema.ResourceDat
 			return nil
 		}
 		log.Printf("[DEBUG] GetJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetJobWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetJobWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("project_id", job.ProjectID); err != nil {
@@ -700,7 +700,7 @@ func resourceIbmCodeEngineJobUpdate(context context.Context, d *schema.ResourceD
 		_, response, err := codeEngineClient.UpdateJobWithContext(context, updateJobOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateJobWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateJobWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateJobWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -726,7 +726,7 @@ func resourceIbmCodeEngineJobDelete(context context.Context, d *schema.ResourceD
 	response, err := codeEngineClient.DeleteJobWithContext(context, deleteJobOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteJobWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteJobWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
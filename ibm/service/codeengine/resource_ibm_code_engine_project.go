@@ -15,6 +15,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/code-engine-go-sdk/codeenginev2"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -133,7 +134,7 @@ func resourceIbmCodeEngineProjectCreate(context context.Context, d *schema.Resou
 	project, response, err := codeEngineClient.CreateProjectWithContext(context, createProjectOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateProjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateProjectWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateProjectWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*project.ID)
@@ -163,13 +164,13 @@ func waitForIbmCodeEngineProjectCreate(d *schema.ResourceData, meta interface{})
 			stateObj, response, err := codeEngineClient.GetProject(getProjectOptions)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("The instance %s does not exist anymore: %s\n%s", "getProjectOptions", err, response)
+					return nil, "", flex.NewServiceError(fmt.Sprintf("The instance %s does not exist anymore", "getProjectOptions"), err, response)
 				}
 				return nil, "", err
 			}
 			failStates := map[string]bool{"creation_failed": true}
 			if failStates[*stateObj.Status] {
-				return stateObj, *stateObj.Status, fmt.Errorf("The instance %s failed: %s\n%s", "getProjectOptions", err, response)
+				return stateObj, *stateObj.Status, flex.NewServiceError(fmt.Sprintf("The instance %s failed", "getProjectOptions"), err, response)
 			}
 			return stateObj, *stateObj.Status, nil
 		},
@@ -198,7 +199,7 @@ func resourceIbmCodeEngineProjectRead(context context.Context, d *schema.Resourc
 			return nil
 		}
 		log.Printf("[DEBUG] GetProjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProjectWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetProjectWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("name", project.Name); err != nil {
@@ -264,7 +265,7 @@ func resourceIbmCodeEngineProjectDelete(context context.Context, d *schema.Resou
 	response, err := codeEngineClient.DeleteProjectWithContext(context, deleteProjectOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteProjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteProjectWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteProjectWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
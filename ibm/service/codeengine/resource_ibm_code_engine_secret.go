@@ -230,7 +230,7 @@ func resourceIbmCodeEngineSecretCreate(context context.Context, d *schema.Resour
 	secret, response, err := codeEngineClient.CreateSecretWithContext(context, createSecretOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSecretWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateSecretWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createSecretOptions.ProjectID, *secret.Name))
@@ -261,7 +261,7 @@ func resourceIbmCodeEngineSecretRead(context context.Context, d *schema.Resource
 			return nil
 		}
 		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSecretWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("project_id", secret.ProjectID); err != nil {
@@ -376,7 +376,7 @@ func resourceIbmCodeEngineSecretUpdate(context context.Context, d *schema.Resour
 		_, response, err := codeEngineClient.ReplaceSecretWithContext(context, replaceSecretOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ReplaceSecretWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceSecretWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ReplaceSecretWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -402,7 +402,7 @@ func resourceIbmCodeEngineSecretDelete(context context.Context, d *schema.Resour
 	response, err := codeEngineClient.DeleteSecretWithContext(context, deleteSecretOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteSecretWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteSecretWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -297,7 +297,7 @@ func resourceIBMCbrRuleCreate(context context.Context, d *schema.ResourceData, m
 	rule, response, err := contextBasedRestrictionsClient.CreateRuleWithContext(context, createRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateRuleWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateRuleWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*rule.ID)
@@ -322,7 +322,7 @@ func resourceIBMCbrRuleRead(context context.Context, d *schema.ResourceData, met
 			return nil
 		}
 		log.Printf("[DEBUG] GetRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetRuleWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetRuleWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("x_correlation_id", getRuleOptions.XCorrelationID); err != nil {
@@ -454,7 +454,7 @@ func resourceIBMCbrRuleUpdate(context context.Context, d *schema.ResourceData, m
 	_, response, err := contextBasedRestrictionsClient.ReplaceRuleWithContext(context, replaceRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ReplaceRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ReplaceRuleWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ReplaceRuleWithContext failed", err, response).Diagnostics()
 	}
 
 	return resourceIBMCbrRuleRead(context, d, meta)
@@ -473,7 +473,7 @@ func resourceIBMCbrRuleDelete(context context.Context, d *schema.ResourceData, m
 	response, err := contextBasedRestrictionsClient.DeleteRuleWithContext(context, deleteRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteRuleWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteRuleWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -283,7 +283,7 @@ func resourceIBMCbrZoneCreate(context context.Context, d *schema.ResourceData, m
 	zone, response, err := contextBasedRestrictionsClient.CreateZoneWithContext(context, createZoneOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateZoneWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateZoneWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateZoneWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*zone.ID)
@@ -308,7 +308,7 @@ func resourceIBMCbrZoneRead(context context.Context, d *schema.ResourceData, met
 			return nil
 		}
 		log.Printf("[DEBUG] GetZoneWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetZoneWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetZoneWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("x_correlation_id", getZoneOptions.XCorrelationID); err != nil {
@@ -436,7 +436,7 @@ func resourceIBMCbrZoneUpdate(context context.Context, d *schema.ResourceData, m
 	_, response, err := contextBasedRestrictionsClient.ReplaceZoneWithContext(context, replaceZoneOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ReplaceZoneWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ReplaceZoneWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ReplaceZoneWithContext failed", err, response).Diagnostics()
 	}
 
 	return resourceIBMCbrZoneRead(context, d, meta)
@@ -455,7 +455,7 @@ func resourceIBMCbrZoneDelete(context context.Context, d *schema.ResourceData, m
 	response, err := contextBasedRestrictionsClient.DeleteZoneWithContext(context, deleteZoneOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteZoneWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteZoneWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteZoneWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
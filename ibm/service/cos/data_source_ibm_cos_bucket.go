@@ -733,7 +733,7 @@ func dataSourceIBMCosBucketRead(d *schema.ResourceData, meta interface{}) error
 
 	bucketPtr, response, err := sess.GetBucketConfig(getBucketConfigOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in getting bucket info rule: %s\n%s", err, response)
+		return flex.NewServiceErrorV3("Error in getting bucket info rule", err, response)
 	}
 
 	if bucketPtr != nil {
@@ -1019,7 +1019,7 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 	if hasChanged {
 		response, err := sess.UpdateBucketConfig(updateBucketConfigOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Update COS Bucket: %s\n%s", err, response)
+			return flex.NewServiceErrorV3("Error Update COS Bucket", err, response)
 		}
 	}
 
@@ -1170,7 +1170,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	bucketPtr, response, err := sess.GetBucketConfig(getBucketConfigOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in getting bucket info rule: %s\n%s", err, response)
+		return flex.NewServiceErrorV3("Error in getting bucket info rule", err, response)
 	}
 	head, err := s3Client.HeadBucket(headInput)
 	if err != nil {
@@ -1565,7 +1565,7 @@ func resourceIBMCOSBucketExists(d *schema.ResourceData, meta interface{}) (bool,
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[WARN] Error getting resource instance from cos bucket: %s with resp code: %s", err, resp)
+		return false, flex.NewServiceError("Error getting resource instance from cos bucket", err, resp)
 	}
 	if instance != nil && (strings.Contains(*instance.State, "removed") || strings.Contains(*instance.State, "pending_reclamation")) {
 		log.Printf("[WARN] Removing instance from state because it's in removed or pending_reclamation state from the cos bucket resource")
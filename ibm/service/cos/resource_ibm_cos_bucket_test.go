@@ -84,6 +84,39 @@ func TestAccIBMCosBucket_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMCosBucket_replay(t *testing.T) {
+	r := acc.NewReplay(t)
+	serviceName := fmt.Sprintf("tf-replay-cos-%d", r.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("tf-replay-bucket-%d", r.RandIntRange(10, 100))
+	bucketRegion := "us"
+	bucketClass := "standard"
+	bucketRegionType := "cross_region_location"
+
+	r.Test(t, resource.TestCase{
+		PreCheck:     func() { r.PreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_basic(serviceName, bucketName, bucketRegionType, bucketRegion, bucketClass),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "bucket_name", bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "storage_class", bucketClass),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "cross_region_location", bucketRegion),
+					resource.TestCheckResourceAttrPair("ibm_cos_bucket.bucket", "resource_instance_id", "ibm_resource_instance.instance", "id"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cos_bucket.bucket",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force_delete"},
+			},
+		},
+	})
+}
+
 func TestAccIBMCosBucket_Basic_Single_Site_Location(t *testing.T) {
 
 	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
@@ -78,7 +78,7 @@ func DataSourceIBMDatabaseBackupRead(context context.Context, d *schema.Resource
 	backup, response, err := cloudDatabasesClient.GetBackupInfoWithContext(context, getBackupInfoOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetBackupInfoWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetBackupInfoWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetBackupInfoWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*backup.Backup.ID)
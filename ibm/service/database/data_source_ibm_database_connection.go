@@ -1658,7 +1658,7 @@ func DataSourceIBMDatabaseConnectionRead(context context.Context, d *schema.Reso
 	connection, response, err := cloudDatabasesClient.GetConnectionWithContext(context, getConnectionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetConnectionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConnectionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetConnectionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(DataSourceIBMDatabaseConnectionID(d))
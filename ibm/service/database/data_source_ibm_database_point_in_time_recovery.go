@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -67,7 +68,7 @@ func DataSourceIBMDatabasePointInTimeRecoveryRead(context context.Context, d *sc
 	pointInTimeRecoveryData, response, err := cloudDatabasesClient.GetPitrDataWithContext(context, getPitrDataOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetPitrDataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetPitrDataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetPitrDataWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(d.Get("deployment_id").(string))
@@ -10,7 +10,6 @@ import (
 	"fmt"
 	"log"
 	"net/url"
-	"os"
 	"reflect"
 	"regexp"
 	"sort"
@@ -290,6 +289,13 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_database", "tags")},
 				Set:      flex.ResourceIBMVPCHash,
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
 			"point_in_time_recovery_deployment_id": {
 				Description:      "The CRN of source instance",
 				Type:             schema.TypeString,
@@ -1088,7 +1094,7 @@ type CountLimit struct {
 }
 
 func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
-	err = flex.ResourceTagsCustomizeDiff(diff)
+	err = flex.ResourceTagsCustomizeDiff(diff, meta)
 	if err != nil {
 		return err
 	}
@@ -1308,7 +1314,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 	instance, response, err := rsConClient.CreateResourceInstance(&rsInst)
 	if err != nil {
 		return diag.FromErr(
-			fmt.Errorf("[ERROR] Error creating database instance: %s %s", err, response))
+			flex.NewServiceError("Error creating database instance", err, response))
 	}
 	d.SetId(*instance.ID)
 
@@ -1380,14 +1386,13 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 				_, err = waitForDatabaseTaskComplete(taskIDLink, d, meta, d.Timeout(schema.TimeoutCreate))
 
 				if err != nil {
-					return diag.FromErr(err)
+					return flex.DiagFromErr(err)
 				}
 			}
 		}
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, "tags") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -1430,7 +1435,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 
 		updateUserResponse, response, err := cloudDatabasesClient.UpdateUser(updateUserOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] UpdateUser (%s) failed %s\n%s", *updateUserOptions.Username, err, response))
+			return flex.NewServiceError(fmt.Sprintf("UpdateUser (%s) failed", *updateUserOptions.Username), err, response).Diagnostics()
 		}
 
 		taskID := *updateUserResponse.Task.ID
@@ -1552,8 +1557,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		updateDatabaseConfigurationResponse, response, err := cloudDatabasesClient.UpdateDatabaseConfiguration(updateDatabaseConfigurationOptions)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf(
-				"[ERROR] Error updating database configuration failed %s\n%s", err, response))
+			return flex.NewServiceError("Error updating database configuration failed", err, response).Diagnostics()
 		}
 
 		taskID := *updateDatabaseConfigurationResponse.Task.ID
@@ -1590,7 +1594,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 
 			createLogicalRepSlotResponse, response, err := cloudDatabasesClient.CreateLogicalReplicationSlot(createLogicalReplicationOptions)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] CreateLogicalReplicationSlot (%s) failed %s\n%s", *createLogicalReplicationOptions.LogicalReplicationSlot.Name, err, response))
+				return flex.NewServiceError(fmt.Sprintf("CreateLogicalReplicationSlot (%s) failed", *createLogicalReplicationOptions.LogicalReplicationSlot.Name), err, response).Diagnostics()
 			}
 
 			taskID := *createLogicalRepSlotResponse.Task.ID
@@ -1624,7 +1628,7 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving resource instance: %s %s", err, response))
+		return flex.NewServiceError("Error retrieving resource instance", err, response).Diagnostics()
 	}
 	if strings.Contains(*instance.State, "removed") {
 		log.Printf("[WARN] Removing instance from TF state because it's now in removed state")
@@ -1637,7 +1641,7 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 		log.Printf(
 			"Error on get of ibm Database tags (%s) tags: %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	flex.SetResourceTags(d, meta, "tags", "user", tags)
 	d.Set("name", *instance.Name)
 	d.Set("status", *instance.State)
 	d.Set("resource_group_id", *instance.ResourceGroupID)
@@ -1818,7 +1822,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 	if update {
 		_, response, err := rsConClient.UpdateResourceInstance(&updateReq)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating resource instance: %s %s", err, response))
+			return flex.NewServiceError("Error updating resource instance", err, response).Diagnostics()
 		}
 
 		_, err = waitForDatabaseInstanceUpdate(d, meta)
@@ -1828,7 +1832,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
-	if d.HasChange("tags") {
+	if flex.TagsHasChange(d, "tags") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, instanceID)
 		if err != nil {
@@ -1869,8 +1873,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 			updateDatabaseConfigurationResponse, response, err := cloudDatabasesClient.UpdateDatabaseConfiguration(updateDatabaseConfigurationOptions)
 
 			if err != nil {
-				return diag.FromErr(fmt.Errorf(
-					"[ERROR] Error updating database configuration failed %s\n%s", err, response))
+				return flex.NewServiceError("Error updating database configuration failed", err, response).Diagnostics()
 			}
 
 			taskID := *updateDatabaseConfigurationResponse.Task.ID
@@ -1948,7 +1951,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 				setDeploymentScalingGroupResponse, response, err := cloudDatabasesClient.SetDeploymentScalingGroup(setDeploymentScalingGroupOptions)
 
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] SetDeploymentScalingGroup (%s) failed %s\n%s", group.ID, err, response))
+					return flex.NewServiceError(fmt.Sprintf("SetDeploymentScalingGroup (%s) failed", group.ID), err, response).Diagnostics()
 				}
 
 				// API may return HTTP 204 No Content if no change made
@@ -1958,7 +1961,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 					_, err = waitForDatabaseTaskComplete(taskIDLink, d, meta, d.Timeout(schema.TimeoutCreate))
 
 					if err != nil {
-						return diag.FromErr(err)
+						return flex.DiagFromErr(err)
 					}
 				}
 			}
@@ -2026,7 +2029,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 
 		updateUserResponse, response, err := cloudDatabasesClient.UpdateUser(updateUserOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] UpdateUser (%s) failed %s\n%s", *updateUserOptions.Username, err, response))
+			return flex.NewServiceError(fmt.Sprintf("UpdateUser (%s) failed", *updateUserOptions.Username), err, response).Diagnostics()
 		}
 
 		taskID := *updateUserResponse.Task.ID
@@ -2154,7 +2157,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 
 				createLogicalRepSlotResponse, response, err := cloudDatabasesClient.CreateLogicalReplicationSlot(createLogicalReplicationOptions)
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] CreateLogicalReplicationSlot (%s) failed %s\n%s", *createLogicalReplicationOptions.LogicalReplicationSlot.Name, err, response))
+					return flex.NewServiceError(fmt.Sprintf("CreateLogicalReplicationSlot (%s) failed", *createLogicalReplicationOptions.LogicalReplicationSlot.Name), err, response).Diagnostics()
 				}
 
 				taskID := *createLogicalRepSlotResponse.Task.ID
@@ -2178,8 +2181,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 				deleteLogicalReplicationSlotResponse, response, err := cloudDatabasesClient.DeleteLogicalReplicationSlot(deleteLogicalReplicationSlotOptions)
 
 				if err != nil {
-					return diag.FromErr(fmt.Errorf(
-						"[ERROR] DeleteLogicalReplicationSlot (%s) failed %s\n%s", *deleteLogicalReplicationSlotOptions.Name, err, response))
+					return flex.NewServiceError(fmt.Sprintf("DeleteLogicalReplicationSlot (%s) failed", *deleteLogicalReplicationSlotOptions.Name), err, response).Diagnostics()
 				}
 
 				taskID := *deleteLogicalReplicationSlotResponse.Task.ID
@@ -2295,7 +2297,7 @@ func resourceIBMDatabaseInstanceDelete(context context.Context, d *schema.Resour
 			log.Printf("[WARN] Resource instance already deleted %s\n ", err)
 			err = nil
 		} else {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting resource instance: %s %s ", err, response))
+			return flex.NewServiceError("Error deleting resource instance", err, response).Diagnostics()
 		}
 	}
 
@@ -2325,7 +2327,7 @@ func resourceIBMDatabaseInstanceExists(d *schema.ResourceData, meta interface{})
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("[ERROR] Error getting database: %s %s", err, response)
+		return false, flex.NewServiceError("Error getting database", err, response)
 	}
 	if instance != nil && (strings.Contains(*instance.State, "removed") || strings.Contains(*instance.State, databaseInstanceReclamation)) {
 		log.Printf("[WARN] Removing instance from state because it's in removed or pending_reclamation state")
@@ -2376,12 +2378,12 @@ func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, ins
 			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
 			if err != nil || instance == nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %s %s", d.Id(), err, response)
+					return nil, "", flex.NewServiceError(fmt.Sprintf("The resource instance %s does not exist anymore", d.Id()), err, response)
 				}
-				return nil, "", fmt.Errorf("[ERROR] GetResourceInstance on %s failed with error %s %s", d.Id(), err, response)
+				return nil, "", flex.NewServiceError(fmt.Sprintf("GetResourceInstance on %s failed", d.Id()), err, response)
 			}
 			if *instance.State == databaseInstanceFailStatus {
-				return *instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed: %s %s", d.Id(), err, response)
+				return *instance, *instance.State, flex.NewServiceError(fmt.Sprintf("The resource instance %s failed", d.Id()), err, response)
 			}
 			return *instance, *instance.State, nil
 		},
@@ -2415,12 +2417,12 @@ func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %s %s", d.Id(), err, response)
+					return nil, "", flex.NewServiceError(fmt.Sprintf("The resource instance %s does not exist anymore", d.Id()), err, response)
 				}
-				return nil, "", fmt.Errorf("[ERROR] GetResourceInstance on %s failed with error %s %s", d.Id(), err, response)
+				return nil, "", flex.NewServiceError(fmt.Sprintf("GetResourceInstance on %s failed", d.Id()), err, response)
 			}
 			if *instance.State == databaseInstanceFailStatus {
-				return *instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed: %s %s", d.Id(), err, response)
+				return *instance, *instance.State, flex.NewServiceError(fmt.Sprintf("The resource instance %s failed", d.Id()), err, response)
 			}
 			return *instance, *instance.State, nil
 		},
@@ -2500,10 +2502,10 @@ func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return instance, databaseInstanceSuccessStatus, nil
 				}
-				return nil, "", fmt.Errorf("[ERROR] GetResourceInstance on %s failed with error %s %s", d.Id(), err, response)
+				return nil, "", flex.NewServiceError(fmt.Sprintf("GetResourceInstance on %s failed", d.Id()), err, response)
 			}
 			if *instance.State == databaseInstanceFailStatus {
-				return instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed to delete: %s %s", d.Id(), err, response)
+				return instance, *instance.State, flex.NewServiceError(fmt.Sprintf("The resource instance %s failed to delete", d.Id()), err, response)
 			}
 			return *instance, *instance.State, nil
 		},
@@ -3095,7 +3097,7 @@ func (u *DatabaseUser) Create(instanceID string, d *schema.ResourceData, meta in
 
 	createDatabaseUserResponse, response, err := cloudDatabasesClient.CreateDatabaseUser(createDatabaseUserOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] CreateDatabaseUser (%s) failed %w\n%s", *userEntry.Username, err, response)
+		return flex.NewServiceError(fmt.Sprintf("CreateDatabaseUser (%s) failed", *userEntry.Username), err, response)
 	}
 
 	taskID := *createDatabaseUserResponse.Task.ID
@@ -3135,7 +3137,7 @@ func (u *DatabaseUser) Update(instanceID string, d *schema.ResourceData, meta in
 
 	// user was found but an error occurs while triggering task
 	if err != nil || (response.StatusCode < 200 || response.StatusCode >= 300) {
-		return fmt.Errorf("[ERROR] UpdateUser (%s) failed %w\n%s", *updateUserOptions.Username, err, response)
+		return flex.NewServiceError(fmt.Sprintf("UpdateUser (%s) failed", *updateUserOptions.Username), err, response)
 	}
 
 	taskID := *updateUserResponse.Task.ID
@@ -3164,8 +3166,7 @@ func (u *DatabaseUser) Delete(instanceID string, d *schema.ResourceData, meta in
 	deleteDatabaseUserResponse, response, err := cloudDatabasesClient.DeleteDatabaseUser(deleteDatabaseUserOptions)
 
 	if err != nil {
-		return fmt.Errorf(
-			"[ERROR] DeleteDatabaseUser (%s) failed %s\n%s", *deleteDatabaseUserOptions.Username, err, response)
+		return flex.NewServiceError(fmt.Sprintf("DeleteDatabaseUser (%s) failed", *deleteDatabaseUserOptions.Username), err, response)
 
 	}
 
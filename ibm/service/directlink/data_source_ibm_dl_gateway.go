@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 )
 
@@ -373,7 +374,7 @@ func dataSourceIBMDLGatewayVirtualConnectionsRead(d *schema.ResourceData, meta i
 	listVcOptions.SetGatewayID(dlGatewayId)
 	listGatewayVirtualConnections, response, err := directLink.ListGatewayVirtualConnections(listVcOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while listing directlink gateway's virtual connections XXX %s\n%s", err, response)
+		return flex.NewServiceError("Error while listing directlink gateway's virtual connections XXX", err, response)
 	}
 	gatewayVCs := make([]map[string]interface{}, 0)
 	for _, instance := range listGatewayVirtualConnections.VirtualConnections {
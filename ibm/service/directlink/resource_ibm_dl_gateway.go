@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 	"strings"
 	"time"
@@ -40,7 +39,7 @@ func ResourceIBMDLGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 		Schema: map[string]*schema.Schema{
@@ -513,6 +512,13 @@ func ResourceIBMDLGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the direct link gateway",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -929,7 +935,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 
 	gateway, response, err := directLink.CreateGateway(createGatewayOptionsModel)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create Direct Link Gateway (%s) err %s\n%s", dtype, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Create Direct Link Gateway (%s)", dtype), err, response)
 	}
 	d.SetId(*gateway.ID)
 
@@ -938,7 +944,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		getPortOptions := directLink.NewGetPortOptions(*gateway.Port.ID)
 		port, response, err := directLink.GetPort(getPortOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting port %s %s", response, err)
+			return flex.NewServiceError("Error getting port", err, response)
 		}
 		if port != nil && port.ProviderName != nil && !strings.Contains(strings.ToLower(*port.ProviderName), "netbond") && !strings.Contains(strings.ToLower(*port.ProviderName), "megaport") {
 			_, err = isWaitForDirectLinkAvailable(directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
@@ -949,8 +955,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(dlTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, dlTags) {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *gateway.Crn)
 		if err != nil {
@@ -1076,7 +1081,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Direct Link Gateway (%s Template): %s\n%s", dtype, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Direct Link Gateway (%s Template)", dtype), err, response)
 	}
 	if instance.Name != nil {
 		d.Set(dlName, *instance.Name)
@@ -1240,7 +1245,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 		log.Printf(
 			"Error on get of resource direct link gateway (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(dlTags, tags)
+	flex.SetResourceTags(d, meta, dlTags, "user", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -1296,7 +1301,7 @@ func isDirectLinkRefreshFunc(client *directlinkv1.DirectLinkV1, id string) resou
 		}
 		instance, response, err := client.GetGateway(getOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Direct Link: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting Direct Link", err, response)
 		}
 		if *instance.OperationalStatus == "provisioned" || *instance.OperationalStatus == "failed" || *instance.OperationalStatus == "create_rejected" {
 			return instance, dlGatewayProvisioningDone, nil
@@ -1327,7 +1332,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 	updateGatewayOptionsModel.ID = &ID
 	dtype := *instance.Type
 
-	if d.HasChange(dlTags) {
+	if flex.TagsHasChange(d, dlTags) {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
@@ -1361,7 +1366,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		_, response, operationErr := directLink.ListGatewayAsPrepends(listGatewayAsPrependsOptions)
 		if operationErr != nil {
 			log.Printf("[DEBUG] Error listing Direct Link Gateway AS Prepends err %s\n%s", err, response)
-			return fmt.Errorf("[ERROR] Error listing Direct Link Gateway AS Prepends err %s\n%s", err, response)
+			return flex.NewServiceError("Error listing Direct Link Gateway AS Prepends", err, response)
 		}
 		etag := response.GetHeaders().Get("etag")
 		asPrependsCreateItems := make([]directlinkv1.AsPrependPrefixArrayTemplate, 0)
@@ -1400,7 +1405,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		_, responseRep, operationErr := directLink.ReplaceGatewayAsPrepends(replaceGatewayAsPrependsOptionsModel)
 		if operationErr != nil {
 			log.Printf("[DEBUG] Error while replacing AS Prepends to a gateway id %s %s\n%s", ID, operationErr, responseRep)
-			return fmt.Errorf("[ERROR] Error while replacing AS Prepends to a gateway id %s %s\n%s", ID, operationErr, responseRep)
+			return flex.NewServiceError(fmt.Sprintf("Error while replacing AS Prepends to a gateway id %s", ID), operationErr, responseRep)
 		}
 	}
 	if d.HasChange(dlExportRouteFilters) {
@@ -1409,7 +1414,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		_, response, operationErr := directLink.ListGatewayExportRouteFilters(listGatewayExportRouteFiltersOptionsModel)
 		if operationErr != nil {
 			log.Printf("[DEBUG] Error listing the Direct Link Export Route Filters  %s\n%s", operationErr, response)
-			return fmt.Errorf("[ERROR] Error listing Direct Link Gateway Export Route Filters %s\n%s", operationErr, response)
+			return flex.NewServiceError("Error listing Direct Link Gateway Export Route Filters", operationErr, response)
 		}
 		etag := response.GetHeaders().Get("etag")
 		exportRouteFiltersReplaceList := make([]directlinkv1.GatewayTemplateRouteFilter, 0)
@@ -1450,7 +1455,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		_, response, err := directLink.ReplaceGatewayExportRouteFilters(replaceGatewayExportRouteFiltersOptionsModel)
 		if err != nil {
 			log.Printf("[DEBUG] Error while replacing Export Route Fileter to a gateway id %s %s\n%s", ID, err, response)
-			return fmt.Errorf("[ERROR] Error while replacing Export Route Fileter to a gateway id %s %s\n%s", ID, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error while replacing Export Route Fileter to a gateway id %s", ID), err, response)
 		}
 	}
 
@@ -1459,7 +1464,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		_, response, operationErr := directLink.ListGatewayImportRouteFilters(listGatewayImportRouteFiltersOptionsModel)
 		if operationErr != nil {
 			log.Printf("[DEBUG] Error listing the Direct Link Import Route Filters  %s\n%s", operationErr, response)
-			return fmt.Errorf("[ERROR] Error listing Direct Link Gateway Import Route Filters %s\n%s", operationErr, response)
+			return flex.NewServiceError("Error listing Direct Link Gateway Import Route Filters", operationErr, response)
 		}
 		etag := response.GetHeaders().Get("etag")
 		importRouteFiltersReplaceList := make([]directlinkv1.GatewayTemplateRouteFilter, 0)
@@ -1500,7 +1505,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		_, response, err := directLink.ReplaceGatewayImportRouteFilters(replaceGatewayImportRouteFiltersOptionsModel)
 		if err != nil {
 			log.Printf("[DEBUG] Error while replacing Import Route Fileter to a gateway id %s %s\n%s", ID, err, response)
-			return fmt.Errorf("[ERROR] Error while replacing Import Route Fileter to a gateway id %s %s\n%s", ID, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error while replacing Import Route Fileter to a gateway id %s", ID), err, response)
 		}
 
 	}
@@ -1651,7 +1656,7 @@ func resourceIBMdlGatewayExists(d *schema.ResourceData, meta interface{}) (bool,
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting Direct Link Gateway : %s\n%s", err, response)
+		return false, flex.NewServiceError("Error Getting Direct Link Gateway", err, response)
 	}
 	return true, nil
 }
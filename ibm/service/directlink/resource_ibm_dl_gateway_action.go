@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 	"time"
 
@@ -36,7 +35,7 @@ func ResourceIBMDLGatewayAction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 		Schema: map[string]*schema.Schema{
@@ -434,6 +433,13 @@ func ResourceIBMDLGatewayAction() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the direct link gateway",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -698,7 +704,7 @@ func resourceIBMdlGatewayCreateAction(d *schema.ResourceData, meta interface{})
 	}
 	gateway, response, err := directLink.CreateGatewayAction(createGatewayActionOptionsModel)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Direct Link Gateway Action err %s\n%s", err, response)
+		return flex.NewServiceError("Error creating Direct Link Gateway Action", err, response)
 	}
 
 	d.SetId(*gateway.ID)
@@ -706,8 +712,7 @@ func resourceIBMdlGatewayCreateAction(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(dlTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, dlTags) {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *gateway.Crn)
 		if err != nil {
@@ -824,7 +829,7 @@ func resourceIBMdlGatewayActionRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Direct Link Gateway: %s\n%s", err, response)
+		return flex.NewServiceError("Error Getting Direct Link Gateway", err, response)
 	}
 	if instance.Name != nil {
 		d.Set(dlName, *instance.Name)
@@ -938,7 +943,7 @@ func resourceIBMdlGatewayActionRead(d *schema.ResourceData, meta interface{}) er
 		log.Printf(
 			"Error on get of resource direct link gateway (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(dlTags, tags)
+	flex.SetResourceTags(d, meta, dlTags, "user", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -995,7 +1000,7 @@ func isDirectLinkRefreshFuncforAction(client *directlinkv1.DirectLinkV1, id stri
 		}
 		instance, response, err := client.GetGateway(getOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Direct Link: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting Direct Link", err, response)
 		}
 		if *instance.OperationalStatus == "provisioned" || *instance.OperationalStatus == "failed" || *instance.OperationalStatus == "create_rejected" {
 			return instance, dlGatewayProvisioningDone, nil
@@ -1023,7 +1028,7 @@ func isDirectLinkRefreshActionFunc(client *directlinkv1.DirectLinkV1, id string)
 		}
 		instance, response, err := client.GetGateway(getOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Direct Link: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting Direct Link", err, response)
 		}
 		if instance.ChangeRequest != nil {
 			gatewayChangeRequestIntf := instance.ChangeRequest
@@ -1055,7 +1060,7 @@ func isDirectLinkRefreshDeleteActionFunc(client *directlinkv1.DirectLinkV1, id s
 		}
 		instance, response, err := client.GetGateway(getOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Direct Link: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting Direct Link", err, response)
 		}
 		if instance.ChangeRequest != nil {
 			gatewayChangeRequestIntf := instance.ChangeRequest
@@ -1096,7 +1101,7 @@ func resourceIBMdlGatewayActionUpdate(d *schema.ResourceData, meta interface{})
 				d.SetId("")
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Getting Direct Link Gateway : %s\n%s", err, response)
+			return flex.NewServiceError("Error Getting Direct Link Gateway", err, response)
 		}
 		if instance.ChangeRequest != nil {
 			gatewayChangeRequestIntf := instance.ChangeRequest
@@ -1138,7 +1143,7 @@ func resourceIBMdlGatewayActionUpdate(d *schema.ResourceData, meta interface{})
 		createGatewayActionOptionsModel.Updates = updateList
 		gateway, response, err := directLink.CreateGatewayAction(createGatewayActionOptionsModel)
 		if err != nil {
-			return fmt.Errorf("[DEBUG] Direct Link Gateway update_attributes_approve err %s\n%s", err, response)
+			return flex.NewServiceError("Error approving Direct Link Gateway update_attributes", err, response)
 		}
 		d.SetId(*gateway.ID)
 		_, err = isWaitForDirectLinkAvailableforAction(directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
@@ -1154,7 +1159,7 @@ func resourceIBMdlGatewayActionUpdate(d *schema.ResourceData, meta interface{})
 		}
 		_, response, err := directLink.CreateGatewayAction(createGatewayActionOptionsModel)
 		if err != nil {
-			return fmt.Errorf("[DEBUG] delete_gateway_approve failed with error  %s\n%s", err, response)
+			return flex.NewServiceError("delete_gateway_approve failed", err, response)
 		}
 	}
 	return nil
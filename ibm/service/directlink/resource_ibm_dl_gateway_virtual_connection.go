@@ -166,7 +166,7 @@ func resourceIBMdlGatewayVCRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Directlink Gateway Connection (%s): %s\n%s", ID, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Directlink Gateway Connection (%s)", ID), err, response)
 	}
 
 	if instance.Name != nil {
@@ -194,7 +194,7 @@ func resourceIBMdlGatewayVCRead(d *schema.ResourceData, meta interface{}) error
 	}
 	dlgw, response, err := directLink.GetGateway(getGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Direct Link Gateway (Dedicated Template): %s\n%s", err, response)
+		return flex.NewServiceError("Error Getting Direct Link Gateway (Dedicated Template)", err, response)
 	}
 	d.Set(flex.RelatedCRN, *dlgw.Crn)
 	return nil
@@ -297,7 +297,7 @@ func resourceIBMdlGatewayVCExists(d *schema.ResourceData, meta interface{}) (boo
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting Direct Link Gateway (Dedicated Template) Virtual Connection: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error Getting Direct Link Gateway (Dedicated Template) Virtual Connection", err, response)
 	}
 
 	if response.StatusCode == 404 {
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
@@ -25,7 +24,7 @@ func ResourceIBMDLProviderGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -123,6 +122,13 @@ func ResourceIBMDLProviderGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the direct link gateway",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -231,8 +237,7 @@ func resourceIBMdlProviderGatewayCreate(d *schema.ResourceData, meta interface{}
 
 	log.Printf("[INFO] Created Direct Link Provider Gateway : %s", *gateway.ID)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(dlTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, dlTags) {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *gateway.Crn)
 		if err != nil {
@@ -265,7 +270,7 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Direct Link Gateway (%s Template): %s\n%s", dtype, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Direct Link Gateway (%s Template)", dtype), err, response)
 	}
 	if instance.Name != nil {
 		d.Set(dlName, *instance.Name)
@@ -319,7 +324,7 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 		log.Printf(
 			"Error on get of resource direct link gateway (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(dlTags, tags)
+	flex.SetResourceTags(d, meta, dlTags, "user", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -345,12 +350,12 @@ func resourceIBMdlProviderGatewayUpdate(d *schema.ResourceData, meta interface{}
 
 	instance, response, err := directLink.GetProviderGateway(getOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting provider gateway %s, %s", err, response)
+		return flex.NewServiceError("Error getting provider gateway", err, response)
 	}
 
 	updateGatewayOptionsModel := directLink.NewUpdateProviderGatewayOptions(ID)
 
-	if d.HasChange(dlTags) {
+	if flex.TagsHasChange(d, dlTags) {
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
@@ -428,7 +433,7 @@ func resourceIBMdlProviderGatewayExists(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting Direct Link Provider Gateway : %s\n%s", err, response)
+		return false, flex.NewServiceError("Error Getting Direct Link Provider Gateway", err, response)
 	}
 	return true, nil
 }
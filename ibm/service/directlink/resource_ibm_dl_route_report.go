@@ -250,7 +250,7 @@ func isDirectLinkGatewayRouteReportRefreshFunc(client *directlinkv1.DirectLinkV1
 		}
 		routeReport, response, err := client.GetGatewayRouteReport(getOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] error fetching directlink route report: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("error fetching directlink route report", err, response)
 		}
 		if *routeReport.Status == "complete" {
 			return routeReport, dlRouteReportComplete, nil
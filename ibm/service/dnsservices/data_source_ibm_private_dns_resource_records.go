@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -79,7 +80,7 @@ func dataSourceIBMPrivateDNSResourceRecordsRead(d *schema.ResourceData, meta int
 	listDNSResRecOptions := sess.NewListResourceRecordsOptions(instanceID, DnszoneID)
 	availableDNSResRecs, detail, err := sess.ListResourceRecords(listDNSResRecOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error reading list of pdns resource records:%s\n%s", err, detail)
+		return flex.NewServiceError("Error reading list of pdns resource records", err, detail)
 	}
 	dnsResRecs := make([]map[string]interface{}, 0)
 	for _, instance := range availableDNSResRecs.ResourceRecords {
@@ -5,10 +5,10 @@ package dnsservices
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -106,7 +106,7 @@ func dataSourceIBMDNSSecondaryZonesRead(context context.Context, d *schema.Resou
 
 	result, resp, err := sess.ListSecondaryZonesWithContext(context, opt)
 	if err != nil || result == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error listing the Secondary Zones %s:%s", err, resp))
+		return flex.NewServiceError("Error listing the Secondary Zones", err, resp).Diagnostics()
 	}
 
 	secondaryZones := make([]interface{}, 0)
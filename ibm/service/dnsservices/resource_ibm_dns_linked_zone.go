@@ -9,7 +9,7 @@ import (
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
-	//"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -132,7 +132,7 @@ func resourceIBMDNSLinkedZoneCreate(ctx context.Context, d *schema.ResourceData,
 
 	resource, response, err := sess.CreateLinkedZone(createLinkedZoneOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error creating DNS Linked zone:%s\n%s", err, response))
+		return flex.NewServiceError("Error creating DNS Linked zone", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", instanceID, *resource.ID))
@@ -158,7 +158,7 @@ func resourceIBMDNSLinkedZoneRead(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error reading DNS Linked zone:%s\n%s", err, response))
+		return flex.NewServiceError("Error reading DNS Linked zone", err, response).Diagnostics()
 	}
 
 	d.Set(DnsLinkedZoneInstanceID, idSet[0])
@@ -187,7 +187,7 @@ func resourceIBMDNSLinkedZoneUpdate(ctx context.Context, d *schema.ResourceData,
 	getLinkedZoneOptions := sess.NewGetLinkedZoneOptions(instanceID, linkedDnsZoneID)
 	_, response, err := sess.GetLinkedZone(getLinkedZoneOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching secondary zone:%s\n%s", err, response))
+		return flex.NewServiceError("Error fetching secondary zone", err, response).Diagnostics()
 	}
 
 	// Update DNS Linked zone if attributes has any change
@@ -206,7 +206,7 @@ func resourceIBMDNSLinkedZoneUpdate(ctx context.Context, d *schema.ResourceData,
 		_, response, err := sess.UpdateLinkedZone(updateLinkedZoneOptions)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating DNS Services zone:%s\n%s", err, response))
+			return flex.NewServiceError("Error updating DNS Services zone", err, response).Diagnostics()
 		}
 	}
 
@@ -234,7 +234,7 @@ func resourceIBMDNSLinkedZoneDelete(ctx context.Context, d *schema.ResourceData,
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error reading DNS Services secondary zone:%s\n%s", err, response))
+		return flex.NewServiceError("Error reading DNS Services secondary zone", err, response).Diagnostics()
 	}
 
 	d.SetId("")
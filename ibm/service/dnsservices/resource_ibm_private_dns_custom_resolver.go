@@ -241,7 +241,7 @@ func resouceIBMPrivateDNSCustomResolverCreate(context context.Context, d *schema
 	// Create a custom resolver
 	result, resp, err := sess.CreateCustomResolverWithContext(context, customResolverOption)
 	if err != nil || result == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error reading the custom resolver %s:%s", err, resp))
+		return flex.NewServiceError("Error reading the custom resolver", err, resp).Diagnostics()
 	}
 
 	d.SetId(flex.ConvertCisToTfTwoVar(*result.ID, crn))
@@ -277,7 +277,7 @@ func resouceIBMPrivateDNSCustomResolverRead(context context.Context, d *schema.R
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error reading the custom resolver %s:%s", err, response))
+		return flex.NewServiceError("Error reading the custom resolver", err, response).Diagnostics()
 	}
 	fwopt := sess.NewListForwardingRulesOptions(crn, customResolverID)
 
@@ -370,7 +370,7 @@ func resouceIBMPrivateDNSCustomResolverUpdate(context context.Context, d *schema
 		}
 		result, resp, err := sess.UpdateCustomResolverWithContext(context, opt)
 		if err != nil || result == nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the custom resolver %s:%s", err, resp))
+			return flex.NewServiceError("Error updating the custom resolver", err, resp).Diagnostics()
 		}
 
 	}
@@ -492,7 +492,7 @@ func resouceIBMPrivateDNSCustomResolverDelete(context context.Context, d *schema
 	optEnabled.SetEnabled(false)
 	result, resp, errEnabled := sess.UpdateCustomResolverWithContext(context, optEnabled)
 	if err != nil || result == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error updating the custom resolver to disable before deleting %s:%s", errEnabled, resp))
+		return flex.NewServiceError("Error updating the custom resolver to disable before deleting", errEnabled, resp).Diagnostics()
 	}
 
 	opt := sess.NewDeleteCustomResolverOptions(crn, customResolverID)
@@ -502,7 +502,7 @@ func resouceIBMPrivateDNSCustomResolverDelete(context context.Context, d *schema
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting the custom resolver %s:%s", err, response))
+		return flex.NewServiceError("Error deleting the custom resolver", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -618,7 +618,7 @@ func PDNSCustomResolverDisableLocation(meta interface{}, instanceID string, cust
 	updatelocation.SetEnabled(false)
 	result, resp, err := sess.UpdateCustomResolverLocation(updatelocation)
 	if err != nil || result == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Disabling the custom resolver location %s:%s", err, resp))
+		return flex.NewServiceError("Error Disabling the custom resolver location", err, resp).Diagnostics()
 	}
 	return nil
 }
@@ -634,7 +634,7 @@ func deleteCRLocation(meta interface{}, instanceID string, customResolverID stri
 		if resp != nil && resp.StatusCode == 404 {
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Deleting the custom resolver location %s:%s", errDel, resp))
+		return flex.NewServiceError("Error Deleting the custom resolver location", errDel, resp).Diagnostics()
 	}
 	return nil
 }
@@ -650,7 +650,7 @@ func addCRLocation(meta interface{}, instanceID string, customResolverID string,
 	result, resp, err := sess.AddCustomResolverLocation(opt)
 	locationID := *result.ID
 	if err != nil || result == nil {
-		return "", diag.FromErr(fmt.Errorf("[ERROR] Error creating the custom resolver location %s:%s", err, resp))
+		return "", flex.NewServiceError("Error creating the custom resolver location", err, resp).Diagnostics()
 	}
 	return locationID, nil
 }
@@ -665,7 +665,7 @@ func updateLocationSubnet(meta interface{}, instanceID string, customResolverID
 	updatelocation.SetEnabled(false)
 	result, resp, err := sess.UpdateCustomResolverLocation(updatelocation)
 	if err != nil || result == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Disable and updating the custom resolver location %s:%s", err, resp))
+		return flex.NewServiceError("Error Disable and updating the custom resolver location", err, resp).Diagnostics()
 	}
 	return nil
 }
@@ -117,7 +117,7 @@ func resourceIBMPrivateDNSSecondaryZoneCreate(ctx context.Context, d *schema.Res
 
 	resource, response, err := sess.CreateSecondaryZone(createSecondaryZoneOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error creating DNS Services secondary zone:%s\n%s", err, response))
+		return flex.NewServiceError("Error creating DNS Services secondary zone", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, resolverID, *resource.ID))
@@ -144,7 +144,7 @@ func resourceIBMPrivateDNSSecondaryZoneRead(ctx context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error reading DNS Services secondary zone:%s\n%s", err, response))
+		return flex.NewServiceError("Error reading DNS Services secondary zone", err, response).Diagnostics()
 	}
 
 	transferFrom := []string{}
@@ -183,7 +183,7 @@ func resourceIBMPrivateDNSSecondaryZoneUpdate(ctx context.Context, d *schema.Res
 	getZoneOptions := sess.NewGetSecondaryZoneOptions(instanceID, resolverID, secondaryZoneID)
 	_, response, err := sess.GetSecondaryZone(getZoneOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching secondary zone:%s\n%s", err, response))
+		return flex.NewServiceError("Error fetching secondary zone", err, response).Diagnostics()
 	}
 
 	// Update DNS zone if attributes has any change
@@ -205,7 +205,7 @@ func resourceIBMPrivateDNSSecondaryZoneUpdate(ctx context.Context, d *schema.Res
 		_, response, err := sess.UpdateSecondaryZone(updateSecondaryZoneOptions)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating DNS Services zone:%s\n%s", err, response))
+			return flex.NewServiceError("Error updating DNS Services zone", err, response).Diagnostics()
 		}
 	}
 
@@ -235,7 +235,7 @@ func resourceIBMPrivateDNSSecondaryZoneDelete(ctx context.Context, d *schema.Res
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error reading DNS Services secondary zone:%s\n%s", err, response))
+		return flex.NewServiceError("Error reading DNS Services secondary zone", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -197,7 +197,7 @@ func resourceIBMPrivateDNSGLBRead(d *schema.ResourceData, meta interface{}) erro
 	getlbOptions := sess.NewGetLoadBalancerOptions(idset[0], idset[1], idset[2])
 	presponse, resp, err := sess.GetLoadBalancer(getlbOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error fetching pdns GLB :%s\n%s", err, resp)
+		return flex.NewServiceError("Error fetching pdns GLB", err, resp)
 	}
 
 	response := *presponse
@@ -259,7 +259,7 @@ func resourceIBMPrivateDNSGLBUpdate(d *schema.ResourceData, meta interface{}) er
 
 		_, detail, err := sess.UpdateLoadBalancer(updatelbOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating pdns GLB :%s\n%s", err, detail)
+			return flex.NewServiceError("Error updating pdns GLB", err, detail)
 		}
 	}
 
@@ -276,7 +276,7 @@ func resourceIBMPrivateDNSGLBDelete(d *schema.ResourceData, meta interface{}) er
 	deletelbOptions := sess.NewDeleteLoadBalancerOptions(idset[0], idset[1], idset[2])
 	response, err := sess.DeleteLoadBalancer(deletelbOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting pdns GLB :%s\n%s", err, response)
+		return flex.NewServiceError("Error deleting pdns GLB", err, response)
 	}
 	_, err = isWaitForLoadBalancerDeleted(sess, d, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -368,7 +368,7 @@ func isVLoadBalancerDeleteRefreshFunc(LoadBalancer *dnssvcsv1.DnsSvcsV1, d *sche
 			if response != nil && response.StatusCode == 404 {
 				return "", pdnsGLBDeleted, nil
 			}
-			return "", "", fmt.Errorf("[ERROR] Error Getting PDNS Load Balancer : %s\n%s", err, response)
+			return "", "", flex.NewServiceError("Error Getting PDNS Load Balancer", err, response)
 		}
 		return LoadBalancer, pdnsGLBDeleting, err
 
@@ -229,7 +229,7 @@ func resourceIBMPrivateDNSGLBPoolRead(d *schema.ResourceData, meta interface{})
 	getPoolOptions := sess.NewGetPoolOptions(idset[0], idset[1])
 	presponse, resp, err := sess.GetPool(getPoolOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error fetching pdns GLB Pool:%s\n%s", err, resp)
+		return flex.NewServiceError("Error fetching pdns GLB Pool", err, resp)
 	}
 
 	response := *presponse
@@ -317,7 +317,7 @@ func resourceIBMPrivateDNSGLBPoolUpdate(d *schema.ResourceData, meta interface{}
 		}
 		_, detail, err := sess.UpdatePool(updatePoolOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating pdns GLB Pool:%s\n%s", err, detail)
+			return flex.NewServiceError("Error updating pdns GLB Pool", err, detail)
 		}
 	}
 
@@ -334,7 +334,7 @@ func resourceIBMPrivateDNSGLBPoolDelete(d *schema.ResourceData, meta interface{}
 	DeletePoolOptions := sess.NewDeletePoolOptions(idset[0], idset[1])
 	response, err := sess.DeletePool(DeletePoolOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting pdns GLB Pool:%s\n%s", err, response)
+		return flex.NewServiceError("Error deleting pdns GLB Pool", err, response)
 	}
 	_, err = waitForPDNSGlbPoolDelete(d, meta)
 	if err != nil {
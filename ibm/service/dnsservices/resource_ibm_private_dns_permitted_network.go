@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -122,7 +123,7 @@ func resourceIBMPrivateDNSPermittedNetworkCreate(d *schema.ResourceData, meta in
 	createPermittedNetworkOptions.SetType(nwType)
 	response, detail, err := sess.CreatePermittedNetwork(createPermittedNetworkOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating pdns permitted network:%s\n%s", err, detail)
+		return flex.NewServiceError("Error creating pdns permitted network", err, detail)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, *response.ID))
@@ -141,7 +142,7 @@ func resourceIBMPrivateDNSPermittedNetworkRead(d *schema.ResourceData, meta inte
 	response, detail, err := sess.GetPermittedNetwork(getPermittedNetworkOptions)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error reading pdns permitted network:%s\n%s", err, detail)
+		return flex.NewServiceError("Error reading pdns permitted network", err, detail)
 	}
 
 	d.Set(pdnsInstanceID, idSet[0])
@@ -170,7 +171,7 @@ func resourceIBMPrivateDNSPermittedNetworkDelete(d *schema.ResourceData, meta in
 	_, response, err := sess.DeletePermittedNetwork(deletePermittedNetworkOptions)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting pdns permitted network:%s\n%s", err, response)
+		return flex.NewServiceError("Error deleting pdns permitted network", err, response)
 	}
 
 	d.SetId("")
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -305,7 +306,7 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 	defer conns.IbmMutexKV.Unlock(mk)
 	response, detail, err := sess.CreateResourceRecord(createResourceRecordOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating pdns resource record:%s\n%s", err, detail)
+		return flex.NewServiceError("Error creating pdns resource record", err, detail)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, *response.ID))
@@ -322,7 +323,7 @@ func resourceIBMPrivateDNSResourceRecordRead(d *schema.ResourceData, meta interf
 	getResourceRecordOptions := sess.NewGetResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 	response, detail, err := sess.GetResourceRecord(getResourceRecordOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error reading pdns resource record:%s\n%s", err, detail)
+		return flex.NewServiceError("Error reading pdns resource record", err, detail)
 	}
 
 	// extract the record name by removing zone details
@@ -483,7 +484,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 
 		_, detail, err := sess.UpdateResourceRecord(updateResourceRecordOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating pdns resource record:%s\n%s", err, detail)
+			return flex.NewServiceError("Error updating pdns resource record", err, detail)
 		}
 	}
 
@@ -505,7 +506,7 @@ func resourceIBMPrivateDNSResourceRecordDelete(d *schema.ResourceData, meta inte
 	defer conns.IbmMutexKV.Unlock(mk)
 	response, err := sess.DeleteResourceRecord(deleteResourceRecordOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting pdns resource record:%s\n%s", err, response)
+		return flex.NewServiceError("Error deleting pdns resource record", err, response)
 	}
 
 	d.SetId("")
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -121,7 +122,7 @@ func resourceIBMPrivateDNSZoneCreate(d *schema.ResourceData, meta interface{}) e
 	createZoneOptions.SetLabel(zoneLabel)
 	response, detail, err := sess.CreateDnszone(createZoneOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating pdns zone:%s\n%s", err, detail)
+		return flex.NewServiceError("Error creating pdns zone", err, detail)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *response.InstanceID, *response.ID))
@@ -140,7 +141,7 @@ func resourceIBMPrivateDNSZoneRead(d *schema.ResourceData, meta interface{}) err
 	getZoneOptions := sess.NewGetDnszoneOptions(idSet[0], idSet[1])
 	response, detail, err := sess.GetDnszone(getZoneOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error fetching pdns zone:%s\n%s", err, detail)
+		return flex.NewServiceError("Error fetching pdns zone", err, detail)
 	}
 
 	d.Set(pdnsZoneID, response.ID)
@@ -167,7 +168,7 @@ func resourceIBMPrivateDNSZoneUpdate(d *schema.ResourceData, meta interface{}) e
 	getZoneOptions := sess.NewGetDnszoneOptions(idSet[0], idSet[1])
 	_, response, err := sess.GetDnszone(getZoneOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error fetching pdns zone:%s\n%s", err, response)
+		return flex.NewServiceError("Error fetching pdns zone", err, response)
 	}
 
 	// Update DNS zone if attributes has any change
@@ -182,7 +183,7 @@ func resourceIBMPrivateDNSZoneUpdate(d *schema.ResourceData, meta interface{}) e
 		_, detail, err := sess.UpdateDnszone(updateZoneOptions)
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating pdns zone:%s\n%s", err, detail)
+			return flex.NewServiceError("Error updating pdns zone", err, detail)
 		}
 	}
 
@@ -200,7 +201,7 @@ func resourceIBMPrivateDNSZoneDelete(d *schema.ResourceData, meta interface{}) e
 	deleteZoneOptions := sess.NewDeleteDnszoneOptions(idSet[0], idSet[1])
 	response, err := sess.DeleteDnszone(deleteZoneOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting pdns zone:%s\n%s", err, response)
+		return flex.NewServiceError("Error deleting pdns zone", err, response)
 	}
 
 	d.SetId("")
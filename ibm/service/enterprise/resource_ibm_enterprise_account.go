@@ -339,7 +339,7 @@ func resourceIbmEnterpriseAccountDelete(context context.Context, d *schema.Resou
 	response, err := enterpriseManagementClient.DeleteAccountWithContext(context, deleteAccountOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteAccountWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteAccountWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteAccountWithContext failed", err, response).Diagnostics()
 	}
 
 	return nil
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -248,7 +249,7 @@ func resourceIbmEnterpriseAccountGroupDelete(context context.Context, d *schema.
 	response, err := enterpriseManagementClient.DeleteAccountGroupWithContext(context, deleteAccountGroupOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteAccountGroupWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteAccountGroupWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteAccountGroupWithContext failed", err, response).Diagnostics()
 	}
 
 	return nil
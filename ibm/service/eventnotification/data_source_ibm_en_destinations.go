@@ -116,7 +116,7 @@ func dataSourceIBMEnDestinationsRead(context context.Context, d *schema.Resource
 		destinationList = result
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("ListDestinationsWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ListDestinationsWithContext failed", err, response).Diagnostics()
 		}
 
 		offset = offset + limit
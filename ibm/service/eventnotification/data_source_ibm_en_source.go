@@ -67,7 +67,7 @@ func dataSourceIBMEnSourceRead(context context.Context, d *schema.ResourceData,
 
 	result, response, err := enClient.GetSourceWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("GetSource failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSource failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *options.ID))
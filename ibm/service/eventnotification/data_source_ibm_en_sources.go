@@ -113,7 +113,7 @@ func dataSourceIBMEnSourcesRead(context context.Context, d *schema.ResourceData,
 		sourceList = result
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("ListSourcesWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ListSourcesWithContext failed", err, response).Diagnostics()
 		}
 
 		offset = offset + limit
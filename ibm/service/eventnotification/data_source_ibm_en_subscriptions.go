@@ -123,7 +123,7 @@ func dataSourceIBMEnSubscriptionsRead(context context.Context, d *schema.Resourc
 		subscriptionList = result
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("ListSubscriptionsWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ListSubscriptionsWithContext failed", err, response).Diagnostics()
 		}
 
 		offset = offset + limit
@@ -101,7 +101,7 @@ func resourceIBMEnFCMSubscriptionCreate(context context.Context, d *schema.Resou
 
 	result, response, err := enClient.CreateSubscriptionWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateSubscriptionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -131,7 +131,7 @@ func resourceIBMEnFCMSubscriptionRead(context context.Context, d *schema.Resourc
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSubscriptionWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -214,7 +214,7 @@ func resourceIBMEnFCMSubscriptionUpdate(context context.Context, d *schema.Resou
 
 		_, response, err := enClient.UpdateSubscriptionWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateSubscriptionWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSubscriptionWithContext failed", err, response).Diagnostics()
 		}
 
 		return resourceIBMEnFCMSubscriptionRead(context, d, meta)
@@ -245,7 +245,7 @@ func resourceIBMEnFCMSubscriptionDelete(context context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteSubscriptionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
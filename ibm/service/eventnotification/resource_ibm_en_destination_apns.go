@@ -182,7 +182,7 @@ func resourceIBMEnAPNSDestinationCreate(context context.Context, d *schema.Resou
 
 	result, response, err := enClient.CreateDestinationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateDestinationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -212,7 +212,7 @@ func resourceIBMEnAPNSDestinationRead(context context.Context, d *schema.Resourc
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetDestinationWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -305,7 +305,7 @@ func resourceIBMEnAPNSDestinationUpdate(context context.Context, d *schema.Resou
 		}
 		_, response, err := enClient.UpdateDestinationWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateDestinationWithContext failed", err, response).Diagnostics()
 		}
 
 		return resourceIBMEnAPNSDestinationRead(context, d, meta)
@@ -336,7 +336,7 @@ func resourceIBMEnAPNSDestinationDelete(context context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteDestinationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
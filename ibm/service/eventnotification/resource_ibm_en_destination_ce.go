@@ -135,7 +135,7 @@ func resourceIBMEnCodeEngineDestinationCreate(context context.Context, d *schema
 
 	result, response, err := enClient.CreateDestinationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateDestinationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -165,7 +165,7 @@ func resourceIBMEnCodeEngineDestinationRead(context context.Context, d *schema.R
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetDestinationWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -240,7 +240,7 @@ func resourceIBMEnCodeEngineDestinationUpdate(context context.Context, d *schema
 		}
 		_, response, err := enClient.UpdateDestinationWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateDestinationWithContext failed", err, response).Diagnostics()
 		}
 
 		return resourceIBMEnCodeEngineDestinationRead(context, d, meta)
@@ -271,7 +271,7 @@ func resourceIBMEnCodeEngineDestinationDelete(context context.Context, d *schema
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteDestinationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -123,7 +123,7 @@ func resourceIBMEnCFDestinationCreate(context context.Context, d *schema.Resourc
 
 	result, response, err := enClient.CreateDestinationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateDestinationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -153,7 +153,7 @@ func resourceIBMEnCFDestinationRead(context context.Context, d *schema.ResourceD
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetDestinationWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -228,7 +228,7 @@ func resourceIBMEnCFDestinationUpdate(context context.Context, d *schema.Resourc
 		}
 		_, response, err := enClient.UpdateDestinationWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateDestinationWithContext failed", err, response).Diagnostics()
 		}
 
 		return resourceIBMEnCFDestinationRead(context, d, meta)
@@ -259,7 +259,7 @@ func resourceIBMEnCFDestinationDelete(context context.Context, d *schema.Resourc
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteDestinationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
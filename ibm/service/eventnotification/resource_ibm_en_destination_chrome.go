@@ -129,7 +129,7 @@ func resourceIBMEnChromeDestinationCreate(context context.Context, d *schema.Res
 
 	result, response, err := enClient.CreateDestinationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateDestinationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -159,7 +159,7 @@ func resourceIBMEnChromeDestinationRead(context context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetDestinationWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -233,7 +233,7 @@ func resourceIBMEnChromeDestinationUpdate(context context.Context, d *schema.Res
 		}
 		_, response, err := enClient.UpdateDestinationWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateDestinationWithContext failed", err, response).Diagnostics()
 		}
 
 		return resourceIBMEnChromeDestinationRead(context, d, meta)
@@ -264,7 +264,7 @@ func resourceIBMEnChromeDestinationDelete(context context.Context, d *schema.Res
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteDestinationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
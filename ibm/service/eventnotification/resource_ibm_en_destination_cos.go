@@ -128,7 +128,7 @@ func resourceIBMEnCOSDestinationCreate(context context.Context, d *schema.Resour
 
 	result, response, err := enClient.CreateDestinationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateDestinationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -158,7 +158,7 @@ func resourceIBMEnCOSDestinationRead(context context.Context, d *schema.Resource
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetDestinationWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -233,7 +233,7 @@ func resourceIBMEnCOSDestinationUpdate(context context.Context, d *schema.Resour
 		}
 		_, response, err := enClient.UpdateDestinationWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateDestinationWithContext failed", err, response).Diagnostics()
 		}
 
 		return resourceIBMEnCOSDestinationRead(context, d, meta)
@@ -264,7 +264,7 @@ func resourceIBMEnCOSDestinationDelete(context context.Context, d *schema.Resour
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteDestinationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteDestinationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
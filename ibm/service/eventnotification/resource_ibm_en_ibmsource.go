@@ -64,7 +64,7 @@ func resourceIBMEnIBMSourceCreate(context context.Context, d *schema.ResourceDat
 
 	_, response, err := enClient.UpdateSourceWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("UpdateSourceWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("UpdateSourceWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *options.ID))
@@ -97,7 +97,7 @@ func resourceIBMEnIBMSourceRead(context context.Context, d *schema.ResourceData,
 			d.SetId(d.Get("source_id").(string))
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSourceWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSourceWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -153,7 +153,7 @@ func resourceIBMEnIBMSourceUpdate(context context.Context, d *schema.ResourceDat
 
 		_, response, err := enClient.UpdateSourceWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateSourceWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSourceWithContext failed", err, response).Diagnostics()
 		}
 
 		return resourceIBMEnIBMSourceRead(context, d, meta)
@@ -92,7 +92,7 @@ func resourceIBMEnIntegrationCreate(context context.Context, d *schema.ResourceD
 
 	_, response, err := enClient.ReplaceIntegrationWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("ReplaceIntegrationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ReplaceIntegrationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *options.ID))
@@ -122,7 +122,7 @@ func resourceIBMEnIntegrationRead(context context.Context, d *schema.ResourceDat
 			d.SetId(d.Get("integration_id").(string))
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetIntegrationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetIntegrationWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -169,7 +169,7 @@ func resourceIBMEnIntegrationUpdate(context context.Context, d *schema.ResourceD
 
 		_, response, err := enClient.ReplaceIntegrationWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("ReplaceIntegrationWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ReplaceIntegrationWithContext failed", err, response).Diagnostics()
 		}
 
 		return resourceIBMEnIntegrationRead(context, d, meta)
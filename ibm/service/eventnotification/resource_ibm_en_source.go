@@ -78,7 +78,7 @@ func resourceIBMEnSourceCreate(context context.Context, d *schema.ResourceData,
 
 	result, response, err := enClient.CreateSourcesWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateSourcesWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateSourcesWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -108,7 +108,7 @@ func resourceIBMEnSourceRead(context context.Context, d *schema.ResourceData, me
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSourceWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSourceWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -165,7 +165,7 @@ func resourceIBMEnSourceUpdate(context context.Context, d *schema.ResourceData,
 
 		_, response, err := enClient.UpdateSourceWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateSourceWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSourceWithContext failed", err, response).Diagnostics()
 		}
 
 		return resourceIBMEnSourceRead(context, d, meta)
@@ -196,7 +196,7 @@ func resourceIBMEnSourceDelete(context context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteSourceWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteSourceWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -172,7 +172,7 @@ func resourceIBMEnCustomEmailSubscriptionCreate(context context.Context, d *sche
 
 	result, response, err := enClient.CreateSubscriptionWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateSubscriptionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -202,7 +202,7 @@ func resourceIBMEnCustomEmailSubscriptionRead(context context.Context, d *schema
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSubscriptionWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -288,7 +288,7 @@ func resourceIBMEnCustomEmailSubscriptionUpdate(context context.Context, d *sche
 
 		_, response, err := enClient.UpdateSubscriptionWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateSubscriptionWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSubscriptionWithContext failed", err, response).Diagnostics()
 		}
 
 		return resourceIBMEnCustomEmailSubscriptionRead(context, d, meta)
@@ -319,7 +319,7 @@ func resourceIBMEnCustomEmailSubscriptionDelete(context context.Context, d *sche
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteSubscriptionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
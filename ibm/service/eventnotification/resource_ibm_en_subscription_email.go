@@ -157,7 +157,7 @@ func resourceIBMEnEmailSubscriptionCreate(context context.Context, d *schema.Res
 
 	result, response, err := enClient.CreateSubscriptionWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateSubscriptionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -187,7 +187,7 @@ func resourceIBMEnEmailSubscriptionRead(context context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSubscriptionWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -273,7 +273,7 @@ func resourceIBMEnEmailSubscriptionUpdate(context context.Context, d *schema.Res
 
 		_, response, err := enClient.UpdateSubscriptionWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateSubscriptionWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSubscriptionWithContext failed", err, response).Diagnostics()
 		}
 
 		return resourceIBMEnEmailSubscriptionRead(context, d, meta)
@@ -304,7 +304,7 @@ func resourceIBMEnEmailSubscriptionDelete(context context.Context, d *schema.Res
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteSubscriptionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -119,7 +119,7 @@ func resourceIBMEnSlackSubscriptionCreate(context context.Context, d *schema.Res
 
 	result, response, err := enClient.CreateSubscriptionWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateSubscriptionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -149,7 +149,7 @@ func resourceIBMEnSlackSubscriptionRead(context context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSubscriptionWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -235,7 +235,7 @@ func resourceIBMEnSlackSubscriptionUpdate(context context.Context, d *schema.Res
 
 		_, response, err := enClient.UpdateSubscriptionWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateSubscriptionWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSubscriptionWithContext failed", err, response).Diagnostics()
 		}
 
 		return resourceIBMEnSlackSubscriptionRead(context, d, meta)
@@ -266,7 +266,7 @@ func resourceIBMEnSlackSubscriptionDelete(context context.Context, d *schema.Res
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteSubscriptionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -131,7 +131,7 @@ func resourceIBMEnSMSSubscriptionCreate(context context.Context, d *schema.Resou
 
 	result, response, err := enClient.CreateSubscriptionWithContext(context, options)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("CreateSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateSubscriptionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
@@ -161,7 +161,7 @@ func resourceIBMEnSMSSubscriptionRead(context context.Context, d *schema.Resourc
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSubscriptionWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("instance_guid", options.InstanceID); err != nil {
@@ -247,7 +247,7 @@ func resourceIBMEnSMSSubscriptionUpdate(context context.Context, d *schema.Resou
 
 		_, response, err := enClient.UpdateSubscriptionWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("UpdateSubscriptionWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSubscriptionWithContext failed", err, response).Diagnostics()
 		}
 
 		return resourceIBMEnSMSSubscriptionRead(context, d, meta)
@@ -278,7 +278,7 @@ func resourceIBMEnSMSSubscriptionDelete(context context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("DeleteSubscriptionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteSubscriptionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
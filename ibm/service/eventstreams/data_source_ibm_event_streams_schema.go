@@ -5,10 +5,10 @@ package eventstreams
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -50,7 +50,7 @@ func dataSourceIBMEventStreamsSchemaRead(context context.Context, d *schema.Reso
 		log.Printf("[DEBUG] dataSourceIBMEventStreamsSchemaRead getInstanceURL err %s", err)
 		return diag.FromErr(err)
 	}
-	schemaregistryClient.SetServiceURL(adminURL)
+	setSchemaRegistryURL(schemaregistryClient, adminURL)
 
 	getLatestSchemaOptions := &schemaregistryv1.GetLatestSchemaOptions{}
 
@@ -60,7 +60,7 @@ func dataSourceIBMEventStreamsSchemaRead(context context.Context, d *schema.Reso
 	schema, response, err := schemaregistryClient.GetLatestSchemaWithContext(context, getLatestSchemaOptions)
 	if err != nil || schema == nil {
 		log.Printf("[DEBUG] GetLatestSchemaWithContext failed with error: %s and response:\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetLatestSchemaWithContext failed with error: %s\n and response:%s", err, response))
+		return flex.NewServiceError("GetLatestSchemaWithContext failed", err, response).Diagnostics()
 	}
 	uniqueID := getUniqueSchemaID(instanceCRN, schemaID)
 
@@ -181,7 +181,7 @@ func resourceIBMEventStreamsSchemaCreate(context context.Context, d *schema.Reso
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	schemaregistryClient.SetServiceURL(adminURL)
+	setSchemaRegistryURL(schemaregistryClient, adminURL)
 	createSchemaOptions := &schemaregistryv1.CreateSchemaOptions{}
 
 	if s, ok := d.GetOk("schema"); ok {
@@ -196,7 +196,7 @@ func resourceIBMEventStreamsSchemaCreate(context context.Context, d *schema.Reso
 	schemaMetadata, response, err := schemaregistryClient.CreateSchemaWithContext(context, createSchemaOptions)
 	if err != nil || schemaMetadata == nil {
 		log.Printf("[DEBUG] CreateSchemaWithContext failed with error: %s and response: \n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSchemaWithContext failed with eror: %s and response: \n%s", err, response))
+		return flex.NewServiceError("CreateSchemaWithContext failed", err, response).Diagnostics()
 	}
 	uniqueID := getUniqueSchemaID(instanceCRN, *schemaMetadata.ID)
 	d.SetId(uniqueID)
@@ -214,7 +214,7 @@ func resourceIBMEventStreamsSchemaRead(context context.Context, d *schema.Resour
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	schemaregistryClient.SetServiceURL(adminURL)
+	setSchemaRegistryURL(schemaregistryClient, adminURL)
 
 	getSchemaOptions := &schemaregistryv1.GetLatestSchemaOptions{}
 
@@ -228,7 +228,7 @@ func resourceIBMEventStreamsSchemaRead(context context.Context, d *schema.Resour
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("GetSchemaWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSchemaWithContext failed", err, response).Diagnostics()
 	}
 
 	s, err := json.Marshal(avroSchema)
@@ -255,7 +255,7 @@ func resourceIBMEventStreamsSchemaUpdate(context context.Context, d *schema.Reso
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	schemaregistryClient.SetServiceURL(adminURL)
+	setSchemaRegistryURL(schemaregistryClient, adminURL)
 
 	updateSchemaOptions := &schemaregistryv1.UpdateSchemaOptions{}
 	schemaID := d.Get("schema_id").(string)
@@ -270,7 +270,7 @@ func resourceIBMEventStreamsSchemaUpdate(context context.Context, d *schema.Reso
 		schemaMetadata, response, err := schemaregistryClient.UpdateSchemaWithContext(context, updateSchemaOptions)
 		if err != nil || schemaMetadata == nil {
 			log.Printf("[DEBUG] UpdateSchemaWithContext failed with error: %s\n and response: %s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSchemaWithContext failed with error: %s and response: \n%s", err, response))
+			return flex.NewServiceError("UpdateSchemaWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -287,7 +287,7 @@ func resourceIBMEventStreamsSchemaDelete(context context.Context, d *schema.Reso
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	schemaregistryClient.SetServiceURL(adminURL)
+	setSchemaRegistryURL(schemaregistryClient, adminURL)
 
 	deleteSchemaOptions := &schemaregistryv1.DeleteSchemaOptions{}
 	schemaID := d.Get("schema_id").(string)
@@ -306,7 +306,7 @@ func resourceIBMEventStreamsSchemaDelete(context context.Context, d *schema.Reso
 	response, err = schemaregistryClient.DeleteSchemaWithContext(context, deleteSchemaOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteSchemaWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteSchemaWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteSchemaWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -314,6 +314,14 @@ func resourceIBMEventStreamsSchemaDelete(context context.Context, d *schema.Reso
 	return nil
 }
 
+// setSchemaRegistryURL sets the kafka_http_url of the instance as the URL of the client, unless the
+// event_streams_schema_registry endpoint is set in the provider endpoints block
+func setSchemaRegistryURL(schemaregistryClient *schemaregistryv1.SchemaregistryV1, adminURL string) {
+	if schemaregistryClient.GetServiceURL() == "" {
+		schemaregistryClient.SetServiceURL(adminURL)
+	}
+}
+
 func getInstanceURL(d *schema.ResourceData, meta interface{}) (string, string, error) {
 	instanceCRN := d.Get("resource_instance_id").(string)
 	if len(instanceCRN) == 0 {
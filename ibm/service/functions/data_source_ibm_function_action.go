@@ -135,7 +135,7 @@ func dataSourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 	namespace := d.Get("namespace").(string)
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
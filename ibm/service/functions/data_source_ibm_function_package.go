@@ -76,7 +76,7 @@ func dataSourceIBMFunctionPackageRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 	namespace := d.Get("namespace").(string)
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
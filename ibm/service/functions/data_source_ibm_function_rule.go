@@ -70,7 +70,7 @@ func dataSourceIBMFunctionRuleRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 	namespace := d.Get("namespace").(string)
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
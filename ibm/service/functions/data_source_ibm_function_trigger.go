@@ -68,7 +68,7 @@ func dataSourceIBMFunctionTriggerRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 	namespace := d.Get("namespace").(string)
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
@@ -232,7 +232,7 @@ func resourceIBMFunctionActionCreate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 	namespace := d.Get("namespace").(string)
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
@@ -314,7 +314,7 @@ func resourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		return err
 	}
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
@@ -418,7 +418,7 @@ func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
@@ -503,7 +503,7 @@ func resourceIBMFunctionActionDelete(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return err
 	}
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
@@ -547,7 +547,7 @@ func resourceIBMFunctionActionExists(d *schema.ResourceData, meta interface{}) (
 		return false, err
 	}
 
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return false, err
 
@@ -655,7 +655,7 @@ func testAccCheckFunctionActionExists(n string, obj *whisk.Action) resource.Test
 			return err
 		}
 
-		client, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+		client, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, acc.TestAccProvider.Meta().(conns.ClientSession).RetryPolicy())
 		if err != nil {
 			return err
 
@@ -694,7 +694,7 @@ func testAccCheckFunctionActionDestroy(s *terraform.State) error {
 		namespace := parts[0]
 		name := parts[1]
 
-		client, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+		client, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, acc.TestAccProvider.Meta().(conns.ClientSession).RetryPolicy())
 		if err != nil && strings.Contains(err.Error(), "is not in the list of entitled namespaces") {
 			return nil
 		}
@@ -163,7 +163,7 @@ func resourceIBMFunctionPackageCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 	namespace := d.Get("namespace").(string)
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
@@ -250,7 +250,7 @@ func resourceIBMFunctionPackageRead(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return err
 	}
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
@@ -330,7 +330,7 @@ func resourceIBMFunctionPackageUpdate(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
@@ -402,7 +402,7 @@ func resourceIBMFunctionPackageDelete(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
@@ -445,7 +445,7 @@ func resourceIBMFunctionPackageExists(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return false, err
 	}
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return false, err
 
@@ -472,7 +472,7 @@ func testAccCheckFunctionPackageExists(n string, obj *whisk.Package) resource.Te
 		if err != nil {
 			return err
 		}
-		client, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+		client, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, acc.TestAccProvider.Meta().(conns.ClientSession).RetryPolicy())
 		if err != nil {
 			return err
 
@@ -511,7 +511,7 @@ func testAccCheckFunctionPackageDestroy(s *terraform.State) error {
 		namespace := parts[0]
 		name := parts[1]
 
-		wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+		wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, acc.TestAccProvider.Meta().(conns.ClientSession).RetryPolicy())
 		if err != nil && strings.Contains(err.Error(), "is not in the list of entitled namespaces") {
 			return nil
 		}
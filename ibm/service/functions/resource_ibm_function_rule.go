@@ -139,7 +139,7 @@ func resourceIBMFunctionRuleCreate(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 	namespace := d.Get("namespace").(string)
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
@@ -202,7 +202,7 @@ func resourceIBMFunctionRuleRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
@@ -246,7 +246,7 @@ func resourceIBMFunctionRuleUpdate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	namespace := parts[0]
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
@@ -311,7 +311,7 @@ func resourceIBMFunctionRuleDelete(d *schema.ResourceData, meta interface{}) err
 
 	namespace := parts[0]
 	ruleID := parts[1]
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
@@ -355,7 +355,7 @@ func resourceIBMFunctionRuleExists(d *schema.ResourceData, meta interface{}) (bo
 		return false, err
 	}
 
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return false, err
 
@@ -192,7 +192,7 @@ func testAccCheckFunctionRuleExists(n string, obj *whisk.Rule) resource.TestChec
 			return err
 		}
 
-		wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+		wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, acc.TestAccProvider.Meta().(conns.ClientSession).RetryPolicy())
 		if err != nil {
 			return err
 
@@ -231,7 +231,7 @@ func testAccCheckFunctionRuleDestroy(s *terraform.State) error {
 		namespace := parts[0]
 		name := parts[1]
 
-		client, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+		client, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, acc.TestAccProvider.Meta().(conns.ClientSession).RetryPolicy())
 		if err != nil && strings.Contains(err.Error(), "is not in the list of entitled namespaces") {
 			return nil
 		}
@@ -195,7 +195,7 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 	namespace := d.Get("namespace").(string)
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
@@ -330,7 +330,7 @@ func resourceIBMFunctionTriggerRead(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
@@ -390,7 +390,7 @@ func resourceIBMFunctionTriggerUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	namespace := parts[0]
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
@@ -460,7 +460,7 @@ func resourceIBMFunctionTriggerDelete(d *schema.ResourceData, meta interface{})
 	namespace := parts[0]
 	triggerID := parts[1]
 
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return err
 
@@ -547,7 +547,7 @@ func resourceIBMFunctionTriggerExists(d *schema.ResourceData, meta interface{})
 		return false, err
 	}
 
-	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, meta.(conns.ClientSession).RetryPolicy())
 	if err != nil {
 		return false, err
 
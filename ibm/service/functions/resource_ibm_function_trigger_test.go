@@ -248,7 +248,7 @@ func testAccCheckFunctionTriggerExists(n string, obj *whisk.Trigger) resource.Te
 			return err
 		}
 
-		client, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+		client, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, acc.TestAccProvider.Meta().(conns.ClientSession).RetryPolicy())
 		if err != nil {
 			return err
 
@@ -287,7 +287,7 @@ func testAccCheckFunctionTriggerDestroy(s *terraform.State) error {
 		namespace := parts[0]
 		name := parts[1]
 
-		client, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+		client, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI, acc.TestAccProvider.Meta().(conns.ClientSession).RetryPolicy())
 		if err != nil && strings.Contains(err.Error(), "is not in the list of entitled namespaces") {
 			return nil
 		}
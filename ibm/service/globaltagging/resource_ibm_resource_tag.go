@@ -37,7 +37,7 @@ func ResourceIBMResourceTag() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -167,7 +167,7 @@ func resourceIBMResourceTagCreate(d *schema.ResourceData, meta interface{}) erro
 	if len(add) > 0 {
 		_, resp, err := gtClient.AttachTag(AttachTagOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error attaching resource tags : %v\n%s", resp, err)
+			return flex.NewServiceError("Error attaching resource tags", err, resp)
 		}
 	}
 
@@ -290,7 +290,7 @@ func resourceIBMResourceTagDelete(d *schema.ResourceData, meta interface{}) erro
 
 		_, resp, err := gtClient.DetachTag(detachTagOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error detaching resource tags %v: %s\n%s", remove, err, resp)
+			return flex.NewServiceError(fmt.Sprintf("Error detaching resource tags %v", remove), err, resp)
 		}
 		for _, v := range remove {
 			delTagOptions := &globaltaggingv1.DeleteTagOptions{
@@ -298,7 +298,7 @@ func resourceIBMResourceTagDelete(d *schema.ResourceData, meta interface{}) erro
 			}
 			_, resp, err := gtClient.DeleteTag(delTagOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error deleting resource tag %v: %s\n%s", v, err, resp)
+				return flex.NewServiceError(fmt.Sprintf("Error deleting resource tag %v", v), err, resp)
 			}
 		}
 	}
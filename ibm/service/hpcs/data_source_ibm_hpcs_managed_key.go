@@ -298,7 +298,7 @@ func DataSourceIbmManagedKeyRead(context context.Context, d *schema.ResourceData
 	managedKey, response, err := ukoClient.GetManagedKeyWithContext(context, getManagedKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetManagedKeyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetManagedKeyWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetManagedKeyWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instance_id, vault_id, *getManagedKeyOptions.ID))
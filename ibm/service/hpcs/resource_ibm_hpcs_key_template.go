@@ -256,7 +256,7 @@ func ResourceIbmKeyTemplateCreate(context context.Context, d *schema.ResourceDat
 	template, response, err := ukoClient.CreateKeyTemplateWithContext(context, createKeyTemplateOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateKeyTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateKeyTemplateWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateKeyTemplateWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instance_id, d.Get("uko_vault").(string), *template.ID))
@@ -293,7 +293,7 @@ func ResourceIbmKeyTemplateRead(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetKeyTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetKeyTemplateWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetKeyTemplateWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("uko_vault", getKeyTemplateOptions.UKOVault); err != nil {
@@ -421,7 +421,7 @@ func ResourceIbmKeyTemplateUpdate(context context.Context, d *schema.ResourceDat
 		_, response, err := ukoClient.UpdateKeyTemplateWithContext(context, updateKeyTemplateOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateKeyTemplateWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateKeyTemplateWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateKeyTemplateWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -457,7 +457,7 @@ func ResourceIbmKeyTemplateDelete(context context.Context, d *schema.ResourceDat
 	response, err := ukoClient.DeleteKeyTemplateWithContext(context, deleteKeyTemplateOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteKeyTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteKeyTemplateWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteKeyTemplateWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
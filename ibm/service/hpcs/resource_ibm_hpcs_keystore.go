@@ -300,7 +300,7 @@ func ResourceIbmKeystoreCreate(context context.Context, d *schema.ResourceData,
 	keystoreIntf, response, err := ukoClient.CreateKeystoreWithContext(context, createKeystoreOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateKeystoreWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateKeystoreWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateKeystoreWithContext failed", err, response).Diagnostics()
 	}
 
 	keystore := keystoreIntf.(*ukov4.Keystore)
@@ -343,7 +343,7 @@ func ResourceIbmKeystoreRead(context context.Context, d *schema.ResourceData, me
 			return nil
 		}
 		log.Printf("[DEBUG] GetKeystoreWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetKeystoreWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetKeystoreWithContext failed", err, response).Diagnostics()
 	}
 
 	keystore := keystoreIntf.(*ukov4.Keystore)
@@ -512,7 +512,7 @@ func ResourceIbmKeystoreUpdate(context context.Context, d *schema.ResourceData,
 		_, response, err := ukoClient.UpdateKeystoreWithContext(context, updateKeystoreOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateKeystoreWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateKeystoreWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateKeystoreWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -553,7 +553,7 @@ func ResourceIbmKeystoreDelete(context context.Context, d *schema.ResourceData,
 	response, err := ukoClient.DeleteKeystoreWithContext(context, deleteKeystoreOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteKeystoreWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteKeystoreWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteKeystoreWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -1386,7 +1386,7 @@ func SyncKeys(ukoClient *ukov4.UkoV4, context context.Context, UKOVault string,
 	result, response, err := ukoClient.ListManagedKeysFromKeystoreWithContext(context, listManagedKeysOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListManagedKeysFromKeystoreWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListManagedKeysFromKeystoreWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ListManagedKeysFromKeystoreWithContext failed", err, response).Diagnostics()
 	}
 	var wasError diag.Diagnostics = nil
 	for i := 0; i < len(result.ManagedKeys); i++ {
@@ -1397,7 +1397,7 @@ func SyncKeys(ukoClient *ukov4.UkoV4, context context.Context, UKOVault string,
 		_, response, err = ukoClient.GetManagedKeyWithContext(context, getManagedKeyOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetManagedKeyWithContext failed %s\n%s", err, response)
-			wasError = diag.FromErr(fmt.Errorf("GetManagedKeyWithContext failed %s\n%s", err, response))
+			wasError = flex.NewServiceError("GetManagedKeyWithContext failed", err, response).Diagnostics()
 			// Can't do sync without etag, move on
 			continue
 		}
@@ -1412,7 +1412,7 @@ func SyncKeys(ukoClient *ukov4.UkoV4, context context.Context, UKOVault string,
 		_, response, err = ukoClient.SyncManagedKeyWithContext(context, syncManagedKeyOptions)
 		if err != nil {
 			log.Printf("[DEBUG] SyncManagedKeyWithContext failed %s\n%s", err, response)
-			wasError = diag.FromErr(fmt.Errorf("SyncManagedKeyWithContext failed %s\n%s", err, response))
+			wasError = flex.NewServiceError("SyncManagedKeyWithContext failed", err, response).Diagnostics()
 		}
 	}
 	return wasError
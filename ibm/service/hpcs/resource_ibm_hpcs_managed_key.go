@@ -388,7 +388,7 @@ func ResourceIbmManagedKeyCreate(context context.Context, d *schema.ResourceData
 	managedKey, response, err := ukoClient.CreateManagedKeyWithContext(context, createManagedKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateManagedKeyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateManagedKeyWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateManagedKeyWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instance_id, uko_vault, *managedKey.ID))
@@ -425,7 +425,7 @@ func ResourceIbmManagedKeyRead(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetManagedKeyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetManagedKeyWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetManagedKeyWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("uko_vault", getManagedKeyOptions.UKOVault); err != nil {
@@ -595,7 +595,7 @@ func ResourceIbmManagedKeyUpdate(context context.Context, d *schema.ResourceData
 		_, response, err := ukoClient.UpdateManagedKeyWithContext(context, updateManagedKeyOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateManagedKeyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateManagedKeyWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateManagedKeyWithContext failed", err, response).Diagnostics()
 		}
 		etag = response.Headers.Get("Etag")
 	}
@@ -616,7 +616,7 @@ func ResourceIbmManagedKeyUpdate(context context.Context, d *schema.ResourceData
 				_, response, err := ukoClient.DeactivateManagedKeyWithContext(context, deactivateManagedKeyOptions)
 				if err != nil {
 					log.Printf("[DEBUG] DeactivateManagedKeyWithContext failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("DeactivateManagedKeyWithContext failed %s\n%s", err, response))
+					return flex.NewServiceError("DeactivateManagedKeyWithContext failed", err, response).Diagnostics()
 				}
 			} else {
 				return diag.FromErr(fmt.Errorf("Deactivate managed key failed: Cannot deactivate key not in active state"))
@@ -632,7 +632,7 @@ func ResourceIbmManagedKeyUpdate(context context.Context, d *schema.ResourceData
 				_, response, err := ukoClient.DestroyManagedKeyWithContext(context, destroyManagedKeyOptions)
 				if err != nil {
 					log.Printf("[DEBUG] DestroyManagedKeyWithContext failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("DestroyManagedKeyWithContext failed %s\n%s", err, response))
+					return flex.NewServiceError("DestroyManagedKeyWithContext failed", err, response).Diagnostics()
 				}
 			} else {
 				return diag.FromErr(fmt.Errorf("Destroy managed key failed: Cannot destroy key not in deactivated state"))
@@ -648,7 +648,7 @@ func ResourceIbmManagedKeyUpdate(context context.Context, d *schema.ResourceData
 				_, response, err := ukoClient.ActivateManagedKeyWithContext(context, activateManagedKeyOptions)
 				if err != nil {
 					log.Printf("[DEBUG] ActivateManagedKeyWithContext failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("ActivateManagedKeyWithContext failed %s\n%s", err, response))
+					return flex.NewServiceError("ActivateManagedKeyWithContext failed", err, response).Diagnostics()
 				}
 			} else {
 				return diag.FromErr(fmt.Errorf("Activate managed key failed: Cannot activate key not in deactivated or pre_activation state"))
@@ -695,7 +695,7 @@ func ResourceIbmManagedKeyDelete(context context.Context, d *schema.ResourceData
 	response, err := ukoClient.DeleteManagedKeyWithContext(context, deleteManagedKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteManagedKeyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteManagedKeyWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteManagedKeyWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -153,7 +153,7 @@ func ResourceIbmVaultCreate(context context.Context, d *schema.ResourceData, met
 	vault, response, err := ukoClient.CreateVaultWithContext(context, createVaultOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateVaultWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateVaultWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateVaultWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instance_id, *vault.ID))
@@ -188,7 +188,7 @@ func ResourceIbmVaultRead(context context.Context, d *schema.ResourceData, meta
 			return nil
 		}
 		log.Printf("[DEBUG] GetVaultWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetVaultWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetVaultWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("vault_id", vault.ID); err != nil {
@@ -260,7 +260,7 @@ func ResourceIbmVaultUpdate(context context.Context, d *schema.ResourceData, met
 		_, response, err := ukoClient.UpdateVaultWithContext(context, updateVaultOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateVaultWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateVaultWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateVaultWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -293,7 +293,7 @@ func ResourceIbmVaultDelete(context context.Context, d *schema.ResourceData, met
 	response, err := ukoClient.DeleteVaultWithContext(context, deleteVaultOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteVaultWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteVaultWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteVaultWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -315,6 +315,9 @@ func getUkoUrl(context context.Context, region string, instance_id string, ukoCl
 		} else {
 			return fmt.Sprintf("https://%s/", v), nil
 		}
+	} else if url := ukoClient.GetServiceURL(); url != "" {
+		// Set in the hpcs_uko endpoint of the provider endpoints block
+		return url, nil
 	} else {
 		if os.Getenv("IBMCLOUD_IAM_API_ENDPOINT") == "https://iam.test.cloud.ibm.com" {
 			if region == "us-south" {
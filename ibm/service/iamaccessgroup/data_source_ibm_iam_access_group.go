@@ -169,7 +169,7 @@ func dataIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 		serviceIDs, resp, err := iamClient.ListServiceIds(&listServiceIDOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error listing Service Ids %s %s", err, resp)
+			return flex.NewServiceError("Error listing Service Ids", err, resp)
 		}
 		start = flex.GetNextIAM(serviceIDs.Next)
 		allrecs = append(allrecs, serviceIDs.Serviceids...)
@@ -192,7 +192,7 @@ func dataIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 		profileIDs, resp, err := iamClient.ListProfiles(&listProfilesOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error listing Trusted Profiles %s %s", err, resp)
+			return flex.NewServiceError("Error listing Trusted Profiles", err, resp)
 		}
 		profileStart = flex.GetNextIAM(profileIDs.Next)
 		allprofiles = append(allprofiles, profileIDs.Profiles...)
@@ -207,7 +207,7 @@ func dataIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
 	listAccessGroupOption.Offset = &offset
 	retreivedGroups, detailedResponse, err := iamAccessGroupsClient.ListAccessGroups(listAccessGroupOption)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving access groups: %s. API Response is: %s", err, detailedResponse)
+		return flex.NewServiceError("Error retrieving access groups", err, detailedResponse)
 	}
 
 	if len(retreivedGroups.Groups) == 0 {
@@ -221,7 +221,7 @@ func dataIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
 		listAccessGroupOption.SetOffset(offset)
 		retreivedGroups, detailedResponse, err := iamAccessGroupsClient.ListAccessGroups(listAccessGroupOption)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving access groups: %s. API Response is: %s", err, detailedResponse)
+			return flex.NewServiceError("Error retrieving access groups", err, detailedResponse)
 		}
 
 		allGroups = append(allGroups, retreivedGroups.Groups...)
@@ -206,7 +206,7 @@ func dataSourceIBMIAMAccessGroupTemplateAssignmentRead(context context.Context,
 	listTemplateAssignmentResponse, response, err := iamAccessGroupsClient.ListAssignmentsWithContext(context, listAssignmentsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListAssignmentsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListAssignmentsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ListAssignmentsWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(dataSourceIBMIAMAccessGroupTemplateAssignmentID(d))
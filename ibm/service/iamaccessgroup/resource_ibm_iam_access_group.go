@@ -5,8 +5,8 @@ package iamaccessgroup
 
 import (
 	"context"
-	"fmt"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -70,7 +70,7 @@ func resourceIBMIAMAccessGroupCreate(context context.Context, d *schema.Resource
 	}
 	agrp, detailedResponse, err := iamAccessGroupsClient.CreateAccessGroup(creatAccessGroupOptions)
 	if err != nil || agrp == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error creating access group: %s. API Response: %s", err, detailedResponse))
+		return flex.NewServiceError("Error creating access group", err, detailedResponse).Diagnostics()
 	}
 
 	d.SetId(*agrp.ID)
@@ -102,7 +102,7 @@ func resourceIBMIAMAccessGroupRead(context context.Context, d *schema.ResourceDa
 		agrp, detailedResponse, err = iamAccessGroupsClient.GetAccessGroup(getAccessGroupOptions)
 	}
 	if err != nil || agrp == nil || detailedResponse == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group: %s. API Response: %s", err, detailedResponse))
+		return flex.NewServiceError("Error retrieving access group", err, detailedResponse).Diagnostics()
 	}
 	version := detailedResponse.GetHeaders().Get("etag")
 	d.Set("name", agrp.Name)
@@ -138,7 +138,7 @@ func resourceIBMIAMAccessGroupUpdate(context context.Context, d *schema.Resource
 	if hasChange {
 		agrp, detailedResponse, err := iamAccessGroupsClient.UpdateAccessGroup(updateAccessGroupOptions)
 		if err != nil || agrp == nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating access group: %s. API Response: %s", err, detailedResponse))
+			return flex.NewServiceError("Error updating access group", err, detailedResponse).Diagnostics()
 		}
 	}
 
@@ -158,7 +158,7 @@ func resourceIBMIAMAccessGroupDelete(context context.Context, d *schema.Resource
 	deleteAccessGroupOptions.SetForce(force)
 	detailedResponse, err := iamAccessGroupsClient.DeleteAccessGroup(deleteAccessGroupOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting access group: %s, API Response: %s", err, detailedResponse))
+		return flex.NewServiceError("Error deleting access group", err, detailedResponse).Diagnostics()
 	}
 
 	d.SetId("")
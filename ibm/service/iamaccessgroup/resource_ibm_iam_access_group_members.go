@@ -137,7 +137,7 @@ func resourceIBMIAMAccessGroupMembersCreate(context context.Context, d *schema.R
 	addMembersToAccessGroupOptions.SetMembers(members)
 	membership, detailResponse, err := iamAccessGroupsClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions)
 	if err != nil || membership == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error adding members to group(%s). API response: %s", grpID, detailResponse))
+		return flex.NewServiceError(fmt.Sprintf("Error adding members to group(%s)", grpID), err, detailResponse).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", grpID, time.Now().UTC().String()))
@@ -164,7 +164,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 	listAccessGroupMembersOptions.SetLimit(limit)
 	members, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
+		return flex.NewServiceError("Error retrieving access group members", err, detailedResponse).Diagnostics()
 	}
 	allMembers := members.Members
 	totalMembers := flex.IntValue(members.TotalCount)
@@ -173,7 +173,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 		listAccessGroupMembersOptions.SetOffset(offset)
 		members, detailedResponse, err = iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
+			return flex.NewServiceError("Error retrieving access group members", err, detailedResponse).Diagnostics()
 		}
 		allMembers = append(allMembers, members.Members...)
 	}
@@ -216,7 +216,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 
 		serviceIDs, resp, err := iamClient.ListServiceIds(&listServiceIDOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error listing Service Ids %s %s", err, resp))
+			return flex.NewServiceError("Error listing Service Ids", err, resp).Diagnostics()
 		}
 		start = flex.GetNextIAM(serviceIDs.Next)
 		allrecs = append(allrecs, serviceIDs.Serviceids...)
@@ -239,7 +239,7 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 
 		profileIDs, resp, err := iamClient.ListProfiles(&listProfilesOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error listing Trusted Profiles %s %s", err, resp))
+			return flex.NewServiceError("Error listing Trusted Profiles", err, resp).Diagnostics()
 		}
 		profileStart = flex.GetNextIAM(profileIDs.Next)
 		allprofiles = append(allprofiles, profileIDs.Profiles...)
@@ -327,7 +327,7 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 		addMembersToAccessGroupOptions.SetMembers(members)
 		membership, detailResponse, err := iamAccessGroupsClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions)
 		if err != nil || membership == nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating members to group(%s). API response: %s", grpID, detailResponse))
+			return flex.NewServiceError(fmt.Sprintf("Error updating members to group(%s)", grpID), err, detailResponse).Diagnostics()
 		}
 
 	}
@@ -355,12 +355,12 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 			}
 			serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
 			if err != nil || serviceID == nil {
-				return diag.FromErr(fmt.Errorf("ERROR] Error Getting Service Ids %s %s", err, resp))
+				return flex.NewServiceError("ERROR] Error Getting Service Ids", err, resp).Diagnostics()
 			}
 			removeMembersFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, *serviceID.IamID)
 			detailResponse, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error removing members to group(%s). API Response: %s", grpID, detailResponse))
+				return flex.NewServiceError(fmt.Sprintf("Error removing members to group(%s)", grpID), err, detailResponse).Diagnostics()
 			}
 
 		}
@@ -371,12 +371,12 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 			}
 			profileID, resp, err := iamClient.GetProfile(&getProfileOptions)
 			if err != nil || profileID == nil {
-				return diag.FromErr(fmt.Errorf("ERROR] Error Getting Profile Ids %s %s", err, resp))
+				return flex.NewServiceError("ERROR] Error Getting Profile Ids", err, resp).Diagnostics()
 			}
 			removeMembersFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, *profileID.IamID)
 			detailResponse, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error removing members to group(%s). API Response: %s", grpID, detailResponse))
+				return flex.NewServiceError(fmt.Sprintf("Error removing members to group(%s)", grpID), err, detailResponse).Diagnostics()
 			}
 
 		}
@@ -508,7 +508,7 @@ func getServiceID(id string, meta interface{}) (iamidentityv1.ServiceID, error)
 	}
 	serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
 	if err != nil || serviceID == nil {
-		return serviceids, fmt.Errorf("ERROR] Error Getting Service Ids %s %s", err, resp)
+		return serviceids, flex.NewServiceError("ERROR] Error Getting Service Ids", err, resp)
 	}
 	return *serviceID, nil
 }
@@ -548,7 +548,7 @@ func getProfileID(id string, meta interface{}) (iamidentityv1.TrustedProfile, er
 	}
 	profileID, resp, err := iamClient.GetProfile(&getProfileOptions)
 	if err != nil || profileID == nil {
-		return profileids, fmt.Errorf("ERROR] Error Getting Profile Ids %s %s", err, resp)
+		return profileids, flex.NewServiceError("ERROR] Error Getting Profile Ids", err, resp)
 	}
 	return *profileID, nil
 }
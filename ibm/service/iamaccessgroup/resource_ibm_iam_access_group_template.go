@@ -384,7 +384,7 @@ func resourceIBMIAMAccessGroupTemplateCreate(context context.Context, d *schema.
 	templateResponse, response, err := iamAccessGroupsClient.CreateTemplateWithContext(context, createTemplateOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTemplateWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateTemplateWithContext failed", err, response).Diagnostics()
 	}
 	version, _ := strconv.Atoi(*templateResponse.Version)
 
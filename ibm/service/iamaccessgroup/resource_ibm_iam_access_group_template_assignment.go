@@ -193,7 +193,7 @@ func resourceIBMIAMAccessGroupTemplateAssignmentCreate(context context.Context,
 	templateAssignmentResponse, response, err := iamAccessGroupsClient.CreateAssignmentWithContext(context, createAssignmentOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateAssignmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateAssignmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateAssignmentWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*templateAssignmentResponse.ID)
@@ -223,7 +223,7 @@ func resourceIBMIAMAccessGroupTemplateAssignmentRead(context context.Context, d
 			return nil
 		}
 		log.Printf("[DEBUG] GetAssignmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAssignmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetAssignmentWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("template_id", templateAssignmentVerboseResponse.TemplateID); err != nil {
@@ -291,7 +291,7 @@ func resourceIBMIAMAccessGroupTemplateAssignmentUpdate(context context.Context,
 			return nil
 		}
 		log.Printf("[DEBUG] GetAssignmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAssignmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetAssignmentWithContext failed", err, response).Diagnostics()
 	}
 
 	updateAssignmentOptions.SetIfMatch(response.Headers.Get("ETag"))
@@ -307,7 +307,7 @@ func resourceIBMIAMAccessGroupTemplateAssignmentUpdate(context context.Context,
 		_, response, err := iamAccessGroupsClient.UpdateAssignmentWithContext(context, updateAssignmentOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateAssignmentWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateAssignmentWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateAssignmentWithContext failed", err, response).Diagnostics()
 		}
 		waitForAssignment(d.Timeout(schema.TimeoutUpdate), meta, d, isAccessGroupTemplateAssigned)
 	}
@@ -328,7 +328,7 @@ func resourceIBMIAMAccessGroupTemplateAssignmentDelete(context context.Context,
 	response, err := iamAccessGroupsClient.DeleteAssignmentWithContext(context, deleteAssignmentOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteAssignmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteAssignmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteAssignmentWithContext failed", err, response).Diagnostics()
 	}
 
 	waitForAssignment(d.Timeout(schema.TimeoutDelete), meta, d, isAccessGroupTemplateAssignmentDeleted)
@@ -381,11 +381,11 @@ func isAccessGroupTemplateAssigned(id string, meta interface{}) resource.StateRe
 			}
 
 			if *assignment.Status == "failed" {
-				return assignment, failed, fmt.Errorf("[ERROR] The assignment %s did complete but with a 'failed' status. Please check assignment resource for detailed errors: %s\n", id, response)
+				return assignment, failed, flex.NewServiceError(fmt.Sprintf("The assignment %s did complete but with a 'failed' status. Please check assignment resource for detailed errors", id), nil, response)
 			}
 		}
 
-		return assignment, failed, fmt.Errorf("[ERROR] Unexpected status reached for assignment %s.: %s\n", id, response)
+		return assignment, failed, flex.NewServiceError(fmt.Sprintf("Unexpected status reached for assignment %s", id), nil, response)
 	}
 }
 
@@ -407,7 +407,7 @@ func isAccessGroupTemplateAssignmentDeleted(id string, meta interface{}) resourc
 				return assignment, complete, nil
 			}
 
-			return nil, failed, fmt.Errorf("[ERROR] The assignment %s failed to delete or deletion was not completed within specific timeout period: %s\n%s", id, err, response)
+			return nil, failed, flex.NewServiceError(fmt.Sprintf("The assignment %s failed to delete or deletion was not completed within specific timeout period", id), err, response)
 		} else {
 			log.Printf("Assignment removal still in progress\n")
 		}
@@ -387,7 +387,7 @@ func resourceIBMIAMAccessGroupTemplateVersionCreate(context context.Context, d *
 	templateVersionResponse, response, err := iamAccessGroupsClient.CreateTemplateVersionWithContext(context, createTemplateVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateTemplateVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTemplateVersionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateTemplateVersionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *templateVersionResponse.ID, *templateVersionResponse.Version))
@@ -418,7 +418,7 @@ func resourceIBMIAMAccessGroupTemplateVersionRead(context context.Context, d *sc
 			return nil
 		}
 		log.Printf("[DEBUG] GetTemplateVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTemplateVersionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetTemplateVersionWithContext failed", err, response).Diagnostics()
 	}
 
 	if !core.IsNil(templateVersionResponse.Name) {
@@ -514,7 +514,7 @@ func resourceIBMIAMAccessGroupTemplateVersionUpdate(context context.Context, d *
 			return nil
 		}
 		log.Printf("[DEBUG] GetTemplateVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTemplateVersionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetTemplateVersionWithContext failed", err, response).Diagnostics()
 	}
 
 	updateTemplateVersionOptions.SetIfMatch(response.Headers.Get("ETag"))
@@ -558,7 +558,7 @@ func resourceIBMIAMAccessGroupTemplateVersionUpdate(context context.Context, d *
 	_, response, err = iamAccessGroupsClient.UpdateTemplateVersionWithContext(context, updateTemplateVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] UpdateTemplateVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("UpdateTemplateVersionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("UpdateTemplateVersionWithContext failed", err, response).Diagnostics()
 	}
 
 	// Test committed
@@ -571,7 +571,7 @@ func resourceIBMIAMAccessGroupTemplateVersionUpdate(context context.Context, d *
 			response, err = iamAccessGroupsClient.CommitTemplateWithContext(context, commitTemplateOptions)
 			if err != nil {
 				log.Printf("[DEBUG] UpdateTemplateVersionWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("UpdateTemplateVersionWithContext failed %s\n%s", err, response))
+				return flex.NewServiceError("UpdateTemplateVersionWithContext failed", err, response).Diagnostics()
 			}
 		}
 	}
@@ -598,7 +598,7 @@ func resourceIBMIAMAccessGroupTemplateVersionDelete(context context.Context, d *
 	response, err := iamAccessGroupsClient.DeleteTemplateVersionWithContext(context, deleteTemplateVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteTemplateVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTemplateVersionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteTemplateVersionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
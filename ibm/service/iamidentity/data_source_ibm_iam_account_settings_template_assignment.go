@@ -303,7 +303,7 @@ func dataSourceIBMAccountSettingsTemplateAssignmentRead(context context.Context,
 	templateAssignmentResponse, response, err := iamIdentityClient.GetAccountSettingsAssignmentWithContext(context, getAccountSettingsAssignmentOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetAccountSettingsAssignmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAccountSettingsAssignmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetAccountSettingsAssignmentWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s", *getAccountSettingsAssignmentOptions.AssignmentID))
@@ -163,7 +163,7 @@ func dataSourceIBMIamTrustedProfileRead(context context.Context, d *schema.Resou
 	trustedProfile, response, err := iamIdentityClient.GetProfile(getProfileOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetProfile failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProfile failed %s\n%s", err, response))
+		return flex.NewServiceError("GetProfile failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*trustedProfile.ID)
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 )
 
@@ -85,7 +86,7 @@ func dataSourceIBMIamTrustedProfileIdentitiesRead(context context.Context, d *sc
 	profileIdentitiesResponse, response, err := iamIdentityClient.GetProfileIdentitiesWithContext(context, getProfileIdentitiesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetProfileIdentitiesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProfileIdentitiesWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetProfileIdentitiesWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(dataSourceIBMIamTrustedProfileIdentitiesID(d))
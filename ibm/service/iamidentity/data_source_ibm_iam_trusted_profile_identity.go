@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 )
 
@@ -83,7 +84,7 @@ func dataSourceIBMIamTrustedProfileIdentityRead(context context.Context, d *sche
 	profileIdentityResponse, response, err := iamIdentityClient.GetProfileIdentityWithContext(context, getProfileIdentityOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetProfileIdentityWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProfileIdentityWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetProfileIdentityWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(dataSourceIBMIamTrustedProfileIdentityID(d))
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -123,7 +124,7 @@ func dataSourceIBMIamTrustedProfileLinkListRead(context context.Context, d *sche
 	profileLinkList, response, err := iamIdentityClient.ListLinks(listLinkOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListLink failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListLink failed %s\n%s", err, response))
+		return flex.NewServiceError("ListLink failed", err, response).Diagnostics()
 	}
 
 	d.SetId(dataSourceIBMIamTrustedProfileLinkListID(d))
@@ -295,7 +295,7 @@ func dataSourceIBMTrustedProfileTemplateRead(context context.Context, d *schema.
 	trustedProfileTemplateResponse, response, err := iamIdentityClient.GetProfileTemplateVersionWithContext(context, getProfileTemplateVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetProfileTemplateVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProfileTemplateVersionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetProfileTemplateVersionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(buildResourceIdFromTemplateVersion(*trustedProfileTemplateResponse.ID, *trustedProfileTemplateResponse.Version))
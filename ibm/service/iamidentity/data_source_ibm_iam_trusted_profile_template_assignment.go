@@ -370,7 +370,7 @@ func dataSourceIBMTrustedProfileTemplateAssignmentRead(context context.Context,
 	templateAssignmentResponse, response, err := iamIdentityClient.GetTrustedProfileAssignmentWithContext(context, getTrustedProfileAssignmentOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetTrustedProfileAssignmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTrustedProfileAssignmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetTrustedProfileAssignmentWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s", *getTrustedProfileAssignmentOptions.AssignmentID))
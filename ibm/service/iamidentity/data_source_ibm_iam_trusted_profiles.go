@@ -5,7 +5,6 @@ package iamidentity
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"time"
 
@@ -205,7 +204,7 @@ func dataSourceIBMIamTrustedProfileListRead(context context.Context, d *schema.R
 		trustedProfiles, response, err := iamIdentityClient.ListProfiles(listProfileOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListProfile failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListProfile failed %s\n%s", err, response))
+			return flex.NewServiceError("ListProfile failed", err, response).Diagnostics()
 		}
 		start = flex.GetNextIAM(trustedProfiles.Next)
 		allrecs = append(allrecs, trustedProfiles.Profiles...)
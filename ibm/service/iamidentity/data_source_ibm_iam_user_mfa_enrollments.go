@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 )
 
@@ -150,7 +151,7 @@ func dataSourceIBMIamUserMfaEnrollmentsRead(context context.Context, d *schema.R
 	userMfaEnrollments, response, err := iamIdentityClient.GetMfaStatusWithContext(context, getMfaStatusOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetMfaStatusWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetMfaStatusWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetMfaStatusWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(dataSourceIBMIamUserMfaEnrollmentsID(d))
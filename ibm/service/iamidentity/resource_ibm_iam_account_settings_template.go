@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 )
@@ -247,7 +248,7 @@ func resourceIBMAccountSettingsTemplateCreate(context context.Context, d *schema
 	accountSettingsTemplateResponse, response, err := iamIdentityClient.CreateAccountSettingsTemplateWithContext(context, createAccountSettingsTemplateOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateAccountSettingsTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateAccountSettingsTemplateWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateAccountSettingsTemplateWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(buildResourceIdFromTemplateVersion(*accountSettingsTemplateResponse.ID, *accountSettingsTemplateResponse.Version))
@@ -300,7 +301,7 @@ func resourceIBMAccountSettingsTemplateCreateVersion(context context.Context, d
 	accountSettingsTemplateResponse, response, err := iamIdentityClient.CreateAccountSettingsTemplateVersionWithContext(context, createAccountSettingsTemplateVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateAccountSettingsTemplateVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateAccountSettingsTemplateVersionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateAccountSettingsTemplateVersionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(buildResourceIdFromTemplateVersion(*accountSettingsTemplateResponse.ID, *accountSettingsTemplateResponse.Version))
@@ -340,7 +341,7 @@ func resourceIBMAccountSettingsTemplateRead(context context.Context, d *schema.R
 			return nil
 		}
 		log.Printf("[DEBUG] GetAccountSettingsTemplateVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAccountSettingsTemplateVersionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetAccountSettingsTemplateVersionWithContext failed", err, response).Diagnostics()
 	}
 
 	if !core.IsNil(accountSettingsTemplateResponse.Version) {
@@ -463,7 +464,7 @@ func resourceIBMAccountSettingsTemplateUpdate(context context.Context, d *schema
 		_, response, err := iamIdentityClient.UpdateAccountSettingsTemplateVersionWithContext(context, updateAccountSettingsTemplateVersionOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateAccountSettingsTemplateVersionWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateAccountSettingsTemplateVersionWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateAccountSettingsTemplateVersionWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -502,7 +503,7 @@ func resourceIBMAccountSettingsTemplateDelete(context context.Context, d *schema
 	response, err := iamIdentityClient.DeleteAccountSettingsTemplateVersionWithContext(context, deleteAccountSettingsTemplateVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteAccountSettingsTemplateVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteAccountSettingsTemplateVersionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteAccountSettingsTemplateVersionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -312,7 +312,7 @@ func resourceIBMAccountSettingsTemplateAssignmentCreate(context context.Context,
 	templateAssignmentResponse, response, err := iamIdentityClient.CreateAccountSettingsAssignmentWithContext(context, createAccountSettingsAssignmentOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateAccountSettingsAssignmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateAccountSettingsAssignmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateAccountSettingsAssignmentWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*templateAssignmentResponse.ID)
@@ -342,7 +342,7 @@ func resourceIBMAccountSettingsTemplateAssignmentRead(context context.Context, d
 			return nil
 		}
 		log.Printf("[DEBUG] GetAccountSettingsAssignmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAccountSettingsAssignmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetAccountSettingsAssignmentWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("template_id", templateAssignmentResponse.TemplateID); err != nil {
@@ -443,7 +443,7 @@ func resourceIBMAccountSettingsTemplateAssignmentUpdate(context context.Context,
 		_, response, err := iamIdentityClient.UpdateAccountSettingsAssignmentWithContext(context, updateAccountSettingsAssignmentOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateAccountSettingsAssignmentWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateAccountSettingsAssignmentWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateAccountSettingsAssignmentWithContext failed", err, response).Diagnostics()
 		}
 
 		_, err = waitForAssignment(d.Timeout(schema.TimeoutUpdate), meta, d, isAccountSettingsTemplateAssigned)
@@ -468,7 +468,7 @@ func resourceIBMAccountSettingsTemplateAssignmentDelete(context context.Context,
 	_, response, err := iamIdentityClient.DeleteAccountSettingsAssignmentWithContext(context, deleteAccountSettingsAssignmentOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteAccountSettingsAssignmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteAccountSettingsAssignmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteAccountSettingsAssignmentWithContext failed", err, response).Diagnostics()
 	}
 
 	_, err = waitForAssignment(d.Timeout(schema.TimeoutDelete), meta, d, isAccountSettingsAssignmentRemoved)
@@ -618,7 +618,7 @@ func isAccountSettingsAssignmentRemoved(id string, meta interface{}) resource.St
 				return assignment, complete, nil
 			}
 
-			return nil, failed, fmt.Errorf("[ERROR] The assignment %s failed to delete or deletion was not completed within specific timeout period: %s\n%s", id, err, response)
+			return nil, failed, flex.NewServiceError(fmt.Sprintf("The assignment %s failed to delete or deletion was not completed within specific timeout period", id), err, response)
 		} else {
 			log.Printf("Assignment removal still in progress\n")
 		}
@@ -637,7 +637,7 @@ func isAccountSettingsTemplateAssigned(id string, meta interface{}) resource.Sta
 		}
 		assignment, response, err := iamIdentityClient.GetAccountSettingsAssignment(getOptions)
 		if err != nil {
-			return nil, failed, fmt.Errorf("[ERROR] The assignment %s failed or did not complete within specific timeout period: %s\n%s", id, err, response)
+			return nil, failed, flex.NewServiceError(fmt.Sprintf("The assignment %s failed or did not complete within specific timeout period", id), err, response)
 		}
 
 		if assignment != nil {
@@ -651,10 +651,10 @@ func isAccountSettingsTemplateAssigned(id string, meta interface{}) resource.Sta
 			}
 
 			if *assignment.Status == "failed" {
-				return assignment, failed, fmt.Errorf("[ERROR] The assignment %s did complete but with a 'failed' status. Please check assignment resource for detailed errors: %s\n", id, response)
+				return assignment, failed, flex.NewServiceError(fmt.Sprintf("The assignment %s did complete but with a 'failed' status. Please check assignment resource for detailed errors", id), nil, response)
 			}
 		}
 
-		return assignment, failed, fmt.Errorf("[ERROR] Unexpected status reached for assignment %s.: %s\n", id, response)
+		return assignment, failed, flex.NewServiceError(fmt.Sprintf("Unexpected status reached for assignment %s", id), nil, response)
 	}
 }
@@ -183,7 +183,7 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 
 	apiKey, response, err := iamIdentityClient.CreateAPIKey(createAPIKeyOptions)
 	if err != nil || apiKey == nil {
-		return fmt.Errorf("[DEBUG] Service API Key creation Error: %s\n%s", err, response)
+		return flex.NewServiceError("Service API Key creation Error", err, response)
 	}
 
 	d.SetId(*apiKey.ID)
@@ -215,7 +215,7 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response)
+		return flex.NewServiceError("Error retrieving Service API Key", err, response)
 	}
 	if apiKey.Name != nil {
 		d.Set("name", *apiKey.Name)
@@ -268,7 +268,7 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 
 	apiKey, resp, err := iamIdentityClient.GetAPIKey(getAPIKeyOptions)
 	if err != nil || apiKey == nil {
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, resp)
+		return flex.NewServiceError("Error retrieving Service API Key", err, resp)
 	}
 
 	updateAPIKeyOptions := &iamidentityv1.UpdateAPIKeyOptions{
@@ -292,7 +292,7 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 	if hasChange {
 		_, response, err := iamIdentityClient.UpdateAPIKey(updateAPIKeyOptions)
 		if err != nil {
-			return fmt.Errorf("[DEBUG] Error updating Service API Key: %s\n%s", err, response)
+			return flex.NewServiceError("Error updating Service API Key", err, response)
 		}
 	}
 
@@ -317,7 +317,7 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response)
+		return flex.NewServiceError("Error retrieving Service API Key", err, response)
 	}
 
 	deleteAPIKeyOptions := &iamidentityv1.DeleteAPIKeyOptions{
@@ -326,7 +326,7 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 
 	resp, err := iamIdentityClient.DeleteAPIKey(deleteAPIKeyOptions)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Error deleting Service API Key: %s\n%s", err, resp)
+		return flex.NewServiceError("Error deleting Service API Key", err, resp)
 	}
 	d.SetId("")
 
@@ -349,7 +349,7 @@ func resourceIBMIAMServiceAPIKeyExists(d *schema.ResourceData, meta interface{})
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error retrieving Service API Key: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error retrieving Service API Key", err, response)
 	}
 	return *apiKey.ID == apiKeyID, nil
 }
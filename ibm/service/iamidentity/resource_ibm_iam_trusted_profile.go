@@ -158,7 +158,7 @@ func resourceIBMIamTrustedProfileCreate(context context.Context, d *schema.Resou
 	trustedProfile, response, err := iamIdentityClient.CreateProfile(createProfileOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateProfileWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateProfileWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateProfileWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*trustedProfile.ID)
@@ -183,7 +183,7 @@ func resourceIBMIamTrustedProfileRead(context context.Context, d *schema.Resourc
 			return nil
 		}
 		log.Printf("[DEBUG] GetProfile failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProfile failed %s\n%s", err, response))
+		return flex.NewServiceError("GetProfile failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("name", trustedProfile.Name); err != nil {
@@ -311,7 +311,7 @@ func resourceIBMIamTrustedProfileUpdate(context context.Context, d *schema.Resou
 	_, response, err := iamIdentityClient.UpdateProfile(updateProfileOptions)
 	if err != nil {
 		log.Printf("[DEBUG] UpdateProfile failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("UpdateProfile failed %s\n%s", err, response))
+		return flex.NewServiceError("UpdateProfile failed", err, response).Diagnostics()
 	}
 
 	return resourceIBMIamTrustedProfileRead(context, d, meta)
@@ -330,7 +330,7 @@ func resourceIBMIamTrustedProfileDelete(context context.Context, d *schema.Resou
 	response, err := iamIdentityClient.DeleteProfile(deleteProfileOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteProfile failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteProfile failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteProfile failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
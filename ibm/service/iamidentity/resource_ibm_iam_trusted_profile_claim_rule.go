@@ -163,7 +163,7 @@ func resourceIBMIamTrustedProfileClaimRuleCreate(context context.Context, d *sch
 	profileClaimRule, response, err := iamIdentityClient.CreateClaimRule(createClaimRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateClaimRule failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateClaimRule failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateClaimRule failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", profile, *profileClaimRule.ID))
@@ -242,7 +242,7 @@ func resourceIBMIamTrustedProfileClaimRuleRead(context context.Context, d *schem
 			return nil
 		}
 		log.Printf("[DEBUG] GetClaimRule failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetClaimRule failed %s\n%s", err, response))
+		return flex.NewServiceError("GetClaimRule failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("profile_id", getClaimRuleOptions.ProfileID); err != nil {
@@ -376,7 +376,7 @@ func resourceIBMIamTrustedProfileClaimRuleUpdate(context context.Context, d *sch
 	_, response, err := iamIdentityClient.UpdateClaimRule(updateClaimRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] UpdateClaimRule failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("UpdateClaimRule failed %s\n%s", err, response))
+		return flex.NewServiceError("UpdateClaimRule failed", err, response).Diagnostics()
 	}
 
 	return resourceIBMIamTrustedProfileClaimRuleRead(context, d, meta)
@@ -400,7 +400,7 @@ func resourceIBMIamTrustedProfileClaimRuleDelete(context context.Context, d *sch
 	response, err := iamIdentityClient.DeleteClaimRule(deleteClaimRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteClaimRule failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteClaimRule failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteClaimRule failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -119,7 +119,7 @@ func resourceIBMIamTrustedProfileIdentityCreate(context context.Context, d *sche
 	profileIdentityResponse, response, err := iamIdentityClient.SetProfileIdentityWithContext(context, setProfileIdentityOptions)
 	if err != nil {
 		log.Printf("[DEBUG] SetProfileIdentityWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("SetProfileIdentityWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("SetProfileIdentityWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", *setProfileIdentityOptions.ProfileID, *setProfileIdentityOptions.IdentityType, *profileIdentityResponse.Identifier))
@@ -151,7 +151,7 @@ func resourceIBMIamTrustedProfileIdentityRead(context context.Context, d *schema
 			return nil
 		}
 		log.Printf("[DEBUG] GetProfileIdentityWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProfileIdentityWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetProfileIdentityWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("profile_id", getProfileIdentityOptions.ProfileID); err != nil {
@@ -200,7 +200,7 @@ func resourceIBMIamTrustedProfileIdentityDelete(context context.Context, d *sche
 	response, err := iamIdentityClient.DeleteProfileIdentityWithContext(context, deleteProfileIdentityOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteProfileIdentityWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteProfileIdentityWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteProfileIdentityWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -309,7 +309,7 @@ func resourceIBMTrustedProfileTemplateCreate(context context.Context, d *schema.
 	trustedProfileTemplateResponse, response, err := iamIdentityClient.CreateProfileTemplateWithContext(context, createProfileTemplateOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateProfileTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateProfileTemplateWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateProfileTemplateWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(buildResourceIdFromTemplateVersion(*trustedProfileTemplateResponse.ID, *trustedProfileTemplateResponse.Version))
@@ -374,7 +374,7 @@ func resourceIBMTrustedProfileTemplateCreateVersion(context context.Context, d *
 	trustedProfileTemplateVersionResponse, response, err := iamIdentityClient.CreateProfileTemplateVersionWithContext(context, createProfileTemplateVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateProfileTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateProfileTemplateWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateProfileTemplateWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(buildResourceIdFromTemplateVersion(*trustedProfileTemplateVersionResponse.ID, *trustedProfileTemplateVersionResponse.Version))
@@ -414,7 +414,7 @@ func resourceIBMTrustedProfileTemplateRead(context context.Context, d *schema.Re
 			return nil
 		}
 		log.Printf("[DEBUG] GetProfileTemplateVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProfileTemplateVersionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetProfileTemplateVersionWithContext failed", err, response).Diagnostics()
 	}
 
 	if !core.IsNil(trustedProfileTemplateResponse.Version) {
@@ -569,7 +569,7 @@ func resourceIBMTrustedProfileTemplateUpdate(context context.Context, d *schema.
 		_, response, err := iamIdentityClient.UpdateProfileTemplateVersionWithContext(context, updateProfileTemplateVersionOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateProfileTemplateVersionWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateProfileTemplateVersionWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateProfileTemplateVersionWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -608,7 +608,7 @@ func resourceIBMTrustedProfileTemplateDelete(context context.Context, d *schema.
 	response, err := iamIdentityClient.DeleteProfileTemplateVersionWithContext(context, deleteProfileTemplateVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteProfileTemplateVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteProfileTemplateVersionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteProfileTemplateVersionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -389,7 +389,7 @@ func resourceIBMTrustedProfileTemplateAssignmentCreate(context context.Context,
 	templateAssignmentResponse, response, err := iamIdentityClient.CreateTrustedProfileAssignmentWithContext(context, createTrustedProfileAssignmentOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateTrustedProfileAssignmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTrustedProfileAssignmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateTrustedProfileAssignmentWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*templateAssignmentResponse.ID)
@@ -419,7 +419,7 @@ func resourceIBMTrustedProfileTemplateAssignmentRead(context context.Context, d
 			return nil
 		}
 		log.Printf("[DEBUG] GetTrustedProfileAssignmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTrustedProfileAssignmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetTrustedProfileAssignmentWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("template_id", templateAssignmentResponse.TemplateID); err != nil {
@@ -520,7 +520,7 @@ func resourceIBMTrustedProfileTemplateAssignmentUpdate(context context.Context,
 		_, response, err := iamIdentityClient.UpdateTrustedProfileAssignmentWithContext(context, updateTrustedProfileAssignmentOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateTrustedProfileAssignmentWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateTrustedProfileAssignmentWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateTrustedProfileAssignmentWithContext failed", err, response).Diagnostics()
 		}
 
 		_, err = waitForAssignment(d.Timeout(schema.TimeoutUpdate), meta, d, isTrustedProfileTemplateAssigned)
@@ -544,7 +544,7 @@ func resourceIBMTrustedProfileTemplateAssignmentDelete(context context.Context,
 
 	_, response, err := iamIdentityClient.DeleteTrustedProfileAssignmentWithContext(context, deleteTrustedProfileAssignmentOptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[DEBUG] DeleteTrustedProfileAssignmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteTrustedProfileAssignmentWithContext failed", err, response).Diagnostics()
 	}
 
 	_, err = waitForAssignment(d.Timeout(schema.TimeoutDelete), meta, d, isTrustedProfileAssignmentRemoved)
@@ -583,7 +583,7 @@ func isTrustedProfileAssignmentRemoved(id string, meta interface{}) resource.Sta
 				return assignment, complete, nil
 			}
 
-			return nil, failed, fmt.Errorf("[ERROR] The assignment %s failed to delete or deletion was not completed within specific timeout period: %s\n%s", id, err, response)
+			return nil, failed, flex.NewServiceError(fmt.Sprintf("The assignment %s failed to delete or deletion was not completed within specific timeout period", id), err, response)
 		} else {
 			log.Printf("Assignment removal still in progress\n")
 		}
@@ -601,7 +601,7 @@ func isTrustedProfileTemplateAssigned(id string, meta interface{}) resource.Stat
 		}
 		assignment, response, err := iamIdentityClient.GetTrustedProfileAssignment(getOptions)
 		if err != nil {
-			return nil, failed, fmt.Errorf("[ERROR] The assignment %s failed or did not complete within specific timeout period: %s\n%s", id, err, response)
+			return nil, failed, flex.NewServiceError(fmt.Sprintf("The assignment %s failed or did not complete within specific timeout period", id), err, response)
 		}
 
 		if assignment != nil {
@@ -615,11 +615,11 @@ func isTrustedProfileTemplateAssigned(id string, meta interface{}) resource.Stat
 			}
 
 			if *assignment.Status == "failed" {
-				return assignment, failed, fmt.Errorf("[ERROR] The assignment %s did complete but with a 'failed' status. Please check assignment resource for detailed errors: %s\n", id, response)
+				return assignment, failed, flex.NewServiceError(fmt.Sprintf("The assignment %s did complete but with a 'failed' status. Please check assignment resource for detailed errors", id), nil, response)
 			}
 		}
 
-		return assignment, failed, fmt.Errorf("[ERROR] Unexpected status reached for assignment %s.: %s\n", id, response)
+		return assignment, failed, flex.NewServiceError(fmt.Sprintf("Unexpected status reached for assignment %s", id), nil, response)
 	}
 }
 
@@ -287,7 +287,7 @@ func dataSourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interfac
 	policyList, resp, err := iamPolicyManagementClient.ListV2Policies(listPoliciesOptions)
 
 	if err != nil || resp == nil {
-		return fmt.Errorf("Error listing access group policies: %s, %s", err, resp)
+		return flex.NewServiceError("Error listing access group policies", err, resp)
 	}
 
 	policies := policyList.Policies
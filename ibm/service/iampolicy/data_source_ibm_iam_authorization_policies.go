@@ -159,7 +159,7 @@ func dataSourceIBMIAMAuthorizationPoliciesRead(d *schema.ResourceData, meta inte
 	policyList, resp, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
 
 	if err != nil || resp == nil {
-		return fmt.Errorf("[ERROR] Error listing authorization policies: %s, %s", err, resp)
+		return flex.NewServiceError("Error listing authorization policies", err, resp)
 	}
 
 	policies := policyList.Policies
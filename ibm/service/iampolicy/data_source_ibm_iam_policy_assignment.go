@@ -250,7 +250,7 @@ func dataSourceIBMIAMPolicyAssignmentRead(context context.Context, d *schema.Res
 	policyAssignmentRecord, response, err := iamPolicyManagementClient.GetPolicyAssignmentWithContext(context, getPolicyAssignmentOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetPolicyAssignmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetPolicyAssignmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetPolicyAssignmentWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*policyAssignmentRecord.ID)
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 )
 
@@ -282,7 +283,7 @@ func dataSourceIBMIAMPolicyAssignmentsRead(context context.Context, d *schema.Re
 	policyTemplateAssignmentCollection, response, err := iamPolicyManagementClient.ListPolicyAssignmentsWithContext(context, listPolicyAssignmentsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListPolicyAssignmentsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListPolicyAssignmentsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ListPolicyAssignmentsWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(dataSourceIBMPolicyAssignmentID(d))
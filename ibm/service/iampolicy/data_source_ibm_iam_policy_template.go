@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 )
 
@@ -226,7 +227,7 @@ func dataSourceIBMPolicyTemplateRead(context context.Context, d *schema.Resource
 	policyTemplateCollection, response, err := iamPolicyManagementClient.ListPolicyTemplatesWithContext(context, listPolicyTemplatesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListPolicyTemplatesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListPolicyTemplatesWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ListPolicyTemplatesWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(accountID)
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 )
 
@@ -216,7 +217,7 @@ func dataSourceIBMIAMPolicyTemplateVersionRead(context context.Context, d *schem
 	policyTemplate, response, err := iamPolicyManagementClient.GetPolicyTemplateVersionWithContext(context, getPolicyTemplateVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetPolicyTemplateVersionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetPolicyTemplateVersionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetPolicyTemplateVersionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*policyTemplate.ID)
@@ -242,7 +242,7 @@ func dataSourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{})
 		}
 		serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
 		if err != nil || resp == nil {
-			return fmt.Errorf("[ERROR] Error] Error Getting Service Id %s %s", err, resp)
+			return flex.NewServiceError("Error] Error Getting Service Id", err, resp)
 		}
 		iamID = *serviceID.IamID
 	}
@@ -277,7 +277,7 @@ func dataSourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{})
 	policyList, resp, err := iamPolicyManagementClient.ListV2Policies(listPoliciesOptions)
 
 	if err != nil {
-		return fmt.Errorf("Error listing service policies: %s, %s", err, resp)
+		return flex.NewServiceError("Error listing service policies", err, resp)
 	}
 
 	policies := policyList.Policies
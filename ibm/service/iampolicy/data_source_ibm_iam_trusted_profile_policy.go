@@ -281,7 +281,7 @@ func dataSourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta inter
 		}
 		profile, resp, err := iamClient.GetProfile(&getprofileOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting profile ID %s %s", err, resp)
+			return flex.NewServiceError("Error getting profile ID", err, resp)
 		}
 		iamID = *profile.IamID
 	}
@@ -316,7 +316,7 @@ func dataSourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta inter
 	policyList, resp, err := iamPolicyManagementClient.ListV2Policies(listPoliciesOptions)
 
 	if err != nil || resp == nil {
-		return fmt.Errorf("Error listing trusted profile policies: %s, %s", err, resp)
+		return flex.NewServiceError("Error listing trusted profile policies", err, resp)
 	}
 
 	policies := policyList.Policies
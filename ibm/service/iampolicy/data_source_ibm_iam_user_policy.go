@@ -238,7 +238,7 @@ func dataSourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) er
 	policyList, resp, err := iamPolicyManagementClient.ListV2Policies(listPoliciesOptions)
 
 	if err != nil || resp == nil {
-		return fmt.Errorf("Error listing user policies: %s, %s", err, resp)
+		return flex.NewServiceError("Error listing user policies", err, resp)
 	}
 
 	policies := policyList.Policies
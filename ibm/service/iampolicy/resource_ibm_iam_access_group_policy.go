@@ -352,7 +352,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 		accessGroupPolicy, resp, err := iamPolicyManagementClient.CreateV2Policy(createPolicyOptions)
 
 		if err != nil {
-			return fmt.Errorf("Error creating user policies: %s, %s", err, resp)
+			return flex.NewServiceError("Error creating user policies", err, resp)
 		}
 
 		policyID = *accessGroupPolicy.ID
@@ -400,7 +400,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 
 		accessGroupPolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
 		if err != nil || accessGroupPolicy == nil {
-			return fmt.Errorf("[ERROR] Error creating access group policy: %s\n%s", err, res)
+			return flex.NewServiceError("Error creating access group policy", err, res)
 		}
 
 		policyID = *accessGroupPolicy.ID
@@ -478,7 +478,7 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 		accessGroupPolicy, res, err = iamPolicyManagementClient.GetV2Policy(getPolicyOptions)
 	}
 	if err != nil || accessGroupPolicy == nil || res == nil {
-		return fmt.Errorf("[ERROR] Error retrieving access group policy: %s\n%s", err, res)
+		return flex.NewServiceError("Error retrieving access group policy", err, res)
 	}
 
 	retrievedAttribute := flex.GetV2PolicySubjectAttribute("access_group_id", *accessGroupPolicy.Subject).(string)
@@ -563,7 +563,7 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error retrieving Policy: %s\n%s", err, response)
+			return flex.NewServiceError("Error retrieving Policy", err, response)
 		}
 
 		accessGroupPolicyETag := response.Headers.Get("ETag")
@@ -622,7 +622,7 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 
 			_, resp, err := iamPolicyManagementClient.ReplaceV2Policy(updatePolicyOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error updating user policy: %s, %s", err, resp)
+				return flex.NewServiceError("Error updating user policy", err, resp)
 			}
 		} else {
 
@@ -677,7 +677,7 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 
 			_, res, err := iamPolicyManagementClient.ReplacePolicy(updatePolicyOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error updating access group policy: %s\n%s", err, res)
+				return flex.NewServiceError("Error updating access group policy", err, res)
 			}
 		}
 	}
@@ -731,7 +731,7 @@ func resourceIBMIAMAccessGroupPolicyDelete(d *schema.ResourceData, meta interfac
 	}
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting access group policy: %s\n%s", err, res)
+		return flex.NewServiceError("Error deleting access group policy", err, res)
 	}
 
 	d.SetId("")
@@ -763,7 +763,7 @@ func resourceIBMIAMAccessGroupPolicyExists(d *schema.ResourceData, meta interfac
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting access group policy: %s\n%s", err, resp)
+		return false, flex.NewServiceError("Error getting access group policy", err, resp)
 	}
 
 	if accessGroupPolicy != nil && accessGroupPolicy.State != nil && *accessGroupPolicy.State == "deleted" {
@@ -794,7 +794,7 @@ func importAccessGroupPolicy(d *schema.ResourceData, meta interface{}) (interfac
 
 	accessGroupPolicy, res, err := iamPolicyManagementClient.GetV2Policy(getPolicyOptions)
 	if err != nil {
-		return nil, nil, fmt.Errorf("[ERROR] Error retrieving access group policy: %s\n%s", err, res)
+		return nil, nil, flex.NewServiceError("Error retrieving access group policy", err, res)
 	}
 
 	resources := flex.FlattenV2PolicyResource(*accessGroupPolicy.Resource)
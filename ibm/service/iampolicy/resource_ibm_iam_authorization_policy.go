@@ -438,7 +438,7 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 	roleList, resp, err := iampapClient.ListRoles(listRoleOptions)
 
 	if err != nil || roleList == nil {
-		return fmt.Errorf("[ERROR] Error in listing roles %s, %s", err, resp)
+		return flex.NewServiceError("Error in listing roles", err, resp)
 	}
 
 	policyRoles := flex.MapRoleListToPolicyRoles(*roleList)
@@ -474,7 +474,7 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 
 	authPolicy, resp, err := iampapClient.CreateV2Policy(createPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating authorization policy: %s %s", err, resp)
+		return flex.NewServiceError("Error creating authorization policy", err, resp)
 	}
 
 	d.SetId(*authPolicy.ID)
@@ -515,7 +515,7 @@ func resourceIBMIAMAuthorizationPolicyRead(d *schema.ResourceData, meta interfac
 		authorizationPolicy, resp, err = iampapClient.GetV2Policy(getPolicyOptions)
 	}
 	if err != nil || resp == nil {
-		return fmt.Errorf("[ERROR] Error retrieving authorizationPolicy: %s %s", err, resp)
+		return flex.NewServiceError("Error retrieving authorizationPolicy", err, resp)
 	}
 	roles, err := flex.GetRoleNamesFromPolicyResponse(*authorizationPolicy, d, meta)
 	if authorizationPolicy.Description != nil {
@@ -591,7 +591,7 @@ func resourceIBMIAMAuthorizationPolicyExists(d *schema.ResourceData, meta interf
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting authorisation policy: %s\n%s", err, resp)
+		return false, flex.NewServiceError("Error getting authorisation policy", err, resp)
 	}
 
 	if authorizationPolicy != nil && authorizationPolicy.State != nil && *authorizationPolicy.State == "deleted" {
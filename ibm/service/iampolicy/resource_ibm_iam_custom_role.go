@@ -4,7 +4,6 @@
 package iampolicy
 
 import (
-	"fmt"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -151,7 +150,7 @@ func resourceIBMIAMCustomRoleCreate(d *schema.ResourceData, meta interface{}) er
 
 	role, response, err := iamPolicyManagementClient.CreateRole(roleOptions)
 	if err != nil || role == nil {
-		return fmt.Errorf("[ERROR] Error creating Custom Roles: %s\n%s", err, response)
+		return flex.NewServiceError("Error creating Custom Roles", err, response)
 	}
 
 	d.SetId(*role.ID)
@@ -176,7 +175,7 @@ func resourceIBMIAMCustomRoleRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error retrieving Custom Roles: %s\n%s", err, response)
+		return flex.NewServiceError("Error retrieving Custom Roles", err, response)
 	}
 
 	d.Set(iamCRDisplayName, role.DisplayName)
@@ -221,7 +220,7 @@ func resourceIBMIAMCustomRoleUpdate(d *schema.ResourceData, meta interface{}) er
 				d.SetId("")
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error retrieving Custom Roles: %s\n%s", err, response)
+			return flex.NewServiceError("Error retrieving Custom Roles", err, response)
 		}
 
 		roleETag := response.Headers.Get("ETag")
@@ -235,7 +234,7 @@ func resourceIBMIAMCustomRoleUpdate(d *schema.ResourceData, meta interface{}) er
 
 		_, response, err = iamPolicyManagementClient.ReplaceRole(roleUpdateOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating Custom Roles: %s\n%s", err, response)
+			return flex.NewServiceError("Error updating Custom Roles", err, response)
 		}
 	}
 
@@ -255,7 +254,7 @@ func resourceIBMIAMCustomRoleDelete(d *schema.ResourceData, meta interface{}) er
 
 	response, err := iamPolicyManagementClient.DeleteRole(roleDeleteOptions)
 	if err != nil && !strings.Contains(err.Error(), "404") {
-		return fmt.Errorf("[ERROR] Error deleting Custom Roles: %s\n%s", err, response)
+		return flex.NewServiceError("Error deleting Custom Roles", err, response)
 	}
 
 	d.SetId("")
@@ -279,7 +278,7 @@ func resourceIBMIAMCustomRoleExists(d *schema.ResourceData, meta interface{}) (b
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error retrieving Custom Roles: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error retrieving Custom Roles", err, response)
 	}
 
 	return *role.ID == roleID, nil
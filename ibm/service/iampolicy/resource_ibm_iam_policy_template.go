@@ -269,7 +269,7 @@ func resourceIBMIAMPolicyTemplateCreate(context context.Context, d *schema.Resou
 	policyTemplate, response, err := iamPolicyManagementClient.CreatePolicyTemplateWithContext(context, createPolicyTemplateOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreatePolicyTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreatePolicyTemplateWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreatePolicyTemplateWithContext failed", err, response).Diagnostics()
 	}
 
 	version, _ := strconv.Atoi(*policyTemplate.Version)
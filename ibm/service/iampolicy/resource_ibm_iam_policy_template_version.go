@@ -253,7 +253,7 @@ func resourceIBMIAMPolicyTemplateVersionCreate(context context.Context, d *schem
 	policyTemplate, response, err := iamPolicyManagementClient.CreatePolicyTemplateVersion(createPolicyTemplateVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreatePolicyTemplateVersion failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreatePolicyTemplateVersion failed %s\n%s", err, response))
+		return flex.NewServiceError("CreatePolicyTemplateVersion failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createPolicyTemplateVersionOptions.PolicyTemplateID, *policyTemplate.Version))
@@ -284,7 +284,7 @@ func resourceIBMIAMPolicyTemplateVersionRead(context context.Context, d *schema.
 			return nil
 		}
 		log.Printf("[DEBUG] GetPolicyTemplateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetPolicyTemplateWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetPolicyTemplateWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("name", policyTemplate.Name); err != nil {
@@ -353,7 +353,7 @@ func resourceIBMIAMPolicyTemplateVersionUpdate(context context.Context, d *schem
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Policy Template: %s\n%s", err, response))
+			return flex.NewServiceError("Error retrieving Policy Template", err, response).Diagnostics()
 		}
 
 		replacePolicyTemplateOptions.SetPolicyTemplateID(parts[0])
@@ -381,7 +381,7 @@ func resourceIBMIAMPolicyTemplateVersionUpdate(context context.Context, d *schem
 		_, response, err = iamPolicyManagementClient.ReplacePolicyTemplateWithContext(context, replacePolicyTemplateOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ReplacePolicyTemplateWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplacePolicyTemplateWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ReplacePolicyTemplateWithContext failed", err, response).Diagnostics()
 		}
 	}
 	return resourceIBMIAMPolicyTemplateVersionRead(context, d, meta)
@@ -406,7 +406,7 @@ func resourceIBMIAMPolicyTemplateVersionDelete(context context.Context, d *schem
 	response, err := iamPolicyManagementClient.DeletePolicyTemplateVersionWithContext(context, deletePolicyTemplateVersionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeletePolicyTemplateVersion failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeletePolicyTemplateVersion failed %s\n%s", err, response))
+		return flex.NewServiceError("DeletePolicyTemplateVersion failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -439,7 +439,7 @@ func resourceIBMIAMPolicyTemplateVersionExists(d *schema.ResourceData, meta inte
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting policy template: %s\n%s", err, resp)
+		return false, flex.NewServiceError("Error getting policy template", err, resp)
 	}
 
 	tempID := fmt.Sprintf("%s/%s", *policyTemplate.ID, *policyTemplate.Version)
@@ -302,7 +302,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 		}
 		serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error] Error Getting Service Id %s %s", err, resp)
+			return flex.NewServiceError("Error] Error Getting Service Id", err, resp)
 		}
 		iamID = *serviceID.IamID
 	}
@@ -374,7 +374,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 		servicePolicy, resp, err := iamPolicyManagementClient.CreateV2Policy(createPolicyOptions)
 
 		if err != nil {
-			return fmt.Errorf("Error creating user policies: %s, %s", err, resp)
+			return flex.NewServiceError("Error creating user policies", err, resp)
 		}
 
 		policyID = *servicePolicy.ID
@@ -422,7 +422,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 
 		servicePolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating servicePolicy: %s %s", err, res)
+			return flex.NewServiceError("Error creating servicePolicy", err, res)
 		}
 
 		policyID = *servicePolicy.ID
@@ -513,7 +513,7 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 		servicePolicy, res, err = iamPolicyManagementClient.GetV2Policy(getPolicyOptions)
 	}
 	if err != nil || servicePolicy == nil || res == nil {
-		return fmt.Errorf("[ERROR] Error retrieving servicePolicy: %s %s", err, res)
+		return flex.NewServiceError("Error retrieving servicePolicy", err, res)
 	}
 	if strings.HasPrefix(serviceIDUUID, "iam-") {
 		d.Set("iam_id", serviceIDUUID)
@@ -590,7 +590,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 
 			serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error] Error Getting Service Id %s %s", err, resp)
+				return flex.NewServiceError("Error] Error Getting Service Id", err, resp)
 			}
 			iamID = *serviceID.IamID
 		}
@@ -621,7 +621,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error retrieving Policy: %s\n%s", err, response)
+			return flex.NewServiceError("Error retrieving Policy", err, response)
 		}
 
 		servicePolicyETag := response.Headers.Get("ETag")
@@ -680,7 +680,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 
 			_, resp, err := iamPolicyManagementClient.ReplaceV2Policy(updatePolicyOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error updating user policy: %s, %s", err, resp)
+				return flex.NewServiceError("Error updating user policy", err, resp)
 			}
 		} else {
 			_, ruleConditionsDefined := d.GetOk("rule_conditions")
@@ -818,7 +818,7 @@ func resourceIBMIAMServicePolicyExists(d *schema.ResourceData, meta interface{})
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting service policy: %s\n%s", err, resp)
+		return false, flex.NewServiceError("Error getting service policy", err, resp)
 	}
 
 	if servicePolicy != nil && servicePolicy.State != nil && *servicePolicy.State == "deleted" {
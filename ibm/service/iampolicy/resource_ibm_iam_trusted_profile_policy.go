@@ -302,7 +302,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 		}
 		profileID, resp, err := iamClient.GetProfile(getProfileOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error] Error getting trusted profile ID %s %s", err, resp)
+			return flex.NewServiceError("Error] Error getting trusted profile ID", err, resp)
 		}
 		iamID = *profileID.IamID
 	}
@@ -374,7 +374,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 		trustedProfilePolicy, resp, err := iamPolicyManagementClient.CreateV2Policy(createPolicyOptions)
 
 		if err != nil {
-			return fmt.Errorf("Error creating user policies: %s, %s", err, resp)
+			return flex.NewServiceError("Error creating user policies", err, resp)
 		}
 
 		policyID = *trustedProfilePolicy.ID
@@ -422,7 +422,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 
 		trustedProfilePolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating trustedProfilePolicy: %s %s", err, res)
+			return flex.NewServiceError("Error creating trustedProfilePolicy", err, res)
 		}
 		policyID = *trustedProfilePolicy.ID
 	}
@@ -511,7 +511,7 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 		trustedProfilePolicy, res, err = iamPolicyManagementClient.GetV2Policy(getPolicyOptions)
 	}
 	if err != nil || trustedProfilePolicy == nil || res == nil {
-		return fmt.Errorf("[ERROR] Error retrieving trusted profile policy: %s %s", err, res)
+		return flex.NewServiceError("Error retrieving trusted profile policy", err, res)
 	}
 	if strings.HasPrefix(profileIDUUID, "iam-") {
 		d.Set("iam_id", profileIDUUID)
@@ -582,7 +582,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 			}
 			profileID, resp, err := iamClient.GetProfile(&getProfileIDOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error] Error getting trusted profile ID %s %s", err, resp)
+				return flex.NewServiceError("Error] Error getting trusted profile ID", err, resp)
 			}
 			iamID = *profileID.IamID
 		}
@@ -613,7 +613,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error retrieving Policy: %s\n%s", err, response)
+			return flex.NewServiceError("Error retrieving Policy", err, response)
 		}
 
 		trustedProfilePolicyETag := response.Headers.Get("ETag")
@@ -672,7 +672,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 
 			_, resp, err := iamPolicyManagementClient.ReplaceV2Policy(updatePolicyOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error updating user policy: %s, %s", err, resp)
+				return flex.NewServiceError("Error updating user policy", err, resp)
 			}
 		} else {
 
@@ -726,7 +726,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 
 			_, resp, err := iamPolicyManagementClient.ReplacePolicy(updatePolicyOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error updating trusted profile policy: %s: %s", err, resp)
+				return flex.NewServiceError("Error updating trusted profile policy", err, resp)
 			}
 		}
 	}
@@ -811,7 +811,7 @@ func resourceIBMIAMTrustedProfilePolicyExists(d *schema.ResourceData, meta inter
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting trusted profile policy: %s\n%s", err, resp)
+		return false, flex.NewServiceError("Error getting trusted profile policy", err, resp)
 	}
 
 	if trustedProfilePolicy != nil && trustedProfilePolicy.State != nil && *trustedProfilePolicy.State == "deleted" {
@@ -839,7 +839,7 @@ func importTrustedProfilePolicy(d *schema.ResourceData, meta interface{}) (inter
 	)
 	trustedProfilePolicy, resp, err := iamPolicyManagementClient.GetV2Policy(getPolicyOptions)
 	if err != nil {
-		return nil, nil, fmt.Errorf("[ERROR] Error retrieving trusted profile policy: %s %s", err, resp)
+		return nil, nil, flex.NewServiceError("Error retrieving trusted profile policy", err, resp)
 	}
 	resources := flex.FlattenV2PolicyResource(*trustedProfilePolicy.Resource)
 	resource_attributes := flex.FlattenV2PolicyResourceAttributes(trustedProfilePolicy.Resource.Attributes)
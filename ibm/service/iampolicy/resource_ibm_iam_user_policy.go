@@ -339,7 +339,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 		userPolicy, resp, err := iamPolicyManagementClient.CreateV2Policy(createPolicyOptions)
 
 		if err != nil {
-			return fmt.Errorf("Error creating user policies: %s, %s", err, resp)
+			return flex.NewServiceError("Error creating user policies", err, resp)
 		}
 
 		policyID = userPolicy.ID
@@ -389,7 +389,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 
 		userPolicy, resp, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
 		if err != nil {
-			return fmt.Errorf("Error creating user policies: %s, %s", err, resp)
+			return flex.NewServiceError("Error creating user policies", err, resp)
 		}
 
 		policyID = userPolicy.ID
@@ -469,7 +469,7 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 		userPolicy, res, err = iamPolicyManagementClient.GetV2Policy(getPolicyOptions)
 	}
 	if err != nil || userPolicy == nil || res == nil {
-		return fmt.Errorf("[ERROR] Error retrieving userPolicy: %s %s", err, res)
+		return flex.NewServiceError("Error retrieving userPolicy", err, res)
 	}
 	d.Set("ibm_id", userEmail)
 
@@ -545,7 +545,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error retrieving Policy: %s\n%s", err, response)
+			return flex.NewServiceError("Error retrieving Policy", err, response)
 		}
 
 		userPolicyETag := response.Headers.Get("ETag")
@@ -609,7 +609,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 
 			_, resp, err := iamPolicyManagementClient.ReplaceV2Policy(updatePolicyOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error updating user policy: %s, %s", err, resp)
+				return flex.NewServiceError("Error updating user policy", err, resp)
 			}
 		} else {
 			_, ruleConditionsDefined := d.GetOk("rule_conditions")
@@ -661,7 +661,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 
 			_, resp, err := iamPolicyManagementClient.ReplacePolicy(updatePolicyOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error updating user policy: %s, %s", err, resp)
+				return flex.NewServiceError("Error updating user policy", err, resp)
 			}
 		}
 	}
@@ -744,7 +744,7 @@ func resourceIBMIAMUserPolicyExists(d *schema.ResourceData, meta interface{}) (b
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting user policy: %s\n%s", err, resp)
+		return false, flex.NewServiceError("Error getting user policy", err, resp)
 	}
 
 	if userPolicy != nil && userPolicy.State != nil && *userPolicy.State == "deleted" {
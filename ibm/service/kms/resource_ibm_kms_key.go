@@ -274,7 +274,7 @@ func populateKPClient(d *schema.ResourceData, meta interface{}, instanceID strin
 
 	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 	if err != nil || instanceData == nil {
-		return nil, nil, fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+		return nil, nil, flex.NewServiceError("Error retrieving resource instance", err, resp)
 	}
 	extensions := instanceData.Extensions
 	kpAPI.URL, err = KmsEndpointURL(kpAPI, endpointType, extensions)
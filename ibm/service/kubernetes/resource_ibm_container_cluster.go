@@ -74,7 +74,7 @@ func ResourceIBMContainerCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -399,6 +399,13 @@ func ResourceIBMContainerCluster() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the resource",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
 
 			"worker_pools": {
 				Type:     schema.TypeList,
@@ -898,7 +905,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 		log.Printf(
 			"An error occured during reading of instance (%s) tags : %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	flex.SetResourceTags(d, meta, "tags", "user", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -1156,7 +1163,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if flex.TagsHasChange(d, "tags") || v != "" {
 		oldList, newList := d.GetChange("tags")
 		cluster, err := clusterAPI.Find(clusterID, targetEnv)
 		if err != nil {
@@ -119,7 +119,7 @@ func resourceIbmContainerNlbDnsCreate(context context.Context, d *schema.Resourc
 	response, err := satClient.UpdateDNSWithIPWithContext(context, registerDNSWithIPOptions)
 	if err != nil {
 		log.Printf("[DEBUG] RegisterDNSWithIPWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("RegisterDNSWithIPWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("RegisterDNSWithIPWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*registerDNSWithIPOptions.IdOrName)
@@ -199,7 +199,7 @@ func resourceIbmContainerNlbDnsUpdate(context context.Context, d *schema.Resourc
 				response, err := satClient.UnregisterDNSWithIPWithContext(context, unregisterDNSWithIPOptions)
 				if err != nil {
 					log.Printf("[DEBUG] UnregisterDNSWithIPWithContext failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("UnregisterDNSWithIPWithContext failed %s\n%s", err, response))
+					return flex.NewServiceError("UnregisterDNSWithIPWithContext failed", err, response).Diagnostics()
 				}
 			}
 		}
@@ -214,7 +214,7 @@ func resourceIbmContainerNlbDnsUpdate(context context.Context, d *schema.Resourc
 			response, err := satClient.UpdateDNSWithIPWithContext(context, updateDNSWithIPOptions)
 			if err != nil {
 				log.Printf("[DEBUG] RegisterDNSWithIPWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("RegisterDNSWithIPWithContext failed %s\n%s", err, response))
+				return flex.NewServiceError("RegisterDNSWithIPWithContext failed", err, response).Diagnostics()
 			}
 		}
 	}
@@ -245,7 +245,7 @@ func resourceIbmContainerNlbDnsDelete(context context.Context, d *schema.Resourc
 			response, err := satClient.UnregisterDNSWithIPWithContext(context, unregisterDNSWithIPOptions)
 			if err != nil {
 				log.Printf("[DEBUG] UnregisterDNSWithIPWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("UnregisterDNSWithIPWithContext failed %s\n%s", err, response))
+				return flex.NewServiceError("UnregisterDNSWithIPWithContext failed", err, response).Diagnostics()
 			}
 		}
 	}
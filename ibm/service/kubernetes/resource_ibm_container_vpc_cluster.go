@@ -46,7 +46,7 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -271,6 +271,13 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for the resources",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
 
 			"wait_till": {
 				Type:             schema.TypeString,
@@ -689,7 +696,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 	clusterID := d.Id()
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if flex.TagsHasChange(d, "tags") || v != "" {
 		oldList, newList := d.GetChange("tags")
 		cluster, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 		if err != nil {
@@ -1007,7 +1014,7 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 		log.Printf(
 			"An error occured during reading of instance (%s) tags : %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	flex.SetResourceTags(d, meta, "tags", "user", tags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -1120,7 +1127,7 @@ func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) resource.StateRefreshFun
 			if response != nil && response.StatusCode == 404 {
 				return lb, "done", nil
 			}
-			return nil, "failed", fmt.Errorf("[ERROR] The vpc load balancer %s failed to delete: %s\n%s", id, err, response)
+			return nil, "failed", flex.NewServiceError(fmt.Sprintf("The vpc load balancer %s failed to delete", id), err, response)
 		}
 		return lb, "deleting", nil
 	}
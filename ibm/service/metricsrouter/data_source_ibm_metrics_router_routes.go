@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/metricsrouterv3"
 )
 
@@ -145,7 +146,7 @@ func dataSourceIBMMetricsRouterRoutesRead(context context.Context, d *schema.Res
 	routeCollection, response, err := metricsRouterClient.ListRoutesWithContext(context, listRoutesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListRoutesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListRoutesWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ListRoutesWithContext failed", err, response).Diagnostics()
 	}
 
 	// Use the provided filter argument and construct a new list with only the requested resource(s)
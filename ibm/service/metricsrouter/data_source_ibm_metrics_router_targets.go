@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/metricsrouterv3"
 )
 
@@ -90,7 +91,7 @@ func dataSourceIBMMetricsRouterTargetsRead(context context.Context, d *schema.Re
 	targetCollection, response, err := metricsRouterClient.ListTargetsWithContext(context, listTargetsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListTargetsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListTargetsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ListTargetsWithContext failed", err, response).Diagnostics()
 	}
 
 	// Use the provided filter argument and construct a new list with only the requested resource(s)
@@ -181,7 +181,7 @@ func resourceIBMMetricsRouterRouteCreate(context context.Context, d *schema.Reso
 	route, response, err := metricsRouterClient.CreateRouteWithContext(context, createRouteOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateRouteWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateRouteWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*route.ID)
@@ -206,7 +206,7 @@ func resourceIBMMetricsRouterRouteRead(context context.Context, d *schema.Resour
 			return nil
 		}
 		log.Printf("[DEBUG] GetRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetRouteWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetRouteWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("name", route.Name); err != nil {
@@ -269,7 +269,7 @@ func resourceIBMMetricsRouterRouteUpdate(context context.Context, d *schema.Reso
 		_, response, err := metricsRouterClient.UpdateRouteWithContext(context, updateRouteOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateRouteWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateRouteWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateRouteWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -289,7 +289,7 @@ func resourceIBMMetricsRouterRouteDelete(context context.Context, d *schema.Reso
 	response, err := metricsRouterClient.DeleteRouteWithContext(context, deleteRouteOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteRouteWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteRouteWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/metricsrouterv3"
@@ -142,7 +143,7 @@ func resourceIBMMetricsRouterSettingsCreate(context context.Context, d *schema.R
 	setting, response, err := metricsRouterClient.UpdateSettingsWithContext(context, updateSettingsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] UpdateSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("UpdateSettingsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("UpdateSettingsWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*setting.PrimaryMetadataRegion)
@@ -165,7 +166,7 @@ func resourceIBMMetricsRouterSettingsRead(context context.Context, d *schema.Res
 			return nil
 		}
 		log.Printf("[DEBUG] GetSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSettingsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSettingsWithContext failed", err, response).Diagnostics()
 	}
 
 	defaultTargets := []map[string]interface{}{}
@@ -258,7 +259,7 @@ func resourceIBMMetricsRouterSettingsUpdate(context context.Context, d *schema.R
 		_, response, err := metricsRouterClient.UpdateSettingsWithContext(context, updateSettingsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateSettingsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSettingsWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSettingsWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -275,7 +276,7 @@ func resourceIBMMetricsRouterSettingsDelete(context context.Context, d *schema.R
 	settings, response, err := metricsRouterClient.GetSettingsWithContext(context, &metricsrouterv3.GetSettingsOptions{})
 	if err != nil {
 		log.Printf("[DEBUG] UpdateSettingsWithContext with GetSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("with GetSettingsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("with GetSettingsWithContext failed", err, response).Diagnostics()
 	}
 
 	updateSettingsOptions := &metricsrouterv3.UpdateSettingsOptions{}
@@ -289,7 +290,7 @@ func resourceIBMMetricsRouterSettingsDelete(context context.Context, d *schema.R
 	_, res, err := metricsRouterClient.UpdateSettingsWithContext(context, updateSettingsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] UpdateSettingsWithContext failed %s\n%s", err, res)
-		return diag.FromErr(fmt.Errorf("UpdateSettingsWithContext failed %s\n%s", err, res))
+		return flex.NewServiceError("UpdateSettingsWithContext failed", err, res).Diagnostics()
 	}
 
 	d.SetId("")
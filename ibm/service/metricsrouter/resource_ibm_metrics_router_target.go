@@ -122,7 +122,7 @@ func resourceIBMMetricsRouterTargetCreate(context context.Context, d *schema.Res
 	target, response, err := metricsRouterClient.CreateTargetWithContext(context, createTargetOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateTargetWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTargetWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateTargetWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*target.ID)
@@ -147,7 +147,7 @@ func resourceIBMMetricsRouterTargetRead(context context.Context, d *schema.Resou
 			return nil
 		}
 		log.Printf("[DEBUG] GetTargetWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTargetWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetTargetWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("name", target.Name); err != nil {
@@ -202,7 +202,7 @@ func resourceIBMMetricsRouterTargetUpdate(context context.Context, d *schema.Res
 		_, response, err := metricsRouterClient.UpdateTargetWithContext(context, updateTargetOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateTargetWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateTargetWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateTargetWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -222,7 +222,7 @@ func resourceIBMMetricsRouterTargetDelete(context context.Context, d *schema.Res
 	response, err := metricsRouterClient.DeleteTargetWithContext(context, deleteTargetOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteTargetWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTargetWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteTargetWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
 )
 
@@ -91,7 +92,7 @@ func dataSourceIbmMqcloudApplicationRead(context context.Context, d *schema.Reso
 
 		result, response, err := mqcloudClient.ListApplicationsWithContext(context, listApplicationsOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Applications %s\n%s", err, response))
+			return flex.NewServiceError("Error Getting Applications", err, response).Diagnostics()
 		}
 		if result == nil {
 			return diag.FromErr(fmt.Errorf("List Applications returned nil"))
@@ -146,7 +146,7 @@ func dataSourceIbmMqcloudKeystoreCertificateRead(context context.Context, d *sch
 	keyStoreCertificateDetailsCollection, response, err := mqcloudClient.ListKeyStoreCertificatesWithContext(context, listKeyStoreCertificatesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListKeyStoreCertificatesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListKeyStoreCertificatesWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ListKeyStoreCertificatesWithContext failed", err, response).Diagnostics()
 	}
 
 	// Use the provided filter argument and construct a new list with only the requested resource(s)
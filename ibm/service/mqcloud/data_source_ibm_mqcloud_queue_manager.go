@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
 )
 
@@ -145,7 +146,7 @@ func dataSourceIbmMqcloudQueueManagerRead(context context.Context, d *schema.Res
 
 		result, response, err := mqcloudClient.ListQueueManagersWithContext(context, listQueueManagersOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Getting QueueManagers %s\n%s", err, response))
+			return flex.NewServiceError("Error Getting QueueManagers", err, response).Diagnostics()
 		}
 		if result == nil {
 			return diag.FromErr(fmt.Errorf("List QueueManagers returned nil"))
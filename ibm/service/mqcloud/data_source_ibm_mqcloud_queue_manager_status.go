@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
 )
 
@@ -59,7 +60,7 @@ func dataSourceIbmMqcloudQueueManagerStatusRead(context context.Context, d *sche
 	queueManagerStatus, response, err := mqcloudClient.GetQueueManagerStatusWithContext(context, getQueueManagerStatusOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetQueueManagerStatusWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetQueueManagerStatusWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetQueueManagerStatusWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(dataSourceIbmMqcloudQueueManagerStatusID(d))
@@ -133,7 +133,7 @@ func dataSourceIbmMqcloudTruststoreCertificateRead(context context.Context, d *s
 	trustStoreCertificateDetailsCollection, response, err := mqcloudClient.ListTrustStoreCertificatesWithContext(context, listTrustStoreCertificatesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListTrustStoreCertificatesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListTrustStoreCertificatesWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ListTrustStoreCertificatesWithContext failed", err, response).Diagnostics()
 	}
 
 	// Use the provided filter argument and construct a new list with only the requested resource(s)
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
 )
 
@@ -90,7 +91,7 @@ func dataSourceIbmMqcloudUserRead(context context.Context, d *schema.ResourceDat
 
 		result, response, err := mqcloudClient.ListUsersWithContext(context, listUsersOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Users %s\n%s", err, response))
+			return flex.NewServiceError("Error Getting Users", err, response).Diagnostics()
 		}
 		if result == nil {
 			return diag.FromErr(fmt.Errorf("List Users returned nil"))
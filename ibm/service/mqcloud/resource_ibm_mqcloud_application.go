@@ -103,7 +103,7 @@ func resourceIbmMqcloudApplicationCreate(context context.Context, d *schema.Reso
 	applicationCreated, response, err := mqcloudClient.CreateApplicationWithContext(context, createApplicationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateApplicationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateApplicationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateApplicationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createApplicationOptions.ServiceInstanceGuid, *applicationCreated.ID))
@@ -134,7 +134,7 @@ func resourceIbmMqcloudApplicationRead(context context.Context, d *schema.Resour
 			return nil
 		}
 		log.Printf("[DEBUG] GetApplicationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetApplicationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetApplicationWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("name", applicationDetails.Name); err != nil {
@@ -179,7 +179,7 @@ func resourceIbmMqcloudApplicationDelete(context context.Context, d *schema.Reso
 	response, err := mqcloudClient.DeleteApplicationWithContext(context, deleteApplicationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteApplicationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteApplicationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteApplicationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -184,7 +184,7 @@ func resourceIbmMqcloudKeystoreCertificateCreate(context context.Context, d *sch
 	keyStoreCertificateDetails, response, err := mqcloudClient.CreateKeyStorePemCertificateWithContext(context, createKeyStorePemCertificateOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateKeyStorePemCertificateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateKeyStorePemCertificateWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateKeyStorePemCertificateWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", *createKeyStorePemCertificateOptions.ServiceInstanceGuid, *createKeyStorePemCertificateOptions.QueueManagerID, *keyStoreCertificateDetails.ID))
@@ -216,7 +216,7 @@ func resourceIbmMqcloudKeystoreCertificateRead(context context.Context, d *schem
 			return nil
 		}
 		log.Printf("[DEBUG] GetKeyStoreCertificateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetKeyStoreCertificateWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetKeyStoreCertificateWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("service_instance_guid", parts[0]); err != nil {
@@ -295,7 +295,7 @@ func resourceIbmMqcloudKeystoreCertificateDelete(context context.Context, d *sch
 	response, err := mqcloudClient.DeleteKeyStoreCertificateWithContext(context, deleteKeyStoreCertificateOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteKeyStoreCertificateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteKeyStoreCertificateWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteKeyStoreCertificateWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -218,7 +218,7 @@ func resourceIbmMqcloudQueueManagerCreate(context context.Context, d *schema.Res
 	queueManagerTaskStatus, response, err := mqcloudClient.CreateQueueManagerWithContext(context, createQueueManagerOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateQueueManagerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateQueueManagerWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateQueueManagerWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createQueueManagerOptions.ServiceInstanceGuid, *queueManagerTaskStatus.QueueManagerID))
@@ -270,7 +270,7 @@ func resourceIbmMqcloudQueueManagerRead(context context.Context, d *schema.Resou
 			return nil
 		}
 		log.Printf("[DEBUG] GetQueueManagerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetQueueManagerWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetQueueManagerWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("service_instance_guid", parts[0]); err != nil {
@@ -365,7 +365,7 @@ func resourceIbmMqcloudQueueManagerUpdate(context context.Context, d *schema.Res
 		_, response, err := mqcloudClient.SetQueueManagerVersionWithContext(context, setQueueManagerVersionOptions)
 		if err != nil {
 			log.Printf("[DEBUG] SetQueueManagerVersionWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("SetQueueManagerVersionWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("SetQueueManagerVersionWithContext failed", err, response).Diagnostics()
 		}
 		if waitForQmStatus {
 			_, err = waitForQmStatusUpdate(context, d, meta)
@@ -402,7 +402,7 @@ func resourceIbmMqcloudQueueManagerDelete(context context.Context, d *schema.Res
 	_, response, err := mqcloudClient.DeleteQueueManagerWithContext(context, deleteQueueManagerOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteQueueManagerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteQueueManagerWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteQueueManagerWithContext failed", err, response).Diagnostics()
 	}
 	if waitForQmStatus {
 		_, err = waitForQueueManagerToDelete(context, d, meta)
@@ -174,7 +174,7 @@ func resourceIbmMqcloudTruststoreCertificateCreate(context context.Context, d *s
 	trustStoreCertificateDetails, response, err := mqcloudClient.CreateTrustStorePemCertificateWithContext(context, createTrustStorePemCertificateOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateTrustStorePemCertificateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTrustStorePemCertificateWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateTrustStorePemCertificateWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", *createTrustStorePemCertificateOptions.ServiceInstanceGuid, *createTrustStorePemCertificateOptions.QueueManagerID, *trustStoreCertificateDetails.ID))
@@ -206,7 +206,7 @@ func resourceIbmMqcloudTruststoreCertificateRead(context context.Context, d *sch
 			return nil
 		}
 		log.Printf("[DEBUG] GetTrustStoreCertificateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTrustStoreCertificateWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetTrustStoreCertificateWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("service_instance_guid", parts[0]); err != nil {
@@ -278,7 +278,7 @@ func resourceIbmMqcloudTruststoreCertificateDelete(context context.Context, d *s
 	response, err := mqcloudClient.DeleteTrustStoreCertificateWithContext(context, deleteTrustStoreCertificateOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteTrustStoreCertificateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTrustStoreCertificateWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteTrustStoreCertificateWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -113,7 +113,7 @@ func resourceIbmMqcloudUserCreate(context context.Context, d *schema.ResourceDat
 	userDetails, response, err := mqcloudClient.CreateUserWithContext(context, createUserOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateUserWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateUserWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateUserWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createUserOptions.ServiceInstanceGuid, *userDetails.ID))
@@ -144,7 +144,7 @@ func resourceIbmMqcloudUserRead(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetUserWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetUserWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetUserWithContext failed", err, response).Diagnostics()
 	}
 	if err = d.Set("service_instance_guid", parts[0]); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting service_instance_guid: %s", err))
@@ -187,7 +187,7 @@ func resourceIbmMqcloudUserDelete(context context.Context, d *schema.ResourceDat
 	response, err := mqcloudClient.DeleteUserWithContext(context, deleteUserOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteUserWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteUserWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteUserWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -134,7 +134,7 @@ func checkSIPlan(d *schema.ResourceData, meta interface{}) error {
 	}
 	instance, response, err := rsConClient.GetResourceInstance(&rsInst)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Failed to retrieve Resource Instance: %s, Response: %s", err, response)
+		return flex.NewServiceError("Failed to retrieve Resource Instance", err, response)
 	}
 
 	// Creating a Resource Catalog Client
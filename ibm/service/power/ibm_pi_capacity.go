@@ -0,0 +1,222 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// piInstanceCapacityCustomizeDiff fails the plan of a new instance, or of an
+// instance with more processors or memory, when the system pool of
+// pi_sys_type or the storage of the workspace lacks the capacity for it.
+// Values that are not known at plan time are not checked, and the check is
+// skipped when the capacity cannot be retrieved. The increase of a resized
+// instance is checked as a new placement on any host of the pool, not on the
+// host the instance runs on.
+func piInstanceCapacityCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	cloudInstanceID := diff.Get(helpers.PICloudInstanceId).(string)
+	if diff.Get(Arg_SkipCapacityCheck).(bool) || cloudInstanceID == "" {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChanges(helpers.PIInstanceProcessors, helpers.PIInstanceMemory) {
+		return nil
+	}
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+
+	count := diff.Get(helpers.PIInstanceReplicants).(int)
+	if diff.Id() != "" {
+		count = len(diff.Get(Attr_Instances).([]interface{}))
+	}
+	procs := piCapacityIncrease(diff, helpers.PIInstanceProcessors)
+	mem := piCapacityIncrease(diff, helpers.PIInstanceMemory)
+	_, sap := diff.GetOk(PISAPInstanceProfileID)
+	_, spp := diff.GetOk(Arg_PIInstanceSharedProcessorPool)
+	if !sap && !spp && (procs > 0 || mem > 0) && count > 0 {
+		client := st.NewIBMPISystemPoolClient(ctx, sess, cloudInstanceID)
+		pools, err := client.GetSystemPools()
+		if err != nil {
+			log.Printf("[WARN] failed to get the system pools, their capacity is not checked: %v", err)
+		} else {
+			err = piSystemPoolCapacityCheck(pools, diff.Get(helpers.PIInstanceSystemType).(string), procs, mem, count)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	imageID := diff.Get(helpers.PIInstanceImageId).(string)
+	if diff.Id() != "" || imageID == "" {
+		return nil
+	}
+	imageClient := st.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+	image, err := imageClient.GetStockImage(imageID)
+	if err != nil {
+		image, err = imageClient.Get(imageID)
+		if err != nil {
+			log.Printf("[DEBUG] image %s is not found, its size is not checked: %v", imageID, err)
+			return nil
+		}
+	}
+	if image.Size == nil {
+		return nil
+	}
+	client := st.NewIBMPIStorageCapacityClient(ctx, sess, cloudInstanceID)
+	return piStorageCapacityCheck(client, diff.Get(PIInstanceStoragePool).(string), diff.Get(helpers.PIInstanceStorageType).(string), *image.Size)
+}
+
+// piVolumeCapacityCustomizeDiff fails the plan of a new or a grown volume
+// when its storage pool, its storage type or the workspace lacks the capacity
+// for it. Values that are not known at plan time are not checked.
+func piVolumeCapacityCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	cloudInstanceID := diff.Get(helpers.PICloudInstanceId).(string)
+	if diff.Get(Arg_SkipCapacityCheck).(bool) || cloudInstanceID == "" {
+		return nil
+	}
+	size := piCapacityIncrease(diff, helpers.PIVolumeSize)
+	if size <= 0 {
+		return nil
+	}
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+
+	client := st.NewIBMPIStorageCapacityClient(ctx, sess, cloudInstanceID)
+	return piStorageCapacityCheck(client, diff.Get(helpers.PIVolumePool).(string), diff.Get(helpers.PIVolumeType).(string), size)
+}
+
+// piCapacityIncrease returns the value of key for a new resource, or its
+// increase for an existing one
+func piCapacityIncrease(diff *schema.ResourceDiff, key string) float64 {
+	o, n := diff.GetChange(key)
+	if diff.Id() == "" {
+		return n.(float64)
+	}
+	return math.Max(n.(float64)-o.(float64), 0)
+}
+
+// piSystemPoolCapacityCheck returns an error if count instances of procs
+// processors and mem GB of memory cannot be placed on the hosts of the system
+// pool of sysType, or of any system pool if sysType is empty
+func piSystemPoolCapacityCheck(pools models.SystemPools, sysType string, procs, mem float64, count int) error {
+	if sysType != "" {
+		pool, ok := pools[sysType]
+		if !ok {
+			log.Printf("[DEBUG] system pool %s is not found, its capacity is not checked", sysType)
+			return nil
+		}
+		if piSystemPoolFits(pool, procs, mem, count) {
+			return nil
+		}
+		maxCores, maxMemory := piSystemPoolMaxAvailable(pool)
+		return fmt.Errorf("system pool %s lacks the capacity for %d instance(s) of %v processors and %v GB of memory, "+
+			"a host has at most %v processors and %d GB of memory available; set %s to skip this check", sysType, count, procs, mem, maxCores, maxMemory, Arg_SkipCapacityCheck)
+	}
+	for _, pool := range pools {
+		if piSystemPoolFits(pool, procs, mem, count) {
+			return nil
+		}
+	}
+	return fmt.Errorf("no system pool has the capacity for %d instance(s) of %v processors and %v GB of memory; set %s to skip this check", count, procs, mem, Arg_SkipCapacityCheck)
+}
+
+// piSystemPoolFits places count instances one after another on the first
+// host of the pool with enough processors and memory available
+func piSystemPoolFits(pool models.SystemPool, procs, mem float64, count int) bool {
+	systems := pool.Systems
+	if len(systems) == 0 && pool.MaxAvailable != nil {
+		systems = []*models.System{pool.MaxAvailable}
+	}
+	cores := make([]float64, 0, len(systems))
+	memory := make([]float64, 0, len(systems))
+	for _, s := range systems {
+		if s == nil || s.Cores == nil || s.Memory == nil {
+			continue
+		}
+		cores = append(cores, *s.Cores)
+		memory = append(memory, float64(*s.Memory))
+	}
+
+	for placed := 0; placed < count; placed++ {
+		host := -1
+		for i := range cores {
+			if cores[i] >= procs && memory[i] >= mem {
+				host = i
+				break
+			}
+		}
+		if host < 0 {
+			return false
+		}
+		cores[host] -= procs
+		memory[host] -= mem
+	}
+	return true
+}
+
+func piSystemPoolMaxAvailable(pool models.SystemPool) (float64, int64) {
+	var maxCores float64
+	var maxMemory int64
+	if pool.MaxCoresAvailable != nil && pool.MaxCoresAvailable.Cores != nil {
+		maxCores = *pool.MaxCoresAvailable.Cores
+	}
+	if pool.MaxMemoryAvailable != nil && pool.MaxMemoryAvailable.Memory != nil {
+		maxMemory = *pool.MaxMemoryAvailable.Memory
+	}
+	return maxCores, maxMemory
+}
+
+// piStorageCapacityCheck returns an error if size GB exceeds the maximum
+// allocation size of storagePool, of storageType or of the workspace. The
+// check is skipped when the capacity cannot be retrieved.
+func piStorageCapacityCheck(client *st.IBMPIStorageCapacityClient, storagePool, storageType string, size float64) error {
+	var maxAllocation *models.MaximumStorageAllocation
+	var where string
+	switch {
+	case storagePool != "":
+		sp, err := client.GetStoragePoolCapacity(storagePool)
+		if err != nil {
+			log.Printf("[WARN] failed to get the capacity of storage pool %s, it is not checked: %v", storagePool, err)
+			return nil
+		}
+		maxAllocation = &models.MaximumStorageAllocation{MaxAllocationSize: sp.MaxAllocationSize}
+		where = fmt.Sprintf("storage pool %s", storagePool)
+	case storageType != "":
+		stc, err := client.GetStorageTypeCapacity(storageType)
+		if err != nil {
+			log.Printf("[WARN] failed to get the capacity of storage type %s, it is not checked: %v", storageType, err)
+			return nil
+		}
+		maxAllocation = stc.MaximumStorageAllocation
+		where = fmt.Sprintf("storage type %s", storageType)
+	default:
+		spc, err := client.GetAllStoragePoolsCapacity()
+		if err != nil {
+			log.Printf("[WARN] failed to get the storage capacity of the workspace, it is not checked: %v", err)
+			return nil
+		}
+		maxAllocation = spc.MaximumStorageAllocation
+		where = "the workspace"
+	}
+
+	if maxAllocation == nil || maxAllocation.MaxAllocationSize == nil {
+		return nil
+	}
+	if math.Ceil(size) > float64(*maxAllocation.MaxAllocationSize) {
+		return fmt.Errorf("%v GB of storage exceeds the maximum allocation size of %d GB in %s; set %s to skip this check", size, *maxAllocation.MaxAllocationSize, where, Arg_SkipCapacityCheck)
+	}
+	return nil
+}
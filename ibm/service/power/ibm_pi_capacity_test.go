@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+)
+
+func piTestSystem(cores float64, memory int64) *models.System {
+	return &models.System{Cores: &cores, Memory: &memory}
+}
+
+func TestPISystemPoolFits(t *testing.T) {
+	testCases := []struct {
+		name  string
+		pool  models.SystemPool
+		procs float64
+		mem   float64
+		count int
+		want  bool
+	}{
+		{name: "single instance", pool: models.SystemPool{Systems: []*models.System{piTestSystem(4, 32)}}, procs: 4, mem: 32, count: 1, want: true},
+		{name: "too many processors", pool: models.SystemPool{Systems: []*models.System{piTestSystem(4, 32)}}, procs: 4.25, mem: 32, count: 1},
+		{name: "too much memory", pool: models.SystemPool{Systems: []*models.System{piTestSystem(4, 32)}}, procs: 1, mem: 33, count: 1},
+		{name: "replicants on one host", pool: models.SystemPool{Systems: []*models.System{piTestSystem(4, 32)}}, procs: 2, mem: 16, count: 2, want: true},
+		{name: "replicants beyond one host", pool: models.SystemPool{Systems: []*models.System{piTestSystem(4, 32)}}, procs: 2, mem: 16, count: 3},
+		{name: "replicants limited by memory", pool: models.SystemPool{Systems: []*models.System{piTestSystem(8, 8)}}, procs: 1, mem: 4, count: 3},
+		{
+			name:  "replicants across hosts",
+			pool:  models.SystemPool{Systems: []*models.System{piTestSystem(2, 8), piTestSystem(4, 16)}},
+			procs: 2, mem: 8, count: 3, want: true,
+		},
+		{
+			name:  "replicants beyond the hosts",
+			pool:  models.SystemPool{Systems: []*models.System{piTestSystem(2, 8), piTestSystem(4, 16)}},
+			procs: 2, mem: 8, count: 4,
+		},
+		{
+			name:  "no instance split across hosts",
+			pool:  models.SystemPool{Systems: []*models.System{piTestSystem(2, 16), piTestSystem(2, 16)}},
+			procs: 3, mem: 8, count: 1,
+		},
+		{
+			name:  "host without capacity ignored",
+			pool:  models.SystemPool{Systems: []*models.System{{}, piTestSystem(4, 32)}},
+			procs: 4, mem: 32, count: 1, want: true,
+		},
+		{name: "max available without systems", pool: models.SystemPool{MaxAvailable: piTestSystem(4, 32)}, procs: 2, mem: 16, count: 2, want: true},
+		{name: "max available exceeded", pool: models.SystemPool{MaxAvailable: piTestSystem(4, 32)}, procs: 3, mem: 16, count: 2},
+		{name: "no capacity", pool: models.SystemPool{}, procs: 1, mem: 2, count: 1},
+	}
+
+	for _, tc := range testCases {
+		if got := piSystemPoolFits(tc.pool, tc.procs, tc.mem, tc.count); got != tc.want {
+			t.Errorf("%s: piSystemPoolFits() = %t, want %t", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestPISystemPoolCapacityCheck(t *testing.T) {
+	pools := models.SystemPools{
+		"s922": models.SystemPool{
+			Systems:            []*models.System{piTestSystem(2, 16), piTestSystem(4, 64)},
+			MaxCoresAvailable:  piTestSystem(4, 64),
+			MaxMemoryAvailable: piTestSystem(4, 64),
+		},
+		"e980": models.SystemPool{
+			Systems: []*models.System{piTestSystem(16, 512)},
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		sysType string
+		procs   float64
+		mem     float64
+		count   int
+		err     string
+	}{
+		{name: "system type fits", sysType: "s922", procs: 2, mem: 16, count: 3},
+		{name: "system type lacks capacity", sysType: "s922", procs: 2, mem: 16, count: 4, err: "system pool s922 lacks the capacity for 4 instance(s) of 2 processors and 16 GB of memory, a host has at most 4 processors and 64 GB"},
+		{name: "unknown system type", sysType: "s1022", procs: 64, mem: 1024, count: 1},
+		{name: "any system pool fits", procs: 8, mem: 256, count: 2},
+		{name: "no system pool fits", procs: 8, mem: 256, count: 3, err: "no system pool has the capacity for 3 instance(s)"},
+	}
+
+	for _, tc := range testCases {
+		err := piSystemPoolCapacityCheck(pools, tc.sysType, tc.procs, tc.mem, tc.count)
+		if tc.err == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %s", tc.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.err) || !strings.Contains(err.Error(), Arg_SkipCapacityCheck) {
+			t.Errorf("%s: error = %v, want %q", tc.name, err, tc.err)
+		}
+	}
+}
+
+func TestPIStorageCapacityCheck(t *testing.T) {
+	maxAllocation := func(size int64) *models.MaximumStorageAllocation {
+		return &models.MaximumStorageAllocation{MaxAllocationSize: &size}
+	}
+	poolSize := int64(100)
+	capacities := map[string]interface{}{
+		"/storage-pools/Tier1-Flash-1": &models.StoragePoolCapacity{MaxAllocationSize: &poolSize},
+		"/storage-types/tier3":         &models.StorageTypeCapacity{MaximumStorageAllocation: maxAllocation(500)},
+		"/storage-types/tier5k":        &models.StorageTypeCapacity{},
+		"/storage-pools":               &models.StoragePoolsCapacity{MaximumStorageAllocation: maxAllocation(2000)},
+	}
+	sess := piTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		capacity, ok := capacities[strings.TrimPrefix(r.URL.Path, "/pcloud/v1/cloud-instances/cloud-instance/storage-capacity")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(capacity)
+	})
+	client := st.NewIBMPIStorageCapacityClient(context.Background(), sess, "cloud-instance")
+
+	testCases := []struct {
+		name        string
+		storagePool string
+		storageType string
+		size        float64
+		err         string
+	}{
+		{name: "storage pool fits", storagePool: "Tier1-Flash-1", storageType: "tier3", size: 100},
+		{name: "storage pool exceeded", storagePool: "Tier1-Flash-1", storageType: "tier3", size: 100.5, err: "exceeds the maximum allocation size of 100 GB in storage pool Tier1-Flash-1"},
+		{name: "storage type fits", storageType: "tier3", size: 500},
+		{name: "storage type exceeded", storageType: "tier3", size: 501, err: "exceeds the maximum allocation size of 500 GB in storage type tier3"},
+		{name: "storage type without maximum", storageType: "tier5k", size: 10000},
+		{name: "workspace fits", size: 2000},
+		{name: "workspace exceeded", size: 2001, err: "exceeds the maximum allocation size of 2000 GB in the workspace"},
+		{name: "unknown storage pool", storagePool: "Tier3-Flash-9", size: 10000},
+	}
+
+	for _, tc := range testCases {
+		err := piStorageCapacityCheck(client, tc.storagePool, tc.storageType, tc.size)
+		if tc.err == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %s", tc.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%s: error = %v, want %q", tc.name, err, tc.err)
+		}
+	}
+}
@@ -22,6 +22,7 @@ const (
 	Arg_SharedProcessorPoolName             = "pi_shared_processor_pool_name"
 	Arg_SharedProcessorPoolPlacementGroupID = "pi_shared_processor_pool_placement_group_id"
 	Arg_SharedProcessorPoolReservedCores    = "pi_shared_processor_pool_reserved_cores"
+	Arg_SkipCapacityCheck                   = "pi_skip_capacity_check"
 	Arg_StoragePool                         = "pi_storage_pool"
 	Arg_StorageType                         = "pi_storage_type"
 	Arg_VTL                                 = "vtl"
@@ -344,6 +345,79 @@ const (
 	// Disaster Recovery Location
 	PIDRLocation = "location"
 
+	// DR Failover
+	Arg_DRFailbackOnDestroy       = "pi_failback_on_destroy"
+	Arg_DRInstances               = "pi_instances"
+	Arg_DRRollbackOnFailure       = "pi_rollback_on_failure"
+	Arg_DRSourceInstanceID        = "pi_source_instance_id"
+	Arg_DRTargetCloudInstanceID   = "pi_target_cloud_instance_id"
+	Arg_DRTargetZone              = "pi_target_zone"
+	Attr_DRCompletedSteps         = "completed_steps"
+	Attr_DRInstances              = "instances"
+	Attr_DROnboardedVolumes       = "onboarded_volumes"
+	Attr_DRSourceInstanceID       = "source_instance_id"
+	Attr_DRSourceVolumeID         = "source_volume_id"
+	Attr_DRStoppedSourceInstances = "stopped_source_instances"
+	Attr_DRTargetInstanceID       = "instance_id"
+	Attr_DRTargetVolumeID         = "volume_id"
+	Attr_DRTargetVolumeIDs        = "volume_ids"
+
+	// DR Failover steps and status
+	DRStepStopSourceInstances = "stop_source_instances"
+	DRStepReverseReplication  = "reverse_replication"
+	DRStepOnboardVolumes      = "onboard_volumes"
+	DRStepDeployInstances     = "deploy_instances"
+	DRStatusFailedOver        = "failed-over"
+	DRStatusFailed            = "failed"
+
+	// DR Failover remote copy relationship states
+	DRCopyConsistentCopying      = "consistent_copying"
+	DRCopyConsistentSynchronized = "consistent_synchronized"
+	DRCopyInconsistentCopying    = "inconsistent_copying"
+
+	// Capture Policy
+	Arg_CapturePolicyNamePrefix        = "pi_capture_name_prefix"
+	Arg_CapturePolicyRetention         = "pi_retention"
+	Arg_CapturePolicySchedule          = "pi_schedule"
+	Attr_CapturePolicyCaptures         = "captures"
+	Attr_CapturePolicyCaptureTime      = "capture_time"
+	Attr_CapturePolicyDestination      = "destination"
+	Attr_CapturePolicyLastRunMessage   = "last_run_message"
+	Attr_CapturePolicyLastRunStatus    = "last_run_status"
+	Attr_CapturePolicyLastRunTime      = "last_run_time"
+	Attr_CapturePolicyNextRunTime      = "next_run_time"
+	Attr_CapturePolicyStorageImagePath = "storage_image_path"
+	Attr_CapturePolicyStorageRegion    = "storage_region"
+	CapturePolicyStatusCompleted       = "completed"
+	CapturePolicyStatusFailed          = "failed"
+
+	// Instance Migration
+	Arg_MigrationCleanupCOSObjects       = "pi_cleanup_cos_objects"
+	Arg_MigrationDeleteInstanceOnDestroy = "pi_delete_instance_on_destroy"
+	Arg_MigrationKeepIPAddresses         = "pi_keep_ip_addresses"
+	Arg_MigrationNetworkMapping          = "pi_network_mapping"
+	Arg_MigrationSourceInstanceID        = "pi_source_instance_id"
+	Arg_MigrationSourceNetworkID         = "pi_source_network_id"
+	Arg_MigrationTargetCloudInstanceID   = "pi_target_cloud_instance_id"
+	Arg_MigrationTargetNetworkID         = "pi_target_network_id"
+	Arg_MigrationTargetZone              = "pi_target_zone"
+	Attr_MigrationCaptureName            = "capture_name"
+	Attr_MigrationCompletedSteps         = "completed_steps"
+	Attr_MigrationCOSObject              = "cos_object"
+	Attr_MigrationCurrentStep            = "current_step"
+	Attr_MigrationInstanceID             = "instance_id"
+	Attr_MigrationInstanceStatus         = "instance_status"
+	Attr_MigrationSourceInstanceName     = "source_instance_name"
+
+	// Instance Migration steps and status
+	MigrationStepCapture    = "capture"
+	MigrationStepImport     = "import"
+	MigrationStepDeploy     = "deploy"
+	MigrationStepCleanup    = "cleanup"
+	MigrationStatusRunning  = "in-progress"
+	MigrationStatusComplete = "completed"
+	MigrationStatusFailed   = "failed"
+
 	// VPN
 	PIVPNConnectionId                         = "connection_id"
 	PIVPNConnectionStatus                     = "connection_status"
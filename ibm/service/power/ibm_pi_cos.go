@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+// piCOSClient returns a Cloud Object Storage client of region authenticated
+// with the HMAC keys used by captures and image imports
+func piCOSClient(region, accessKey, secretKey string) (*s3.S3, error) {
+	sess, err := session.NewSession()
+	if err != nil {
+		return nil, err
+	}
+	conf := aws.NewConfig().
+		WithEndpoint(fmt.Sprintf("s3.%s.cloud-object-storage.appdomain.cloud", region)).
+		WithCredentials(credentials.NewStaticCredentials(accessKey, secretKey, "")).
+		WithS3ForcePathStyle(true)
+	return s3.New(sess, conf), nil
+}
+
+// piCOSSplitImagePath splits an image path bucket-name[/folder/../..] into
+// the bucket name and the key prefix of its folder
+func piCOSSplitImagePath(imagePath string) (string, string) {
+	parts := strings.SplitN(strings.Trim(imagePath, "/"), "/", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], strings.TrimSuffix(parts[1], "/") + "/"
+}
+
+// piCOSFindImageFile returns the name, relative to the folder of imagePath, of
+// the image file of the capture name, an .ova.gz object whose name starts with
+// name, or an empty string if there is none
+func piCOSFindImageFile(client *s3.S3, imagePath, name string) (string, error) {
+	bucket, folder := piCOSSplitImagePath(imagePath)
+	var file string
+	err := client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(folder + name),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, o := range page.Contents {
+			if key := aws.StringValue(o.Key); strings.HasSuffix(key, ".ova.gz") {
+				file = strings.TrimPrefix(key, folder)
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list the objects %s%s* of bucket %s: %v", folder, name, bucket, err)
+	}
+	return file, nil
+}
+
+// piCOSDeleteObjects deletes the objects of the folder of imagePath whose
+// name starts with name
+func piCOSDeleteObjects(client *s3.S3, imagePath, name string) error {
+	bucket, folder := piCOSSplitImagePath(imagePath)
+	var objects []*s3.ObjectIdentifier
+	err := client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(folder + name),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, o := range page.Contents {
+			objects = append(objects, &s3.ObjectIdentifier{Key: o.Key})
+		}
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list the objects %s%s* of bucket %s: %v", folder, name, bucket, err)
+	}
+
+	// DeleteObjects accepts at most 1000 keys per request
+	for len(objects) > 0 {
+		n := len(objects)
+		if n > 1000 {
+			n = 1000
+		}
+		out, err := client.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{Objects: objects[:n], Quiet: aws.Bool(true)},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to delete the objects %s%s* of bucket %s: %v", folder, name, bucket, err)
+		}
+		if len(out.Errors) > 0 {
+			return fmt.Errorf("failed to delete object %s of bucket %s: %s", aws.StringValue(out.Errors[0].Key), bucket, aws.StringValue(out.Errors[0].Message))
+		}
+		objects = objects[n:]
+	}
+	log.Printf("[DEBUG] deleted the objects %s%s* of bucket %s", folder, name, bucket)
+	return nil
+}
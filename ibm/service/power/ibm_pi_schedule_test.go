@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParsePISchedule(t *testing.T) {
+	testCases := []struct {
+		spec   string
+		minute []int
+		hour   []int
+		dow    []int
+		err    string
+	}{
+		{spec: "0 2 * * *", minute: []int{0}, hour: []int{2}},
+		{spec: "*/15 0-6/2 * * 1-5", minute: []int{0, 15, 30, 45}, hour: []int{0, 2, 4, 6}, dow: []int{1, 2, 3, 4, 5}},
+		{spec: "10/20 1,13 * * *", minute: []int{10, 30, 50}, hour: []int{1, 13}},
+		{spec: " @weekly ", minute: []int{0}, hour: []int{0}, dow: []int{0}},
+		{spec: "0 0 * * 7", minute: []int{0}, hour: []int{0}, dow: []int{0, 7}},
+		{spec: "0 2 * *", err: "must have 5 fields"},
+		{spec: "60 * * * *", err: "invalid minute"},
+		{spec: "0 25 * * *", err: "invalid hour"},
+		{spec: "0 0 0 * *", err: "invalid day of month"},
+		{spec: "0 0 * 5-1 *", err: "invalid month"},
+		{spec: "0 0 * * 8", err: "invalid day of week"},
+		{spec: "*/0 * * * *", err: "invalid step"},
+		{spec: "a * * * *", err: "invalid value"},
+		{spec: "@every 1h", err: "must have 5 fields"},
+	}
+
+	for _, tc := range testCases {
+		s, err := parsePISchedule(tc.spec)
+		if tc.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("parsePISchedule(%q) error = %v, want %q", tc.spec, err, tc.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parsePISchedule(%q) unexpected error: %v", tc.spec, err)
+			continue
+		}
+		if got := piScheduleValues(s.minute); !reflect.DeepEqual(got, tc.minute) {
+			t.Errorf("parsePISchedule(%q) minute = %v, want %v", tc.spec, got, tc.minute)
+		}
+		if got := piScheduleValues(s.hour); !reflect.DeepEqual(got, tc.hour) {
+			t.Errorf("parsePISchedule(%q) hour = %v, want %v", tc.spec, got, tc.hour)
+		}
+		if tc.dow != nil {
+			if got := piScheduleValues(s.dow); !reflect.DeepEqual(got, tc.dow) {
+				t.Errorf("parsePISchedule(%q) day of week = %v, want %v", tc.spec, got, tc.dow)
+			}
+		}
+	}
+}
+
+func TestPIScheduleNext(t *testing.T) {
+	testCases := []struct {
+		name string
+		spec string
+		from string
+		want string
+	}{
+		{name: "same day", spec: "0 2 * * *", from: "2024-09-02T01:59:30Z", want: "2024-09-02T02:00:00Z"},
+		{name: "strictly after", spec: "0 2 * * *", from: "2024-09-02T02:00:00Z", want: "2024-09-03T02:00:00Z"},
+		{name: "step", spec: "*/15 * * * *", from: "2024-09-02T10:16:00Z", want: "2024-09-02T10:30:00Z"},
+		{name: "next month", spec: "0 0 1 * *", from: "2024-12-15T00:00:00Z", want: "2025-01-01T00:00:00Z"},
+		{name: "day of month only", spec: "0 0 13 * *", from: "2024-09-01T00:00:00Z", want: "2024-09-13T00:00:00Z"},
+		{name: "day of week only", spec: "0 0 * * 5", from: "2024-09-01T00:00:00Z", want: "2024-09-06T00:00:00Z"},
+		{name: "day of month or day of week, week day first", spec: "0 0 13 * 5", from: "2024-09-01T00:00:00Z", want: "2024-09-06T00:00:00Z"},
+		{name: "day of month or day of week, month day first", spec: "0 0 13 * 5", from: "2024-10-11T00:00:00Z", want: "2024-10-13T00:00:00Z"},
+		{name: "7 is sunday", spec: "0 0 * * 7", from: "2024-09-02T00:00:00Z", want: "2024-09-08T00:00:00Z"},
+		{name: "0 is sunday", spec: "0 0 * * 0", from: "2024-09-02T00:00:00Z", want: "2024-09-08T00:00:00Z"},
+		{name: "weekly", spec: "@weekly", from: "2024-09-02T00:00:00Z", want: "2024-09-08T00:00:00Z"},
+		{name: "leap day", spec: "0 0 29 2 *", from: "2024-03-01T00:00:00Z", want: "2028-02-29T00:00:00Z"},
+		{name: "never", spec: "0 0 30 2 *", from: "2024-09-02T00:00:00Z", want: ""},
+	}
+
+	for _, tc := range testCases {
+		s, err := parsePISchedule(tc.spec)
+		if err != nil {
+			t.Fatalf("%s: parsePISchedule(%q) unexpected error: %v", tc.name, tc.spec, err)
+		}
+		from, _ := time.Parse(time.RFC3339, tc.from)
+		var got string
+		if next := s.next(from); !next.IsZero() {
+			got = next.Format(time.RFC3339)
+		}
+		if got != tc.want {
+			t.Errorf("%s: next(%s) of %q = %q, want %q", tc.name, tc.from, tc.spec, got, tc.want)
+		}
+	}
+}
+
+func piScheduleValues(values map[int]bool) []int {
+	var sorted []int
+	for v := 0; v <= 59; v++ {
+		if values[v] {
+			sorted = append(sorted, v)
+		}
+	}
+	return sorted
+}
@@ -127,38 +127,9 @@ func resourceIBMPICaptureCreate(ctx context.Context, d *schema.ResourceData, met
 
 	client := st.NewIBMPIInstanceClient(context.Background(), sess, cloudInstanceID)
 
-	captureBody := &models.PVMInstanceCapture{
-		CaptureDestination: &capturedestination,
-		CaptureName:        &capturename,
-	}
-	if capturedestination != imageCatalogDestination {
-		if v, ok := d.GetOk(helpers.PIInstanceCaptureCloudStorageRegion); ok {
-			captureBody.CloudStorageRegion = v.(string)
-		} else {
-			return diag.Errorf("%s is required when capture destination is %s", helpers.PIInstanceCaptureCloudStorageRegion, capturedestination)
-		}
-		if v, ok := d.GetOk(helpers.PIInstanceCaptureCloudStorageAccessKey); ok {
-			captureBody.CloudStorageAccessKey = v.(string)
-		} else {
-			return diag.Errorf("%s is required when capture destination is %s ", helpers.PIInstanceCaptureCloudStorageAccessKey, capturedestination)
-		}
-		if v, ok := d.GetOk(helpers.PIInstanceCaptureCloudStorageImagePath); ok {
-			captureBody.CloudStorageImagePath = v.(string)
-		} else {
-			return diag.Errorf("%s is required when capture destination is %s ", helpers.PIInstanceCaptureCloudStorageImagePath, capturedestination)
-		}
-		if v, ok := d.GetOk(helpers.PIInstanceCaptureCloudStorageSecretKey); ok {
-			captureBody.CloudStorageSecretKey = v.(string)
-		} else {
-			return diag.Errorf("%s is required when capture destination is %s ", helpers.PIInstanceCaptureCloudStorageSecretKey, capturedestination)
-		}
-	}
-
-	if v, ok := d.GetOk(helpers.PIInstanceCaptureVolumeIds); ok {
-		volids := flex.ExpandStringList((v.(*schema.Set)).List())
-		if len(volids) > 0 {
-			captureBody.CaptureVolumeIDs = volids
-		}
+	captureBody, err := expandPICaptureBody(d, capturename)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	captureResponse, err := client.CaptureInstanceToImageCatalogV2(name, captureBody)
@@ -239,3 +210,43 @@ func resourceIBMPICaptureDelete(ctx context.Context, d *schema.ResourceData, met
 	d.SetId("")
 	return nil
 }
+
+// expandPICaptureBody returns the body of a capture named capturename from the
+// capture destination, volumes and cloud storage arguments
+func expandPICaptureBody(d *schema.ResourceData, capturename string) (*models.PVMInstanceCapture, error) {
+	capturedestination := d.Get(helpers.PIInstanceCaptureDestination).(string)
+	captureBody := &models.PVMInstanceCapture{
+		CaptureDestination: &capturedestination,
+		CaptureName:        &capturename,
+	}
+	if capturedestination != imageCatalogDestination {
+		if v, ok := d.GetOk(helpers.PIInstanceCaptureCloudStorageRegion); ok {
+			captureBody.CloudStorageRegion = v.(string)
+		} else {
+			return nil, fmt.Errorf("%s is required when capture destination is %s", helpers.PIInstanceCaptureCloudStorageRegion, capturedestination)
+		}
+		if v, ok := d.GetOk(helpers.PIInstanceCaptureCloudStorageAccessKey); ok {
+			captureBody.CloudStorageAccessKey = v.(string)
+		} else {
+			return nil, fmt.Errorf("%s is required when capture destination is %s ", helpers.PIInstanceCaptureCloudStorageAccessKey, capturedestination)
+		}
+		if v, ok := d.GetOk(helpers.PIInstanceCaptureCloudStorageImagePath); ok {
+			captureBody.CloudStorageImagePath = v.(string)
+		} else {
+			return nil, fmt.Errorf("%s is required when capture destination is %s ", helpers.PIInstanceCaptureCloudStorageImagePath, capturedestination)
+		}
+		if v, ok := d.GetOk(helpers.PIInstanceCaptureCloudStorageSecretKey); ok {
+			captureBody.CloudStorageSecretKey = v.(string)
+		} else {
+			return nil, fmt.Errorf("%s is required when capture destination is %s ", helpers.PIInstanceCaptureCloudStorageSecretKey, capturedestination)
+		}
+	}
+
+	if v, ok := d.GetOk(helpers.PIInstanceCaptureVolumeIds); ok {
+		volids := flex.ExpandStringList((v.(*schema.Set)).List())
+		if len(volids) > 0 {
+			captureBody.CaptureVolumeIDs = volids
+		}
+	}
+	return captureBody, nil
+}
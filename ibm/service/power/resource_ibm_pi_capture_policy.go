@@ -0,0 +1,471 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_images"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// piCapturePolicyTimeFormat is the suffix of the names of the captures of a
+// policy, the UTC time of the capture
+const piCapturePolicyTimeFormat = "20060102150405"
+
+func ResourceIBMPICapturePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPICapturePolicyCreate,
+		ReadContext:   resourceIBMPICapturePolicyRead,
+		UpdateContext: resourceIBMPICapturePolicyUpdate,
+		DeleteContext: resourceIBMPICapturePolicyDelete,
+		CustomizeDiff: resourceIBMPICapturePolicyCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+			Update: schema.DefaultTimeout(75 * time.Minute),
+			Delete: schema.DefaultTimeout(50 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			helpers.PICloudInstanceId: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Cloud Instance ID - This is the service_instance_id.",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			helpers.PIInstanceName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Name or ID of the instance to capture",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_CapturePolicySchedule: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Cron schedule of the captures in UTC, minute hour day-of-month month day-of-week, or @yearly, @monthly, @weekly, @daily, @hourly",
+				ValidateFunc: validatePISchedule,
+			},
+			Arg_CapturePolicyNamePrefix: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Prefix of the names of the captures, followed by the UTC time of the capture",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			helpers.PIInstanceCaptureDestination: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Destination for the deployable images",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{imageCatalogDestination, cloudStorageDestination, "both"}),
+			},
+			helpers.PIInstanceCaptureVolumeIds: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "List of Data volume IDs to capture",
+			},
+			helpers.PIInstanceCaptureCloudStorageRegion: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Cloud Storage region",
+			},
+			helpers.PIInstanceCaptureCloudStorageAccessKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Cloud Storage access key",
+			},
+			helpers.PIInstanceCaptureCloudStorageSecretKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Cloud Storage secret key",
+			},
+			helpers.PIInstanceCaptureCloudStorageImagePath: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Cloud Storage image path (bucket-name [/folder/../..])",
+			},
+			Arg_CapturePolicyRetention: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      7,
+				Description:  "Number of captures to retain, older captures are deleted",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			// Attributes
+			Attr_CapturePolicyCaptures: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Retained captures of the policy, newest first",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_CapturePolicyCaptureTime: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time of the capture",
+						},
+						Attr_CapturePolicyDestination: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Destination of the capture",
+						},
+						Attr_ImageID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Image ID of the capture in the image catalog",
+						},
+						Attr_Name: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the capture",
+						},
+						Attr_CapturePolicyStorageImagePath: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cloud Storage image path of the capture",
+						},
+						Attr_CapturePolicyStorageRegion: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cloud Storage region of the capture",
+						},
+					},
+				},
+			},
+			Attr_CapturePolicyLastRunMessage: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Error message of the last run if it failed",
+			},
+			Attr_CapturePolicyLastRunStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the last run, completed or failed",
+			},
+			Attr_CapturePolicyLastRunTime: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time of the last run",
+			},
+			Attr_CapturePolicyNextRunTime: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time of the next scheduled run",
+			},
+		},
+	}
+}
+
+func resourceIBMPICapturePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
+	if _, err := expandPICaptureBody(d, d.Get(Arg_CapturePolicyNamePrefix).(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyID, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, policyID))
+
+	// The first capture is taken on create, the next ones on schedule
+	err = piCapturePolicyRun(ctx, d, sess, time.Now().UTC(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	return resourceIBMPICapturePolicyRead(ctx, d, meta)
+}
+
+func resourceIBMPICapturePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cloudInstanceID, _, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set(helpers.PICloudInstanceId, cloudInstanceID)
+
+	if err := piCapturePolicyRefreshCaptures(ctx, d, sess); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Captures are only taken and pruned on apply, the refresh only computes
+	// the next run, which the plan then shows as due
+	schedule, err := parsePISchedule(d.Get(Arg_CapturePolicySchedule).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	lastRun, _ := time.Parse(time.RFC3339, d.Get(Attr_CapturePolicyLastRunTime).(string))
+	piCapturePolicySetNextRunTime(d, schedule, lastRun)
+	return nil
+}
+
+func resourceIBMPICapturePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if _, err := expandPICaptureBody(d, d.Get(Arg_CapturePolicyNamePrefix).(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	return piCapturePolicyReconcile(ctx, d, sess, d.Timeout(schema.TimeoutUpdate))
+}
+
+func resourceIBMPICapturePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	captures := d.Get(Attr_CapturePolicyCaptures).([]interface{})
+	for i, c := range captures {
+		if err := piCapturePolicyDeleteCapture(ctx, d, sess, c.(map[string]interface{})); err != nil {
+			// Keep the captures not deleted yet for the next destroy
+			d.Set(Attr_CapturePolicyCaptures, captures[i:])
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMPICapturePolicyCustomizeDiff plans a run when one is due, so that
+// the apply takes the capture and prunes the captures exceeding the retention
+func resourceIBMPICapturePolicyCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if diff.HasChange(Arg_CapturePolicySchedule) {
+		if err := diff.SetNewComputed(Attr_CapturePolicyNextRunTime); err != nil {
+			return err
+		}
+	}
+	if diff.HasChange(Arg_CapturePolicyRetention) || len(diff.Get(Attr_CapturePolicyCaptures).([]interface{})) > diff.Get(Arg_CapturePolicyRetention).(int) {
+		if err := diff.SetNewComputed(Attr_CapturePolicyCaptures); err != nil {
+			return err
+		}
+	}
+
+	schedule, err := parsePISchedule(diff.Get(Arg_CapturePolicySchedule).(string))
+	if err != nil {
+		// The schedule is not known yet
+		return nil
+	}
+	lastRun, _ := time.Parse(time.RFC3339, diff.Get(Attr_CapturePolicyLastRunTime).(string))
+	if next := schedule.next(lastRun); next.IsZero() || next.After(time.Now().UTC()) {
+		return nil
+	}
+	for _, key := range []string{Attr_CapturePolicyCaptures, Attr_CapturePolicyLastRunMessage, Attr_CapturePolicyLastRunStatus,
+		Attr_CapturePolicyLastRunTime, Attr_CapturePolicyNextRunTime} {
+		if err := diff.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validatePISchedule(v interface{}, k string) (ws []string, errs []error) {
+	if _, err := parsePISchedule(v.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q: %v", k, err))
+	}
+	return
+}
+
+// piCapturePolicyReconcile takes a capture if a scheduled run was missed since
+// the last run, several missed runs being caught up by a single capture, and
+// deletes the captures exceeding the retention. It only runs on apply. A failed
+// capture is reported as a warning, it is retried on the next scheduled run.
+func piCapturePolicyReconcile(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+	schedule, err := parsePISchedule(d.Get(Arg_CapturePolicySchedule).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	now := time.Now().UTC()
+	lastRun, _ := time.Parse(time.RFC3339, d.Get(Attr_CapturePolicyLastRunTime).(string))
+	if next := schedule.next(lastRun); !next.IsZero() && !next.After(now) {
+		if err := piCapturePolicyRun(ctx, d, sess, now, timeout); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("scheduled capture of instance %s failed", d.Get(helpers.PIInstanceName).(string)),
+				Detail:   err.Error(),
+			})
+		}
+		lastRun = now
+	}
+	piCapturePolicyPrune(ctx, d, sess)
+	piCapturePolicySetNextRunTime(d, schedule, lastRun)
+	return diags
+}
+
+func piCapturePolicySetNextRunTime(d *schema.ResourceData, schedule *piSchedule, lastRun time.Time) {
+	if next := schedule.next(lastRun); next.IsZero() {
+		d.Set(Attr_CapturePolicyNextRunTime, "")
+	} else {
+		d.Set(Attr_CapturePolicyNextRunTime, next.Format(time.RFC3339))
+	}
+}
+
+// piCapturePolicyRun takes a capture named after the prefix and now, records
+// the run and, if the capture completed, adds it to the captures
+func piCapturePolicyRun(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, now time.Time, timeout time.Duration) error {
+	d.Set(Attr_CapturePolicyLastRunTime, now.Format(time.RFC3339))
+	capture, err := piCapturePolicyCapture(ctx, d, sess, now, timeout)
+	if err != nil {
+		log.Printf("[WARN] capture of policy %s failed: %v", d.Id(), err)
+		d.Set(Attr_CapturePolicyLastRunStatus, CapturePolicyStatusFailed)
+		d.Set(Attr_CapturePolicyLastRunMessage, err.Error())
+		return err
+	}
+	d.Set(Attr_CapturePolicyLastRunStatus, CapturePolicyStatusCompleted)
+	d.Set(Attr_CapturePolicyLastRunMessage, "")
+	captures := append([]interface{}{capture}, d.Get(Attr_CapturePolicyCaptures).([]interface{})...)
+	d.Set(Attr_CapturePolicyCaptures, captures)
+	return nil
+}
+
+func piCapturePolicyCapture(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, now time.Time, timeout time.Duration) (map[string]interface{}, error) {
+	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
+	name := d.Get(helpers.PIInstanceName).(string)
+	capturename := d.Get(Arg_CapturePolicyNamePrefix).(string) + now.Format(piCapturePolicyTimeFormat)
+	capturedestination := d.Get(helpers.PIInstanceCaptureDestination).(string)
+
+	captureBody, err := expandPICaptureBody(d, capturename)
+	if err != nil {
+		return nil, err
+	}
+	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	captureResponse, err := client.CaptureInstanceToImageCatalogV2(name, captureBody)
+	if err != nil {
+		return nil, err
+	}
+	jobClient := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+	_, err = waitForIBMPIJobCompleted(ctx, jobClient, *captureResponse.ID, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	capture := map[string]interface{}{
+		Attr_CapturePolicyCaptureTime: now.Format(time.RFC3339),
+		Attr_CapturePolicyDestination: capturedestination,
+		Attr_Name:                     capturename,
+	}
+	if capturedestination != imageCatalogDestination {
+		capture[Attr_CapturePolicyStorageImagePath] = captureBody.CloudStorageImagePath
+		capture[Attr_CapturePolicyStorageRegion] = captureBody.CloudStorageRegion
+	}
+	if capturedestination != cloudStorageDestination {
+		imageClient := st.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+		imagedata, err := imageClient.Get(capturename)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the image of capture %s: %v", capturename, err)
+		}
+		capture[Attr_ImageID] = *imagedata.ImageID
+	}
+	return capture, nil
+}
+
+// piCapturePolicyPrune deletes the captures exceeding the retention. A capture
+// that fails to be deleted is kept and deleted again on the next apply.
+func piCapturePolicyPrune(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession) {
+	captures := d.Get(Attr_CapturePolicyCaptures).([]interface{})
+	retention := d.Get(Arg_CapturePolicyRetention).(int)
+	if len(captures) <= retention {
+		return
+	}
+
+	retained := captures[:retention]
+	for _, c := range captures[retention:] {
+		capture := c.(map[string]interface{})
+		if err := piCapturePolicyDeleteCapture(ctx, d, sess, capture); err != nil {
+			log.Printf("[WARN] failed to delete expired capture %s, retrying on the next apply: %v", capture[Attr_Name], err)
+			retained = append(retained, capture)
+		}
+	}
+	d.Set(Attr_CapturePolicyCaptures, retained)
+}
+
+// piCapturePolicyDeleteCapture deletes the image of a capture from the image
+// catalog and its objects from Cloud Storage, ignoring those already deleted
+func piCapturePolicyDeleteCapture(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, capture map[string]interface{}) error {
+	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
+	name := capture[Attr_Name].(string)
+	destination := capture[Attr_CapturePolicyDestination].(string)
+
+	if imageID, _ := capture[Attr_ImageID].(string); destination != cloudStorageDestination && imageID != "" {
+		imageClient := st.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+		err := imageClient.Delete(imageID)
+		if err != nil && !piCapturePolicyImageNotFound(err) {
+			return fmt.Errorf("failed to delete the image %s of capture %s: %v", imageID, name, err)
+		}
+	}
+	if destination != imageCatalogDestination {
+		client, err := piCOSClient(capture[Attr_CapturePolicyStorageRegion].(string),
+			d.Get(helpers.PIInstanceCaptureCloudStorageAccessKey).(string), d.Get(helpers.PIInstanceCaptureCloudStorageSecretKey).(string))
+		if err != nil {
+			return err
+		}
+		if err := piCOSDeleteObjects(client, capture[Attr_CapturePolicyStorageImagePath].(string), name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// piCapturePolicyRefreshCaptures drops the captures whose only copy, the image
+// in the image catalog, was deleted outside of the policy
+func piCapturePolicyRefreshCaptures(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession) error {
+	imageClient := st.NewIBMPIImageClient(ctx, sess, d.Get(helpers.PICloudInstanceId).(string))
+	captures := make([]interface{}, 0)
+	for _, c := range d.Get(Attr_CapturePolicyCaptures).([]interface{}) {
+		capture := c.(map[string]interface{})
+		if imageID, _ := capture[Attr_ImageID].(string); imageID != "" {
+			_, err := imageClient.Get(imageID)
+			if err != nil {
+				if !piCapturePolicyImageNotFound(err) {
+					return err
+				}
+				log.Printf("[DEBUG] image %s of capture %s does not exist", imageID, capture[Attr_Name])
+				if capture[Attr_CapturePolicyDestination].(string) == imageCatalogDestination {
+					continue
+				}
+				capture[Attr_ImageID] = ""
+			}
+		}
+		captures = append(captures, capture)
+	}
+	d.Set(Attr_CapturePolicyCaptures, captures)
+	return nil
+}
+
+func piCapturePolicyImageNotFound(err error) bool {
+	var getNotFound *p_cloud_images.PcloudCloudinstancesImagesGetNotFound
+	var deleteNotFound *p_cloud_images.PcloudCloudinstancesImagesDeleteNotFound
+	return errors.As(err, &getNotFound) || errors.As(err, &deleteNotFound)
+}
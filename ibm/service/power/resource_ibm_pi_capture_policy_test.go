@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPICapturePolicyBasic(t *testing.T) {
+	policyRes := "ibm_pi_capture_policy.capture_policy"
+	prefix := fmt.Sprintf("tf-pi-capture-policy-%d-", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPICapturePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPICapturePolicyConfig(prefix, "@daily", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPICapturePolicyExists(policyRes),
+					resource.TestCheckResourceAttr(policyRes, "last_run_status", "completed"),
+					resource.TestCheckResourceAttr(policyRes, "captures.#", "1"),
+					resource.TestMatchResourceAttr(policyRes, "captures.0.name", regexp.MustCompile("^"+prefix+"[0-9]{14}$")),
+					resource.TestCheckResourceAttrSet(policyRes, "captures.0.image_id"),
+					resource.TestCheckResourceAttrSet(policyRes, "next_run_time"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPICapturePolicyConfig(prefix, "0 */6 * * *", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPICapturePolicyExists(policyRes),
+					resource.TestCheckResourceAttr(policyRes, "pi_schedule", "0 */6 * * *"),
+					resource.TestCheckResourceAttr(policyRes, "pi_retention", "1"),
+					resource.TestCheckResourceAttr(policyRes, "captures.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMPICapturePolicyInvalidSchedule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPICapturePolicyConfig("tf-pi-capture-policy-", "0 25 * * *", 1),
+				ExpectError: regexp.MustCompile("invalid hour"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPICapturePolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+		client := st.NewIBMPIImageClient(context.Background(), sess, rs.Primary.Attributes["pi_cloud_instance_id"])
+		_, err = client.Get(rs.Primary.Attributes["captures.0.image_id"])
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMPICapturePolicyDestroy(s *terraform.State) error {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_capture_policy" {
+			continue
+		}
+		imageClient := st.NewIBMPIImageClient(context.Background(), sess, rs.Primary.Attributes["pi_cloud_instance_id"])
+		_, err := imageClient.Get(rs.Primary.Attributes["captures.0.image_id"])
+		if err == nil {
+			return fmt.Errorf("PI Image of capture policy still exists: %s", rs.Primary.Attributes["captures.0.image_id"])
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMPICapturePolicyConfig(prefix, schedule string, retention int) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_capture_policy" "capture_policy" {
+		pi_cloud_instance_id   = "%[1]s"
+		pi_instance_name       = "%[2]s"
+		pi_capture_name_prefix = "%[3]s"
+		pi_schedule            = "%[4]s"
+		pi_capture_destination = "image-catalog"
+		pi_retention           = %[5]d
+	}
+	`, acc.Pi_cloud_instance_id, acc.Pi_instance_name, prefix, schedule, retention)
+}
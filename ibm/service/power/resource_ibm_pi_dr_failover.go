@@ -0,0 +1,844 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volume_groups"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volumes"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func ResourceIBMPIDRFailover() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIDRFailoverCreate,
+		ReadContext:   resourceIBMPIDRFailoverRead,
+		UpdateContext: resourceIBMPIDRFailoverUpdate,
+		DeleteContext: resourceIBMPIDRFailoverDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Cloud Instance ID of the source workspace - This is the service_instance_id.",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_VolumeGroupID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the replicated volume group in the source workspace.",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			piSourceCRN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "CRN of the source workspace, from where the auxiliary volumes are onboarded.",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_DRTargetCloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Cloud Instance ID of the target workspace in the DR site.",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_DRTargetZone: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Zone of the target workspace, the zone of the provider is used if not provided.",
+			},
+			Arg_DRInstances: {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: "Source instances to stop and to replace in the target workspace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Arg_DRSourceInstanceID: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the source instance.",
+						},
+						helpers.PIInstanceName: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the replacement instance.",
+						},
+						helpers.PIInstanceImageId: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of an image in the target workspace to deploy the replacement instance, the onboarded boot volume is set as boot volume once deployed.",
+						},
+						helpers.PIInstanceNetworkIds: {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "IDs of the networks of the target workspace to attach the replacement instance to.",
+						},
+						helpers.PIInstanceMemory: {
+							Type:        schema.TypeFloat,
+							Optional:    true,
+							Description: "Memory of the replacement instance in GB, the memory of the source instance is used if not provided.",
+						},
+						helpers.PIInstanceProcessors: {
+							Type:        schema.TypeFloat,
+							Optional:    true,
+							Description: "Processors of the replacement instance, the processors of the source instance are used if not provided.",
+						},
+						helpers.PIInstanceProcType: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Processor type of the replacement instance, the processor type of the source instance is used if not provided.",
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"dedicated", "shared", "capped"}),
+						},
+						helpers.PIInstanceSystemType: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "System type of the replacement instance, the system type of the source instance is used if not provided.",
+						},
+						helpers.PIInstanceSSHKeyName: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "SSH key name of the replacement instance.",
+						},
+					},
+				},
+			},
+			Arg_DRRollbackOnFailure: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Roll back the completed steps if the failover fails. When false, the partial failover is kept in the state and rolled back on destroy.",
+			},
+			Arg_DRFailbackOnDestroy: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Fail back to the source workspace on destroy. When false, destroy only removes the failover from the state.",
+			},
+
+			// Attributes
+			Attr_Status: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the failover, failed-over or failed.",
+			},
+			Attr_DRCompletedSteps: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The completed steps of the failover, in order.",
+			},
+			Attr_ReplicationStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The replication status of the volume group.",
+			},
+			Attr_DRStoppedSourceInstances: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the source instances stopped by the failover, which are started again on rollback.",
+			},
+			Attr_DROnboardedVolumes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The auxiliary volumes onboarded in the target workspace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_DRSourceVolumeID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the source volume.",
+						},
+						Attr_DRTargetVolumeID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the onboarded volume in the target workspace.",
+						},
+						Attr_Name: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the onboarded volume.",
+						},
+					},
+				},
+			},
+			Attr_DRInstances: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The replacement instances deployed in the target workspace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_DRSourceInstanceID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the source instance.",
+						},
+						Attr_DRTargetInstanceID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the replacement instance.",
+						},
+						Attr_Name: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the replacement instance.",
+						},
+						Attr_Status: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the replacement instance.",
+						},
+						Attr_BootVolumeID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the onboarded boot volume of the replacement instance.",
+						},
+						Attr_DRTargetVolumeIDs: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "IDs of the onboarded volumes attached to the replacement instance.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// piDRVolume is a volume of the volume group and its onboarded volume
+type piDRVolume struct {
+	sourceID    string
+	name        string
+	auxName     string
+	boot        bool
+	instanceIDs []string
+	targetID    string
+}
+
+func resourceIBMPIDRFailoverCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, targetSess, err := piDRFailoverSessions(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	vgID := d.Get(Arg_VolumeGroupID).(string)
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, vgID))
+
+	err = piDRFailover(ctx, d, sess, targetSess)
+	if err != nil {
+		d.Set(Attr_Status, DRStatusFailed)
+		if !d.Get(Arg_DRRollbackOnFailure).(bool) {
+			return diag.Errorf("failover of volume group %s failed after steps %v: %v", vgID, d.Get(Attr_DRCompletedSteps), err)
+		}
+		log.Printf("[INFO] failover of volume group %s failed, rolling back: %v", vgID, err)
+		rollbackErr := piDRFailback(ctx, d, sess, targetSess)
+		if rollbackErr != nil {
+			return diag.Errorf("failover of volume group %s failed: %v, rollback failed after steps %v were kept: %v", vgID, err, d.Get(Attr_DRCompletedSteps), rollbackErr)
+		}
+		d.SetId("")
+		return diag.Errorf("failover of volume group %s failed and was rolled back: %v", vgID, err)
+	}
+	d.Set(Attr_Status, DRStatusFailedOver)
+
+	return resourceIBMPIDRFailoverRead(ctx, d, meta)
+}
+
+func resourceIBMPIDRFailoverRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, targetSess, err := piDRFailoverSessions(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, vgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_VolumeGroupID, vgID)
+
+	vgClient := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	vg, err := vgClient.GetDetails(vgID)
+	if err != nil {
+		var notFound *p_cloud_volume_groups.PcloudVolumegroupsGetDetailsNotFound
+		if errors.As(err, &notFound) {
+			log.Printf("[DEBUG] volume group %s does not exist, removing the failover from the state", vgID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	d.Set(Attr_ReplicationStatus, vg.ReplicationStatus)
+
+	targetClient := st.NewIBMPIInstanceClient(ctx, targetSess, d.Get(Arg_DRTargetCloudInstanceID).(string))
+	instances := d.Get(Attr_DRInstances).([]interface{})
+	for _, v := range instances {
+		instance := v.(map[string]interface{})
+		pvm, err := targetClient.Get(instance[Attr_DRTargetInstanceID].(string))
+		if err != nil {
+			if isPIInstanceNotFound(err) {
+				log.Printf("[DEBUG] replacement lpar %s does not exist", instance[Attr_DRTargetInstanceID])
+				instance[Attr_Status] = ""
+				continue
+			}
+			return diag.FromErr(err)
+		}
+		if pvm.Status != nil {
+			instance[Attr_Status] = *pvm.Status
+		}
+	}
+	d.Set(Attr_DRInstances, instances)
+
+	return nil
+}
+
+func resourceIBMPIDRFailoverUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only pi_rollback_on_failure and pi_failback_on_destroy can be updated, they are only stored
+	return resourceIBMPIDRFailoverRead(ctx, d, meta)
+}
+
+func resourceIBMPIDRFailoverDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.Get(Arg_DRFailbackOnDestroy).(bool) {
+		log.Printf("[INFO] failback of volume group %s is disabled, removing the failover from the state only", d.Get(Arg_VolumeGroupID))
+		d.SetId("")
+		return nil
+	}
+
+	sess, targetSess, err := piDRFailoverSessions(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = piDRFailback(ctx, d, sess, targetSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// piDRFailoverSessions returns the sessions of the source and the target workspace
+func piDRFailoverSessions(d *schema.ResourceData, meta interface{}) (*ibmpisession.IBMPISession, *ibmpisession.IBMPISession, error) {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return nil, nil, err
+	}
+	targetSess, err := meta.(conns.ClientSession).IBMPISessionForZone(d.Get(Arg_DRTargetZone).(string))
+	if err != nil {
+		return nil, nil, err
+	}
+	return sess, targetSess, nil
+}
+
+// piDRFailover stops the source instances, reverses the replication of the
+// volume group, onboards the auxiliary volumes in the target workspace and
+// deploys the replacement instances. Each step is recorded in completed_steps
+// once done, so that piDRFailback can roll back a partial failover.
+func piDRFailover(ctx context.Context, d *schema.ResourceData, sess, targetSess *ibmpisession.IBMPISession) error {
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	vgID := d.Get(Arg_VolumeGroupID).(string)
+	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+
+	stopped := []string{}
+	for _, v := range d.Get(Arg_DRInstances).([]interface{}) {
+		id := v.(map[string]interface{})[Arg_DRSourceInstanceID].(string)
+		pvm, err := client.Get(id)
+		if err != nil {
+			return err
+		}
+		if pvm.Status != nil && *pvm.Status == StatusShutoff {
+			continue
+		}
+		err = stopLparForResourceChange(ctx, client, id)
+		if err != nil {
+			return err
+		}
+		stopped = append(stopped, id)
+		d.Set(Attr_DRStoppedSourceInstances, stopped)
+	}
+	piDRFailoverCompleteStep(d, DRStepStopSourceInstances)
+
+	vgClient := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	err := piDRSetReplicationSource(ctx, vgClient, vgID, "aux", d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	piDRFailoverCompleteStep(d, DRStepReverseReplication)
+
+	volumes, err := piDROnboardVolumes(ctx, d, sess, targetSess)
+	if err != nil {
+		return err
+	}
+	piDRFailoverCompleteStep(d, DRStepOnboardVolumes)
+
+	targetCloudInstanceID := d.Get(Arg_DRTargetCloudInstanceID).(string)
+	targetClient := st.NewIBMPIInstanceClient(ctx, targetSess, targetCloudInstanceID)
+	targetVolClient := st.NewIBMPIVolumeClient(ctx, targetSess, targetCloudInstanceID)
+	for _, v := range d.Get(Arg_DRInstances).([]interface{}) {
+		err = piDRDeployInstance(ctx, d, client, targetClient, targetVolClient, v.(map[string]interface{}), volumes)
+		if err != nil {
+			return err
+		}
+	}
+	piDRFailoverCompleteStep(d, DRStepDeployInstances)
+
+	return nil
+}
+
+// piDRFailback deletes the replacement instances, restores the replication of
+// the volume group from the source workspace and starts the source instances
+// stopped by the failover.
+//
+// The onboarded volumes are detached from the replacement instances before
+// they are deleted and are kept in the target workspace. Before the replication
+// is switched back to master, the failback waits until the data written at the
+// DR site has been copied back to the master volumes. If the copy is not
+// synchronized within the delete timeout the failback fails without switching,
+// so that no data written at the DR site is lost.
+func piDRFailback(ctx context.Context, d *schema.ResourceData, sess, targetSess *ibmpisession.IBMPISession) error {
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	vgID := d.Get(Arg_VolumeGroupID).(string)
+
+	targetCloudInstanceID := d.Get(Arg_DRTargetCloudInstanceID).(string)
+	targetClient := st.NewIBMPIInstanceClient(ctx, targetSess, targetCloudInstanceID)
+	targetVolClient := st.NewIBMPIVolumeClient(ctx, targetSess, targetCloudInstanceID)
+	instances := d.Get(Attr_DRInstances).([]interface{})
+	deleted := []string{}
+	for _, v := range instances {
+		instance := v.(map[string]interface{})
+		id := instance[Attr_DRTargetInstanceID].(string)
+		err := piDRDetachVolumes(ctx, d, targetClient, targetVolClient, id, flex.ExpandStringList(instance[Attr_DRTargetVolumeIDs].([]interface{})))
+		if err != nil {
+			if isPIInstanceNotFound(err) {
+				log.Printf("[DEBUG] replacement lpar %s does not exist", id)
+				continue
+			}
+			return err
+		}
+		err = targetClient.Delete(id)
+		if err != nil {
+			if isPIInstanceNotFound(err) {
+				log.Printf("[DEBUG] replacement lpar %s does not exist", id)
+				continue
+			}
+			return err
+		}
+		deleted = append(deleted, id)
+	}
+	for _, id := range deleted {
+		_, err := isWaitForPIInstanceDeleted(ctx, targetClient, id)
+		if err != nil {
+			return err
+		}
+	}
+	d.Set(Attr_DRInstances, nil)
+	piDRFailoverRemoveStep(d, DRStepDeployInstances)
+
+	if piDRFailoverStepCompleted(d, DRStepReverseReplication) {
+		vgClient := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+		_, err := isWaitForIBMPIVolumeGroupSynchronized(ctx, vgClient, vgID, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return fmt.Errorf("the data written at the DR site was not copied back to the master volumes of volume group %s, the replication was not switched back: %v", vgID, err)
+		}
+		err = piDRSetReplicationSource(ctx, vgClient, vgID, "master", d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return err
+		}
+		piDRFailoverRemoveStep(d, DRStepReverseReplication)
+	}
+
+	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	stopped := flex.ExpandStringList(d.Get(Attr_DRStoppedSourceInstances).([]interface{}))
+	for len(stopped) > 0 {
+		err := startLparAfterResourceChange(ctx, client, stopped[0])
+		if err != nil {
+			return err
+		}
+		stopped = stopped[1:]
+		d.Set(Attr_DRStoppedSourceInstances, stopped)
+	}
+	piDRFailoverRemoveStep(d, DRStepStopSourceInstances)
+
+	return nil
+}
+
+func piDRFailoverCompleteStep(d *schema.ResourceData, step string) {
+	d.Set(Attr_DRCompletedSteps, append(d.Get(Attr_DRCompletedSteps).([]interface{}), step))
+}
+
+func piDRFailoverRemoveStep(d *schema.ResourceData, step string) {
+	steps := []interface{}{}
+	for _, s := range d.Get(Attr_DRCompletedSteps).([]interface{}) {
+		if s.(string) != step {
+			steps = append(steps, s)
+		}
+	}
+	d.Set(Attr_DRCompletedSteps, steps)
+}
+
+func piDRFailoverStepCompleted(d *schema.ResourceData, step string) bool {
+	for _, s := range d.Get(Attr_DRCompletedSteps).([]interface{}) {
+		if s.(string) == step {
+			return true
+		}
+	}
+	return false
+}
+
+// piDRSetReplicationSource stops the replication of the volume group with
+// access to the auxiliary volumes and starts it again from source, master or aux
+func piDRSetReplicationSource(ctx context.Context, client *st.IBMPIVolumeGroupClient, vgID, source string, timeout time.Duration) error {
+	_, err := client.VolumeGroupAction(vgID, &models.VolumeGroupAction{
+		Stop: &models.VolumeGroupActionStop{
+			Access: sl.Bool(true),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stop the replication of volume group %s: %v", vgID, err)
+	}
+	_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, vgID, timeout)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.VolumeGroupAction(vgID, &models.VolumeGroupAction{
+		Start: &models.VolumeGroupActionStart{
+			Source: sl.String(source),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start the replication of volume group %s from %s: %v", vgID, source, err)
+	}
+	_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, vgID, timeout)
+	return err
+}
+
+// piDRDetachVolumes stops a replacement instance and detaches the onboarded
+// volumes from it, boot volume included, so that deleting the instance keeps them
+func piDRDetachVolumes(ctx context.Context, d *schema.ResourceData, client *st.IBMPIInstanceClient, volClient *st.IBMPIVolumeClient, id string, volumeIDs []string) error {
+	pvm, err := client.Get(id)
+	if err != nil {
+		return err
+	}
+	if pvm.Status == nil || *pvm.Status != StatusShutoff {
+		err = stopLparForResourceChange(ctx, client, id)
+		if err != nil {
+			return err
+		}
+	}
+	cloudInstanceID := d.Get(Arg_DRTargetCloudInstanceID).(string)
+	for _, volumeID := range volumeIDs {
+		err = volClient.Detach(id, volumeID)
+		if err != nil {
+			var notFound *p_cloud_volumes.PcloudPvminstancesVolumesDeleteNotFound
+			if errors.As(err, &notFound) {
+				log.Printf("[DEBUG] onboarded volume %s is not attached to the replacement lpar %s", volumeID, id)
+				continue
+			}
+			return fmt.Errorf("failed to detach onboarded volume %s from the replacement lpar %s: %v", volumeID, id, err)
+		}
+		_, err = isWaitForIBMPIVolumeDetach(ctx, volClient, volumeID, cloudInstanceID, id, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isWaitForIBMPIVolumeGroupSynchronized waits until every remote copy
+// relationship of the volume group is consistent and synchronized
+func isWaitForIBMPIVolumeGroupSynchronized(ctx context.Context, client *st.IBMPIVolumeGroupClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the remote copy relationships of Volume Group (%s) to be synchronized.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{DRCopyConsistentCopying, DRCopyInconsistentCopying},
+		Target:     []string{DRCopyConsistentSynchronized},
+		Refresh:    isIBMPIVolumeGroupSynchronizedRefreshFunc(client, id),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeGroupSynchronizedRefreshFunc(client *st.IBMPIVolumeGroupClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rcr, err := client.GetVolumeGroupRemoteCopyRelationships(id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		// The least advanced relationship gives the state of the volume group
+		state := DRCopyConsistentSynchronized
+		for _, rel := range rcr.RemoteCopyRelationships {
+			if rel.State == DRCopyConsistentSynchronized {
+				continue
+			}
+			log.Printf("[DEBUG] remote copy relationship of volume %s in volume group %s is %s (%d%%)", rel.MasterVolumeName, id, rel.State, rel.Progress)
+			if state == DRCopyConsistentSynchronized || rel.State != DRCopyConsistentCopying {
+				state = rel.State
+			}
+		}
+		return rcr, state, nil
+	}
+}
+
+// piDROnboardVolumes onboards the auxiliary volumes of the volume group in the
+// target workspace, volumes already onboarded by a previous attempt are reused
+func piDROnboardVolumes(ctx context.Context, d *schema.ResourceData, sess, targetSess *ibmpisession.IBMPISession) ([]piDRVolume, error) {
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	targetCloudInstanceID := d.Get(Arg_DRTargetCloudInstanceID).(string)
+	vgID := d.Get(Arg_VolumeGroupID).(string)
+
+	vg, err := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID).GetDetails(vgID)
+	if err != nil {
+		return nil, err
+	}
+	volClient := st.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	volumes := make([]piDRVolume, 0, len(vg.VolumeIDs))
+	for _, id := range vg.VolumeIDs {
+		vol, err := volClient.Get(id)
+		if err != nil {
+			return nil, err
+		}
+		if vol.AuxVolumeName == "" {
+			return nil, fmt.Errorf("volume %s of volume group %s has no auxiliary volume", id, vgID)
+		}
+		volumes = append(volumes, piDRVolume{
+			sourceID:    id,
+			name:        *vol.Name,
+			auxName:     vol.AuxVolumeName,
+			boot:        vol.BootVolume != nil && *vol.BootVolume,
+			instanceIDs: vol.PvmInstanceIDs,
+		})
+	}
+
+	targetVolClient := st.NewIBMPIVolumeClient(ctx, targetSess, targetCloudInstanceID)
+	err = piDRMatchOnboardedVolumes(targetVolClient, volumes)
+	if err != nil {
+		return nil, err
+	}
+	auxVolumes := []*models.AuxiliaryVolumeForOnboarding{}
+	for _, vol := range volumes {
+		if vol.targetID == "" {
+			auxVolumes = append(auxVolumes, &models.AuxiliaryVolumeForOnboarding{
+				AuxVolumeName: sl.String(vol.auxName),
+				Name:          vol.name,
+			})
+		}
+	}
+
+	if len(auxVolumes) > 0 {
+		onboardingClient := st.NewIBMPIVolumeOnboardingClient(ctx, targetSess, targetCloudInstanceID)
+		onboarding, err := onboardingClient.CreateVolumeOnboarding(&models.VolumeOnboardingCreate{
+			Description: fmt.Sprintf("Failover of volume group %s", vgID),
+			Volumes: []*models.AuxiliaryVolumesForOnboarding{
+				{
+					SourceCRN:        sl.String(d.Get(piSourceCRN).(string)),
+					AuxiliaryVolumes: auxVolumes,
+				},
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to onboard the auxiliary volumes of volume group %s: %v", vgID, err)
+		}
+		_, err = isWaitForIBMPIVolumeOnboardingCompleted(ctx, onboardingClient, onboarding.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return nil, err
+		}
+		err = piDRMatchOnboardedVolumes(targetVolClient, volumes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	onboarded := make([]map[string]interface{}, 0, len(volumes))
+	for _, vol := range volumes {
+		if vol.targetID == "" {
+			return nil, fmt.Errorf("auxiliary volume %s of volume %s was not onboarded", vol.auxName, vol.sourceID)
+		}
+		onboarded = append(onboarded, map[string]interface{}{
+			Attr_DRSourceVolumeID: vol.sourceID,
+			Attr_DRTargetVolumeID: vol.targetID,
+			Attr_Name:             vol.name,
+		})
+	}
+	d.Set(Attr_DROnboardedVolumes, onboarded)
+
+	return volumes, nil
+}
+
+// piDRMatchOnboardedVolumes sets the target ID of the volumes onboarded in the
+// target workspace, an onboarded volume keeps the auxiliary volume name at
+// storage host level
+func piDRMatchOnboardedVolumes(client *st.IBMPIVolumeClient, volumes []piDRVolume) error {
+	targetVolumes, err := client.GetAll()
+	if err != nil {
+		return err
+	}
+	onboarded := make(map[string]string, len(targetVolumes.Volumes))
+	for _, vol := range targetVolumes.Volumes {
+		if vol.Auxiliary != nil && *vol.Auxiliary && vol.AuxVolumeName != "" {
+			onboarded[vol.AuxVolumeName] = *vol.VolumeID
+		}
+	}
+	for i := range volumes {
+		volumes[i].targetID = onboarded[volumes[i].auxName]
+	}
+	return nil
+}
+
+func isWaitForIBMPIVolumeOnboardingCompleted(ctx context.Context, client *st.IBMPIVolumeOnboardingClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Volume Onboarding (%s) to be completed.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"queued", "in-progress"},
+		Target:     []string{"completed"},
+		Refresh:    isIBMPIVolumeOnboardingRefreshFunc(client, id),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeOnboardingRefreshFunc(client *st.IBMPIVolumeOnboardingClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		onboarding, err := client.Get(id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		switch onboarding.Status {
+		case "completed":
+			return onboarding, "completed", nil
+		case "failed":
+			failures := []string{}
+			if onboarding.Results != nil {
+				for _, failure := range onboarding.Results.VolumeOnboardingFailures {
+					failures = append(failures, fmt.Sprintf("%s: %s", strings.Join(failure.Volumes, ", "), failure.FailureMessage))
+				}
+			}
+			return onboarding, onboarding.Status, fmt.Errorf("failed to onboard the volumes: %s", strings.Join(failures, "; "))
+		}
+		return onboarding, "in-progress", nil
+	}
+}
+
+// piDRDeployInstance deploys the replacement of a source instance in the target
+// workspace with the onboarded volumes of the source instance attached, and
+// boots it from the onboarded boot volume
+func piDRDeployInstance(ctx context.Context, d *schema.ResourceData, client, targetClient *st.IBMPIInstanceClient, targetVolClient *st.IBMPIVolumeClient, instance map[string]interface{}, volumes []piDRVolume) error {
+	sourceID := instance[Arg_DRSourceInstanceID].(string)
+	source, err := client.Get(sourceID)
+	if err != nil {
+		return err
+	}
+
+	body := &models.PVMInstanceCreate{
+		ServerName:  sl.String(instance[helpers.PIInstanceName].(string)),
+		ImageID:     sl.String(instance[helpers.PIInstanceImageId].(string)),
+		Memory:      source.Memory,
+		Processors:  source.Processors,
+		ProcType:    source.ProcType,
+		SysType:     source.SysType,
+		KeyPairName: instance[helpers.PIInstanceSSHKeyName].(string),
+	}
+	if mem := instance[helpers.PIInstanceMemory].(float64); mem > 0 {
+		body.Memory = &mem
+	}
+	if procs := instance[helpers.PIInstanceProcessors].(float64); procs > 0 {
+		body.Processors = &procs
+	}
+	if procType := instance[helpers.PIInstanceProcType].(string); procType != "" {
+		body.ProcType = &procType
+	}
+	if sysType := instance[helpers.PIInstanceSystemType].(string); sysType != "" {
+		body.SysType = sysType
+	}
+	for _, networkID := range flex.ExpandStringList(instance[helpers.PIInstanceNetworkIds].([]interface{})) {
+		body.Networks = append(body.Networks, &models.PVMInstanceAddNetwork{
+			NetworkID: sl.String(networkID),
+		})
+	}
+	var bootVolumeID string
+	for _, vol := range volumes {
+		for _, id := range vol.instanceIDs {
+			if id == sourceID {
+				body.VolumeIDs = append(body.VolumeIDs, vol.targetID)
+				if vol.boot {
+					bootVolumeID = vol.targetID
+				}
+			}
+		}
+	}
+
+	pvmList, err := targetClient.Create(body)
+	if err != nil {
+		return fmt.Errorf("failed to provision the replacement of lpar %s: %v", sourceID, err)
+	}
+	if pvmList == nil || len(*pvmList) == 0 {
+		return fmt.Errorf("failed to provision the replacement of lpar %s", sourceID)
+	}
+	id := *(*pvmList)[0].PvmInstanceID
+	d.Set(Attr_DRInstances, append(d.Get(Attr_DRInstances).([]interface{}), map[string]interface{}{
+		Attr_DRSourceInstanceID: sourceID,
+		Attr_DRTargetInstanceID: id,
+		Attr_Name:               *body.ServerName,
+		Attr_BootVolumeID:       bootVolumeID,
+		Attr_DRTargetVolumeIDs:  body.VolumeIDs,
+	}))
+
+	_, err = isWaitForPIInstanceAvailable(ctx, targetClient, id, helpers.PIInstanceHealthOk)
+	if err != nil {
+		return err
+	}
+	if bootVolumeID == "" {
+		return nil
+	}
+
+	err = stopLparForResourceChange(ctx, targetClient, id)
+	if err != nil {
+		return err
+	}
+	err = targetVolClient.SetBootVolume(id, bootVolumeID)
+	if err != nil {
+		return fmt.Errorf("failed to set boot volume %s of the replacement lpar %s: %v", bootVolumeID, id, err)
+	}
+	return startLparAfterResourceChange(ctx, targetClient, id)
+}
@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// piTestSession returns a Power session whose requests are served by handler
+func piTestSession(t *testing.T, handler http.HandlerFunc) *ibmpisession.IBMPISession {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	sess, err := ibmpisession.NewIBMPISession(&ibmpisession.IBMPIOptions{
+		Authenticator: &core.BearerTokenAuthenticator{BearerToken: "token"},
+		URL:           server.URL,
+		UserAccount:   "test-account",
+		Zone:          "dal12",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return sess
+}
+
+// piTestJSON returns a handler serving payload as JSON on path
+func piTestJSON(t *testing.T, path string, payload interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(payload)
+	}
+}
+
+func TestPIDRFailoverSteps(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceIBMPIDRFailover().Schema, map[string]interface{}{})
+
+	for _, step := range []string{DRStepStopSourceInstances, DRStepReverseReplication, DRStepOnboardVolumes} {
+		piDRFailoverCompleteStep(d, step)
+	}
+	if !piDRFailoverStepCompleted(d, DRStepReverseReplication) || piDRFailoverStepCompleted(d, DRStepDeployInstances) {
+		t.Errorf("Unexpected completed steps %v", d.Get(Attr_DRCompletedSteps))
+	}
+
+	piDRFailoverRemoveStep(d, DRStepReverseReplication)
+	piDRFailoverRemoveStep(d, DRStepDeployInstances)
+	want := []interface{}{DRStepStopSourceInstances, DRStepOnboardVolumes}
+	if got := d.Get(Attr_DRCompletedSteps); !reflect.DeepEqual(got, want) {
+		t.Errorf("completed steps = %v, want %v", got, want)
+	}
+	if piDRFailoverStepCompleted(d, DRStepReverseReplication) {
+		t.Errorf("Expected %s to be removed", DRStepReverseReplication)
+	}
+}
+
+func TestIBMPIVolumeGroupSynchronizedRefreshFunc(t *testing.T) {
+	testCases := []struct {
+		name   string
+		states []string
+		want   string
+	}{
+		{name: "no relationship", want: DRCopyConsistentSynchronized},
+		{name: "synchronized", states: []string{DRCopyConsistentSynchronized, DRCopyConsistentSynchronized}, want: DRCopyConsistentSynchronized},
+		{name: "copying", states: []string{DRCopyConsistentSynchronized, DRCopyConsistentCopying}, want: DRCopyConsistentCopying},
+		{name: "inconsistent after copying", states: []string{DRCopyConsistentCopying, DRCopyInconsistentCopying}, want: DRCopyInconsistentCopying},
+		{name: "inconsistent before copying", states: []string{DRCopyInconsistentCopying, DRCopyConsistentCopying}, want: DRCopyInconsistentCopying},
+		{name: "stopped", states: []string{DRCopyConsistentCopying, "consistent_stopped", DRCopyConsistentSynchronized}, want: "consistent_stopped"},
+	}
+
+	for _, tc := range testCases {
+		relationships := []*models.RemoteCopyRelationship{}
+		for _, state := range tc.states {
+			relationships = append(relationships, &models.RemoteCopyRelationship{State: state})
+		}
+		sess := piTestSession(t, piTestJSON(t, "/pcloud/v1/cloud-instances/cloud-instance/volume-groups/vg-1/remote-copy-relationships",
+			&models.VolumeGroupRemoteCopyRelationships{RemoteCopyRelationships: relationships}))
+		client := st.NewIBMPIVolumeGroupClient(context.Background(), sess, "cloud-instance")
+
+		_, state, err := isIBMPIVolumeGroupSynchronizedRefreshFunc(client, "vg-1")()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		if state != tc.want {
+			t.Errorf("%s: state = %s, want %s", tc.name, state, tc.want)
+		}
+	}
+}
+
+func TestPIDRMatchOnboardedVolumes(t *testing.T) {
+	auxiliary, primary := true, false
+	targetVolumes := &models.Volumes{Volumes: []*models.VolumeReference{
+		{VolumeID: core.StringPtr("target-1"), Auxiliary: &auxiliary, AuxVolumeName: "aux_data"},
+		{VolumeID: core.StringPtr("target-2"), Auxiliary: &primary, AuxVolumeName: "aux_boot"},
+		{VolumeID: core.StringPtr("target-3"), Auxiliary: &auxiliary},
+	}}
+	sess := piTestSession(t, piTestJSON(t, "/pcloud/v1/cloud-instances/target-instance/volumes", targetVolumes))
+	client := st.NewIBMPIVolumeClient(context.Background(), sess, "target-instance")
+
+	volumes := []piDRVolume{
+		{sourceID: "source-1", auxName: "aux_data"},
+		{sourceID: "source-2", auxName: "aux_boot", targetID: "stale"},
+		{sourceID: "source-3", auxName: "aux_log"},
+	}
+	if err := piDRMatchOnboardedVolumes(client, volumes); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	// Only an auxiliary volume of the target workspace is an onboarded volume
+	want := []string{"target-1", "", ""}
+	for i, vol := range volumes {
+		if vol.targetID != want[i] {
+			t.Errorf("target of %s = %q, want %q", vol.sourceID, vol.targetID, want[i])
+		}
+	}
+}
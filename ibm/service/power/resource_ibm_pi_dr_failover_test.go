@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func TestAccIBMPIDRFailoverBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-dr-failover-%d", acctest.RandIntRange(10, 100))
+	failoverRes := "ibm_pi_dr_failover.power_dr_failover"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIDRFailoverDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIDRFailoverConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIDRFailoverExists(failoverRes),
+					resource.TestCheckResourceAttr(failoverRes, "status", "failed-over"),
+					resource.TestCheckResourceAttr(failoverRes, "completed_steps.#", "4"),
+					resource.TestCheckResourceAttr(failoverRes, "instances.#", "1"),
+					resource.TestCheckResourceAttr(failoverRes, "instances.0.name", name),
+					resource.TestCheckResourceAttrSet(failoverRes, "instances.0.instance_id"),
+					resource.TestCheckResourceAttrSet(failoverRes, "onboarded_volumes.0.volume_id"),
+					resource.TestCheckResourceAttrSet(failoverRes, "replication_status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIDRFailoverExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISessionForZone(rs.Primary.Attributes["pi_target_zone"])
+		if err != nil {
+			return err
+		}
+
+		client := st.NewIBMPIInstanceClient(context.Background(), sess, rs.Primary.Attributes["pi_target_cloud_instance_id"])
+		_, err = client.Get(rs.Primary.Attributes["instances.0.instance_id"])
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMPIDRFailoverDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_dr_failover" {
+			continue
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISessionForZone(rs.Primary.Attributes["pi_target_zone"])
+		if err != nil {
+			return err
+		}
+		client := st.NewIBMPIInstanceClient(context.Background(), sess, rs.Primary.Attributes["pi_target_cloud_instance_id"])
+		_, err = client.Get(rs.Primary.Attributes["instances.0.instance_id"])
+		if err == nil {
+			return fmt.Errorf("replacement instance still exists: %s", rs.Primary.Attributes["instances.0.instance_id"])
+		}
+
+		sourceSess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+		ids, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		cloudInstanceID, vgID := ids[0], ids[1]
+		vg, err := st.NewIBMPIVolumeGroupClient(context.Background(), sourceSess, cloudInstanceID).GetDetails(vgID)
+		if err != nil {
+			return err
+		}
+		if vg.Status != "available" {
+			return fmt.Errorf("volume group %s is %s after failback", vgID, vg.Status)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMPIDRFailoverConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_dr_failover" "power_dr_failover" {
+		pi_cloud_instance_id        = "%[1]s"
+		pi_volume_group_id          = "%[2]s"
+		pi_source_crn               = "%[3]s"
+		pi_target_cloud_instance_id = "%[4]s"
+		pi_target_zone              = "%[5]s"
+
+		pi_instances {
+			pi_source_instance_id = "%[6]s"
+			pi_instance_name      = "%[7]s"
+			pi_image_id           = "%[8]s"
+			pi_network_ids        = ["%[9]s"]
+		}
+	}`, acc.Pi_cloud_instance_id, acc.Pi_volume_group_id, acc.Pi_volume_onboarding_source_crn, acc.Pi_dr_target_cloud_instance_id,
+		acc.Pi_dr_target_zone, acc.Pi_dr_source_instance_id, name, acc.Pi_dr_target_image_id, acc.Pi_dr_target_network_id)
+}
@@ -6,6 +6,7 @@ package power
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -17,6 +18,8 @@ import (
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_p_vm_instances"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -29,6 +32,7 @@ func ResourceIBMPIInstance() *schema.Resource {
 		ReadContext:   resourceIBMPIInstanceRead,
 		UpdateContext: resourceIBMPIInstanceUpdate,
 		DeleteContext: resourceIBMPIInstanceDelete,
+		CustomizeDiff: piInstanceCapacityCustomizeDiff,
 		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -272,11 +276,12 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Description: "Custom SAP Deployment Type Information",
 			},
 			helpers.PIInstanceSystemType: {
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Optional:    true,
-				Computed:    true,
-				Description: "PI Instance system type",
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_pi_instance", helpers.PIInstanceSystemType),
+				Description:  "PI Instance system type",
 			},
 			helpers.PIInstanceReplicants: {
 				Type:        schema.TypeInt,
@@ -285,6 +290,11 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Default:     1,
 				Description: "PI Instance replicas count",
 			},
+			Arg_SkipCapacityCheck: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Skip the plan time check of the system pool and storage capacity for the processors, memory and image size of the instance",
+			},
 			helpers.PIInstanceReplicationPolicy: {
 				Type:         schema.TypeString,
 				ForceNew:     true,
@@ -336,6 +346,63 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Computed:    true,
 				Description: "Virtual Cores Assigned to the PVMInstance",
 			},
+			Attr_Instances: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The instances created for pi_replicants, the first one is the instance of the resource ID",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_ID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the instance",
+						},
+						Attr_Name: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the instance",
+						},
+						Attr_Status: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the instance",
+						},
+						Attr_Networks: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The networks of the instance",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"mac_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"network_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"network_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"external_ip": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"max_virtual_cores": {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -350,6 +417,23 @@ func ResourceIBMPIInstance() *schema.Resource {
 	}
 }
 
+func ResourceIBMPIInstanceValidator() *validate.ResourceValidator {
+
+	validateSchema := make([]validate.ValidateSchema, 0)
+
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 helpers.PIInstanceSystemType,
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              validate.CloudDataTypePISysType,
+			Optional:                   true})
+	ibmPIInstanceResourceValidator := validate.ResourceValidator{
+		ResourceName: "ibm_pi_instance",
+		Schema:       validateSchema}
+	return &ibmPIInstanceResourceValidator
+}
+
 func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("Now in the PowerVMCreate")
 	sess, err := meta.(conns.ClientSession).IBMPISession()
@@ -378,6 +462,16 @@ func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *(*pvmList)[0].PvmInstanceID))
 
+	// Track every replicant as soon as it is created so that none is orphaned
+	instances := make([]map[string]interface{}, 0, len(*pvmList))
+	for _, s := range *pvmList {
+		instances = append(instances, map[string]interface{}{
+			Attr_ID:   *s.PvmInstanceID,
+			Attr_Name: *s.ServerName,
+		})
+	}
+	d.Set(Attr_Instances, instances)
+
 	for _, s := range *pvmList {
 		_, err = isWaitForPIInstanceAvailable(ctx, client, *s.PvmInstanceID, instanceReadyStatus)
 		if err != nil {
@@ -446,23 +540,7 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set(Arg_PIInstanceSharedProcessorPool, powervmdata.SharedProcessorPool)
 	d.Set(Attr_PIInstanceSharedProcessorPoolID, powervmdata.SharedProcessorPoolID)
 
-	networksMap := []map[string]interface{}{}
-	if powervmdata.Networks != nil {
-		for _, n := range powervmdata.Networks {
-			if n != nil {
-				v := map[string]interface{}{
-					"ip_address":   n.IPAddress,
-					"mac_address":  n.MacAddress,
-					"network_id":   n.NetworkID,
-					"network_name": n.NetworkName,
-					"type":         n.Type,
-					"external_ip":  n.ExternalIP,
-				}
-				networksMap = append(networksMap, v)
-			}
-		}
-	}
-	d.Set(PIInstanceNetwork, networksMap)
+	d.Set(PIInstanceNetwork, flattenPIInstanceNetworks(powervmdata.Networks))
 
 	if powervmdata.SapProfile != nil && powervmdata.SapProfile.ProfileID != nil {
 		d.Set(PISAPInstanceProfileID, powervmdata.SapProfile.ProfileID)
@@ -487,16 +565,103 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 	d.Set(helpers.PIInstanceLicenseRepositoryCapacity, powervmdata.LicenseRepositoryCapacity)
 	d.Set(PIInstanceDeploymentType, powervmdata.DeploymentType)
+
+	instances := []map[string]interface{}{}
+	for _, replicant := range piInstanceReplicants(d) {
+		replicantdata := powervmdata
+		if replicant.id != instanceID {
+			replicantdata, err = client.Get(replicant.id)
+			if err != nil {
+				if isPIInstanceNotFound(err) {
+					log.Printf("[DEBUG] replicant %s of the lpar %s does not exist", replicant.id, instanceID)
+					continue
+				}
+				return diag.FromErr(err)
+			}
+		}
+		instance := map[string]interface{}{
+			Attr_ID:       *replicantdata.PvmInstanceID,
+			Attr_Name:     *replicantdata.ServerName,
+			Attr_Networks: flattenPIInstanceNetworks(replicantdata.Networks),
+		}
+		if replicantdata.Status != nil {
+			instance[Attr_Status] = *replicantdata.Status
+		}
+		instances = append(instances, instance)
+	}
+	d.Set(Attr_Instances, instances)
 	return nil
 }
 
-func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func flattenPIInstanceNetworks(networks []*models.PVMInstanceNetwork) []map[string]interface{} {
+	networksMap := []map[string]interface{}{}
+	for _, n := range networks {
+		if n != nil {
+			v := map[string]interface{}{
+				"ip_address":   n.IPAddress,
+				"mac_address":  n.MacAddress,
+				"network_id":   n.NetworkID,
+				"network_name": n.NetworkName,
+				"type":         n.Type,
+				"external_ip":  n.ExternalIP,
+			}
+			networksMap = append(networksMap, v)
+		}
+	}
+	return networksMap
+}
 
-	name := d.Get(helpers.PIInstanceName).(string)
-	mem := d.Get(helpers.PIInstanceMemory).(float64)
-	procs := d.Get(helpers.PIInstanceProcessors).(float64)
-	processortype := d.Get(helpers.PIInstanceProcType).(string)
-	assignedVirtualCores := int64(d.Get(helpers.PIVirtualCoresAssigned).(int))
+// piInstanceReplicant is one of the instances created for pi_replicants
+type piInstanceReplicant struct {
+	id     string
+	name   string
+	status string
+}
+
+// piInstanceReplicants returns the replicants of the instance in the state,
+// or the instance of the resource ID alone if they are not known, e.g. after
+// an import or an upgrade of the provider
+func piInstanceReplicants(d *schema.ResourceData) []piInstanceReplicant {
+	replicants := []piInstanceReplicant{}
+	for _, v := range d.Get(Attr_Instances).([]interface{}) {
+		instance := v.(map[string]interface{})
+		replicants = append(replicants, piInstanceReplicant{
+			id:     instance[Attr_ID].(string),
+			name:   instance[Attr_Name].(string),
+			status: instance[Attr_Status].(string),
+		})
+	}
+	if len(replicants) == 0 {
+		_, instanceID, _ := splitID(d.Id())
+		oldName, _ := d.GetChange(helpers.PIInstanceName)
+		replicants = append(replicants, piInstanceReplicant{
+			id:     instanceID,
+			name:   oldName.(string),
+			status: d.Get("status").(string),
+		})
+	}
+	return replicants
+}
+
+// piInstanceReplicantName returns the name of a replicant named name after
+// the instance is renamed from oldName to newName. The replicants are named
+// after the instance with a prefix or a suffix, depending on
+// pi_replication_scheme, which is kept. A replicant that was renamed outside
+// of terraform is named newName.
+func piInstanceReplicantName(name, oldName, newName string) string {
+	if !strings.Contains(name, oldName) {
+		return newName
+	}
+	return strings.Replace(name, oldName, newName, 1)
+}
+
+func isPIInstanceNotFound(err error) bool {
+	var getNotFound *p_cloud_p_vm_instances.PcloudPvminstancesGetNotFound
+	var deleteNotFound *p_cloud_p_vm_instances.PcloudPvminstancesDeleteNotFound
+	return errors.As(err, &getNotFound) || errors.As(err, &deleteNotFound)
+}
+
+func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.Get("health_status") == "WARNING" {
 		return diag.Errorf("the operation cannot be performed when the lpar health in the WARNING State")
@@ -507,7 +672,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("failed to get the session from the IBM Cloud Service")
 	}
 
-	cloudInstanceID, instanceID, err := splitID(d.Id())
+	cloudInstanceID, _, err := splitID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -522,29 +687,51 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 	cores_enabled := checkCloudInstanceCapability(cloudInstance, CUSTOM_VIRTUAL_CORES)
 
+	// The replicants are identical, every change is made to each of them
+	for _, replicant := range piInstanceReplicants(d) {
+		err = updatePIInstanceReplicant(ctx, d, sess, client, cloudInstanceID, replicant, cores_enabled)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMPIInstanceRead(ctx, d, meta)
+
+}
+
+// updatePIInstanceReplicant makes the changes of the configuration to one
+// replicant of the instance
+func updatePIInstanceReplicant(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, client *st.IBMPIInstanceClient, cloudInstanceID string, replicant piInstanceReplicant, cores_enabled bool) error {
+	mem := d.Get(helpers.PIInstanceMemory).(float64)
+	procs := d.Get(helpers.PIInstanceProcessors).(float64)
+	processortype := d.Get(helpers.PIInstanceProcType).(string)
+	assignedVirtualCores := int64(d.Get(helpers.PIVirtualCoresAssigned).(int))
+
+	var err error
 	if d.HasChange(helpers.PIInstanceName) {
+		oldName, newName := d.GetChange(helpers.PIInstanceName)
 		body := &models.PVMInstanceUpdate{
-			ServerName: name,
+			ServerName: piInstanceReplicantName(replicant.name, oldName.(string), newName.(string)),
 		}
-		_, err = client.Update(instanceID, body)
+		_, err = client.Update(replicant.id, body)
 		if err != nil {
-			return diag.Errorf("failed to update the lpar with the change for name: %v", err)
+			return fmt.Errorf("failed to update the lpar with the change for name: %v", err)
 		}
-		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
+		_, err = isWaitForPIInstanceAvailable(ctx, client, replicant.id, "OK")
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
 
 	if d.HasChange(helpers.PIInstanceProcType) {
 
 		// Stop the lpar
-		if d.Get("status") == "SHUTOFF" {
+		if replicant.status == "SHUTOFF" {
 			log.Printf("the lpar is in the shutoff state. Nothing to do . Moving on ")
 		} else {
-			err := stopLparForResourceChange(ctx, client, instanceID)
+			err := stopLparForResourceChange(ctx, client, replicant.id)
 			if err != nil {
-				return diag.FromErr(err)
+				return err
 			}
 		}
 
@@ -557,19 +744,19 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		} else {
 			log.Printf("no virtual cores support enabled for this customer..")
 		}
-		_, err = client.Update(instanceID, updatebody)
+		_, err = client.Update(replicant.id, updatebody)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
-		_, err = isWaitForPIInstanceStopped(ctx, client, instanceID)
+		_, err = isWaitForPIInstanceStopped(ctx, client, replicant.id)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 
 		// Start the lpar
-		err := startLparAfterResourceChange(ctx, client, instanceID)
+		err := startLparAfterResourceChange(ctx, client, replicant.id)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
 
@@ -578,13 +765,13 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		body := &models.PVMInstanceUpdate{
 			VirtualCores: &models.VirtualCores{Assigned: &assignedVirtualCores},
 		}
-		_, err = client.Update(instanceID, body)
+		_, err = client.Update(replicant.id, body)
 		if err != nil {
-			return diag.Errorf("failed to update the lpar with the change for virtual cores: %v", err)
+			return fmt.Errorf("failed to update the lpar with the change for virtual cores: %v", err)
 		}
-		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
+		_, err = isWaitForPIInstanceAvailable(ctx, client, replicant.id, "OK")
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
 
@@ -604,13 +791,13 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 
 		//if d.GetOkExists("reboot_for_resource_change")
 
-		instanceState := d.Get("status")
+		instanceState := replicant.status
 		log.Printf("the instance state is %s", instanceState)
 
 		if (mem > maxMemLpar || procs > maxCPULpar) && instanceState != "SHUTOFF" {
-			err = performChangeAndReboot(ctx, client, instanceID, cloudInstanceID, mem, procs)
+			err = performChangeAndReboot(ctx, client, replicant.id, cloudInstanceID, mem, procs)
 			if err != nil {
-				return diag.FromErr(err)
+				return err
 			}
 
 		} else {
@@ -625,19 +812,19 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 				log.Printf("no virtual cores support enabled for this customer..")
 			}
 
-			_, err = client.Update(instanceID, body)
+			_, err = client.Update(replicant.id, body)
 			if err != nil {
-				return diag.Errorf("failed to update the lpar with the change %v", err)
+				return fmt.Errorf("failed to update the lpar with the change %v", err)
 			}
 			if instanceState == "SHUTOFF" {
-				_, err = isWaitforPIInstanceUpdate(ctx, client, instanceID)
+				_, err = isWaitforPIInstanceUpdate(ctx, client, replicant.id)
 				if err != nil {
-					return diag.FromErr(err)
+					return err
 				}
 			} else {
-				_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
+				_, err = isWaitForPIInstanceAvailable(ctx, client, replicant.id, "OK")
 				if err != nil {
-					return diag.FromErr(err)
+					return err
 				}
 			}
 		}
@@ -651,24 +838,24 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		body := &models.PVMInstanceUpdate{
 			LicenseRepositoryCapacity: lrc,
 		}
-		_, err = client.Update(instanceID, body)
+		_, err = client.Update(replicant.id, body)
 		if err != nil {
-			return diag.Errorf("failed to update the lpar with the change for license repository capacity %s", err)
+			return fmt.Errorf("failed to update the lpar with the change for license repository capacity %s", err)
 		}
-		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
+		_, err = isWaitForPIInstanceAvailable(ctx, client, replicant.id, "OK")
 		if err != nil {
-			diag.FromErr(err)
+			return err
 		}
 	}
 
 	if d.HasChange(PISAPInstanceProfileID) {
 		// Stop the lpar
-		if d.Get("status") == "SHUTOFF" {
+		if replicant.status == "SHUTOFF" {
 			log.Printf("the lpar is in the shutoff state. Nothing to do... Moving on ")
 		} else {
-			err := stopLparForResourceChange(ctx, client, instanceID)
+			err := stopLparForResourceChange(ctx, client, replicant.id)
 			if err != nil {
-				return diag.FromErr(err)
+				return err
 			}
 		}
 
@@ -677,21 +864,21 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		body := &models.PVMInstanceUpdate{
 			SapProfileID: profileID,
 		}
-		_, err = client.Update(instanceID, body)
+		_, err = client.Update(replicant.id, body)
 		if err != nil {
-			return diag.Errorf("failed to update the lpar with the change for sap profile: %v", err)
+			return fmt.Errorf("failed to update the lpar with the change for sap profile: %v", err)
 		}
 
 		// Wait for the resize to complete and status to reset
-		_, err = isWaitForPIInstanceStopped(ctx, client, instanceID)
+		_, err = isWaitForPIInstanceStopped(ctx, client, replicant.id)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 
 		// Start the lpar
-		err := startLparAfterResourceChange(ctx, client, instanceID)
+		err := startLparAfterResourceChange(ctx, client, replicant.id)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
 	if d.HasChange(PIInstanceStoragePoolAffinity) {
@@ -700,9 +887,9 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 			StoragePoolAffinity: &storagePoolAffinity,
 		}
 		// This is a synchronous process hence no need to check for health status
-		_, err = client.Update(instanceID, body)
+		_, err = client.Update(replicant.id, body)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 	}
 
@@ -718,13 +905,13 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 			placementGroupID := old
 			//remove server from old placement group
 			body := &models.PlacementGroupServer{
-				ID: &instanceID,
+				ID: &replicant.id,
 			}
 			_, err := pgClient.DeleteMember(placementGroupID, body)
 			if err != nil {
 				// ignore delete member error where the server is already not in the PG
 				if !strings.Contains(err.Error(), "is not part of placement-group") {
-					return diag.FromErr(err)
+					return err
 				}
 			}
 		}
@@ -733,17 +920,16 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 			placementGroupID := new
 			// add server to a new placement group
 			body := &models.PlacementGroupServer{
-				ID: &instanceID,
+				ID: &replicant.id,
 			}
 			_, err := pgClient.AddMember(placementGroupID, body)
 			if err != nil {
-				return diag.FromErr(err)
+				return err
 			}
 		}
 	}
 
-	return resourceIBMPIInstanceRead(ctx, d, meta)
-
+	return nil
 }
 
 func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -752,20 +938,29 @@ func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
-	cloudInstanceID, instanceID, err := splitID(d.Id())
+	cloudInstanceID, _, err := splitID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
-	err = client.Delete(instanceID)
-	if err != nil {
-		return diag.FromErr(err)
+	replicants := piInstanceReplicants(d)
+	for _, replicant := range replicants {
+		err = client.Delete(replicant.id)
+		if err != nil {
+			if isPIInstanceNotFound(err) {
+				log.Printf("[DEBUG] lpar %s does not exist", replicant.id)
+				continue
+			}
+			return diag.FromErr(err)
+		}
 	}
 
-	_, err = isWaitForPIInstanceDeleted(ctx, client, instanceID)
-	if err != nil {
-		return diag.FromErr(err)
+	for _, replicant := range replicants {
+		_, err = isWaitForPIInstanceDeleted(ctx, client, replicant.id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	d.SetId("")
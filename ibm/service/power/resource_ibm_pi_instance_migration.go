@@ -0,0 +1,703 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// piInstanceMigrationSteps are the steps of a migration, in order
+var piInstanceMigrationSteps = []string{MigrationStepCapture, MigrationStepImport, MigrationStepDeploy, MigrationStepCleanup}
+
+func ResourceIBMPIInstanceMigration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIInstanceMigrationCreate,
+		ReadContext:   resourceIBMPIInstanceMigrationRead,
+		UpdateContext: resourceIBMPIInstanceMigrationUpdate,
+		DeleteContext: resourceIBMPIInstanceMigrationDelete,
+		CustomizeDiff: resourceIBMPIInstanceMigrationCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Minute),
+			Update: schema.DefaultTimeout(240 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The GUID of the source service instance associated with an account.",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_MigrationSourceInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The ID or name of the source instance to migrate.",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_MigrationTargetCloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The GUID of the target service instance to migrate the instance to.",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_MigrationTargetZone: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The zone of the target workspace. The zone of the provider is used if not provided.",
+			},
+			Arg_MigrationNetworkMapping: {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: "The networks of the target workspace replacing each network of the source instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Arg_MigrationSourceNetworkID: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The ID of a network of the source instance.",
+							ValidateFunc: validation.NoZeroValues,
+						},
+						Arg_MigrationTargetNetworkID: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The ID of the network of the target workspace replacing it.",
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			Arg_MigrationKeepIPAddresses: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Keeps the IP addresses of the source instance on the target networks.",
+			},
+			helpers.PIInstanceCaptureCloudStorageRegion: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The Cloud Storage region of the bucket staging the capture.",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			helpers.PIInstanceCaptureCloudStorageImagePath: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The Cloud Storage image path staging the capture (bucket-name [/folder/../..]).",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			helpers.PIInstanceCaptureCloudStorageAccessKey: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "The Cloud Storage access key.",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			helpers.PIInstanceCaptureCloudStorageSecretKey: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "The Cloud Storage secret key.",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_MigrationCleanupCOSObjects: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Deletes the capture from Cloud Storage once the instance is deployed.",
+			},
+			Arg_MigrationDeleteInstanceOnDestroy: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Deletes the migrated instance on destroy. The instance of an incomplete migration is always deleted.",
+			},
+			helpers.PIInstanceName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of the migrated instance. The name of the source instance is used if not provided.",
+			},
+			helpers.PIInstanceSSHKeyName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The SSH key name of the migrated instance.",
+			},
+			helpers.PIInstanceMemory: {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The memory of the migrated instance in GB. The memory of the source instance is used if not provided.",
+			},
+			helpers.PIInstanceProcessors: {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The processors of the migrated instance. The processors of the source instance are used if not provided.",
+			},
+			helpers.PIInstanceProcType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The processor type of the migrated instance. The processor type of the source instance is used if not provided.",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"dedicated", "shared", "capped"}),
+			},
+			helpers.PIInstanceSystemType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The system type of the migrated instance. The system type of the source instance is used if not provided.",
+			},
+			helpers.PIInstanceStorageType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The storage type of the imported image and of the migrated instance. The storage type of the source instance is used if not provided.",
+			},
+
+			// Attributes
+			Attr_MigrationCaptureName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the capture of the source instance, and of the imported image.",
+			},
+			Attr_MigrationCompletedSteps: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The completed steps of the migration, in order.",
+			},
+			Attr_MigrationCOSObject: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Cloud Storage object of the capture, relative to the image path.",
+			},
+			Attr_MigrationCurrentStep: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The step of the migration in progress or failed.",
+			},
+			Attr_ImageID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the imported image in the target workspace.",
+			},
+			Attr_MigrationInstanceID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the migrated instance in the target workspace.",
+			},
+			Attr_MigrationInstanceStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the migrated instance.",
+			},
+			Attr_Progress: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The percentage of the completed steps of the migration.",
+			},
+			Attr_MigrationSourceInstanceName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the source instance.",
+			},
+			Attr_Status: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the migration, in-progress, completed or failed.",
+			},
+		},
+	}
+}
+
+func resourceIBMPIInstanceMigrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, targetSess, err := piInstanceMigrationSessions(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	sourceID := d.Get(Arg_MigrationSourceInstanceID).(string)
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, sourceID))
+
+	diags := piInstanceMigrationRun(ctx, d, sess, targetSess, d.Timeout(schema.TimeoutCreate))
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, resourceIBMPIInstanceMigrationRead(ctx, d, meta)...)
+}
+
+func resourceIBMPIInstanceMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, targetSess, err := piInstanceMigrationSessions(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, sourceID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_MigrationSourceInstanceID, sourceID)
+
+	id := d.Get(Attr_MigrationInstanceID).(string)
+	if id == "" {
+		return nil
+	}
+	targetClient := st.NewIBMPIInstanceClient(ctx, targetSess, d.Get(Arg_MigrationTargetCloudInstanceID).(string))
+	pvm, err := targetClient.Get(id)
+	if err != nil {
+		if isPIInstanceNotFound(err) {
+			log.Printf("[DEBUG] migrated lpar %s does not exist", id)
+			d.Set(Attr_MigrationInstanceStatus, "")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if pvm.Status != nil {
+		d.Set(Attr_MigrationInstanceStatus, *pvm.Status)
+	}
+
+	return nil
+}
+
+func resourceIBMPIInstanceMigrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// An incomplete migration is resumed from its first step not completed
+	if d.Get(Attr_Status).(string) != MigrationStatusComplete {
+		sess, targetSess, err := piInstanceMigrationSessions(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		diags := piInstanceMigrationRun(ctx, d, sess, targetSess, d.Timeout(schema.TimeoutUpdate))
+		if diags.HasError() {
+			return diags
+		}
+		return append(diags, resourceIBMPIInstanceMigrationRead(ctx, d, meta)...)
+	}
+	return resourceIBMPIInstanceMigrationRead(ctx, d, meta)
+}
+
+func resourceIBMPIInstanceMigrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, targetSess, err := piInstanceMigrationSessions(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	targetCloudInstanceID := d.Get(Arg_MigrationTargetCloudInstanceID).(string)
+
+	id := d.Get(Attr_MigrationInstanceID).(string)
+	if id != "" && (d.Get(Attr_Status).(string) != MigrationStatusComplete || d.Get(Arg_MigrationDeleteInstanceOnDestroy).(bool)) {
+		targetClient := st.NewIBMPIInstanceClient(ctx, targetSess, targetCloudInstanceID)
+		err := targetClient.Delete(id)
+		if err != nil && !isPIInstanceNotFound(err) {
+			return diag.FromErr(err)
+		}
+		if err == nil {
+			_, err = isWaitForPIInstanceDeleted(ctx, targetClient, id)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		d.Set(Attr_MigrationInstanceID, "")
+	}
+
+	if imageID := d.Get(Attr_ImageID).(string); imageID != "" {
+		imageClient := st.NewIBMPIImageClient(ctx, targetSess, targetCloudInstanceID)
+		err := imageClient.Delete(imageID)
+		if err != nil && !piCapturePolicyImageNotFound(err) {
+			return diag.FromErr(err)
+		}
+		d.Set(Attr_ImageID, "")
+	}
+
+	if d.Get(Arg_MigrationCleanupCOSObjects).(bool) && !piInstanceMigrationStepCompleted(d, MigrationStepCleanup) {
+		if err := piInstanceMigrationCleanup(d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMPIInstanceMigrationCustomizeDiff plans an update of an
+// incomplete migration so that apply resumes it
+func resourceIBMPIInstanceMigrationCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if status := diff.Get(Attr_Status).(string); status != "" && status != MigrationStatusComplete {
+		return diff.SetNewComputed(Attr_Status)
+	}
+	return nil
+}
+
+// piInstanceMigrationSessions returns the sessions of the source and the target workspace
+func piInstanceMigrationSessions(d *schema.ResourceData, meta interface{}) (*ibmpisession.IBMPISession, *ibmpisession.IBMPISession, error) {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return nil, nil, err
+	}
+	targetSess, err := meta.(conns.ClientSession).IBMPISessionForZone(d.Get(Arg_MigrationTargetZone).(string))
+	if err != nil {
+		return nil, nil, err
+	}
+	return sess, targetSess, nil
+}
+
+// piInstanceMigrationRun runs the steps of the migration not completed yet
+// and records its status. A failed migration is kept in the state with its
+// completed steps, so that it is resumed from the failed step. A migration
+// failing on create is tainted, it must be untainted to be resumed rather
+// than destroyed and started over.
+func piInstanceMigrationRun(ctx context.Context, d *schema.ResourceData, sess, targetSess *ibmpisession.IBMPISession, timeout time.Duration) diag.Diagnostics {
+	d.Set(Attr_Status, MigrationStatusRunning)
+	err := piInstanceMigrate(ctx, d, sess, targetSess, timeout)
+	if err != nil {
+		d.Set(Attr_Status, MigrationStatusFailed)
+		resume := "apply again to resume it"
+		if d.IsNewResource() {
+			resume = "run terraform untaint on the resource and apply again to resume it, applying without untainting destroys the partial migration and starts it over"
+		}
+		return diag.Errorf("migration of lpar %s failed at step %s after steps %v, %s: %v",
+			d.Get(Arg_MigrationSourceInstanceID), d.Get(Attr_MigrationCurrentStep), d.Get(Attr_MigrationCompletedSteps), resume, err)
+	}
+	d.Set(Attr_Status, MigrationStatusComplete)
+	d.Set(Attr_MigrationCurrentStep, "")
+	return nil
+}
+
+// piInstanceMigrate captures the source instance to Cloud Storage, imports
+// the capture in the target workspace, deploys the migrated instance from the
+// imported image and deletes the capture from Cloud Storage. Each step is
+// recorded in completed_steps once done and skipped when resumed.
+func piInstanceMigrate(ctx context.Context, d *schema.ResourceData, sess, targetSess *ibmpisession.IBMPISession, timeout time.Duration) error {
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	source, err := client.Get(d.Get(Arg_MigrationSourceInstanceID).(string))
+	if err != nil {
+		return err
+	}
+	d.Set(Attr_MigrationSourceInstanceName, source.ServerName)
+
+	for _, step := range piInstanceMigrationSteps {
+		if piInstanceMigrationStepCompleted(d, step) {
+			continue
+		}
+		d.Set(Attr_MigrationCurrentStep, step)
+		switch step {
+		case MigrationStepCapture:
+			err = piInstanceMigrationCapture(ctx, d, sess, source, timeout)
+		case MigrationStepImport:
+			err = piInstanceMigrationImport(ctx, d, targetSess, source, timeout)
+		case MigrationStepDeploy:
+			err = piInstanceMigrationDeploy(ctx, d, targetSess, source)
+		case MigrationStepCleanup:
+			if d.Get(Arg_MigrationCleanupCOSObjects).(bool) {
+				err = piInstanceMigrationCleanup(d)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		piInstanceMigrationCompleteStep(d, step)
+	}
+	return nil
+}
+
+// piInstanceMigrationCapture captures the source instance with its data
+// volumes to Cloud Storage. A capture of a previous attempt is reused if its
+// image file is found, otherwise its objects are deleted before capturing
+// again under a new name.
+func piInstanceMigrationCapture(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, source *models.PVMInstance, timeout time.Duration) error {
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	imagePath := d.Get(helpers.PIInstanceCaptureCloudStorageImagePath).(string)
+	cosClient, err := piInstanceMigrationCOSClient(d)
+	if err != nil {
+		return err
+	}
+
+	if capturename := d.Get(Attr_MigrationCaptureName).(string); capturename != "" {
+		file, err := piCOSFindImageFile(cosClient, imagePath, capturename)
+		if err != nil {
+			return err
+		}
+		if file != "" {
+			log.Printf("[DEBUG] reusing capture %s of lpar %s", file, *source.PvmInstanceID)
+			d.Set(Attr_MigrationCOSObject, file)
+			return nil
+		}
+		log.Printf("[DEBUG] deleting the objects of the incomplete capture %s of lpar %s", capturename, *source.PvmInstanceID)
+		if err := piCOSDeleteObjects(cosClient, imagePath, capturename); err != nil {
+			return err
+		}
+		d.Set(Attr_MigrationCaptureName, "")
+	}
+
+	capturename := fmt.Sprintf("%s-%s", piInstanceMigrationName(d, source), time.Now().UTC().Format(piCapturePolicyTimeFormat))
+	capturedestination := cloudStorageDestination
+	captureBody := &models.PVMInstanceCapture{
+		CaptureDestination:    &capturedestination,
+		CaptureName:           &capturename,
+		CloudStorageRegion:    d.Get(helpers.PIInstanceCaptureCloudStorageRegion).(string),
+		CloudStorageImagePath: imagePath,
+		CloudStorageAccessKey: d.Get(helpers.PIInstanceCaptureCloudStorageAccessKey).(string),
+		CloudStorageSecretKey: d.Get(helpers.PIInstanceCaptureCloudStorageSecretKey).(string),
+	}
+	volClient := st.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	volumes, err := volClient.GetAllInstanceVolumes(*source.PvmInstanceID)
+	if err != nil {
+		return err
+	}
+	for _, vol := range volumes.Volumes {
+		if vol.BootVolume == nil || !*vol.BootVolume {
+			captureBody.CaptureVolumeIDs = append(captureBody.CaptureVolumeIDs, *vol.VolumeID)
+		}
+	}
+
+	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	captureResponse, err := client.CaptureInstanceToImageCatalogV2(*source.PvmInstanceID, captureBody)
+	if err != nil {
+		return err
+	}
+	d.Set(Attr_MigrationCaptureName, capturename)
+	jobClient := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+	_, err = waitForIBMPIJobCompleted(ctx, jobClient, *captureResponse.ID, timeout)
+	if err != nil {
+		return err
+	}
+
+	file, err := piCOSFindImageFile(cosClient, imagePath, capturename)
+	if err != nil {
+		return err
+	}
+	if file == "" {
+		return fmt.Errorf("image file of capture %s is not found in %s", capturename, imagePath)
+	}
+	d.Set(Attr_MigrationCOSObject, file)
+	return nil
+}
+
+// piInstanceMigrationImport imports the capture from Cloud Storage in the
+// target workspace. An image of a previous attempt is reused if found, once
+// available if it is still being imported. The capture is imported only when
+// the image is not found, any other error fails the step.
+func piInstanceMigrationImport(ctx context.Context, d *schema.ResourceData, targetSess *ibmpisession.IBMPISession, source *models.PVMInstance, timeout time.Duration) error {
+	targetCloudInstanceID := d.Get(Arg_MigrationTargetCloudInstanceID).(string)
+	imageClient := st.NewIBMPIImageClient(ctx, targetSess, targetCloudInstanceID)
+	imageName := d.Get(Attr_MigrationCaptureName).(string)
+
+	image, err := imageClient.Get(imageName)
+	if err != nil && !piCapturePolicyImageNotFound(err) {
+		return err
+	}
+	if err == nil {
+		if image.State != helpers.PIImageActiveStatus {
+			log.Printf("[DEBUG] image %s is %s, waiting for its import in %s", *image.ImageID, image.State, targetCloudInstanceID)
+			_, err = isWaitForIBMPIImageAvailable(ctx, imageClient, *image.ImageID, timeout)
+			if err != nil {
+				return err
+			}
+		}
+		log.Printf("[DEBUG] reusing image %s imported in %s", *image.ImageID, targetCloudInstanceID)
+		d.Set(Attr_ImageID, *image.ImageID)
+		return nil
+	}
+
+	bucketName := d.Get(helpers.PIInstanceCaptureCloudStorageImagePath).(string)
+	bucketAccess := "private"
+	imageFilename := d.Get(Attr_MigrationCOSObject).(string)
+	region := d.Get(helpers.PIInstanceCaptureCloudStorageRegion).(string)
+	body := &models.CreateCosImageImportJob{
+		ImageName:     &imageName,
+		BucketName:    &bucketName,
+		BucketAccess:  &bucketAccess,
+		ImageFilename: &imageFilename,
+		Region:        &region,
+		AccessKey:     d.Get(helpers.PIInstanceCaptureCloudStorageAccessKey).(string),
+		SecretKey:     d.Get(helpers.PIInstanceCaptureCloudStorageSecretKey).(string),
+		StorageType:   piInstanceMigrationStorageType(d, source),
+	}
+	imageResponse, err := imageClient.CreateCosImage(body)
+	if err != nil {
+		return err
+	}
+	jobClient := st.NewIBMPIJobClient(ctx, targetSess, targetCloudInstanceID)
+	_, err = waitForIBMPIJobCompleted(ctx, jobClient, *imageResponse.ID, timeout)
+	if err != nil {
+		return err
+	}
+
+	// Once the job is completed find by name
+	image, err = imageClient.Get(imageName)
+	if err != nil {
+		return err
+	}
+	d.Set(Attr_ImageID, *image.ImageID)
+	return nil
+}
+
+// piInstanceMigrationDeploy deploys the migrated instance from the imported
+// image with the sizing of the source instance and its networks mapped to the
+// target networks. An instance of a previous attempt is reused if found.
+func piInstanceMigrationDeploy(ctx context.Context, d *schema.ResourceData, targetSess *ibmpisession.IBMPISession, source *models.PVMInstance) error {
+	targetClient := st.NewIBMPIInstanceClient(ctx, targetSess, d.Get(Arg_MigrationTargetCloudInstanceID).(string))
+
+	if id := d.Get(Attr_MigrationInstanceID).(string); id != "" {
+		_, err := targetClient.Get(id)
+		if err == nil {
+			log.Printf("[DEBUG] reusing migrated lpar %s", id)
+			_, err = isWaitForPIInstanceAvailable(ctx, targetClient, id, helpers.PIInstanceHealthOk)
+			return err
+		}
+		if !isPIInstanceNotFound(err) {
+			return err
+		}
+		d.Set(Attr_MigrationInstanceID, "")
+	}
+
+	networks, err := piInstanceMigrationNetworks(d, source)
+	if err != nil {
+		return err
+	}
+	body := &models.PVMInstanceCreate{
+		ServerName:  sl.String(piInstanceMigrationName(d, source)),
+		ImageID:     sl.String(d.Get(Attr_ImageID).(string)),
+		Memory:      source.Memory,
+		Processors:  source.Processors,
+		ProcType:    source.ProcType,
+		SysType:     source.SysType,
+		KeyPairName: d.Get(helpers.PIInstanceSSHKeyName).(string),
+		StorageType: piInstanceMigrationStorageType(d, source),
+		Networks:    networks,
+	}
+	if mem := d.Get(helpers.PIInstanceMemory).(float64); mem > 0 {
+		body.Memory = &mem
+	}
+	if procs := d.Get(helpers.PIInstanceProcessors).(float64); procs > 0 {
+		body.Processors = &procs
+	}
+	if procType := d.Get(helpers.PIInstanceProcType).(string); procType != "" {
+		body.ProcType = &procType
+	}
+	if sysType := d.Get(helpers.PIInstanceSystemType).(string); sysType != "" {
+		body.SysType = sysType
+	}
+
+	pvmList, err := targetClient.Create(body)
+	if err != nil {
+		return fmt.Errorf("failed to provision the migrated lpar of %s: %v", *source.PvmInstanceID, err)
+	}
+	if pvmList == nil || len(*pvmList) == 0 {
+		return fmt.Errorf("failed to provision the migrated lpar of %s", *source.PvmInstanceID)
+	}
+	id := *(*pvmList)[0].PvmInstanceID
+	d.Set(Attr_MigrationInstanceID, id)
+
+	_, err = isWaitForPIInstanceAvailable(ctx, targetClient, id, helpers.PIInstanceHealthOk)
+	return err
+}
+
+// piInstanceMigrationNetworks maps each network of the source instance to
+// its target network, with the IP address of the source instance if kept
+func piInstanceMigrationNetworks(d *schema.ResourceData, source *models.PVMInstance) ([]*models.PVMInstanceAddNetwork, error) {
+	mapping := map[string]string{}
+	for _, v := range d.Get(Arg_MigrationNetworkMapping).([]interface{}) {
+		m := v.(map[string]interface{})
+		mapping[m[Arg_MigrationSourceNetworkID].(string)] = m[Arg_MigrationTargetNetworkID].(string)
+	}
+
+	keepIPAddresses := d.Get(Arg_MigrationKeepIPAddresses).(bool)
+	networks := []*models.PVMInstanceAddNetwork{}
+	for _, n := range source.Networks {
+		targetNetworkID, ok := mapping[n.NetworkID]
+		if !ok {
+			return nil, fmt.Errorf("network %s of lpar %s has no target network in %s", n.NetworkID, *source.PvmInstanceID, Arg_MigrationNetworkMapping)
+		}
+		network := &models.PVMInstanceAddNetwork{
+			NetworkID: sl.String(targetNetworkID),
+		}
+		if keepIPAddresses {
+			network.IPAddress = n.IPAddress
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// piInstanceMigrationCleanup deletes the capture from Cloud Storage
+func piInstanceMigrationCleanup(d *schema.ResourceData) error {
+	capturename := d.Get(Attr_MigrationCaptureName).(string)
+	if capturename == "" {
+		return nil
+	}
+	cosClient, err := piInstanceMigrationCOSClient(d)
+	if err != nil {
+		return err
+	}
+	return piCOSDeleteObjects(cosClient, d.Get(helpers.PIInstanceCaptureCloudStorageImagePath).(string), capturename)
+}
+
+func piInstanceMigrationCOSClient(d *schema.ResourceData) (*s3.S3, error) {
+	return piCOSClient(d.Get(helpers.PIInstanceCaptureCloudStorageRegion).(string),
+		d.Get(helpers.PIInstanceCaptureCloudStorageAccessKey).(string), d.Get(helpers.PIInstanceCaptureCloudStorageSecretKey).(string))
+}
+
+func piInstanceMigrationName(d *schema.ResourceData, source *models.PVMInstance) string {
+	if name := d.Get(helpers.PIInstanceName).(string); name != "" {
+		return name
+	}
+	return *source.ServerName
+}
+
+func piInstanceMigrationStorageType(d *schema.ResourceData, source *models.PVMInstance) string {
+	if storageType := d.Get(helpers.PIInstanceStorageType).(string); storageType != "" {
+		return storageType
+	}
+	if source.StorageType != nil {
+		return *source.StorageType
+	}
+	return ""
+}
+
+func piInstanceMigrationCompleteStep(d *schema.ResourceData, step string) {
+	steps := append(d.Get(Attr_MigrationCompletedSteps).([]interface{}), step)
+	d.Set(Attr_MigrationCompletedSteps, steps)
+	d.Set(Attr_Progress, len(steps)*100/len(piInstanceMigrationSteps))
+}
+
+func piInstanceMigrationStepCompleted(d *schema.ResourceData, step string) bool {
+	for _, s := range d.Get(Attr_MigrationCompletedSteps).([]interface{}) {
+		if s.(string) == step {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestPIInstanceMigrationResume(t *testing.T) {
+	testCases := []struct {
+		name      string
+		completed []interface{}
+		cleanup   bool
+		current   string
+		progress  int
+	}{
+		{
+			name:      "all steps completed",
+			completed: []interface{}{MigrationStepCapture, MigrationStepImport, MigrationStepDeploy, MigrationStepCleanup},
+			progress:  100,
+		},
+		{
+			name:      "cleanup skipped",
+			completed: []interface{}{MigrationStepCapture, MigrationStepImport, MigrationStepDeploy},
+			current:   MigrationStepCleanup,
+			progress:  100,
+		},
+	}
+
+	for _, tc := range testCases {
+		// Only the source instance is read, a completed step makes no request
+		sess := piTestSession(t, piTestJSON(t, "/pcloud/v1/cloud-instances/cloud-instance/pvm-instances/pvm-1", &models.PVMInstance{ServerName: core.StringPtr("web")}))
+		d := schema.TestResourceDataRaw(t, ResourceIBMPIInstanceMigration().Schema, map[string]interface{}{
+			Arg_CloudInstanceID:            "cloud-instance",
+			Arg_MigrationSourceInstanceID:  "pvm-1",
+			Arg_MigrationCleanupCOSObjects: tc.cleanup,
+		})
+		d.Set(Attr_MigrationCompletedSteps, tc.completed)
+		d.Set(Attr_Progress, len(tc.completed)*100/len(piInstanceMigrationSteps))
+
+		if err := piInstanceMigrate(context.Background(), d, sess, sess, 0); err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		for _, step := range piInstanceMigrationSteps {
+			if !piInstanceMigrationStepCompleted(d, step) {
+				t.Errorf("%s: expected step %s to be completed", tc.name, step)
+			}
+		}
+		if got := d.Get(Attr_MigrationCompletedSteps); !reflect.DeepEqual(got, []interface{}{MigrationStepCapture, MigrationStepImport, MigrationStepDeploy, MigrationStepCleanup}) {
+			t.Errorf("%s: completed steps = %v", tc.name, got)
+		}
+		if got := d.Get(Attr_MigrationCurrentStep).(string); got != tc.current {
+			t.Errorf("%s: current step = %q, want %q", tc.name, got, tc.current)
+		}
+		if got := d.Get(Attr_Progress).(int); got != tc.progress {
+			t.Errorf("%s: progress = %d, want %d", tc.name, got, tc.progress)
+		}
+	}
+}
+
+func TestPIInstanceMigrationImportError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   int
+		imported bool
+	}{
+		{name: "image not found", status: http.StatusNotFound, imported: true},
+		{name: "server error", status: http.StatusInternalServerError},
+		{name: "forbidden", status: http.StatusForbidden},
+	}
+
+	for _, tc := range testCases {
+		imported := false
+		sess := piTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/pcloud/v1/cloud-instances/target-instance/images/web-capture":
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.status)
+				w.Write([]byte(`{"description":"image error"}`))
+			case "/pcloud/v1/cloud-instances/target-instance/cos-images":
+				// Fail the import once requested, the test ends there
+				imported = true
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"description":"import error"}`))
+			default:
+				t.Errorf("%s: unexpected request %s %s", tc.name, r.Method, r.URL.Path)
+				http.NotFound(w, r)
+			}
+		})
+		d := schema.TestResourceDataRaw(t, ResourceIBMPIInstanceMigration().Schema, map[string]interface{}{
+			Arg_MigrationTargetCloudInstanceID: "target-instance",
+		})
+		d.Set(Attr_MigrationCaptureName, "web-capture")
+
+		err := piInstanceMigrationImport(context.Background(), d, sess, &models.PVMInstance{}, 0)
+		if err == nil {
+			t.Errorf("%s: expected an error", tc.name)
+		}
+		if imported != tc.imported {
+			t.Errorf("%s: imported = %t, want %t", tc.name, imported, tc.imported)
+		}
+	}
+}
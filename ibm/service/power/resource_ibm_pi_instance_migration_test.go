@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func TestAccIBMPIInstanceMigrationBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-migration-%d", acctest.RandIntRange(10, 100))
+	migrationRes := "ibm_pi_instance_migration.power_instance_migration"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceMigrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceMigrationConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceMigrationExists(migrationRes),
+					resource.TestCheckResourceAttr(migrationRes, "status", "completed"),
+					resource.TestCheckResourceAttr(migrationRes, "progress", "100"),
+					resource.TestCheckResourceAttr(migrationRes, "completed_steps.#", "4"),
+					resource.TestCheckResourceAttrSet(migrationRes, "capture_name"),
+					resource.TestCheckResourceAttrSet(migrationRes, "image_id"),
+					resource.TestCheckResourceAttrSet(migrationRes, "instance_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceMigrationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISessionForZone(rs.Primary.Attributes["pi_target_zone"])
+		if err != nil {
+			return err
+		}
+
+		client := st.NewIBMPIInstanceClient(context.Background(), sess, rs.Primary.Attributes["pi_target_cloud_instance_id"])
+		_, err = client.Get(rs.Primary.Attributes["instance_id"])
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMPIInstanceMigrationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_instance_migration" {
+			continue
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISessionForZone(rs.Primary.Attributes["pi_target_zone"])
+		if err != nil {
+			return err
+		}
+		client := st.NewIBMPIInstanceClient(context.Background(), sess, rs.Primary.Attributes["pi_target_cloud_instance_id"])
+		_, err = client.Get(rs.Primary.Attributes["instance_id"])
+		if err == nil {
+			return fmt.Errorf("migrated instance still exists: %s", rs.Primary.Attributes["instance_id"])
+		}
+		imageClient := st.NewIBMPIImageClient(context.Background(), sess, rs.Primary.Attributes["pi_target_cloud_instance_id"])
+		_, err = imageClient.Get(rs.Primary.Attributes["image_id"])
+		if err == nil {
+			return fmt.Errorf("imported image still exists: %s", rs.Primary.Attributes["image_id"])
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMPIInstanceMigrationConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_instance_migration" "power_instance_migration" {
+		pi_cloud_instance_id                = "%[1]s"
+		pi_source_instance_id               = "%[2]s"
+		pi_target_cloud_instance_id         = "%[3]s"
+		pi_target_zone                      = "%[4]s"
+		pi_instance_name                    = "%[5]s"
+		pi_capture_cloud_storage_region     = "us-east"
+		pi_capture_cloud_storage_access_key = "%[6]s"
+		pi_capture_cloud_storage_secret_key = "%[7]s"
+		pi_capture_storage_image_path       = "%[8]s"
+		pi_delete_instance_on_destroy       = true
+
+		pi_network_mapping {
+			pi_source_network_id = "%[9]s"
+			pi_target_network_id = "%[10]s"
+		}
+	}`, acc.Pi_cloud_instance_id, acc.Pi_instance_name, acc.Pi_migration_target_cloud_instance_id, acc.Pi_migration_target_zone, name,
+		acc.Pi_capture_cloud_storage_access_key, acc.Pi_capture_cloud_storage_secret_key, acc.Pi_capture_storage_image_path,
+		acc.Pi_migration_source_network_id, acc.Pi_migration_target_network_id)
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestPIInstanceReplicantName(t *testing.T) {
+	testCases := []struct {
+		name      string
+		replicant string
+		want      string
+	}{
+		{name: "single instance", replicant: "web", want: "app"},
+		{name: "suffix scheme", replicant: "web-2", want: "app-2"},
+		{name: "prefix scheme", replicant: "2-web", want: "2-app"},
+		{name: "renamed outside of terraform", replicant: "db-2", want: "app"},
+	}
+
+	for _, tc := range testCases {
+		if got := piInstanceReplicantName(tc.replicant, "web", "app"); got != tc.want {
+			t.Errorf("%s: piInstanceReplicantName(%q) = %q, want %q", tc.name, tc.replicant, got, tc.want)
+		}
+	}
+}
+
+func TestPIInstanceReplicants(t *testing.T) {
+	testCases := []struct {
+		name       string
+		attributes map[string]string
+		want       []piInstanceReplicant
+	}{
+		{
+			name: "replicants",
+			attributes: map[string]string{
+				helpers.PIInstanceName: "web",
+				"status":               "ACTIVE",
+				"instances.#":          "2",
+				"instances.0.id":       "pvm-1",
+				"instances.0.name":     "web-1",
+				"instances.0.status":   "ACTIVE",
+				"instances.1.id":       "pvm-2",
+				"instances.1.name":     "web-2",
+				"instances.1.status":   "SHUTOFF",
+			},
+			want: []piInstanceReplicant{
+				{id: "pvm-1", name: "web-1", status: "ACTIVE"},
+				{id: "pvm-2", name: "web-2", status: "SHUTOFF"},
+			},
+		},
+		{
+			name: "state without instances",
+			attributes: map[string]string{
+				helpers.PIInstanceName: "web",
+				"status":               "ACTIVE",
+			},
+			want: []piInstanceReplicant{
+				{id: "pvm-1", name: "web", status: "ACTIVE"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		d := ResourceIBMPIInstance().Data(&terraform.InstanceState{ID: "cloud-instance/pvm-1", Attributes: tc.attributes})
+		if got := piInstanceReplicants(d); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: piInstanceReplicants() = %+v, want %+v", tc.name, got, tc.want)
+		}
+	}
+}
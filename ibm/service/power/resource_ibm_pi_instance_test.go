@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
 	"testing"
 	"time"
 
@@ -531,6 +533,130 @@ func TestAccIBMPIInstanceUpdateStoppedState(t *testing.T) {
 	})
 }
 
+func TestAccIBMPIInstanceReplicants(t *testing.T) {
+	instanceRes := "ibm_pi_instance.power_instance"
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	newName := fmt.Sprintf("tf-pi-instance-upd-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceReplicantsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceReplicantsConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "instances.#", "3"),
+					resource.TestCheckResourceAttrPair(instanceRes, "instances.0.id", instanceRes, "instance_id"),
+					resource.TestCheckResourceAttrSet(instanceRes, "instances.2.networks.0.ip_address"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIInstanceReplicantsConfig(newName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(instanceRes, "instances.#", "3"),
+					resource.TestMatchResourceAttr(instanceRes, "instances.1.name", regexp.MustCompile(newName)),
+					resource.TestMatchResourceAttr(instanceRes, "instances.2.name", regexp.MustCompile(newName)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceReplicantsConfig(name string) string {
+	return fmt.Sprintf(`
+	data "ibm_pi_image" "power_image" {
+		pi_image_name        = "%[3]s"
+		pi_cloud_instance_id = "%[1]s"
+	}
+	data "ibm_pi_network" "power_networks" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[4]s"
+	}
+	resource "ibm_pi_instance" "power_instance" {
+		pi_memory             = "2"
+		pi_processors         = "0.25"
+		pi_instance_name      = "%[2]s"
+		pi_proc_type          = "shared"
+		pi_image_id           = data.ibm_pi_image.power_image.id
+		pi_sys_type           = "s922"
+		pi_cloud_instance_id  = "%[1]s"
+		pi_storage_pool       = data.ibm_pi_image.power_image.storage_pool
+		pi_health_status      = "OK"
+		pi_replicants         = 3
+		pi_replication_policy = "anti-affinity"
+		pi_replication_scheme = "suffix"
+		pi_network {
+			network_id = data.ibm_pi_network.power_networks.id
+		}
+	}
+	`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name)
+}
+
+func TestAccIBMPIInstanceCapacityCheck(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPIInstanceCapacityConfig(name, "9999"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("lacks the capacity"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceCapacityConfig(name, processors string) string {
+	return fmt.Sprintf(`
+	data "ibm_pi_image" "power_image" {
+		pi_image_name        = "%[4]s"
+		pi_cloud_instance_id = "%[1]s"
+	}
+	data "ibm_pi_network" "power_networks" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[5]s"
+	}
+	resource "ibm_pi_instance" "power_instance" {
+		pi_memory            = "2"
+		pi_processors        = "%[3]s"
+		pi_instance_name     = "%[2]s"
+		pi_proc_type         = "dedicated"
+		pi_image_id          = data.ibm_pi_image.power_image.id
+		pi_sys_type          = "s922"
+		pi_cloud_instance_id = "%[1]s"
+		pi_network {
+			network_id = data.ibm_pi_network.power_networks.id
+		}
+	}
+	`, acc.Pi_cloud_instance_id, name, processors, acc.Pi_image, acc.Pi_network_name)
+}
+
+func testAccCheckIBMPIInstanceReplicantsDestroy(s *terraform.State) error {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_instance" {
+			continue
+		}
+		client := st.NewIBMPIInstanceClient(context.Background(), sess, rs.Primary.Attributes["pi_cloud_instance_id"])
+		count, _ := strconv.Atoi(rs.Primary.Attributes["instances.#"])
+		for i := 0; i < count; i++ {
+			instanceID := rs.Primary.Attributes[fmt.Sprintf("instances.%d.id", i)]
+			_, err = client.Get(instanceID)
+			if err == nil {
+				return fmt.Errorf("PI Instance replicant still exists: %s", instanceID)
+			}
+		}
+	}
+
+	return nil
+}
+
 func testAccCheckIBMPIActiveInstanceConfigUpdate(name, instanceHealthStatus, proc, memory string) string {
 	return fmt.Sprintf(`
 	data "ibm_pi_image" "power_image" {
@@ -75,12 +75,12 @@ func ResourceIBMPINetwork() *schema.Resource {
 				Description: "PI network gateway",
 			},
 			helpers.PINetworkJumbo: {
-				Type:         schema.TypeBool,
-				Optional:     true,
-				Computed:     true,
-				Deprecated:   "This field is deprecated, use pi_network_mtu instead.",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				Deprecated:    "This field is deprecated, use pi_network_mtu instead.",
 				ConflictsWith: []string{helpers.PINetworkMtu, helpers.PINetworkJumbo},
-				Description:  "PI network enable MTU Jumbo option",
+				Description:   "PI network enable MTU Jumbo option",
 			},
 			helpers.PINetworkMtu: {
 				Type:          schema.TypeInt,
@@ -209,7 +209,7 @@ func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, met
 
 	_, err = isWaitForIBMPINetworkAvailable(ctx, client, networkID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 
 	return resourceIBMPINetworkRead(ctx, d, meta)
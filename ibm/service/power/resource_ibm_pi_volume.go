@@ -29,6 +29,7 @@ func ResourceIBMPIVolume() *schema.Resource {
 		ReadContext:   resourceIBMPIVolumeRead,
 		UpdateContext: resourceIBMPIVolumeUpdate,
 		DeleteContext: resourceIBMPIVolumeDelete,
+		CustomizeDiff: piVolumeCapacityCustomizeDiff,
 		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -116,6 +117,11 @@ func ResourceIBMPIVolume() *schema.Resource {
 				Computed:    true,
 				Description: "Indicates if the volume should be replication enabled or not",
 			},
+			Arg_SkipCapacityCheck: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Skip the plan time check of the storage capacity for the size of the volume",
+			},
 
 			// Computed Attributes
 			"volume_id": {
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -161,6 +162,44 @@ func testAccCheckIBMPIVolumePoolConfig(name string) string {
 	`, name, acc.Pi_cloud_instance_id)
 }
 
+func TestAccIBMPIVolumeCapacityCheck(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-volume-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPIVolumeCapacityConfig(name, 1000000),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("exceeds the maximum allocation size"),
+			},
+			{
+				Config: testAccCheckIBMPIVolumeCapacityConfig(name, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeExists("ibm_pi_volume.power_volume"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMPIVolumeCapacityConfig(name, 1000000),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("exceeds the maximum allocation size"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIVolumeCapacityConfig(name string, size int) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_volume" "power_volume"{
+		pi_volume_size       = %d
+		pi_volume_name       = "%s"
+		pi_volume_type       = "tier3"
+		pi_cloud_instance_id = "%s"
+	  }
+	`, size, name, acc.Pi_cloud_instance_id)
+}
+
 // TestAccIBMPIVolumeGRS test the volume replication feature which is part of global replication service(GRS)
 func TestAccIBMPIVolumeGRS(t *testing.T) {
 	name := fmt.Sprintf("tf-pi-volume-%d", acctest.RandIntRange(10, 100))
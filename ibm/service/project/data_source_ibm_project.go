@@ -323,7 +323,7 @@ func dataSourceIbmProjectRead(context context.Context, d *schema.ResourceData, m
 	project, response, err := projectClient.GetProjectWithContext(context, getProjectOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetProjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProjectWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetProjectWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s", *getProjectOptions.ID))
@@ -431,7 +431,7 @@ func dataSourceIbmProjectConfigRead(context context.Context, d *schema.ResourceD
 	projectConfig, response, err := projectClient.GetConfigWithContext(context, getConfigOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetConfigWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConfigWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetConfigWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *getConfigOptions.ProjectID, *getConfigOptions.ID))
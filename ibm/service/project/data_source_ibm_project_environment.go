@@ -193,7 +193,7 @@ func dataSourceIbmProjectEnvironmentRead(context context.Context, d *schema.Reso
 	environment, response, err := projectClient.GetProjectEnvironmentWithContext(context, getProjectEnvironmentOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetProjectEnvironmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProjectEnvironmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetProjectEnvironmentWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *getProjectEnvironmentOptions.ProjectID, *getProjectEnvironmentOptions.ID))
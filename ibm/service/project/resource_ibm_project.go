@@ -386,7 +386,7 @@ func resourceIbmProjectCreate(context context.Context, d *schema.ResourceData, m
 	project, response, err := projectClient.CreateProjectWithContext(context, createProjectOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateProjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateProjectWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateProjectWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*project.ID)
@@ -411,7 +411,7 @@ func resourceIbmProjectRead(context context.Context, d *schema.ResourceData, met
 			return nil
 		}
 		log.Printf("[DEBUG] GetProjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProjectWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetProjectWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("location", project.Location); err != nil {
@@ -514,7 +514,7 @@ func resourceIbmProjectUpdate(context context.Context, d *schema.ResourceData, m
 		_, response, err := projectClient.UpdateProjectWithContext(context, updateProjectOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateProjectWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateProjectWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateProjectWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -534,7 +534,7 @@ func resourceIbmProjectDelete(context context.Context, d *schema.ResourceData, m
 	response, err := projectClient.DeleteProjectWithContext(context, deleteProjectOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteProjectWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteProjectWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteProjectWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
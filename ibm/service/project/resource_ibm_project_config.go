@@ -464,7 +464,7 @@ func resourceIbmProjectConfigCreate(context context.Context, d *schema.ResourceD
 	projectConfig, response, err := projectClient.CreateConfigWithContext(context, createConfigOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateConfigWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateConfigWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateConfigWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createConfigOptions.ProjectID, *projectConfig.ID))
@@ -495,7 +495,7 @@ func resourceIbmProjectConfigRead(context context.Context, d *schema.ResourceDat
 			return nil
 		}
 		log.Printf("[DEBUG] GetConfigWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConfigWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetConfigWithContext failed", err, response).Diagnostics()
 	}
 
 	definitionMap, err := resourceIbmProjectConfigProjectConfigResponseDefinitionToMap(projectConfig.Definition)
@@ -600,7 +600,7 @@ func resourceIbmProjectConfigUpdate(context context.Context, d *schema.ResourceD
 		_, response, err := projectClient.UpdateConfigWithContext(context, updateConfigOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateConfigWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateConfigWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateConfigWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -626,7 +626,7 @@ func resourceIbmProjectConfigDelete(context context.Context, d *schema.ResourceD
 	_, response, err := projectClient.DeleteConfigWithContext(context, deleteConfigOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteConfigWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteConfigWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteConfigWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
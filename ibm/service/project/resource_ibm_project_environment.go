@@ -225,7 +225,7 @@ func resourceIbmProjectEnvironmentCreate(context context.Context, d *schema.Reso
 	environment, response, err := projectClient.CreateProjectEnvironmentWithContext(context, createProjectEnvironmentOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateProjectEnvironmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateProjectEnvironmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateProjectEnvironmentWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createProjectEnvironmentOptions.ProjectID, *environment.ID))
@@ -256,7 +256,7 @@ func resourceIbmProjectEnvironmentRead(context context.Context, d *schema.Resour
 			return nil
 		}
 		log.Printf("[DEBUG] GetProjectEnvironmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProjectEnvironmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetProjectEnvironmentWithContext failed", err, response).Diagnostics()
 	}
 
 	definitionMap, err := resourceIbmProjectEnvironmentEnvironmentDefinitionRequiredPropertiesToMap(environment.Definition)
@@ -329,7 +329,7 @@ func resourceIbmProjectEnvironmentUpdate(context context.Context, d *schema.Reso
 		_, response, err := projectClient.UpdateProjectEnvironmentWithContext(context, updateProjectEnvironmentOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateProjectEnvironmentWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateProjectEnvironmentWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateProjectEnvironmentWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -355,7 +355,7 @@ func resourceIbmProjectEnvironmentDelete(context context.Context, d *schema.Reso
 	_, response, err := projectClient.DeleteProjectEnvironmentWithContext(context, deleteProjectEnvironmentOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteProjectEnvironmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteProjectEnvironmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteProjectEnvironmentWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -201,7 +201,7 @@ func DataSourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{})
 		}
 		listInstanceResponse, resp, err := rsConClient.ListResourceInstances(&resourceInstanceListOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+			return flex.NewServiceError("Error retrieving resource instance", err, resp)
 		}
 		next_url, err = getInstancesNext(listInstanceResponse.NextURL)
 		if err != nil {
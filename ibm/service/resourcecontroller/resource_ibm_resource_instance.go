@@ -8,7 +8,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -51,7 +50,7 @@ func ResourceIBMResourceInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -123,6 +122,13 @@ func ResourceIBMResourceInstance() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_resource_instance",
 					"tags"),
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
 
 			"status": {
 				Type:        schema.TypeString,
@@ -509,7 +515,7 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		log.Printf(
 			"Error when creating resource instance: %s, Instance info  NAME->%s, LOCATION->%s, GROUP_ID->%s, PLAN_ID->%s",
 			err, *rsInst.Name, *rsInst.Target, *rsInst.ResourceGroup, *rsInst.ResourcePlanID)
-		return fmt.Errorf("[ERROR] Error when creating resource instance: %s with resp code: %s", err, resp)
+		return flex.NewServiceError("Error when creating resource instance", err, resp)
 	}
 
 	d.SetId(*instance.ID)
@@ -519,8 +525,7 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[ERROR] Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err)
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, "tags") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -544,7 +549,7 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+		return flex.NewServiceError("Error retrieving resource instance", err, resp)
 	}
 
 	tags, err := flex.GetTagsUsingCRN(meta, *instance.CRN)
@@ -552,7 +557,7 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 		log.Printf(
 			"Error on get of resource instance tags (%s) tags: %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	flex.SetResourceTags(d, meta, "tags", "user", tags)
 	d.Set("name", instance.Name)
 	d.Set("status", instance.State)
 	d.Set("resource_group_id", instance.ResourceGroupID)
@@ -703,7 +708,7 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 	if d.HasChange("parameters") {
 		instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+			return flex.NewServiceError("Error retrieving resource instance", err, resp)
 		}
 
 		if parameters, ok := d.GetOk("parameters"); ok {
@@ -754,10 +759,10 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 	}
 	instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting resource instance: %s with resp code: %s", err, resp)
+		return flex.NewServiceError("Error Getting resource instance", err, resp)
 	}
 
-	if d.HasChange("tags") {
+	if flex.TagsHasChange(d, "tags") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -768,7 +773,7 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 
 	_, resp, err = rsConClient.UpdateResourceInstance(&resourceInstanceUpdate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating resource instance: %s with resp code: %s", err, resp)
+		return flex.NewServiceError("Error updating resource instance", err, resp)
 	}
 
 	_, err = waitForResourceInstanceUpdate(d, meta)
@@ -796,7 +801,7 @@ func ResourceIBMResourceInstanceDelete(d *schema.ResourceData, meta interface{})
 		if resp != nil && resp.StatusCode == 410 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting resource instance: %s with resp code: %s", error, resp)
+		return flex.NewServiceError("Error deleting resource instance", error, resp)
 	}
 
 	_, err = waitForResourceInstanceDelete(d, meta)
@@ -823,7 +828,7 @@ func ResourceIBMResourceInstanceExists(d *schema.ResourceData, meta interface{})
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting resource instance: %s with resp code: %s", err, resp)
+		return false, flex.NewServiceError("Error getting resource instance", err, resp)
 	}
 	if instance != nil && (strings.Contains(*instance.State, "removed") || strings.Contains(*instance.State, RsInstanceReclamation)) {
 		log.Printf("[WARN] Removing instance from state because it's in removed or pending_reclamation state")
@@ -200,6 +200,38 @@ func TestAccIBMCOSResourceInstanceWithInvalidPlan(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceInstance_replay(t *testing.T) {
+	r := acc.NewReplay(t)
+	serviceName := fmt.Sprintf("tf-replay-cos-%d", r.RandIntRange(10, 100))
+	resourceGroupID := r.Env("IBM_RESOURCE_GROUP_ID")
+
+	r.Test(t, resource.TestCase{
+		PreCheck:     func() { r.PreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceInstanceReplay(serviceName, resourceGroupID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceInstanceExists("ibm_resource_instance.instance"),
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "name", serviceName),
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "service", "cloud-object-storage"),
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "plan", "standard"),
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "location", "global"),
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "status", "active"),
+				),
+			},
+			{
+				ResourceName:      "ibm_resource_instance.instance",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"parameters"},
+			},
+		},
+	})
+}
+
 func testAccCheckIBMResourceInstanceDestroy(s *terraform.State) error {
 	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -259,6 +291,20 @@ func testAccCheckIBMResourceInstanceExists(n string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckIBMResourceInstanceReplay(serviceName, resourceGroupID string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = "%s"
+		parameters = {
+			"HMAC" = true
+		}
+	}`, serviceName, resourceGroupID)
+}
+
 func testAccCheckIBMResourceInstanceBasic(serviceName string) string {
 	return fmt.Sprintf(`
 		
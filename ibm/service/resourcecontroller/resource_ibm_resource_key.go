@@ -276,7 +276,7 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 
 	resourceKey, resp, err := rsContClient.CreateResourceKey(&resourceKeyCreate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating resource key: %s with resp code: %s", err, resp)
+		return flex.NewServiceError("Error creating resource key", err, resp)
 	}
 
 	d.SetId(*resourceKey.ID)
@@ -300,7 +300,7 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 
 	resourceKey, resp, err := rsContClient.GetResourceKey(&resourceKeyGet)
 	if err != nil || resourceKey == nil {
-		return fmt.Errorf("[ERROR] Error retrieving resource key: %s with resp : %s", err, resp)
+		return flex.NewServiceError("Error retrieving resource key", err, resp)
 	}
 	var credInterface map[string]interface{}
 	cred, _ := json.Marshal(resourceKey.Credentials)
@@ -400,7 +400,7 @@ func resourceIBMResourceKeyDelete(d *schema.ResourceData, meta interface{}) erro
 
 	resp, err := rsContClient.DeleteResourceKey(&resourceKeyDelete)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting resource key: %s with resp code: %s", err, resp)
+		return flex.NewServiceError("Error deleting resource key", err, resp)
 	}
 
 	d.SetId("")
@@ -423,7 +423,7 @@ func resourceIBMResourceKeyExists(d *schema.ResourceData, meta interface{}) (boo
 		if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 410) {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting resource key: %s with resp code: %s", err, resp)
+		return false, flex.NewServiceError("Error getting resource key", err, resp)
 	}
 	if err == nil && *resourceKey.State == "removed" {
 		return false, nil
@@ -4,10 +4,10 @@
 package resourcemanager
 
 import (
-	"fmt"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	rg "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -110,7 +110,7 @@ func resourceIBMResourceGroupCreate(d *schema.ResourceData, meta interface{}) er
 
 	resourceGroup, resp, err := rMgtClient.CreateResourceGroup(&resourceGroupCreate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating resource group: %s with response code  %s", err, resp)
+		return flex.NewServiceError("Error creating resource group", err, resp)
 	}
 
 	d.SetId(*resourceGroup.ID)
@@ -135,7 +135,7 @@ func resourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error retrieving resource group: %s with response code  %s", err, resp)
+		return flex.NewServiceError("Error retrieving resource group", err, resp)
 	}
 
 	d.Set("name", *resourceGroup.Name)
@@ -198,7 +198,7 @@ func resourceIBMResourceGroupUpdate(d *schema.ResourceData, meta interface{}) er
 	if hasChange {
 		_, resp, err := rMgtClient.UpdateResourceGroup(&resourceGroupUpdate)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating resource group: %s with response code  %s", err, resp)
+			return flex.NewServiceError("Error updating resource group", err, resp)
 		}
 
 	}
@@ -222,7 +222,7 @@ func resourceIBMResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 			log.Printf("[WARN] Resource Group is not found")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting resource group: %s with response code  %s", err, resp)
+		return flex.NewServiceError("Error Deleting resource group", err, resp)
 	}
 
 	d.SetId("")
@@ -245,7 +245,7 @@ func resourceIBMResourceGroupExists(d *schema.ResourceData, meta interface{}) (b
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting resource group: %s with response code  %s", err, resp)
+		return false, flex.NewServiceError("Error getting resource group", err, resp)
 	}
 
 	return *resourceGroup.ID == resourceGroupID, nil
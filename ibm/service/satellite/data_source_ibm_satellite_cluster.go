@@ -206,7 +206,7 @@ func dataSourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{})
 
 	clusterFields, response, err := satClient.GetCluster(getSatClusterOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving cluster: %s\n%s", err, response)
+		return flex.NewServiceError("Error retrieving cluster", err, response)
 	}
 
 	getWorkersOptions := &kubernetesserviceapiv1.GetWorkers1Options{}
@@ -216,7 +216,7 @@ func dataSourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{})
 	}
 	workerFields, response, err := satClient.GetWorkers1(getWorkersOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving workers for satellite cluster: %s, %s", err, response)
+		return flex.NewServiceError("Error retrieving workers for satellite cluster", err, response)
 	}
 	workers := make([]string, len(workerFields))
 	for i, worker := range workerFields {
@@ -235,7 +235,7 @@ func dataSourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{})
 
 	workerPools, response, err := satClient.GetWorkerPools1(getSatWorkerPoolOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving worker pools of the cluster %s: %s\n%s", name, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error retrieving worker pools of the cluster %s", name), err, response)
 	}
 
 	d.SetId(*clusterFields.ID)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -136,7 +137,7 @@ func dataSourceIBMSatelliteClusterWorkerPoolRead(d *schema.ResourceData, meta in
 
 	workerPool, response, err := satClient.GetWorkerPool(getSatWorkerPoolOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving worker pool  %s: %s\n%s", name, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error retrieving worker pool  %s", name), err, response)
 	}
 
 	var zones = make([]map[string]interface{}, 0)
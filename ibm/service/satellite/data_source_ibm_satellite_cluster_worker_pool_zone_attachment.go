@@ -7,6 +7,7 @@ import (
 
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -78,10 +79,10 @@ func dataSourceIBMSatelliteClusterWorkerPoolAttachmentRead(context context.Conte
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			log.Printf("[DEBUG] Satellite cluster workerpool zone attachment record is not found %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("Satellite cluster workerpool zone attachment record is not found %s\n%s", err, response))
+			return flex.NewServiceError("Satellite cluster workerpool zone attachment record is not found", err, response).Diagnostics()
 		}
 		log.Printf("[DEBUG] GetWorkerPoolWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetWorkerPoolWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetWorkerPoolWithContext failed", err, response).Diagnostics()
 	}
 
 	if getWorkerPoolResponse != nil && getWorkerPoolResponse.Zones != nil {
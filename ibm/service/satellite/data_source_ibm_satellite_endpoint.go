@@ -10,6 +10,7 @@ import (
 
 	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -327,7 +328,7 @@ func dataSourceIbmSatelliteEndpointRead(context context.Context, d *schema.Resou
 	endpoint, response, err := satelliteLinkClient.GetEndpointsWithContext(context, getEndpointsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetEndpointsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetEndpointsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetEndpointsWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *endpoint.LocationID, *endpoint.EndpointID))
@@ -118,7 +118,7 @@ func dataSourceIBMSatelliteAttachHostScriptRead(d *schema.ResourceData, meta int
 		locData, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
 	}
 	if err != nil || locData == nil {
-		return fmt.Errorf("[ERROR] Error getting Satellite location (%s): %s\n%s", location, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting Satellite location (%s)", location), err, response)
 	}
 
 	// script labels
@@ -168,7 +168,7 @@ func dataSourceIBMSatelliteAttachHostScriptRead(d *schema.ResourceData, meta int
 
 	resp, err := satClient.AttachSatelliteHost(createRegOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Generating Satellite Registration Script: %s\n%s", err, resp)
+		return flex.NewServiceError("Error Generating Satellite Registration Script", err, nil)
 	}
 
 	scriptContent := string(resp)
@@ -181,7 +181,7 @@ func dataSourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{}
 		instance, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
 	}
 	if err != nil || instance == nil {
-		return fmt.Errorf("[ERROR] Error retrieving IBM cloud satellite location %s : %s\n%s", location, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error retrieving IBM cloud satellite location %s", location), err, response)
 	}
 
 	d.SetId(*instance.ID)
@@ -212,7 +212,7 @@ func dataSourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{}
 
 	hostList, response, err := satClient.GetSatelliteHosts(getSatHostOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving location hosts %s : %s\n%s", location, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error retrieving location hosts %s", location), err, response)
 	}
 	if hostList != nil {
 		d.Set("hosts", flex.FlattenSatelliteHosts(hostList))
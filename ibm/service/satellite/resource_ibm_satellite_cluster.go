@@ -55,7 +55,7 @@ func ResourceIBMSatelliteCluster() *schema.Resource {
 				cluster, response, err := satClient.GetCluster(getSatClusterOptions)
 				if err != nil || cluster == nil {
 					if response != nil && response.StatusCode == 404 && strings.Contains(err.Error(), "The specified cluster could not be found") {
-						return nil, fmt.Errorf("Error reading satellite cluster: %s\n%s", err, response)
+						return nil, flex.NewServiceError("Error reading satellite cluster", err, response)
 					}
 					return nil, fmt.Errorf("Error reading satellite cluster: %s", err)
 				}
@@ -70,7 +70,7 @@ func ResourceIBMSatelliteCluster() *schema.Resource {
 				return flex.ImmutableResourceCustomizeDiff([]string{"name", "location", "resource_group_id", "crn_token"}, diff)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -266,6 +266,13 @@ func ResourceIBMSatelliteCluster() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for the resources",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
 			"host_labels": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -424,14 +431,14 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 
 		instance, response, err := satClient.CreateSatelliteClusterRemote(createRemoteClusterOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Creating Satellite Cluster for remote location: %s\n%s", err, response)
+			return flex.NewServiceError("Error Creating Satellite Cluster for remote location", err, response)
 		}
 		clusterId = *instance.ID
 		log.Printf("[INFO] Created ROKS Satellite Cluster for remote location: %s", clusterId)
 	} else {
 		instance, response, err := satClient.CreateSatelliteCluster(createClusterOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Creating Satellite Cluster: %s\n%s", err, response)
+			return flex.NewServiceError("Error Creating Satellite Cluster", err, response)
 		}
 		clusterId = *instance.ID
 		log.Printf("[INFO] Created ROKS Satellite Cluster : %s", clusterId)
@@ -459,7 +466,7 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 
 					response, err := satClient.CreateSatelliteWorkerPoolZone(zoneOptions)
 					if err != nil {
-						return fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response)
+						return flex.NewServiceError("Error Adding Worker Pool Zone", err, response)
 					}
 				}
 			}
@@ -487,12 +494,11 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 
 		response, err := satClient.V2SetWorkerPoolLabels(wpots)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response)
+			return flex.NewServiceError("Error updating the labels", err, response)
 		}
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, "tags") {
 		getSatClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
 			Cluster: flex.PtrToString(clusterId),
 		}
@@ -591,7 +597,7 @@ func resourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{}) e
 		log.Printf(
 			"An error occured during reading of instance (%s) tags : %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	flex.SetResourceTags(d, meta, "tags", "user", tags)
 	d.Set("default_worker_pool_labels", flex.IgnoreSystemLabels(workerPool.Labels))
 	d.Set("host_labels", flex.FlattenWorkerPoolHostLabels(workerPool.HostLabels))
 	d.Set("operating_system", workerPool.OperatingSystem)
@@ -647,7 +653,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 		workerOpts := satClient.NewGetWorkers1Options(clusterID)
 		workerFields, response, err := satClient.GetWorkers1(workerOpts)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving workerFields %s: %s", err, response)
+			return flex.NewServiceError("Error retrieving workerFields", err, response)
 		}
 
 		getSatClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
@@ -657,7 +663,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 
 		cluster, response, err := satClient.GetCluster(getSatClusterOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s, %s", clusterID, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error retrieving cluster %s", clusterID), err, response)
 		}
 		waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
 		if workerFields != nil {
@@ -696,7 +702,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 
 		response, err := satClient.V2ResizeWorkerPool(resizeOpts)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the worker pool size %d: %s\n%s", workerCount, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error updating the worker pool size %d", workerCount), err, response)
 		}
 	}
 
@@ -716,12 +722,12 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 		response, err := satClient.V2SetWorkerPoolLabels(wpots)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response)
+			return flex.NewServiceError("Error updating the labels", err, response)
 		}
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if flex.TagsHasChange(d, "tags") || v != "" {
 		oldList, newList := d.GetChange("tags")
 		getSatClusterOptions := &kubernetesserviceapiv1.GetClusterOptions{
 			Cluster:            &clusterID,
@@ -730,7 +736,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 
 		cluster, response, err := satClient.GetCluster(getSatClusterOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s\n%s", clusterID, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error retrieving cluster %s", clusterID), err, response)
 		}
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *cluster.Crn)
 		if err != nil {
@@ -762,7 +768,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 				}
 				response, err := satClient.CreateSatelliteWorkerPoolZone(zoneOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response)
+					return flex.NewServiceError("Error Adding Worker Pool Zone", err, response)
 				}
 			}
 			_, err = WaitForSatelliteWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutUpdate), targetEnv)
@@ -781,7 +787,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 				}
 				response, err := satClient.RemoveWorkerPoolZone(zoneOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error deleting Worker Pool Zone : %s\n%s", err, response)
+					return flex.NewServiceError("Error deleting Worker Pool Zone", err, response)
 				}
 			}
 		}
@@ -802,7 +808,7 @@ func resourceIBMSatelliteClusterDelete(d *schema.ResourceData, meta interface{})
 
 	response, err := satClient.RemoveCluster(removeClusterOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Creating Satellite Location: %s\n%s", err, response)
+		return flex.NewServiceError("Error Creating Satellite Location", err, response)
 	}
 
 	//Wait for cluster to get delete
@@ -880,7 +886,7 @@ func waitForClusterToReady(cluster string, d *schema.ResourceData, meta interfac
 			}
 			instance, response, err := satClient.GetCluster(getClusterOptions)
 			if err != nil {
-				return nil, "", fmt.Errorf("[ERROR] Error Getting cluster : %s\n%s", err, response)
+				return nil, "", flex.NewServiceError("Error Getting cluster", err, response)
 			}
 			if instance != nil && *instance.State == isCluterDeployFailed {
 				return instance, isCluterDeployFailed, fmt.Errorf("[ERROR] The cluster failed to deploy : %s", cluster)
@@ -60,7 +60,7 @@ func ResourceIBMSatelliteClusterWorkerPool() *schema.Resource {
 				}
 				workerPool, response, err := satClient.GetWorkerPool(getWorkerPoolOptions)
 				if err != nil {
-					return nil, fmt.Errorf("Error reading satellite worker pool: %s\n%s", response, err)
+					return nil, flex.NewServiceError("Error reading satellite worker pool", err, response)
 				}
 
 				var zones = make([]map[string]interface{}, 0)
@@ -270,7 +270,7 @@ func resourceIBMSatelliteClusterWorkerPoolCreate(d *schema.ResourceData, meta in
 
 	instance, response, err := satClient.CreateSatelliteWorkerPool(createWorkerPoolOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Creating Satellite cluster worker pool: %s\n%s", err, response)
+		return flex.NewServiceError("Error Creating Satellite cluster worker pool", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", cluster, *instance.WorkerPoolID))
@@ -356,7 +356,7 @@ func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta in
 		}
 		response, err := satClient.V2SetWorkerPoolLabels(wpots)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating the labels: %s\n%s", err, response)
+			return flex.NewServiceError("Error updating the labels", err, response)
 		}
 	}
 
@@ -406,7 +406,7 @@ func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta in
 				}
 				response, err := satClient.CreateSatelliteWorkerPoolZone(zoneOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Adding Worker Pool Zone : %s\n%s", err, response)
+					return flex.NewServiceError("Error Adding Worker Pool Zone", err, response)
 				}
 			}
 			_, err = WaitForSatelliteWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
@@ -425,7 +425,7 @@ func resourceIBMSatelliteClusterWorkerPoolUpdate(d *schema.ResourceData, meta in
 				}
 				response, err := satClient.RemoveWorkerPoolZone(zoneOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error deleting Worker Pool Zone : %s\n%s", err, response)
+					return flex.NewServiceError("Error deleting Worker Pool Zone", err, response)
 				}
 			}
 		}
@@ -461,7 +461,7 @@ func resourceIBMSatelliteClusterWorkerPoolDelete(d *schema.ResourceData, meta in
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting Satellite Cluster WorkerPool: %s\n%s", err, response)
+		return flex.NewServiceError("Error Deleting Satellite Cluster WorkerPool", err, response)
 	}
 
 	_, err = WaitForSatelliteWorkerDelete(clusterID, workerPoolID, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
@@ -504,7 +504,7 @@ func WaitForSatelliteWorkerPoolAvailable(d *schema.ResourceData, meta interface{
 
 			workers, response, err := satClient.GetWorkers1(getWorkersOptions)
 			if err != nil {
-				return nil, "", fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s\n%s", err, response)
+				return nil, "", flex.NewServiceError("Error retrieving workers for cluster", err, response)
 			}
 
 			//Check active transactions
@@ -556,7 +556,7 @@ func satelliteWorkerPoolDeleteStateRefreshFunc(satClient *kubernetesserviceapiv1
 
 		workerFields, response, err := satClient.GetWorkers1(getWorkersOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error retrieving workers for cluster", err, response)
 		}
 		//Done worker has two fields desiredState and actualState , so check for those 2
 		for _, e := range workerFields {
@@ -166,7 +166,7 @@ func resourceIBMSatelliteHostCreate(d *schema.ResourceData, meta interface{}) er
 	if hostStatus == rsHostReadyStatus {
 		_, response, err := satClient.CreateSatelliteAssignment(hostAssignOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Assigning Satellite Host: %s\n%s", err, response)
+			return flex.NewServiceError("Error Assigning Satellite Host", err, response)
 		}
 	}
 
@@ -273,7 +273,7 @@ func resourceIBMSatelliteHostUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 		response, err := satClient.UpdateSatelliteHost(updateHostOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Satellite Host: %s\n%s", err, response)
+			return flex.NewServiceError("Error Updating Satellite Host", err, response)
 		}
 	}
 
@@ -302,7 +302,7 @@ func resourceIBMSatelliteHostDelete(d *schema.ResourceData, meta interface{}) er
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting Satellite Host: %s\n%s", err, response)
+		return flex.NewServiceError("Error Deleting Satellite Host", err, response)
 	}
 
 	d.SetId("")
@@ -325,7 +325,7 @@ func waitForHostAttachment(hostName, location string, d *schema.ResourceData, me
 			hostList, resp, err := satClient.GetSatelliteHosts(attachOptions)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() != 404 {
-					return nil, "", fmt.Errorf("[ERROR] The satellite host (%s) failed to attached: %v\n%s", hostName, err, resp)
+					return nil, "", flex.NewServiceError(fmt.Sprintf("The satellite host (%s) failed to attached", hostName), err, resp)
 				}
 			}
 
@@ -51,7 +51,7 @@ func ResourceIBMSatelliteLocation() *schema.Resource {
 
 				instance, response, err := satClient.GetSatelliteLocation(getSatLocOptions)
 				if err != nil || instance == nil {
-					return nil, fmt.Errorf("Error reading satellite location: %s\n%s", err, response)
+					return nil, flex.NewServiceError("Error reading satellite location", err, response)
 				}
 
 				d.Set("zones", flex.NewStringSet(schema.HashString, instance.WorkerZones))
@@ -61,7 +61,7 @@ func ResourceIBMSatelliteLocation() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ImmutableResourceCustomizeDiff([]string{satLocation, sateLocZone, "resource_group_id", "zones"}, diff)
@@ -173,6 +173,13 @@ func ResourceIBMSatelliteLocation() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags associated with resource instance",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
 			flex.ResourceGroupName: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -301,14 +308,13 @@ func resourceIBMSatelliteLocationCreate(d *schema.ResourceData, meta interface{}
 
 	instance, response, err := satClient.CreateSatelliteLocation(createSatLocOptions)
 	if err != nil || instance == nil {
-		return fmt.Errorf("[ERROR] Error Creating Satellite Location: %s\n%s", err, response)
+		return flex.NewServiceError("Error Creating Satellite Location", err, response)
 	}
 
 	d.SetId(*instance.ID)
 	log.Printf("[INFO] Created satellite location : %s", satLocation)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, "tags") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
@@ -368,7 +374,7 @@ func resourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{})
 		log.Printf(
 			"Error on get of ibm satellite location tags (%s) tags: %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	flex.SetResourceTags(d, meta, "tags", "user", tags)
 	d.Set("crn", *instance.Crn)
 	d.Set(flex.ResourceGroupName, *instance.ResourceGroupName)
 	if instance.Hosts != nil {
@@ -400,7 +406,7 @@ func resourceIBMSatelliteLocationUpdate(d *schema.ResourceData, meta interface{}
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
+	if flex.TagsHasChange(d, "tags") || v != "" {
 		oldList, newList := d.GetChange("tags")
 		getSatLocOptions := &kubernetesserviceapiv1.GetSatelliteLocationOptions{
 			Controller: &ID,
@@ -408,7 +414,7 @@ func resourceIBMSatelliteLocationUpdate(d *schema.ResourceData, meta interface{}
 
 		instance, response, err := satClient.GetSatelliteLocation(getSatLocOptions)
 		if err != nil || instance == nil {
-			return fmt.Errorf("[ERROR] Error retrieving satellite location: %s\n%s", err, response)
+			return flex.NewServiceError("Error retrieving satellite location", err, response)
 		}
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
@@ -431,7 +437,7 @@ func resourceIBMSatelliteLocationDelete(d *schema.ResourceData, meta interface{}
 
 	response, err := satClient.RemoveSatelliteLocation(removeSatLocOptions)
 	if err != nil && response.StatusCode != 404 {
-		return fmt.Errorf("[ERROR] Error Deleting Satellite Location: %s\n%s", err, response)
+		return flex.NewServiceError("Error Deleting Satellite Location", err, response)
 	}
 
 	//Wait for location to delete
@@ -457,7 +463,7 @@ func waitForLocationDelete(location string, d *schema.ResourceData, meta interfa
 			getSatLocOptions := &kubernetesserviceapiv1.GetSatelliteLocationsOptions{}
 			locations, response, err := satClient.GetSatelliteLocations(getSatLocOptions)
 			if err != nil {
-				return nil, "", fmt.Errorf("[ERROR] Error Getting locations list to delete : %s\n%s", err, response)
+				return nil, "", flex.NewServiceError("Error Getting locations list to delete", err, response)
 			}
 
 			isExist := false
@@ -472,7 +478,7 @@ func waitForLocationDelete(location string, d *schema.ResourceData, meta interfa
 					return location, isLocationDeleteDone, nil
 				}
 			}
-			return nil, "", fmt.Errorf("[ERROR] Failed to delete location : %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Failed to delete location", err, response)
 		},
 		Timeout:    d.Timeout(schema.TimeoutDelete),
 		Delay:      60 * time.Second,
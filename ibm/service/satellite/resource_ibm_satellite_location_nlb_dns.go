@@ -67,7 +67,7 @@ func resourceIbmSatelliteLocationNlbDnsCreate(context context.Context, d *schema
 	mscRegisterResp, response, err := satClient.RegisterMultishiftClusterWithContext(context, registerMultishiftClusterOptions)
 	if err != nil {
 		log.Printf("[DEBUG] RegisterMultishiftClusterWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("RegisterMultishiftClusterWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("RegisterMultishiftClusterWithContext failed", err, response).Diagnostics()
 	}
 	d.SetId(*mscRegisterResp.Controller)
 
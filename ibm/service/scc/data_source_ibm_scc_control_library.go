@@ -287,7 +287,7 @@ func dataSourceIbmSccControlLibraryRead(context context.Context, d *schema.Resou
 	controlLibrary, response, err := securityandcompliancecenterapiClient.GetControlLibraryWithContext(context, getControlLibraryOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetControlLibraryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetControlLibraryWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetControlLibraryWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s", *getControlLibraryOptions.ControlLibrariesID))
@@ -313,7 +313,7 @@ func dataSourceIbmSccLatestReportsRead(context context.Context, d *schema.Resour
 	reportLatest, response, err := resultsClient.GetLatestReportsWithContext(context, getLatestReportsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetLatestReportsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetLatestReportsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetLatestReportsWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(dataSourceIbmSccLatestReportsID(d))
@@ -126,7 +126,7 @@ func dataSourceIbmSccProviderTypeRead(context context.Context, d *schema.Resourc
 	providerTypeItem, response, err := securityAndComplianceCenterApIsClient.GetProviderTypeByIDWithContext(context, getProviderTypeByIdOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetProviderTypeByIDWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProviderTypeByIDWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetProviderTypeByIDWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*providerTypeItem.ID)
@@ -130,7 +130,7 @@ func dataSourceIbmSccProviderTypeCollectionRead(context context.Context, d *sche
 	providerTypesCollection, response, err := securityAndComplianceCenterApIsClient.ListProviderTypesWithContext(context, listProviderTypesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListProviderTypesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListProviderTypesWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ListProviderTypesWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(dataSourceIbmSccProviderTypeCollectionID(d))
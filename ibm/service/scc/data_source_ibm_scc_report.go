@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
 )
 
@@ -193,7 +194,7 @@ func dataSourceIbmSccReportRead(context context.Context, d *schema.ResourceData,
 	report, response, err := resultsClient.GetReportWithContext(context, getReportOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetReportWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetReportWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetReportWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*report.ID)
@@ -324,7 +324,7 @@ func dataSourceIbmSccReportControlsRead(context context.Context, d *schema.Resou
 	reportControls, response, err := resultsClient.GetReportControlsWithContext(context, getReportControlsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetReportControlsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetReportControlsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetReportControlsWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(dataSourceIbmSccReportControlsID(d))
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
 )
 
@@ -102,7 +103,7 @@ func dataSourceIbmSccReportRuleRead(context context.Context, d *schema.ResourceD
 	ruleInfo, response, err := resultsClient.GetReportRuleWithContext(context, getReportRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetReportRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetReportRuleWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetReportRuleWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*ruleInfo.ID)
@@ -307,7 +307,7 @@ func dataSourceIbmSccReportSummaryRead(context context.Context, d *schema.Resour
 	reportSummary, response, err := resultsClient.GetReportSummaryWithContext(context, getReportSummaryOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetReportSummaryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetReportSummaryWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetReportSummaryWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(dataSourceIbmSccReportSummaryID(d))
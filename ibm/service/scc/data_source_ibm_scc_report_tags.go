@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
 )
 
@@ -77,7 +78,7 @@ func dataSourceIbmSccReportTagsRead(context context.Context, d *schema.ResourceD
 	reportTags, response, err := resultsClient.GetReportTagsWithContext(context, getReportTagsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetReportTagsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetReportTagsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetReportTagsWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(dataSourceIbmSccReportTagsID(d))
@@ -122,7 +122,7 @@ func dataSourceIbmSccReportViolationDriftRead(context context.Context, d *schema
 	reportViolationsDrift, response, err := resultsClient.GetReportViolationsDriftWithContext(context, getReportViolationsDriftOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetReportViolationsDriftWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetReportViolationsDriftWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetReportViolationsDriftWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(dataSourceIbmSccReportViolationDriftID(d))
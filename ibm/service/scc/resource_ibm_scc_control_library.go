@@ -373,7 +373,7 @@ func resourceIbmSccControlLibraryCreate(context context.Context, d *schema.Resou
 	controlLibrary, response, err := securityandcompliancecenterapiClient.CreateCustomControlLibraryWithContext(context, createCustomControlLibraryOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateCustomControlLibraryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateCustomControlLibraryWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateCustomControlLibraryWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(instance_id + "/" + *controlLibrary.ID)
@@ -402,7 +402,7 @@ func resourceIbmSccControlLibraryRead(context context.Context, d *schema.Resourc
 			return nil
 		}
 		log.Printf("[DEBUG] GetControlLibraryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetControlLibraryWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetControlLibraryWithContext failed", err, response).Diagnostics()
 	}
 	if err = d.Set("instance_id", parts[0]); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
@@ -551,7 +551,7 @@ func resourceIbmSccControlLibraryUpdate(context context.Context, d *schema.Resou
 		_, response, err := securityandcompliancecenterapiClient.ReplaceCustomControlLibraryWithContext(context, replaceCustomControlLibraryOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ReplaceCustomControlLibraryWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceCustomControlLibraryWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ReplaceCustomControlLibraryWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -576,7 +576,7 @@ func resourceIbmSccControlLibraryDelete(context context.Context, d *schema.Resou
 	_, response, err := securityandcompliancecenterapiClient.DeleteCustomControlLibraryWithContext(context, deleteCustomControlLibraryOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteCustomControlLibraryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteCustomControlLibraryWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteCustomControlLibraryWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
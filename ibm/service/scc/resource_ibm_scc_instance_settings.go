@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
@@ -147,7 +148,7 @@ func resourceIbmSccInstanceSettingsCreate(context context.Context, d *schema.Res
 	_, response, err := adminClient.UpdateSettingsWithContext(context, updateSettingsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] UpdateSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("UpdateSettingsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("UpdateSettingsWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(instance_id)
@@ -172,7 +173,7 @@ func resourceIbmSccInstanceSettingsRead(context context.Context, d *schema.Resou
 			return nil
 		}
 		log.Printf("[DEBUG] GetSettingsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSettingsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSettingsWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("instance_id", instance_id); err != nil {
@@ -237,7 +238,7 @@ func resourceIbmSccInstanceSettingsUpdate(context context.Context, d *schema.Res
 		_, response, err := adminClient.UpdateSettingsWithContext(context, updateSettingsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateSettingsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSettingsWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSettingsWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -386,7 +386,7 @@ func resourceIbmSccProfileCreate(context context.Context, d *schema.ResourceData
 	profile, response, err := securityandcompliancecenterapiClient.CreateProfileWithContext(context, createProfileOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateProfileWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateProfileWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateProfileWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(instance_id + "/" + *profile.ID)
@@ -417,7 +417,7 @@ func resourceIbmSccProfileRead(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetProfileWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProfileWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetProfileWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("instance_id", parts[0]); err != nil {
@@ -580,7 +580,7 @@ func resourceIbmSccProfileUpdate(context context.Context, d *schema.ResourceData
 		_, response, err := securityandcompliancecenterapiClient.ReplaceProfileWithContext(context, replaceProfileOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ReplaceProfileWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceProfileWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ReplaceProfileWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -605,7 +605,7 @@ func resourceIbmSccProfileDelete(context context.Context, d *schema.ResourceData
 	_, response, err := securityandcompliancecenterapiClient.DeleteCustomProfileWithContext(context, deleteCustomProfileOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteCustomProfileWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteCustomProfileWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteCustomProfileWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
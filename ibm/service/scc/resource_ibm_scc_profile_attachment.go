@@ -313,7 +313,7 @@ func resourceIbmSccProfileAttachmentCreate(context context.Context, d *schema.Re
 	attachmentPrototype, response, err := securityandcompliancecenterapiClient.CreateAttachmentWithContext(context, createAttachmentOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateAttachmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateAttachmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateAttachmentWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instance_id, *createAttachmentOptions.ProfileID, *attachmentPrototype.Attachments[0].ID))
@@ -345,7 +345,7 @@ func resourceIbmSccProfileAttachmentRead(context context.Context, d *schema.Reso
 			return nil
 		}
 		log.Printf("[DEBUG] GetProfileAttachmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProfileAttachmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetProfileAttachmentWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("instance_id", parts[0]); err != nil {
@@ -551,7 +551,7 @@ func resourceIbmSccProfileAttachmentUpdate(context context.Context, d *schema.Re
 		_, response, err := securityandcompliancecenterapiClient.ReplaceProfileAttachmentWithContext(context, replaceProfileAttachmentOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ReplaceProfileAttachmentWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceProfileAttachmentWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ReplaceProfileAttachmentWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -578,7 +578,7 @@ func resourceIbmSccProfileAttachmentDelete(context context.Context, d *schema.Re
 	_, response, err := securityandcompliancecenterapiClient.DeleteProfileAttachmentWithContext(context, deleteProfileAttachmentOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteProfileAttachmentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteProfileAttachmentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteProfileAttachmentWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -116,7 +116,7 @@ func resourceIbmSccProviderTypeInstanceCreate(context context.Context, d *schema
 	providerTypeInstanceItem, response, err := securityAndComplianceCenterApIsClient.CreateProviderTypeInstanceWithContext(context, createProviderTypeInstanceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateProviderTypeInstanceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateProviderTypeInstanceWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateProviderTypeInstanceWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, *createProviderTypeInstanceOptions.ProviderTypeID, *providerTypeInstanceItem.ID))
@@ -148,7 +148,7 @@ func resourceIbmSccProviderTypeInstanceRead(context context.Context, d *schema.R
 			return nil
 		}
 		log.Printf("[DEBUG] GetProviderTypeInstanceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetProviderTypeInstanceWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetProviderTypeInstanceWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("instance_id", parts[0]); err != nil {
@@ -232,7 +232,7 @@ func resourceIbmSccProviderTypeInstanceUpdate(context context.Context, d *schema
 		_, response, err := securityAndComplianceCenterApIsClient.UpdateProviderTypeInstanceWithContext(context, updateProviderTypeInstanceOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateProviderTypeInstanceWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateProviderTypeInstanceWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateProviderTypeInstanceWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -259,7 +259,7 @@ func resourceIbmSccProviderTypeInstanceDelete(context context.Context, d *schema
 	response, err := securityAndComplianceCenterApIsClient.DeleteProviderTypeInstanceWithContext(context, deleteProviderTypeInstanceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteProviderTypeInstanceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteProviderTypeInstanceWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteProviderTypeInstanceWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -524,7 +524,7 @@ func resourceIbmSccRuleCreate(context context.Context, d *schema.ResourceData, m
 	rule, response, err := configManagerClient.CreateRuleWithContext(context, createRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateRuleWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateRuleWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(instance_id + "/" + *rule.ID)
@@ -554,7 +554,7 @@ func resourceIbmSccRuleRead(context context.Context, d *schema.ResourceData, met
 			return nil
 		}
 		log.Printf("[DEBUG] GetRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetRuleWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetRuleWithContext failed", err, response).Diagnostics()
 	}
 	// Manual Intervention
 	if err = d.Set("instance_id", parts[0]); err != nil {
@@ -697,7 +697,7 @@ func resourceIbmSccRuleUpdate(context context.Context, d *schema.ResourceData, m
 		_, response, err := configManagerClient.ReplaceRuleWithContext(context, replaceRuleOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ReplaceRuleWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceRuleWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ReplaceRuleWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -722,7 +722,7 @@ func resourceIbmSccRuleDelete(context context.Context, d *schema.ResourceData, m
 	response, err := configManagerClient.DeleteRuleWithContext(context, deleteRuleOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteRuleWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteRuleWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteRuleWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -549,7 +549,7 @@ func dataSourceIbmSchematicsAgentRead(context context.Context, d *schema.Resourc
 	agentData, response, err := schematicsClient.GetAgentDataWithContext(context, getAgentDataOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetAgentDataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAgentDataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetAgentDataWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s", *getAgentDataOptions.AgentID))
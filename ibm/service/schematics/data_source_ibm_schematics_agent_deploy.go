@@ -90,7 +90,7 @@ func dataSourceIbmSchematicsAgentDeployRead(context context.Context, d *schema.R
 			return nil
 		}
 		log.Printf("[DEBUG] GetAgentDataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAgentDataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetAgentDataWithContext failed", err, response).Diagnostics()
 	}
 	d.SetId(DataSourceIBMSchematicsAgentID(d))
 
@@ -85,7 +85,7 @@ func dataSourceIbmSchematicsAgentHealthRead(context context.Context, d *schema.R
 			return nil
 		}
 		log.Printf("[DEBUG] GetAgentDataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAgentDataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetAgentDataWithContext failed", err, response).Diagnostics()
 	}
 	d.SetId(DataSourceIBMSchematicsAgentID(d))
 
@@ -85,7 +85,7 @@ func dataSourceIbmSchematicsAgentPrsRead(context context.Context, d *schema.Reso
 			return nil
 		}
 		log.Printf("[DEBUG] GetAgentDataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAgentDataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetAgentDataWithContext failed", err, response).Diagnostics()
 	}
 	d.SetId(DataSourceIBMSchematicsAgentID(d))
 
@@ -196,7 +196,7 @@ func dataSourceIbmSchematicsAgentsRead(context context.Context, d *schema.Resour
 	agentList, response, err := schematicsClient.ListAgentWithContext(context, listAgentOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListAgentWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListAgentWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ListAgentWithContext failed", err, response).Diagnostics()
 	}
 
 	// Use the provided filter argument and construct a new list with only the requested resource(s)
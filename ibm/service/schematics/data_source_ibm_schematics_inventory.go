@@ -109,7 +109,7 @@ func dataSourceIBMSchematicsInventoryRead(context context.Context, d *schema.Res
 	inventoryResourceRecord, response, err := schematicsClient.GetInventoryWithContext(context, getInventoryOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetInventoryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetInventoryWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetInventoryWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*getInventoryOptions.InventoryID)
@@ -2832,7 +2832,7 @@ func dataSourceIBMSchematicsJobRead(context context.Context, d *schema.ResourceD
 	job, response, err := schematicsClient.GetJobWithContext(context, getJobOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetJobWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetJobWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*getJobOptions.JobID)
@@ -153,7 +153,7 @@ func dataSourceIbmSchematicsPoliciesRead(context context.Context, d *schema.Reso
 	policyList, response, err := schematicsClient.ListPolicyWithContext(context, listPolicyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListPolicyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListPolicyWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ListPolicyWithContext failed", err, response).Diagnostics()
 	}
 
 	// Use the provided filter argument and construct a new list with only the requested resource(s)
@@ -278,7 +278,7 @@ func dataSourceIbmSchematicsPolicyRead(context context.Context, d *schema.Resour
 	policy, response, err := schematicsClient.GetPolicyWithContext(context, getPolicyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetPolicyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetPolicyWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetPolicyWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s", *getPolicyOptions.PolicyID))
@@ -134,7 +134,7 @@ func dataSourceIBMSchematicsResourceQueryRead(context context.Context, d *schema
 	resourceQueryRecord, response, err := schematicsClient.GetResourcesQueryWithContext(context, getResourcesQueryOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetResourcesQueryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetResourcesQueryWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetResourcesQueryWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*getResourcesQueryOptions.QueryID)
@@ -77,7 +77,7 @@ func dataSourceIBMSchematicsStateRead(context context.Context, d *schema.Resourc
 	_, response, _ := schematicsClient.GetWorkspaceTemplateStateWithContext(context, getWorkspaceTemplateStateOptions)
 	if response.StatusCode != 200 {
 		log.Printf("[DEBUG] GetWorkspaceTemplateStateWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetWorkspaceTemplateStateWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetWorkspaceTemplateStateWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(dataSourceIBMSchematicsStateID(d))
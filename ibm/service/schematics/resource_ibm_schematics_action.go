@@ -1078,7 +1078,7 @@ func resourceIBMSchematicsActionCreate(context context.Context, d *schema.Resour
 	action, response, err := schematicsClient.CreateActionWithContext(context, createActionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateActionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateActionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateActionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*action.ID)
@@ -1353,7 +1353,7 @@ func resourceIBMSchematicsActionRead(context context.Context, d *schema.Resource
 			return nil
 		}
 		log.Printf("[DEBUG] GetActionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetActionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetActionWithContext failed", err, response).Diagnostics()
 	}
 	if err = d.Set("name", action.Name); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
@@ -1908,7 +1908,7 @@ func resourceIBMSchematicsActionUpdate(context context.Context, d *schema.Resour
 		_, response, err := schematicsClient.UpdateActionWithContext(context, updateActionOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateActionWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateActionWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateActionWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -1933,7 +1933,7 @@ func resourceIBMSchematicsActionDelete(context context.Context, d *schema.Resour
 	response, err := schematicsClient.DeleteActionWithContext(context, deleteActionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteActionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteActionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteActionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -608,7 +608,7 @@ func resourceIbmSchematicsAgentCreate(context context.Context, d *schema.Resourc
 	agentData, response, err := schematicsClient.CreateAgentDataWithContext(context, createAgentDataOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateAgentDataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateAgentDataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateAgentDataWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*agentData.ID)
@@ -635,7 +635,7 @@ func resourceIbmSchematicsAgentRead(context context.Context, d *schema.ResourceD
 			return nil
 		}
 		log.Printf("[DEBUG] GetAgentDataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAgentDataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetAgentDataWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("name", agentData.Name); err != nil {
@@ -801,7 +801,7 @@ func resourceIbmSchematicsAgentUpdate(context context.Context, d *schema.Resourc
 			return nil
 		}
 		log.Printf("[DEBUG] GetAgentDataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAgentDataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetAgentDataWithContext failed", err, response).Diagnostics()
 	}
 	if agentData.Name != nil {
 		updateAgentDataOptions.Name = agentData.Name
@@ -887,7 +887,7 @@ func resourceIbmSchematicsAgentUpdate(context context.Context, d *schema.Resourc
 		_, response, err := schematicsClient.UpdateAgentDataWithContext(context, updateAgentDataOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateAgentDataWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateAgentDataWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateAgentDataWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -916,7 +916,7 @@ func resourceIbmSchematicsAgentDelete(context context.Context, d *schema.Resourc
 	response, err := schematicsClient.DeleteAgentDataWithContext(context, deleteAgentDataOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteAgentDataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteAgentDataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteAgentDataWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
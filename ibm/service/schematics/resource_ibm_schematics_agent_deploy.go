@@ -114,7 +114,7 @@ func resourceIbmSchematicsAgentDeployCreate(context context.Context, d *schema.R
 	agentDeployJob, response, err := schematicsClient.DeployAgentJobWithContext(context, deployAgentJobOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeployAgentJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeployAgentJobWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeployAgentJobWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *deployAgentJobOptions.AgentID, *agentDeployJob.JobID))
@@ -160,7 +160,7 @@ func agentRefreshFunc(schematicsClient *schematicsv1.SchematicsV1, id string) re
 
 		agent, response, err := schematicsClient.GetAgentData(getAgentDataOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Agent: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting Agent", err, response)
 		}
 		if agent.RecentDeployJob.StatusCode != nil {
 			return agent, *agent.RecentDeployJob.StatusCode, nil
@@ -192,7 +192,7 @@ func resourceIbmSchematicsAgentDeployRead(context context.Context, d *schema.Res
 			return nil
 		}
 		log.Printf("[DEBUG] GetAgentDataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAgentDataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetAgentDataWithContext failed", err, response).Diagnostics()
 	}
 	if agentData.RecentDeployJob != nil {
 
@@ -265,7 +265,7 @@ func resourceIbmSchematicsAgentDeployUpdate(context context.Context, d *schema.R
 		agentDeployJob, response, err := schematicsClient.DeployAgentJobWithContext(context, deployAgentJobOptions)
 		if err != nil {
 			log.Printf("[DEBUG] DeployAgentJobWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("DeployAgentJobWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("DeployAgentJobWithContext failed", err, response).Diagnostics()
 		}
 		d.SetId(fmt.Sprintf("%s/%s", *deployAgentJobOptions.AgentID, *agentDeployJob.JobID))
 
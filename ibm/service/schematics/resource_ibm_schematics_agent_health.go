@@ -103,7 +103,7 @@ func resourceIbmSchematicsAgentHealthCreate(context context.Context, d *schema.R
 	agentHealthJob, response, err := schematicsClient.HealthCheckAgentJobWithContext(context, healthCheckAgentJobOptions)
 	if err != nil {
 		log.Printf("[DEBUG] HealthCheckAgentJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("HealthCheckAgentJobWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("HealthCheckAgentJobWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *healthCheckAgentJobOptions.AgentID, *agentHealthJob.JobID))
@@ -135,7 +135,7 @@ func resourceIbmSchematicsAgentHealthRead(context context.Context, d *schema.Res
 			return nil
 		}
 		log.Printf("[DEBUG] GetAgentDataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAgentDataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetAgentDataWithContext failed", err, response).Diagnostics()
 	}
 
 	if agentData.RecentHealthJob != nil {
@@ -211,7 +211,7 @@ func resourceIbmSchematicsAgentHealthUpdate(context context.Context, d *schema.R
 		agentHealthJob, response, err := schematicsClient.HealthCheckAgentJobWithContext(context, healthCheckAgentJobOptions)
 		if err != nil {
 			log.Printf("[DEBUG] HealthCheckAgentJobWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("HealthCheckAgentJobWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("HealthCheckAgentJobWithContext failed", err, response).Diagnostics()
 		}
 		d.SetId(fmt.Sprintf("%s/%s", *healthCheckAgentJobOptions.AgentID, *agentHealthJob.JobID))
 	}
@@ -102,7 +102,7 @@ func resourceIbmSchematicsAgentPrsCreate(context context.Context, d *schema.Reso
 	agentPrsJob, response, err := schematicsClient.PrsAgentJobWithContext(context, prsAgentJobOptions)
 	if err != nil {
 		log.Printf("[DEBUG] PrsAgentJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("PrsAgentJobWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("PrsAgentJobWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *prsAgentJobOptions.AgentID, *agentPrsJob.JobID))
@@ -134,7 +134,7 @@ func resourceIbmSchematicsAgentPrsRead(context context.Context, d *schema.Resour
 			return nil
 		}
 		log.Printf("[DEBUG] GetAgentDataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetAgentDataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetAgentDataWithContext failed", err, response).Diagnostics()
 	}
 	if agentData.RecentPrsJob != nil {
 
@@ -208,7 +208,7 @@ func resourceIbmSchematicsAgentPrsUpdate(context context.Context, d *schema.Reso
 		agentPrsJob, response, err := schematicsClient.PrsAgentJobWithContext(context, prsAgentJobOptions)
 		if err != nil {
 			log.Printf("[DEBUG] PrsAgentJobWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("PrsAgentJobWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("PrsAgentJobWithContext failed", err, response).Diagnostics()
 		}
 		d.SetId(fmt.Sprintf("%s/%s", *prsAgentJobOptions.AgentID, *agentPrsJob.JobID))
 	}
@@ -145,7 +145,7 @@ func resourceIBMSchematicsInventoryCreate(context context.Context, d *schema.Res
 	inventoryResourceRecord, response, err := schematicsClient.CreateInventoryWithContext(context, createInventoryOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateInventoryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateInventoryWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateInventoryWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*inventoryResourceRecord.ID)
@@ -176,7 +176,7 @@ func resourceIBMSchematicsInventoryRead(context context.Context, d *schema.Resou
 			return nil
 		}
 		log.Printf("[DEBUG] GetInventoryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetInventoryWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetInventoryWithContext failed", err, response).Diagnostics()
 	}
 	if err = d.Set("name", inventoryResourceRecord.Name); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
@@ -266,7 +266,7 @@ func resourceIBMSchematicsInventoryUpdate(context context.Context, d *schema.Res
 		_, response, err := schematicsClient.ReplaceInventoryWithContext(context, updateInventoryOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateInventoryWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateInventoryWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateInventoryWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -291,7 +291,7 @@ func resourceIBMSchematicsInventoryDelete(context context.Context, d *schema.Res
 	response, err := schematicsClient.DeleteInventoryWithContext(context, deleteInventoryOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteInventoryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteInventoryWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteInventoryWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
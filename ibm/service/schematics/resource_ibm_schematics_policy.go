@@ -350,7 +350,7 @@ func resourceIbmSchematicsPolicyCreate(context context.Context, d *schema.Resour
 	policy, response, err := schematicsClient.CreatePolicyWithContext(context, createPolicyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreatePolicyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreatePolicyWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreatePolicyWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*policy.ID)
@@ -375,7 +375,7 @@ func resourceIbmSchematicsPolicyRead(context context.Context, d *schema.Resource
 			return nil
 		}
 		log.Printf("[DEBUG] GetPolicyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetPolicyWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetPolicyWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("name", policy.Name); err != nil {
@@ -522,7 +522,7 @@ func resourceIbmSchematicsPolicyUpdate(context context.Context, d *schema.Resour
 		_, response, err := schematicsClient.UpdatePolicyWithContext(context, updatePolicyOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdatePolicyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdatePolicyWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdatePolicyWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -542,7 +542,7 @@ func resourceIbmSchematicsPolicyDelete(context context.Context, d *schema.Resour
 	response, err := schematicsClient.DeletePolicyWithContext(context, deletePolicyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeletePolicyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeletePolicyWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeletePolicyWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
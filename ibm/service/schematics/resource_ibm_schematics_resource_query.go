@@ -167,7 +167,7 @@ func resourceIBMSchematicsResourceQueryCreate(context context.Context, d *schema
 	resourceQueryRecord, response, err := schematicsClient.CreateResourceQueryWithContext(context, createResourceQueryOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateResourceQueryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateResourceQueryWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateResourceQueryWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*resourceQueryRecord.ID)
@@ -238,7 +238,7 @@ func resourceIBMSchematicsResourceQueryRead(context context.Context, d *schema.R
 			return nil
 		}
 		log.Printf("[DEBUG] GetResourcesQueryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetResourcesQueryWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetResourcesQueryWithContext failed", err, response).Diagnostics()
 	}
 	if err = d.Set("type", resourceQueryRecord.Type); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting type: %s", err))
@@ -343,7 +343,7 @@ func resourceIBMSchematicsResourceQueryUpdate(context context.Context, d *schema
 	_, response, err := schematicsClient.ReplaceResourcesQueryWithContext(context, replaceResourcesQueryOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ReplaceResourcesQueryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ReplaceResourcesQueryWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ReplaceResourcesQueryWithContext failed", err, response).Diagnostics()
 	}
 
 	return resourceIBMSchematicsResourceQueryRead(context, d, meta)
@@ -367,7 +367,7 @@ func resourceIBMSchematicsResourceQueryDelete(context context.Context, d *schema
 	response, err := schematicsClient.DeleteResourcesQueryWithContext(context, deleteResourcesQueryOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteResourcesQueryWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteResourcesQueryWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteResourcesQueryWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -754,7 +754,7 @@ func resourceIBMSchematicsWorkspaceCreate(context context.Context, d *schema.Res
 	workspaceResponse, response, err := schematicsClient.CreateWorkspaceWithContext(context, createWorkspaceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateWorkspaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateWorkspaceWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateWorkspaceWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*workspaceResponse.ID)
@@ -1096,7 +1096,7 @@ func resourceIBMSchematicsWorkspaceRead(context context.Context, d *schema.Resou
 			return nil
 		}
 		log.Printf("[DEBUG] GetWorkspaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetWorkspaceWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetWorkspaceWithContext failed", err, response).Diagnostics()
 	}
 	if workspaceResponse.AppliedShareddataIds != nil {
 		if err = d.Set("applied_shareddata_ids", workspaceResponse.AppliedShareddataIds); err != nil {
@@ -1815,7 +1815,7 @@ func resourceIBMSchematicsWorkspaceUpdate(context context.Context, d *schema.Res
 			_, response, err := schematicsClient.ReplaceWorkspaceWithContext(context, replaceWorkspaceOptions)
 			if err != nil {
 				log.Printf("[DEBUG] ReplaceWorkspaceWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("ReplaceWorkspaceWithContext failed %s\n%s", err, response))
+				return flex.NewServiceError("ReplaceWorkspaceWithContext failed", err, response).Diagnostics()
 			}
 		}
 
@@ -1823,7 +1823,7 @@ func resourceIBMSchematicsWorkspaceUpdate(context context.Context, d *schema.Res
 			_, response, err := schematicsClient.UpdateWorkspaceWithContext(context, updateWorkspaceOptions)
 			if err != nil {
 				log.Printf("[DEBUG] UpdateWorkspaceWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("UpdateWorkspaceWithContext failed %s\n%s", err, response))
+				return flex.NewServiceError("UpdateWorkspaceWithContext failed", err, response).Diagnostics()
 			}
 		}
 
@@ -1850,7 +1850,7 @@ func resourceIBMSchematicsWorkspaceUpdate(context context.Context, d *schema.Res
 				_, response, err := schematicsClient.ReplaceWorkspaceInputs(replaceWorkspaceInputsOptions)
 				if err != nil {
 					log.Printf("[DEBUG] ReplaceWorkspaceInputs failed %s\n%s", err, response)
-					return diag.FromErr(fmt.Errorf("ReplaceWorkspaceInputs failed %s\n%s", err, response))
+					return flex.NewServiceError("ReplaceWorkspaceInputs failed", err, response).Diagnostics()
 				}
 			}
 		}
@@ -1886,7 +1886,7 @@ func resourceIBMSchematicsWorkspaceDelete(context context.Context, d *schema.Res
 	_, response, err := schematicsClient.DeleteWorkspaceWithContext(context, deleteWorkspaceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteWorkspaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteWorkspaceWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteWorkspaceWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -134,7 +134,7 @@ func dataSourceIbmSmArbitrarySecretMetadataRead(context context.Context, d *sche
 	arbitrarySecretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetSecretMetadataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretMetadataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSecretMetadataWithContext failed", err, response).Diagnostics()
 	}
 
 	arbitrarySecretMetadata := arbitrarySecretMetadataIntf.(*secretsmanagerv2.ArbitrarySecretMetadata)
@@ -11,6 +11,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
@@ -43,7 +44,7 @@ func dataSourceIbmSmEnRegistrationRead(context context.Context, d *schema.Resour
 	notificationsRegistration, response, err := secretsManagerClient.GetNotificationsRegistrationWithContext(context, getNotificationsRegistrationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetNotificationsRegistrationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetNotificationsRegistrationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetNotificationsRegistrationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", region, instanceId))
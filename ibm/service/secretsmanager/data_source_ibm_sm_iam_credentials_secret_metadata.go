@@ -191,7 +191,7 @@ func dataSourceIbmSmIamCredentialsSecretMetadataRead(context context.Context, d
 	iAMCredentialsSecretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetSecretMetadataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretMetadataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSecretMetadataWithContext failed", err, response).Diagnostics()
 	}
 	iAMCredentialsSecretMetadata := iAMCredentialsSecretMetadataIntf.(*secretsmanagerv2.IAMCredentialsSecretMetadata)
 
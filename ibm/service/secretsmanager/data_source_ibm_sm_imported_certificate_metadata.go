@@ -196,7 +196,7 @@ func dataSourceIbmSmImportedCertificateMetadataRead(context context.Context, d *
 	importedCertificateMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetSecretMetadataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretMetadataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSecretMetadataWithContext failed", err, response).Diagnostics()
 	}
 	importedCertificateMetadata := importedCertificateMetadataIntf.(*secretsmanagerv2.ImportedCertificateMetadata)
 
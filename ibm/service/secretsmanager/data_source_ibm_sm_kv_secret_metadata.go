@@ -129,7 +129,7 @@ func dataSourceIbmSmKvSecretMetadataRead(context context.Context, d *schema.Reso
 	kVSecretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetSecretMetadataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretMetadataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSecretMetadataWithContext failed", err, response).Diagnostics()
 	}
 	kVSecretMetadata := kVSecretMetadataIntf.(*secretsmanagerv2.KVSecretMetadata)
 
@@ -270,7 +270,7 @@ func dataSourceIbmSmPrivateCertificateConfigurationIntermediateCARead(context co
 	configurationIntf, response, err := secretsManagerClient.GetConfigurationWithContext(context, getConfigurationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetConfigurationWithContext failed", err, response).Diagnostics()
 	}
 	privateCertificateConfigurationIntermediateCA := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationIntermediateCA)
 
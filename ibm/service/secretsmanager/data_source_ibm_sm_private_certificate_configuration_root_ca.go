@@ -294,7 +294,7 @@ func dataSourceIbmSmPrivateCertificateConfigurationRootCARead(context context.Co
 	privateCertificateConfigurationRootCAIntf, response, err := secretsManagerClient.GetConfigurationWithContext(context, getConfigurationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetConfigurationWithContext failed", err, response).Diagnostics()
 	}
 
 	privateCertificateConfigurationRootCA := privateCertificateConfigurationRootCAIntf.(*secretsmanagerv2.PrivateCertificateConfigurationRootCA)
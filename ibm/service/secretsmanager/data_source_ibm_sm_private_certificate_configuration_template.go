@@ -304,7 +304,7 @@ func dataSourceIbmSmPrivateCertificateConfigurationTemplateRead(context context.
 	privateCertificateConfigurationTemplateIntf, response, err := secretsManagerClient.GetConfigurationWithContext(context, getConfigurationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetConfigurationWithContext failed", err, response).Diagnostics()
 	}
 	privateCertificateConfigurationTemplate := privateCertificateConfigurationTemplateIntf.(*secretsmanagerv2.PrivateCertificateConfigurationTemplate)
 
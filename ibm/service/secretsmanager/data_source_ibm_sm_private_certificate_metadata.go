@@ -235,7 +235,7 @@ func dataSourceIbmSmPrivateCertificateMetadataRead(context context.Context, d *s
 	privateCertificateMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetSecretMetadataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretMetadataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSecretMetadataWithContext failed", err, response).Diagnostics()
 	}
 
 	privateCertificateMetadata := privateCertificateMetadataIntf.(*secretsmanagerv2.PrivateCertificateMetadata)
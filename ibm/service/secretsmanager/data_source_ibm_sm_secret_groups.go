@@ -80,7 +80,7 @@ func dataSourceIbmSmSecretGroupsRead(context context.Context, d *schema.Resource
 	secretGroupCollection, response, err := secretsManagerClient.ListSecretGroupsWithContext(context, listSecretGroupsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListSecretGroupsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListSecretGroupsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ListSecretGroupsWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", region, instanceId))
@@ -162,7 +162,7 @@ func resourceIbmSmArbitrarySecretCreate(context context.Context, d *schema.Resou
 	secretIntf, response, err := secretsManagerClient.CreateSecretWithContext(context, createSecretOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSecretWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateSecretWithContext failed", err, response).Diagnostics()
 	}
 
 	secret := secretIntf.(*secretsmanagerv2.ArbitrarySecret)
@@ -193,13 +193,13 @@ func waitForIbmSmArbitrarySecretCreate(secretsManagerClient *secretsmanagerv2.Se
 			stateObj := stateObjIntf.(*secretsmanagerv2.ArbitrarySecret)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("The instance %s does not exist anymore: %s\n%s", "getSecretOptions", err, response)
+					return nil, "", flex.NewServiceError(fmt.Sprintf("The instance %s does not exist anymore", "getSecretOptions"), err, response)
 				}
 				return nil, "", err
 			}
 			failStates := map[string]bool{"destroyed": true}
 			if failStates[*stateObj.StateDescription] {
-				return stateObj, *stateObj.StateDescription, fmt.Errorf("The instance %s failed: %s\n%s", "getSecretOptions", err, response)
+				return stateObj, *stateObj.StateDescription, flex.NewServiceError(fmt.Sprintf("The instance %s failed", "getSecretOptions"), err, response)
 			}
 			return stateObj, *stateObj.StateDescription, nil
 		},
@@ -237,7 +237,7 @@ func resourceIbmSmArbitrarySecretRead(context context.Context, d *schema.Resourc
 			return nil
 		}
 		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSecretWithContext failed", err, response).Diagnostics()
 	}
 
 	secret := secretIntf.(*secretsmanagerv2.ArbitrarySecret)
@@ -313,7 +313,7 @@ func resourceIbmSmArbitrarySecretRead(context context.Context, d *schema.Resourc
 	versionMetadataIntf, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getVersionMetdataOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretVersionMetadataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSecretVersionMetadataWithContext failed", err, response).Diagnostics()
 	}
 
 	versionMetadata := versionMetadataIntf.(*secretsmanagerv2.ArbitrarySecretVersionMetadata)
@@ -389,7 +389,7 @@ func resourceIbmSmArbitrarySecretUpdate(context context.Context, d *schema.Resou
 		_, response, err := secretsManagerClient.UpdateSecretMetadataWithContext(context, updateSecretMetadataOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateSecretMetadataWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSecretMetadataWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSecretMetadataWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -415,7 +415,7 @@ func resourceIbmSmArbitrarySecretUpdate(context context.Context, d *schema.Resou
 				resourceIbmSmArbitrarySecretRead(context, d, meta)
 			}
 			log.Printf("[DEBUG] CreateSecretVersionWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("CreateSecretVersionWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("CreateSecretVersionWithContext failed", err, response).Diagnostics()
 		}
 	} else if d.HasChange("version_custom_metadata") {
 		// Apply change to version_custom_metadata in current version
@@ -434,7 +434,7 @@ func resourceIbmSmArbitrarySecretUpdate(context context.Context, d *schema.Resou
 				resourceIbmSmArbitrarySecretRead(context, d, meta)
 			}
 			log.Printf("[DEBUG] UpdateSecretVersionMetadataWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSecretVersionMetadataWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSecretVersionMetadataWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -460,7 +460,7 @@ func resourceIbmSmArbitrarySecretDelete(context context.Context, d *schema.Resou
 	response, err := secretsManagerClient.DeleteSecretWithContext(context, deleteSecretOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteSecretWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteSecretWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
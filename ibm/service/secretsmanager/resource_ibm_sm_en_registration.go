@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
@@ -108,7 +109,7 @@ func resourceIbmSmEnRegistrationCreate(context context.Context, d *schema.Resour
 	_, response, err := secretsManagerClient.CreateNotificationsRegistrationWithContext(context, createNotificationsRegistrationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateNotificationsRegistrationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateNotificationsRegistrationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateNotificationsRegistrationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", region, instanceId))
@@ -139,7 +140,7 @@ func resourceIbmSmEnRegistrationRead(context context.Context, d *schema.Resource
 			return nil
 		}
 		log.Printf("[DEBUG] GetNotificationsRegistrationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetNotificationsRegistrationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetNotificationsRegistrationWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("instance_id", instanceId); err != nil {
@@ -184,7 +185,7 @@ func resourceIbmSmEnRegistrationUpdate(context context.Context, d *schema.Resour
 		_, response, err := secretsManagerClient.CreateNotificationsRegistrationWithContext(context, createNotificationsRegistrationOptions)
 		if err != nil {
 			log.Printf("[DEBUG] CreateNotificationsRegistrationWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("CreateNotificationsRegistrationWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("CreateNotificationsRegistrationWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -207,7 +208,7 @@ func resourceIbmSmEnRegistrationDelete(context context.Context, d *schema.Resour
 	response, err := secretsManagerClient.DeleteNotificationsRegistrationWithContext(context, deleteNotificationsRegistrationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteNotificationsRegistrationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteNotificationsRegistrationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteNotificationsRegistrationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
@@ -88,7 +89,7 @@ func resourceIbmSmIamCredentialsConfigurationCreate(context context.Context, d *
 	configurationIntf, response, err := secretsManagerClient.CreateConfigurationWithContext(context, createConfigurationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateConfigurationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateConfigurationWithContext failed", err, response).Diagnostics()
 	}
 	configuration := configurationIntf.(*secretsmanagerv2.IAMCredentialsConfiguration)
 
@@ -123,7 +124,7 @@ func resourceIbmSmIamCredentialsConfigurationRead(context context.Context, d *sc
 			return nil
 		}
 		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetConfigurationWithContext failed", err, response).Diagnostics()
 	}
 	configuration := configurationIntf.(*secretsmanagerv2.IAMCredentialsConfiguration)
 
@@ -186,7 +187,7 @@ func resourceIbmSmIamCredentialsConfigurationUpdate(context context.Context, d *
 		_, response, err := secretsManagerClient.UpdateConfigurationWithContext(context, updateConfigurationOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateConfigurationWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateConfigurationWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateConfigurationWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -212,7 +213,7 @@ func resourceIbmSmIamCredentialsConfigurationDelete(context context.Context, d *
 	response, err := secretsManagerClient.DeleteConfigurationWithContext(context, deleteConfigurationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteConfigurationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteConfigurationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
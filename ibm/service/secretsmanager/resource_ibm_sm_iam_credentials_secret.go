@@ -228,7 +228,7 @@ func resourceIbmSmIamCredentialsSecretCreate(context context.Context, d *schema.
 	secretIntf, response, err := secretsManagerClient.CreateSecretWithContext(context, createSecretOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSecretWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateSecretWithContext failed", err, response).Diagnostics()
 	}
 
 	secret := secretIntf.(*secretsmanagerv2.IAMCredentialsSecret)
@@ -260,13 +260,13 @@ func waitForIbmSmIamCredentialsSecretCreate(secretsManagerClient *secretsmanager
 			stateObj := stateObjIntf.(*secretsmanagerv2.IAMCredentialsSecret)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("The instance %s does not exist anymore: %s\n%s", "getSecretOptions", err, response)
+					return nil, "", flex.NewServiceError(fmt.Sprintf("The instance %s does not exist anymore", "getSecretOptions"), err, response)
 				}
 				return nil, "", err
 			}
 			failStates := map[string]bool{"destroyed": true}
 			if failStates[*stateObj.StateDescription] {
-				return stateObj, *stateObj.StateDescription, fmt.Errorf("The instance %s failed: %s\n%s", "getSecretOptions", err, response)
+				return stateObj, *stateObj.StateDescription, flex.NewServiceError(fmt.Sprintf("The instance %s failed", "getSecretOptions"), err, response)
 			}
 			return stateObj, *stateObj.StateDescription, nil
 		},
@@ -304,7 +304,7 @@ func resourceIbmSmIamCredentialsSecretRead(context context.Context, d *schema.Re
 			return nil
 		}
 		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSecretWithContext failed", err, response).Diagnostics()
 	}
 
 	secret := secretIntf.(*secretsmanagerv2.IAMCredentialsSecret)
@@ -415,7 +415,7 @@ func resourceIbmSmIamCredentialsSecretRead(context context.Context, d *schema.Re
 	versionMetadataIntf, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getVersionMetdataOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretVersionMetadataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSecretVersionMetadataWithContext failed", err, response).Diagnostics()
 	}
 
 	versionMetadata := versionMetadataIntf.(*secretsmanagerv2.IAMCredentialsSecretVersionMetadata)
@@ -488,7 +488,7 @@ func resourceIbmSmIamCredentialsSecretUpdate(context context.Context, d *schema.
 		_, response, err := secretsManagerClient.UpdateSecretMetadataWithContext(context, updateSecretMetadataOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateSecretMetadataWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSecretMetadataWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSecretMetadataWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -509,7 +509,7 @@ func resourceIbmSmIamCredentialsSecretUpdate(context context.Context, d *schema.
 				resourceIbmSmIamCredentialsSecretRead(context, d, meta)
 			}
 			log.Printf("[DEBUG] UpdateSecretVersionMetadataWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSecretVersionMetadataWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSecretVersionMetadataWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -535,7 +535,7 @@ func resourceIbmSmIamCredentialsSecretDelete(context context.Context, d *schema.
 	response, err := secretsManagerClient.DeleteSecretWithContext(context, deleteSecretOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteSecretWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteSecretWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -247,7 +247,7 @@ func resourceIbmSmImportedCertificateCreate(context context.Context, d *schema.R
 	secretIntf, response, err := secretsManagerClient.CreateSecretWithContext(context, createSecretOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSecretWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateSecretWithContext failed", err, response).Diagnostics()
 	}
 	secret := secretIntf.(*secretsmanagerv2.ImportedCertificate)
 
@@ -279,13 +279,13 @@ func waitForIbmSmImportedCertificateCreate(secretsManagerClient *secretsmanagerv
 			stateObj := stateObjIntf.(*secretsmanagerv2.ImportedCertificate)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("The instance %s does not exist anymore: %s\n%s", "getSecretOptions", err, response)
+					return nil, "", flex.NewServiceError(fmt.Sprintf("The instance %s does not exist anymore", "getSecretOptions"), err, response)
 				}
 				return nil, "", err
 			}
 			failStates := map[string]bool{"destroyed": true}
 			if failStates[*stateObj.StateDescription] {
-				return stateObj, *stateObj.StateDescription, fmt.Errorf("The instance %s failed: %s\n%s", "getSecretOptions", err, response)
+				return stateObj, *stateObj.StateDescription, flex.NewServiceError(fmt.Sprintf("The instance %s failed", "getSecretOptions"), err, response)
 			}
 			return stateObj, *stateObj.StateDescription, nil
 		},
@@ -323,7 +323,7 @@ func resourceIbmSmImportedCertificateRead(context context.Context, d *schema.Res
 			return nil
 		}
 		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSecretWithContext failed", err, response).Diagnostics()
 	}
 	secret := secretIntf.(*secretsmanagerv2.ImportedCertificate)
 
@@ -437,7 +437,7 @@ func resourceIbmSmImportedCertificateRead(context context.Context, d *schema.Res
 	versionMetadataIntf, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getVersionMetdataOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretVersionMetadataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSecretVersionMetadataWithContext failed", err, response).Diagnostics()
 	}
 
 	versionMetadata := versionMetadataIntf.(*secretsmanagerv2.ImportedCertificateVersionMetadata)
@@ -498,7 +498,7 @@ func resourceIbmSmImportedCertificateUpdate(context context.Context, d *schema.R
 		_, response, err := secretsManagerClient.UpdateSecretMetadataWithContext(context, updateSecretMetadataOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateSecretMetadataWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSecretMetadataWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSecretMetadataWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -530,7 +530,7 @@ func resourceIbmSmImportedCertificateUpdate(context context.Context, d *schema.R
 				resourceIbmSmImportedCertificateRead(context, d, meta)
 			}
 			log.Printf("[DEBUG] CreateSecretVersionWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("CreateSecretVersionWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("CreateSecretVersionWithContext failed", err, response).Diagnostics()
 		}
 	} else if d.HasChange("version_custom_metadata") {
 		// Apply change to version_custom_metadata in current version
@@ -549,7 +549,7 @@ func resourceIbmSmImportedCertificateUpdate(context context.Context, d *schema.R
 				resourceIbmSmImportedCertificateRead(context, d, meta)
 			}
 			log.Printf("[DEBUG] UpdateSecretVersionMetadataWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSecretVersionMetadataWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSecretVersionMetadataWithContext failed", err, response).Diagnostics()
 		}
 	}
 	return resourceIbmSmImportedCertificateRead(context, d, meta)
@@ -574,7 +574,7 @@ func resourceIbmSmImportedCertificateDelete(context context.Context, d *schema.R
 	response, err := secretsManagerClient.DeleteSecretWithContext(context, deleteSecretOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteSecretWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteSecretWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -153,7 +153,7 @@ func resourceIbmSmKvSecretCreate(context context.Context, d *schema.ResourceData
 	secretIntf, response, err := secretsManagerClient.CreateSecretWithContext(context, createSecretOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSecretWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateSecretWithContext failed", err, response).Diagnostics()
 	}
 
 	secret := secretIntf.(*secretsmanagerv2.KVSecret)
@@ -185,13 +185,13 @@ func waitForIbmSmKvSecretCreate(secretsManagerClient *secretsmanagerv2.SecretsMa
 			stateObj := stateObjIntf.(*secretsmanagerv2.KVSecret)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("The instance %s does not exist anymore: %s\n%s", "getSecretOptions", err, response)
+					return nil, "", flex.NewServiceError(fmt.Sprintf("The instance %s does not exist anymore", "getSecretOptions"), err, response)
 				}
 				return nil, "", err
 			}
 			failStates := map[string]bool{"destroyed": true}
 			if failStates[*stateObj.StateDescription] {
-				return stateObj, *stateObj.StateDescription, fmt.Errorf("The instance %s failed: %s\n%s", "getSecretOptions", err, response)
+				return stateObj, *stateObj.StateDescription, flex.NewServiceError(fmt.Sprintf("The instance %s failed", "getSecretOptions"), err, response)
 			}
 			return stateObj, *stateObj.StateDescription, nil
 		},
@@ -229,7 +229,7 @@ func resourceIbmSmKvSecretRead(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSecretWithContext failed", err, response).Diagnostics()
 	}
 
 	secret := secretIntf.(*secretsmanagerv2.KVSecret)
@@ -302,7 +302,7 @@ func resourceIbmSmKvSecretRead(context context.Context, d *schema.ResourceData,
 	versionMetadataIntf, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getVersionMetdataOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretVersionMetadataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSecretVersionMetadataWithContext failed", err, response).Diagnostics()
 	}
 
 	versionMetadata := versionMetadataIntf.(*secretsmanagerv2.KVSecretVersionMetadata)
@@ -363,7 +363,7 @@ func resourceIbmSmKvSecretUpdate(context context.Context, d *schema.ResourceData
 		_, response, err := secretsManagerClient.UpdateSecretMetadataWithContext(context, updateSecretMetadataOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateSecretMetadataWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSecretMetadataWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSecretMetadataWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -389,7 +389,7 @@ func resourceIbmSmKvSecretUpdate(context context.Context, d *schema.ResourceData
 				resourceIbmSmKvSecretRead(context, d, meta)
 			}
 			log.Printf("[DEBUG] CreateSecretVersionWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("CreateSecretVersionWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("CreateSecretVersionWithContext failed", err, response).Diagnostics()
 		}
 	} else if d.HasChange("version_custom_metadata") {
 		// Apply change to version_custom_metadata in current version
@@ -408,7 +408,7 @@ func resourceIbmSmKvSecretUpdate(context context.Context, d *schema.ResourceData
 				resourceIbmSmKvSecretRead(context, d, meta)
 			}
 			log.Printf("[DEBUG] UpdateSecretVersionMetadataWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSecretVersionMetadataWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSecretVersionMetadataWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -434,7 +434,7 @@ func resourceIbmSmKvSecretDelete(context context.Context, d *schema.ResourceData
 	response, err := secretsManagerClient.DeleteSecretWithContext(context, deleteSecretOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteSecretWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteSecretWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -337,7 +337,7 @@ func resourceIbmSmPrivateCertificateCreate(context context.Context, d *schema.Re
 	secretIntf, response, err := secretsManagerClient.CreateSecretWithContext(context, createSecretOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSecretWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateSecretWithContext failed", err, response).Diagnostics()
 	}
 
 	secret := secretIntf.(*secretsmanagerv2.PrivateCertificate)
@@ -369,13 +369,13 @@ func waitForIbmSmPrivateCertificateCreate(secretsManagerClient *secretsmanagerv2
 			stateObj := stateObjIntf.(*secretsmanagerv2.PrivateCertificate)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("The instance %s does not exist anymore: %s\n%s", "getSecretOptions", err, response)
+					return nil, "", flex.NewServiceError(fmt.Sprintf("The instance %s does not exist anymore", "getSecretOptions"), err, response)
 				}
 				return nil, "", err
 			}
 			failStates := map[string]bool{"destroyed": true}
 			if failStates[*stateObj.StateDescription] {
-				return stateObj, *stateObj.StateDescription, fmt.Errorf("The instance %s failed: %s\n%s", "getSecretOptions", err, response)
+				return stateObj, *stateObj.StateDescription, flex.NewServiceError(fmt.Sprintf("The instance %s failed", "getSecretOptions"), err, response)
 			}
 			return stateObj, *stateObj.StateDescription, nil
 		},
@@ -413,7 +413,7 @@ func resourceIbmSmPrivateCertificateRead(context context.Context, d *schema.Reso
 			return nil
 		}
 		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSecretWithContext failed", err, response).Diagnostics()
 	}
 
 	secret := secretIntf.(*secretsmanagerv2.PrivateCertificate)
@@ -553,7 +553,7 @@ func resourceIbmSmPrivateCertificateRead(context context.Context, d *schema.Reso
 	versionMetadataIntf, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getVersionMetdataOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretVersionMetadataWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSecretVersionMetadataWithContext failed", err, response).Diagnostics()
 	}
 
 	versionMetadata := versionMetadataIntf.(*secretsmanagerv2.PrivateCertificateVersionMetadata)
@@ -621,7 +621,7 @@ func resourceIbmSmPrivateCertificateUpdate(context context.Context, d *schema.Re
 		_, response, err := secretsManagerClient.UpdateSecretMetadataWithContext(context, updateSecretMetadataOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateSecretMetadataWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSecretMetadataWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSecretMetadataWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -642,7 +642,7 @@ func resourceIbmSmPrivateCertificateUpdate(context context.Context, d *schema.Re
 				resourceIbmSmPrivateCertificateRead(context, d, meta)
 			}
 			log.Printf("[DEBUG] UpdateSecretVersionMetadataWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSecretVersionMetadataWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSecretVersionMetadataWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -668,7 +668,7 @@ func resourceIbmSmPrivateCertificateDelete(context context.Context, d *schema.Re
 	response, err := secretsManagerClient.DeleteSecretWithContext(context, deleteSecretOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteSecretWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteSecretWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
@@ -66,7 +67,7 @@ func resourceIbmSmPrivateCertificateConfigurationActionSetSignedCreateOrUpdate(c
 	_, response, err := secretsManagerClient.CreateConfigurationActionWithContext(context, createConfigurationActionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateConfigurationActionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateConfigurationActionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateConfigurationActionWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/set_signed", region, instanceId, d.Get("name").(string)))
@@ -230,7 +230,7 @@ func resourceIbmSmPrivateCertificateConfigurationActionSignCsrCreateOrUpdate(con
 	configurationActionIntf, response, err := secretsManagerClient.CreateConfigurationActionWithContext(context, createConfigurationActionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateConfigurationActionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateConfigurationActionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateConfigurationActionWithContext failed", err, response).Diagnostics()
 	}
 
 	configurationAction := configurationActionIntf.(*secretsmanagerv2.PrivateCertificateConfigurationActionSignCSR)
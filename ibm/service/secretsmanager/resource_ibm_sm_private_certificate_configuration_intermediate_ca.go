@@ -334,7 +334,7 @@ func resourceIbmSmPrivateCertificateConfigurationIntermediateCACreate(context co
 	configurationIntf, response, err := secretsManagerClient.CreateConfigurationWithContext(context, createConfigurationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateConfigurationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateConfigurationWithContext failed", err, response).Diagnostics()
 	}
 	configuration := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationIntermediateCA)
 
@@ -355,7 +355,7 @@ func resourceIbmSmPrivateCertificateConfigurationIntermediateCACreate(context co
 			_, responseAction, errAction := secretsManagerClient.CreateConfigurationActionWithContext(context, createConfigurationActionOptions)
 			if errAction != nil {
 				log.Printf("[DEBUG] CreateConfigurationActionWithContext failed %s\n%s", errAction, responseAction)
-				return diag.FromErr(fmt.Errorf("CreateConfigurationActionWithContext failed %s\n%s", errAction, responseAction))
+				return flex.NewServiceError("CreateConfigurationActionWithContext failed", errAction, responseAction).Diagnostics()
 			}
 		} else {
 			return diag.FromErr(fmt.Errorf("`issuer` parameter is missing"))
@@ -391,7 +391,7 @@ func resourceIbmSmPrivateCertificateConfigurationIntermediateCARead(context cont
 			return nil
 		}
 		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetConfigurationWithContext failed", err, response).Diagnostics()
 	}
 	configuration := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationIntermediateCA)
 
@@ -583,7 +583,7 @@ func resourceIbmSmPrivateCertificateConfigurationIntermediateCAUpdate(context co
 		_, response, err := secretsManagerClient.UpdateConfigurationWithContext(context, updateConfigurationOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateConfigurationWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateConfigurationWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateConfigurationWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -609,7 +609,7 @@ func resourceIbmSmPrivateCertificateConfigurationIntermediateCADelete(context co
 	response, err := secretsManagerClient.DeleteConfigurationWithContext(context, deleteConfigurationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteConfigurationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteConfigurationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
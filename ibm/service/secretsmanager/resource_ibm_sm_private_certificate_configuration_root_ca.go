@@ -338,7 +338,7 @@ func resourceIbmSmPrivateCertificateConfigurationRootCACreate(context context.Co
 	configurationIntf, response, err := secretsManagerClient.CreateConfigurationWithContext(context, createConfigurationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateConfigurationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateConfigurationWithContext failed", err, response).Diagnostics()
 	}
 
 	configuration := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationRootCA)
@@ -373,7 +373,7 @@ func resourceIbmSmPrivateCertificateConfigurationRootCARead(context context.Cont
 			return nil
 		}
 		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetConfigurationWithContext failed", err, response).Diagnostics()
 	}
 
 	configuration := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationRootCA)
@@ -574,7 +574,7 @@ func resourceIbmSmPrivateCertificateConfigurationRootCAUpdate(context context.Co
 		_, response, err := secretsManagerClient.UpdateConfigurationWithContext(context, updateConfigurationOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateConfigurationWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateConfigurationWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateConfigurationWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -600,7 +600,7 @@ func resourceIbmSmPrivateCertificateConfigurationRootCADelete(context context.Co
 	response, err := secretsManagerClient.DeleteConfigurationWithContext(context, deleteConfigurationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteConfigurationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteConfigurationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
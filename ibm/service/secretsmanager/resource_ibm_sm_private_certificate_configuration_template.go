@@ -343,7 +343,7 @@ func resourceIbmSmPrivateCertificateConfigurationTemplateCreate(context context.
 	configurationIntf, response, err := secretsManagerClient.CreateConfigurationWithContext(context, createConfigurationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateConfigurationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateConfigurationWithContext failed", err, response).Diagnostics()
 	}
 	configuration := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationTemplate)
 
@@ -378,7 +378,7 @@ func resourceIbmSmPrivateCertificateConfigurationTemplateRead(context context.Co
 			return nil
 		}
 		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetConfigurationWithContext failed", err, response).Diagnostics()
 	}
 	configuration := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationTemplate)
 
@@ -802,7 +802,7 @@ func resourceIbmSmPrivateCertificateConfigurationTemplateUpdate(context context.
 		_, response, err := secretsManagerClient.UpdateConfigurationWithContext(context, updateConfigurationOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateConfigurationWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateConfigurationWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateConfigurationWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -828,7 +828,7 @@ func resourceIbmSmPrivateCertificateConfigurationTemplateDelete(context context.
 	response, err := secretsManagerClient.DeleteConfigurationWithContext(context, deleteConfigurationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteConfigurationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteConfigurationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
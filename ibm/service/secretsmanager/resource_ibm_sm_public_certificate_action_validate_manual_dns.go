@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
@@ -75,7 +76,7 @@ func validateManualDns(context context.Context, d *schema.ResourceData, secretsM
 	_, response, err := secretsManagerClient.CreateSecretActionWithContext(context, createActionOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateSecretActionWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSecretActionWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateSecretActionWithContext failed", err, response).Diagnostics()
 	}
 
 	_, err = waitForIbmSmPublicCertificateCreate(secretsManagerClient, d, "pre_activation", "active")
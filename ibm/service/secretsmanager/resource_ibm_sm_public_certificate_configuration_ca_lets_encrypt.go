@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
@@ -88,7 +89,7 @@ func resourceIbmSmPublicCertificateConfigurationCALetsEncryptCreate(context cont
 	configurationIntf, response, err := secretsManagerClient.CreateConfigurationWithContext(context, createConfigurationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateConfigurationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateConfigurationWithContext failed", err, response).Diagnostics()
 	}
 	configuration := configurationIntf.(*secretsmanagerv2.PublicCertificateConfigurationCALetsEncrypt)
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *configuration.Name))
@@ -122,7 +123,7 @@ func resourceIbmSmPublicCertificateConfigurationCALetsEncryptRead(context contex
 			return nil
 		}
 		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetConfigurationWithContext failed", err, response).Diagnostics()
 	}
 
 	configuration := configurationIntf.(*secretsmanagerv2.PublicCertificateConfigurationCALetsEncrypt)
@@ -196,7 +197,7 @@ func resourceIbmSmPublicCertificateConfigurationCALetsEncryptUpdate(context cont
 		_, response, err := secretsManagerClient.UpdateConfigurationWithContext(context, updateConfigurationOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateConfigurationWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateConfigurationWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateConfigurationWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -222,7 +223,7 @@ func resourceIbmSmPublicCertificateConfigurationCALetsEncryptDelete(context cont
 	response, err := secretsManagerClient.DeleteConfigurationWithContext(context, deleteConfigurationOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteConfigurationWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteConfigurationWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteConfigurationWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
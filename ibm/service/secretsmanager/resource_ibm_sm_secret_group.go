@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
@@ -104,7 +105,7 @@ func resourceIbmSmSecretGroupCreate(context context.Context, d *schema.ResourceD
 	secretGroup, response, err := secretsManagerClient.CreateSecretGroupWithContext(context, createSecretGroupOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateSecretGroupWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSecretGroupWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateSecretGroupWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secretGroup.ID))
@@ -139,7 +140,7 @@ func resourceIbmSmSecretGroupRead(context context.Context, d *schema.ResourceDat
 			return nil
 		}
 		log.Printf("[DEBUG] GetSecretGroupWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSecretGroupWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSecretGroupWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("secret_group_id", secretGroupId); err != nil {
@@ -202,7 +203,7 @@ func resourceIbmSmSecretGroupUpdate(context context.Context, d *schema.ResourceD
 		_, response, err := secretsManagerClient.UpdateSecretGroupWithContext(context, updateSecretGroupOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateSecretGroupWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSecretGroupWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSecretGroupWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -228,7 +229,7 @@ func resourceIbmSmSecretGroupDelete(context context.Context, d *schema.ResourceD
 	response, err := secretsManagerClient.DeleteSecretGroupWithContext(context, deleteSecretGroupOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteSecretGroupWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteSecretGroupWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteSecretGroupWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 	"github.com/go-openapi/strfmt"
@@ -160,7 +161,7 @@ func getSecretByIdOrByName(context context.Context, d *schema.ResourceData, meta
 		secretIntf, response, err = secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-			return nil, "", "", diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+			return nil, "", "", flex.NewServiceError("GetSecretWithContext failed", err, response).Diagnostics()
 		}
 		return secretIntf, region, instanceId, nil
 	}
@@ -176,7 +177,7 @@ func getSecretByIdOrByName(context context.Context, d *schema.ResourceData, meta
 		secretIntf, response, err = secretsManagerClient.GetSecretByNameTypeWithContext(context, getSecretByNameOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetSecretByNameTypeWithContext failed %s\n%s", err, response)
-			return nil, "", "", diag.FromErr(fmt.Errorf("GetSecretByNameTypeWithContext failed %s\n%s", err, response))
+			return nil, "", "", flex.NewServiceError("GetSecretByNameTypeWithContext failed", err, response).Diagnostics()
 		}
 		return secretIntf, region, instanceId, nil
 	}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 const (
@@ -88,7 +90,7 @@ func dataSourceIBMTransitGatewayConnectionPrefixFilterRead(d *schema.ResourceDat
 	getTransitGatewayConnectionPrefixFilterOptionsModel.SetFilterID(filterId)
 	prefixFilter, response, err := client.GetTransitGatewayConnectionPrefixFilter(getTransitGatewayConnectionPrefixFilterOptionsModel)
 	if err != nil {
-		return fmt.Errorf("Error retrieving transit gateway connection prefix filter (%s): %s\n%s", filterId, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error retrieving transit gateway connection prefix filter (%s)", filterId), err, response)
 	}
 
 	d.SetId(*prefixFilter.ID)
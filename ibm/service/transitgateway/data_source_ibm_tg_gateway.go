@@ -156,7 +156,7 @@ func dataSourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) e
 	listTransitGatewaysOptionsModel := &transitgatewayapisv1.ListTransitGatewaysOptions{}
 	listTransitGateways, response, err := client.ListTransitGateways(listTransitGatewaysOptionsModel)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while listing transit gateways %s\n%s", err, response)
+		return flex.NewServiceError("Error while listing transit gateways", err, response)
 	}
 
 	gwName := d.Get(tgName).(string)
@@ -211,7 +211,7 @@ func dataSourceIBMTransitGatewayConnectionsRead(d *schema.ResourceData, meta int
 		}
 		listTGConnections, response, err := client.ListTransitGatewayConnections(listTransitGatewayConnectionsOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error while listing transit gateway connections %s\n%s", err, response)
+			return flex.NewServiceError("Error while listing transit gateway connections", err, response)
 		}
 		for _, instance := range listTGConnections.Connections {
 			tgConn := map[string]interface{}{}
@@ -4,9 +4,10 @@
 package transitgateway
 
 import (
-	"fmt"
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 const (
@@ -144,7 +145,7 @@ func dataSourceIBMTransitGatewayRouteReportRead(d *schema.ResourceData, meta int
 	getTransitGatewayRouteReportOptionsModel.SetID(routeReportId)
 	routeReport, response, err := client.GetTransitGatewayRouteReport(getTransitGatewayRouteReportOptionsModel)
 	if err != nil {
-		return fmt.Errorf("Error while retrieving transit gateway route report %s\n%s", err, response)
+		return flex.NewServiceError("Error while retrieving transit gateway route report", err, response)
 	}
 
 	d.Set(tgRouteReport, routeReport.ID)
@@ -268,7 +268,7 @@ func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta inte
 
 	tgConnections, response, err := client.CreateTransitGatewayConnection(createTransitGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Create Transit Gateway connection err %s\n%s", err, response)
+		return flex.NewServiceError("Error creating Transit Gateway connection", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", gatewayId, *tgConnections.ID))
@@ -315,7 +315,7 @@ func isTransitGatewayConnectionRefreshFunc(client *transitgatewayapisv1.TransitG
 		getTransitGatewayConnectionOptions.SetID(ID)
 		tgConnection, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection (%s): %s\n%s", ID, err, response)
+			return nil, "", flex.NewServiceError(fmt.Sprintf("Error Getting Transit Gateway Connection (%s)", ID), err, response)
 		}
 		if *tgConnection.Status == "attached" || *tgConnection.Status == "failed" {
 			return tgConnection, isTransitGatewayConnectionAttached, nil
@@ -347,7 +347,7 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection (%s): %s\n%s", ID, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Transit Gateway Connection (%s)", ID), err, response)
 	}
 
 	if instance.Name != nil {
@@ -381,7 +381,7 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 	}
 	tgw, response, err := client.GetTransitGateway(getTransitGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Transit Gateway : %s\n%s", err, response)
+		return flex.NewServiceError("Error Getting Transit Gateway", err, response)
 	}
 	d.Set(flex.RelatedCRN, *tgw.Crn)
 
@@ -409,7 +409,7 @@ func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta inte
 
 	_, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection: %s\n%s", err, response)
+		return flex.NewServiceError("Error Getting Transit Gateway Connection", err, response)
 	}
 
 	updateTransitGatewayConnectionOptions := &transitgatewayapisv1.UpdateTransitGatewayConnectionOptions{}
@@ -424,7 +424,7 @@ func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta inte
 
 	_, response, err = client.UpdateTransitGatewayConnection(updateTransitGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error in Update Transit Gateway Connection : %s\n%s", err, response)
+		return flex.NewServiceError("Error in Update Transit Gateway Connection", err, response)
 	}
 
 	return resourceIBMTransitGatewayConnectionRead(d, meta)
@@ -453,7 +453,7 @@ func resourceIBMTransitGatewayConnectionDelete(d *schema.ResourceData, meta inte
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error deleting Transit Gateway Connection(%s): %s\n%s", ID, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error deleting Transit Gateway Connection(%s)", ID), err, response)
 	}
 	_, err = isWaitForTransitGatewayConnectionDeleted(client, d.Id(), d.Timeout(schema.TimeoutCreate))
 
@@ -502,7 +502,7 @@ func isTransitGatewayConnectionDeleteRefreshFunc(client *transitgatewayapisv1.Tr
 				return tgConnection, isTransitGatewayConnectionDeleted, nil
 			}
 
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection (%s): %s\n%s", ID, err, response)
+			return nil, "", flex.NewServiceError(fmt.Sprintf("Error Getting Transit Gateway Connection (%s)", ID), err, response)
 		}
 		return tgConnection, isTransitGatewayConnectionDeleting, err
 	}
@@ -532,7 +532,7 @@ func resourceIBMTransitGatewayConnectionExists(d *schema.ResourceData, meta inte
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting Transit Gateway Connection: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error Getting Transit Gateway Connection", err, response)
 	}
 
 	return true, nil
@@ -110,7 +110,7 @@ func resourceIBMTransitGatewayConnectionActionCreate(d *schema.ResourceData, met
 
 	response, err := client.CreateTransitGatewayConnectionActions(createTransitGatewayConnectionActionsOptions)
 	if err != nil {
-		return fmt.Errorf("Error performing an action on the Transit Gateway Connection: %s\n%s", err, response)
+		return flex.NewServiceError("Error performing an action on the Transit Gateway Connection", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", gatewayId, connectionId))
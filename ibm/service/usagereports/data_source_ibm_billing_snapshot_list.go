@@ -211,7 +211,7 @@ func dataSourceIBMBillingSnapshotListRead(context context.Context, d *schema.Res
 		snapshotListResponse, response, err := usageReportsClient.GetReportsSnapshotWithContext(context, getReportsSnapshotOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetReportsSnapshotWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetReportsSnapshotWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("GetReportsSnapshotWithContext failed", err, response).Diagnostics()
 		}
 		if snapshotListResponse.Snapshots != nil && len(snapshotListResponse.Snapshots) > 0 {
 			snapshotList = append(snapshotList, snapshotListResponse.Snapshots...)
@@ -244,7 +244,7 @@ func resourceIBMBillingReportSnapshotCreate(context context.Context, d *schema.R
 	snapshotConfig, response, err := usageReportsClient.CreateReportsSnapshotConfigWithContext(context, createReportsSnapshotConfigOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateReportsSnapshotConfigWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateReportsSnapshotConfigWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateReportsSnapshotConfigWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*snapshotConfig.AccountID)
@@ -269,7 +269,7 @@ func resourceIBMBillingReportSnapshotRead(context context.Context, d *schema.Res
 			return nil
 		}
 		log.Printf("[DEBUG] GetReportsSnapshotConfigWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetReportsSnapshotConfigWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetReportsSnapshotConfigWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("interval", snapshotConfig.Interval); err != nil {
@@ -394,7 +394,7 @@ func resourceIBMBillingReportSnapshotUpdate(context context.Context, d *schema.R
 		_, response, err := usageReportsClient.UpdateReportsSnapshotConfigWithContext(context, updateReportsSnapshotConfigOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateReportsSnapshotConfigWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateReportsSnapshotConfigWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateReportsSnapshotConfigWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -414,7 +414,7 @@ func resourceIBMBillingReportSnapshotDelete(context context.Context, d *schema.R
 	response, err := usageReportsClient.DeleteReportsSnapshotConfigWithContext(context, deleteReportsSnapshotConfigOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteReportsSnapshotConfigWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteReportsSnapshotConfigWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteReportsSnapshotConfigWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// cidrRange is the half open range [start, end) of the IPv4 addresses of a
+// CIDR block. end is kept as uint64 so 0.0.0.0/0 can be represented.
+type cidrRange struct {
+	start, end uint64
+}
+
+func parseIPv4CIDRRange(cidr string) (cidrRange, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return cidrRange{}, err
+	}
+	ip := ipNet.IP.To4()
+	if ip == nil {
+		return cidrRange{}, fmt.Errorf("%s is not an IPv4 CIDR block", cidr)
+	}
+	ones, _ := ipNet.Mask.Size()
+	start := uint64(binary.BigEndian.Uint32(ip))
+	return cidrRange{start: start, end: start + 1<<uint(32-ones)}, nil
+}
+
+func formatIPv4CIDR(start uint64, prefixLength int) string {
+	ip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(ip, uint32(start))
+	return fmt.Sprintf("%s/%d", ip, prefixLength)
+}
+
+// freeCIDRBlocks returns, in ascending address order, the blocks of prefix
+// that do not overlap any of the used blocks. With a prefixLength the blocks
+// are all of that length, otherwise the free space is returned as the fewest
+// largest aligned blocks. At most limit blocks are returned, so the result is
+// the same for as long as the used blocks do not change.
+func freeCIDRBlocks(prefix string, used []string, prefixLength, limit int) ([]string, error) {
+	prefixRange, err := parseIPv4CIDRRange(prefix)
+	if err != nil {
+		return nil, err
+	}
+	usedRanges := make([]cidrRange, 0, len(used))
+	for _, cidr := range used {
+		usedRange, err := parseIPv4CIDRRange(cidr)
+		if err != nil {
+			return nil, err
+		}
+		if usedRange.end > prefixRange.start && usedRange.start < prefixRange.end {
+			usedRanges = append(usedRanges, usedRange)
+		}
+	}
+	sort.Slice(usedRanges, func(i, j int) bool { return usedRanges[i].start < usedRanges[j].start })
+
+	blocks := []string{}
+	emit := func(free cidrRange) {
+		if prefixLength > 0 {
+			size := uint64(1) << uint(32-prefixLength)
+			for start := (free.start + size - 1) / size * size; start+size <= free.end && len(blocks) < limit; start += size {
+				blocks = append(blocks, formatIPv4CIDR(start, prefixLength))
+			}
+			return
+		}
+		for start := free.start; start < free.end && len(blocks) < limit; {
+			length := 32
+			for length > 0 {
+				size := uint64(1) << uint(32-length+1)
+				if start%size != 0 || start+size > free.end {
+					break
+				}
+				length--
+			}
+			blocks = append(blocks, formatIPv4CIDR(start, length))
+			start += uint64(1) << uint(32-length)
+		}
+	}
+
+	next := prefixRange.start
+	for _, usedRange := range usedRanges {
+		if usedRange.start > next {
+			emit(cidrRange{start: next, end: usedRange.start})
+		}
+		if usedRange.end > next {
+			next = usedRange.end
+		}
+	}
+	if next < prefixRange.end {
+		emit(cidrRange{start: next, end: prefixRange.end})
+	}
+	return blocks, nil
+}
+
+type availableCIDR struct {
+	zone              string
+	addressPrefixID   string
+	addressPrefixCIDR string
+	cidr              string
+}
+
+// listAvailableSubnetCIDRs computes the free blocks of the address prefixes of
+// a VPC, optionally narrowed to one zone or one address prefix. Blocks used by
+// the subnets of the VPC and the reserved address ranges are never returned.
+func listAvailableSubnetCIDRs(context context.Context, sess *vpcv1.VpcV1, vpcID, zone, addressPrefixID string, prefixLength, limit int) ([]availableCIDR, error) {
+	prefixPager, err := sess.NewVPCAddressPrefixesPager(&vpcv1.ListVPCAddressPrefixesOptions{VPCID: &vpcID})
+	if err != nil {
+		return nil, err
+	}
+	allPrefixes, err := prefixPager.GetAllWithContext(context)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing address prefixes of VPC (%s): %s", vpcID, err)
+	}
+	type prefixBlock struct {
+		prefix vpcv1.AddressPrefix
+		block  cidrRange
+	}
+	prefixes := make([]prefixBlock, 0, len(allPrefixes))
+	for _, prefix := range allPrefixes {
+		if addressPrefixID != "" && *prefix.ID != addressPrefixID {
+			continue
+		}
+		if zone != "" && *prefix.Zone.Name != zone {
+			continue
+		}
+		block, err := parseIPv4CIDRRange(*prefix.CIDR)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, prefixBlock{prefix: prefix, block: block})
+	}
+	if addressPrefixID != "" && len(prefixes) == 0 {
+		return nil, fmt.Errorf("[ERROR] No address prefix %s found in VPC (%s) zone %q", addressPrefixID, vpcID, zone)
+	}
+	sort.SliceStable(prefixes, func(i, j int) bool {
+		if *prefixes[i].prefix.Zone.Name != *prefixes[j].prefix.Zone.Name {
+			return *prefixes[i].prefix.Zone.Name < *prefixes[j].prefix.Zone.Name
+		}
+		return prefixes[i].block.start < prefixes[j].block.start
+	})
+
+	subnetPager, err := sess.NewSubnetsPager(&vpcv1.ListSubnetsOptions{VPCID: &vpcID})
+	if err != nil {
+		return nil, err
+	}
+	subnets, err := subnetPager.GetAllWithContext(context)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing subnets of VPC (%s): %s", vpcID, err)
+	}
+	used := append([]string{}, validate.ReservedAddressRanges...)
+	for _, subnet := range subnets {
+		if subnet.Ipv4CIDRBlock != nil {
+			used = append(used, *subnet.Ipv4CIDRBlock)
+		}
+	}
+
+	available := []availableCIDR{}
+	for _, prefix := range prefixes {
+		if len(available) >= limit {
+			break
+		}
+		blocks, err := freeCIDRBlocks(*prefix.prefix.CIDR, used, prefixLength, limit-len(available))
+		if err != nil {
+			return nil, err
+		}
+		for _, block := range blocks {
+			available = append(available, availableCIDR{
+				zone:              *prefix.prefix.Zone.Name,
+				addressPrefixID:   *prefix.prefix.ID,
+				addressPrefixCIDR: *prefix.prefix.CIDR,
+				cidr:              block,
+			})
+		}
+	}
+	return available, nil
+}
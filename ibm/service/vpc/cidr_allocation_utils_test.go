@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFreeCIDRBlocks(t *testing.T) {
+	testCases := []struct {
+		name         string
+		prefix       string
+		used         []string
+		prefixLength int
+		limit        int
+		expected     []string
+	}{
+		{
+			name:         "empty prefix",
+			prefix:       "10.240.0.0/18",
+			prefixLength: 24,
+			limit:        2,
+			expected:     []string{"10.240.0.0/24", "10.240.1.0/24"},
+		},
+		{
+			name:         "skips used and realigns",
+			prefix:       "10.240.0.0/18",
+			used:         []string{"10.240.0.0/24", "10.240.1.0/28", "192.168.0.0/16"},
+			prefixLength: 24,
+			limit:        2,
+			expected:     []string{"10.240.2.0/24", "10.240.3.0/24"},
+		},
+		{
+			name:         "fills gaps with smaller blocks",
+			prefix:       "10.240.0.0/24",
+			used:         []string{"10.240.0.0/26", "10.240.0.128/26"},
+			prefixLength: 28,
+			limit:        10,
+			expected:     []string{"10.240.0.64/28", "10.240.0.80/28", "10.240.0.96/28", "10.240.0.112/28", "10.240.0.192/28", "10.240.0.208/28", "10.240.0.224/28", "10.240.0.240/28"},
+		},
+		{
+			name:     "largest blocks",
+			prefix:   "10.240.0.0/22",
+			used:     []string{"10.240.0.16/28", "10.240.2.0/24"},
+			limit:    10,
+			expected: []string{"10.240.0.0/28", "10.240.0.32/27", "10.240.0.64/26", "10.240.0.128/25", "10.240.1.0/24", "10.240.3.0/24"},
+		},
+		{
+			name:         "prefix exhausted",
+			prefix:       "10.240.0.0/24",
+			used:         []string{"10.240.0.0/23"},
+			prefixLength: 29,
+			limit:        1,
+			expected:     []string{},
+		},
+		{
+			name:         "block larger than prefix",
+			prefix:       "10.240.0.0/24",
+			prefixLength: 22,
+			limit:        1,
+			expected:     []string{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			blocks, err := freeCIDRBlocks(tc.prefix, tc.used, tc.prefixLength, tc.limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(blocks, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, blocks)
+			}
+		})
+	}
+}
@@ -232,7 +232,7 @@ func dataSourceIBMIsBackupPolicyRead(context context.Context, d *schema.Resource
 		backupPolicyInfo, response, err := sess.GetBackupPolicyWithContext(context, getBackupPolicyOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetBackupPolicyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] GetBackupPolicyWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("GetBackupPolicyWithContext failed", err, response).Diagnostics()
 		}
 		backupPolicy = backupPolicyInfo.(*vpcv1.BackupPolicy)
 
@@ -249,7 +249,7 @@ func dataSourceIBMIsBackupPolicyRead(context context.Context, d *schema.Resource
 			backupPolicyCollection, response, err := sess.ListBackupPoliciesWithContext(context, listBackupPoliciesOptions)
 			if err != nil {
 				log.Printf("[DEBUG] ListBackupPoliciesWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("[ERROR] ListBackupPoliciesWithContext failed %s\n%s", err, response))
+				return flex.NewServiceError("ListBackupPoliciesWithContext failed", err, response).Diagnostics()
 			}
 			if backupPolicyCollection != nil && *backupPolicyCollection.TotalCount == int64(0) {
 				break
@@ -333,7 +333,7 @@ func dataSourceIBMIsBackupPolicyJobRead(context context.Context, d *schema.Resou
 	backupPolicyJob, response, err := vpcClient.GetBackupPolicyJobWithContext(context, getBackupPolicyJobOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetBackupPolicyJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetBackupPolicyJobWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetBackupPolicyJobWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*backupPolicyJob.ID)
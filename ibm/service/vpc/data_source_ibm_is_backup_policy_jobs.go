@@ -420,7 +420,7 @@ func dataSourceIBMIsBackupPolicyJobsRead(context context.Context, d *schema.Reso
 		backupPolicyJobCollection, response, err := vpcClient.ListBackupPolicyJobsWithContext(context, listBackupPolicyJobsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListBackupPolicyJobsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListBackupPolicyJobsWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ListBackupPolicyJobsWithContext failed", err, response).Diagnostics()
 		}
 
 		if backupPolicyJobCollection != nil && *backupPolicyJobCollection.TotalCount == int64(0) {
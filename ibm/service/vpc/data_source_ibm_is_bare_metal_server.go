@@ -451,7 +451,7 @@ func dataSourceIBMISBareMetalServerRead(context context.Context, d *schema.Resou
 		server, response, err := sess.GetBareMetalServerWithContext(context, options)
 		if err != nil {
 			log.Printf("[DEBUG] GetBareMetalServerWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Bare Metal Server (%s): %s\n%s", id, err, response))
+			return flex.NewServiceError(fmt.Sprintf("Error Getting Bare Metal Server (%s)", id), err, response).Diagnostics()
 		}
 		bms = server
 	} else if name != "" {
@@ -460,7 +460,7 @@ func dataSourceIBMISBareMetalServerRead(context context.Context, d *schema.Resou
 		bmservers, response, err := sess.ListBareMetalServersWithContext(context, options)
 		if err != nil {
 			log.Printf("[DEBUG] ListBareMetalServersWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Listing Bare Metal Server (%s): %s\n%s", name, err, response))
+			return flex.NewServiceError(fmt.Sprintf("Error Listing Bare Metal Server (%s)", name), err, response).Diagnostics()
 		}
 		if len(bmservers.BareMetalServers) == 0 {
 			return diag.FromErr(fmt.Errorf("[ERROR] No bare metal servers found with name %s", name))
@@ -484,7 +484,7 @@ func dataSourceIBMISBareMetalServerRead(context context.Context, d *schema.Resou
 
 	initialization, response, err := sess.GetBareMetalServerInitialization(optionsInitialization)
 	if err != nil || initialization == nil {
-		return diag.FromErr(fmt.Errorf("[Error] Error getting Bare Metal Server (%s) initialization : %s\n%s", *bms.ID, err, response))
+		return flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server (%s) initialization", *bms.ID), err, response).Diagnostics()
 	}
 
 	d.Set(isBareMetalServerImage, initialization.Image.ID)
@@ -593,7 +593,7 @@ func dataSourceIBMISBareMetalServerRead(context context.Context, d *schema.Resou
 		}
 		bmsnic, response, err := sess.GetBareMetalServerNetworkInterface(getnicoptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error getting network interfaces attached to the bare metal server %s\n%s", err, response))
+			return flex.NewServiceError("Error getting network interfaces attached to the bare metal server", err, response).Diagnostics()
 		}
 
 		switch reflect.TypeOf(bmsnic).String() {
@@ -679,7 +679,7 @@ func dataSourceIBMISBareMetalServerRead(context context.Context, d *schema.Resou
 			}
 			bmsnicintf, response, err := sess.GetBareMetalServerNetworkInterface(getnicoptions)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error getting network interfaces attached to the bare metal server %s\n%s", err, response))
+				return flex.NewServiceError("Error getting network interfaces attached to the bare metal server", err, response).Diagnostics()
 			}
 
 			switch reflect.TypeOf(bmsnicintf).String() {
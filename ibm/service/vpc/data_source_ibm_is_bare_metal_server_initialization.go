@@ -128,7 +128,7 @@ func dataSourceIBMISBareMetalServerInitializationRead(context context.Context, d
 
 	initialization, response, err := sess.GetBareMetalServerInitializationWithContext(context, options)
 	if err != nil || initialization == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) initialization : %s\n%s", bareMetalServerID, err, response))
+		return flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server (%s) initialization", bareMetalServerID), err, response).Diagnostics()
 	}
 	d.SetId(bareMetalServerID)
 	if initialization.Image != nil {
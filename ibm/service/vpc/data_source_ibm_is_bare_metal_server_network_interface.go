@@ -238,7 +238,7 @@ func dataSourceIBMISBareMetalServerNetworkInterfaceRead(context context.Context,
 
 	nicIntf, response, err := sess.GetBareMetalServerNetworkInterfaceWithContext(context, options)
 	if err != nil || nicIntf == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) network interface (%s): %s\n%s", bareMetalServerID, bareMetalServerNicID, err, response))
+		return flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server (%s) network interface (%s)", bareMetalServerID, bareMetalServerNicID), err, response).Diagnostics()
 	}
 	switch reflect.TypeOf(nicIntf).String() {
 	case "*vpcv1.BareMetalServerNetworkInterfaceByPci":
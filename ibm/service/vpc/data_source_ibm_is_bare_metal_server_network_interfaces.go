@@ -221,7 +221,7 @@ func dataSourceIBMISBareMetalServerNetworkInterfacesRead(context context.Context
 	nics := []vpcv1.BareMetalServerNetworkInterfaceIntf{}
 	bmsNics, response, err := sess.ListBareMetalServerNetworkInterfacesWithContext(context, options)
 	if err != nil || bmsNics == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error listing Bare Metal Server (%s) network interfaces : %s\n%s", bareMetalServerID, err, response))
+		return flex.NewServiceError(fmt.Sprintf("Error listing Bare Metal Server (%s) network interfaces", bareMetalServerID), err, response).Diagnostics()
 	}
 	nics = append(nics, bmsNics.NetworkInterfaces...)
 	nicsInfo := make([]map[string]interface{}, 0)
@@ -350,7 +350,7 @@ func dataSourceIBMISBMSProfileRead(context context.Context, d *schema.ResourceDa
 	}
 	bmsProfile, response, err := sess.GetBareMetalServerProfileWithContext(context, options)
 	if err != nil || bmsProfile == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Bare Metal Server Profile (%s): %s\n%s", name, err, response))
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Bare Metal Server Profile (%s)", name), err, response).Diagnostics()
 	}
 	d.SetId(*bmsProfile.Name)
 	d.Set(isBareMetalServerProfileName, *bmsProfile.Name)
@@ -5,7 +5,6 @@ package vpc
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"reflect"
 	"time"
@@ -504,7 +503,7 @@ func dataSourceIBMISBareMetalServersRead(context context.Context, d *schema.Reso
 		}
 		availableServers, response, err := sess.ListBareMetalServersWithContext(context, listBareMetalServersOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error fetching Bare Metal Servers %s\n%s", err, response))
+			return flex.NewServiceError("Error fetching Bare Metal Servers", err, response).Diagnostics()
 		}
 		start = flex.GetNext(availableServers.Next)
 		allrecs = append(allrecs, availableServers.BareMetalServers...)
@@ -610,7 +609,7 @@ func dataSourceIBMISBareMetalServersRead(context context.Context, d *schema.Reso
 			}
 			bmsnic, response, err := sess.GetBareMetalServerNetworkInterface(getnicoptions)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error getting network interfaces attached to the bare metal server %s\n%s", err, response))
+				return flex.NewServiceError("Error getting network interfaces attached to the bare metal server", err, response).Diagnostics()
 			}
 
 			switch reflect.TypeOf(bmsnic).String() {
@@ -694,7 +693,7 @@ func dataSourceIBMISBareMetalServersRead(context context.Context, d *schema.Reso
 				}
 				bmsnicintf, response, err := sess.GetBareMetalServerNetworkInterface(getnicoptions)
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] Error getting network interfaces attached to the bare metal server %s\n%s", err, response))
+					return flex.NewServiceError("Error getting network interfaces attached to the bare metal server", err, response).Diagnostics()
 				}
 
 				switch reflect.TypeOf(bmsnicintf).String() {
@@ -221,7 +221,7 @@ func dataSourceIBMIsFlowLogRead(context context.Context, d *schema.ResourceData,
 
 		flowlogCollectors, response, err := sess.ListFlowLogCollectors(listOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("Error Fetching Flow Logs for VPC %s\n%s", err, response))
+			return flex.NewServiceError("Error Fetching Flow Logs for VPC", err, response).Diagnostics()
 		}
 
 		allrecs := flowlogCollectors.FlowLogCollectors
@@ -240,7 +240,7 @@ func dataSourceIBMIsFlowLogRead(context context.Context, d *schema.ResourceData,
 		flowlogCollector, response, err := sess.GetFlowLogCollectorWithContext(context, getFlowLogCollectorOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetFlowLogCollectorWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetFlowLogCollectorWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("GetFlowLogCollectorWithContext failed", err, response).Diagnostics()
 		}
 		flowLogCollector = flowlogCollector
 	}
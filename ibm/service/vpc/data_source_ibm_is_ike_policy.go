@@ -168,7 +168,7 @@ func dataSourceIBMIsIkePolicyRead(context context.Context, d *schema.ResourceDat
 			}
 			ikePolicies, response, err := vpcClient.ListIkePolicies(listIkePoliciesyOptions)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("Error Fetching IKE Policies %s\n%s", err, response))
+				return flex.NewServiceError("Error Fetching IKE Policies", err, response).Diagnostics()
 			}
 			start = flex.GetNext(ikePolicies.Next)
 			allrecs = append(allrecs, ikePolicies.IkePolicies...)
@@ -197,7 +197,7 @@ func dataSourceIBMIsIkePolicyRead(context context.Context, d *schema.ResourceDat
 		ikePolicy1, response, err := vpcClient.GetIkePolicyWithContext(context, getIkePolicyOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetIkePolicyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetIkePolicyWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("GetIkePolicyWithContext failed", err, response).Diagnostics()
 		}
 		ikePolicy = ikePolicy1
 	}
@@ -288,7 +288,7 @@ func imageGetByName(d *schema.ResourceData, meta interface{}, name, visibility s
 	}
 	availableImages, response, err := sess.ListImages(listImagesOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Fetching Images %s\n%s", err, response)
+		return flex.NewServiceError("Error Fetching Images", err, response)
 	}
 	allrecs := availableImages.Images
 
@@ -373,7 +373,7 @@ func imageGetById(d *schema.ResourceData, meta interface{}, identifier string) e
 		if response.StatusCode == 404 {
 			return fmt.Errorf("[ERROR] No image found with id  %s", identifier)
 		}
-		return fmt.Errorf("[ERROR] Error Fetching Images %s\n%s", err, response)
+		return flex.NewServiceError("Error Fetching Images", err, response)
 	}
 
 	d.SetId(*image.ID)
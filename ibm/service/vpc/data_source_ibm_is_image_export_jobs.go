@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 )
 
@@ -164,7 +165,7 @@ func DataSourceIBMIsImageExportsRead(context context.Context, d *schema.Resource
 	imageExportJobUnpaginatedCollection, response, err := vpcClient.ListImageExportJobsWithContext(context, listImageExportJobsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListImageExportJobsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListImageExportJobsWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ListImageExportJobsWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(DataSourceIBMIsImageExportsID(d))
@@ -723,7 +723,7 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 
 	instances, response, err := sess.ListInstances(listInstancesOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Fetching Instances %s\n%s", err, response)
+		return flex.NewServiceError("Error Fetching Instances", err, response)
 	}
 	allrecs := instances.Instances
 
@@ -849,7 +849,7 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 		}
 		insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
+			return flex.NewServiceError("Error getting network interfaces attached to the instance", err, response)
 		}
 		if insnic.PortSpeed != nil {
 			currentPrimNic[isInstanceNicPortSpeed] = *insnic.PortSpeed
@@ -903,7 +903,7 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 				}
 				insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
+					return flex.NewServiceError("Error getting network interfaces attached to the instance", err, response)
 				}
 				currentNic[isInstanceNicSubnet] = *insnic.Subnet.ID
 				if len(insnic.SecurityGroups) != 0 {
@@ -981,7 +981,7 @@ func instanceGetByName(d *schema.ResourceData, meta interface{}, name string) er
 	}
 	initParms, response, err := sess.GetInstanceInitialization(getInstanceInitializationOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting instance Initialization: %s\n%s", err, response)
+		return flex.NewServiceError("Error Getting instance Initialization", err, response)
 	}
 	if initParms.Keys != nil {
 		initKeyList := make([]map[string]interface{}, 0)
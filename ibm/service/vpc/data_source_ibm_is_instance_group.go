@@ -115,7 +115,7 @@ func dataSourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{})
 		}
 		instanceGroupsCollection, response, err := sess.ListInstanceGroups(&listInstanceGroupOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching InstanceGroups %s\n%s", err, response)
+			return flex.NewServiceError("Error Fetching InstanceGroups", err, response)
 		}
 		start = flex.GetNext(instanceGroupsCollection.Next)
 		allrecs = append(allrecs, instanceGroupsCollection.InstanceGroups...)
@@ -155,7 +155,7 @@ func dataSourceIBMISInstanceGroupManagerActionRead(d *schema.ResourceData, meta
 		}
 		instanceGroupManagerActionsCollection, response, err := sess.ListInstanceGroupManagerActions(&listInstanceGroupManagerActionsOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager Actions %s\n%s", err, response)
+			return flex.NewServiceError("Error Getting InstanceGroup Manager Actions", err, response)
 		}
 		if instanceGroupManagerActionsCollection != nil && *instanceGroupManagerActionsCollection.TotalCount == int64(0) {
 			break
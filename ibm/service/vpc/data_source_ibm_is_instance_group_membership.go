@@ -116,7 +116,7 @@ func dataSourceIBMISInstanceGroupMembershipRead(d *schema.ResourceData, meta int
 		}
 		instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMemberships(&listInstanceGroupMembershipsOptions)
 		if err != nil || instanceGroupMembershipCollection == nil {
-			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership Collection %s\n%s", err, response)
+			return flex.NewServiceError("Error Getting InstanceGroup Membership Collection", err, response)
 		}
 
 		start = flex.GetNext(instanceGroupMembershipCollection.Next)
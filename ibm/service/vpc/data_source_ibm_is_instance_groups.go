@@ -343,7 +343,7 @@ func DataSourceIBMIsInstanceGroupsRead(context context.Context, d *schema.Resour
 		instanceGroupCollection, response, err := vpcClient.ListInstanceGroupsWithContext(context, listInstanceGroupsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListInstanceGroupsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListInstanceGroupsWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ListInstanceGroupsWithContext failed", err, response).Diagnostics()
 		}
 		start = flex.GetNext(instanceGroupCollection.Next)
 		allrecs = append(allrecs, instanceGroupCollection.InstanceGroups...)
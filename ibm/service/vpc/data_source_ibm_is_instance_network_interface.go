@@ -267,7 +267,7 @@ func dataSourceIBMIsInstanceNetworkInterfaceRead(context context.Context, d *sch
 
 		instances, response, err := vpcClient.ListInstancesWithContext(context, listInstancesOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Fetching Instances %s\n%s", err, response))
+			return flex.NewServiceError("Error Fetching Instances", err, response).Diagnostics()
 		}
 		start = flex.GetNext(instances.Next)
 		allrecs = append(allrecs, instances.Instances...)
@@ -287,7 +287,7 @@ func dataSourceIBMIsInstanceNetworkInterfaceRead(context context.Context, d *sch
 
 			if err != nil {
 				log.Printf("[DEBUG] ListSecurityGroupNetworkInterfacesWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("ListSecurityGroupNetworkInterfacesWithContext failed %s\n%s", err, response))
+				return flex.NewServiceError("ListSecurityGroupNetworkInterfacesWithContext failed", err, response).Diagnostics()
 			}
 			network_interface_name := d.Get("network_interface_name").(string)
 			for _, networkInterface := range networkInterfaceCollection.NetworkInterfaces {
@@ -9,6 +9,8 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 const (
@@ -643,7 +645,7 @@ func instanceProfilesList(d *schema.ResourceData, meta interface{}) error {
 	listInstanceProfilesOptions := &vpcv1.ListInstanceProfilesOptions{}
 	availableProfiles, response, err := sess.ListInstanceProfiles(listInstanceProfilesOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Fetching Instance Profiles %s\n%s", err, response)
+		return flex.NewServiceError("Error Fetching Instance Profiles", err, response)
 	}
 	profilesInfo := make([]map[string]interface{}, 0)
 	for _, profile := range availableProfiles.Profiles {
@@ -8,6 +8,8 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 const (
@@ -135,7 +137,7 @@ func instanceVolumeAttachmentGetByName(d *schema.ResourceData, meta interface{},
 	}
 	volumeAtts, response, err := sess.ListInstanceVolumeAttachments(listInstanceVolumeAttOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error fetching Instance volume attachments %s\n%s", err, response)
+		return flex.NewServiceError("Error fetching Instance volume attachments", err, response)
 	}
 	allrecs = append(allrecs, volumeAtts.VolumeAttachments...)
 	for _, volumeAtt := range allrecs {
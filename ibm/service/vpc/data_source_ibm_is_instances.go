@@ -4,7 +4,6 @@
 package vpc
 
 import (
-	"fmt"
 	"log"
 	"time"
 
@@ -726,7 +725,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 			}
 			instanceGroupsCollection, response, err := sess.ListInstanceGroups(&listInstanceGroupOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Fetching InstanceGroups %s\n%s", err, response)
+				return flex.NewServiceError("Error Fetching InstanceGroups", err, response)
 			}
 			start = flex.GetNext(instanceGroupsCollection.Next)
 			allrecs = append(allrecs, instanceGroupsCollection.InstanceGroups...)
@@ -786,7 +785,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 
 		instances, response, err := sess.ListInstances(listInstancesOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching Instances %s\n%s", err, response)
+			return flex.NewServiceError("Error Fetching Instances", err, response)
 		}
 		start = flex.GetNext(instances.Next)
 		allrecs = append(allrecs, instances.Instances...)
@@ -807,7 +806,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 			}
 			instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMemberships(&listInstanceGroupMembershipsOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership Collection %s\n%s", err, response)
+				return flex.NewServiceError("Error Getting InstanceGroup Membership Collection", err, response)
 			}
 
 			start = flex.GetNext(instanceGroupMembershipCollection.Next)
@@ -988,7 +987,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 			}
 			insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
+				return flex.NewServiceError("Error getting network interfaces attached to the instance", err, response)
 			}
 			currentPrimNic[isInstanceNicSubnet] = *insnic.Subnet.ID
 			if len(insnic.SecurityGroups) != 0 {
@@ -1039,7 +1038,7 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 					}
 					insnic, response, err := sess.GetInstanceNetworkInterface(getnicoptions)
 					if err != nil {
-						return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
+						return flex.NewServiceError("Error getting network interfaces attached to the instance", err, response)
 					}
 					currentNic[isInstanceNicSubnet] = *insnic.Subnet.ID
 					if len(insnic.SecurityGroups) != 0 {
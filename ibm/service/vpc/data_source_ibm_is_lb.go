@@ -342,7 +342,7 @@ func lbGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 		}
 		lbs, response, err := sess.ListLoadBalancers(listLoadBalancersOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching Load Balancers %s\n%s", err, response)
+			return flex.NewServiceError("Error Fetching Load Balancers", err, response)
 		}
 		start = flex.GetNext(lbs.Next)
 		allrecs = append(allrecs, lbs.LoadBalancers...)
@@ -234,7 +234,7 @@ func dataSourceIBMIsLbListenerRead(context context.Context, d *schema.ResourceDa
 	loadBalancerListener, response, err := vpcClient.GetLoadBalancerListenerWithContext(context, getLoadBalancerListenerOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetLoadBalancerListenerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetLoadBalancerListenerWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetLoadBalancerListenerWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*loadBalancerListener.ID)
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMISLBListenerPolicies() *schema.Resource {
@@ -210,7 +212,7 @@ func dataSourceIBMIsLbListenerPoliciesRead(context context.Context, d *schema.Re
 	loadBalancerListenerPolicyCollection, response, err := vpcClient.ListLoadBalancerListenerPoliciesWithContext(context, listLoadBalancerListenerPoliciesOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListLoadBalancerListenerPoliciesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListLoadBalancerListenerPoliciesWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ListLoadBalancerListenerPoliciesWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(dataSourceIBMIsLbListenerPoliciesID(d))
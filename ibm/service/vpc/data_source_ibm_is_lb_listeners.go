@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMISLBListeners() *schema.Resource {
@@ -243,7 +245,7 @@ func dataSourceIBMIsLbListenersRead(context context.Context, d *schema.ResourceD
 	loadBalancerListenerCollection, response, err := vpcClient.ListLoadBalancerListenersWithContext(context, listLoadBalancerListenersOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListLoadBalancerListenersWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListLoadBalancerListenersWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ListLoadBalancerListenersWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(dataSourceIBMIsLbListenersID(d))
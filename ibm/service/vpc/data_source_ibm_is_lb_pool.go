@@ -223,7 +223,7 @@ func dataSourceIBMIsLbPoolRead(context context.Context, d *schema.ResourceData,
 		loadBalancerPoolInfo, response, err := sess.GetLoadBalancerPoolWithContext(context, getLoadBalancerPoolOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetLoadBalancerPoolWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetLoadBalancerPoolWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("GetLoadBalancerPoolWithContext failed", err, response).Diagnostics()
 		}
 		loadBalancerPool = loadBalancerPoolInfo
 
@@ -235,7 +235,7 @@ func dataSourceIBMIsLbPoolRead(context context.Context, d *schema.ResourceData,
 		loadBalancerPoolCollection, response, err := sess.ListLoadBalancerPoolsWithContext(context, listLoadBalancerPoolsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListLoadBalancerPoolsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListLoadBalancerPoolsWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ListLoadBalancerPoolsWithContext failed", err, response).Diagnostics()
 		}
 
 		name := v.(string)
@@ -132,7 +132,7 @@ func dataSourceIBMIsLbPoolMemberRead(context context.Context, d *schema.Resource
 	loadBalancerPoolMember, response, err := sess.GetLoadBalancerPoolMemberWithContext(context, getLoadBalancerPoolMemberOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetLoadBalancerPoolMemberWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetLoadBalancerPoolMemberWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetLoadBalancerPoolMemberWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*loadBalancerPoolMember.ID)
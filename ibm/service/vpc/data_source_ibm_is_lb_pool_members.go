@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMISLBPoolMembers() *schema.Resource {
@@ -140,7 +142,7 @@ func dataSourceIBMIsLbPoolMembersRead(context context.Context, d *schema.Resourc
 	loadBalancerPoolMemberCollection, response, err := sess.ListLoadBalancerPoolMembersWithContext(context, listLoadBalancerPoolMembersOptions)
 	if err != nil {
 		log.Printf("[DEBUG] ListLoadBalancerPoolMembersWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListLoadBalancerPoolMembersWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("ListLoadBalancerPoolMembersWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(dataSourceIBMIsLbPoolMembersID(d))
@@ -91,7 +91,7 @@ func dataSourceIBMISLbProfilesRead(d *schema.ResourceData, meta interface{}) err
 		}
 		lbProfile, response, err := sess.GetLoadBalancerProfile(getLoadBalancerProfileOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching Load Balancer Profile(%s) for VPC %s\n%s", lbprofilename, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error Fetching Load Balancer Profile(%s) for VPC", lbprofilename), err, response)
 		}
 		allrecs = append(allrecs, *lbProfile)
 	} else {
@@ -102,7 +102,7 @@ func dataSourceIBMISLbProfilesRead(d *schema.ResourceData, meta interface{}) err
 			}
 			profileCollectors, response, err := sess.ListLoadBalancerProfiles(listOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Fetching Load Balancer Profiles for VPC %s\n%s", err, response)
+				return flex.NewServiceError("Error Fetching Load Balancer Profiles for VPC", err, response)
 			}
 			start = flex.GetNext(profileCollectors.Next)
 			allrecs = append(allrecs, profileCollectors.Profiles...)
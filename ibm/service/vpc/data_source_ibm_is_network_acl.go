@@ -378,7 +378,7 @@ func dataSourceIBMIsNetworkACLRead(context context.Context, d *schema.ResourceDa
 			networkACLCollection, response, err := vpcClient.ListNetworkAclsWithContext(context, listNetworkAclsOptions)
 			if err != nil || networkACLCollection == nil {
 				log.Printf("[DEBUG] ListNetworkAclsWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("ListNetworkAclsWithContext failed %s\n%s", err, response))
+				return flex.NewServiceError("ListNetworkAclsWithContext failed", err, response).Diagnostics()
 			}
 			start = flex.GetNext(networkACLCollection.Next)
 			allrecs = append(allrecs, networkACLCollection.NetworkAcls...)
@@ -408,7 +408,7 @@ func dataSourceIBMIsNetworkACLRead(context context.Context, d *schema.ResourceDa
 		networkACLInst, response, err := vpcClient.GetNetworkACLWithContext(context, getNetworkACLOptions)
 		if err != nil || networkACLInst == nil {
 			log.Printf("[DEBUG] GetNetworkACLWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetNetworkACLWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("GetNetworkACLWithContext failed", err, response).Diagnostics()
 		}
 		networkACL = networkACLInst
 	}
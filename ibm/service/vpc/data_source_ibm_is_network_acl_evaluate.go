@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMIsNetworkACLEvaluate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsNetworkACLEvaluateRead,
+
+		Schema: map[string]*schema.Schema{
+			isNwACLID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The network ACL identifier.",
+			},
+			isNetworkACLRuleDirection: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_network_acl_evaluate", isNetworkACLRuleDirection),
+				Description:  "Whether the traffic is inbound or outbound.",
+			},
+			isNetworkACLRuleSource: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ValidateIP,
+				Description:  "The source IP address of the traffic.",
+			},
+			isNetworkACLRuleDestination: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ValidateIP,
+				Description:  "The destination IP address of the traffic.",
+			},
+			isNetworkACLRuleProtocol: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_network_acl_evaluate", isNetworkACLRuleProtocol),
+				Description:  "The protocol of the traffic, one of icmp, tcp or udp.",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The destination port of tcp or udp traffic.",
+			},
+			"source_port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The source port of tcp or udp traffic. If unspecified, any source port is assumed.",
+			},
+			isNetworkACLRuleICMPType: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ICMP type of icmp traffic.",
+			},
+			isNetworkACLRuleICMPCode: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ICMP code of icmp traffic.",
+			},
+			"allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the network ACL allows the traffic.",
+			},
+			isNetworkACLRuleAction: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The action applied to the traffic, allow or deny.",
+			},
+			"rule": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the first rule matching the traffic. If absent, no rule matches and the traffic is denied.",
+			},
+			"rule_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the first rule matching the traffic.",
+			},
+			"findings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The shadowed, duplicate and overlapping rules found in the network ACL.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"severity": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The severity of the finding, error or warning.",
+						},
+						"rule": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the rule the finding is about.",
+						},
+						"rule_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the rule the finding is about.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A description of the finding.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMIsNetworkACLEvaluateValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isNetworkACLRuleDirection,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "inbound, outbound"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isNetworkACLRuleProtocol,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "icmp, tcp, udp"})
+
+	ibmISNetworkACLEvaluateValidator := validate.ResourceValidator{ResourceName: "ibm_is_network_acl_evaluate", Schema: validateSchema}
+	return &ibmISNetworkACLEvaluateValidator
+}
+
+func dataSourceIBMIsNetworkACLEvaluateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	nwaclID := d.Get(isNwACLID).(string)
+
+	start := ""
+	allrecs := []vpcv1.NetworkACLRuleItemIntf{}
+	for {
+		listNetworkACLRulesOptions := &vpcv1.ListNetworkACLRulesOptions{
+			NetworkACLID: &nwaclID,
+		}
+		if start != "" {
+			listNetworkACLRulesOptions.Start = &start
+		}
+		rawrules, response, err := sess.ListNetworkACLRulesWithContext(context, listNetworkACLRulesOptions)
+		if err != nil {
+			return flex.NewServiceError("Error Listing network ACL rules", err, response).Diagnostics()
+		}
+		start = flex.GetNext(rawrules.Next)
+		allrecs = append(allrecs, rawrules.Rules...)
+		if start == "" {
+			break
+		}
+	}
+
+	rules := make([]analyzedRule, 0, len(allrecs))
+	ruleIDs := make([]string, 0, len(allrecs))
+	for _, ruleItem := range allrecs {
+		rule, ok := networkACLRuleFromItem(len(rules), ruleItem)
+		if !ok {
+			continue
+		}
+		rules = append(rules, rule)
+		ruleIDs = append(ruleIDs, networkACLRuleID(ruleItem))
+	}
+
+	protocol := d.Get(isNetworkACLRuleProtocol).(string)
+	traffic := newAnalyzedRule(0, "", "", d.Get(isNetworkACLRuleDirection).(string), d.Get(isNetworkACLRuleSource).(string), d.Get(isNetworkACLRuleDestination).(string), protocol)
+	switch protocol {
+	case "icmp":
+		if icmpType, ok := d.GetOkExists(isNetworkACLRuleICMPType); ok {
+			traffic.icmpType = icmpType.(int)
+		}
+		if icmpCode, ok := d.GetOkExists(isNetworkACLRuleICMPCode); ok {
+			traffic.icmpCode = icmpCode.(int)
+		}
+	case "tcp", "udp":
+		port, ok := d.GetOk("port")
+		if !ok {
+			return diag.FromErr(fmt.Errorf("[ERROR] port is required to evaluate %s traffic", protocol))
+		}
+		traffic.portMin, traffic.portMax = port.(int), port.(int)
+		if sourcePort, ok := d.GetOk("source_port"); ok {
+			traffic.sourcePortMin, traffic.sourcePortMax = sourcePort.(int), sourcePort.(int)
+		}
+	}
+
+	action := "deny"
+	d.Set("rule", "")
+	d.Set("rule_name", "")
+	for i, rule := range rules {
+		if rule.covers(traffic) {
+			action = rule.action
+			d.Set("rule", ruleIDs[i])
+			d.Set("rule_name", rule.name)
+			break
+		}
+	}
+	d.Set(isNetworkACLRuleAction, action)
+	d.Set("allowed", action == "allow")
+
+	findings := make([]map[string]interface{}, 0)
+	for _, finding := range analyzeNetworkACLRules(rules) {
+		findings = append(findings, map[string]interface{}{
+			"severity":  finding.severity,
+			"rule":      ruleIDs[finding.index],
+			"rule_name": finding.name,
+			"message":   finding.message,
+		})
+	}
+	if err = d.Set("findings", findings); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting findings: %s", err))
+	}
+
+	d.SetId(strings.Join([]string{nwaclID, d.Get(isNetworkACLRuleDirection).(string), protocol, d.Get(isNetworkACLRuleSource).(string), d.Get(isNetworkACLRuleDestination).(string)}, "/"))
+	return nil
+}
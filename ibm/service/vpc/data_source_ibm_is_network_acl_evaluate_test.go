@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsNetworkACLEvaluateDataSourceBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	aclname := fmt.Sprintf("tf-nwacl-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsNetworkACLEvaluateDataSourceConfig(vpcname, aclname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_network_acl_evaluate.ssh", "allowed", "false"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_acl_evaluate.ssh", "action", "deny"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_acl_evaluate.ssh", "rule_name", "deny-ssh"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_acl_evaluate.https", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_acl_evaluate.https", "rule_name", "allow-inbound"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_acl_evaluate.ssh", "findings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsNetworkACLEvaluateDataSourceConfig(vpcname, aclname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_network_acl" "testacc_nwacl" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		rules {
+			name        = "deny-ssh"
+			action      = "deny"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+			tcp {
+				port_min = 22
+				port_max = 22
+			}
+		}
+		rules {
+			name        = "allow-inbound"
+			action      = "allow"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+		}
+	}
+
+	data "ibm_is_network_acl_evaluate" "ssh" {
+		network_acl = ibm_is_network_acl.testacc_nwacl.id
+		direction   = "inbound"
+		source      = "203.0.113.10"
+		destination = "10.240.0.4"
+		protocol    = "tcp"
+		port        = 22
+	}
+
+	data "ibm_is_network_acl_evaluate" "https" {
+		network_acl = ibm_is_network_acl.testacc_nwacl.id
+		direction   = "inbound"
+		source      = "203.0.113.10"
+		destination = "10.240.0.4"
+		protocol    = "tcp"
+		port        = 443
+	}
+	`, vpcname, aclname)
+}
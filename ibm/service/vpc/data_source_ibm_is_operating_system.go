@@ -4,10 +4,10 @@
 package vpc
 
 import (
-	"fmt"
-
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 const (
@@ -93,7 +93,7 @@ func osGet(d *schema.ResourceData, meta interface{}, name string) error {
 	}
 	os, response, err := sess.GetOperatingSystem(getOperatingSystemOptions)
 	if err != nil || os == nil {
-		return fmt.Errorf("[ERROR] Error Getting Operating System Details %s , %s", err, response)
+		return flex.NewServiceError("Error Getting Operating System Details", err, response)
 	}
 	d.Set(isOperatingSystemName, *os.Name)
 	d.SetId(*os.Name)
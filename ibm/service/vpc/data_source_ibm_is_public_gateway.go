@@ -131,7 +131,7 @@ func dataSourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{})
 		}
 		publicgws, response, err := sess.ListPublicGateways(listPublicGatewaysOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching public gateways %s\n%s", err, response)
+			return flex.NewServiceError("Error Fetching public gateways", err, response)
 		}
 		start = flex.GetNext(publicgws.Next)
 		allrecs = append(allrecs, publicgws.PublicGateways...)
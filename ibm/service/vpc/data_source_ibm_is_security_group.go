@@ -185,7 +185,7 @@ func securityGroupGet(d *schema.ResourceData, meta interface{}, name string) err
 		}
 		sgs, response, err := sess.ListSecurityGroups(listSgOptions)
 		if err != nil || sgs == nil {
-			return fmt.Errorf("[ERROR] Error Getting Security Groups %s\n%s", err, response)
+			return flex.NewServiceError("Error Getting Security Groups", err, response)
 		}
 		if *sgs.TotalCount == int64(0) {
 			break
@@ -4,12 +4,13 @@
 package vpc
 
 import (
-	"fmt"
 	"reflect"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func DataSourceIBMIsSecurityGroupRules() *schema.Resource {
@@ -146,7 +147,7 @@ func dataSourceIBMIsSecurityGroupRulesRead(d *schema.ResourceData, meta interfac
 
 	ruleList, response, err := sess.ListSecurityGroupRules(&listSecurityGroupRuleOptions)
 	if err != nil {
-		return fmt.Errorf("Error fetching security group rules %s\n%s", err, response)
+		return flex.NewServiceError("Error fetching security group rules", err, response)
 	}
 
 	rulesInfo := make([]map[string]interface{}, 0)
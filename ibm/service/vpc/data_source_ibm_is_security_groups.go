@@ -344,7 +344,7 @@ func dataSourceIBMIsSecurityGroupsRead(context context.Context, d *schema.Resour
 		securityGroupCollection, response, err := vpcClient.ListSecurityGroupsWithContext(context, listSecurityGroupsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListSecurityGroupsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListSecurityGroupsWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ListSecurityGroupsWithContext failed", err, response).Diagnostics()
 		}
 
 		start = flex.GetNext(securityGroupCollection.Next)
@@ -411,7 +411,7 @@ func snapshotGetByNameOrID(d *schema.ResourceData, meta interface{}, name, id st
 			}
 			snapshots, response, err := sess.ListSnapshots(listSnapshotOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Fetching snapshots %s\n%s", err, response)
+				return flex.NewServiceError("Error Fetching snapshots", err, response)
 			}
 			start = flex.GetNext(snapshots.Next)
 			allrecs = append(allrecs, snapshots.Snapshots...)
@@ -545,7 +545,7 @@ func snapshotGetByNameOrID(d *schema.ResourceData, meta interface{}, name, id st
 		}
 		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error fetching snapshot %s\n%s", err, response)
+			return flex.NewServiceError("Error fetching snapshot", err, response)
 		}
 		if (response != nil && response.StatusCode == 404) || snapshot == nil {
 			return fmt.Errorf("[ERROR] No snapshot found with id %s", id)
@@ -68,7 +68,7 @@ func getSnapshotClone(context context.Context, d *schema.ResourceData, meta inte
 
 	clone, response, err := sess.GetSnapshotCloneWithContext(context, getSnapshotCloneOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error fetching snapshot(%s) clone(%s) %s\n%s", id, zone, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error fetching snapshot(%s) clone(%s)", id, zone), err, response)
 	}
 
 	if clone != nil && clone.Zone != nil {
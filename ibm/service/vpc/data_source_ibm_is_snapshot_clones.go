@@ -84,7 +84,7 @@ func getSnapshotClones(context context.Context, d *schema.ResourceData, meta int
 
 	clonesCollection, response, err := sess.ListSnapshotClonesWithContext(context, listSnapshotClonesOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error fetching snapshot(%s) clones %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error fetching snapshot(%s) clones", id), err, response)
 	}
 	clones := clonesCollection.Clones
 
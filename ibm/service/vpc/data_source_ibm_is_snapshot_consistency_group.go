@@ -296,7 +296,7 @@ func dataSourceIBMIsSnapshotConsistencyGroupRead(context context.Context, d *sch
 
 			snapshotConsistencyGroup, response, err := vpcClient.ListSnapshotConsistencyGroups(listSnapshotConsistencyGroupsOptions)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error fetching snapshots %s\n%s", err, response))
+				return flex.NewServiceError("Error fetching snapshots", err, response).Diagnostics()
 			}
 			start = flex.GetNext(snapshotConsistencyGroup.Next)
 			allrecs = append(allrecs, snapshotConsistencyGroup.SnapshotConsistencyGroups...)
@@ -399,7 +399,7 @@ func dataSourceIBMIsSnapshotConsistencyGroupRead(context context.Context, d *sch
 		snapshotConsistencyGroup, response, err := vpcClient.GetSnapshotConsistencyGroupWithContext(context, getSnapshotConsistencyGroupOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetSnapshotConsistencyGroupWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetSnapshotConsistencyGroupWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("GetSnapshotConsistencyGroupWithContext failed", err, response).Diagnostics()
 		}
 
 		d.SetId(fmt.Sprintf("%s", *getSnapshotConsistencyGroupOptions.ID))
@@ -277,7 +277,7 @@ func dataSourceIBMIsSnapshotConsistencyGroupsRead(context context.Context, d *sc
 
 		snapshotConsistencyGroup, response, err := vpcClient.ListSnapshotConsistencyGroups(listSnapshotConsistencyGroupsOptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error fetching snapshots %s\n%s", err, response))
+			return flex.NewServiceError("Error fetching snapshots", err, response).Diagnostics()
 		}
 		start = flex.GetNext(snapshotConsistencyGroup.Next)
 		allrecs = append(allrecs, snapshotConsistencyGroup.SnapshotConsistencyGroups...)
@@ -563,7 +563,7 @@ func getSnapshots(d *schema.ResourceData, meta interface{}) error {
 		}
 		snapshots, response, err := sess.ListSnapshots(listSnapshotOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error fetching snapshots %s\n%s", err, response)
+			return flex.NewServiceError("Error fetching snapshots", err, response)
 		}
 		start = flex.GetNext(snapshots.Next)
 		allrecs = append(allrecs, snapshots.Snapshots...)
@@ -120,7 +120,7 @@ func keyGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 
 		keys, response, err := sess.ListKeys(listKeysOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error fetching Keys %s\n%s", err, response)
+			return flex.NewServiceError("Error fetching Keys", err, response)
 		}
 		start = flex.GetNext(keys.Next)
 		allrecs = append(allrecs, keys.Keys...)
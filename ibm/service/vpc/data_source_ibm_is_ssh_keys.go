@@ -141,7 +141,7 @@ func dataSourceIBMIsSshKeysRead(context context.Context, d *schema.ResourceData,
 		keyCollection, response, err := vpcClient.ListKeysWithContext(context, listKeysOptions)
 		if err != nil || keyCollection == nil {
 			log.Printf("[DEBUG] ListKeysWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListKeysWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ListKeysWithContext failed", err, response).Diagnostics()
 		}
 
 		start = flex.GetNext(keyCollection.Next)
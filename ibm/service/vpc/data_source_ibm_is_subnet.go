@@ -224,7 +224,7 @@ func subnetGetByNameOrID(d *schema.ResourceData, meta interface{}) error {
 		}
 		subnetinfo, response, err := sess.GetSubnet(getSubnetOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Getting Subnet (%s): %s\n%s", id, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error Getting Subnet (%s)", id), err, response)
 		}
 		subnet = subnetinfo
 	} else if v, ok := d.GetOk(isSubnetName); ok {
@@ -242,7 +242,7 @@ func subnetGetByNameOrID(d *schema.ResourceData, meta interface{}) error {
 			}
 			subnetsCollection, response, err := sess.ListSubnets(getSubnetsListOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Fetching subnets List %s\n%s", err, response)
+				return flex.NewServiceError("Error Fetching subnets List", err, response)
 			}
 			start = flex.GetNext(subnetsCollection.Next)
 			allrecs = append(allrecs, subnetsCollection.Subnets...)
@@ -4,11 +4,12 @@
 package vpc
 
 import (
-	"fmt"
 	"reflect"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 // Define all the constants that matches with the given terrafrom attribute
@@ -123,7 +124,7 @@ func dataSdataSourceIBMISReservedIPRead(d *schema.ResourceData, meta interface{}
 	reserveIP, response, err := sess.GetSubnetReservedIP(options)
 
 	if err != nil || response == nil || reserveIP == nil {
-		return fmt.Errorf("[ERROR] Error fetching the reserved IP %s\n%s", err, response)
+		return flex.NewServiceError("Error fetching the reserved IP", err, response)
 	}
 
 	d.SetId(*reserveIP.ID)
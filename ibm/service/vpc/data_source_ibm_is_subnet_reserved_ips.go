@@ -4,7 +4,6 @@
 package vpc
 
 import (
-	"fmt"
 	"reflect"
 	"time"
 
@@ -136,7 +135,7 @@ func dataSdataSourceIBMISReservedIPsRead(d *schema.ResourceData, meta interface{
 
 		result, response, err := sess.ListSubnetReservedIps(options)
 		if err != nil || response == nil || result == nil {
-			return fmt.Errorf("[ERROR] Error fetching reserved ips %s\n%s", err, response)
+			return flex.NewServiceError("Error fetching reserved ips", err, response)
 		}
 		start = flex.GetNext(result.Next)
 		allrecs = append(allrecs, result.ReservedIps...)
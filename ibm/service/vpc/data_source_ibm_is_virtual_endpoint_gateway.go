@@ -158,7 +158,7 @@ func dataSourceIBMISEndpointGatewayRead(
 
 	results, response, err := sess.ListEndpointGateways(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error fetching endpoint gateways %s\n%s", err, response)
+		return flex.NewServiceError("Error fetching endpoint gateways", err, response)
 	}
 	allrecs := results.EndpointGateways
 
@@ -351,7 +351,7 @@ func dataSourceIBMIsVirtualNetworkInterfaceRead(context context.Context, d *sche
 	virtualNetworkInterface, response, err := vpcClient.GetVirtualNetworkInterfaceWithContext(context, getVirtualNetworkInterfaceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetVirtualNetworkInterfaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetVirtualNetworkInterfaceWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetVirtualNetworkInterfaceWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*virtualNetworkInterface.ID)
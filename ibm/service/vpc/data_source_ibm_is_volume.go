@@ -307,7 +307,7 @@ func volumeGet(d *schema.ResourceData, meta interface{}, name string) error {
 	listVolumesOptions.Name = &name
 	vols, response, err := sess.ListVolumes(listVolumesOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Fetching volumes %s\n%s", err, response)
+		return flex.NewServiceError("Error Fetching volumes", err, response)
 	}
 	allrecs := vols.Volumes
 
@@ -644,7 +644,7 @@ func dataSourceIBMIsVolumesRead(context context.Context, d *schema.ResourceData,
 		volumeCollection, response, err := vpcClient.ListVolumesWithContext(context, listVolumesOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListVolumesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListVolumesWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ListVolumesWithContext failed", err, response).Diagnostics()
 		}
 
 		start = flex.GetNext(volumeCollection.Next)
@@ -518,7 +518,7 @@ func vpcGetByNameOrId(d *schema.ResourceData, meta interface{}, name, id string)
 		}
 		vpcGet, response, err := sess.GetVPC(getVpcsOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching vpc %s\n%s", err, response)
+			return flex.NewServiceError("Error Fetching vpc", err, response)
 		}
 		flag = true
 		setVpcDetails(d, vpcGet, meta, sess)
@@ -532,7 +532,7 @@ func vpcGetByNameOrId(d *schema.ResourceData, meta interface{}, name, id string)
 			}
 			vpcs, response, err := sess.ListVpcs(listVpcsOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Fetching vpcs %s\n%s", err, response)
+				return flex.NewServiceError("Error Fetching vpcs", err, response)
 			}
 			start = flex.GetNext(vpcs.Next)
 			allrecs = append(allrecs, vpcs.Vpcs...)
@@ -650,7 +650,7 @@ func setVpcDetails(d *schema.ResourceData, vpc *vpcv1.VPC, meta interface{}, ses
 			}
 			s, response, err := sess.ListSubnets(options)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error fetching subnets %s\n%s", err, response)
+				return flex.NewServiceError("Error fetching subnets", err, response)
 			}
 			startSub = flex.GetNext(s.Next)
 			allrecsSub = append(allrecsSub, s.Subnets...)
@@ -691,7 +691,7 @@ func setVpcDetails(d *schema.ResourceData, vpc *vpcv1.VPC, meta interface{}, ses
 			}
 			sgs, response, err := sess.ListSecurityGroups(listSgOptions)
 			if err != nil || sgs == nil {
-				return fmt.Errorf("[ERROR] Error fetching Security Groups %s\n%s", err, response)
+				return flex.NewServiceError("Error fetching Security Groups", err, response)
 			}
 			if *sgs.TotalCount == int64(0) {
 				break
@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMIsVPCAvailableCIDRs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVPCAvailableCIDRsRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPC identifier.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The zone to compute free blocks for. If unspecified, all zones are used.",
+			},
+			isAddressPrefix: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The address prefix identifier to compute free blocks for. If unspecified, all address prefixes are used.",
+			},
+			"prefix_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_vpc_available_cidrs", "prefix_length"),
+				Description:  "The prefix length of the blocks to return. If unspecified, the free space is returned as the largest possible blocks.",
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_vpc_available_cidrs", "limit"),
+				Description:  "The maximum number of blocks to return.",
+			},
+			"available_cidrs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The free blocks, ordered by zone, address prefix and address.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone of the block.",
+						},
+						isAddressPrefix: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the address prefix containing the block.",
+						},
+						"address_prefix_cidr": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CIDR of the address prefix containing the block.",
+						},
+						"cidr": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The free CIDR block.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMIsVPCAvailableCIDRsValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "prefix_length",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "8",
+			MaxValue:                   "29"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "limit",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "1024"})
+
+	ibmISVPCAvailableCIDRsValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpc_available_cidrs", Schema: validateSchema}
+	return &ibmISVPCAvailableCIDRsValidator
+}
+
+func dataSourceIBMIsVPCAvailableCIDRsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	vpcID := d.Get("vpc").(string)
+	zone := d.Get("zone").(string)
+	addressPrefixID := d.Get(isAddressPrefix).(string)
+	prefixLength := d.Get("prefix_length").(int)
+
+	available, err := listAvailableSubnetCIDRs(context, sess, vpcID, zone, addressPrefixID, prefixLength, d.Get("limit").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	availableList := make([]map[string]interface{}, 0, len(available))
+	for _, block := range available {
+		availableList = append(availableList, map[string]interface{}{
+			"zone":                block.zone,
+			isAddressPrefix:       block.addressPrefixID,
+			"address_prefix_cidr": block.addressPrefixCIDR,
+			"cidr":                block.cidr,
+		})
+	}
+	if err = d.Set("available_cidrs", availableList); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting available_cidrs: %s", err))
+	}
+
+	d.SetId(strings.Join([]string{vpcID, zone, addressPrefixID, fmt.Sprint(prefixLength)}, "/"))
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISVPCAvailableCIDRsDataSource_basic(t *testing.T) {
+	node := "data.ibm_is_vpc_available_cidrs.test"
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	prefixname := fmt.Sprintf("tf-prefix-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCAvailableCIDRsDataSourceConfig(vpcname, prefixname, subnetname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "available_cidrs.#", "2"),
+					resource.TestCheckResourceAttr(node, "available_cidrs.0.cidr", "10.120.1.0/24"),
+					resource.TestCheckResourceAttr(node, "available_cidrs.1.cidr", "10.120.2.0/24"),
+					resource.TestCheckResourceAttr(node, "available_cidrs.0.zone", acc.ISZoneName),
+					resource.TestCheckResourceAttr(node, "available_cidrs.0.address_prefix_cidr", "10.120.0.0/22"),
+					resource.TestCheckResourceAttrPair(node, "available_cidrs.0.address_prefix", "ibm_is_vpc_address_prefix.testacc_prefix", "address_prefix"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPCAvailableCIDRsDataSourceConfig(vpcname, prefixname, subnetname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name                      = "%s"
+		address_prefix_management = "manual"
+	}
+
+	resource "ibm_is_vpc_address_prefix" "testacc_prefix" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		cidr = "10.120.0.0/22"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "10.120.0.0/24"
+		depends_on      = [ibm_is_vpc_address_prefix.testacc_prefix]
+	}
+
+	data "ibm_is_vpc_available_cidrs" "test" {
+		vpc            = ibm_is_vpc.testacc_vpc.id
+		address_prefix = ibm_is_vpc_address_prefix.testacc_prefix.address_prefix
+		prefix_length  = 24
+		limit          = 2
+		depends_on     = [ibm_is_subnet.testacc_subnet]
+	}
+	`, vpcname, prefixname, acc.ISZoneName, subnetname, acc.ISZoneName)
+}
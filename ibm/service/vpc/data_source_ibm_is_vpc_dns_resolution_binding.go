@@ -235,7 +235,7 @@ func dataSourceIBMIsVPCDnsResolutionBindingRead(context context.Context, d *sche
 	vpcdnsResolutionBinding, response, err := sess.GetVPCDnsResolutionBindingWithContext(context, getVPCDnsResolutionBindingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetVPCDnsResolutionBindingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetVPCDnsResolutionBindingWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetVPCDnsResolutionBindingWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*vpcdnsResolutionBinding.ID)
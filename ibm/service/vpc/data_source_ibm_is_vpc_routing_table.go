@@ -216,7 +216,7 @@ func dataSourceIBMIBMIsVPCRoutingTableRead(context context.Context, d *schema.Re
 		rt, response, err := vpcClient.GetVPCRoutingTableWithContext(context, getVPCRoutingTableOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetVPCRoutingTableWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] GetVPCRoutingTableWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("GetVPCRoutingTableWithContext failed", err, response).Diagnostics()
 		}
 		routingTable = rt
 	} else {
@@ -232,7 +232,7 @@ func dataSourceIBMIBMIsVPCRoutingTableRead(context context.Context, d *schema.Re
 			result, detail, err := vpcClient.ListVPCRoutingTables(listOptions)
 			if err != nil {
 				log.Printf("[ERROR] Error reading list of VPC Routing Tables:%s\n%s", err, detail)
-				return diag.FromErr(fmt.Errorf("[ERROR] ListVPCRoutingTables failed %s\n%s", err, detail))
+				return flex.NewServiceError("ListVPCRoutingTables failed", err, detail).Diagnostics()
 			}
 			start = flex.GetNext(result.Next)
 			allrecs = append(allrecs, result.RoutingTables...)
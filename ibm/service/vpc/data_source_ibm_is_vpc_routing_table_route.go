@@ -232,7 +232,7 @@ func dataSourceIBMIBMIsVPCRoutingTableRouteRead(context context.Context, d *sche
 		r, response, err := vpcClient.GetVPCRoutingTableRouteWithContext(context, getVPCRoutingTableRouteOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetVPCRoutingTableRouteWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] GetVPCRoutingTableRouteWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("GetVPCRoutingTableRouteWithContext failed", err, response).Diagnostics()
 		}
 		route = r
 	} else {
@@ -250,7 +250,7 @@ func dataSourceIBMIBMIsVPCRoutingTableRouteRead(context context.Context, d *sche
 			result, detail, err := vpcClient.ListVPCRoutingTableRoutes(listVpcRoutingTablesRoutesOptions)
 			if err != nil {
 				log.Printf("Error reading list of VPC Routing Table Routes:%s\n%s", err, detail)
-				return diag.FromErr(fmt.Errorf("[ERROR] GetVPCRoutingTableRouteWithContext failed %s\n%s", err, detail))
+				return flex.NewServiceError("GetVPCRoutingTableRouteWithContext failed", err, detail).Diagnostics()
 			}
 			start = flex.GetNext(result.Next)
 			allrecs = append(allrecs, result.Routes...)
@@ -0,0 +1,797 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMIsVPCTopology() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVPCTopologyRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPC identifier.",
+			},
+			"subnets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The subnets of the VPC.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":              vpcTopologyComputedString("The subnet identifier."),
+						"name":            vpcTopologyComputedString("The subnet name."),
+						"zone":            vpcTopologyComputedString("The zone of the subnet."),
+						"ipv4_cidr_block": vpcTopologyComputedString("The IPv4 range of the subnet."),
+						"network_acl":     vpcTopologyComputedString("The network ACL attached to the subnet."),
+						"public_gateway":  vpcTopologyComputedString("The public gateway attached to the subnet."),
+						"routing_table":   vpcTopologyComputedString("The routing table attached to the subnet."),
+					},
+				},
+			},
+			"address_prefixes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The address prefixes of the VPC.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":   vpcTopologyComputedString("The address prefix identifier."),
+						"name": vpcTopologyComputedString("The address prefix name."),
+						"zone": vpcTopologyComputedString("The zone of the address prefix."),
+						"cidr": vpcTopologyComputedString("The CIDR block of the address prefix."),
+						"is_default": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether this is the default prefix for the zone.",
+						},
+					},
+				},
+			},
+			"routing_tables": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The routing tables of the VPC.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":   vpcTopologyComputedString("The routing table identifier."),
+						"name": vpcTopologyComputedString("The routing table name."),
+						"is_default": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether this is the default routing table of the VPC.",
+						},
+						"subnets": vpcTopologyComputedIDs("The subnets attached to the routing table."),
+						"routes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The routes of the routing table.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id":          vpcTopologyComputedString("The route identifier."),
+									"name":        vpcTopologyComputedString("The route name."),
+									"zone":        vpcTopologyComputedString("The zone the route applies to."),
+									"destination": vpcTopologyComputedString("The destination CIDR of the route."),
+									"action":      vpcTopologyComputedString("The action to perform with a packet matching the route."),
+									"next_hop":    vpcTopologyComputedString("The next hop address or VPN gateway connection of the route."),
+								},
+							},
+						},
+					},
+				},
+			},
+			"public_gateways": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The public gateways of the VPC.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":          vpcTopologyComputedString("The public gateway identifier."),
+						"name":        vpcTopologyComputedString("The public gateway name."),
+						"zone":        vpcTopologyComputedString("The zone of the public gateway."),
+						"floating_ip": vpcTopologyComputedString("The floating IP address of the public gateway."),
+					},
+				},
+			},
+			"vpn_gateways": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The VPN gateways of the VPC.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":     vpcTopologyComputedString("The VPN gateway identifier."),
+						"name":   vpcTopologyComputedString("The VPN gateway name."),
+						"mode":   vpcTopologyComputedString("The mode of the VPN gateway, policy or route."),
+						"subnet": vpcTopologyComputedString("The subnet of the VPN gateway."),
+					},
+				},
+			},
+			"endpoint_gateways": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The endpoint gateways of the VPC.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":              vpcTopologyComputedString("The endpoint gateway identifier."),
+						"name":            vpcTopologyComputedString("The endpoint gateway name."),
+						"target":          vpcTopologyComputedString("The CRN or name of the endpoint gateway target."),
+						"ips":             vpcTopologyComputedIDs("The reserved IP addresses bound to the endpoint gateway."),
+						"security_groups": vpcTopologyComputedIDs("The security groups of the endpoint gateway."),
+					},
+				},
+			},
+			"load_balancers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The load balancers in the subnets of the VPC.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":       vpcTopologyComputedString("The load balancer identifier."),
+						"name":     vpcTopologyComputedString("The load balancer name."),
+						"hostname": vpcTopologyComputedString("The fully qualified domain name of the load balancer."),
+						"is_public": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the load balancer is public.",
+						},
+						"subnets":         vpcTopologyComputedIDs("The subnets of the load balancer."),
+						"security_groups": vpcTopologyComputedIDs("The security groups of the load balancer."),
+					},
+				},
+			},
+			"instances": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The virtual server instances of the VPC.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":                  vpcTopologyComputedString("The instance identifier."),
+						"name":                vpcTopologyComputedString("The instance name."),
+						"zone":                vpcTopologyComputedString("The zone of the instance."),
+						"network_interfaces":  vpcTopologyComputedInterfaces("The network interfaces of the instance."),
+						"network_attachments": vpcTopologyComputedInterfaces("The network attachments of the instance."),
+					},
+				},
+			},
+			"virtual_network_interfaces": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The virtual network interfaces of the VPC.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":              vpcTopologyComputedString("The virtual network interface identifier."),
+						"name":            vpcTopologyComputedString("The virtual network interface name."),
+						"subnet":          vpcTopologyComputedString("The subnet of the virtual network interface."),
+						"primary_ip":      vpcTopologyComputedString("The primary IP address of the virtual network interface."),
+						"target":          vpcTopologyComputedString("The identifier of the attachment or mount target the virtual network interface is bound to."),
+						"security_groups": vpcTopologyComputedIDs("The security groups of the virtual network interface."),
+					},
+				},
+			},
+			"security_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The security groups of the VPC.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":      vpcTopologyComputedString("The security group identifier."),
+						"name":    vpcTopologyComputedString("The security group name."),
+						"targets": vpcTopologyComputedIDs("The resources the security group is attached to."),
+					},
+				},
+			},
+			"network_acls": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The network ACLs of the VPC.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":      vpcTopologyComputedString("The network ACL identifier."),
+						"name":    vpcTopologyComputedString("The network ACL name."),
+						"subnets": vpcTopologyComputedIDs("The subnets the network ACL is attached to."),
+					},
+				},
+			},
+			"graph_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The topology rendered as a JSON document of nodes and edges.",
+			},
+			"graph_dot": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The topology rendered as a Graphviz DOT digraph.",
+			},
+		},
+	}
+}
+
+func vpcTopologyComputedString(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: description,
+	}
+}
+
+func vpcTopologyComputedIDs(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: description,
+	}
+}
+
+func vpcTopologyComputedInterfaces(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id":         vpcTopologyComputedString("The interface identifier."),
+				"name":       vpcTopologyComputedString("The interface name."),
+				"subnet":     vpcTopologyComputedString("The subnet of the interface."),
+				"primary_ip": vpcTopologyComputedString("The primary IP address of the interface."),
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsVPCTopologyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	vpcID := d.Get("vpc").(string)
+
+	vpc, response, err := sess.GetVPCWithContext(context, &vpcv1.GetVPCOptions{ID: &vpcID})
+	if err != nil {
+		return flex.NewServiceError("Error fetching VPC", err, response).Diagnostics()
+	}
+	graph := newVPCTopologyGraph()
+	graph.addNode(vpcID, "vpc", vpc.Name)
+
+	// address prefixes
+	prefixPager, err := sess.NewVPCAddressPrefixesPager(&vpcv1.ListVPCAddressPrefixesOptions{VPCID: &vpcID})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	prefixes, err := prefixPager.GetAllWithContext(context)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing address prefixes of VPC (%s): %s", vpcID, err))
+	}
+	prefixList := make([]map[string]interface{}, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		prefixList = append(prefixList, map[string]interface{}{
+			"id":         vpcTopologyString(prefix.ID),
+			"name":       vpcTopologyString(prefix.Name),
+			"zone":       vpcTopologyZone(prefix.Zone),
+			"cidr":       vpcTopologyString(prefix.CIDR),
+			"is_default": prefix.IsDefault != nil && *prefix.IsDefault,
+		})
+		graph.addNode(*prefix.ID, "address_prefix", prefix.CIDR)
+		graph.addEdge(vpcID, *prefix.ID, "contains")
+	}
+
+	// routing tables and routes
+	tablePager, err := sess.NewVPCRoutingTablesPager(&vpcv1.ListVPCRoutingTablesOptions{VPCID: &vpcID})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tables, err := tablePager.GetAllWithContext(context)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing routing tables of VPC (%s): %s", vpcID, err))
+	}
+	tableList := make([]map[string]interface{}, 0, len(tables))
+	for _, table := range tables {
+		routePager, err := sess.NewVPCRoutingTableRoutesPager(&vpcv1.ListVPCRoutingTableRoutesOptions{VPCID: &vpcID, RoutingTableID: table.ID})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		routes, err := routePager.GetAllWithContext(context)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing routes of routing table (%s): %s", *table.ID, err))
+		}
+		routeList := make([]map[string]interface{}, 0, len(routes))
+		for _, route := range routes {
+			nextHop := ""
+			if hop, ok := route.NextHop.(*vpcv1.RouteNextHop); ok && hop != nil {
+				nextHop = vpcTopologyString(hop.Address)
+				if nextHop == "" {
+					nextHop = vpcTopologyString(hop.ID)
+				}
+			}
+			routeList = append(routeList, map[string]interface{}{
+				"id":          vpcTopologyString(route.ID),
+				"name":        vpcTopologyString(route.Name),
+				"zone":        vpcTopologyZone(route.Zone),
+				"destination": vpcTopologyString(route.Destination),
+				"action":      vpcTopologyString(route.Action),
+				"next_hop":    nextHop,
+			})
+		}
+		subnetIDs := make([]string, 0, len(table.Subnets))
+		for _, subnet := range table.Subnets {
+			subnetIDs = append(subnetIDs, *subnet.ID)
+		}
+		tableList = append(tableList, map[string]interface{}{
+			"id":         vpcTopologyString(table.ID),
+			"name":       vpcTopologyString(table.Name),
+			"is_default": table.IsDefault != nil && *table.IsDefault,
+			"subnets":    subnetIDs,
+			"routes":     routeList,
+		})
+		graph.addNode(*table.ID, "routing_table", table.Name)
+		graph.addEdge(vpcID, *table.ID, "contains")
+	}
+
+	// network ACLs and public gateways have no VPC filter, so they are filtered here
+	aclPager, err := sess.NewNetworkAclsPager(&vpcv1.ListNetworkAclsOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	acls, err := aclPager.GetAllWithContext(context)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing network ACLs: %s", err))
+	}
+	aclList := make([]map[string]interface{}, 0)
+	for _, acl := range acls {
+		if acl.VPC == nil || *acl.VPC.ID != vpcID {
+			continue
+		}
+		subnetIDs := make([]string, 0, len(acl.Subnets))
+		for _, subnet := range acl.Subnets {
+			subnetIDs = append(subnetIDs, *subnet.ID)
+		}
+		aclList = append(aclList, map[string]interface{}{
+			"id":      vpcTopologyString(acl.ID),
+			"name":    vpcTopologyString(acl.Name),
+			"subnets": subnetIDs,
+		})
+		graph.addNode(*acl.ID, "network_acl", acl.Name)
+		graph.addEdge(vpcID, *acl.ID, "contains")
+	}
+
+	gatewayPager, err := sess.NewPublicGatewaysPager(&vpcv1.ListPublicGatewaysOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	gateways, err := gatewayPager.GetAllWithContext(context)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing public gateways: %s", err))
+	}
+	gatewayList := make([]map[string]interface{}, 0)
+	for _, gateway := range gateways {
+		if gateway.VPC == nil || *gateway.VPC.ID != vpcID {
+			continue
+		}
+		floatingIP := ""
+		if gateway.FloatingIP != nil {
+			floatingIP = vpcTopologyString(gateway.FloatingIP.Address)
+		}
+		gatewayList = append(gatewayList, map[string]interface{}{
+			"id":          vpcTopologyString(gateway.ID),
+			"name":        vpcTopologyString(gateway.Name),
+			"zone":        vpcTopologyZone(gateway.Zone),
+			"floating_ip": floatingIP,
+		})
+		graph.addNode(*gateway.ID, "public_gateway", gateway.Name)
+		graph.addEdge(vpcID, *gateway.ID, "contains")
+	}
+
+	// subnets
+	subnetPager, err := sess.NewSubnetsPager(&vpcv1.ListSubnetsOptions{VPCID: &vpcID})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	subnets, err := subnetPager.GetAllWithContext(context)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing subnets of VPC (%s): %s", vpcID, err))
+	}
+	subnetSet := make(map[string]bool, len(subnets))
+	subnetList := make([]map[string]interface{}, 0, len(subnets))
+	for _, subnet := range subnets {
+		subnetSet[*subnet.ID] = true
+		subnetMap := map[string]interface{}{
+			"id":              vpcTopologyString(subnet.ID),
+			"name":            vpcTopologyString(subnet.Name),
+			"zone":            vpcTopologyZone(subnet.Zone),
+			"ipv4_cidr_block": vpcTopologyString(subnet.Ipv4CIDRBlock),
+		}
+		graph.addNode(*subnet.ID, "subnet", subnet.Name)
+		graph.addEdge(vpcID, *subnet.ID, "contains")
+		if subnet.NetworkACL != nil {
+			subnetMap["network_acl"] = *subnet.NetworkACL.ID
+			graph.addEdge(*subnet.ID, *subnet.NetworkACL.ID, "network_acl")
+		}
+		if subnet.PublicGateway != nil {
+			subnetMap["public_gateway"] = *subnet.PublicGateway.ID
+			graph.addEdge(*subnet.ID, *subnet.PublicGateway.ID, "public_gateway")
+		}
+		if subnet.RoutingTable != nil {
+			subnetMap["routing_table"] = *subnet.RoutingTable.ID
+			graph.addEdge(*subnet.ID, *subnet.RoutingTable.ID, "routing_table")
+		}
+		subnetList = append(subnetList, subnetMap)
+	}
+
+	// VPN gateways
+	vpnPager, err := sess.NewVPNGatewaysPager(&vpcv1.ListVPNGatewaysOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	vpnGateways, err := vpnPager.GetAllWithContext(context)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing VPN gateways: %s", err))
+	}
+	vpnList := make([]map[string]interface{}, 0)
+	for _, vpnGatewayIntf := range vpnGateways {
+		vpnGateway, ok := vpnGatewayIntf.(*vpcv1.VPNGateway)
+		if !ok || vpnGateway.Subnet == nil || !subnetSet[*vpnGateway.Subnet.ID] {
+			continue
+		}
+		vpnList = append(vpnList, map[string]interface{}{
+			"id":     vpcTopologyString(vpnGateway.ID),
+			"name":   vpcTopologyString(vpnGateway.Name),
+			"mode":   vpcTopologyString(vpnGateway.Mode),
+			"subnet": *vpnGateway.Subnet.ID,
+		})
+		graph.addNode(*vpnGateway.ID, "vpn_gateway", vpnGateway.Name)
+		graph.addEdge(*vpnGateway.ID, *vpnGateway.Subnet.ID, "attached_to")
+	}
+
+	// endpoint gateways
+	endpointPager, err := sess.NewEndpointGatewaysPager(&vpcv1.ListEndpointGatewaysOptions{VPCID: &vpcID})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	endpointGateways, err := endpointPager.GetAllWithContext(context)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing endpoint gateways of VPC (%s): %s", vpcID, err))
+	}
+	endpointList := make([]map[string]interface{}, 0, len(endpointGateways))
+	for _, endpointGateway := range endpointGateways {
+		target := ""
+		if endpointTarget, ok := endpointGateway.Target.(*vpcv1.EndpointGatewayTarget); ok && endpointTarget != nil {
+			target = vpcTopologyString(endpointTarget.CRN)
+			if target == "" {
+				target = vpcTopologyString(endpointTarget.Name)
+			}
+		}
+		ips := make([]string, 0, len(endpointGateway.Ips))
+		for _, ip := range endpointGateway.Ips {
+			ips = append(ips, vpcTopologyString(ip.Address))
+		}
+		endpointList = append(endpointList, map[string]interface{}{
+			"id":              vpcTopologyString(endpointGateway.ID),
+			"name":            vpcTopologyString(endpointGateway.Name),
+			"target":          target,
+			"ips":             ips,
+			"security_groups": vpcTopologySecurityGroupIDs(endpointGateway.SecurityGroups),
+		})
+		graph.addNode(*endpointGateway.ID, "endpoint_gateway", endpointGateway.Name)
+		graph.addEdge(vpcID, *endpointGateway.ID, "contains")
+	}
+
+	// load balancers have no VPC filter, so they are matched by subnet
+	lbPager, err := sess.NewLoadBalancersPager(&vpcv1.ListLoadBalancersOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	lbs, err := lbPager.GetAllWithContext(context)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing load balancers: %s", err))
+	}
+	lbList := make([]map[string]interface{}, 0)
+	for _, lb := range lbs {
+		subnetIDs := make([]string, 0, len(lb.Subnets))
+		inVPC := false
+		for _, subnet := range lb.Subnets {
+			subnetIDs = append(subnetIDs, *subnet.ID)
+			inVPC = inVPC || subnetSet[*subnet.ID]
+		}
+		if !inVPC {
+			continue
+		}
+		lbList = append(lbList, map[string]interface{}{
+			"id":              vpcTopologyString(lb.ID),
+			"name":            vpcTopologyString(lb.Name),
+			"hostname":        vpcTopologyString(lb.Hostname),
+			"is_public":       lb.IsPublic != nil && *lb.IsPublic,
+			"subnets":         subnetIDs,
+			"security_groups": vpcTopologySecurityGroupIDs(lb.SecurityGroups),
+		})
+		graph.addNode(*lb.ID, "load_balancer", lb.Name)
+		for _, subnetID := range subnetIDs {
+			graph.addEdge(*lb.ID, subnetID, "attached_to")
+		}
+	}
+
+	// instances; interface and attachment identifiers are mapped back to their
+	// instance so security group targets and virtual network interfaces can be
+	// drawn against the instance node
+	instancePager, err := sess.NewInstancesPager(&vpcv1.ListInstancesOptions{VPCID: &vpcID})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instances, err := instancePager.GetAllWithContext(context)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing instances of VPC (%s): %s", vpcID, err))
+	}
+	interfaceOwners := map[string]string{}
+	instanceList := make([]map[string]interface{}, 0, len(instances))
+	for _, instance := range instances {
+		graph.addNode(*instance.ID, "instance", instance.Name)
+		nics := make([]map[string]interface{}, 0, len(instance.NetworkInterfaces))
+		for _, nic := range instance.NetworkInterfaces {
+			nics = append(nics, vpcTopologyInterfaceToMap(nic.ID, nic.Name, nic.Subnet, nic.PrimaryIP))
+			interfaceOwners[*nic.ID] = *instance.ID
+			if nic.Subnet != nil {
+				graph.addEdge(*instance.ID, *nic.Subnet.ID, "network_interface")
+			}
+		}
+		attachments := make([]map[string]interface{}, 0, len(instance.NetworkAttachments))
+		for _, attachment := range instance.NetworkAttachments {
+			attachments = append(attachments, vpcTopologyInterfaceToMap(attachment.ID, attachment.Name, attachment.Subnet, attachment.PrimaryIP))
+			interfaceOwners[*attachment.ID] = *instance.ID
+			if attachment.Subnet != nil {
+				graph.addEdge(*instance.ID, *attachment.Subnet.ID, "network_attachment")
+			}
+		}
+		instanceList = append(instanceList, map[string]interface{}{
+			"id":                  vpcTopologyString(instance.ID),
+			"name":                vpcTopologyString(instance.Name),
+			"zone":                vpcTopologyZone(instance.Zone),
+			"network_interfaces":  nics,
+			"network_attachments": attachments,
+		})
+	}
+
+	// virtual network interfaces have no VPC filter, so they are filtered here
+	vniPager, err := sess.NewVirtualNetworkInterfacesPager(&vpcv1.ListVirtualNetworkInterfacesOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	vnis, err := vniPager.GetAllWithContext(context)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing virtual network interfaces: %s", err))
+	}
+	vniList := make([]map[string]interface{}, 0)
+	for _, vni := range vnis {
+		if vni.VPC == nil || *vni.VPC.ID != vpcID {
+			continue
+		}
+		vniMap := map[string]interface{}{
+			"id":              vpcTopologyString(vni.ID),
+			"name":            vpcTopologyString(vni.Name),
+			"security_groups": vpcTopologySecurityGroupIDs(vni.SecurityGroups),
+		}
+		graph.addNode(*vni.ID, "virtual_network_interface", vni.Name)
+		if vni.Subnet != nil {
+			vniMap["subnet"] = *vni.Subnet.ID
+			graph.addEdge(*vni.ID, *vni.Subnet.ID, "attached_to")
+		}
+		if vni.PrimaryIP != nil {
+			vniMap["primary_ip"] = vpcTopologyString(vni.PrimaryIP.Address)
+		}
+		if vniTarget, ok := vni.Target.(*vpcv1.VirtualNetworkInterfaceTarget); ok && vniTarget != nil && vniTarget.ID != nil {
+			vniMap["target"] = *vniTarget.ID
+			if owner, ok := interfaceOwners[*vniTarget.ID]; ok {
+				graph.addEdge(*vni.ID, owner, "bound_to")
+			}
+		}
+		vniList = append(vniList, vniMap)
+	}
+
+	// security groups
+	sgPager, err := sess.NewSecurityGroupsPager(&vpcv1.ListSecurityGroupsOptions{VPCID: &vpcID})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	securityGroups, err := sgPager.GetAllWithContext(context)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing security groups of VPC (%s): %s", vpcID, err))
+	}
+	sgList := make([]map[string]interface{}, 0, len(securityGroups))
+	for _, securityGroup := range securityGroups {
+		graph.addNode(*securityGroup.ID, "security_group", securityGroup.Name)
+		graph.addEdge(vpcID, *securityGroup.ID, "contains")
+		targets := make([]string, 0, len(securityGroup.Targets))
+		for _, targetIntf := range securityGroup.Targets {
+			target, ok := targetIntf.(*vpcv1.SecurityGroupTargetReference)
+			if !ok || target.ID == nil {
+				continue
+			}
+			targets = append(targets, *target.ID)
+			if owner, ok := interfaceOwners[*target.ID]; ok {
+				graph.addEdge(*securityGroup.ID, owner, "protects")
+			} else {
+				graph.addEdge(*securityGroup.ID, *target.ID, "protects")
+			}
+		}
+		sgList = append(sgList, map[string]interface{}{
+			"id":      vpcTopologyString(securityGroup.ID),
+			"name":    vpcTopologyString(securityGroup.Name),
+			"targets": targets,
+		})
+	}
+
+	d.SetId(vpcID)
+	for key, value := range map[string]interface{}{
+		"subnets":                    subnetList,
+		"address_prefixes":           prefixList,
+		"routing_tables":             tableList,
+		"public_gateways":            gatewayList,
+		"vpn_gateways":               vpnList,
+		"endpoint_gateways":          endpointList,
+		"load_balancers":             lbList,
+		"instances":                  instanceList,
+		"virtual_network_interfaces": vniList,
+		"security_groups":            sgList,
+		"network_acls":               aclList,
+	} {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting %s: %s", key, err))
+		}
+	}
+
+	graphJSON, err := graph.json()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error rendering topology of VPC (%s): %s", vpcID, err))
+	}
+	d.Set("graph_json", graphJSON)
+	d.Set("graph_dot", graph.dot())
+	return nil
+}
+
+func vpcTopologyString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func vpcTopologyZone(zone *vpcv1.ZoneReference) string {
+	if zone == nil {
+		return ""
+	}
+	return vpcTopologyString(zone.Name)
+}
+
+func vpcTopologySecurityGroupIDs(securityGroups []vpcv1.SecurityGroupReference) []string {
+	ids := make([]string, 0, len(securityGroups))
+	for _, securityGroup := range securityGroups {
+		ids = append(ids, *securityGroup.ID)
+	}
+	return ids
+}
+
+func vpcTopologyInterfaceToMap(id, name *string, subnet *vpcv1.SubnetReference, primaryIP *vpcv1.ReservedIPReference) map[string]interface{} {
+	interfaceMap := map[string]interface{}{
+		"id":   vpcTopologyString(id),
+		"name": vpcTopologyString(name),
+	}
+	if subnet != nil {
+		interfaceMap["subnet"] = *subnet.ID
+	}
+	if primaryIP != nil {
+		interfaceMap["primary_ip"] = vpcTopologyString(primaryIP.Address)
+	}
+	return interfaceMap
+}
+
+type vpcTopologyNode struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+}
+
+type vpcTopologyEdge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Relation string `json:"relation"`
+}
+
+// vpcTopologyGraph keeps nodes and edges in the order they were discovered so
+// the rendered output is stable between reads.
+type vpcTopologyGraph struct {
+	Nodes []vpcTopologyNode `json:"nodes"`
+	Edges []vpcTopologyEdge `json:"edges"`
+	nodes map[string]bool
+	edges map[vpcTopologyEdge]bool
+}
+
+func newVPCTopologyGraph() *vpcTopologyGraph {
+	return &vpcTopologyGraph{
+		Nodes: []vpcTopologyNode{},
+		Edges: []vpcTopologyEdge{},
+		nodes: map[string]bool{},
+		edges: map[vpcTopologyEdge]bool{},
+	}
+}
+
+func (g *vpcTopologyGraph) addNode(id, nodeType string, name *string) {
+	if g.nodes[id] {
+		return
+	}
+	g.nodes[id] = true
+	g.Nodes = append(g.Nodes, vpcTopologyNode{ID: id, Type: nodeType, Name: vpcTopologyString(name)})
+}
+
+func (g *vpcTopologyGraph) addEdge(from, to, relation string) {
+	edge := vpcTopologyEdge{From: from, To: to, Relation: relation}
+	if g.edges[edge] {
+		return
+	}
+	g.edges[edge] = true
+	g.Edges = append(g.Edges, edge)
+}
+
+// prune drops edges to resources outside the VPC topology, such as security
+// group targets on bare metal servers or VPN servers.
+func (g *vpcTopologyGraph) prune() {
+	edges := make([]vpcTopologyEdge, 0, len(g.Edges))
+	for _, edge := range g.Edges {
+		if g.nodes[edge.From] && g.nodes[edge.To] {
+			edges = append(edges, edge)
+		}
+	}
+	g.Edges = edges
+}
+
+func (g *vpcTopologyGraph) json() (string, error) {
+	g.prune()
+	graphJSON, err := json.Marshal(g)
+	if err != nil {
+		return "", err
+	}
+	return string(graphJSON), nil
+}
+
+func (g *vpcTopologyGraph) dot() string {
+	g.prune()
+	var b strings.Builder
+	b.WriteString("digraph vpc {\n")
+	for _, node := range g.Nodes {
+		label := node.Type
+		if node.Name != "" {
+			label = fmt.Sprintf("%s\n%s", node.Type, node.Name)
+		}
+		fmt.Fprintf(&b, "  %q [label=%q, shape=%s];\n", node.ID, label, vpcTopologyNodeShape(node.Type))
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&b, "  %q -> %q [label=%q];\n", edge.From, edge.To, edge.Relation)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func vpcTopologyNodeShape(nodeType string) string {
+	switch nodeType {
+	case "vpc":
+		return "doubleoctagon"
+	case "subnet", "address_prefix":
+		return "box"
+	case "security_group", "network_acl":
+		return "octagon"
+	case "routing_table":
+		return "folder"
+	case "instance", "virtual_network_interface":
+		return "component"
+	default:
+		return "ellipse"
+	}
+}
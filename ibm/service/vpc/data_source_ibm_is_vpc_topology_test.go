@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISVPCTopologyDataSource_basic(t *testing.T) {
+	node := "data.ibm_is_vpc_topology.test"
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	pgwname := fmt.Sprintf("tf-pgw-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCTopologyDataSourceConfig(vpcname, subnetname, pgwname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(node, "id", "ibm_is_vpc.testacc_vpc", "id"),
+					resource.TestCheckResourceAttr(node, "subnets.#", "1"),
+					resource.TestCheckResourceAttrPair(node, "subnets.0.id", "ibm_is_subnet.testacc_subnet", "id"),
+					resource.TestCheckResourceAttrPair(node, "subnets.0.public_gateway", "ibm_is_public_gateway.testacc_pgw", "id"),
+					resource.TestCheckResourceAttr(node, "public_gateways.#", "1"),
+					resource.TestCheckResourceAttrSet(node, "address_prefixes.#"),
+					resource.TestCheckResourceAttrSet(node, "routing_tables.0.routes.#"),
+					resource.TestCheckResourceAttrSet(node, "security_groups.0.id"),
+					resource.TestCheckResourceAttrSet(node, "network_acls.0.id"),
+					resource.TestMatchResourceAttr(node, "graph_json", regexp.MustCompile(`"relation":"public_gateway"`)),
+					resource.TestMatchResourceAttr(node, "graph_dot", regexp.MustCompile(`^digraph vpc \{`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPCTopologyDataSourceConfig(vpcname, subnetname, pgwname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_public_gateway" "testacc_pgw" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name                     = "%s"
+		vpc                      = ibm_is_vpc.testacc_vpc.id
+		zone                     = "%s"
+		total_ipv4_address_count = 16
+		public_gateway           = ibm_is_public_gateway.testacc_pgw.id
+	}
+
+	data "ibm_is_vpc_topology" "test" {
+		vpc        = ibm_is_vpc.testacc_vpc.id
+		depends_on = [ibm_is_subnet.testacc_subnet]
+	}
+	`, vpcname, pgwname, acc.ISZoneName, subnetname, acc.ISZoneName)
+}
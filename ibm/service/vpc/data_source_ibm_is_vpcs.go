@@ -7,7 +7,6 @@ import (
 	//"encoding/json"
 
 	"context"
-	"fmt"
 	"log"
 	"reflect"
 	"time"
@@ -618,7 +617,7 @@ func dataSourceIBMISVPCListRead(context context.Context, d *schema.ResourceData,
 			}
 			s, response, err := sess.ListSubnetsWithContext(context, options)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error fetching subnets %s\n%s", err, response))
+				return flex.NewServiceError("Error fetching subnets", err, response).Diagnostics()
 			}
 			startSub = flex.GetNext(s.Next)
 			allrecsSub = append(allrecsSub, s.Subnets...)
@@ -659,7 +658,7 @@ func dataSourceIBMISVPCListRead(context context.Context, d *schema.ResourceData,
 			}
 			sgs, response, err := sess.ListSecurityGroupsWithContext(context, listSgOptions)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error fetching Security Groups %s\n%s", err, response))
+				return flex.NewServiceError("Error fetching Security Groups", err, response).Diagnostics()
 			}
 			if *sgs.TotalCount == int64(0) {
 				break
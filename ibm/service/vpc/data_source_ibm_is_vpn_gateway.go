@@ -345,7 +345,7 @@ func dataSourceIBMIsVPNGatewayRead(context context.Context, d *schema.ResourceDa
 		vpnGatewayIntf, response, err := vpcClient.GetVPNGatewayWithContext(context, getVPNGatewayOptions)
 		if err != nil || vpnGatewayIntf.(*vpcv1.VPNGateway) == nil {
 			log.Printf("[DEBUG] GetVPNGatewayWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetVPNGatewayWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("GetVPNGatewayWithContext failed", err, response).Diagnostics()
 		}
 		vpnGateway = vpnGatewayIntf.(*vpcv1.VPNGateway)
 	} else {
@@ -359,7 +359,7 @@ func dataSourceIBMIsVPNGatewayRead(context context.Context, d *schema.ResourceDa
 			}
 			availableVPNGateways, detail, err := vpcClient.ListVPNGatewaysWithContext(context, listvpnGWOptions)
 			if err != nil || availableVPNGateways == nil {
-				return diag.FromErr(fmt.Errorf("Error reading list of VPN Gateways:%s\n%s", err, detail))
+				return flex.NewServiceError("Error reading list of VPN Gateways", err, detail).Diagnostics()
 			}
 			start = flex.GetNext(availableVPNGateways.Next)
 			allrecs = append(allrecs, availableVPNGateways.VPNGateways...)
@@ -296,7 +296,7 @@ func dataSourceIBMIsVPNGatewayConnectionRead(context context.Context, d *schema.
 			}
 			availableVPNGateways, detail, err := vpcClient.ListVPNGatewaysWithContext(context, listvpnGWOptions)
 			if err != nil || availableVPNGateways == nil {
-				return diag.FromErr(fmt.Errorf("Error reading list of VPN Gateways:%s\n%s", err, detail))
+				return flex.NewServiceError("Error reading list of VPN Gateways", err, detail).Diagnostics()
 			}
 			start = flex.GetNext(availableVPNGateways.Next)
 			allrecs = append(allrecs, availableVPNGateways.VPNGateways...)
@@ -324,7 +324,7 @@ func dataSourceIBMIsVPNGatewayConnectionRead(context context.Context, d *schema.
 
 		availableVPNGatewayConnections, detail, err := vpcClient.ListVPNGatewayConnections(listvpnGWConnectionOptions)
 		if err != nil || availableVPNGatewayConnections == nil {
-			return diag.FromErr(fmt.Errorf("Error reading list of VPN Gateway Connections:%s\n%s", err, detail))
+			return flex.NewServiceError("Error reading list of VPN Gateway Connections", err, detail).Diagnostics()
 		}
 
 		vpn_gateway_conn_found := false
@@ -348,7 +348,7 @@ func dataSourceIBMIsVPNGatewayConnectionRead(context context.Context, d *schema.
 		vpnGatewayConnectionIntf, response, err := vpcClient.GetVPNGatewayConnectionWithContext(context, getVPNGatewayConnectionOptions)
 		if err != nil || vpnGatewayConnectionIntf.(*vpcv1.VPNGatewayConnection) == nil {
 			log.Printf("[DEBUG] GetVPNGatewayConnectionWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetVPNGatewayConnectionWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("GetVPNGatewayConnectionWithContext failed", err, response).Diagnostics()
 		}
 		vpnGatewayConnection = vpnGatewayConnectionIntf.(*vpcv1.VPNGatewayConnection)
 	}
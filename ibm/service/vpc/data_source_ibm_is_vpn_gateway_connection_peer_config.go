@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMISVPNGatewayConnectionPeerConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVPNGatewayConnectionPeerConfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpn_gateway": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPN gateway identifier.",
+			},
+			"vpn_gateway_connection": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPN gateway connection identifier.",
+			},
+			"include_psk": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true, the preshared key of the connection is rendered in the configurations, otherwise the placeholder REPLACE_WITH_PSK is rendered.",
+			},
+			"mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The mode of the VPN gateway, policy or route.",
+			},
+			"ike_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The IKE protocol version.",
+			},
+			"peer_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IP address of the peer VPN gateway.",
+			},
+			"gateway_public_ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The public IP addresses of the VPN gateway the peer connects to.",
+			},
+			"local_cidrs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The VPC CIDRs of the connection, the remote CIDRs of the peer.",
+			},
+			"peer_cidrs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The on-premises CIDRs of the connection, the local CIDRs of the peer.",
+			},
+			"ike_proposal": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IKE proposal in strongSwan syntax. Empty if the connection uses auto-negotiation.",
+			},
+			"esp_proposal": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ESP proposal in strongSwan syntax. Empty if the connection uses auto-negotiation.",
+			},
+			"strongswan_swanctl_conf": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The peer configuration in strongSwan swanctl.conf format, including the secrets section.",
+			},
+			"strongswan_ipsec_conf": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The peer configuration in strongSwan ipsec.conf format.",
+			},
+			"libreswan_conf": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The peer configuration in libreswan ipsec.conf format.",
+			},
+			"ipsec_secrets": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The ipsec.secrets entries for the strongSwan ipsec.conf and libreswan configurations.",
+			},
+			"json_config": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The peer configuration as vendor-neutral JSON.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsVPNGatewayConnectionPeerConfigRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	gatewayID := d.Get("vpn_gateway").(string)
+	connectionID := d.Get("vpn_gateway_connection").(string)
+
+	vpnGatewayIntf, response, err := sess.GetVPNGatewayWithContext(context, &vpcv1.GetVPNGatewayOptions{ID: &gatewayID})
+	if err != nil {
+		return flex.NewServiceError("Error getting VPN Gateway", err, response).Diagnostics()
+	}
+	vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
+
+	connectionIntf, response, err := sess.GetVPNGatewayConnectionWithContext(context, &vpcv1.GetVPNGatewayConnectionOptions{VPNGatewayID: &gatewayID, ID: &connectionID})
+	if err != nil {
+		return flex.NewServiceError("Error getting VPN Gateway Connection", err, response).Diagnostics()
+	}
+	connection := connectionIntf.(*vpcv1.VPNGatewayConnection)
+
+	config := vpnPeerConfig{
+		Name:            *connection.Name,
+		Mode:            *connection.Mode,
+		IkeVersion:      2,
+		LocalAddress:    *connection.PeerAddress,
+		LocalCIDRs:      connection.PeerCIDRs,
+		RemoteAddresses: []string{},
+		RemoteCIDRs:     connection.LocalCIDRs,
+		PSK:             vpnPeerConfigPSKPlaceholder,
+	}
+	if config.LocalCIDRs == nil {
+		config.LocalCIDRs = []string{}
+	}
+	if config.RemoteCIDRs == nil {
+		config.RemoteCIDRs = []string{}
+	}
+	if d.Get("include_psk").(bool) && connection.Psk != nil {
+		config.PSK = *connection.Psk
+	}
+
+	// route mode connections report one tunnel per gateway member, policy
+	// mode connections are reached on the public IPs of the members
+	if len(connection.Tunnels) > 0 {
+		for _, tunnel := range connection.Tunnels {
+			if tunnel.PublicIP != nil && tunnel.PublicIP.Address != nil {
+				config.RemoteAddresses = append(config.RemoteAddresses, *tunnel.PublicIP.Address)
+			}
+		}
+	} else {
+		for _, member := range vpnGateway.Members {
+			if member.PublicIP != nil && member.PublicIP.Address != nil && *member.PublicIP.Address != "0.0.0.0" {
+				config.RemoteAddresses = append(config.RemoteAddresses, *member.PublicIP.Address)
+			}
+		}
+	}
+
+	if connection.DeadPeerDetection != nil {
+		config.DeadPeerDetection = &vpnPeerDeadPeerDetection{
+			Action:   *connection.DeadPeerDetection.Action,
+			Interval: flex.IntValue(connection.DeadPeerDetection.Interval),
+			Timeout:  flex.IntValue(connection.DeadPeerDetection.Timeout),
+		}
+	}
+
+	if connection.IkePolicy != nil {
+		ikePolicy, response, err := sess.GetIkePolicyWithContext(context, &vpcv1.GetIkePolicyOptions{ID: connection.IkePolicy.ID})
+		if err != nil {
+			return flex.NewServiceError("Error getting IKE Policy", err, response).Diagnostics()
+		}
+		config.IkeVersion = flex.IntValue(ikePolicy.IkeVersion)
+		config.IKE = &vpnPeerIKEProposal{
+			EncryptionAlgorithm:     *ikePolicy.EncryptionAlgorithm,
+			AuthenticationAlgorithm: *ikePolicy.AuthenticationAlgorithm,
+			DhGroup:                 flex.IntValue(ikePolicy.DhGroup),
+			KeyLifetime:             flex.IntValue(ikePolicy.KeyLifetime),
+			NegotiationMode:         *ikePolicy.NegotiationMode,
+		}
+	}
+	if connection.IpsecPolicy != nil {
+		ipsecPolicy, response, err := sess.GetIpsecPolicyWithContext(context, &vpcv1.GetIpsecPolicyOptions{ID: connection.IpsecPolicy.ID})
+		if err != nil {
+			return flex.NewServiceError("Error getting IPSEC Policy", err, response).Diagnostics()
+		}
+		config.IPsec = &vpnPeerIPsecProposal{
+			EncryptionAlgorithm:     *ipsecPolicy.EncryptionAlgorithm,
+			AuthenticationAlgorithm: *ipsecPolicy.AuthenticationAlgorithm,
+			Pfs:                     *ipsecPolicy.Pfs,
+			KeyLifetime:             flex.IntValue(ipsecPolicy.KeyLifetime),
+			TransformProtocol:       *ipsecPolicy.TransformProtocol,
+			EncapsulationMode:       *ipsecPolicy.EncapsulationMode,
+		}
+	}
+
+	jsonConfig, err := config.json()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error rendering peer configuration of VPN gateway connection (%s): %s", connectionID, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", gatewayID, connectionID))
+	d.Set("mode", config.Mode)
+	d.Set("ike_version", config.IkeVersion)
+	d.Set("peer_address", config.LocalAddress)
+	d.Set("gateway_public_ips", config.RemoteAddresses)
+	d.Set("local_cidrs", config.RemoteCIDRs)
+	d.Set("peer_cidrs", config.LocalCIDRs)
+	d.Set("ike_proposal", config.strongswanIKEProposal())
+	d.Set("esp_proposal", config.strongswanESPProposal())
+	d.Set("strongswan_swanctl_conf", config.strongswanSwanctlConf())
+	d.Set("strongswan_ipsec_conf", config.ipsecConf(false))
+	d.Set("libreswan_conf", config.ipsecConf(true))
+	d.Set("ipsec_secrets", config.ipsecSecrets())
+	d.Set("json_config", jsonConfig)
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsVPNGatewayConnectionPeerConfigDataSourceBasic(t *testing.T) {
+	node := "data.ibm_is_vpn_gateway_connection_peer_config.example"
+	vpcname := fmt.Sprintf("tfvpnuat-vpc-%d", acctest.RandIntRange(100, 200))
+	subnetname := fmt.Sprintf("tfvpnuat-subnet-%d", acctest.RandIntRange(100, 200))
+	vpngwname := fmt.Sprintf("tfvpnuat-vpngw-%d", acctest.RandIntRange(100, 200))
+	ikename := fmt.Sprintf("tfvpnuat-ike-%d", acctest.RandIntRange(100, 200))
+	ipsecname := fmt.Sprintf("tfvpnuat-ipsec-%d", acctest.RandIntRange(100, 200))
+	name := fmt.Sprintf("tfvpnuat-createname-%d", acctest.RandIntRange(100, 200))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVPNGatewayConnectionPeerConfigDataSourceConfig(vpcname, subnetname, vpngwname, ikename, ipsecname, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "mode", "policy"),
+					resource.TestCheckResourceAttr(node, "ike_version", "2"),
+					resource.TestCheckResourceAttr(node, "peer_address", "1.2.3.4"),
+					resource.TestCheckResourceAttr(node, "peer_cidrs.0", "192.168.0.0/16"),
+					resource.TestCheckResourceAttr(node, "local_cidrs.0", acc.ISCIDR),
+					resource.TestCheckResourceAttrSet(node, "gateway_public_ips.0"),
+					resource.TestCheckResourceAttr(node, "ike_proposal", "aes256-sha384-modp2048"),
+					resource.TestCheckResourceAttr(node, "esp_proposal", "aes256-sha256-modp2048"),
+					resource.TestMatchResourceAttr(node, "strongswan_ipsec_conf", regexp.MustCompile(`leftsubnet=192\.168\.0\.0/16`)),
+					resource.TestMatchResourceAttr(node, "libreswan_conf", regexp.MustCompile(`ike=aes256-sha2_384-modp2048`)),
+					resource.TestMatchResourceAttr(node, "ipsec_secrets", regexp.MustCompile(`PSK "REPLACE_WITH_PSK"`)),
+					resource.TestMatchResourceAttr(node, "strongswan_swanctl_conf", regexp.MustCompile(`esp_proposals = aes256-sha256-modp2048`)),
+					resource.TestMatchResourceAttr(node, "json_config", regexp.MustCompile(`"dh_group": 14`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsVPNGatewayConnectionPeerConfigDataSourceConfig(vpc, subnet, vpngwname, ikename, ipsecname, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "example" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "example" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.example.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_vpn_gateway" "example" {
+		name   = "%s"
+		subnet = ibm_is_subnet.example.id
+		mode   = "policy"
+	}
+
+	resource "ibm_is_ike_policy" "example" {
+		name                     = "%s"
+		authentication_algorithm = "sha384"
+		encryption_algorithm     = "aes256"
+		dh_group                 = 14
+		ike_version              = 2
+	}
+
+	resource "ibm_is_ipsec_policy" "example" {
+		name                     = "%s"
+		authentication_algorithm = "sha256"
+		encryption_algorithm     = "aes256"
+		pfs                      = "group_14"
+	}
+
+	resource "ibm_is_vpn_gateway_connection" "example" {
+		name          = "%s"
+		vpn_gateway   = ibm_is_vpn_gateway.example.id
+		peer_address  = "1.2.3.4"
+		local_cidrs   = [ibm_is_subnet.example.ipv4_cidr_block]
+		peer_cidrs    = ["192.168.0.0/16"]
+		preshared_key = "VPNDemoPassword"
+		ike_policy    = ibm_is_ike_policy.example.id
+		ipsec_policy  = ibm_is_ipsec_policy.example.id
+	}
+
+	data "ibm_is_vpn_gateway_connection_peer_config" "example" {
+		vpn_gateway            = ibm_is_vpn_gateway.example.id
+		vpn_gateway_connection = ibm_is_vpn_gateway_connection.example.gateway_connection
+	}
+	`, vpc, subnet, acc.ISZoneName, acc.ISCIDR, vpngwname, ikename, ipsecname, name)
+}
@@ -441,7 +441,7 @@ func dataSourceIBMIsVPNServerRead(context context.Context, d *schema.ResourceDat
 		vpnServerInfo, response, err := sess.GetVPNServerWithContext(context, getVPNServerOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetVPNServerWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("GetVPNServerWithContext failed", err, response).Diagnostics()
 		}
 		vpnServer = vpnServerInfo
 	} else if v, ok := d.GetOk("name"); ok {
@@ -458,7 +458,7 @@ func dataSourceIBMIsVPNServerRead(context context.Context, d *schema.ResourceDat
 			vpnServerCollection, response, err := sess.ListVPNServersWithContext(context, listVPNServersOptions)
 			if err != nil {
 				log.Printf("[DEBUG] ListVPNServersWithContext failed %s\n%s", err, response)
-				return diag.FromErr(fmt.Errorf("[ERROR] ListVPNServersWithContext failed %s\n%s", err, response))
+				return flex.NewServiceError("ListVPNServersWithContext failed", err, response).Diagnostics()
 			}
 			start = flex.GetNext(vpnServerCollection.Next)
 			allrecs = append(allrecs, vpnServerCollection.VPNServers...)
@@ -130,7 +130,7 @@ func dataSourceIBMIsVPNServerClientsRead(context context.Context, d *schema.Reso
 		vpnServerClientCollection, response, err := sess.ListVPNServerClientsWithContext(context, listVPNServerClientsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListVPNServerClientsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] ListVPNServerClientsWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ListVPNServerClientsWithContext failed", err, response).Diagnostics()
 		}
 		start = flex.GetNext(vpnServerClientCollection.Next)
 		allrecs = append(allrecs, vpnServerClientCollection.Clients...)
@@ -459,7 +459,7 @@ func dataSourceIBMIsVPNServersRead(context context.Context, d *schema.ResourceDa
 		vpnServerCollection, response, err := sess.ListVPNServersWithContext(context, listVPNServersOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListVPNServersWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] ListVPNServersWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("ListVPNServersWithContext failed", err, response).Diagnostics()
 		}
 		start = flex.GetNext(vpnServerCollection.Next)
 		allrecs = append(allrecs, vpnServerCollection.VPNServers...)
@@ -0,0 +1,765 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// instanceReplacementKeys are the arguments of ibm_is_instance that cannot be
+// updated in place. They force a new resource, unless a replacement_strategy
+// is configured and the instance is replaced by instanceReplace instead.
+var instanceReplacementKeys = []string{
+	isInstanceImage,
+	isInstanceZone,
+	isInstanceVPC,
+	"boot_volume.0.volume_id",
+	"boot_volume.0.snapshot",
+	"primary_network_interface.0.subnet",
+}
+
+// instanceReplacementNameSuffix is appended to the name of the new instance
+// until the old instance is deleted, as names are unique within a VPC
+const instanceReplacementNameSuffix = "-replacement"
+
+func instanceReplacementCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	// the deletion of the instance replaced by a previous replacement is
+	// retried by the next update
+	if diff.Get(isInstanceReplacedInstance).(string) != "" {
+		if err := diff.SetNewComputed(isInstanceReplacedInstance); err != nil {
+			return err
+		}
+	}
+	if _, replace := diff.GetOk(isInstanceReplacementStrategy); replace {
+		return nil
+	}
+	for _, key := range instanceReplacementKeys {
+		if !diff.HasChange(key) {
+			continue
+		}
+		if err := diff.ForceNew(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// instanceReplacementFixedPrimaryIP returns the reserved IP and the address
+// that the configuration sets as the primary IP of the primary network
+// interface, if any.
+func instanceReplacementFixedPrimaryIP(config cty.Value) (reservedIP, address string) {
+	if config.IsNull() || !config.IsKnown() {
+		return "", ""
+	}
+	nics := config.GetAttr(isInstancePrimaryNetworkInterface)
+	if nics.IsNull() || !nics.IsKnown() || nics.LengthInt() == 0 {
+		return "", ""
+	}
+	nic := nics.Index(cty.NumberIntVal(0))
+	if v := nic.GetAttr(isInstanceNicPrimaryIpv4Address); !v.IsNull() && v.IsKnown() {
+		address = v.AsString()
+	}
+	ips := nic.GetAttr(isInstanceNicPrimaryIP)
+	if ips.IsNull() || !ips.IsKnown() || ips.LengthInt() == 0 {
+		return "", address
+	}
+	ip := ips.Index(cty.NumberIntVal(0))
+	if v := ip.GetAttr(isInstanceNicReservedIpId); !v.IsNull() && v.IsKnown() {
+		reservedIP = v.AsString()
+	}
+	if v := ip.GetAttr(isInstanceNicReservedIpAddress); !v.IsNull() && v.IsKnown() {
+		address = v.AsString()
+	}
+	return reservedIP, address
+}
+
+func instanceReplacementRequired(d *schema.ResourceData) bool {
+	if d.IsNewResource() {
+		return false
+	}
+	if _, ok := d.GetOk(isInstanceReplacementStrategy); !ok {
+		return false
+	}
+	return d.HasChanges(instanceReplacementKeys...)
+}
+
+func instanceReplacementName(name string) string {
+	if len(name)+len(instanceReplacementNameSuffix) > 63 {
+		name = strings.TrimSuffix(name[:63-len(instanceReplacementNameSuffix)], "-")
+	}
+	return name + instanceReplacementNameSuffix
+}
+
+// instanceReplacementMember is a load balancer pool member targeting the old
+// instance, by id or by the address of its primary network interface
+type instanceReplacementMember struct {
+	lbID          string
+	poolID        string
+	memberID      string
+	port          int64
+	weight        *int64
+	byAddress     bool
+	replacementID string
+}
+
+// instanceReplacementVolume is a data volume moved from the old instance
+type instanceReplacementVolume struct {
+	volumeID       string
+	attachmentID   string
+	deleteOnDelete bool
+}
+
+// instanceReplacementPrimaryIP is the primary reserved IP of the old instance,
+// moved to the new instance when the configuration fixes the primary IP
+type instanceReplacementPrimaryIP struct {
+	id         string
+	subnetID   string
+	address    string
+	autoDelete bool
+}
+
+// instanceReplacement holds the state of a rolling replacement, so that the
+// resources moved to the new instance can be moved back on failure
+type instanceReplacement struct {
+	sess        *vpcv1.VpcV1
+	d           *schema.ResourceData
+	oldID       string
+	oldNicID    string
+	oldAddress  string
+	newID       string
+	newNicID    string
+	newAddress  string
+	primaryIP   *instanceReplacementPrimaryIP
+	floatingIPs []vpcv1.FloatingIP
+	members     []*instanceReplacementMember
+	volumes     []*instanceReplacementVolume
+	// undo holds a step per change made so far, run in reverse order by
+	// rollback
+	undo []func() error
+	// oldDeleted is set once the old instance is deleted, after which the
+	// replacement can no longer be rolled back
+	oldDeleted bool
+}
+
+// onRollback registers the step undoing the last change
+func (r *instanceReplacement) onRollback(step func() error) {
+	r.undo = append(r.undo, step)
+}
+
+// instanceReplace replaces the instance by creating a new instance with a
+// temporary name, moving the load balancer pool members, data volumes and
+// floating IPs of the old instance to it once it is healthy, deleting the old
+// instance and renaming the new one. If a step fails before the old instance
+// is deleted, the changes are undone in reverse order and the new instance is
+// deleted.
+func instanceReplace(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	strategy := d.Get(isInstanceReplacementStrategy).([]interface{})[0].(map[string]interface{})
+	name := d.Get(isInstanceName).(string)
+	zone := d.Get(isInstanceZone).(string)
+	r := &instanceReplacement{
+		sess:  sess,
+		d:     d,
+		oldID: d.Id(),
+	}
+
+	instance, response, err := sess.GetInstance(&vpcv1.GetInstanceOptions{ID: &r.oldID})
+	if err != nil {
+		return flex.NewServiceError("Error Getting Instance", err, response)
+	}
+	if instance.PrimaryNetworkInterface == nil {
+		return fmt.Errorf("[ERROR] Error replacing instance (%s): %s is only supported for instances with a %s", r.oldID, isInstanceReplacementStrategy, isInstancePrimaryNetworkInterface)
+	}
+	r.oldNicID = *instance.PrimaryNetworkInterface.ID
+	if instance.PrimaryNetworkInterface.PrimaryIP != nil && instance.PrimaryNetworkInterface.PrimaryIP.Address != nil {
+		r.oldAddress = *instance.PrimaryNetworkInterface.PrimaryIP.Address
+	}
+
+	// everything that has to move is looked up before any change, so that a
+	// replacement that cannot succeed fails without changes
+	if err = r.findPrimaryIP(instance); err != nil {
+		return err
+	}
+	if strategy[isInstanceReplacementMoveFloatingIPs].(bool) {
+		err = r.findFloatingIPs(zone)
+		if err != nil {
+			return err
+		}
+	}
+	if strategy[isInstanceReplacementMoveLBPoolMembers].(bool) {
+		err = r.findMembers()
+		if err != nil {
+			return err
+		}
+	}
+	err = r.findVolumes(*instance.Zone.Name, zone, strategy[isInstanceReplacementMoveVolumes].(bool))
+	if err != nil {
+		return err
+	}
+
+	if r.primaryIP != nil {
+		if err = r.releasePrimaryIP(); err != nil {
+			return r.rollback(err)
+		}
+		// the new instance is created with the released reserved IP, whether
+		// the configuration sets it by id or by address
+		nic := d.Get(isInstancePrimaryNetworkInterface).([]interface{})[0].(map[string]interface{})
+		nic[isInstanceNicPrimaryIpv4Address] = ""
+		nic[isInstanceNicPrimaryIP] = []interface{}{map[string]interface{}{isInstanceNicReservedIpId: r.primaryIP.id}}
+		if err = d.Set(isInstancePrimaryNetworkInterface, []interface{}{nic}); err != nil {
+			return r.rollback(fmt.Errorf("[ERROR] Error setting %s: %s", isInstancePrimaryNetworkInterface, err))
+		}
+	}
+
+	tempName := instanceReplacementName(name)
+	log.Printf("[INFO] Creating instance %s to replace instance %s", tempName, r.oldID)
+	err = instanceCreate(d, meta, tempName)
+	if d.Id() != r.oldID {
+		r.newID = d.Id()
+		r.onRollback(func() error {
+			return instanceReplacementDeleteInstance(r.sess, r.d, r.newID)
+		})
+	}
+	if err != nil {
+		return r.rollback(err)
+	}
+	_, err = isWaitForInstanceActionStart(sess, d.Timeout(schema.TimeoutUpdate), r.newID, d)
+	if err != nil {
+		return r.rollback(err)
+	}
+	newInstance, response, err := sess.GetInstance(&vpcv1.GetInstanceOptions{ID: &r.newID})
+	if err != nil {
+		return r.rollback(flex.NewServiceError("Error Getting Instance", err, response))
+	}
+	r.newNicID = *newInstance.PrimaryNetworkInterface.ID
+	r.newAddress = *newInstance.PrimaryNetworkInterface.PrimaryIP.Address
+
+	if err = r.addMembers(); err != nil {
+		return r.rollback(err)
+	}
+	healthCheckTimeout := time.Duration(strategy[isInstanceReplacementHealthCheckTimeout].(int)) * time.Second
+	if err = r.waitForMembersHealthy(healthCheckTimeout); err != nil {
+		return r.rollback(err)
+	}
+	// the data volumes can only be attached to one instance, so they are
+	// moved once the new instance is healthy, right before the cutover
+	if err = r.moveVolumes(); err != nil {
+		return r.rollback(err)
+	}
+	if err = r.moveFloatingIPs(); err != nil {
+		return r.rollback(err)
+	}
+	if err = r.moveMembers(); err != nil {
+		return r.rollback(err)
+	}
+
+	if !r.oldDeleted {
+		if err = r.deleteOld(); err != nil {
+			// the new instance has replaced the old one, which is kept in
+			// state until the next apply deletes it
+			d.Set(isInstanceReplacedInstance, r.oldID)
+			return fmt.Errorf("[ERROR] Error deleting instance (%s) replaced by instance (%s), the deletion is retried by the next apply: %s", r.oldID, r.newID, err)
+		}
+	}
+	if r.primaryIP != nil && r.primaryIP.autoDelete {
+		if err = r.setPrimaryIPAutoDelete(true); err != nil {
+			return err
+		}
+	}
+
+	instancePatch, err := (&vpcv1.InstancePatch{Name: &name}).AsPatch()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error calling asPatch for InstancePatch: %s", err)
+	}
+	_, response, err = sess.UpdateInstance(&vpcv1.UpdateInstanceOptions{
+		ID:            &r.newID,
+		InstancePatch: instancePatch,
+	})
+	if err != nil {
+		return flex.NewServiceError("Error renaming replacement Instance", err, response)
+	}
+	return nil
+}
+
+// instanceReplacementDeleteInstance deletes the instance and waits for it to be
+// deleted, an instance that is already deleted is ignored
+func instanceReplacementDeleteInstance(sess *vpcv1.VpcV1, d *schema.ResourceData, id string) error {
+	response, err := sess.DeleteInstance(&vpcv1.DeleteInstanceOptions{ID: &id})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return flex.NewServiceError(fmt.Sprintf("Error Deleting Instance (%s)", id), err, response)
+	}
+	_, err = isWaitForInstanceDelete(sess, d, id)
+	return err
+}
+
+// instanceReplacementDeleteReplaced deletes the instance replaced by a
+// previous replacement whose deletion failed, and keeps it in
+// replaced_instance until the deletion succeeds
+func instanceReplacementDeleteReplaced(d *schema.ResourceData, meta interface{}, id string) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Deleting instance %s replaced by instance %s", id, d.Id())
+	if err = instanceReplacementDeleteInstance(sess, d, id); err != nil {
+		d.Set(isInstanceReplacedInstance, id)
+		return fmt.Errorf("[ERROR] Error deleting instance (%s) replaced by instance (%s): %s", id, d.Id(), err)
+	}
+	d.Set(isInstanceReplacedInstance, "")
+	return nil
+}
+
+// deleteOld deletes the old instance. Once the deletion is accepted, nothing
+// can be moved back to it.
+func (r *instanceReplacement) deleteOld() error {
+	log.Printf("[INFO] Deleting instance %s replaced by instance %s", r.oldID, r.newID)
+	response, err := r.sess.DeleteInstance(&vpcv1.DeleteInstanceOptions{ID: &r.oldID})
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return flex.NewServiceError(fmt.Sprintf("Error Deleting Instance (%s)", r.oldID), err, response)
+	}
+	r.oldDeleted = true
+	_, err = isWaitForInstanceDelete(r.sess, r.d, r.oldID)
+	return err
+}
+
+// findPrimaryIP looks up the primary reserved IP of the old instance when the
+// configuration fixes the primary IP to it
+func (r *instanceReplacement) findPrimaryIP(instance *vpcv1.Instance) error {
+	nic := instance.PrimaryNetworkInterface
+	if nic.PrimaryIP == nil || nic.PrimaryIP.ID == nil || r.d.HasChange("primary_network_interface.0.subnet") {
+		return nil
+	}
+	reservedIP, address := instanceReplacementFixedPrimaryIP(r.d.GetRawConfig())
+	if reservedIP != *nic.PrimaryIP.ID && (address == "" || address != r.oldAddress) {
+		return nil
+	}
+	ip, response, err := r.sess.GetSubnetReservedIP(&vpcv1.GetSubnetReservedIPOptions{
+		SubnetID: nic.Subnet.ID,
+		ID:       nic.PrimaryIP.ID,
+	})
+	if err != nil {
+		return flex.NewServiceError("Error Getting Subnet Reserved IP", err, response)
+	}
+	r.primaryIP = &instanceReplacementPrimaryIP{
+		id:         *ip.ID,
+		subnetID:   *nic.Subnet.ID,
+		address:    *ip.Address,
+		autoDelete: ip.AutoDelete != nil && *ip.AutoDelete,
+	}
+	return nil
+}
+
+func (r *instanceReplacement) setPrimaryIPAutoDelete(autoDelete bool) error {
+	reservedIPPatch, err := (&vpcv1.ReservedIPPatch{AutoDelete: &autoDelete}).AsPatch()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error calling asPatch for ReservedIPPatch: %s", err)
+	}
+	_, response, err := r.sess.UpdateSubnetReservedIP(&vpcv1.UpdateSubnetReservedIPOptions{
+		SubnetID:        &r.primaryIP.subnetID,
+		ID:              &r.primaryIP.id,
+		ReservedIPPatch: reservedIPPatch,
+	})
+	if err != nil {
+		return flex.NewServiceError(fmt.Sprintf("Error Updating Subnet Reserved IP (%s)", r.primaryIP.id), err, response)
+	}
+	return nil
+}
+
+// releasePrimaryIP unbinds the primary reserved IP from the old instance, so
+// that the new instance can be created with it. The primary IP of a network
+// interface is bound when the interface is created, and a primary network
+// interface cannot be deleted, so the reserved IP is unbound by deleting the
+// old instance, with auto_delete unset to keep it. The data volumes are
+// detached first, so that they are not deleted with the old instance.
+func (r *instanceReplacement) releasePrimaryIP() error {
+	log.Printf("[INFO] Releasing primary IP %s of instance %s", r.primaryIP.address, r.oldID)
+	if r.primaryIP.autoDelete {
+		if err := r.setPrimaryIPAutoDelete(false); err != nil {
+			return err
+		}
+		r.onRollback(func() error {
+			return r.setPrimaryIPAutoDelete(true)
+		})
+	}
+	for _, volume := range r.volumes {
+		if err := r.detachVolume(volume, r.oldID); err != nil {
+			return err
+		}
+		volume := volume
+		r.onRollback(func() error {
+			return r.attachVolume(volume, r.oldID)
+		})
+	}
+	return r.deleteOld()
+}
+
+func (r *instanceReplacement) findFloatingIPs(zone string) error {
+	fips, response, err := r.sess.ListInstanceNetworkInterfaceFloatingIps(r.sess.NewListInstanceNetworkInterfaceFloatingIpsOptions(r.oldID, r.oldNicID))
+	if err != nil {
+		return flex.NewServiceError("Error Listing Floating IPs of the instance", err, response)
+	}
+	for _, fip := range fips.FloatingIps {
+		if *fip.Zone.Name != zone {
+			return fmt.Errorf("[ERROR] Error replacing instance (%s): floating IP %s is in zone %s and cannot be moved to zone %s", r.oldID, *fip.Address, *fip.Zone.Name, zone)
+		}
+		r.floatingIPs = append(r.floatingIPs, fip)
+	}
+	return nil
+}
+
+func (r *instanceReplacement) findMembers() error {
+	pager, err := r.sess.NewLoadBalancersPager(&vpcv1.ListLoadBalancersOptions{})
+	if err != nil {
+		return err
+	}
+	lbs, err := pager.GetAll()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Listing Load Balancers: %s", err)
+	}
+	for _, lb := range lbs {
+		pools, response, err := r.sess.ListLoadBalancerPools(&vpcv1.ListLoadBalancerPoolsOptions{LoadBalancerID: lb.ID})
+		if err != nil {
+			return flex.NewServiceError("Error Listing Load Balancer Pools", err, response)
+		}
+		for _, pool := range pools.Pools {
+			if len(pool.Members) == 0 {
+				continue
+			}
+			members, response, err := r.sess.ListLoadBalancerPoolMembers(&vpcv1.ListLoadBalancerPoolMembersOptions{
+				LoadBalancerID: lb.ID,
+				PoolID:         pool.ID,
+			})
+			if err != nil {
+				return flex.NewServiceError("Error Listing Load Balancer Pool Members", err, response)
+			}
+			for _, member := range members.Members {
+				target, ok := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget)
+				if !ok {
+					continue
+				}
+				byID := target.ID != nil && *target.ID == r.oldID
+				byAddress := target.Address != nil && r.oldAddress != "" && *target.Address == r.oldAddress
+				if byID || byAddress {
+					r.members = append(r.members, &instanceReplacementMember{
+						lbID:      *lb.ID,
+						poolID:    *pool.ID,
+						memberID:  *member.ID,
+						port:      *member.Port,
+						weight:    member.Weight,
+						byAddress: byAddress,
+					})
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// findVolumes looks up the data volumes to move. A data volume of the old
+// instance that is not moved would be deleted with it or left detached, so
+// the replacement fails before any change in that case.
+func (r *instanceReplacement) findVolumes(oldZone, zone string, move bool) error {
+	attachments, response, err := r.sess.ListInstanceVolumeAttachments(&vpcv1.ListInstanceVolumeAttachmentsOptions{InstanceID: &r.oldID})
+	if err != nil {
+		return flex.NewServiceError("Error Listing volume attachments to the instance", err, response)
+	}
+	volumes := flex.ExpandStringList(r.d.Get(isInstanceVolumes).([]interface{}))
+	var unmoved []string
+	for _, attachment := range attachments.VolumeAttachments {
+		if *attachment.Type != "data" || attachment.Volume == nil {
+			continue
+		}
+		if !move || !flex.StringContains(volumes, *attachment.Volume.ID) {
+			unmoved = append(unmoved, *attachment.Volume.ID)
+			continue
+		}
+		r.volumes = append(r.volumes, &instanceReplacementVolume{
+			volumeID:       *attachment.Volume.ID,
+			attachmentID:   *attachment.ID,
+			deleteOnDelete: *attachment.DeleteVolumeOnInstanceDelete,
+		})
+	}
+	if len(unmoved) > 0 {
+		return fmt.Errorf("[ERROR] Error replacing instance (%s): data volumes %s would be deleted with the old instance or left detached. Only the volumes listed in %s are moved, with %s set, detach the others before the replacement", r.oldID, strings.Join(unmoved, ", "), isInstanceVolumes, isInstanceReplacementMoveVolumes)
+	}
+	if len(r.volumes) > 0 && oldZone != zone {
+		return fmt.Errorf("[ERROR] Error replacing instance (%s): data volumes are in zone %s and cannot be moved to zone %s", r.oldID, oldZone, zone)
+	}
+	return nil
+}
+
+func (r *instanceReplacement) detachVolume(volume *instanceReplacementVolume, instanceID string) error {
+	_, err := r.sess.DeleteInstanceVolumeAttachment(&vpcv1.DeleteInstanceVolumeAttachmentOptions{
+		InstanceID: &instanceID,
+		ID:         &volume.attachmentID,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while removing volume %q for instance %s: %q", volume.volumeID, instanceID, err)
+	}
+	_, err = isWaitForInstanceVolumeDetached(r.sess, r.d, instanceID, volume.attachmentID)
+	if err != nil {
+		return err
+	}
+	volume.attachmentID = ""
+	return nil
+}
+
+func (r *instanceReplacement) attachVolume(volume *instanceReplacementVolume, instanceID string) error {
+	attachment, response, err := r.sess.CreateInstanceVolumeAttachment(&vpcv1.CreateInstanceVolumeAttachmentOptions{
+		InstanceID: &instanceID,
+		Volume: &vpcv1.VolumeAttachmentPrototypeVolume{
+			ID: &volume.volumeID,
+		},
+		DeleteVolumeOnInstanceDelete: &volume.deleteOnDelete,
+	})
+	if err != nil {
+		return flex.NewServiceError(fmt.Sprintf("Error while attaching volume %q for instance %s", volume.volumeID, instanceID), err, response)
+	}
+	_, err = isWaitForInstanceVolumeAttached(r.sess, r.d, instanceID, *attachment.ID)
+	if err != nil {
+		return err
+	}
+	volume.attachmentID = *attachment.ID
+	return nil
+}
+
+// moveVolumes moves the data volumes to the new instance. A volume can only
+// be attached to one instance, so the old instance loses its volumes from here
+// on.
+func (r *instanceReplacement) moveVolumes() error {
+	for _, volume := range r.volumes {
+		volume := volume
+		if volume.attachmentID != "" {
+			if err := r.detachVolume(volume, r.oldID); err != nil {
+				return err
+			}
+			r.onRollback(func() error {
+				return r.attachVolume(volume, r.oldID)
+			})
+		}
+		if err := r.attachVolume(volume, r.newID); err != nil {
+			return err
+		}
+		r.onRollback(func() error {
+			return r.detachVolume(volume, r.newID)
+		})
+	}
+	return nil
+}
+
+func (r *instanceReplacement) memberTarget(instanceID, address string, byAddress bool) *vpcv1.LoadBalancerPoolMemberTargetPrototype {
+	if byAddress {
+		return &vpcv1.LoadBalancerPoolMemberTargetPrototype{Address: &address}
+	}
+	return &vpcv1.LoadBalancerPoolMemberTargetPrototype{ID: &instanceID}
+}
+
+// addMembers adds the new instance to the pools of the old instance next to
+// it, so that its health is checked before any traffic is moved. A member
+// targeting the primary IP moved to the new instance already targets it.
+func (r *instanceReplacement) addMembers() error {
+	for _, member := range r.members {
+		member := member
+		if member.byAddress && r.newAddress == r.oldAddress {
+			continue
+		}
+		created, response, err := r.sess.CreateLoadBalancerPoolMember(&vpcv1.CreateLoadBalancerPoolMemberOptions{
+			LoadBalancerID: &member.lbID,
+			PoolID:         &member.poolID,
+			Port:           &member.port,
+			Weight:         member.weight,
+			Target:         r.memberTarget(r.newID, r.newAddress, member.byAddress),
+		})
+		if err != nil {
+			return flex.NewServiceError("Error Creating Load Balancer Pool Member for the replacement instance", err, response)
+		}
+		member.replacementID = *created.ID
+		r.onRollback(func() error {
+			return r.deleteReplacementMember(member)
+		})
+		_, err = isWaitForLBAvailable(r.sess, member.lbID, r.d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", member.lbID, err)
+		}
+	}
+	return nil
+}
+
+func (r *instanceReplacement) waitForMembersHealthy(timeout time.Duration) error {
+	if len(r.members) == 0 {
+		return nil
+	}
+	log.Printf("Waiting for load balancer pool members of instance (%s) to be healthy.", r.newID)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.LoadBalancerPoolMemberHealthUnknownConst, vpcv1.LoadBalancerPoolMemberHealthFaultedConst},
+		Target:  []string{vpcv1.LoadBalancerPoolMemberHealthOkConst},
+		Refresh: func() (interface{}, string, error) {
+			for _, member := range r.members {
+				id := member.replacementID
+				if id == "" {
+					id = member.memberID
+				}
+				poolMember, response, err := r.sess.GetLoadBalancerPoolMember(&vpcv1.GetLoadBalancerPoolMemberOptions{
+					LoadBalancerID: &member.lbID,
+					PoolID:         &member.poolID,
+					ID:             &id,
+				})
+				if err != nil {
+					return nil, "", flex.NewServiceError("Error Getting Load Balancer Pool Member", err, response)
+				}
+				if *poolMember.Health != vpcv1.LoadBalancerPoolMemberHealthOkConst {
+					return poolMember, *poolMember.Health, nil
+				}
+			}
+			return r.members, vpcv1.LoadBalancerPoolMemberHealthOkConst, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for load balancer pool members of instance (%s) to be healthy: %s", r.newID, err)
+	}
+	return nil
+}
+
+func (r *instanceReplacement) retargetFloatingIP(id, nicID string) error {
+	fipPatch, err := (&vpcv1.FloatingIPPatch{Target: &vpcv1.FloatingIPTargetPatch{ID: &nicID}}).AsPatch()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error calling asPatch for FloatingIPPatch: %s", err)
+	}
+	_, response, err := r.sess.UpdateFloatingIP(&vpcv1.UpdateFloatingIPOptions{
+		ID:              &id,
+		FloatingIPPatch: fipPatch,
+	})
+	if err != nil {
+		return flex.NewServiceError("Error updating vpc Floating IP", err, response)
+	}
+	return nil
+}
+
+func (r *instanceReplacement) moveFloatingIPs() error {
+	for _, fip := range r.floatingIPs {
+		id := *fip.ID
+		if err := r.retargetFloatingIP(id, r.newNicID); err != nil {
+			return err
+		}
+		r.onRollback(func() error {
+			return r.retargetFloatingIP(id, r.oldNicID)
+		})
+	}
+	return nil
+}
+
+func (r *instanceReplacement) retargetMember(member *instanceReplacementMember, instanceID, address string) error {
+	memberPatch, err := (&vpcv1.LoadBalancerPoolMemberPatch{
+		Target: r.memberTarget(instanceID, address, member.byAddress),
+	}).AsPatch()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error calling asPatch for LoadBalancerPoolMemberPatch: %s", err)
+	}
+	_, response, err := r.sess.UpdateLoadBalancerPoolMember(&vpcv1.UpdateLoadBalancerPoolMemberOptions{
+		LoadBalancerID:              &member.lbID,
+		PoolID:                      &member.poolID,
+		ID:                          &member.memberID,
+		LoadBalancerPoolMemberPatch: memberPatch,
+	})
+	if err != nil {
+		return flex.NewServiceError("Error Updating Load Balancer Pool Member", err, response)
+	}
+	_, err = isWaitForLBAvailable(r.sess, member.lbID, r.d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", member.lbID, err)
+	}
+	return nil
+}
+
+func (r *instanceReplacement) deleteReplacementMember(member *instanceReplacementMember) error {
+	if member.replacementID == "" {
+		return nil
+	}
+	response, err := r.sess.DeleteLoadBalancerPoolMember(&vpcv1.DeleteLoadBalancerPoolMemberOptions{
+		LoadBalancerID: &member.lbID,
+		PoolID:         &member.poolID,
+		ID:             &member.replacementID,
+	})
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return flex.NewServiceError("Error Deleting Load Balancer Pool Member", err, response)
+	}
+	member.replacementID = ""
+	_, err = isWaitForLBAvailable(r.sess, member.lbID, r.d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", member.lbID, err)
+	}
+	return nil
+}
+
+// moveMembers replaces the temporary pool members of the new instance by
+// retargeting the original members, which keeps their ids, and with them any
+// ibm_is_lb_pool_member resources, valid
+func (r *instanceReplacement) moveMembers() error {
+	for _, member := range r.members {
+		member := member
+		if member.replacementID == "" {
+			continue
+		}
+		if err := r.deleteReplacementMember(member); err != nil {
+			return err
+		}
+		if err := r.retargetMember(member, r.newID, r.newAddress); err != nil {
+			return err
+		}
+		r.onRollback(func() error {
+			return r.retargetMember(member, r.oldID, r.oldAddress)
+		})
+	}
+	return nil
+}
+
+// rollback undoes the changes made so far in reverse order, which moves
+// everything moved to the new instance back to the old one and deletes the new
+// instance last. Once the old instance is deleted, there is nothing to move
+// back to, and the new instance, if any, is kept. Rollback errors are reported
+// along with the cause.
+func (r *instanceReplacement) rollback(cause error) error {
+	if r.oldDeleted {
+		r.d.SetId(r.newID)
+		return fmt.Errorf("[ERROR] Error replacing instance (%s): %s\nThe old instance was deleted to release its primary IP %s, so the replacement cannot be rolled back. Apply again to create the instance, and check the floating IPs and load balancer pool members of the old instance", r.oldID, cause, r.primaryIP.address)
+	}
+	log.Printf("[WARN] Rolling back replacement of instance %s: %s", r.oldID, cause)
+	var errs []string
+	for i := len(r.undo) - 1; i >= 0; i-- {
+		if err := r.undo[i](); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	r.undo = nil
+
+	// keep the prior state of the old instance
+	r.d.SetId(r.oldID)
+	r.d.Partial(true)
+	if len(errs) > 0 {
+		return fmt.Errorf("[ERROR] Error replacing instance (%s): %s\nThe rollback of the replacement failed: %s", r.oldID, cause, strings.Join(errs, "\n"))
+	}
+	return fmt.Errorf("[ERROR] Error replacing instance (%s), the replacement was rolled back: %s", r.oldID, cause)
+}
@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestInstanceReplacementName(t *testing.T) {
+	if name := instanceReplacementName("web-1"); name != "web-1-replacement" {
+		t.Errorf("expected web-1-replacement, got %s", name)
+	}
+	long := strings.Repeat("a", 50) + "-" + strings.Repeat("b", 12)
+	name := instanceReplacementName(long)
+	if len(name) > 63 {
+		t.Errorf("expected a name of at most 63 characters, got %d", len(name))
+	}
+	if name != strings.Repeat("a", 50)+instanceReplacementNameSuffix {
+		t.Errorf("expected the trailing hyphen of the truncated name to be trimmed, got %s", name)
+	}
+}
+
+func TestInstanceReplacementCustomizeDiff(t *testing.T) {
+	instanceSchema := ResourceIBMISInstance().Schema
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			return instanceReplacementCustomizeDiff(diff)
+		},
+	}
+	for _, key := range []string{isInstanceImage, isInstanceZone, isInstanceVPC, isInstanceBootVolume, isInstancePrimaryNetworkInterface, isInstanceReplacementStrategy, isInstanceReplacedInstance} {
+		testResource.Schema[key] = instanceSchema[key]
+	}
+	state := map[string]string{
+		isInstanceImage: "r006-old",
+		isInstanceZone:  "us-south-1",
+		isInstanceVPC:   "r006-vpc",
+	}
+	strategy := []interface{}{map[string]interface{}{isInstanceReplacementHealthCheckTimeout: 600}}
+
+	testCases := []struct {
+		name        string
+		replaced    string
+		config      map[string]interface{}
+		requiresNew bool
+		retryDelete bool
+	}{
+		{
+			name:        "image change without replacement strategy",
+			config:      map[string]interface{}{isInstanceImage: "r006-new", isInstanceZone: "us-south-1", isInstanceVPC: "r006-vpc"},
+			requiresNew: true,
+		},
+		{
+			name:   "image change with replacement strategy",
+			config: map[string]interface{}{isInstanceImage: "r006-new", isInstanceZone: "us-south-1", isInstanceVPC: "r006-vpc", isInstanceReplacementStrategy: strategy},
+		},
+		{
+			name:   "no change",
+			config: map[string]interface{}{isInstanceImage: "r006-old", isInstanceZone: "us-south-1", isInstanceVPC: "r006-vpc"},
+		},
+		{
+			name:        "replaced instance left by a previous replacement",
+			replaced:    "0717-old",
+			config:      map[string]interface{}{isInstanceImage: "r006-old", isInstanceZone: "us-south-1", isInstanceVPC: "r006-vpc", isInstanceReplacementStrategy: strategy},
+			retryDelete: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		attributes := map[string]string{isInstanceReplacedInstance: tc.replaced}
+		for k, v := range state {
+			attributes[k] = v
+		}
+		if _, ok := tc.config[isInstanceReplacementStrategy]; ok {
+			attributes["replacement_strategy.#"] = "1"
+			attributes["replacement_strategy.0.health_check_timeout"] = "600"
+			attributes["replacement_strategy.0.move_floating_ips"] = "true"
+			attributes["replacement_strategy.0.move_lb_pool_members"] = "true"
+			attributes["replacement_strategy.0.move_volumes"] = "true"
+		}
+		instanceDiff, err := testResource.Diff(context.Background(), &terraform.InstanceState{ID: "0717-new", Attributes: attributes}, terraform.NewResourceConfigRaw(tc.config), nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		if got := instanceDiff != nil && instanceDiff.RequiresNew(); got != tc.requiresNew {
+			t.Errorf("%s: requires new = %t, want %t", tc.name, got, tc.requiresNew)
+		}
+		var attr *terraform.ResourceAttrDiff
+		if instanceDiff != nil {
+			attr = instanceDiff.Attributes[isInstanceReplacedInstance]
+		}
+		if got := attr != nil && tc.replaced != "" && attr.Old == tc.replaced && attr.NewComputed; got != tc.retryDelete {
+			t.Errorf("%s: %s diff = %+v, want the deletion to be retried: %t", tc.name, isInstanceReplacedInstance, attr, tc.retryDelete)
+		}
+	}
+}
+
+func TestInstanceReplacementFixedPrimaryIP(t *testing.T) {
+	config := func(ipv4Address, reservedIP, address cty.Value) cty.Value {
+		ip := cty.ObjectVal(map[string]cty.Value{
+			isInstanceNicReservedIpId:      reservedIP,
+			isInstanceNicReservedIpAddress: address,
+		})
+		nic := cty.ObjectVal(map[string]cty.Value{
+			isInstanceNicPrimaryIpv4Address: ipv4Address,
+			isInstanceNicPrimaryIP:          cty.ListVal([]cty.Value{ip}),
+		})
+		return cty.ObjectVal(map[string]cty.Value{
+			isInstancePrimaryNetworkInterface: cty.ListVal([]cty.Value{nic}),
+		})
+	}
+	null := cty.NullVal(cty.String)
+
+	testCases := []struct {
+		name       string
+		config     cty.Value
+		reservedIP string
+		address    string
+	}{
+		{name: "no fixed IP", config: config(null, null, null)},
+		{name: "primary_ipv4_address", config: config(cty.StringVal("10.240.0.4"), null, null), address: "10.240.0.4"},
+		{name: "primary_ip address", config: config(null, null, cty.StringVal("10.240.0.5")), address: "10.240.0.5"},
+		{name: "primary_ip reserved_ip", config: config(null, cty.StringVal("0717-ip"), null), reservedIP: "0717-ip"},
+		{name: "null configuration", config: cty.NullVal(cty.DynamicPseudoType)},
+	}
+
+	for _, tc := range testCases {
+		reservedIP, address := instanceReplacementFixedPrimaryIP(tc.config)
+		if reservedIP != tc.reservedIP || address != tc.address {
+			t.Errorf("%s: got reserved IP %q and address %q, want %q and %q", tc.name, reservedIP, address, tc.reservedIP, tc.address)
+		}
+	}
+}
+
+func TestInstanceReplacementRollback(t *testing.T) {
+	// the steps are registered in the order of instanceReplace
+	steps := []string{
+		"delete new instance",
+		"delete replacement pool member",
+		"attach volume to old instance",
+		"detach volume from new instance",
+		"retarget floating IP to old instance",
+		"retarget pool member to old instance",
+	}
+	newReplacement := func(undone *[]string, failing string) *instanceReplacement {
+		d := schema.TestResourceDataRaw(t, ResourceIBMISInstance().Schema, map[string]interface{}{})
+		d.SetId("0717-new")
+		r := &instanceReplacement{d: d, oldID: "0717-old", newID: "0717-new"}
+		for _, step := range steps {
+			step := step
+			r.onRollback(func() error {
+				*undone = append(*undone, step)
+				if step == failing {
+					return errors.New(step + " failed")
+				}
+				return nil
+			})
+		}
+		return r
+	}
+
+	var undone []string
+	r := newReplacement(&undone, "detach volume from new instance")
+	err := r.rollback(errors.New("pool members unhealthy"))
+	want := []string{
+		"retarget pool member to old instance",
+		"retarget floating IP to old instance",
+		"detach volume from new instance",
+		"attach volume to old instance",
+		"delete replacement pool member",
+		"delete new instance",
+	}
+	if !reflect.DeepEqual(undone, want) {
+		t.Errorf("expected the steps to be undone in reverse order, got %v", undone)
+	}
+	if err == nil || !strings.Contains(err.Error(), "pool members unhealthy") || !strings.Contains(err.Error(), "detach volume from new instance failed") {
+		t.Errorf("expected the cause and the rollback error to be reported, got %v", err)
+	}
+	if r.d.Id() != "0717-old" {
+		t.Errorf("expected the old instance to be kept in state, got %s", r.d.Id())
+	}
+
+	undone = nil
+	r = newReplacement(&undone, "")
+	r.oldDeleted = true
+	r.primaryIP = &instanceReplacementPrimaryIP{id: "0717-ip", address: "10.240.0.4"}
+	err = r.rollback(errors.New("instance failed to start"))
+	if len(undone) != 0 {
+		t.Errorf("expected no step to be undone once the old instance is deleted, got %v", undone)
+	}
+	if err == nil || !strings.Contains(err.Error(), "cannot be rolled back") {
+		t.Errorf("expected the replacement not to be rolled back, got %v", err)
+	}
+	if r.d.Id() != "0717-new" {
+		t.Errorf("expected the new instance to be kept in state, got %s", r.d.Id())
+	}
+}
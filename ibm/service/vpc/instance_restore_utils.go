@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"sort"
+)
+
+// instanceRestoreSnapshot is a snapshot to restore a volume of an instance from
+type instanceRestoreSnapshot struct {
+	id           string
+	bootable     bool
+	sourceVolume string
+}
+
+// instanceRestoreSortSnapshots sorts the snapshots in the order in which their source volumes
+// are attached to the source instance, as given by position. Snapshots of volumes that are not
+// attached to it keep their order, after the others.
+func instanceRestoreSortSnapshots(snapshots []instanceRestoreSnapshot, position map[string]int) {
+	index := func(snapshot instanceRestoreSnapshot) int {
+		if i, ok := position[snapshot.sourceVolume]; ok {
+			return i
+		}
+		return len(position)
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return index(snapshots[i]) < index(snapshots[j])
+	})
+}
+
+// instanceRestoreVolumeProfile returns the profile of the volume restored from snapshot, from
+// the profiles by snapshot if it is listed there, or else the default profile
+func instanceRestoreVolumeProfile(profiles map[string]interface{}, snapshot, defaultProfile string) string {
+	if profile, ok := profiles[snapshot].(string); ok && profile != "" {
+		return profile
+	}
+	return defaultProfile
+}
+
+// instanceRestoreSplitSnapshots returns the snapshot of the boot volume and the snapshots of the
+// data volumes, in the order of snapshots, which must have exactly one bootable snapshot
+func instanceRestoreSplitSnapshots(snapshots []instanceRestoreSnapshot) (string, []string, error) {
+	boot := ""
+	data := []string{}
+	for _, snapshot := range snapshots {
+		if !snapshot.bootable {
+			data = append(data, snapshot.id)
+			continue
+		}
+		if boot != "" {
+			return "", nil, fmt.Errorf("[ERROR] The snapshots %s and %s are both bootable, exactly one snapshot must be bootable", boot, snapshot.id)
+		}
+		boot = snapshot.id
+	}
+	if boot == "" {
+		return "", nil, fmt.Errorf("[ERROR] None of the snapshots is bootable, exactly one snapshot must be bootable")
+	}
+	return boot, data, nil
+}
+
+// instanceRestoreSortAttachments sorts the data volume attachments of a restored instance in the
+// order of the snapshots of their volumes. Attachments of other volumes are sorted last.
+func instanceRestoreSortAttachments(attachments []map[string]interface{}, snapshots []string) {
+	order := make(map[string]int, len(snapshots))
+	for i, snapshot := range snapshots {
+		order[snapshot] = i
+	}
+	index := func(attachment map[string]interface{}) int {
+		if i, ok := order[attachment[isInstanceRestoreSourceSnapshot].(string)]; ok {
+			return i
+		}
+		return len(snapshots)
+	}
+	sort.SliceStable(attachments, func(i, j int) bool {
+		return index(attachments[i]) < index(attachments[j])
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInstanceRestoreSplitSnapshots(t *testing.T) {
+	boot, data, err := instanceRestoreSplitSnapshots([]instanceRestoreSnapshot{
+		{id: "data-1"},
+		{id: "boot", bootable: true},
+		{id: "data-2"},
+		{id: "data-3"},
+	})
+	if err != nil || boot != "boot" || !reflect.DeepEqual(data, []string{"data-1", "data-2", "data-3"}) {
+		t.Errorf("unexpected split %q %v (%v)", boot, data, err)
+	}
+
+	boot, data, err = instanceRestoreSplitSnapshots([]instanceRestoreSnapshot{{id: "boot", bootable: true}})
+	if err != nil || boot != "boot" || len(data) != 0 {
+		t.Errorf("unexpected split %q %v (%v)", boot, data, err)
+	}
+
+	for _, snapshots := range [][]instanceRestoreSnapshot{
+		{},
+		{{id: "data-1"}, {id: "data-2"}},
+		{{id: "boot-1", bootable: true}, {id: "boot-2", bootable: true}},
+	} {
+		if _, _, err := instanceRestoreSplitSnapshots(snapshots); err == nil {
+			t.Errorf("expected an error for %v", snapshots)
+		}
+	}
+}
+
+func TestInstanceRestoreSortAttachments(t *testing.T) {
+	attachments := []map[string]interface{}{
+		{isInstanceRestoreSourceSnapshot: "other"},
+		{isInstanceRestoreSourceSnapshot: "data-3"},
+		{isInstanceRestoreSourceSnapshot: "data-1"},
+		{isInstanceRestoreSourceSnapshot: "data-2"},
+	}
+	instanceRestoreSortAttachments(attachments, []string{"data-1", "data-2", "data-3"})
+	sorted := []string{}
+	for _, attachment := range attachments {
+		sorted = append(sorted, attachment[isInstanceRestoreSourceSnapshot].(string))
+	}
+	if !reflect.DeepEqual(sorted, []string{"data-1", "data-2", "data-3", "other"}) {
+		t.Errorf("unexpected order %v", sorted)
+	}
+}
+
+func TestInstanceRestoreSortSnapshots(t *testing.T) {
+	snapshots := []instanceRestoreSnapshot{
+		{id: "data-2", sourceVolume: "vol-2"},
+		{id: "other", sourceVolume: "vol-other"},
+		{id: "boot", bootable: true, sourceVolume: "vol-boot"},
+		{id: "data-1", sourceVolume: "vol-1"},
+		{id: "copy"},
+	}
+	instanceRestoreSortSnapshots(snapshots, map[string]int{"vol-boot": 0, "vol-1": 1, "vol-2": 2})
+	sorted := []string{}
+	for _, snapshot := range snapshots {
+		sorted = append(sorted, snapshot.id)
+	}
+	if !reflect.DeepEqual(sorted, []string{"boot", "data-1", "data-2", "other", "copy"}) {
+		t.Errorf("unexpected order %v", sorted)
+	}
+
+	instanceRestoreSortSnapshots(snapshots[3:], nil)
+	if snapshots[3].id != "other" || snapshots[4].id != "copy" {
+		t.Errorf("expected the order to be kept without positions, got %v", snapshots)
+	}
+}
+
+func TestInstanceRestoreVolumeProfile(t *testing.T) {
+	profiles := map[string]interface{}{"data-1": "10iops-tier", "data-2": ""}
+	for snapshot, expected := range map[string]string{
+		"data-1": "10iops-tier",
+		"data-2": "general-purpose",
+		"boot":   "general-purpose",
+	} {
+		if profile := instanceRestoreVolumeProfile(profiles, snapshot, "general-purpose"); profile != expected {
+			t.Errorf("expected profile %s for %s, got %s", expected, snapshot, profile)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isInstanceTemplateNamePrefix       = "name_prefix"
+	isInstanceTemplateVersionRetention = "version_retention"
+	isInstanceTemplateLatestVersionID  = "latest_version_id"
+	isInstanceTemplateVersions         = "versions"
+)
+
+// instanceTemplateVersionTimeFormat is appended to name_prefix to name a version
+const instanceTemplateVersionTimeFormat = "20060102150405"
+
+// instanceTemplateClearForceNew clears ForceNew in s and returns the top-level
+// keys that had it set on themselves or on a nested argument, sorted. As instance
+// templates are immutable, instanceTemplateVersionCustomizeDiff forces a new
+// resource on a change of these keys, unless name_prefix is set and a new
+// version is created instead.
+func instanceTemplateClearForceNew(s map[string]*schema.Schema) []string {
+	keys := []string{}
+	for key, sch := range s {
+		if instanceTemplateClearSchemaForceNew(sch) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func instanceTemplateClearSchemaForceNew(s *schema.Schema) bool {
+	forceNew := s.ForceNew
+	s.ForceNew = false
+	if elem, ok := s.Elem.(*schema.Resource); ok {
+		for _, nested := range elem.Schema {
+			if instanceTemplateClearSchemaForceNew(nested) {
+				forceNew = true
+			}
+		}
+	}
+	return forceNew
+}
+
+func instanceTemplateVersionCustomizeDiff(diff *schema.ResourceDiff, keys []string) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if _, ok := diff.GetOk(isInstanceTemplateNamePrefix); !ok {
+		for _, key := range keys {
+			if diff.HasChange(key) {
+				if err := diff.ForceNew(key); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if diff.HasChanges(keys...) {
+		for _, key := range []string{isInstanceTemplateLatestVersionID, isInstanceTemplateVersions, isInstanceTemplateName, isInstanceTemplateCRN} {
+			if err := diff.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	} else if diff.HasChange(isInstanceTemplateVersionRetention) {
+		return diff.SetNewComputed(isInstanceTemplateVersions)
+	}
+	return nil
+}
+
+// instanceTemplateVersioned returns true if the template is versioned by name_prefix
+func instanceTemplateVersioned(d *schema.ResourceData) bool {
+	_, ok := d.GetOk(isInstanceTemplateNamePrefix)
+	return ok
+}
+
+// instanceTemplateLatestVersion returns the ID of the template in use, which
+// is the latest version of a versioned template
+func instanceTemplateLatestVersion(d *schema.ResourceData) string {
+	if latest, ok := d.GetOk(isInstanceTemplateLatestVersionID); ok {
+		return latest.(string)
+	}
+	return d.Id()
+}
+
+// instanceTemplateVersionIDs returns the versions of the template, newest first
+func instanceTemplateVersionIDs(d *schema.ResourceData) []string {
+	versions := flex.ExpandStringList(d.Get(isInstanceTemplateVersions).([]interface{}))
+	if len(versions) == 0 && d.Id() != "" {
+		versions = []string{d.Id()}
+	}
+	return versions
+}
+
+func instanceTemplateVersionName(prefix string, t time.Time) string {
+	return prefix + t.UTC().Format(instanceTemplateVersionTimeFormat)
+}
+
+// instanceTemplateExpiredVersions splits versions, newest first, into the
+// latest version, retention previous versions and the first version to keep,
+// and the versions to delete. The first version is kept for as long as the
+// template exists, as its ID is the ID of the resource.
+func instanceTemplateExpiredVersions(versions []string, retention int, first string) ([]string, []string) {
+	keep, expired := []string{}, []string{}
+	kept := 0
+	for _, version := range versions {
+		switch {
+		case version == first:
+			keep = append(keep, version)
+		case kept <= retention:
+			keep = append(keep, version)
+			kept++
+		default:
+			expired = append(expired, version)
+		}
+	}
+	return keep, expired
+}
+
+// instanceTemplateVersionUpdate creates a new version of the template, switches
+// the instance groups using a previous version to it and deletes the previous
+// versions beyond version_retention. A version that cannot be deleted is kept
+// and deleted by a later update.
+//
+// The ID of the resource stays the ID of the first version, as changing the ID
+// on apply would break the plan of the resources referencing it, so the first
+// version is never deleted by an update. The version in use is
+// latest_version_id.
+func instanceTemplateVersionUpdate(d *schema.ResourceData, meta interface{}, keys []string) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	versions := instanceTemplateVersionIDs(d)
+	if d.HasChanges(keys...) {
+		name := instanceTemplateVersionName(d.Get(isInstanceTemplateNamePrefix).(string), time.Now())
+		latest, err := instanceTemplateCreateFromConfig(d, meta, name)
+		if err != nil {
+			return err
+		}
+		log.Printf("[INFO] Instance template : %s version %s created", d.Id(), latest)
+		versions = append([]string{latest}, versions...)
+		d.Set(isInstanceTemplateLatestVersionID, latest)
+		d.Set(isInstanceTemplateVersions, versions)
+		if err = instanceTemplateSwitchInstanceGroups(sess, versions[1:], latest); err != nil {
+			return err
+		}
+	}
+
+	keep, expired := instanceTemplateExpiredVersions(versions, d.Get(isInstanceTemplateVersionRetention).(int), d.Id())
+	for _, version := range expired {
+		if err = instanceTemplateDeleteVersion(sess, version); err != nil {
+			log.Printf("[WARN] Error deleting instance template version %s, it will be deleted by a later update: %s", version, err)
+			keep = append(keep, version)
+		}
+	}
+	d.Set(isInstanceTemplateVersions, keep)
+	return nil
+}
+
+// instanceTemplateSwitchInstanceGroups switches the instance groups using one
+// of versions to the template latest
+func instanceTemplateSwitchInstanceGroups(sess *vpcv1.VpcV1, versions []string, latest string) error {
+	previous := make(map[string]bool, len(versions))
+	for _, version := range versions {
+		previous[version] = true
+	}
+	start := ""
+	allrecs := []vpcv1.InstanceGroup{}
+	for {
+		listInstanceGroupsOptions := &vpcv1.ListInstanceGroupsOptions{}
+		if start != "" {
+			listInstanceGroupsOptions.Start = &start
+		}
+		instanceGroupCollection, response, err := sess.ListInstanceGroups(listInstanceGroupsOptions)
+		if err != nil {
+			return flex.NewServiceError("Error listing instance groups", err, response)
+		}
+		start = flex.GetNext(instanceGroupCollection.Next)
+		allrecs = append(allrecs, instanceGroupCollection.InstanceGroups...)
+		if start == "" {
+			break
+		}
+	}
+
+	for _, instanceGroup := range allrecs {
+		if instanceGroup.InstanceTemplate == nil || !previous[*instanceGroup.InstanceTemplate.ID] {
+			continue
+		}
+		instanceGroupPatchModel := &vpcv1.InstanceGroupPatch{
+			InstanceTemplate: &vpcv1.InstanceTemplateIdentity{
+				ID: &latest,
+			},
+		}
+		instanceGroupPatch, err := instanceGroupPatchModel.AsPatch()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error calling asPatch for InstanceGroupPatch: %s", err)
+		}
+		updateInstanceGroupOptions := &vpcv1.UpdateInstanceGroupOptions{
+			ID:                 instanceGroup.ID,
+			InstanceGroupPatch: instanceGroupPatch,
+		}
+		_, response, err := sess.UpdateInstanceGroup(updateInstanceGroupOptions)
+		if err != nil {
+			return flex.NewServiceError(fmt.Sprintf("Error switching instance group %s to instance template %s", *instanceGroup.ID, latest), err, response)
+		}
+		log.Printf("[INFO] Instance group : %s switched from instance template %s to %s", *instanceGroup.ID, *instanceGroup.InstanceTemplate.ID, latest)
+	}
+	return nil
+}
+
+// instanceTemplateDeleteVersion deletes a version of the template, a version
+// already deleted is ignored
+func instanceTemplateDeleteVersion(sess *vpcv1.VpcV1, version string) error {
+	deleteInstanceTemplateOptions := &vpcv1.DeleteInstanceTemplateOptions{
+		ID: &version,
+	}
+	response, err := sess.DeleteInstanceTemplate(deleteInstanceTemplateOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return flex.NewServiceError("Error deleting InstanceTemplate", err, response)
+	}
+	return nil
+}
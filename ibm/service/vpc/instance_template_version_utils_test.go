@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestInstanceTemplateClearForceNew(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"name":    {Type: schema.TypeString, Optional: true},
+		"profile": {Type: schema.TypeString, Required: true, ForceNew: true},
+		"primary_network_interface": {
+			Type:     schema.TypeList,
+			Required: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"subnet": {Type: schema.TypeString, Required: true, ForceNew: true},
+				},
+			},
+		},
+	}
+	keys := instanceTemplateClearForceNew(s)
+	if !reflect.DeepEqual(keys, []string{"primary_network_interface", "profile"}) {
+		t.Errorf("unexpected keys %v", keys)
+	}
+	if s["profile"].ForceNew || s["primary_network_interface"].Elem.(*schema.Resource).Schema["subnet"].ForceNew {
+		t.Errorf("expected ForceNew to be cleared")
+	}
+}
+
+func TestInstanceTemplateVersionName(t *testing.T) {
+	name := instanceTemplateVersionName("web-", time.Date(2024, 3, 5, 7, 9, 11, 0, time.UTC))
+	if name != "web-20240305070911" {
+		t.Errorf("expected web-20240305070911, got %s", name)
+	}
+}
+
+func TestInstanceTemplateExpiredVersions(t *testing.T) {
+	versions := []string{"v4", "v3", "v2", "v1"}
+	testCases := []struct {
+		name      string
+		versions  []string
+		retention int
+		first     string
+		keep      []string
+		expired   []string
+	}{
+		{name: "retention 1", retention: 1, keep: []string{"v4", "v3"}, expired: []string{"v2", "v1"}},
+		{name: "retention 0", retention: 0, keep: []string{"v4"}, expired: []string{"v3", "v2", "v1"}},
+		{name: "retention beyond versions", retention: 5, keep: versions, expired: []string{}},
+		{name: "first version kept", retention: 1, first: "v1", keep: []string{"v4", "v3", "v1"}, expired: []string{"v2"}},
+		{name: "first version only", versions: []string{"v1"}, retention: 0, first: "v1", keep: []string{"v1"}, expired: []string{}},
+	}
+
+	for _, tc := range testCases {
+		vs := versions
+		if tc.versions != nil {
+			vs = tc.versions
+		}
+		keep, expired := instanceTemplateExpiredVersions(vs, tc.retention, tc.first)
+		if !reflect.DeepEqual(keep, tc.keep) || !reflect.DeepEqual(expired, tc.expired) {
+			t.Errorf("%s: got %v and %v, want %v and %v", tc.name, keep, expired, tc.keep, tc.expired)
+		}
+	}
+}
+
+func TestInstanceTemplateVersionCustomizeDiff(t *testing.T) {
+	templateSchema := ResourceIBMISInstanceTemplate().Schema
+	keys := []string{isInstanceTemplateProfile}
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			return instanceTemplateVersionCustomizeDiff(diff, keys)
+		},
+	}
+	for _, key := range []string{isInstanceTemplateProfile, isInstanceTemplateName, isInstanceTemplateNamePrefix, isInstanceTemplateVersionRetention, isInstanceTemplateLatestVersionID, isInstanceTemplateVersions, isInstanceTemplateCRN} {
+		testResource.Schema[key] = templateSchema[key]
+	}
+
+	testCases := []struct {
+		name        string
+		prefix      string
+		config      map[string]interface{}
+		requiresNew bool
+		computed    []string
+	}{
+		{
+			name:        "change without name_prefix",
+			config:      map[string]interface{}{isInstanceTemplateProfile: "bx2-4x16", isInstanceTemplateName: "web"},
+			requiresNew: true,
+		},
+		{
+			name:   "no change without name_prefix",
+			config: map[string]interface{}{isInstanceTemplateProfile: "bx2-2x8", isInstanceTemplateName: "web"},
+		},
+		{
+			name:     "change with name_prefix",
+			prefix:   "web-",
+			config:   map[string]interface{}{isInstanceTemplateProfile: "bx2-4x16", isInstanceTemplateNamePrefix: "web-"},
+			computed: []string{isInstanceTemplateLatestVersionID, isInstanceTemplateVersions + ".#", isInstanceTemplateName, isInstanceTemplateCRN},
+		},
+		{
+			name:     "version_retention change with name_prefix",
+			prefix:   "web-",
+			config:   map[string]interface{}{isInstanceTemplateProfile: "bx2-2x8", isInstanceTemplateNamePrefix: "web-", isInstanceTemplateVersionRetention: 1},
+			computed: []string{isInstanceTemplateVersions + ".#"},
+		},
+		{
+			name:   "no change with name_prefix",
+			prefix: "web-",
+			config: map[string]interface{}{isInstanceTemplateProfile: "bx2-2x8", isInstanceTemplateNamePrefix: "web-"},
+		},
+	}
+
+	for _, tc := range testCases {
+		attributes := map[string]string{
+			isInstanceTemplateProfile:          "bx2-2x8",
+			isInstanceTemplateName:             "web",
+			isInstanceTemplateVersionRetention: "2",
+			isInstanceTemplateLatestVersionID:  "0717-v1",
+			isInstanceTemplateCRN:              "crn:v1:0717-v1",
+			isInstanceTemplateVersions + ".#":  "0",
+		}
+		if tc.prefix != "" {
+			attributes[isInstanceTemplateName] = tc.prefix + "20240305070911"
+			attributes[isInstanceTemplateNamePrefix] = tc.prefix
+			attributes[isInstanceTemplateVersions+".#"] = "1"
+			attributes[isInstanceTemplateVersions+".0"] = "0717-v1"
+		}
+		instanceDiff, err := testResource.Diff(context.Background(), &terraform.InstanceState{ID: "0717-v1", Attributes: attributes}, terraform.NewResourceConfigRaw(tc.config), nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		if got := instanceDiff != nil && instanceDiff.RequiresNew(); got != tc.requiresNew {
+			t.Errorf("%s: requires new = %t, want %t", tc.name, got, tc.requiresNew)
+		}
+		if tc.requiresNew {
+			continue
+		}
+		computed := []string{}
+		if instanceDiff != nil {
+			for key, attr := range instanceDiff.Attributes {
+				if attr.NewComputed {
+					computed = append(computed, key)
+				}
+			}
+		}
+		sort.Strings(computed)
+		want := append([]string{}, tc.computed...)
+		sort.Strings(want)
+		if !reflect.DeepEqual(computed, want) {
+			t.Errorf("%s: computed %v, want %v", tc.name, computed, want)
+		}
+	}
+}
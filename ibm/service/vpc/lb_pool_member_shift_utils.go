@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import "fmt"
+
+// lbPoolMemberWeight is a pool member identified by its port and target
+type lbPoolMemberWeight struct {
+	port          int64
+	targetID      string
+	targetAddress string
+	weight        int64
+}
+
+func (m lbPoolMemberWeight) key() string {
+	return fmt.Sprintf("%d/%s/%s", m.port, m.targetID, m.targetAddress)
+}
+
+// lbPoolMembersShiftSteps returns the member sets that shift the weights from
+// the old members to the new members in the given number of steps. Members
+// only in the new set are first added with weight 0, so that they are health
+// checked before they receive traffic. The weights of the members in between
+// are interpolated, and the last set is the new set.
+func lbPoolMembersShiftSteps(old, new []lbPoolMemberWeight, steps int) [][]lbPoolMemberWeight {
+	oldWeights := map[string]int64{}
+	for _, member := range old {
+		oldWeights[member.key()] = member.weight
+	}
+	newWeights := map[string]int64{}
+	for _, member := range new {
+		newWeights[member.key()] = member.weight
+	}
+	members := append([]lbPoolMemberWeight{}, old...)
+	added := false
+	for _, member := range new {
+		if _, ok := oldWeights[member.key()]; !ok {
+			members = append(members, member)
+			added = true
+		}
+	}
+
+	sets := [][]lbPoolMemberWeight{}
+	step := func(i int) []lbPoolMemberWeight {
+		set := make([]lbPoolMemberWeight, 0, len(members))
+		for _, member := range members {
+			from, to := oldWeights[member.key()], newWeights[member.key()]
+			member.weight = from + (to-from)*int64(i)/int64(steps)
+			set = append(set, member)
+		}
+		return set
+	}
+	if added {
+		sets = append(sets, step(0))
+	}
+	for i := 1; i < steps; i++ {
+		sets = append(sets, step(i))
+	}
+	return append(sets, new)
+}
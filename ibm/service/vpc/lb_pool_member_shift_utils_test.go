@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLBPoolMembersShiftSteps(t *testing.T) {
+	blue := lbPoolMemberWeight{port: 80, targetAddress: "10.240.0.4", weight: 100}
+	green := lbPoolMemberWeight{port: 80, targetAddress: "10.240.0.5", weight: 100}
+	shared := lbPoolMemberWeight{port: 80, targetID: "0717-instance", weight: 20}
+	sharedNew := shared
+	sharedNew.weight = 60
+
+	sets := lbPoolMembersShiftSteps([]lbPoolMemberWeight{blue, shared}, []lbPoolMemberWeight{green, sharedNew}, 4)
+	weights := make([][]int64, 0, len(sets))
+	for _, set := range sets {
+		setWeights := []int64{}
+		for _, member := range set {
+			setWeights = append(setWeights, member.weight)
+		}
+		weights = append(weights, setWeights)
+	}
+	expected := [][]int64{
+		{100, 20, 0},
+		{75, 30, 25},
+		{50, 40, 50},
+		{25, 50, 75},
+		{100, 60},
+	}
+	if !reflect.DeepEqual(weights, expected) {
+		t.Errorf("expected weights %v, got %v", expected, weights)
+	}
+	if last := sets[len(sets)-1]; last[0].key() != green.key() || last[1].key() != sharedNew.key() {
+		t.Errorf("expected the last step to be the new members, got %v", last)
+	}
+}
+
+func TestLBPoolMembersShiftStepsWeightsOnly(t *testing.T) {
+	old := []lbPoolMemberWeight{{port: 443, targetAddress: "10.240.0.4", weight: 0}}
+	new := []lbPoolMemberWeight{{port: 443, targetAddress: "10.240.0.4", weight: 100}}
+	sets := lbPoolMembersShiftSteps(old, new, 2)
+	if len(sets) != 2 || sets[0][0].weight != 50 || sets[1][0].weight != 100 {
+		t.Errorf("unexpected steps %v", sets)
+	}
+}
@@ -259,7 +259,7 @@ func resourceIBMIsBackupPolicyCreate(context context.Context, d *schema.Resource
 	backupPolicyIntf, response, err := vpcClient.CreateBackupPolicyWithContext(context, createBackupPolicyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateBackupPolicyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] CreateBackupPolicyWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateBackupPolicyWithContext failed", err, response).Diagnostics()
 	}
 
 	backupPolicy := backupPolicyIntf.(*vpcv1.BackupPolicy)
@@ -285,7 +285,7 @@ func resourceIBMIsBackupPolicyRead(context context.Context, d *schema.ResourceDa
 			return nil
 		}
 		log.Printf("[DEBUG] GetBackupPolicyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetBackupPolicyWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetBackupPolicyWithContext failed", err, response).Diagnostics()
 	}
 	backupPolicy := backupPolicyIntf.(*vpcv1.BackupPolicy)
 
@@ -430,7 +430,7 @@ func resourceIBMIsBackupPolicyUpdate(context context.Context, d *schema.Resource
 		_, response, err := vpcClient.UpdateBackupPolicyWithContext(context, updateBackupPolicyOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateBackupPolicyWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] UpdateBackupPolicyWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateBackupPolicyWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -448,7 +448,7 @@ func resourceIBMIsBackupPolicyDelete(context context.Context, d *schema.Resource
 	_, response, err := vpcClient.DeleteBackupPolicyWithContext(context, deleteBackupPolicyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteBackupPolicyWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] DeleteBackupPolicyWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteBackupPolicyWithContext failed", err, response).Diagnostics()
 	}
 	d.SetId("")
 	return nil
@@ -9,7 +9,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 	"strings"
 	"time"
@@ -52,6 +51,7 @@ const (
 	isBareMetalServerName                                = "name"
 	isBareMetalServerNetworkInterfaces                   = "network_interfaces"
 	isBareMetalServerPrimaryNetworkInterface             = "primary_network_interface"
+	isBareMetalServerPrimaryNetworkAttachment            = "primary_network_attachment"
 	isBareMetalServerProfile                             = "profile"
 	isBareMetalServerResourceGroup                       = "resource_group"
 	isBareMetalServerResourceType                        = "resource_type"
@@ -109,7 +109,7 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -272,11 +272,13 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 				Description: "Enables stopping type of the bare metal server before deleting",
 			},
 			isBareMetalServerPrimaryNetworkInterface: {
-				Type:        schema.TypeList,
-				MinItems:    1,
-				MaxItems:    1,
-				Required:    true,
-				Description: "Primary Network interface info",
+				Type:         schema.TypeList,
+				MinItems:     1,
+				MaxItems:     1,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{isBareMetalServerPrimaryNetworkInterface, isBareMetalServerPrimaryNetworkAttachment},
+				Description:  "Primary Network interface info",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -390,6 +392,50 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 				},
 			},
 
+			isBareMetalServerPrimaryNetworkAttachment: {
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{isBareMetalServerNetworkInterfaces},
+				ExactlyOneOf:  []string{isBareMetalServerPrimaryNetworkInterface, isBareMetalServerPrimaryNetworkAttachment},
+				Description:   "The primary network attachment of the bare metal server, which attaches an existing virtual network interface",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the network attachment",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The name of the network attachment",
+						},
+						"virtual_network_interface": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The unique identifier of the virtual network interface to attach",
+						},
+						"allowed_vlans": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Set:         schema.HashInt,
+							Description: "The VLAN IDs allowed for `vlan` attachments using the primary network attachment",
+						},
+						"subnet": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The subnet of the virtual network interface",
+						},
+					},
+				},
+			},
+
 			isBareMetalServerNetworkInterfaces: {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -615,6 +661,20 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the Bare metal server",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 
 			isBareMetalServerAccessTags: {
 				Type:        schema.TypeSet,
@@ -745,7 +805,24 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 		options.Name = &nameStr
 	}
 
-	if primnicintf, ok := d.GetOk(isBareMetalServerPrimaryNetworkInterface); ok && len(primnicintf.([]interface{})) > 0 {
+	if vniID, ok := d.GetOk("primary_network_attachment.0.virtual_network_interface"); ok {
+		vniIDStr := vniID.(string)
+		interfaceType := "pci"
+		primaryNetworkAttachment := &vpcv1.BareMetalServerPrimaryNetworkAttachmentPrototype{
+			InterfaceType: &interfaceType,
+			VirtualNetworkInterface: &vpcv1.BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterface{
+				ID: &vniIDStr,
+			},
+		}
+		if name, ok := d.GetOk("primary_network_attachment.0.name"); ok {
+			nameStr := name.(string)
+			primaryNetworkAttachment.Name = &nameStr
+		}
+		if allowedVlans, ok := d.GetOk("primary_network_attachment.0.allowed_vlans"); ok {
+			primaryNetworkAttachment.AllowedVlans = expandBareMetalServerNetworkAttachmentAllowedVlans(allowedVlans.(*schema.Set))
+		}
+		options.PrimaryNetworkAttachment = primaryNetworkAttachment
+	} else if primnicintf, ok := d.GetOk(isBareMetalServerPrimaryNetworkInterface); ok && len(primnicintf.([]interface{})) > 0 {
 		primnic := primnicintf.([]interface{})[0].(map[string]interface{})
 		subnetintf, _ := primnic[isBareMetalServerNicSubnet]
 		subnetintfstr := subnetintf.(string)
@@ -1304,16 +1381,15 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 	createbmsoptions.BareMetalServerPrototype = options
 	bms, response, err := sess.CreateBareMetalServerWithContext(context, createbmsoptions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[DEBUG] Create bare metal server err %s\n%s", err, response))
+		return flex.NewServiceError("Error creating Bare Metal Server", err, response).Diagnostics()
 	}
 	d.SetId(*bms.ID)
 	log.Printf("[INFO] Bare Metal Server : %s", *bms.ID)
 	_, err = isWaitForBareMetalServerAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isBareMetalServerTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isBareMetalServerTags) {
 		oldList, newList := d.GetChange(isBareMetalServerTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *bms.CRN, "", isBareMetalServerUserTagType)
 		if err != nil {
@@ -1321,7 +1397,7 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 				"[ERROR] Error on create of resource bare metal server (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isBareMetalServerAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isBareMetalServerAccessTags) {
 		oldList, newList := d.GetChange(isBareMetalServerAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *bms.CRN, "", isBareMetalServerAccessTagType)
 		if err != nil {
@@ -1337,7 +1413,7 @@ func resourceIBMISBareMetalServerRead(context context.Context, d *schema.Resourc
 	id := d.Id()
 	err := bareMetalServerGet(context, d, meta, id)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 
 	return nil
@@ -1357,7 +1433,7 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server (%s)", id), err, response)
 	}
 	d.SetId(*bms.ID)
 	d.Set(isBareMetalServerBandwidth, bms.Bandwidth)
@@ -1423,7 +1499,7 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) initialization: %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server (%s) initialization", id), err, response)
 	}
 	if bmsinitialization != nil && bmsinitialization.Image.ID != nil {
 		d.Set(isBareMetalServerImage, *bmsinitialization.Image.ID)
@@ -1438,6 +1514,36 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 		d.Set(isBareMetalServerKeys, keyList)
 	}
 
+	if bms.PrimaryNetworkAttachment != nil {
+		getAttachmentOptions := &vpcv1.GetBareMetalServerNetworkAttachmentOptions{
+			BareMetalServerID: &id,
+			ID:                bms.PrimaryNetworkAttachment.ID,
+		}
+		attachmentIntf, response, err := sess.GetBareMetalServerNetworkAttachmentWithContext(context, getAttachmentOptions)
+		if err != nil {
+			return flex.NewServiceError("Error getting the primary network attachment of the bare metal server", err, response)
+		}
+		attachment := attachmentIntf.(*vpcv1.BareMetalServerNetworkAttachment)
+		allowedVlans := make([]int, 0, len(attachment.AllowedVlans))
+		for _, vlan := range attachment.AllowedVlans {
+			allowedVlans = append(allowedVlans, int(vlan))
+		}
+		attachmentMap := map[string]interface{}{
+			"id":            *attachment.ID,
+			"allowed_vlans": allowedVlans,
+		}
+		if attachment.Name != nil {
+			attachmentMap["name"] = *attachment.Name
+		}
+		if attachment.VirtualNetworkInterface != nil && attachment.VirtualNetworkInterface.ID != nil {
+			attachmentMap["virtual_network_interface"] = *attachment.VirtualNetworkInterface.ID
+		}
+		if attachment.Subnet != nil && attachment.Subnet.ID != nil {
+			attachmentMap["subnet"] = *attachment.Subnet.ID
+		}
+		d.Set(isBareMetalServerPrimaryNetworkAttachment, []map[string]interface{}{attachmentMap})
+	}
+
 	//pni
 
 	if bms.PrimaryNetworkInterface != nil {
@@ -1454,7 +1560,7 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 		bmsnic, response, err := sess.GetBareMetalServerNetworkInterfaceWithContext(context, getnicoptions)
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting primary network interface attached to the bare metal server %s\n%s", err, response)
+			return flex.NewServiceError("Error getting primary network interface attached to the bare metal server", err, response)
 		}
 
 		if bms.PrimaryNetworkInterface.PrimaryIP != nil {
@@ -1485,7 +1591,7 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 			}
 			bmsRip, response, err := sess.GetSubnetReservedIP(getripoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the bare metal server primary network interface(%s): %s\n%s", *bms.PrimaryNetworkInterface.PrimaryIP.ID, *bms.PrimaryNetworkInterface.ID, err, response)
+				return flex.NewServiceError(fmt.Sprintf("Error getting network interface reserved ip(%s) attached to the bare metal server primary network interface(%s)", *bms.PrimaryNetworkInterface.PrimaryIP.ID, *bms.PrimaryNetworkInterface.ID), err, response)
 			}
 			currentIP[isBareMetalServerNicIpAutoDelete] = bmsRip.AutoDelete
 		}
@@ -1572,7 +1678,7 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 				}
 				bmsnicintf, response, err := sess.GetBareMetalServerNetworkInterfaceWithContext(context, getnicoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error getting network interface(%s) attached to the bare metal server(%s) %s\n%s", nicId, id, err, response)
+					return flex.NewServiceError(fmt.Sprintf("Error getting network interface(%s) attached to the bare metal server(%s)", nicId, id), err, response)
 				}
 				if intfc.PrimaryIP != nil {
 					primaryIpList := make([]map[string]interface{}, 0)
@@ -1595,7 +1701,7 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 						}
 						bmsRip, response, err := sess.GetSubnetReservedIP(getripoptions)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the bare metal server network interface(%s): %s\n%s", ripId, nicId, err, response)
+							return flex.NewServiceError(fmt.Sprintf("Error getting network interface reserved ip(%s) attached to the bare metal server network interface(%s)", ripId, nicId), err, response)
 						}
 						if bmsRip.AutoDelete != nil {
 							currentIP[isBareMetalServerNicIpAutoDelete] = *bmsRip.AutoDelete
@@ -1704,14 +1810,14 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 		log.Printf(
 			"[ERROR] Error on get of resource bare metal server (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isBareMetalServerTags, tags)
+	flex.SetResourceTags(d, meta, isBareMetalServerTags, isBareMetalServerUserTagType, tags)
 
 	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *bms.CRN, "", isBareMetalServerAccessTagType)
 	if err != nil {
 		log.Printf(
 			"[ERROR] Error on get of resource bare metal server (%s) access tags: %s", d.Id(), err)
 	}
-	d.Set(isBareMetalServerAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isBareMetalServerAccessTags, isBareMetalServerAccessTagType, accesstags)
 
 	return nil
 }
@@ -1722,7 +1828,7 @@ func resourceIBMISBareMetalServerUpdate(context context.Context, d *schema.Resou
 
 	err := bareMetalServerUpdate(context, d, meta, id)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 
 	return resourceIBMISBareMetalServerRead(context, d, meta)
@@ -1734,7 +1840,34 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		return err
 	}
 	isServerStopped := false
-	if d.HasChange(isBareMetalServerTags) || d.HasChange(isBareMetalServerAccessTags) {
+	if d.HasChanges("primary_network_attachment.0.name", "primary_network_attachment.0.allowed_vlans") {
+		attachmentID := d.Get("primary_network_attachment.0.id").(string)
+		attachmentPatchModel := &vpcv1.BareMetalServerNetworkAttachmentPatch{}
+		if d.HasChange("primary_network_attachment.0.name") {
+			name := d.Get("primary_network_attachment.0.name").(string)
+			attachmentPatchModel.Name = &name
+		}
+		if d.HasChange("primary_network_attachment.0.allowed_vlans") {
+			attachmentPatchModel.AllowedVlans = expandBareMetalServerNetworkAttachmentAllowedVlans(d.Get("primary_network_attachment.0.allowed_vlans").(*schema.Set))
+		}
+		attachmentPatch, err := attachmentPatchModel.AsPatch()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error calling asPatch for BareMetalServerNetworkAttachmentPatch: %s", err)
+		}
+		if d.HasChange("primary_network_attachment.0.allowed_vlans") && attachmentPatchModel.AllowedVlans == nil {
+			attachmentPatch["allowed_vlans"] = []int64{}
+		}
+		updateAttachmentOptions := &vpcv1.UpdateBareMetalServerNetworkAttachmentOptions{
+			BareMetalServerID:                     &id,
+			ID:                                    &attachmentID,
+			BareMetalServerNetworkAttachmentPatch: attachmentPatch,
+		}
+		_, response, err := sess.UpdateBareMetalServerNetworkAttachmentWithContext(context, updateAttachmentOptions)
+		if err != nil {
+			return flex.NewServiceError("Error updating the primary network attachment of the bare metal server", err, response)
+		}
+	}
+	if flex.TagsHasChange(d, isBareMetalServerTags) || flex.AccessTagsHasChange(d, isBareMetalServerAccessTags) {
 		bmscrn := d.Get(isBareMetalServerCRN).(string)
 		if bmscrn == "" {
 			options := &vpcv1.GetBareMetalServerOptions{
@@ -1746,11 +1879,11 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 					d.SetId("")
 					return nil
 				}
-				return fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s): %s\n%s", id, err, response)
+				return flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server (%s)", id), err, response)
 			}
 			bmscrn = *bms.CRN
 		}
-		if d.HasChange(isBareMetalServerTags) {
+		if flex.TagsHasChange(d, isBareMetalServerTags) {
 			oldList, newList := d.GetChange(isBareMetalServerTags)
 			err = flex.UpdateTagsUsingCRN(oldList, newList, meta, bmscrn)
 			if err != nil {
@@ -1758,7 +1891,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 					"[ERROR] Error on update of vpc Bare metal server (%s) tags: %s", id, err)
 			}
 		}
-		if d.HasChange(isBareMetalServerAccessTags) {
+		if flex.AccessTagsHasChange(d, isBareMetalServerAccessTags) {
 			oldList, newList := d.GetChange(isBareMetalServerAccessTags)
 			err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, bmscrn, "", isBareMetalServerAccessTagType)
 			if err != nil {
@@ -1828,7 +1961,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 						updatepnicfoptions.BareMetalServerNetworkInterfacePatch = networkInterfacePatch
 						_, response, err := sess.UpdateBareMetalServerNetworkInterface(updatepnicfoptions)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error while updating network interface(%s) of bar emetal server(%s) \n%s: %q", networkId, d.Id(), err, response)
+							return flex.NewServiceError(fmt.Sprintf("Error while updating network interface(%s) of bar emetal server(%s)", networkId, d.Id()), err, response)
 						}
 						ns.Remove(nA)
 						os.Remove(oA)
@@ -2268,7 +2401,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		// 		updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 		// 		_, response, err := sess.UpdateSubnetReservedIP(updateripoptions)
 		// 		if err != nil {
-		// 			return fmt.Errorf("[ERROR] Error updating bare metal server network interface reserved ip(%s): %s\n%s", ripId, err, response)
+		// 			return flex.NewServiceError(fmt.Sprintf("Error updating bare metal server network interface reserved ip(%s)", ripId), err, response)
 		// 		}
 		// 	}
 
@@ -2288,7 +2421,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		// 				}
 		// 				_, response, err := sess.CreateSecurityGroupTargetBinding(createsgnicoptions)
 		// 				if err != nil {
-		// 					return fmt.Errorf("[ERROR] Error while creating security group %q for network interface of bare metal server %s\n%s: %q", add[i], d.Id(), err, response)
+		// 					return flex.NewServiceError(fmt.Sprintf("Error while creating security group %q for network interface of bare metal server %s", add[i], d.Id()), err, response)
 		// 				}
 		// 			}
 
@@ -2303,7 +2436,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		// 				}
 		// 				response, err := sess.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
 		// 				if err != nil {
-		// 					return fmt.Errorf("[ERROR] Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+		// 					return flex.NewServiceError(fmt.Sprintf("Error while removing security group %q for network interface of instance %s", remove[i], d.Id()), err, response)
 		// 				}
 		// 			}
 		// 		}
@@ -2366,7 +2499,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 			updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 			_, response, err := sess.UpdateSubnetReservedIP(updateripoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error updating bare metal server primary network interface reserved ip(%s): %s\n%s", ripId, err, response)
+				return flex.NewServiceError(fmt.Sprintf("Error updating bare metal server primary network interface reserved ip(%s)", ripId), err, response)
 			}
 		}
 		bmsNicUpdateOptions := &vpcv1.UpdateBareMetalServerNetworkInterfaceOptions{
@@ -2417,7 +2550,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 					}
 					_, response, err := sess.CreateSecurityGroupTargetBinding(createsgnicoptions)
 					if err != nil {
-						return fmt.Errorf("[ERROR] Error while creating security group %q for primary network interface of bare metal server %s\n%s: %q", add[i], d.Id(), err, response)
+						return flex.NewServiceError(fmt.Sprintf("Error while creating security group %q for primary network interface of bare metal server %s", add[i], d.Id()), err, response)
 					}
 					_, err = isWaitForBareMetalServerAvailable(sess, id, d.Timeout(schema.TimeoutUpdate), d)
 					if err != nil {
@@ -2435,7 +2568,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 					}
 					response, err := sess.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
 					if err != nil {
-						return fmt.Errorf("[ERROR] Error while removing security group %q for primary network interface of bare metal server %s\n%s: %q", remove[i], d.Id(), err, response)
+						return flex.NewServiceError(fmt.Sprintf("Error while removing security group %q for primary network interface of bare metal server %s", remove[i], d.Id()), err, response)
 					}
 					_, err = isWaitForBareMetalServerAvailable(sess, id, d.Timeout(schema.TimeoutUpdate), d)
 					if err != nil {
@@ -2483,7 +2616,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		options.BareMetalServerPatch = bmsPatch
 		_, response, err := sess.UpdateBareMetalServerWithContext(context, options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating Bare Metal Server: %s\n%s", err, response)
+			return flex.NewServiceError("Error updating Bare Metal Server", err, response)
 		}
 	}
 
@@ -2519,7 +2652,7 @@ func resourceIBMISBareMetalServerDelete(context context.Context, d *schema.Resou
 	}
 	err := bareMetalServerDelete(context, d, meta, id, deleteType)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 
 	return nil
@@ -2539,7 +2672,7 @@ func bareMetalServerDelete(context context.Context, d *schema.ResourceData, meta
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Bare Metal Server (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Bare Metal Server (%s)", id), err, response)
 	}
 	if *bms.Status == "running" {
 
@@ -2550,7 +2683,7 @@ func bareMetalServerDelete(context context.Context, d *schema.ResourceData, meta
 
 		response, err := sess.StopBareMetalServerWithContext(context, options)
 		if err != nil && response != nil && response.StatusCode != 204 {
-			return fmt.Errorf("[ERROR] Error stopping Bare Metal Server (%s): %s\n%s", id, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error stopping Bare Metal Server (%s)", id), err, response)
 		}
 		isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutDelete), id, d)
 
@@ -2560,7 +2693,7 @@ func bareMetalServerDelete(context context.Context, d *schema.ResourceData, meta
 	}
 	response, err = sess.DeleteBareMetalServerWithContext(context, options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Bare Metal Server : %s\n%s", err, response)
+		return flex.NewServiceError("Error Deleting Bare Metal Server", err, response)
 	}
 	_, err = isWaitForBareMetalServerDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -2595,7 +2728,7 @@ func isBareMetalServerDeleteRefreshFunc(bmsC *vpcv1.VpcV1, id string) resource.S
 			if response != nil && response.StatusCode == 404 {
 				return bms, isBareMetalServerActionDeleted, nil
 			}
-			return bms, "", fmt.Errorf("[ERROR] Error Getting Bare Metal Server: %s\n%s", err, response)
+			return bms, "", flex.NewServiceError("Error Getting Bare Metal Server", err, response)
 		}
 		if *bms.Status == isBareMetalServerStatusFailed {
 			return bms, *bms.Status, fmt.Errorf("[ERROR] The Bare Metal Server (%s) failed to delete: %v", *bms.ID, err)
@@ -2625,7 +2758,7 @@ func isBareMetalServerRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.Reso
 		}
 		bms, response, err := client.GetBareMetalServer(bmsgetoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting Bare Metal Server: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error getting Bare Metal Server", err, response)
 		}
 		d.Set(isBareMetalServerStatus, *bms.Status)
 
@@ -2683,7 +2816,7 @@ func isWaitForBareMetalServerActionStop(bmsC *vpcv1.VpcV1, timeout time.Duration
 			}
 			bms, response, err := bmsC.GetBareMetalServer(getbmsoptions)
 			if err != nil {
-				return nil, "", fmt.Errorf("[ERROR] Error Getting Bare Metal Server: %s\n%s", err, response)
+				return nil, "", flex.NewServiceError("Error Getting Bare Metal Server", err, response)
 			}
 			select {
 			case data := <-communicator:
@@ -2720,7 +2853,7 @@ func isBareMetalServerRestartStopAction(bmsC *vpcv1.VpcV1, id string, d *schema.
 			}
 			response, err := bmsC.StopBareMetalServer(createbmssactoptions)
 			if err != nil {
-				communicator <- fmt.Errorf("[ERROR] Error retrying Bare Metal Server action stop: %s\n%s", err, response)
+				communicator <- flex.NewServiceError("Error retrying Bare Metal Server action stop", err, response)
 				return
 			}
 		case <-communicator:
@@ -2741,7 +2874,7 @@ func isBareMetalServerStart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData
 		if response != nil && response.StatusCode == 404 {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("[ERROR] Error creating Bare Metal Server action start : %s\n%s", err, response)
+		return nil, flex.NewServiceError("Error creating Bare Metal Server action start", err, response)
 	}
 	_, err = isWaitForBareMetalServerAvailable(bmsC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
@@ -2760,7 +2893,7 @@ func isBareMetalServerStop(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData,
 		if response != nil && response.StatusCode == 404 {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("[ERROR] Error creating Bare Metal Server Action stop: %s\n%s", err, response)
+		return nil, flex.NewServiceError("Error creating Bare Metal Server Action stop", err, response)
 	}
 	_, err = isWaitForBareMetalServerActionStop(bmsC, d.Timeout(schema.TimeoutUpdate), d.Id(), d)
 	if err != nil {
@@ -2777,7 +2910,7 @@ func isBareMetalServerRestart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 		if response != nil && response.StatusCode == 404 {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("[ERROR] Error creating Bare Metal Server action restart: %s\n%s", err, response)
+		return nil, flex.NewServiceError("Error creating Bare Metal Server action restart", err, response)
 	}
 	_, err = isWaitForBareMetalServerAvailable(bmsC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
@@ -2805,7 +2938,7 @@ func resourceStopServerIfRunning(id, stoppingType string, d *schema.ResourceData
 		if response != nil && response.StatusCode == 404 {
 			return isServerStopped, nil
 		}
-		return isServerStopped, fmt.Errorf("[ERROR] Error Getting Bare Metal Server (%s): %s\n%s", id, err, response)
+		return isServerStopped, flex.NewServiceError(fmt.Sprintf("Error Getting Bare Metal Server (%s)", id), err, response)
 	}
 	if *bms.Status == "running" {
 
@@ -2816,7 +2949,7 @@ func resourceStopServerIfRunning(id, stoppingType string, d *schema.ResourceData
 
 		response, err := sess.StopBareMetalServerWithContext(context, options)
 		if err != nil && response != nil && response.StatusCode != 204 {
-			return isServerStopped, fmt.Errorf("[ERROR] Error stopping Bare Metal Server (%s): %s\n%s", id, err, response)
+			return isServerStopped, flex.NewServiceError(fmt.Sprintf("Error stopping Bare Metal Server (%s)", id), err, response)
 		}
 		isServerStopped = true
 		isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutDelete), id, d)
@@ -2833,7 +2966,7 @@ func resourceStartServerIfStopped(id, stoppingType string, d *schema.ResourceDat
 		if response != nil && response.StatusCode == 404 {
 			return isServerStopped, nil
 		}
-		return isServerStopped, fmt.Errorf("[ERROR] Error Getting Bare Metal Server (%s): %s\n%s", id, err, response)
+		return isServerStopped, flex.NewServiceError(fmt.Sprintf("Error Getting Bare Metal Server (%s)", id), err, response)
 	}
 	if *bms.Status == "stopped" {
 
@@ -2845,7 +2978,7 @@ func resourceStartServerIfStopped(id, stoppingType string, d *schema.ResourceDat
 			if response != nil && response.StatusCode == 404 {
 				return isServerStopped, nil
 			}
-			return isServerStopped, fmt.Errorf("[ERROR] Error creating Bare Metal Server action start : %s\n%s", err, response)
+			return isServerStopped, flex.NewServiceError("Error creating Bare Metal Server action start", err, response)
 		}
 		isServerStopped = true
 		_, err = isWaitForBareMetalServerAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
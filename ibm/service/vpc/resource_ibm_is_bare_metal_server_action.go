@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -180,7 +181,7 @@ func resourceIBMISBareMetalServerActionCreate(context context.Context, d *schema
 	d.SetId(bareMetalServerId)
 	err = bareMetalServerActionGet(context, sess, bareMetalServerId, d)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 	return nil
 }
@@ -193,7 +194,7 @@ func resourceIBMISBareMetalServerActionRead(context context.Context, d *schema.R
 	id := d.Id()
 	err = bareMetalServerActionGet(context, sess, id, d)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 	return nil
 }
@@ -208,7 +209,7 @@ func bareMetalServerActionGet(context context.Context, sess *vpcv1.VpcV1, id str
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server (%s)", id), err, response)
 	}
 	d.SetId(*bms.ID)
 	d.Set(isBareMetalServerStatus, *bms.Status)
@@ -292,7 +293,7 @@ func resourceIBMISBareMetalServerActionUpdate(context context.Context, d *schema
 		}
 		err = bareMetalServerActionGet(context, sess, bareMetalServerId, d)
 		if err != nil {
-			return diag.FromErr(err)
+			return flex.DiagFromErr(err)
 		}
 	}
 	return nil
@@ -324,7 +325,7 @@ func isBareMetalServerActionRefreshFunc(client *vpcv1.VpcV1, id string, d *schem
 		}
 		bms, response, err := client.GetBareMetalServer(bmsgetoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting Bare Metal Server: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error getting Bare Metal Server", err, response)
 		}
 		d.Set(isBareMetalServerStatus, *bms.Status)
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -100,12 +101,12 @@ func resourceIBMISBareMetalServerDiskCreate(context context.Context, d *schema.R
 	options.BareMetalServerDiskPatch = diskPatch
 	disk, response, err := sess.UpdateBareMetalServerDiskWithContext(context, options)
 	if err != nil || disk == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error updating bare metal server (%s)  disk (%s) err %s\n%s", bareMetalServerId, diskId, err, response))
+		return flex.NewServiceError(fmt.Sprintf("Error updating bare metal server (%s)  disk (%s)", bareMetalServerId, diskId), err, response).Diagnostics()
 	}
 	d.SetId(*disk.ID)
 	err = bareMetalServerDiskGet(context, d, sess, bareMetalServerId, diskId)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 	return nil
 }
@@ -122,7 +123,7 @@ func bareMetalServerDiskGet(context context.Context, d *schema.ResourceData, ses
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error fetching bare metal server (%s)  disk (%s) err %s\n%s", bareMetalServerId, diskId, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error fetching bare metal server (%s)  disk (%s)", bareMetalServerId, diskId), err, response)
 	}
 
 	d.Set(isBareMetalServerID, bareMetalServerId)
@@ -146,7 +147,7 @@ func resourceIBMISBareMetalServerDiskRead(context context.Context, d *schema.Res
 	}
 	err = bareMetalServerDiskGet(context, d, sess, bareMetalServerId, diskId)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 	return nil
 }
@@ -183,11 +184,11 @@ func resourceIBMISBareMetalServerDiskUpdate(context context.Context, d *schema.R
 		options.BareMetalServerDiskPatch = diskPatch
 		disk, response, err := sess.UpdateBareMetalServerDiskWithContext(context, options)
 		if err != nil || disk == nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating bare metal server (%s)  disk (%s) err %s\n%s", bareMetalServerId, diskId, err, response))
+			return flex.NewServiceError(fmt.Sprintf("Error updating bare metal server (%s)  disk (%s)", bareMetalServerId, diskId), err, response).Diagnostics()
 		}
 		err = bareMetalServerDiskGet(context, d, sess, bareMetalServerId, diskId)
 		if err != nil {
-			return diag.FromErr(err)
+			return flex.DiagFromErr(err)
 		}
 	}
 	return nil
@@ -0,0 +1,493 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func ResourceIBMIsBareMetalServerNetworkAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsBareMetalServerNetworkAttachmentCreate,
+		ReadContext:   resourceIBMIsBareMetalServerNetworkAttachmentRead,
+		UpdateContext: resourceIBMIsBareMetalServerNetworkAttachmentUpdate,
+		DeleteContext: resourceIBMIsBareMetalServerNetworkAttachmentDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bare_metal_server": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The bare metal server identifier.",
+			},
+			"virtual_network_interface": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The identifier of the virtual network interface to attach to the bare metal server.",
+			},
+			"interface_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server_network_attachment", "interface_type"),
+				Description:  "The network attachment's interface type, `pci` or `vlan`.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server_network_attachment", "name"),
+				Description:  "The name for this bare metal server network attachment. The name is unique across all network attachments for the bare metal server.",
+			},
+			"allowed_vlans": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeInt},
+				Set:           schema.HashInt,
+				ConflictsWith: []string{"vlan", "allow_to_float"},
+				Description:   "The VLAN IDs to allow for `vlan` attachments using this `pci` attachment.",
+			},
+			"allow_to_float": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"allowed_vlans"},
+				Description:   "Indicates if the `vlan` attachment can automatically float to any other server within the same `resource_group`.",
+			},
+			"vlan": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"allowed_vlans"},
+				Description:   "The VLAN ID used in the IEEE 802.1Q tag present in all traffic on this `vlan` attachment.",
+			},
+			"network_attachment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The bare metal server network attachment identifier.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the bare metal server network attachment was created.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this bare metal server network attachment.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the bare metal server network attachment.",
+			},
+			"port_speed": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The port speed for this bare metal server network attachment in Mbps.",
+			},
+			"primary_ip": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The primary IP address of the virtual network interface for the bare metal server network attachment.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this reserved IP.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this reserved IP.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name for this reserved IP.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type.",
+						},
+					},
+				},
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+			"subnet": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subnet of the virtual network interface for the bare metal server network attachment.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The bare metal server network attachment type.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsBareMetalServerNetworkAttachmentValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9]|[0-9][-a-z0-9]*([a-z]|[-a-z][-a-z0-9]*[a-z0-9]))$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "interface_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "pci, vlan",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_bare_metal_server_network_attachment", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsBareMetalServerNetworkAttachmentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bareMetalServerID := d.Get("bare_metal_server").(string)
+	vniID := d.Get("virtual_network_interface").(string)
+	interfaceType := d.Get("interface_type").(string)
+	prototype := &vpcv1.BareMetalServerNetworkAttachmentPrototype{
+		InterfaceType: &interfaceType,
+		VirtualNetworkInterface: &vpcv1.BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterface{
+			ID: &vniID,
+		},
+	}
+	if name, ok := d.GetOk("name"); ok {
+		prototype.Name = core.StringPtr(name.(string))
+	}
+	if interfaceType == "pci" {
+		if allowedVlans, ok := d.GetOk("allowed_vlans"); ok {
+			prototype.AllowedVlans = expandBareMetalServerNetworkAttachmentAllowedVlans(allowedVlans.(*schema.Set))
+		}
+	} else {
+		if vlan, ok := d.GetOk("vlan"); ok {
+			prototype.Vlan = core.Int64Ptr(int64(vlan.(int)))
+		} else {
+			return diag.FromErr(fmt.Errorf("[ERROR] vlan is required with the vlan interface_type"))
+		}
+		if allowToFloat, ok := d.GetOkExists("allow_to_float"); ok {
+			prototype.AllowToFloat = core.BoolPtr(allowToFloat.(bool))
+		}
+	}
+	createBareMetalServerNetworkAttachmentOptions := &vpcv1.CreateBareMetalServerNetworkAttachmentOptions{
+		BareMetalServerID:                         &bareMetalServerID,
+		BareMetalServerNetworkAttachmentPrototype: prototype,
+	}
+
+	networkAttachmentIntf, response, err := sess.CreateBareMetalServerNetworkAttachmentWithContext(context, createBareMetalServerNetworkAttachmentOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateBareMetalServerNetworkAttachmentWithContext failed %s\n%s", err, response)
+		return flex.NewServiceError("CreateBareMetalServerNetworkAttachmentWithContext failed", err, response).Diagnostics()
+	}
+	networkAttachment := networkAttachmentIntf.(*vpcv1.BareMetalServerNetworkAttachment)
+
+	d.SetId(fmt.Sprintf("%s/%s", bareMetalServerID, *networkAttachment.ID))
+
+	_, err = isWaitForBareMetalServerNetworkAttachmentStable(context, sess, bareMetalServerID, *networkAttachment.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsBareMetalServerNetworkAttachmentRead(context, d, meta)
+}
+
+func resourceIBMIsBareMetalServerNetworkAttachmentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getBareMetalServerNetworkAttachmentOptions := &vpcv1.GetBareMetalServerNetworkAttachmentOptions{}
+	getBareMetalServerNetworkAttachmentOptions.SetBareMetalServerID(parts[0])
+	getBareMetalServerNetworkAttachmentOptions.SetID(parts[1])
+
+	networkAttachmentIntf, response, err := sess.GetBareMetalServerNetworkAttachmentWithContext(context, getBareMetalServerNetworkAttachmentOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetBareMetalServerNetworkAttachmentWithContext failed %s\n%s", err, response)
+		return flex.NewServiceError("GetBareMetalServerNetworkAttachmentWithContext failed", err, response).Diagnostics()
+	}
+	networkAttachment := networkAttachmentIntf.(*vpcv1.BareMetalServerNetworkAttachment)
+
+	if err = d.Set("bare_metal_server", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting bare_metal_server: %s", err))
+	}
+	if err = d.Set("network_attachment", networkAttachment.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting network_attachment: %s", err))
+	}
+	if networkAttachment.VirtualNetworkInterface != nil {
+		if err = d.Set("virtual_network_interface", networkAttachment.VirtualNetworkInterface.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting virtual_network_interface: %s", err))
+		}
+	}
+	if err = d.Set("interface_type", networkAttachment.InterfaceType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting interface_type: %s", err))
+	}
+	if err = d.Set("name", networkAttachment.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	allowedVlans := make([]int, 0, len(networkAttachment.AllowedVlans))
+	for _, vlan := range networkAttachment.AllowedVlans {
+		allowedVlans = append(allowedVlans, int(vlan))
+	}
+	if err = d.Set("allowed_vlans", allowedVlans); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting allowed_vlans: %s", err))
+	}
+	if networkAttachment.AllowToFloat != nil {
+		if err = d.Set("allow_to_float", networkAttachment.AllowToFloat); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting allow_to_float: %s", err))
+		}
+	}
+	if networkAttachment.Vlan != nil {
+		if err = d.Set("vlan", flex.IntValue(networkAttachment.Vlan)); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting vlan: %s", err))
+		}
+	}
+	if err = d.Set("created_at", flex.DateTimeToString(networkAttachment.CreatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_at: %s", err))
+	}
+	if err = d.Set("href", networkAttachment.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting href: %s", err))
+	}
+	if err = d.Set("lifecycle_state", networkAttachment.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting lifecycle_state: %s", err))
+	}
+	if err = d.Set("port_speed", flex.IntValue(networkAttachment.PortSpeed)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting port_speed: %s", err))
+	}
+	primaryIP := []map[string]interface{}{}
+	if networkAttachment.PrimaryIP != nil {
+		modelMap, err := dataSourceIBMIsVirtualNetworkInterfaceReservedIPReferenceToMap(networkAttachment.PrimaryIP)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		delete(modelMap, "deleted")
+		primaryIP = append(primaryIP, modelMap)
+	}
+	if err = d.Set("primary_ip", primaryIP); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting primary_ip: %s", err))
+	}
+	if err = d.Set("resource_type", networkAttachment.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_type: %s", err))
+	}
+	if networkAttachment.Subnet != nil {
+		if err = d.Set("subnet", networkAttachment.Subnet.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting subnet: %s", err))
+		}
+	}
+	if err = d.Set("type", networkAttachment.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting type: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIsBareMetalServerNetworkAttachmentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hasChange := false
+	networkAttachmentPatchModel := &vpcv1.BareMetalServerNetworkAttachmentPatch{}
+	if d.HasChange("name") {
+		networkAttachmentPatchModel.Name = core.StringPtr(d.Get("name").(string))
+		hasChange = true
+	}
+	if d.HasChange("allowed_vlans") {
+		networkAttachmentPatchModel.AllowedVlans = expandBareMetalServerNetworkAttachmentAllowedVlans(d.Get("allowed_vlans").(*schema.Set))
+		hasChange = true
+	}
+	if hasChange {
+		networkAttachmentPatch, err := networkAttachmentPatchModel.AsPatch()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for BareMetalServerNetworkAttachmentPatch: %s", err))
+		}
+		if d.HasChange("allowed_vlans") && networkAttachmentPatchModel.AllowedVlans == nil {
+			networkAttachmentPatch["allowed_vlans"] = []int64{}
+		}
+		updateBareMetalServerNetworkAttachmentOptions := &vpcv1.UpdateBareMetalServerNetworkAttachmentOptions{
+			BareMetalServerID:                     &parts[0],
+			ID:                                    &parts[1],
+			BareMetalServerNetworkAttachmentPatch: networkAttachmentPatch,
+		}
+		_, response, err := sess.UpdateBareMetalServerNetworkAttachmentWithContext(context, updateBareMetalServerNetworkAttachmentOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateBareMetalServerNetworkAttachmentWithContext failed %s\n%s", err, response)
+			return flex.NewServiceError("UpdateBareMetalServerNetworkAttachmentWithContext failed", err, response).Diagnostics()
+		}
+		_, err = isWaitForBareMetalServerNetworkAttachmentStable(context, sess, parts[0], parts[1], d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIsBareMetalServerNetworkAttachmentRead(context, d, meta)
+}
+
+func resourceIBMIsBareMetalServerNetworkAttachmentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteBareMetalServerNetworkAttachmentOptions := &vpcv1.DeleteBareMetalServerNetworkAttachmentOptions{}
+	deleteBareMetalServerNetworkAttachmentOptions.SetBareMetalServerID(parts[0])
+	deleteBareMetalServerNetworkAttachmentOptions.SetID(parts[1])
+
+	response, err := sess.DeleteBareMetalServerNetworkAttachmentWithContext(context, deleteBareMetalServerNetworkAttachmentOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteBareMetalServerNetworkAttachmentWithContext failed %s\n%s", err, response)
+		return flex.NewServiceError("DeleteBareMetalServerNetworkAttachmentWithContext failed", err, response).Diagnostics()
+	}
+	_, err = isWaitForBareMetalServerNetworkAttachmentDeleted(context, sess, parts[0], parts[1], d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandBareMetalServerNetworkAttachmentAllowedVlans(allowedVlans *schema.Set) []int64 {
+	if allowedVlans.Len() == 0 {
+		return nil
+	}
+	vlans := make([]int64, 0, allowedVlans.Len())
+	for _, vlan := range allowedVlans.List() {
+		vlans = append(vlans, int64(vlan.(int)))
+	}
+	return vlans
+}
+
+func isWaitForBareMetalServerNetworkAttachmentStable(context context.Context, sess *vpcv1.VpcV1, bareMetalServerID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for bare metal server network attachment (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending", "updating"},
+		Target:  []string{"stable"},
+		Refresh: func() (interface{}, string, error) {
+			getBareMetalServerNetworkAttachmentOptions := &vpcv1.GetBareMetalServerNetworkAttachmentOptions{}
+			getBareMetalServerNetworkAttachmentOptions.SetBareMetalServerID(bareMetalServerID)
+			getBareMetalServerNetworkAttachmentOptions.SetID(id)
+			networkAttachmentIntf, response, err := sess.GetBareMetalServerNetworkAttachmentWithContext(context, getBareMetalServerNetworkAttachmentOptions)
+			if err != nil {
+				return nil, "", flex.NewServiceError("Error getting bare metal server network attachment", err, response)
+			}
+			networkAttachment := networkAttachmentIntf.(*vpcv1.BareMetalServerNetworkAttachment)
+			if *networkAttachment.LifecycleState == "failed" {
+				return networkAttachment, *networkAttachment.LifecycleState, fmt.Errorf("[ERROR] The bare metal server network attachment %s failed", id)
+			}
+			return networkAttachment, *networkAttachment.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func isWaitForBareMetalServerNetworkAttachmentDeleted(context context.Context, sess *vpcv1.VpcV1, bareMetalServerID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for bare metal server network attachment (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"deleting", "stable", "updating"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			getBareMetalServerNetworkAttachmentOptions := &vpcv1.GetBareMetalServerNetworkAttachmentOptions{}
+			getBareMetalServerNetworkAttachmentOptions.SetBareMetalServerID(bareMetalServerID)
+			getBareMetalServerNetworkAttachmentOptions.SetID(id)
+			networkAttachmentIntf, response, err := sess.GetBareMetalServerNetworkAttachmentWithContext(context, getBareMetalServerNetworkAttachmentOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return &vpcv1.BareMetalServerNetworkAttachment{}, "done", nil
+				}
+				return nil, "", flex.NewServiceError("Error getting bare metal server network attachment", err, response)
+			}
+			networkAttachment := networkAttachmentIntf.(*vpcv1.BareMetalServerNetworkAttachment)
+			if *networkAttachment.LifecycleState == "failed" {
+				return networkAttachment, *networkAttachment.LifecycleState, fmt.Errorf("[ERROR] The bare metal server network attachment %s failed to delete", id)
+			}
+			return networkAttachment, "deleting", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestAccIBMIsBareMetalServerNetworkAttachmentVlan(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	bmsname := fmt.Sprintf("tf-bms-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-bmsna-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-bmsna-update-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	terraformTag := "ibm_is_bare_metal_server_network_attachment.testacc_bmsna"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsBareMetalServerNetworkAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsBareMetalServerNetworkAttachmentConfig(vpcname, subnetname, sshname, publicKey, bmsname, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIsBareMetalServerNetworkAttachmentExists(terraformTag),
+					resource.TestCheckResourceAttr(terraformTag, "name", name),
+					resource.TestCheckResourceAttr(terraformTag, "interface_type", "vlan"),
+					resource.TestCheckResourceAttr(terraformTag, "vlan", "100"),
+					resource.TestCheckResourceAttrPair(terraformTag, "virtual_network_interface", "ibm_is_virtual_network_interface.testacc_vni_vlan", "id"),
+					resource.TestCheckResourceAttr("ibm_is_bare_metal_server.testacc_bms", "primary_network_attachment.0.allowed_vlans.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsBareMetalServerNetworkAttachmentConfig(vpcname, subnetname, sshname, publicKey, bmsname, nameUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIsBareMetalServerNetworkAttachmentExists(terraformTag),
+					resource.TestCheckResourceAttr(terraformTag, "name", nameUpdate),
+				),
+			},
+			{
+				ResourceName:      terraformTag,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsBareMetalServerNetworkAttachmentConfig(vpcname, subnetname, sshname, publicKey, bmsname, name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_is_vpc" "testacc_vpc" {
+			name = "%s"
+		}
+
+		resource "ibm_is_subnet" "testacc_subnet" {
+			name                     = "%s"
+			vpc                      = ibm_is_vpc.testacc_vpc.id
+			zone                     = "%s"
+			total_ipv4_address_count = 16
+		}
+
+		resource "ibm_is_ssh_key" "testacc_sshkey" {
+			name       = "%s"
+			public_key = "%s"
+		}
+
+		resource "ibm_is_virtual_network_interface" "testacc_vni_primary" {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+
+		resource "ibm_is_virtual_network_interface" "testacc_vni_vlan" {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+
+		resource "ibm_is_bare_metal_server" "testacc_bms" {
+			profile = "%s"
+			name    = "%s"
+			image   = "%s"
+			zone    = "%s"
+			keys    = [ibm_is_ssh_key.testacc_sshkey.id]
+			primary_network_attachment {
+				virtual_network_interface = ibm_is_virtual_network_interface.testacc_vni_primary.id
+				allowed_vlans             = [100]
+			}
+			vpc = ibm_is_vpc.testacc_vpc.id
+		}
+
+		resource "ibm_is_bare_metal_server_network_attachment" "testacc_bmsna" {
+			bare_metal_server         = ibm_is_bare_metal_server.testacc_bms.id
+			virtual_network_interface = ibm_is_virtual_network_interface.testacc_vni_vlan.id
+			interface_type            = "vlan"
+			vlan                      = 100
+			name                      = "%s"
+		}
+`, vpcname, subnetname, acc.ISZoneName, sshname, publicKey, acc.IsBareMetalServerProfileName, bmsname, acc.IsBareMetalServerImage, acc.ISZoneName, name)
+}
+
+func testAccCheckIBMIsBareMetalServerNetworkAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+
+		getBareMetalServerNetworkAttachmentOptions := &vpcv1.GetBareMetalServerNetworkAttachmentOptions{}
+		getBareMetalServerNetworkAttachmentOptions.SetBareMetalServerID(parts[0])
+		getBareMetalServerNetworkAttachmentOptions.SetID(parts[1])
+
+		_, _, err = vpcClient.GetBareMetalServerNetworkAttachment(getBareMetalServerNetworkAttachmentOptions)
+		return err
+	}
+}
+
+func testAccCheckIBMIsBareMetalServerNetworkAttachmentDestroy(s *terraform.State) error {
+	vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_bare_metal_server_network_attachment" {
+			continue
+		}
+
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+
+		getBareMetalServerNetworkAttachmentOptions := &vpcv1.GetBareMetalServerNetworkAttachmentOptions{}
+		getBareMetalServerNetworkAttachmentOptions.SetBareMetalServerID(parts[0])
+		getBareMetalServerNetworkAttachmentOptions.SetID(parts[1])
+
+		_, response, err := vpcClient.GetBareMetalServerNetworkAttachment(getBareMetalServerNetworkAttachmentOptions)
+		if err == nil {
+			return fmt.Errorf("BareMetalServerNetworkAttachment still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for BareMetalServerNetworkAttachment (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
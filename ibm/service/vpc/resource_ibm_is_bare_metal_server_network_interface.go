@@ -291,7 +291,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceCreate(context context.Context,
 
 		bms, response, err := sess.GetBareMetalServerWithContext(context, getbmsoptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error fetching bare metal server (%s) err %s\n%s", bareMetalServerId, err, response))
+			return flex.NewServiceError(fmt.Sprintf("Error fetching bare metal server (%s)", bareMetalServerId), err, response).Diagnostics()
 		}
 		// failed, pending, restarting, running, starting, stopped, stopping, maintenance
 		if *bms.Status == "failed" {
@@ -308,11 +308,11 @@ func resourceIBMISBareMetalServerNetworkInterfaceCreate(context context.Context,
 			}
 			res, err := sess.StopBareMetalServerWithContext(context, createstopaction)
 			if err != nil || res.StatusCode != 204 {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error stopping bare metal server (%s) err %s\n%s", bareMetalServerId, err, response))
+				return flex.NewServiceError(fmt.Sprintf("Error stopping bare metal server (%s)", bareMetalServerId), err, response).Diagnostics()
 			}
 			_, err = isWaitForBareMetalServerStoppedForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
-				return diag.FromErr(err)
+				return flex.DiagFromErr(err)
 			}
 		} else if *bms.Status != "stopped" {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error bare metal server in %s state, please try after some time", *bms.Status))
@@ -399,7 +399,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceCreate(context context.Context,
 		options.BareMetalServerNetworkInterfacePrototype = nicOptions
 		nic, response, err := sess.CreateBareMetalServerNetworkInterfaceWithContext(context, options)
 		if err != nil || nic == nil {
-			return diag.FromErr(fmt.Errorf("[DEBUG] Create bare metal server (%s) network interface err %s\n%s", bareMetalServerId, err, response))
+			return flex.NewServiceError(fmt.Sprintf("Create bare metal server (%s) network interface", bareMetalServerId), err, response).Diagnostics()
 		}
 		switch reflect.TypeOf(nic).String() {
 		case "*vpcv1.BareMetalServerNetworkInterfaceByPci":
@@ -422,12 +422,12 @@ func resourceIBMISBareMetalServerNetworkInterfaceCreate(context context.Context,
 		log.Printf("[INFO] Bare Metal Server Network Interface : %s", d.Id())
 		nicAfterWait, err := isWaitForBareMetalServerNetworkInterfaceAvailable(sess, bareMetalServerId, nicId, d.Timeout(schema.TimeoutCreate), d)
 		if err != nil {
-			return diag.FromErr(err)
+			return flex.DiagFromErr(err)
 		}
 
 		err = bareMetalServerNICGet(d, meta, sess, nicAfterWait, bareMetalServerId)
 		if err != nil {
-			return diag.FromErr(err)
+			return flex.DiagFromErr(err)
 		}
 
 		// restarting the server after PCI creation
@@ -438,11 +438,11 @@ func resourceIBMISBareMetalServerNetworkInterfaceCreate(context context.Context,
 		}
 		res, err := sess.StartBareMetalServerWithContext(context, createstartaction)
 		if err != nil || res.StatusCode != 204 {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error starting bare metal server (%s) err %s\n%s", bareMetalServerId, err, response))
+			return flex.NewServiceError(fmt.Sprintf("Error starting bare metal server (%s)", bareMetalServerId), err, response).Diagnostics()
 		}
 		_, err = isWaitForBareMetalServerAvailableForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 		if err != nil {
-			return diag.FromErr(err)
+			return flex.DiagFromErr(err)
 		}
 
 	} else if interfaceTypeOk, ok := d.GetOk(isBareMetalServerNicInterfaceType); ok {
@@ -549,7 +549,7 @@ func createVlanTypeNetworkInterface(context context.Context, d *schema.ResourceD
 	options.BareMetalServerNetworkInterfacePrototype = nicOptions
 	nic, response, err := sess.CreateBareMetalServerNetworkInterfaceWithContext(context, options)
 	if err != nil || nic == nil {
-		return fmt.Errorf("[DEBUG] Create bare metal server (%s) network interface err %s\n%s", bareMetalServerId, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Create bare metal server (%s) network interface", bareMetalServerId), err, response)
 	}
 
 	switch reflect.TypeOf(nic).String() {
@@ -666,7 +666,7 @@ func createHiperSocketTypeNetworkInterface(context context.Context, d *schema.Re
 	options.BareMetalServerNetworkInterfacePrototype = nicOptions
 	nic, response, err := sess.CreateBareMetalServerNetworkInterfaceWithContext(context, options)
 	if err != nil || nic == nil {
-		return fmt.Errorf("[DEBUG] Create bare metal server (%s) network interface err %s\n%s", bareMetalServerId, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Create bare metal server (%s) network interface", bareMetalServerId), err, response)
 	}
 	err = bareMetalServerNICGet(d, meta, sess, nic, bareMetalServerId)
 	if err != nil {
@@ -704,11 +704,11 @@ func resourceIBMISBareMetalServerNetworkInterfaceRead(context context.Context, d
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) network interface (%s): %s\n%s", bareMetalServerId, nicID, err, response))
+		return flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server (%s) network interface (%s)", bareMetalServerId, nicID), err, response).Diagnostics()
 	}
 	err = bareMetalServerNICGet(d, meta, sess, nicIntf, bareMetalServerId)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 	return nil
 }
@@ -767,7 +767,7 @@ func bareMetalServerNICGet(d *schema.ResourceData, meta interface{}, sess *vpcv1
 				}
 				bmsRip, response, err := sess.GetSubnetReservedIP(getripoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the bare metal server network interface(%s): %s\n%s", *nic.PrimaryIP.ID, *nic.ID, err, response)
+					return flex.NewServiceError(fmt.Sprintf("Error getting network interface reserved ip(%s) attached to the bare metal server network interface(%s)", *nic.PrimaryIP.ID, *nic.ID), err, response)
 				}
 				currentIP[isBareMetalServerNicIpAutoDelete] = bmsRip.AutoDelete
 				primaryIpList = append(primaryIpList, currentIP)
@@ -844,7 +844,7 @@ func bareMetalServerNICGet(d *schema.ResourceData, meta interface{}, sess *vpcv1
 			}
 			bmsRip, response, err := sess.GetSubnetReservedIP(getripoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the bare metal server network interface(%s): %s\n%s", *nic.PrimaryIP.ID, *nic.ID, err, response)
+				return flex.NewServiceError(fmt.Sprintf("Error getting network interface reserved ip(%s) attached to the bare metal server network interface(%s)", *nic.PrimaryIP.ID, *nic.ID), err, response)
 			}
 			currentIP[isBareMetalServerNicIpAutoDelete] = bmsRip.AutoDelete
 
@@ -921,7 +921,7 @@ func bareMetalServerNICGet(d *schema.ResourceData, meta interface{}, sess *vpcv1
 			}
 			bmsRip, response, err := sess.GetSubnetReservedIP(getripoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the bare metal server network interface(%s): %s\n%s", *nic.PrimaryIP.ID, *nic.ID, err, response)
+				return flex.NewServiceError(fmt.Sprintf("Error getting network interface reserved ip(%s) attached to the bare metal server network interface(%s)", *nic.PrimaryIP.ID, *nic.ID), err, response)
 			}
 			currentIP[isBareMetalServerNicIpAutoDelete] = bmsRip.AutoDelete
 
@@ -972,11 +972,11 @@ func resourceIBMISBareMetalServerNetworkInterfaceUpdate(context context.Context,
 				}
 				_, response, err := sess.CreateSecurityGroupTargetBinding(createsgnicoptions)
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] Error while creating security group %q for network interface of bare metal server %s\n%s: %q", add[i], d.Id(), err, response))
+					return flex.NewServiceError(fmt.Sprintf("Error while creating security group %q for network interface of bare metal server %s", add[i], d.Id()), err, response).Diagnostics()
 				}
 				_, err = isWaitForBareMetalServerAvailableForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
-					return diag.FromErr(err)
+					return flex.DiagFromErr(err)
 				}
 			}
 
@@ -989,11 +989,11 @@ func resourceIBMISBareMetalServerNetworkInterfaceUpdate(context context.Context,
 				}
 				response, err := sess.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] Error while removing security group %q for network interface of bare metal server %s\n%s: %q", remove[i], d.Id(), err, response))
+					return flex.NewServiceError(fmt.Sprintf("Error while removing security group %q for network interface of bare metal server %s", remove[i], d.Id()), err, response).Diagnostics()
 				}
 				_, err = isWaitForBareMetalServerAvailableForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
-					return diag.FromErr(err)
+					return flex.DiagFromErr(err)
 				}
 			}
 		}
@@ -1027,7 +1027,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceUpdate(context context.Context,
 		updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 		_, response, err := sess.UpdateSubnetReservedIP(updateripoptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating network interface reserved ip(%s): %s\n%s", ripId, err, response))
+			return flex.NewServiceError(fmt.Sprintf("Error updating network interface reserved ip(%s)", ripId), err, response).Diagnostics()
 		}
 	}
 
@@ -1079,7 +1079,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceUpdate(context context.Context,
 
 		nicIntf, response, err := sess.UpdateBareMetalServerNetworkInterfaceWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating Bare Metal Server: %s\n%s", err, response))
+			return flex.NewServiceError("Error updating Bare Metal Server", err, response).Diagnostics()
 		}
 		return diag.FromErr(bareMetalServerNICGet(d, meta, sess, nicIntf, bareMetalServerId))
 	}
@@ -1095,7 +1095,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceDelete(context context.Context,
 
 	err = bareMetalServerNetworkInterfaceDelete(context, d, meta, bareMetalServerId, nicId)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 
 	return nil
@@ -1116,7 +1116,7 @@ func bareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.Re
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) network interface(%s) : %s\n%s", bareMetalServerId, nicId, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server (%s) network interface(%s)", bareMetalServerId, nicId), err, response)
 	}
 	nicType := ""
 	switch reflect.TypeOf(nicIntf).String() {
@@ -1133,7 +1133,7 @@ func bareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.Re
 
 			bms, response, err := sess.GetBareMetalServerWithContext(context, getbmsoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error fetching bare metal server (%s) err %s\n%s", bareMetalServerId, err, response)
+				return flex.NewServiceError(fmt.Sprintf("Error fetching bare metal server (%s)", bareMetalServerId), err, response)
 			}
 			// failed, pending, restarting, running, starting, stopped, stopping, maintenance
 			if *bms.Status == "failed" {
@@ -1150,7 +1150,7 @@ func bareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.Re
 				}
 				res, err := sess.StopBareMetalServerWithContext(context, createstopaction)
 				if err != nil || res.StatusCode != 204 {
-					return fmt.Errorf("[ERROR] Error stopping bare metal server (%s) err %s\n%s", bareMetalServerId, err, response)
+					return flex.NewServiceError(fmt.Sprintf("Error stopping bare metal server (%s)", bareMetalServerId), err, response)
 				}
 				_, err = isWaitForBareMetalServerStoppedForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 				if err != nil || res.StatusCode != 204 {
@@ -1172,7 +1172,7 @@ func bareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.Re
 	}
 	response, err = sess.DeleteBareMetalServerNetworkInterfaceWithContext(context, options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Bare Metal Server (%s) network interface (%s) : %s\n%s", bareMetalServerId, nicId, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Deleting Bare Metal Server (%s) network interface (%s)", bareMetalServerId, nicId), err, response)
 	}
 	_, err = isWaitForBareMetalServerNetworkInterfaceDeleted(sess, bareMetalServerId, nicId, nicType, nicIntf, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -1186,7 +1186,7 @@ func bareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.Re
 		}
 		res, err := sess.StartBareMetalServerWithContext(context, createstartaction)
 		if err != nil || res.StatusCode != 204 {
-			return fmt.Errorf("[ERROR] Error starting bare metal server (%s) err %s\n%s", bareMetalServerId, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error starting bare metal server (%s)", bareMetalServerId), err, response)
 		}
 		_, err = isWaitForBareMetalServerAvailableForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 		if err != nil {
@@ -1224,7 +1224,7 @@ func isBareMetalServerNetworkInterfaceDeleteRefreshFunc(bmsC *vpcv1.VpcV1, bareM
 			}
 			bms, response, err := bmsC.GetBareMetalServer(getBmsOptions)
 			if err != nil {
-				return bmsNic, isBareMetalServerNetworkInterfaceFailed, fmt.Errorf("[ERROR] Error getting Bare Metal Server(%s) : %s\n%s", bareMetalServerId, err, response)
+				return bmsNic, isBareMetalServerNetworkInterfaceFailed, flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server(%s)", bareMetalServerId), err, response)
 			}
 			if *bms.Status == "stopped" {
 				return bmsNic, isBareMetalServerNetworkInterfaceVlanPending, fmt.Errorf("[ERROR] Error deleting Bare Metal Server(%s) Network Interface (%s), server in stopped state ", bareMetalServerId, nicId)
@@ -1236,7 +1236,7 @@ func isBareMetalServerNetworkInterfaceDeleteRefreshFunc(bmsC *vpcv1.VpcV1, bareM
 			}
 			bms, response, err := bmsC.GetBareMetalServer(getBmsOptions)
 			if err != nil {
-				return bmsNic, isBareMetalServerNetworkInterfaceFailed, fmt.Errorf("[ERROR] Error getting Bare Metal Server(%s) : %s\n%s", bareMetalServerId, err, response)
+				return bmsNic, isBareMetalServerNetworkInterfaceFailed, flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server(%s)", bareMetalServerId), err, response)
 			}
 			if *bms.Status == "stopped" {
 				return bmsNic, isBareMetalServerNetworkInterfacePCIPending, nil
@@ -1246,7 +1246,7 @@ func isBareMetalServerNetworkInterfaceDeleteRefreshFunc(bmsC *vpcv1.VpcV1, bareM
 			if response != nil && response.StatusCode == 404 {
 				return nicIntf, isBareMetalServerNetworkInterfaceDeleted, nil
 			}
-			return bmsNic, isBareMetalServerNetworkInterfaceFailed, fmt.Errorf("[ERROR] Error getting Bare Metal Server(%s) Network Interface (%s): %s\n%s", bareMetalServerId, nicId, err, response)
+			return bmsNic, isBareMetalServerNetworkInterfaceFailed, flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server(%s) Network Interface (%s)", bareMetalServerId, nicId), err, response)
 		}
 		return bmsNic, isBareMetalServerNetworkInterfaceDeleting, err
 	}
@@ -1273,7 +1273,7 @@ func isBareMetalServerNetworkInterfaceRefreshFunc(client *vpcv1.VpcV1, bareMetal
 		}
 		bmsNic, response, err := client.GetBareMetalServerNetworkInterface(getBmsNicOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) Network Interface (%s) : %s\n%s", bareMetalServerId, nicId, err, response)
+			return nil, "", flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server (%s) Network Interface (%s)", bareMetalServerId, nicId), err, response)
 		}
 		status := ""
 		pcipending := false
@@ -1288,7 +1288,7 @@ func isBareMetalServerNetworkInterfaceRefreshFunc(client *vpcv1.VpcV1, bareMetal
 				}
 				bms, response, err := client.GetBareMetalServer(getBmsOptions)
 				if err != nil {
-					return nil, "", fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s)  : %s\n%s", bareMetalServerId, err, response)
+					return nil, "", flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server (%s)", bareMetalServerId), err, response)
 				}
 				if *bms.Status == "stopped" {
 					pcipending = true
@@ -1353,7 +1353,7 @@ func isBareMetalServerForNICRefreshFunc(client *vpcv1.VpcV1, id string, d *schem
 		}
 		bms, response, err := client.GetBareMetalServer(bmsgetoptions)
 		if err != nil {
-			return nil, "failed", fmt.Errorf("[ERROR] Error getting Bare Metal Server: %s\n%s", err, response)
+			return nil, "failed", flex.NewServiceError("Error getting Bare Metal Server", err, response)
 		}
 
 		if *bms.Status == "running" || *bms.Status == "failed" {
@@ -1383,7 +1383,7 @@ func isBareMetalServerForNICStoppedRefreshFunc(client *vpcv1.VpcV1, id string, d
 		}
 		bms, response, err := client.GetBareMetalServer(bmsgetoptions)
 		if err != nil {
-			return nil, "failed", fmt.Errorf("[ERROR] Error getting Bare Metal Server: %s\n%s", err, response)
+			return nil, "failed", flex.NewServiceError("Error getting Bare Metal Server", err, response)
 		}
 		if *bms.Status == "stopped" || *bms.Status == "failed" {
 			// let know the isRestartStartAction() to stop
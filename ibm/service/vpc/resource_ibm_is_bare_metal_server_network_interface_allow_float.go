@@ -307,7 +307,7 @@ func createVlanTypeNetworkInterfaceAllowFloat(context context.Context, d *schema
 	options.BareMetalServerNetworkInterfacePrototype = nicOptions
 	nic, response, err := sess.CreateBareMetalServerNetworkInterfaceWithContext(context, options)
 	if err != nil || nic == nil {
-		return fmt.Errorf("[DEBUG] Create bare metal server (%s) network interface err %s\n%s", bareMetalServerId, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Create bare metal server (%s) network interface", bareMetalServerId), err, response)
 	}
 	d.Set(isFloatedBareMetalServerID, bareMetalServerId)
 	switch reflect.TypeOf(nic).String() {
@@ -370,7 +370,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceAllowFloatRead(context context.
 		// if response returns an error
 		if err != nil || nicIntf == nil {
 			if response != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) network interface during read (%s): %s\n%s", bareMetalServerId, nicID, err, response))
+				return flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server (%s) network interface during read (%s)", bareMetalServerId, nicID), err, response).Diagnostics()
 			} else {
 				d.SetId("")
 				return nil
@@ -380,7 +380,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceAllowFloatRead(context context.
 	}
 	err = bareMetalServerNICAllowFloatGet(d, meta, sess, nicIntf, bareMetalServerId)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 	return nil
 }
@@ -396,7 +396,7 @@ func findNicsWithoutBMS(context context.Context, d *schema.ResourceData, sess *v
 		}
 		availableServers, response, err := sess.ListBareMetalServersWithContext(context, listBareMetalServersOptions)
 		if err != nil {
-			return nil, nil, fmt.Errorf("[ERROR] Error fetching Bare Metal Servers %s\n%s", err, response)
+			return nil, nil, flex.NewServiceError("Error fetching Bare Metal Servers", err, response)
 		}
 		start = flex.GetNext(availableServers.Next)
 		allrecs = append(allrecs, availableServers.BareMetalServers...)
@@ -477,7 +477,7 @@ func bareMetalServerNICAllowFloatGet(d *schema.ResourceData, meta interface{}, s
 			}
 			bmsRip, response, err := sess.GetSubnetReservedIP(getripoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the bare metal server network interface(%s): %s\n%s", *nic.PrimaryIP.ID, *nic.ID, err, response)
+				return flex.NewServiceError(fmt.Sprintf("Error getting network interface reserved ip(%s) attached to the bare metal server network interface(%s)", *nic.PrimaryIP.ID, *nic.ID), err, response)
 			}
 			currentIP[isBareMetalServerNicIpAutoDelete] = bmsRip.AutoDelete
 
@@ -589,11 +589,11 @@ func resourceIBMISBareMetalServerNetworkInterfaceAllowFloatUpdate(context contex
 				}
 				_, response, err := sess.CreateSecurityGroupTargetBinding(createsgnicoptions)
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] Error while creating security group %q for network interface of bare metal server %s\n%s: %q", add[i], d.Id(), err, response))
+					return flex.NewServiceError(fmt.Sprintf("Error while creating security group %q for network interface of bare metal server %s", add[i], d.Id()), err, response).Diagnostics()
 				}
 				_, err = isWaitForBareMetalServerAvailableForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
-					return diag.FromErr(err)
+					return flex.DiagFromErr(err)
 				}
 			}
 
@@ -606,11 +606,11 @@ func resourceIBMISBareMetalServerNetworkInterfaceAllowFloatUpdate(context contex
 				}
 				response, err := sess.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] Error while removing security group %q for network interface of bare metal server %s\n%s: %q", remove[i], d.Id(), err, response))
+					return flex.NewServiceError(fmt.Sprintf("Error while removing security group %q for network interface of bare metal server %s", remove[i], d.Id()), err, response).Diagnostics()
 				}
 				_, err = isWaitForBareMetalServerAvailableForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
-					return diag.FromErr(err)
+					return flex.DiagFromErr(err)
 				}
 			}
 		}
@@ -638,7 +638,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceAllowFloatUpdate(context contex
 		updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 		_, response, err := sess.UpdateSubnetReservedIP(updateripoptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating network interface reserved ip(%s): %s\n%s", ripId, err, response))
+			return flex.NewServiceError(fmt.Sprintf("Error updating network interface reserved ip(%s)", ripId), err, response).Diagnostics()
 		}
 	}
 
@@ -682,7 +682,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceAllowFloatUpdate(context contex
 
 		nicIntf, response, err := sess.UpdateBareMetalServerNetworkInterfaceWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating Bare Metal Server: %s\n%s", err, response))
+			return flex.NewServiceError("Error updating Bare Metal Server", err, response).Diagnostics()
 		}
 		return diag.FromErr(bareMetalServerNICAllowFloatGet(d, meta, sess, nicIntf, bareMetalServerId))
 	}
@@ -698,7 +698,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceAllowFloatDelete(context contex
 
 	err = bareMetalServerNetworkInterfaceAllowFloatDelete(context, d, meta, bareMetalServerId, nicId)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 
 	return nil
@@ -719,7 +719,7 @@ func bareMetalServerNetworkInterfaceAllowFloatDelete(context context.Context, d
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) network interface(%s) during delete : %s\n%s", bareMetalServerId, nicId, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server (%s) network interface(%s) during delete", bareMetalServerId, nicId), err, response)
 	}
 	nicType := ""
 	switch reflect.TypeOf(nicIntf).String() {
@@ -736,7 +736,7 @@ func bareMetalServerNetworkInterfaceAllowFloatDelete(context context.Context, d
 
 			bms, response, err := sess.GetBareMetalServerWithContext(context, getbmsoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error fetching bare metal server (%s) err %s\n%s", bareMetalServerId, err, response)
+				return flex.NewServiceError(fmt.Sprintf("Error fetching bare metal server (%s)", bareMetalServerId), err, response)
 			}
 			// failed, pending, restarting, running, starting, stopped, stopping, maintenance
 			if *bms.Status == "failed" {
@@ -753,7 +753,7 @@ func bareMetalServerNetworkInterfaceAllowFloatDelete(context context.Context, d
 				}
 				res, err := sess.StopBareMetalServerWithContext(context, createstopaction)
 				if err != nil || res.StatusCode != 204 {
-					return fmt.Errorf("[ERROR] Error stopping bare metal server (%s) err %s\n%s", bareMetalServerId, err, response)
+					return flex.NewServiceError(fmt.Sprintf("Error stopping bare metal server (%s)", bareMetalServerId), err, response)
 				}
 				_, err = isWaitForBareMetalServerStoppedForNIC(sess, bareMetalServerId, d.Timeout(schema.TimeoutCreate), d)
 				if err != nil || res.StatusCode != 204 {
@@ -775,7 +775,7 @@ func bareMetalServerNetworkInterfaceAllowFloatDelete(context context.Context, d
 	}
 	response, err = sess.DeleteBareMetalServerNetworkInterfaceWithContext(context, options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Bare Metal Server (%s) network interface (%s) : %s\n%s", bareMetalServerId, nicId, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Deleting Bare Metal Server (%s) network interface (%s)", bareMetalServerId, nicId), err, response)
 	}
 	_, err = isWaitForBareMetalServerNetworkInterfaceDeleted(sess, bareMetalServerId, nicId, nicType, nicIntf, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 const (
@@ -132,12 +134,12 @@ func resourceIBMISBareMetalServerNetworkInterfaceFloatingIpCreate(context contex
 
 	fip, response, err := sess.AddBareMetalServerNetworkInterfaceFloatingIPWithContext(context, options)
 	if err != nil || fip == nil {
-		return diag.FromErr(fmt.Errorf("[DEBUG] Create bare metal server (%s) network interface (%s) floating ip (%s) err %s\n%s", bareMetalServerId, bareMetalServerNicId, bareMetalServerNicFipId, err, response))
+		return flex.NewServiceError(fmt.Sprintf("Create bare metal server (%s) network interface (%s) floating ip (%s)", bareMetalServerId, bareMetalServerNicId, bareMetalServerNicFipId), err, response).Diagnostics()
 	}
 	d.SetId(MakeTerraformNICFipID(bareMetalServerId, bareMetalServerNicId, *fip.ID))
 	err = bareMetalServerNICFipGet(d, fip, bareMetalServerId, bareMetalServerNicId)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 
 	return nil
@@ -165,11 +167,11 @@ func resourceIBMISBareMetalServerNetworkInterfaceFloatingIpRead(context context.
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) network interface (%s): %s\n%s", bareMetalServerId, nicID, err, response))
+		return flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server (%s) network interface (%s)", bareMetalServerId, nicID), err, response).Diagnostics()
 	}
 	err = bareMetalServerNICFipGet(d, fip, bareMetalServerId, nicID)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 	return nil
 }
@@ -216,7 +218,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceFloatingIpUpdate(context contex
 
 		fip, response, err := sess.AddBareMetalServerNetworkInterfaceFloatingIPWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating Bare Metal Server: %s\n%s", err, response))
+			return flex.NewServiceError("Error updating Bare Metal Server", err, response).Diagnostics()
 		}
 		d.SetId(MakeTerraformNICFipID(bareMetalServerId, nicId, *fip.ID))
 		return diag.FromErr(bareMetalServerNICFipGet(d, fip, bareMetalServerId, nicId))
@@ -232,7 +234,7 @@ func resourceIBMISBareMetalServerNetworkInterfaceFloatingIpDelete(context contex
 
 	err = bareMetalServerNetworkInterfaceFipDelete(context, d, meta, bareMetalServerId, nicId, fipId)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 
 	return nil
@@ -254,7 +256,7 @@ func bareMetalServerNetworkInterfaceFipDelete(context context.Context, d *schema
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) network interface(%s) Floating Ip(%s) : %s\n%s", bareMetalServerId, nicId, fipId, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server (%s) network interface(%s) Floating Ip(%s)", bareMetalServerId, nicId, fipId), err, response)
 	}
 
 	options := &vpcv1.RemoveBareMetalServerNetworkInterfaceFloatingIPOptions{
@@ -264,7 +266,7 @@ func bareMetalServerNetworkInterfaceFipDelete(context context.Context, d *schema
 	}
 	response, err = sess.RemoveBareMetalServerNetworkInterfaceFloatingIPWithContext(context, options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Bare Metal Server (%s) network interface (%s) Floating Ip(%s) : %s\n%s", bareMetalServerId, nicId, fipId, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Deleting Bare Metal Server (%s) network interface (%s) Floating Ip(%s)", bareMetalServerId, nicId, fipId), err, response)
 	}
 	_, err = isWaitForBareMetalServerNetworkInterfaceFloatingIpDeleted(sess, bareMetalServerId, nicId, fipId, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -302,7 +304,7 @@ func isBareMetalServerNetworkInterfaceFloatingIpDeleteRefreshFunc(bmsC *vpcv1.Vp
 			if response != nil && response.StatusCode == 404 {
 				return fip, isBareMetalServerNetworkInterfaceFloatingIpDeleted, nil
 			}
-			return fip, isBareMetalServerNetworkInterfaceFloatingIpFailed, fmt.Errorf("[ERROR] Error getting Bare Metal Server(%s) Network Interface (%s) FloatingIp(%s) : %s\n%s", bareMetalServerId, nicId, fipId, err, response)
+			return fip, isBareMetalServerNetworkInterfaceFloatingIpFailed, flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server(%s) Network Interface (%s) FloatingIp(%s)", bareMetalServerId, nicId, fipId), err, response)
 		}
 		return fip, isBareMetalServerNetworkInterfaceFloatingIpDeleting, err
 	}
@@ -331,7 +333,7 @@ func isBareMetalServerNetworkInterfaceFloatingIpRefreshFunc(client *vpcv1.VpcV1,
 		}
 		fip, response, err := client.GetBareMetalServerNetworkInterfaceFloatingIP(getBmsNicFloatingIpOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s) Network Interface (%s) FloatingIp(%s) : %s\n%s", bareMetalServerId, nicId, fipId, err, response)
+			return nil, "", flex.NewServiceError(fmt.Sprintf("Error getting Bare Metal Server (%s) Network Interface (%s) FloatingIp(%s)", bareMetalServerId, nicId, fipId), err, response)
 		}
 		status := ""
 
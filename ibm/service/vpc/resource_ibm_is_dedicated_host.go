@@ -53,7 +53,7 @@ func ResourceIbmIsDedicatedHost() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -391,6 +391,13 @@ func ResourceIbmIsDedicatedHost() *schema.Resource {
 				Computed:    true,
 				Description: "The globally unique name of the zone this dedicated host resides in.",
 			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 			isDedicatedHostAccessTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -480,7 +487,7 @@ func resourceIbmIsDedicatedHostCreate(context context.Context, d *schema.Resourc
 	}
 
 	d.SetId(*dedicatedHost.ID)
-	if _, ok := d.GetOk(isDedicatedHostAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isDedicatedHostAccessTags) {
 		oldList, newList := d.GetChange(isDedicatedHostAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *dedicatedHost.CRN, "", isDedicatedHostAccessTagType)
 		if err != nil {
@@ -607,7 +614,7 @@ func resourceIbmIsDedicatedHostRead(context context.Context, d *schema.ResourceD
 		log.Printf(
 			"Error on get of resource dedicated host (%s) access tags: %s", d.Id(), err)
 	}
-	d.Set(isDedicatedHostAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isDedicatedHostAccessTags, isDedicatedHostAccessTagType, accesstags)
 	return nil
 }
 
@@ -697,7 +704,7 @@ func resourceIbmIsDedicatedHostUpdate(context context.Context, d *schema.Resourc
 			return diag.FromErr(err)
 		}
 	}
-	if d.HasChange(isDedicatedHostAccessTags) {
+	if flex.AccessTagsHasChange(d, isDedicatedHostAccessTags) {
 		oldList, newList := d.GetChange(isDedicatedHostAccessTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isDedicatedHostAccessTagType)
 		if err != nil {
@@ -773,7 +780,7 @@ func isWaitForDedicatedHostDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData
 				if response != nil && response.StatusCode == 404 {
 					return dedicatedhost, isDedicatedHostDeleteDone, nil
 				}
-				return nil, "", fmt.Errorf("[ERROR] Error getting dedicated Host: %s\n%s", err, response)
+				return nil, "", flex.NewServiceError("Error getting dedicated Host", err, response)
 			}
 			if *dedicatedhost.State == isDedicatedHostFailed {
 				return dedicatedhost, *dedicatedhost.State, fmt.Errorf("[ERROR] The  Dedicated host %s failed to delete: %v", d.Id(), err)
@@ -810,14 +817,14 @@ func isDedicatedHostRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.Res
 		}
 		dhost, response, err := instanceC.GetDedicatedHost(getinsOptions)
 		if dhost == nil || err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting dedicated host : %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error getting dedicated host", err, response)
 		}
 		d.Set("state", *dhost.State)
 		d.Set("lifecycle_state", *dhost.LifecycleState)
 
 		if *dhost.LifecycleState == isDedicatedHostSuspended || *dhost.LifecycleState == isDedicatedHostFailed {
 
-			return dhost, *dhost.LifecycleState, fmt.Errorf("status of dedicated host is %s : \n%s", *dhost.LifecycleState, response)
+			return dhost, *dhost.LifecycleState, flex.NewServiceError(fmt.Sprintf("status of dedicated host is %s", *dhost.LifecycleState), nil, response)
 
 		}
 		return dhost, *dhost.LifecycleState, nil
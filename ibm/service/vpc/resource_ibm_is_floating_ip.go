@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 	"time"
 
@@ -55,7 +54,7 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -225,6 +224,20 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Floating IP tags",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 
 			isFloatingIPAccessTags: {
 				Type:        schema.TypeSet,
@@ -367,7 +380,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 
 	floatingip, response, err := sess.CreateFloatingIP(createFloatingIPOptions)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Floating IP err %s\n%s", err, response)
+		return flex.NewServiceError("Error creating Floating IP", err, response)
 	}
 	d.SetId(*floatingip.ID)
 	log.Printf("[INFO] Floating IP : %s[%s]", *floatingip.ID, *floatingip.Address)
@@ -375,8 +388,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFloatingIPTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isFloatingIPTags) {
 		oldList, newList := d.GetChange(isFloatingIPTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *floatingip.CRN, "", isUserTagType)
 		if err != nil {
@@ -385,7 +397,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		}
 	}
 
-	if _, ok := d.GetOk(isFloatingIPAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isFloatingIPAccessTags) {
 		oldList, newList := d.GetChange(isFloatingIPAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *floatingip.CRN, "", isAccessTagType)
 		if err != nil {
@@ -420,7 +432,7 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Floating IP (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Floating IP (%s)", id), err, response)
 
 	}
 	d.Set(isFloatingIPName, *floatingip.Name)
@@ -441,7 +453,7 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of vpc Floating IP (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isFloatingIPTags, tags)
+	flex.SetResourceTags(d, meta, isFloatingIPTags, isUserTagType, tags)
 
 	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *floatingip.CRN, "", isAccessTagType)
 	if err != nil {
@@ -449,7 +461,7 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource Floating IP (%s) access tags: %s", d.Id(), err)
 	}
 
-	d.Set(isFloatingIPAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isFloatingIPAccessTags, isAccessTagType, accesstags)
 
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
@@ -482,13 +494,13 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		return err
 	}
 
-	if d.HasChange(isFloatingIPTags) {
+	if flex.TagsHasChange(d, isFloatingIPTags) {
 		options := &vpcv1.GetFloatingIPOptions{
 			ID: &id,
 		}
 		fip, response, err := sess.GetFloatingIP(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Floating IP: %s\n%s", err, response)
+			return flex.NewServiceError("Error getting Floating IP", err, response)
 		}
 
 		oldList, newList := d.GetChange(isFloatingIPTags)
@@ -498,13 +510,13 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 				"Error on update of vpc Floating IP (%s) tags: %s", id, err)
 		}
 	}
-	if d.HasChange(isFloatingIPAccessTags) {
+	if flex.AccessTagsHasChange(d, isFloatingIPAccessTags) {
 		options := &vpcv1.GetFloatingIPOptions{
 			ID: &id,
 		}
 		fip, response, err := sess.GetFloatingIP(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Floating IP: %s\n%s", err, response)
+			return flex.NewServiceError("Error getting Floating IP", err, response)
 		}
 
 		oldList, newList := d.GetChange(isFloatingIPAccessTags)
@@ -546,7 +558,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	if hasChanged {
 		_, response, err := sess.UpdateFloatingIP(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating vpc Floating IP: %s\n%s", err, response)
+			return flex.NewServiceError("Error updating vpc Floating IP", err, response)
 		}
 	}
 	return nil
@@ -575,7 +587,7 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			return nil
 		}
 
-		return fmt.Errorf("[ERROR] Error Getting Floating IP (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Floating IP (%s)", id), err, response)
 	}
 
 	options := &vpcv1.DeleteFloatingIPOptions{
@@ -583,7 +595,7 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteFloatingIP(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Floating IP : %s\n%s", err, response)
+		return flex.NewServiceError("Error Deleting Floating IP", err, response)
 	}
 	_, err = isWaitForFloatingIPDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -612,7 +624,7 @@ func fipExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting floating IP: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error getting floating IP", err, response)
 	}
 	return true, nil
 }
@@ -643,7 +655,7 @@ func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRe
 			if response != nil && response.StatusCode == 404 {
 				return FloatingIP, isFloatingIPDeleted, nil
 			}
-			return FloatingIP, "", fmt.Errorf("[ERROR] Error Getting Floating IP: %s\n%s", err, response)
+			return FloatingIP, "", flex.NewServiceError("Error Getting Floating IP", err, response)
 		}
 		return FloatingIP, isFloatingIPDeleting, err
 	}
@@ -671,7 +683,7 @@ func isInstanceFloatingIPRefreshFunc(floatingipC *vpcv1.VpcV1, id string) resour
 		}
 		instance, response, err := floatingipC.GetFloatingIP(getfipoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Floating IP for the instance: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting Floating IP for the instance", err, response)
 		}
 
 		if *instance.Status == "available" {
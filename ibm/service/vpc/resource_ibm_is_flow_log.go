@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -53,7 +52,7 @@ func ResourceIBMISFlowLog() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -144,6 +143,20 @@ func ResourceIBMISFlowLog() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the VPC Flow logs",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 
 			isFlowLogAccessTags: {
 				Type:        schema.TypeSet,
@@ -256,14 +269,13 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 
 	flowlogCollector, response, err := sess.CreateFlowLogCollector(createFlowLogCollectorOptionsModel)
 	if err != nil {
-		return fmt.Errorf("Create Flow Log Collector err %s\n%s", err, response)
+		return flex.NewServiceError("Error creating Flow Log Collector", err, response)
 	}
 	d.SetId(*flowlogCollector.ID)
 
 	log.Printf("Flow log collector : %s", *flowlogCollector.ID)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFlowLogTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isFlowLogTags) {
 		oldList, newList := d.GetChange(isFlowLogTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isUserTagType)
 		if err != nil {
@@ -271,7 +283,7 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 				"Error on create of resource vpc flow log (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isFlowLogAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isFlowLogAccessTags) {
 		oldList, newList := d.GetChange(isFlowLogAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isAccessTagType)
 		if err != nil {
@@ -296,7 +308,7 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	flowlogCollector, response, err := sess.GetFlowLogCollector(getOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Flow Log Collector: %s\n%s", err, response)
+		return flex.NewServiceError("Error Getting Flow Log Collector", err, response)
 	}
 
 	if flowlogCollector.Name != nil {
@@ -343,13 +355,13 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 		log.Printf(
 			"Error on get of resource vpc flow log (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isFlowLogTags, tags)
+	flex.SetResourceTags(d, meta, isFlowLogTags, isUserTagType, tags)
 	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *flowlogCollector.CRN, "", isAccessTagType)
 	if err != nil {
 		log.Printf(
 			"Error on get of resource VPC Flow Log (%s) access tags: %s", d.Id(), err)
 	}
-	d.Set(isFlowLogAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isFlowLogAccessTags, isAccessTagType, accesstags)
 
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
@@ -383,10 +395,10 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 	flowlogCollector, response, err := sess.GetFlowLogCollector(getOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Flow Log Collector: %s\n%s", err, response)
+		return flex.NewServiceError("Error Getting Flow Log Collector", err, response)
 	}
 
-	if d.HasChange(isFlowLogTags) {
+	if flex.TagsHasChange(d, isFlowLogTags) {
 		oldList, newList := d.GetChange(isFlowLogTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isUserTagType)
 		if err != nil {
@@ -395,7 +407,7 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange(isFlowLogAccessTags) {
+	if flex.AccessTagsHasChange(d, isFlowLogAccessTags) {
 		oldList, newList := d.GetChange(isFlowLogAccessTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isAccessTagType)
 		if err != nil {
@@ -421,7 +433,7 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 		updoptions.FlowLogCollectorPatch = flowLogCollectorPatch
 		_, response, err = sess.UpdateFlowLogCollector(updoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating flow log collector:%s\n%s", err, response)
+			return flex.NewServiceError("Error updating flow log collector", err, response)
 		}
 	}
 
@@ -441,7 +453,7 @@ func resourceIBMISFlowLogDelete(d *schema.ResourceData, meta interface{}) error
 	response, err := sess.DeleteFlowLogCollector(delOptions)
 
 	if err != nil && response.StatusCode != 404 {
-		return fmt.Errorf("[ERROR] Error deleting flow log collector:%s\n%s", err, response)
+		return flex.NewServiceError("Error deleting flow log collector", err, response)
 	}
 
 	d.SetId("")
@@ -461,7 +473,7 @@ func resourceIBMISFlowLogExists(d *schema.ResourceData, meta interface{}) (bool,
 	}
 	_, response, err := sess.GetFlowLogCollector(getOptions)
 	if err != nil && response.StatusCode != 404 {
-		return false, fmt.Errorf("[ERROR] Error Getting Flow Log Collector : %s\n%s", err, response)
+		return false, flex.NewServiceError("Error Getting Flow Log Collector", err, response)
 	}
 	if response.StatusCode == 404 {
 		d.SetId("")
@@ -239,7 +239,7 @@ func ikepCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, enc
 	}
 	ike, response, err := sess.CreateIkePolicy(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] ike policy err %s\n%s", err, response)
+		return flex.NewServiceError("Error creating IKE Policy", err, response)
 	}
 	d.SetId(*ike.ID)
 	log.Printf("[INFO] ike policy : %s", *ike.ID)
@@ -266,7 +266,7 @@ func ikepGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting IKE Policy(%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting IKE Policy(%s)", id), err, response)
 	}
 
 	d.Set(isIKEName, *ike.Name)
@@ -346,7 +346,7 @@ func ikepUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 
 		_, response, err := sess.UpdateIkePolicy(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error on update of IKE Policy(%s): %s\n%s", id, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error on update of IKE Policy(%s)", id), err, response)
 		}
 	}
 	return nil
@@ -372,7 +372,7 @@ func ikepDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting IKE Policy(%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting IKE Policy(%s)", id), err, response)
 	}
 
 	deleteIkePolicyOptions := &vpcv1.DeleteIkePolicyOptions{
@@ -380,7 +380,7 @@ func ikepDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteIkePolicy(deleteIkePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting IKE Policy(%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Deleting IKE Policy(%s)", id), err, response)
 	}
 	d.SetId("")
 	return nil
@@ -405,7 +405,7 @@ func ikepExists(d *schema.ResourceData, meta interface{}, id string) (bool, erro
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting IKE Policy(%s): %s\n%s", id, err, response)
+		return false, flex.NewServiceError(fmt.Sprintf("Error getting IKE Policy(%s)", id), err, response)
 	}
 
 	return true, nil
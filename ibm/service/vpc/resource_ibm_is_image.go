@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -68,7 +67,7 @@ func ResourceIBMISImage() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -145,6 +144,20 @@ func ResourceIBMISImage() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Tags for the image",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 
 			isImageOperatingSystem: {
 				Type:         schema.TypeString,
@@ -360,7 +373,7 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 	}
 	image, response, err := sess.CreateImage(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Image creation err %s\n%s", err, response)
+		return flex.NewServiceError("Error creating Image", err, response)
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Image ID : %s", *image.ID)
@@ -368,8 +381,7 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isImageTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isImageTags) {
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageUserTagType)
 		if err != nil {
@@ -377,7 +389,7 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 				"Error on create of resource vpc Image (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isImageAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isImageAccessTags) {
 		oldList, newList := d.GetChange(isImageAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageAccessTagType)
 		if err != nil {
@@ -404,7 +416,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	}
 	vol, response, err := sess.GetVolume(options)
 	if err != nil || vol == nil {
-		return fmt.Errorf("[ERROR] Error retrieving Volume (%s) details: %s\n%s", volume, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error retrieving Volume (%s)", volume), err, response)
 	}
 	if vol.VolumeAttachments == nil || len(vol.VolumeAttachments) == 0 {
 		return fmt.Errorf("[ERROR] Error creating Image because the specified source_volume %s is not attached to a virtual server instance", volume)
@@ -419,7 +431,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	}
 	instance, response, err := sess.GetInstance(getinsOptions)
 	if err != nil || instance == nil {
-		return fmt.Errorf("[ERROR] Error retrieving Instance (%s) to which the source_volume (%s) is attached : %s\n%s", insId, volume, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error retrieving Instance (%s) to which the source_volume (%s) is attached", insId, volume), err, response)
 	}
 	if instance != nil && *instance.Status == "running" {
 		actiontype := "stop"
@@ -429,7 +441,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 		}
 		_, response, err = sess.CreateInstanceAction(createinsactoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error stopping Instance (%s) to which the source_volume (%s) is attached  : %s\n%s", insId, volume, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error stopping Instance (%s) to which the source_volume (%s) is attached", insId, volume), err, response)
 		}
 		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutCreate), insId, d)
 		if err != nil {
@@ -475,7 +487,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	}
 	image, response, err := sess.CreateImage(imagOptions)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Image creation err %s\n%s", err, response)
+		return flex.NewServiceError("Error creating Image", err, response)
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Image ID : %s", *image.ID)
@@ -483,8 +495,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isImageTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isImageTags) {
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageUserTagType)
 		if err != nil {
@@ -492,7 +503,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 				"Error on create of resource vpc Image (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isImageAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isImageAccessTags) {
 		oldList, newList := d.GetChange(isImageAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageAccessTagType)
 		if err != nil {
@@ -524,7 +535,7 @@ func isImageRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFun
 		}
 		image, response, err := imageC.GetImage(getimgoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Image: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting Image", err, response)
 		}
 
 		if *image.Status == "available" || *image.Status == "failed" {
@@ -566,7 +577,7 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			}
 			response, err := sess.DeprecateImage(deprecateImageOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error during deprecate Image : %s\n%s", err, response)
+				return flex.NewServiceError("Error during deprecate Image", err, response)
 			}
 			_, err = isWaitForImageDeprecate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
@@ -582,7 +593,7 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			}
 			response, err := sess.ObsoleteImage(obsoleteImageOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error during obsolete Image : %s\n%s", err, response)
+				return flex.NewServiceError("Error during obsolete Image", err, response)
 			}
 			_, err = isWaitForImageObsolete(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
@@ -590,13 +601,13 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			}
 		}
 	}
-	if d.HasChange(isImageTags) {
+	if flex.TagsHasChange(d, isImageTags) {
 		options := &vpcv1.GetImageOptions{
 			ID: &id,
 		}
 		image, response, err := sess.GetImage(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Image IP: %s\n%s", err, response)
+			return flex.NewServiceError("Error getting Image IP", err, response)
 		}
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageUserTagType)
@@ -605,13 +616,13 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 				"Error on update of resource vpc Image (%s) tags: %s", id, err)
 		}
 	}
-	if d.HasChange(isImageAccessTags) {
+	if flex.AccessTagsHasChange(d, isImageAccessTags) {
 		options := &vpcv1.GetImageOptions{
 			ID: &id,
 		}
 		image, response, err := sess.GetImage(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Image crn: %s\n%s", err, response)
+			return flex.NewServiceError("Error getting Image crn", err, response)
 		}
 		oldList, newList := d.GetChange(isImageAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageAccessTagType)
@@ -668,7 +679,7 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 	options.ImagePatch = imagePatch
 	_, response, err := sess.UpdateImage(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error on update of resource vpc Image: %s\n%s", err, response)
+		return flex.NewServiceError("Error on update of resource vpc Image", err, response)
 	}
 
 	return nil
@@ -698,7 +709,7 @@ func imgGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Image (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Image (%s)", id), err, response)
 	}
 	// d.Set(isImageArchitecure, image.Architecture)
 	if image.MinimumProvisionedSize != nil {
@@ -747,13 +758,13 @@ func imgGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of resource vpc Image (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isImageTags, tags)
+	flex.SetResourceTags(d, meta, isImageTags, isImageUserTagType, tags)
 	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *image.CRN, "", isImageAccessTagType)
 	if err != nil {
 		log.Printf(
 			"Error on get of resource vpc Image (%s) access tags: %s", d.Id(), err)
 	}
-	d.Set(isImageAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isImageAccessTags, isImageAccessTagType, accesstags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -792,7 +803,7 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Image (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Image (%s)", id), err, response)
 	}
 
 	options := &vpcv1.DeleteImageOptions{
@@ -800,7 +811,7 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteImage(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Image : %s\n%s", err, response)
+		return flex.NewServiceError("Error Deleting Image", err, response)
 	}
 	_, err = isWaitForImageDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -836,7 +847,7 @@ func isImageDeleteRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefr
 			if response != nil && response.StatusCode == 404 {
 				return image, isImageDeleted, nil
 			}
-			return image, "", fmt.Errorf("[ERROR] Error Getting Image: %s\n%s", err, response)
+			return image, "", flex.NewServiceError("Error Getting Image", err, response)
 		}
 		return image, isImageDeleting, err
 	}
@@ -860,7 +871,7 @@ func imgExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Image: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error getting Image", err, response)
 	}
 	return true, nil
 }
@@ -46,7 +46,7 @@ func ResourceIBMISImageDeprecate() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -202,7 +202,7 @@ func imgDeprecateCreate(context context.Context, d *schema.ResourceData, meta in
 	}
 	response, err := sess.DeprecateImageWithContext(context, imageDeprecatePrototype)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Image deprecate err %s\n%s", err, response)
+		return flex.NewServiceError("Error deprecating Image", err, response)
 	}
 	d.SetId(id)
 	log.Printf("[INFO] Image ID : %s", id)
@@ -236,7 +236,7 @@ func isImageDeprecateRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateR
 		}
 		image, response, err := imageC.GetImage(getimgoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Image: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting Image", err, response)
 		}
 
 		if *image.Status == "deprecated" {
@@ -274,7 +274,7 @@ func imgDeprecateGet(d *schema.ResourceData, meta interface{}, id string) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Image (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Image (%s)", id), err, response)
 	}
 	if image.MinimumProvisionedSize != nil {
 		d.Set(isImageMinimumProvisionedSize, *image.MinimumProvisionedSize)
@@ -219,7 +219,7 @@ func ResourceIBMIsImageExportCreate(context context.Context, d *schema.ResourceD
 	imageExportJob, response, err := vpcClient.CreateImageExportJobWithContext(context, createImageExportJobOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateImageExportJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateImageExportJobWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateImageExportJobWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createImageExportJobOptions.ImageID, *imageExportJob.ID))
@@ -250,7 +250,7 @@ func ResourceIBMIsImageExportRead(context context.Context, d *schema.ResourceDat
 			return nil
 		}
 		log.Printf("[DEBUG] GetImageExportJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetImageExportJobWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetImageExportJobWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("format", imageExportJob.Format); err != nil {
@@ -351,7 +351,7 @@ func ResourceIBMIsImageExportUpdate(context context.Context, d *schema.ResourceD
 		_, response, err := vpcClient.UpdateImageExportJobWithContext(context, updateImageExportJobOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateImageExportJobWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateImageExportJobWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateImageExportJobWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -377,7 +377,7 @@ func ResourceIBMIsImageExportDelete(context context.Context, d *schema.ResourceD
 	response, err := vpcClient.DeleteImageExportJobWithContext(context, deleteImageExportJobOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteImageExportJobWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteImageExportJobWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteImageExportJobWithContext failed", err, response).Diagnostics()
 	}
 	_, err = isWaitForImageExportJobDeleted(context, d, meta, vpcClient, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -491,7 +491,7 @@ func isImageExportJobDeleteRefreshFunc(context context.Context, d *schema.Resour
 			if response != nil && response.StatusCode == 404 {
 				return imageExportJob, "done", nil
 			}
-			return imageExportJob, "", fmt.Errorf("[ERROR] Error Getting Image export job: %s\n%s", err, response)
+			return imageExportJob, "", flex.NewServiceError("Error Getting Image export job", err, response)
 		}
 		return imageExportJob, *imageExportJob.Status, err
 	}
@@ -38,7 +38,7 @@ func ResourceIBMISImageObsolete() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -196,7 +196,7 @@ func imgObsoleteCreate(context context.Context, d *schema.ResourceData, meta int
 
 	response, err := sess.ObsoleteImageWithContext(context, imageObsoletePrototype)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Image obsolete err %s\n%s", err, response)
+		return flex.NewServiceError("Error obsoleting Image", err, response)
 	}
 	d.SetId(id)
 	log.Printf("[INFO] Image ID : %s", id)
@@ -229,7 +229,7 @@ func isImageObsoleteRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRe
 		}
 		image, response, err := imageC.GetImage(getimgoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Image: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting Image", err, response)
 		}
 
 		if *image.Status == "obsolete" {
@@ -267,7 +267,7 @@ func imgObsoleteGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Image (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Image (%s)", id), err, response)
 	}
 	if image.MinimumProvisionedSize != nil {
 		d.Set(isImageMinimumProvisionedSize, *image.MinimumProvisionedSize)
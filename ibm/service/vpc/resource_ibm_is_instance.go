@@ -8,7 +8,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -21,62 +20,63 @@ import (
 )
 
 const (
-	isInstanceName                    = "name"
-	IsInstanceCRN                     = "crn"
-	isInstanceKeys                    = "keys"
-	isInstanceTags                    = "tags"
-	isInstanceBootVolumeTags          = "tags"
-	isInstanceNetworkInterfaces       = "network_interfaces"
-	isInstancePrimaryNetworkInterface = "primary_network_interface"
-	isInstanceNicName                 = "name"
-	isInstanceProfile                 = "profile"
-	isInstanceNicPortSpeed            = "port_speed"
-	isInstanceNicAllowIPSpoofing      = "allow_ip_spoofing"
-	isInstanceNicPrimaryIpv4Address   = "primary_ipv4_address"
-	isInstanceNicSecondaryAddress     = "secondary_addresses"
-	isInstanceNicSecurityGroups       = "security_groups"
-	isInstanceNicSubnet               = "subnet"
-	isInstanceNicFloatingIP           = "floating_ip"
-	isInstanceNicFloatingIPs          = "floating_ips"
-	isInstanceUserData                = "user_data"
-	isInstanceVolumes                 = "volumes"
-	isInstanceVPC                     = "vpc"
-	isInstanceZone                    = "zone"
-	isInstanceBootVolume              = "boot_volume"
-	isInstanceVolumeSnapshot          = "snapshot"
-	isInstanceSourceTemplate          = "instance_template"
-	isInstanceBandwidth               = "bandwidth"
-	isInstanceTotalVolumeBandwidth    = "total_volume_bandwidth"
-	isInstanceTotalNetworkBandwidth   = "total_network_bandwidth"
-	isInstanceVolAttVolAutoDelete     = "auto_delete_volume"
-	isInstanceVolAttVolBillingTerm    = "billing_term"
-	isInstanceImage                   = "image"
-	isInstanceCPU                     = "vcpu"
-	isInstanceCPUArch                 = "architecture"
-	isInstanceCPUCores                = "cores"
-	isInstanceCPUCount                = "count"
-	isInstanceCPUManufacturer         = "manufacturer"
-	isInstanceGpu                     = "gpu"
-	isInstanceGpuCores                = "cores"
-	isInstanceGpuCount                = "count"
-	isInstanceGpuManufacturer         = "manufacturer"
-	isInstanceGpuMemory               = "memory"
-	isInstanceGpuModel                = "model"
-	isInstanceMemory                  = "memory"
-	isInstanceDisks                   = "disks"
-	isInstanceDedicatedHost           = "dedicated_host"
-	isInstanceStatus                  = "status"
-	isInstanceStatusReasons           = "status_reasons"
-	isInstanceStatusReasonsCode       = "code"
-	isInstanceStatusReasonsMessage    = "message"
-	isInstanceStatusReasonsMoreInfo   = "more_info"
-	isEnableCleanDelete               = "wait_before_delete"
-	isInstanceProvisioning            = "provisioning"
-	isInstanceProvisioningDone        = "done"
-	isInstanceAvailable               = "available"
-	isInstanceDeleting                = "deleting"
-	isInstanceDeleteDone              = "done"
-	isInstanceFailed                  = "failed"
+	isInstanceName                     = "name"
+	IsInstanceCRN                      = "crn"
+	isInstanceKeys                     = "keys"
+	isInstanceTags                     = "tags"
+	isInstanceBootVolumeTags           = "tags"
+	isInstanceNetworkInterfaces        = "network_interfaces"
+	isInstancePrimaryNetworkInterface  = "primary_network_interface"
+	isInstancePrimaryNetworkAttachment = "primary_network_attachment"
+	isInstanceNicName                  = "name"
+	isInstanceProfile                  = "profile"
+	isInstanceNicPortSpeed             = "port_speed"
+	isInstanceNicAllowIPSpoofing       = "allow_ip_spoofing"
+	isInstanceNicPrimaryIpv4Address    = "primary_ipv4_address"
+	isInstanceNicSecondaryAddress      = "secondary_addresses"
+	isInstanceNicSecurityGroups        = "security_groups"
+	isInstanceNicSubnet                = "subnet"
+	isInstanceNicFloatingIP            = "floating_ip"
+	isInstanceNicFloatingIPs           = "floating_ips"
+	isInstanceUserData                 = "user_data"
+	isInstanceVolumes                  = "volumes"
+	isInstanceVPC                      = "vpc"
+	isInstanceZone                     = "zone"
+	isInstanceBootVolume               = "boot_volume"
+	isInstanceVolumeSnapshot           = "snapshot"
+	isInstanceSourceTemplate           = "instance_template"
+	isInstanceBandwidth                = "bandwidth"
+	isInstanceTotalVolumeBandwidth     = "total_volume_bandwidth"
+	isInstanceTotalNetworkBandwidth    = "total_network_bandwidth"
+	isInstanceVolAttVolAutoDelete      = "auto_delete_volume"
+	isInstanceVolAttVolBillingTerm     = "billing_term"
+	isInstanceImage                    = "image"
+	isInstanceCPU                      = "vcpu"
+	isInstanceCPUArch                  = "architecture"
+	isInstanceCPUCores                 = "cores"
+	isInstanceCPUCount                 = "count"
+	isInstanceCPUManufacturer          = "manufacturer"
+	isInstanceGpu                      = "gpu"
+	isInstanceGpuCores                 = "cores"
+	isInstanceGpuCount                 = "count"
+	isInstanceGpuManufacturer          = "manufacturer"
+	isInstanceGpuMemory                = "memory"
+	isInstanceGpuModel                 = "model"
+	isInstanceMemory                   = "memory"
+	isInstanceDisks                    = "disks"
+	isInstanceDedicatedHost            = "dedicated_host"
+	isInstanceStatus                   = "status"
+	isInstanceStatusReasons            = "status_reasons"
+	isInstanceStatusReasonsCode        = "code"
+	isInstanceStatusReasonsMessage     = "message"
+	isInstanceStatusReasonsMoreInfo    = "more_info"
+	isEnableCleanDelete                = "wait_before_delete"
+	isInstanceProvisioning             = "provisioning"
+	isInstanceProvisioningDone         = "done"
+	isInstanceAvailable                = "available"
+	isInstanceDeleting                 = "deleting"
+	isInstanceDeleteDone               = "done"
+	isInstanceFailed                   = "failed"
 
 	isInstanceStatusRestarting           = "restarting"
 	isInstanceStatusStarting             = "starting"
@@ -125,6 +125,13 @@ const (
 	isInstanceMetadataServiceEnabled1     = "enabled"
 	isInstanceMetadataServiceProtocol     = "protocol"
 	isInstanceMetadataServiceRespHopLimit = "response_hop_limit"
+
+	isInstanceReplacementStrategy           = "replacement_strategy"
+	isInstanceReplacementHealthCheckTimeout = "health_check_timeout"
+	isInstanceReplacementMoveFloatingIPs    = "move_floating_ips"
+	isInstanceReplacementMoveLBPoolMembers  = "move_lb_pool_members"
+	isInstanceReplacementMoveVolumes        = "move_volumes"
+	isInstanceReplacedInstance              = "replaced_instance"
 )
 
 func ResourceIBMISInstance() *schema.Resource {
@@ -151,7 +158,7 @@ func ResourceIBMISInstance() *schema.Resource {
 						d.SetId("")
 						return nil, nil
 					}
-					return nil, fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+					return nil, flex.NewServiceError("Error Getting Instance", err, response)
 				}
 				var volumes []string
 				volumes = make([]string, 0)
@@ -176,12 +183,16 @@ func ResourceIBMISInstance() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return instanceReplacementCustomizeDiff(diff)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -201,7 +212,6 @@ func ResourceIBMISInstance() *schema.Resource {
 			},
 			isInstanceVPC: {
 				Type:        schema.TypeString,
-				ForceNew:    true,
 				Optional:    true,
 				Computed:    true,
 				Description: "VPC id",
@@ -221,19 +231,20 @@ func ResourceIBMISInstance() *schema.Resource {
 				Description:   "Id of the instance template",
 			},
 			isInstanceZone: {
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Computed:    true,
-				Optional:    true,
-				Description: "Zone name",
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_instance", isInstanceZone),
+				Description:  "Zone name",
 			},
 
 			isInstanceProfile: {
-				Type:        schema.TypeString,
-				ForceNew:    false,
-				Computed:    true,
-				Optional:    true,
-				Description: "Profile info",
+				Type:         schema.TypeString,
+				ForceNew:     false,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_instance", isInstanceProfile),
+				Description:  "Profile info",
 			},
 			isInstanceDefaultTrustedProfileAutoLink: {
 				Type:         schema.TypeBool,
@@ -308,6 +319,20 @@ func ResourceIBMISInstance() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "list of tags for the instance",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 
 			isInstanceAccessTags: {
 				Type:        schema.TypeSet,
@@ -497,7 +522,41 @@ func ResourceIBMISInstance() *schema.Resource {
 						isInstanceNicSubnet: {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			isInstancePrimaryNetworkAttachment: {
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{isInstancePrimaryNetworkInterface, isInstanceNetworkInterfaces},
+				Description:   "The primary network attachment of the instance, which attaches an existing virtual network interface",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the network attachment",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The name of the network attachment",
+						},
+						"virtual_network_interface": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The unique identifier of the virtual network interface to attach",
+						},
+						"subnet": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The subnet of the virtual network interface",
 						},
 					},
 				},
@@ -603,7 +662,6 @@ func ResourceIBMISInstance() *schema.Resource {
 
 			isInstanceImage: {
 				Type:          schema.TypeString,
-				ForceNew:      true,
 				Computed:      true,
 				Optional:      true,
 				ConflictsWith: []string{"boot_volume.0.snapshot", "catalog_offering.0.offering_crn", "catalog_offering.0.version_crn", "boot_volume.0.volume_id"},
@@ -622,7 +680,6 @@ func ResourceIBMISInstance() *schema.Resource {
 						isInstanceBootVolumeId: {
 							Type:          schema.TypeString,
 							Optional:      true,
-							ForceNew:      true,
 							Computed:      true,
 							RequiredWith:  []string{isInstanceZone, isInstancePrimaryNetworkInterface, isInstanceProfile, isInstanceKeys, isInstanceVPC},
 							AtLeastOneOf:  []string{isInstanceImage, isInstanceSourceTemplate, "boot_volume.0.volume_id", "boot_volume.0.snapshot", "catalog_offering.0.offering_crn", "catalog_offering.0.version_crn"},
@@ -648,7 +705,6 @@ func ResourceIBMISInstance() *schema.Resource {
 							AtLeastOneOf:  []string{isInstanceImage, isInstanceSourceTemplate, "boot_volume.0.snapshot", "catalog_offering.0.offering_crn", "catalog_offering.0.version_crn", "boot_volume.0.volume_id"},
 							ConflictsWith: []string{isInstanceImage, isInstanceSourceTemplate, "catalog_offering.0.offering_crn", "catalog_offering.0.version_crn", "boot_volume.0.volume_id"},
 							Optional:      true,
-							ForceNew:      true,
 							Computed:      true,
 						},
 						isInstanceBootEncryption: {
@@ -695,6 +751,49 @@ func ResourceIBMISInstance() *schema.Resource {
 				Description: "Auto delete volume along with instance",
 			},
 
+			isInstanceReplacementStrategy: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{isInstancePrimaryNetworkAttachment},
+				Description:   "Replaces the instance with a rolling replacement instead of destroying and recreating it when the image, zone, vpc, boot volume or primary network interface subnet changes",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isInstanceReplacementHealthCheckTimeout: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance", isInstanceReplacementHealthCheckTimeout),
+							Description:  "The time in seconds to wait for the load balancer pool members of the new instance to become healthy before the replacement is rolled back",
+						},
+						isInstanceReplacementMoveFloatingIPs: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Move the floating IPs of the primary network interface to the new instance",
+						},
+						isInstanceReplacementMoveLBPoolMembers: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Move the load balancer pool members targeting the instance to the new instance",
+						},
+						isInstanceReplacementMoveVolumes: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Move the data volumes listed in volumes to the new instance",
+						},
+					},
+				},
+			},
+
+			isInstanceReplacedInstance: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The instance replaced by a rolling replacement whose deletion failed, deleted by the next apply",
+			},
+
 			isInstanceResourceGroup: {
 				Type:        schema.TypeString,
 				ForceNew:    true,
@@ -1058,6 +1157,14 @@ func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 			Optional:                   true,
 			MinValue:                   "1",
 			MaxValue:                   "250"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceReplacementHealthCheckTimeout,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "60",
+			MaxValue:                   "3600"})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 isInstanceAction,
@@ -1065,6 +1172,20 @@ func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			Optional:                   true,
 			AllowedValues:              actions})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceZone,
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              validate.CloudDataTypeZone,
+			Optional:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceProfile,
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              validate.CloudDataTypeISInstanceProfile,
+			Optional:                   true})
 
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
@@ -1214,7 +1335,9 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 
 	}
 
-	if primnicintf, ok := d.GetOk(isInstancePrimaryNetworkInterface); ok {
+	if primaryNetworkAttachment := expandInstancePrimaryNetworkAttachment(d); primaryNetworkAttachment != nil {
+		instanceproto.PrimaryNetworkAttachment = primaryNetworkAttachment
+	} else if primnicintf, ok := d.GetOk(isInstancePrimaryNetworkInterface); ok {
 		primnic := primnicintf.([]interface{})[0].(map[string]interface{})
 		subnetintf, _ := primnic[isInstanceNicSubnet]
 		subnetintfstr := subnetintf.(string)
@@ -1449,8 +1572,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isInstanceTags) {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceUserTagType)
 		if err != nil {
@@ -1458,7 +1580,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isInstanceAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isInstanceAccessTags) {
 		oldList, newList := d.GetChange(isInstanceAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
@@ -1587,7 +1709,9 @@ func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, p
 
 	}
 
-	if primnicintf, ok := d.GetOk(isInstancePrimaryNetworkInterface); ok {
+	if primaryNetworkAttachment := expandInstancePrimaryNetworkAttachment(d); primaryNetworkAttachment != nil {
+		instanceproto.PrimaryNetworkAttachment = primaryNetworkAttachment
+	} else if primnicintf, ok := d.GetOk(isInstancePrimaryNetworkInterface); ok {
 		primnic := primnicintf.([]interface{})[0].(map[string]interface{})
 		subnetintf, _ := primnic[isInstanceNicSubnet]
 		subnetintfstr := subnetintf.(string)
@@ -1822,8 +1946,7 @@ func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, p
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isInstanceTags) {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -1958,7 +2081,9 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 		}
 	}
 
-	if primnicintf, ok := d.GetOk(isInstancePrimaryNetworkInterface); ok {
+	if primaryNetworkAttachment := expandInstancePrimaryNetworkAttachment(d); primaryNetworkAttachment != nil {
+		instanceproto.PrimaryNetworkAttachment = primaryNetworkAttachment
+	} else if primnicintf, ok := d.GetOk(isInstancePrimaryNetworkInterface); ok {
 		primnic := primnicintf.([]interface{})[0].(map[string]interface{})
 		subnetintf, _ := primnic[isInstanceNicSubnet]
 		subnetintfstr := subnetintf.(string)
@@ -2192,8 +2317,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isInstanceTags) {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -2201,7 +2325,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isInstanceAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isInstanceAccessTags) {
 		oldList, newList := d.GetChange(isInstanceAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
@@ -2326,7 +2450,9 @@ func instanceCreateBySnapshot(d *schema.ResourceData, meta interface{}, profile,
 		instanceproto.TotalVolumeBandwidth = &totalVolBandwidthStr
 	}
 
-	if primnicintf, ok := d.GetOk(isInstancePrimaryNetworkInterface); ok {
+	if primaryNetworkAttachment := expandInstancePrimaryNetworkAttachment(d); primaryNetworkAttachment != nil {
+		instanceproto.PrimaryNetworkAttachment = primaryNetworkAttachment
+	} else if primnicintf, ok := d.GetOk(isInstancePrimaryNetworkInterface); ok {
 		primnic := primnicintf.([]interface{})[0].(map[string]interface{})
 		subnetintf, _ := primnic[isInstanceNicSubnet]
 		subnetintfstr := subnetintf.(string)
@@ -2565,8 +2691,7 @@ func instanceCreateBySnapshot(d *schema.ResourceData, meta interface{}, profile,
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isInstanceTags) {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceUserTagType)
 		if err != nil {
@@ -2574,7 +2699,7 @@ func instanceCreateBySnapshot(d *schema.ResourceData, meta interface{}, profile,
 				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isInstanceAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isInstanceAccessTags) {
 		oldList, newList := d.GetChange(isInstanceAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
@@ -2665,7 +2790,9 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 		instanceproto.TotalVolumeBandwidth = &totalVolBandwidthStr
 	}
 
-	if primnicintf, ok := d.GetOk(isInstancePrimaryNetworkInterface); ok {
+	if primaryNetworkAttachment := expandInstancePrimaryNetworkAttachment(d); primaryNetworkAttachment != nil {
+		instanceproto.PrimaryNetworkAttachment = primaryNetworkAttachment
+	} else if primnicintf, ok := d.GetOk(isInstancePrimaryNetworkInterface); ok {
 		primnic := primnicintf.([]interface{})[0].(map[string]interface{})
 		subnetintf, _ := primnic[isInstanceNicSubnet]
 		subnetintfstr := subnetintf.(string)
@@ -2903,8 +3030,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isInstanceTags) {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -2912,7 +3038,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isInstanceAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isInstanceAccessTags) {
 		oldList, newList := d.GetChange(isInstanceAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
@@ -2925,8 +3051,18 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 
 func resourceIBMisInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 
+	err := instanceCreate(d, meta, d.Get(isInstanceName).(string))
+	if err != nil {
+		return err
+	}
+
+	return resourceIBMisInstanceUpdate(d, meta)
+}
+
+// instanceCreate creates an instance with the given name from the configuration
+// and sets it as the id of the resource
+func instanceCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	profile := d.Get(isInstanceProfile).(string)
-	name := d.Get(isInstanceName).(string)
 	vpcID := d.Get(isInstanceVPC).(string)
 	zone := d.Get(isInstanceZone).(string)
 	image := d.Get(isInstanceImage).(string)
@@ -2963,8 +3099,7 @@ func resourceIBMisInstanceCreate(d *schema.ResourceData, meta interface{}) error
 			return err
 		}
 	}
-
-	return resourceIBMisInstanceUpdate(d, meta)
+	return nil
 }
 
 func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
@@ -2996,7 +3131,7 @@ func isInstanceRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.Resource
 		}
 		instance, response, err := instanceC.GetInstance(getinsOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting Instance", err, response)
 		}
 		d.Set(isInstanceStatus, *instance.Status)
 
@@ -3059,7 +3194,7 @@ func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceD
 			}
 			_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				communicator <- fmt.Errorf("[ERROR] Error retrying instance action start: %s\n%s", err, response)
+				communicator <- flex.NewServiceError("Error retrying instance action start", err, response)
 				return
 			}
 			waitTimeout := time.Duration(1) * time.Minute
@@ -3071,7 +3206,7 @@ func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceD
 			}
 			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				communicator <- fmt.Errorf("[ERROR] Error retrying instance action start: %s\n%s", err, response)
+				communicator <- flex.NewServiceError("Error retrying instance action start", err, response)
 				return
 			}
 		case <-communicator:
@@ -3110,11 +3245,11 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Instance: %s\n%s", err, response)
+		return flex.NewServiceError("Error getting Instance", err, response)
 	}
 	instanceInitialization, response, err := instanceC.GetInstanceInitialization(getinsIniOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error getting Instance initialization details: %s\n%s", err, response)
+		return flex.NewServiceError("Error getting Instance initialization details", err, response)
 	}
 	if instanceInitialization.DefaultTrustedProfile != nil && instanceInitialization.DefaultTrustedProfile.AutoLink != nil {
 		d.Set(isInstanceDefaultTrustedProfileAutoLink, *instanceInitialization.DefaultTrustedProfile.AutoLink)
@@ -3205,7 +3340,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		insRip, response, err := instanceC.GetSubnetReservedIP(getripoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the instance network interface(%s): %s\n%s", *instance.PrimaryNetworkInterface.PrimaryIP.ID, *instance.PrimaryNetworkInterface.ID, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error getting network interface reserved ip(%s) attached to the instance network interface(%s)", *instance.PrimaryNetworkInterface.PrimaryIP.ID, *instance.PrimaryNetworkInterface.ID), err, response)
 		}
 		currentPrimIp[isInstanceNicReservedIpAutoDelete] = insRip.AutoDelete
 
@@ -3218,7 +3353,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		insnic, response, err := instanceC.GetInstanceNetworkInterface(getnicoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
+			return flex.NewServiceError("Error getting network interfaces attached to the instance", err, response)
 		}
 		currentPrimNic[isInstanceNicAllowIPSpoofing] = *insnic.AllowIPSpoofing
 		if insnic.PortSpeed != nil {
@@ -3237,6 +3372,30 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 		d.Set(isInstancePrimaryNetworkInterface, primaryNicList)
 	}
 
+	if instance.PrimaryNetworkAttachment != nil {
+		getAttachmentOptions := &vpcv1.GetInstanceNetworkAttachmentOptions{
+			InstanceID: &id,
+			ID:         instance.PrimaryNetworkAttachment.ID,
+		}
+		attachment, response, err := instanceC.GetInstanceNetworkAttachment(getAttachmentOptions)
+		if err != nil {
+			return flex.NewServiceError("Error getting the primary network attachment of the instance", err, response)
+		}
+		attachmentMap := map[string]interface{}{
+			"id": *attachment.ID,
+		}
+		if attachment.Name != nil {
+			attachmentMap["name"] = *attachment.Name
+		}
+		if attachment.VirtualNetworkInterface != nil && attachment.VirtualNetworkInterface.ID != nil {
+			attachmentMap["virtual_network_interface"] = *attachment.VirtualNetworkInterface.ID
+		}
+		if attachment.Subnet != nil && attachment.Subnet.ID != nil {
+			attachmentMap["subnet"] = *attachment.Subnet.ID
+		}
+		d.Set(isInstancePrimaryNetworkAttachment, []map[string]interface{}{attachmentMap})
+	}
+
 	if instance.NetworkInterfaces != nil {
 		interfacesList := make([]map[string]interface{}, 0)
 		for _, intfc := range instance.NetworkInterfaces {
@@ -3272,7 +3431,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 				}
 				insRip, response, err := instanceC.GetSubnetReservedIP(getripoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the instance network interface(%s): %s\n%s", *intfc.PrimaryIP.ID, *intfc.ID, err, response)
+					return flex.NewServiceError(fmt.Sprintf("Error getting network interface reserved ip(%s) attached to the instance network interface(%s)", *intfc.PrimaryIP.ID, *intfc.ID), err, response)
 				}
 				currentPrimIp[isInstanceNicReservedIpAutoDelete] = insRip.AutoDelete
 
@@ -3285,7 +3444,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 				}
 				insnic, response, err := instanceC.GetInstanceNetworkInterface(getnicoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error getting network interfaces attached to the instance %s\n%s", err, response)
+					return flex.NewServiceError("Error getting network interfaces attached to the instance", err, response)
 				}
 				currentNic[isInstanceNicAllowIPSpoofing] = *insnic.AllowIPSpoofing
 				currentNic[isInstanceNicSubnet] = *insnic.Subnet.ID
@@ -3371,7 +3530,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 			}
 			bootVolumeAtt, response, err := instanceC.GetInstanceVolumeAttachment(getinsVolAttOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error getting Instance boot volume attachment : %s\n%s", err, response)
+				return flex.NewServiceError("Error getting Instance boot volume attachment", err, response)
 			}
 
 			bootVol[isInstanceVolAttVolAutoDelete] = *bootVolumeAtt.DeleteVolumeOnInstanceDelete
@@ -3405,13 +3564,13 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of resource Instance (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isInstanceTags, tags)
+	flex.SetResourceTags(d, meta, isInstanceTags, isInstanceUserTagType, tags)
 	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *instance.CRN, "", isInstanceAccessTagType)
 	if err != nil {
 		log.Printf(
 			"Error on get of resource Instance (%s) access tags: %s", d.Id(), err)
 	}
-	d.Set(isInstanceAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isInstanceAccessTags, isInstanceAccessTagType, accesstags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -3495,7 +3654,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		vol, res, err := instanceC.UpdateVolume(updateVolumeOptions)
 
 		if vol == nil || err != nil {
-			return (fmt.Errorf("[ERROR] Error encountered while expanding boot volume of instance %s/n%s", err, res))
+			return (flex.NewServiceError("Error encountered while expanding boot volume of instance", err, res))
 		}
 
 		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutUpdate))
@@ -3529,14 +3688,14 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				_, response, err := instanceC.GetVolume(optionsget)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error getting Boot Volume (%s): %s\n%s", id, err, response)
+					return flex.NewServiceError(fmt.Sprintf("Error getting Boot Volume (%s)", id), err, response)
 				}
 				eTag := response.Headers.Get("ETag")
 				updateVolumeOptions.IfMatch = &eTag
 				updateVolumeOptions.VolumePatch = volumePatch
 				vol, res, err := instanceC.UpdateVolume(updateVolumeOptions)
 				if vol == nil || err != nil {
-					return (fmt.Errorf("[ERROR] Error encountered while applying tags for boot volume of instance %s/n%s", err, res))
+					return (flex.NewServiceError("Error encountered while applying tags for boot volume of instance", err, res))
 				}
 				_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
 				if err != nil {
@@ -3566,7 +3725,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		vol, res, err := instanceC.UpdateVolume(updateVolumeOptions)
 
 		if vol == nil || err != nil {
-			return (fmt.Errorf("[ERROR] Error encountered while updating name of boot volume of instance %s/n%s", err, res))
+			return (flex.NewServiceError("Error encountered while updating name of boot volume of instance", err, res))
 		}
 	}
 	bootVolAutoDel := "boot_volume.0.auto_delete_volume"
@@ -3622,7 +3781,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			return flex.NewServiceError("Error Creating Instance Action", err, response)
 		}
 		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 		if err != nil {
@@ -3669,7 +3828,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			return flex.NewServiceError("Error Creating Instance Action", err, response)
 		}
 		_, err = isWaitForInstanceActionStart(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 		if err != nil {
@@ -3686,7 +3845,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			instance, response, err := instanceC.GetInstance(getinsOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
+				return flex.NewServiceError(fmt.Sprintf("Error Getting Instance (%s)", id), err, response)
 			}
 			if (actiontype == "stop" || actiontype == "reboot") && *instance.Status != isInstanceStatusRunning {
 				d.Set(isInstanceAction, nil)
@@ -3705,7 +3864,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+				return flex.NewServiceError("Error Creating Instance Action", err, response)
 			}
 			if actiontype == "stop" {
 				_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
@@ -3791,6 +3950,24 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("primary_network_attachment.0.name") && !d.IsNewResource() {
+		attachmentID := d.Get("primary_network_attachment.0.id").(string)
+		name := d.Get("primary_network_attachment.0.name").(string)
+		attachmentPatch, err := (&vpcv1.InstanceNetworkAttachmentPatch{Name: &name}).AsPatch()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error calling asPatch for InstanceNetworkAttachmentPatch: %s", err)
+		}
+		updateAttachmentOptions := &vpcv1.UpdateInstanceNetworkAttachmentOptions{
+			InstanceID:                     &id,
+			ID:                             &attachmentID,
+			InstanceNetworkAttachmentPatch: attachmentPatch,
+		}
+		_, response, err := instanceC.UpdateInstanceNetworkAttachment(updateAttachmentOptions)
+		if err != nil {
+			return flex.NewServiceError("Error updating the primary network attachment of the instance", err, response)
+		}
+	}
+
 	if d.HasChange("primary_network_interface.0.security_groups") && !d.IsNewResource() {
 		ovs, nvs := d.GetChange("primary_network_interface.0.security_groups")
 		ov := ovs.(*schema.Set)
@@ -3806,7 +3983,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				_, response, err := instanceC.CreateSecurityGroupTargetBinding(createsgnicoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error while creating security group %q for primary network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
+					return flex.NewServiceError(fmt.Sprintf("Error while creating security group %q for primary network interface of instance %s", add[i], d.Id()), err, response)
 				}
 				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
@@ -3824,7 +4001,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				response, err := instanceC.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error while removing security group %q for primary network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+					return flex.NewServiceError(fmt.Sprintf("Error while removing security group %q for primary network interface of instance %s", remove[i], d.Id()), err, response)
 				}
 				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
@@ -3857,7 +4034,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 		_, response, err := instanceC.UpdateSubnetReservedIP(updateripoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating instance network interface reserved ip(%s): %s\n%s", ripId, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error updating instance network interface reserved ip(%s)", ripId), err, response)
 		}
 	}
 
@@ -3882,7 +4059,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		_, response, err := instanceC.UpdateInstanceNetworkInterface(updatepnicfoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error while updating name %s for primary network interface of instance %s\n%s: %q", newName, d.Id(), err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error while updating name %s for primary network interface of instance %s", newName, d.Id()), err, response)
 		}
 		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
@@ -3923,7 +4100,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 				_, response, err := instanceC.UpdateSubnetReservedIP(updateripoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error updating instance network interface reserved ip(%s): %s\n%s", ripId, err, response)
+					return flex.NewServiceError(fmt.Sprintf("Error updating instance network interface reserved ip(%s)", ripId), err, response)
 				}
 			}
 
@@ -3943,7 +4120,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						_, response, err := instanceC.CreateSecurityGroupTargetBinding(createsgnicoptions)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error while creating security group %q for network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
+							return flex.NewServiceError(fmt.Sprintf("Error while creating security group %q for network interface of instance %s", add[i], d.Id()), err, response)
 						}
 						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
@@ -3962,7 +4139,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						response, err := instanceC.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+							return flex.NewServiceError(fmt.Sprintf("Error while removing security group %q for network interface of instance %s", remove[i], d.Id()), err, response)
 						}
 						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
@@ -3995,7 +4172,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 				_, response, err := instanceC.UpdateInstanceNetworkInterface(updatepnicfoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error while updating name %s for network interface of instance %s\n%s: %q", newName, d.Id(), err, response)
+					return flex.NewServiceError(fmt.Sprintf("Error while updating name %s for network interface of instance %s", newName, d.Id()), err, response)
 				}
 				if err != nil {
 					return err
@@ -4146,7 +4323,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				d.SetId("")
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error Getting Instance (%s)", id), err, response)
 		}
 
 		if instance != nil && *instance.Status == "running" {
@@ -4160,7 +4337,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				if response != nil && response.StatusCode == 404 {
 					return nil
 				}
-				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+				return flex.NewServiceError("Error Creating Instance Action", err, response)
 			}
 			_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 			if err != nil {
@@ -4187,7 +4364,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		_, response, err = instanceC.UpdateInstance(updnetoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error in UpdateInstancePatch: %s\n%s", err, response)
+			return flex.NewServiceError("Error in UpdateInstancePatch", err, response)
 		}
 
 		actiontype := "start"
@@ -4200,7 +4377,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			return flex.NewServiceError("Error Creating Instance Action", err, response)
 		}
 		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
@@ -4214,9 +4391,9 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	instance, response, err := instanceC.GetInstance(getinsOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+		return flex.NewServiceError("Error Getting Instance", err, response)
 	}
-	if d.HasChange(isInstanceTags) {
+	if flex.TagsHasChange(d, isInstanceTags) {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
@@ -4224,7 +4401,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				"Error on update of resource Instance (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if d.HasChange(isInstanceAccessTags) {
+	if flex.AccessTagsHasChange(d, isInstanceAccessTags) {
 		oldList, newList := d.GetChange(isInstanceAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
@@ -4237,6 +4414,24 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceIBMisInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
+	if replaced, _ := d.GetChange(isInstanceReplacedInstance); replaced.(string) != "" {
+		err := instanceReplacementDeleteReplaced(d, meta, replaced.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	if instanceReplacementRequired(d) {
+		err := instanceReplace(d, meta)
+		if err != nil {
+			return err
+		}
+		// the new instance is created from the configuration, so only the
+		// changes that a create applies afterwards, such as volume
+		// attachments, are left for instanceUpdate
+		d.MarkNewResource()
+	}
+
 	err := instanceUpdate(d, meta)
 	if err != nil {
 		return err
@@ -4261,7 +4456,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Instance (%s)", id), err, response)
 	}
 
 	bootvolid := ""
@@ -4277,7 +4472,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			return flex.NewServiceError("Error Creating Instance Action", err, response)
 		}
 		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutDelete), id, d)
 		if err != nil {
@@ -4288,7 +4483,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		vols, response, err := instanceC.ListInstanceVolumeAttachments(listvolattoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Listing volume attachments to the instance: %s\n%s", err, response)
+			return flex.NewServiceError("Error Listing volume attachments to the instance", err, response)
 		}
 		for _, vol := range vols.VolumeAttachments {
 			if *vol.Type == "data" {
@@ -4337,6 +4532,13 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 
 func resourceIBMisInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 
+	if replaced := d.Get(isInstanceReplacedInstance).(string); replaced != "" {
+		err := instanceReplacementDeleteReplaced(d, meta, replaced)
+		if err != nil {
+			return err
+		}
+	}
+
 	id := d.Id()
 	err := instanceDelete(d, meta, id)
 	if err != nil {
@@ -4360,7 +4562,7 @@ func instanceExists(d *schema.ResourceData, meta interface{}, id string) (bool,
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error Getting Instance", err, response)
 	}
 	return true, nil
 }
@@ -4388,7 +4590,7 @@ func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id
 				if response != nil && response.StatusCode == 404 {
 					return instance, isInstanceDeleteDone, nil
 				}
-				return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+				return nil, "", flex.NewServiceError("Error Getting Instance", err, response)
 			}
 			if *instance.Status == isInstanceFailed {
 				return instance, *instance.Status, fmt.Errorf("[ERROR] The  instance %s failed to delete: %v", d.Id(), err)
@@ -4414,7 +4616,7 @@ func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration,
 			}
 			instance, response, err := instanceC.GetInstance(getinsoptions)
 			if err != nil {
-				return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+				return nil, "", flex.NewServiceError("Error Getting Instance", err, response)
 			}
 			select {
 			case data := <-communicator:
@@ -4453,7 +4655,7 @@ func isWaitForInstanceActionStart(instanceC *vpcv1.VpcV1, timeout time.Duration,
 			}
 			instance, response, err := instanceC.GetInstance(getinsoptions)
 			if err != nil {
-				return nil, "", fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
+				return nil, "", flex.NewServiceError("Error Getting Instance", err, response)
 			}
 			select {
 			case data := <-communicator:
@@ -4496,7 +4698,7 @@ func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 			}
 			_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				communicator <- fmt.Errorf("[ERROR] Error retrying instance action stop: %s\n%s", err, response)
+				communicator <- flex.NewServiceError("Error retrying instance action stop", err, response)
 				return
 			}
 		case <-communicator:
@@ -4531,7 +4733,7 @@ func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resou
 		}
 		vol, response, err := instanceC.GetInstanceVolumeAttachment(getvolattoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Attaching volume: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Attaching volume", err, response)
 		}
 
 		if *vol.Status == isInstanceVolumeAttached {
@@ -4557,7 +4759,7 @@ func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceD
 				if response != nil && response.StatusCode == 404 {
 					return vol, isInstanceDeleteDone, nil
 				}
-				return nil, "", fmt.Errorf("[ERROR] Error Detaching: %s\n%s", err, response)
+				return nil, "", flex.NewServiceError("Error Detaching", err, response)
 			}
 			if *vol.Status == isInstanceFailed {
 				return vol, *vol.Status, fmt.Errorf("[ERROR] The instance %s failed to detach volume %s: %v", d.Id(), volID, err)
@@ -4643,3 +4845,23 @@ func GetInstanceMetadataServiceOptions(d *schema.ResourceData) (metadataService
 	}
 	return nil
 }
+
+// expandInstancePrimaryNetworkAttachment returns the primary network attachment prototype of the
+// existing virtual network interface, nil when the instance uses a primary network interface
+func expandInstancePrimaryNetworkAttachment(d *schema.ResourceData) *vpcv1.InstanceNetworkAttachmentPrototype {
+	vniID, ok := d.GetOk("primary_network_attachment.0.virtual_network_interface")
+	if !ok {
+		return nil
+	}
+	vniIDStr := vniID.(string)
+	attachment := &vpcv1.InstanceNetworkAttachmentPrototype{
+		VirtualNetworkInterface: &vpcv1.InstanceNetworkAttachmentPrototypeVirtualNetworkInterface{
+			ID: &vniIDStr,
+		},
+	}
+	if name, ok := d.GetOk("primary_network_attachment.0.name"); ok {
+		nameStr := name.(string)
+		attachment.Name = &nameStr
+	}
+	return attachment
+}
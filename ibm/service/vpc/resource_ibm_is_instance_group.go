@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -43,7 +42,7 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -156,6 +155,13 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for instance group",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
 
 			isInstanceGroupAccessTags: {
 				Type:        schema.TypeSet,
@@ -273,7 +279,7 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 
 	instanceGroup, response, err := sess.CreateInstanceGroup(&instanceGroupOptions)
 	if err != nil || instanceGroup == nil {
-		return fmt.Errorf("[ERROR] Error Creating InstanceGroup: %s\n%s", err, response)
+		return flex.NewServiceError("Error Creating InstanceGroup", err, response)
 	}
 	d.SetId(*instanceGroup.ID)
 
@@ -282,8 +288,7 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 		return healthError
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, "tags") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN, "", isInstanceGroupUserTagType)
 		if err != nil {
@@ -315,12 +320,12 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 	instanceGroupUpdateOptions := vpcv1.UpdateInstanceGroupOptions{}
 	instanceGroupPatchModel := vpcv1.InstanceGroupPatch{}
 
-	if d.HasChange("tags") {
+	if flex.TagsHasChange(d, "tags") {
 		instanceGroupID := d.Id()
 		getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
 		instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
 		if err != nil || instanceGroup == nil {
-			return fmt.Errorf("[ERROR] Error getting instance group: %s\n%s", err, response)
+			return flex.NewServiceError("Error getting instance group", err, response)
 		}
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN)
@@ -382,7 +387,7 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 		instanceGroupUpdateOptions.InstanceGroupPatch = instanceGroupPatch
 		_, response, err := sess.UpdateInstanceGroup(&instanceGroupUpdateOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating InstanceGroup: %s\n%s", err, response)
+			return flex.NewServiceError("Error Updating InstanceGroup", err, response)
 		}
 
 		// wait for instance group health update with update timeout configured.
@@ -408,7 +413,7 @@ func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting InstanceGroup: %s\n%s", err, response)
+		return flex.NewServiceError("Error Getting InstanceGroup", err, response)
 	}
 	d.Set("name", *instanceGroup.Name)
 	d.Set("instance_template", *instanceGroup.InstanceTemplate.ID)
@@ -443,7 +448,7 @@ func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) er
 		log.Printf(
 			"Error on get of instance group (%s) tags: %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	flex.SetResourceTags(d, meta, "tags", "user", tags)
 	return nil
 }
 
@@ -453,7 +458,7 @@ func getLBStatus(sess *vpcv1.VpcV1, lbId string) (string, error) {
 	}
 	lb, response, err := sess.GetLoadBalancer(getlboptions)
 	if err != nil || lb == nil {
-		return "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
+		return "", flex.NewServiceError("Error Getting Load Balancer", err, response)
 	}
 	return *lb.ProvisioningStatus, nil
 }
@@ -492,7 +497,7 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 	instanceGroupUpdateOptions.InstanceGroupPatch = instanceGroupPatch
 	_, response, err = sess.UpdateInstanceGroup(&instanceGroupUpdateOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating instanceGroup's instance count to 0 : %s\n%s", err, response)
+		return flex.NewServiceError("Error updating instanceGroup's instance count to 0", err, response)
 	}
 	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 	if healthError != nil {
@@ -533,7 +538,7 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting the InstanceGroup: %s\n%s", Err, response)
+		return flex.NewServiceError("Error Deleting the InstanceGroup", Err, response)
 	}
 
 	_, deleteError := waitForInstanceGroupDelete(d, meta)
@@ -555,7 +560,7 @@ func resourceIBMISInstanceGroupExists(d *schema.ResourceData, meta interface{})
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting InstanceGroup: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error Getting InstanceGroup", err, response)
 	}
 	return true, nil
 }
@@ -574,7 +579,7 @@ func waitForHealthyInstanceGroup(instanceGroupID string, meta interface{}, timeo
 		Refresh: func() (interface{}, string, error) {
 			instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
 			if err != nil || instanceGroup == nil {
-				return nil, SCALING, fmt.Errorf("[ERROR] Error Getting InstanceGroup: %s\n%s", err, response)
+				return nil, SCALING, flex.NewServiceError("Error Getting InstanceGroup", err, response)
 			}
 			log.Println("Status : ", *instanceGroup.Status)
 
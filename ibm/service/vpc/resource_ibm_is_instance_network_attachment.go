@@ -0,0 +1,387 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func ResourceIBMIsInstanceNetworkAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsInstanceNetworkAttachmentCreate,
+		ReadContext:   resourceIBMIsInstanceNetworkAttachmentRead,
+		UpdateContext: resourceIBMIsInstanceNetworkAttachmentUpdate,
+		DeleteContext: resourceIBMIsInstanceNetworkAttachmentDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The virtual server instance identifier.",
+			},
+			"virtual_network_interface": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The identifier of the virtual network interface to attach to the instance.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_instance_network_attachment", "name"),
+				Description:  "The name for this instance network attachment. The name is unique across all network attachments for the instance.",
+			},
+			"network_attachment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The instance network attachment identifier.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the instance network attachment was created.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this instance network attachment.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the instance network attachment.",
+			},
+			"port_speed": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The port speed for this instance network attachment in Mbps.",
+			},
+			"primary_ip": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The primary IP address of the virtual network interface for the instance network attachment.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this reserved IP.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this reserved IP.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name for this reserved IP.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type.",
+						},
+					},
+				},
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+			"subnet": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subnet of the virtual network interface for the instance network attachment.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The instance network attachment type.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsInstanceNetworkAttachmentValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9]|[0-9][-a-z0-9]*([a-z]|[-a-z][-a-z0-9]*[a-z0-9]))$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_network_attachment", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsInstanceNetworkAttachmentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get("instance").(string)
+	vniID := d.Get("virtual_network_interface").(string)
+	createInstanceNetworkAttachmentOptions := &vpcv1.CreateInstanceNetworkAttachmentOptions{
+		InstanceID: &instanceID,
+		VirtualNetworkInterface: &vpcv1.InstanceNetworkAttachmentPrototypeVirtualNetworkInterface{
+			ID: &vniID,
+		},
+	}
+	if name, ok := d.GetOk("name"); ok {
+		createInstanceNetworkAttachmentOptions.SetName(name.(string))
+	}
+
+	instanceNetworkAttachment, response, err := sess.CreateInstanceNetworkAttachmentWithContext(context, createInstanceNetworkAttachmentOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateInstanceNetworkAttachmentWithContext failed %s\n%s", err, response)
+		return flex.NewServiceError("CreateInstanceNetworkAttachmentWithContext failed", err, response).Diagnostics()
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, *instanceNetworkAttachment.ID))
+
+	_, err = isWaitForInstanceNetworkAttachmentStable(context, sess, instanceID, *instanceNetworkAttachment.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsInstanceNetworkAttachmentRead(context, d, meta)
+}
+
+func resourceIBMIsInstanceNetworkAttachmentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getInstanceNetworkAttachmentOptions := &vpcv1.GetInstanceNetworkAttachmentOptions{}
+	getInstanceNetworkAttachmentOptions.SetInstanceID(parts[0])
+	getInstanceNetworkAttachmentOptions.SetID(parts[1])
+
+	instanceNetworkAttachment, response, err := sess.GetInstanceNetworkAttachmentWithContext(context, getInstanceNetworkAttachmentOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetInstanceNetworkAttachmentWithContext failed %s\n%s", err, response)
+		return flex.NewServiceError("GetInstanceNetworkAttachmentWithContext failed", err, response).Diagnostics()
+	}
+
+	if err = d.Set("instance", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting instance: %s", err))
+	}
+	if err = d.Set("network_attachment", instanceNetworkAttachment.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting network_attachment: %s", err))
+	}
+	if instanceNetworkAttachment.VirtualNetworkInterface != nil {
+		if err = d.Set("virtual_network_interface", instanceNetworkAttachment.VirtualNetworkInterface.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting virtual_network_interface: %s", err))
+		}
+	}
+	if err = d.Set("name", instanceNetworkAttachment.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if err = d.Set("created_at", flex.DateTimeToString(instanceNetworkAttachment.CreatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_at: %s", err))
+	}
+	if err = d.Set("href", instanceNetworkAttachment.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting href: %s", err))
+	}
+	if err = d.Set("lifecycle_state", instanceNetworkAttachment.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting lifecycle_state: %s", err))
+	}
+	if err = d.Set("port_speed", flex.IntValue(instanceNetworkAttachment.PortSpeed)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting port_speed: %s", err))
+	}
+	primaryIP := []map[string]interface{}{}
+	if instanceNetworkAttachment.PrimaryIP != nil {
+		modelMap, err := dataSourceIBMIsVirtualNetworkInterfaceReservedIPReferenceToMap(instanceNetworkAttachment.PrimaryIP)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		delete(modelMap, "deleted")
+		primaryIP = append(primaryIP, modelMap)
+	}
+	if err = d.Set("primary_ip", primaryIP); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting primary_ip: %s", err))
+	}
+	if err = d.Set("resource_type", instanceNetworkAttachment.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_type: %s", err))
+	}
+	if instanceNetworkAttachment.Subnet != nil {
+		if err = d.Set("subnet", instanceNetworkAttachment.Subnet.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting subnet: %s", err))
+		}
+	}
+	if err = d.Set("type", instanceNetworkAttachment.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting type: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIsInstanceNetworkAttachmentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		instanceNetworkAttachmentPatchModel := &vpcv1.InstanceNetworkAttachmentPatch{
+			Name: core.StringPtr(d.Get("name").(string)),
+		}
+		instanceNetworkAttachmentPatch, err := instanceNetworkAttachmentPatchModel.AsPatch()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for InstanceNetworkAttachmentPatch: %s", err))
+		}
+		updateInstanceNetworkAttachmentOptions := &vpcv1.UpdateInstanceNetworkAttachmentOptions{
+			InstanceID:                     &parts[0],
+			ID:                             &parts[1],
+			InstanceNetworkAttachmentPatch: instanceNetworkAttachmentPatch,
+		}
+		_, response, err := sess.UpdateInstanceNetworkAttachmentWithContext(context, updateInstanceNetworkAttachmentOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateInstanceNetworkAttachmentWithContext failed %s\n%s", err, response)
+			return flex.NewServiceError("UpdateInstanceNetworkAttachmentWithContext failed", err, response).WithAttribute(cty.GetAttrPath("name")).Diagnostics()
+		}
+	}
+
+	return resourceIBMIsInstanceNetworkAttachmentRead(context, d, meta)
+}
+
+func resourceIBMIsInstanceNetworkAttachmentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteInstanceNetworkAttachmentOptions := &vpcv1.DeleteInstanceNetworkAttachmentOptions{}
+	deleteInstanceNetworkAttachmentOptions.SetInstanceID(parts[0])
+	deleteInstanceNetworkAttachmentOptions.SetID(parts[1])
+
+	response, err := sess.DeleteInstanceNetworkAttachmentWithContext(context, deleteInstanceNetworkAttachmentOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteInstanceNetworkAttachmentWithContext failed %s\n%s", err, response)
+		return flex.NewServiceError("DeleteInstanceNetworkAttachmentWithContext failed", err, response).Diagnostics()
+	}
+	_, err = isWaitForInstanceNetworkAttachmentDeleted(context, sess, parts[0], parts[1], d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForInstanceNetworkAttachmentStable(context context.Context, sess *vpcv1.VpcV1, instanceID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for instance network attachment (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending", "updating"},
+		Target:  []string{"stable"},
+		Refresh: func() (interface{}, string, error) {
+			getInstanceNetworkAttachmentOptions := &vpcv1.GetInstanceNetworkAttachmentOptions{}
+			getInstanceNetworkAttachmentOptions.SetInstanceID(instanceID)
+			getInstanceNetworkAttachmentOptions.SetID(id)
+			instanceNetworkAttachment, response, err := sess.GetInstanceNetworkAttachmentWithContext(context, getInstanceNetworkAttachmentOptions)
+			if err != nil {
+				return nil, "", flex.NewServiceError("Error getting instance network attachment", err, response)
+			}
+			if *instanceNetworkAttachment.LifecycleState == "failed" {
+				return instanceNetworkAttachment, *instanceNetworkAttachment.LifecycleState, fmt.Errorf("[ERROR] The instance network attachment %s failed", id)
+			}
+			return instanceNetworkAttachment, *instanceNetworkAttachment.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func isWaitForInstanceNetworkAttachmentDeleted(context context.Context, sess *vpcv1.VpcV1, instanceID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for instance network attachment (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"deleting", "stable", "updating"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			getInstanceNetworkAttachmentOptions := &vpcv1.GetInstanceNetworkAttachmentOptions{}
+			getInstanceNetworkAttachmentOptions.SetInstanceID(instanceID)
+			getInstanceNetworkAttachmentOptions.SetID(id)
+			instanceNetworkAttachment, response, err := sess.GetInstanceNetworkAttachmentWithContext(context, getInstanceNetworkAttachmentOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return instanceNetworkAttachment, "done", nil
+				}
+				return nil, "", flex.NewServiceError("Error getting instance network attachment", err, response)
+			}
+			if *instanceNetworkAttachment.LifecycleState == "failed" {
+				return instanceNetworkAttachment, *instanceNetworkAttachment.LifecycleState, fmt.Errorf("[ERROR] The instance network attachment %s failed to delete", id)
+			}
+			return instanceNetworkAttachment, "deleting", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestAccIBMIsInstanceNetworkAttachmentBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	insname := fmt.Sprintf("tf-instance-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-ina-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-ina-update-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+    ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+    `)
+	terraformTag := "ibm_is_instance_network_attachment.testacc_ina"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsInstanceNetworkAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsInstanceNetworkAttachmentConfig(vpcname, subnetname, sshname, publicKey, insname, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIsInstanceNetworkAttachmentExists(terraformTag),
+					resource.TestCheckResourceAttr(terraformTag, "name", name),
+					resource.TestCheckResourceAttrPair(terraformTag, "virtual_network_interface", "ibm_is_virtual_network_interface.testacc_vni_secondary", "id"),
+					resource.TestCheckResourceAttrPair("ibm_is_instance.testacc_instance", "primary_network_attachment.0.virtual_network_interface", "ibm_is_virtual_network_interface.testacc_vni_primary", "id"),
+					resource.TestCheckResourceAttrSet(terraformTag, "primary_ip.0.address"),
+					resource.TestCheckResourceAttr(terraformTag, "lifecycle_state", "stable"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsInstanceNetworkAttachmentConfig(vpcname, subnetname, sshname, publicKey, insname, nameUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIsInstanceNetworkAttachmentExists(terraformTag),
+					resource.TestCheckResourceAttr(terraformTag, "name", nameUpdate),
+				),
+			},
+			{
+				ResourceName:      terraformTag,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsInstanceNetworkAttachmentConfig(vpcname, subnetname, sshname, publicKey, insname, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	}
+
+	resource "ibm_is_virtual_network_interface" "testacc_vni_primary" {
+		subnet = ibm_is_subnet.testacc_subnet.id
+	}
+
+	resource "ibm_is_virtual_network_interface" "testacc_vni_secondary" {
+		subnet = ibm_is_subnet.testacc_subnet.id
+	}
+
+	resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		primary_network_attachment {
+			virtual_network_interface = ibm_is_virtual_network_interface.testacc_vni_primary.id
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		keys = [ibm_is_ssh_key.testacc_sshkey.id]
+	}
+
+	resource "ibm_is_instance_network_attachment" "testacc_ina" {
+		instance                  = ibm_is_instance.testacc_instance.id
+		virtual_network_interface = ibm_is_virtual_network_interface.testacc_vni_secondary.id
+		name                      = "%s"
+	}
+	`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, publicKey, insname, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName, name)
+}
+
+func testAccCheckIBMIsInstanceNetworkAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+
+		getInstanceNetworkAttachmentOptions := &vpcv1.GetInstanceNetworkAttachmentOptions{}
+		getInstanceNetworkAttachmentOptions.SetInstanceID(parts[0])
+		getInstanceNetworkAttachmentOptions.SetID(parts[1])
+
+		_, _, err = vpcClient.GetInstanceNetworkAttachment(getInstanceNetworkAttachmentOptions)
+		return err
+	}
+}
+
+func testAccCheckIBMIsInstanceNetworkAttachmentDestroy(s *terraform.State) error {
+	vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_instance_network_attachment" {
+			continue
+		}
+
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+
+		getInstanceNetworkAttachmentOptions := &vpcv1.GetInstanceNetworkAttachmentOptions{}
+		getInstanceNetworkAttachmentOptions.SetInstanceID(parts[0])
+		getInstanceNetworkAttachmentOptions.SetID(parts[1])
+
+		_, response, err := vpcClient.GetInstanceNetworkAttachment(getInstanceNetworkAttachmentOptions)
+		if err == nil {
+			return fmt.Errorf("InstanceNetworkAttachment still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for InstanceNetworkAttachment (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -344,7 +344,7 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 	networkInterface, response, err := vpcClient.CreateInstanceNetworkInterfaceWithContext(context, createInstanceNetworkInterfaceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateInstanceNetworkInterfaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateInstanceNetworkInterfaceWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateInstanceNetworkInterfaceWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createInstanceNetworkInterfaceOptions.InstanceID, *networkInterface.ID))
@@ -368,7 +368,7 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 
 		if err != nil {
 			d.Set(isInstanceNicFloatingIP, "")
-			return diag.FromErr(fmt.Errorf("[DEBUG] Error adding Floating IP to network interface %s\n%s", err, response))
+			return flex.NewServiceError("Error adding Floating IP to network interface", err, response).Diagnostics()
 		}
 		_, err = isWaitForNetworkInterfaceAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
@@ -379,7 +379,7 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 
 	_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 	return resourceIBMIsInstanceNetworkInterfaceRead(context, d, meta)
 }
@@ -407,7 +407,7 @@ func resourceIBMIsInstanceNetworkInterfaceRead(context context.Context, d *schem
 			return nil
 		}
 		log.Printf("[DEBUG] GetInstanceNetworkInterfaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetInstanceNetworkInterfaceWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetInstanceNetworkInterfaceWithContext failed", err, response).Diagnostics()
 	}
 	d.SetId(fmt.Sprintf("%s/%s", parts[0], *networkInterface.ID))
 	d.Set("network_interface", *networkInterface.ID)
@@ -450,7 +450,7 @@ func resourceIBMIsInstanceNetworkInterfaceRead(context context.Context, d *schem
 		}
 		insRip, response, err := vpcClient.GetSubnetReservedIP(getripoptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the instance network interface(%s): %s\n%s", *networkInterface.PrimaryIP.ID, *networkInterface.ID, err, response))
+			return flex.NewServiceError(fmt.Sprintf("Error getting network interface reserved ip(%s) attached to the instance network interface(%s)", *networkInterface.PrimaryIP.ID, *networkInterface.ID), err, response).Diagnostics()
 		}
 		currentPrimIp[isInstanceNicReservedIpAutoDelete] = insRip.AutoDelete
 
@@ -574,7 +574,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 		updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 		_, response, err := vpcClient.UpdateSubnetReservedIP(updateripoptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating instance network interface reserved ip(%s): %s\n%s", ripId, err, response))
+			return flex.NewServiceError(fmt.Sprintf("Error updating instance network interface reserved ip(%s)", ripId), err, response).Diagnostics()
 		}
 	}
 
@@ -593,11 +593,11 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 				}
 				_, response, err := vpcClient.CreateSecurityGroupTargetBinding(createsgnicoptions)
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] Error while creating security group %q for network interface of instance %s\n%s: %q", add[i], d.Id(), err, response))
+					return flex.NewServiceError(fmt.Sprintf("Error while creating security group %q for network interface of instance %s", add[i], d.Id()), err, response).Diagnostics()
 				}
 				_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
-					return diag.FromErr(err)
+					return flex.DiagFromErr(err)
 				}
 			}
 
@@ -610,11 +610,11 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 				}
 				response, err := vpcClient.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response))
+					return flex.NewServiceError(fmt.Sprintf("Error while removing security group %q for network interface of instance %s", remove[i], d.Id()), err, response).Diagnostics()
 				}
 				_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
-					return diag.FromErr(err)
+					return flex.DiagFromErr(err)
 				}
 			}
 		}
@@ -628,7 +628,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 		_, response, err := vpcClient.UpdateInstanceNetworkInterfaceWithContext(context, updateInstanceNetworkInterfaceOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateInstanceNetworkInterfaceWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateInstanceNetworkInterfaceWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateInstanceNetworkInterfaceWithContext failed", err, response).Diagnostics()
 		}
 	}
 
@@ -643,7 +643,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 				if response.StatusCode == 404 {
 					log.Println("[DEBUG] The specified floating IP address is not associated with the network interface with the specified identifier. ", err.Error())
 				} else {
-					return diag.FromErr(fmt.Errorf("[ERROR] Error de-associating the floating ip %s in network interface %s of instance %s, %s\n%s", floating_ip_id_old.(string), network_interface_id, instance_id, err, response))
+					return flex.NewServiceError(fmt.Sprintf("Error de-associating the floating ip %s in network interface %s of instance %s", floating_ip_id_old.(string), network_interface_id, instance_id), err, response).Diagnostics()
 				}
 			}
 		} else {
@@ -657,7 +657,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 					d.SetId("")
 					return nil
 				}
-				return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Floating IP (%s): %s\n%s", floating_ip_id, err, response))
+				return flex.NewServiceError(fmt.Sprintf("Error Getting Floating IP (%s)", floating_ip_id), err, response).Diagnostics()
 
 			}
 
@@ -679,7 +679,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 
 			if err != nil {
 				d.Set(isInstanceNicFloatingIP, "")
-				return diag.FromErr(fmt.Errorf("[DEBUG] Error adding Floating IP to network interface %s\n%s", err, response))
+				return flex.NewServiceError("Error adding Floating IP to network interface", err, response).Diagnostics()
 			}
 		}
 
@@ -691,7 +691,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 	}
 	_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 	return resourceIBMIsInstanceNetworkInterfaceRead(context, d, meta)
 }
@@ -720,7 +720,7 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 	response, err := vpcClient.DeleteInstanceNetworkInterfaceWithContext(context, deleteInstanceNetworkInterfaceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteInstanceNetworkInterfaceWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteInstanceNetworkInterfaceWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteInstanceNetworkInterfaceWithContext failed", err, response).Diagnostics()
 	}
 
 	_, err = isWaitForNetworkInterfaceDelete(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
@@ -730,7 +730,7 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 
 	_, err = isWaitForInstanceAvailable(vpcClient, instance_id, d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 
 	d.SetId("")
@@ -767,7 +767,7 @@ func isNetworkInterfaceRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.
 
 		networkInterface, response, err := vpcClient.GetInstanceNetworkInterface(getInstanceNetworkInterfaceOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("GetInstanceNetworkInterface failed %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("GetInstanceNetworkInterface failed", err, response)
 		}
 		d.Set("status", *networkInterface.Status)
 
@@ -810,7 +810,7 @@ func isNetworkInterfaceRefreshDeleteFunc(vpcClient *vpcv1.VpcV1, id string, d *s
 			if response != nil && response.StatusCode == 404 {
 				return networkInterface, isNetworkInterfaceDeleted, nil
 			}
-			return nil, "", fmt.Errorf("GetInstanceNetworkInterface failed %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("GetInstanceNetworkInterface failed", err, response)
 		}
 		d.Set("status", *networkInterface.Status)
 
@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 const (
@@ -127,12 +129,12 @@ func resourceIBMISInstanceNetworkInterfaceFloatingIpCreate(context context.Conte
 
 	fip, response, err := sess.AddInstanceNetworkInterfaceFloatingIPWithContext(context, options)
 	if err != nil || fip == nil {
-		return diag.FromErr(fmt.Errorf("[DEBUG] Create Instance (%s) network interface (%s) floating ip (%s) err %s\n%s", instanceId, instanceNicId, instanceNicFipId, err, response))
+		return flex.NewServiceError(fmt.Sprintf("Create Instance (%s) network interface (%s) floating ip (%s)", instanceId, instanceNicId, instanceNicFipId), err, response).Diagnostics()
 	}
 	d.SetId(MakeTerraformNICFipID(instanceId, instanceNicId, *fip.ID))
 	err = instanceNICFipGet(d, fip, instanceId, instanceNicId)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 
 	return nil
@@ -160,11 +162,11 @@ func resourceIBMISInstanceNetworkInterfaceFloatingIpRead(context context.Context
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting Instance (%s) network interface (%s): %s\n%s", instanceId, nicID, err, response))
+		return flex.NewServiceError(fmt.Sprintf("Error getting Instance (%s) network interface (%s)", instanceId, nicID), err, response).Diagnostics()
 	}
 	err = instanceNICFipGet(d, fip, instanceId, nicID)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 	return nil
 }
@@ -211,7 +213,7 @@ func resourceIBMISInstanceNetworkInterfaceFloatingIpUpdate(context context.Conte
 
 		fip, response, err := sess.AddInstanceNetworkInterfaceFloatingIPWithContext(context, options)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating Instance: %s\n%s", err, response))
+			return flex.NewServiceError("Error updating Instance", err, response).Diagnostics()
 		}
 		d.SetId(MakeTerraformNICFipID(instanceId, nicId, *fip.ID))
 		return diag.FromErr(instanceNICFipGet(d, fip, instanceId, nicId))
@@ -249,7 +251,7 @@ func instanceNetworkInterfaceFipDelete(context context.Context, d *schema.Resour
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Instance (%s) network interface(%s) Floating Ip(%s) : %s\n%s", instanceId, nicId, fipId, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting Instance (%s) network interface(%s) Floating Ip(%s)", instanceId, nicId, fipId), err, response)
 	}
 
 	options := &vpcv1.RemoveInstanceNetworkInterfaceFloatingIPOptions{
@@ -259,7 +261,7 @@ func instanceNetworkInterfaceFipDelete(context context.Context, d *schema.Resour
 	}
 	response, err = sess.RemoveInstanceNetworkInterfaceFloatingIPWithContext(context, options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Instance (%s) network interface (%s) Floating Ip(%s) : %s\n%s", instanceId, nicId, fipId, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Deleting Instance (%s) network interface (%s) Floating Ip(%s)", instanceId, nicId, fipId), err, response)
 	}
 	_, err = isWaitForInstanceNetworkInterfaceFloatingIpDeleted(sess, instanceId, nicId, fipId, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -297,7 +299,7 @@ func isInstanceNetworkInterfaceFloatingIpDeleteRefreshFunc(instanceC *vpcv1.VpcV
 			if response != nil && response.StatusCode == 404 {
 				return fip, isInstanceNetworkInterfaceFloatingIpDeleted, nil
 			}
-			return fip, isInstanceNetworkInterfaceFloatingIpFailed, fmt.Errorf("[ERROR] Error getting Instance(%s) Network Interface (%s) FloatingIp(%s) : %s\n%s", instanceId, nicId, fipId, err, response)
+			return fip, isInstanceNetworkInterfaceFloatingIpFailed, flex.NewServiceError(fmt.Sprintf("Error getting Instance(%s) Network Interface (%s) FloatingIp(%s)", instanceId, nicId, fipId), err, response)
 		}
 		return fip, isInstanceNetworkInterfaceFloatingIpDeleting, err
 	}
@@ -326,7 +328,7 @@ func isInstanceNetworkInterfaceFloatingIpRefreshFunc(client *vpcv1.VpcV1, instan
 		}
 		fip, response, err := client.GetInstanceNetworkInterfaceFloatingIP(getBmsNicFloatingIpOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting Instance (%s) Network Interface (%s) FloatingIp(%s) : %s\n%s", instanceId, nicId, fipId, err, response)
+			return nil, "", flex.NewServiceError(fmt.Sprintf("Error getting Instance (%s) Network Interface (%s) FloatingIp(%s)", instanceId, nicId, fipId), err, response)
 		}
 		status := ""
 
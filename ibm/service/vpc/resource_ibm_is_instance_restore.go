@@ -0,0 +1,645 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isInstanceRestoreSnapshotConsistencyGroup = "snapshot_consistency_group"
+	isInstanceRestoreSnapshots                = "snapshots"
+	isInstanceRestoreVolumeProfile            = "volume_profile"
+	isInstanceRestoreVolumeProfiles           = "volume_profiles"
+	isInstanceRestoreEncryptionKey            = "encryption_key"
+	isInstanceRestoreAutoDeleteVolumes        = "auto_delete_volumes"
+	isInstanceRestoreBootSnapshot             = "boot_snapshot"
+	isInstanceRestoreDataSnapshots            = "data_snapshots"
+	isInstanceRestorePrimaryNetworkInterface  = "primary_network_interface_id"
+	isInstanceRestorePrimaryIPAddress         = "primary_ip_address"
+	isInstanceRestoreBootVolumeAttachment     = "boot_volume_attachment"
+	isInstanceRestoreVolumeAttachments        = "volume_attachments"
+	isInstanceRestoreAttachmentID             = "id"
+	isInstanceRestoreAttachmentName           = "name"
+	isInstanceRestoreAttachmentDevice         = "device"
+	isInstanceRestoreVolumeID                 = "volume_id"
+	isInstanceRestoreVolumeName               = "volume_name"
+	isInstanceRestoreSourceSnapshot           = "source_snapshot"
+)
+
+func ResourceIBMISInstanceRestore() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMISInstanceRestoreCreate,
+		Read:     resourceIBMISInstanceRestoreRead,
+		Update:   resourceIBMISInstanceRestoreUpdate,
+		Delete:   resourceIBMISInstanceRestoreDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isInstanceName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_instance", isInstanceName),
+				Description:  "The name of the restored instance",
+			},
+
+			isInstanceRestoreSnapshotConsistencyGroup: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{isInstanceRestoreSnapshotConsistencyGroup, isInstanceRestoreSnapshots},
+				Description:  "The snapshot consistency group to restore the volumes from",
+			},
+
+			isInstanceRestoreSnapshots: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MinItems:     1,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{isInstanceRestoreSnapshotConsistencyGroup, isInstanceRestoreSnapshots},
+				Description:  "The snapshots to restore the volumes from, the data volumes are attached in this order",
+			},
+
+			isInstanceProfile: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The profile of the restored instance",
+			},
+
+			isInstanceZone: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The zone of the restored instance",
+			},
+
+			isInstanceVPC: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The VPC of the restored instance",
+			},
+
+			isInstanceNicSubnet: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The subnet of the primary network interface of the restored instance",
+			},
+
+			isInstanceNicSecurityGroups: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The security groups of the primary network interface of the restored instance",
+			},
+
+			isInstanceKeys: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The SSH keys of the restored instance",
+			},
+
+			isInstanceResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The resource group of the restored instance and volumes",
+			},
+
+			isInstanceRestoreVolumeProfile: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "general-purpose",
+				Description: "The profile of the restored volumes",
+			},
+
+			isInstanceRestoreVolumeProfiles: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The profiles of the restored volumes by snapshot ID, for the volumes that do not use volume_profile",
+			},
+
+			isInstanceRestoreEncryptionKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The CRN of the root key to encrypt the restored volumes with, by default the key of each snapshot",
+			},
+
+			isInstanceRestoreAutoDeleteVolumes: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "If set to true, the restored volumes are deleted with the instance",
+			},
+
+			isInstanceRestoreBootSnapshot: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The snapshot the boot volume is restored from",
+			},
+
+			isInstanceRestoreDataSnapshots: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The snapshots the data volumes are restored from, in the order of their attachments",
+			},
+
+			isInstanceRestoreBootVolumeAttachment: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        instanceRestoreAttachmentSchema(),
+				Description: "The boot volume attachment of the restored instance",
+			},
+
+			isInstanceRestoreVolumeAttachments: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        instanceRestoreAttachmentSchema(),
+				Description: "The data volume attachments of the restored instance, in the order of data_snapshots",
+			},
+
+			isInstanceRestorePrimaryNetworkInterface: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The primary network interface of the restored instance",
+			},
+
+			isInstanceRestorePrimaryIPAddress: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The primary IP address of the restored instance",
+			},
+
+			isInstanceStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the restored instance",
+			},
+
+			IsInstanceCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the restored instance",
+			},
+		},
+	}
+}
+
+func instanceRestoreAttachmentSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			isInstanceRestoreAttachmentID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The volume attachment identifier",
+			},
+			isInstanceRestoreAttachmentName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The volume attachment name",
+			},
+			isInstanceRestoreAttachmentDevice: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The device identifier of the volume attachment",
+			},
+			isInstanceRestoreVolumeID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The restored volume identifier",
+			},
+			isInstanceRestoreVolumeName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The restored volume name",
+			},
+			isInstanceRestoreSourceSnapshot: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The snapshot the volume is restored from",
+			},
+		},
+	}
+}
+
+func resourceIBMISInstanceRestoreCreate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := instanceRestoreSnapshots(d, sess)
+	if err != nil {
+		return err
+	}
+	bootSnapshot, dataSnapshots, err := instanceRestoreSplitSnapshots(snapshots)
+	if err != nil {
+		return err
+	}
+
+	name := d.Get(isInstanceName).(string)
+	profile := d.Get(isInstanceProfile).(string)
+	zone := d.Get(isInstanceZone).(string)
+	vpcID := d.Get(isInstanceVPC).(string)
+	subnet := d.Get(isInstanceNicSubnet).(string)
+	volumeProfile := d.Get(isInstanceRestoreVolumeProfile).(string)
+	volumeProfiles := d.Get(isInstanceRestoreVolumeProfiles).(map[string]interface{})
+	bootProfile := instanceRestoreVolumeProfile(volumeProfiles, bootSnapshot, volumeProfile)
+	autoDelete := d.Get(isInstanceRestoreAutoDeleteVolumes).(bool)
+	var encryptionKey vpcv1.EncryptionKeyIdentityIntf
+	if key, ok := d.GetOk(isInstanceRestoreEncryptionKey); ok {
+		keystr := key.(string)
+		encryptionKey = &vpcv1.EncryptionKeyIdentity{
+			CRN: &keystr,
+		}
+	}
+
+	instanceproto := &vpcv1.InstancePrototypeInstanceBySourceSnapshot{
+		Name: &name,
+		Zone: &vpcv1.ZoneIdentity{
+			Name: &zone,
+		},
+		Profile: &vpcv1.InstanceProfileIdentity{
+			Name: &profile,
+		},
+		VPC: &vpcv1.VPCIdentity{
+			ID: &vpcID,
+		},
+		PrimaryNetworkInterface: &vpcv1.NetworkInterfacePrototype{
+			Subnet: &vpcv1.SubnetIdentity{
+				ID: &subnet,
+			},
+		},
+		BootVolumeAttachment: &vpcv1.VolumeAttachmentPrototypeInstanceBySourceSnapshotContext{
+			DeleteVolumeOnInstanceDelete: &autoDelete,
+			Volume: &vpcv1.VolumePrototypeInstanceBySourceSnapshotContext{
+				SourceSnapshot: &vpcv1.SnapshotIdentity{
+					ID: &bootSnapshot,
+				},
+				Profile: &vpcv1.VolumeProfileIdentity{
+					Name: &bootProfile,
+				},
+				EncryptionKey: encryptionKey,
+			},
+		},
+	}
+
+	// the attachments are created in the order of the snapshots
+	volumeAttachments := make([]vpcv1.VolumeAttachmentPrototype, len(dataSnapshots))
+	for i := range dataSnapshots {
+		dataProfile := instanceRestoreVolumeProfile(volumeProfiles, dataSnapshots[i], volumeProfile)
+		volumeAttachments[i] = vpcv1.VolumeAttachmentPrototype{
+			DeleteVolumeOnInstanceDelete: &autoDelete,
+			Volume: &vpcv1.VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContextVolumePrototypeInstanceContextVolumeBySourceSnapshot{
+				SourceSnapshot: &vpcv1.SnapshotIdentity{
+					ID: &dataSnapshots[i],
+				},
+				Profile: &vpcv1.VolumeProfileIdentity{
+					Name: &dataProfile,
+				},
+				EncryptionKey: encryptionKey,
+			},
+		}
+	}
+	instanceproto.VolumeAttachments = volumeAttachments
+
+	secgrpSet := d.Get(isInstanceNicSecurityGroups).(*schema.Set)
+	if secgrpSet.Len() != 0 {
+		secgrpobjs := make([]vpcv1.SecurityGroupIdentityIntf, secgrpSet.Len())
+		for i, secgrpIntf := range secgrpSet.List() {
+			secgrpIntfstr := secgrpIntf.(string)
+			secgrpobjs[i] = &vpcv1.SecurityGroupIdentity{
+				ID: &secgrpIntfstr,
+			}
+		}
+		instanceproto.PrimaryNetworkInterface.SecurityGroups = secgrpobjs
+	}
+
+	keySet := d.Get(isInstanceKeys).(*schema.Set)
+	if keySet.Len() != 0 {
+		keyobjs := make([]vpcv1.KeyIdentityIntf, keySet.Len())
+		for i, key := range keySet.List() {
+			keystr := key.(string)
+			keyobjs[i] = &vpcv1.KeyIdentity{
+				ID: &keystr,
+			}
+		}
+		instanceproto.Keys = keyobjs
+	}
+
+	if grp, ok := d.GetOk(isInstanceResourceGroup); ok {
+		grpstr := grp.(string)
+		instanceproto.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+			ID: &grpstr,
+		}
+	}
+
+	options := &vpcv1.CreateInstanceOptions{
+		InstancePrototype: instanceproto,
+	}
+	instance, response, err := sess.CreateInstance(options)
+	if err != nil {
+		return flex.NewServiceError("Error restoring Instance", err, response)
+	}
+	d.SetId(*instance.ID)
+	log.Printf("[INFO] Instance : %s restored from %s and %v", *instance.ID, bootSnapshot, dataSnapshots)
+
+	d.Set(isInstanceRestoreBootSnapshot, bootSnapshot)
+	d.Set(isInstanceRestoreDataSnapshots, dataSnapshots)
+	result, err := isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	if err != nil {
+		return err
+	}
+	// the wait also ends on a failed or stopped instance
+	if instance, ok := result.(*vpcv1.Instance); !ok || instance.Status == nil || *instance.Status != isInstanceStatusRunning {
+		status := ""
+		if ok && instance.Status != nil {
+			status = *instance.Status
+		}
+		return fmt.Errorf("[ERROR] Error restoring instance (%s): the instance is %q instead of %q", d.Id(), status, isInstanceStatusRunning)
+	}
+	return resourceIBMISInstanceRestoreRead(d, meta)
+}
+
+// instanceRestoreSnapshots returns the snapshots of snapshot_consistency_group, in the order in
+// which their volumes are attached to the source instance, or the snapshots in the order of
+// snapshots. The snapshots must all be stable.
+func instanceRestoreSnapshots(d *schema.ResourceData, sess *vpcv1.VpcV1) ([]instanceRestoreSnapshot, error) {
+	ids := []string{}
+	group, fromGroup := d.GetOk(isInstanceRestoreSnapshotConsistencyGroup)
+	if fromGroup {
+		groupID := group.(string)
+		getSnapshotConsistencyGroupOptions := &vpcv1.GetSnapshotConsistencyGroupOptions{
+			ID: &groupID,
+		}
+		snapshotConsistencyGroup, response, err := sess.GetSnapshotConsistencyGroup(getSnapshotConsistencyGroupOptions)
+		if err != nil {
+			return nil, flex.NewServiceError("Error getting Snapshot Consistency Group", err, response)
+		}
+		if *snapshotConsistencyGroup.LifecycleState != isSnapshotAvailable {
+			return nil, fmt.Errorf("[ERROR] The snapshot consistency group %s is %s, only a stable snapshot consistency group can be restored", groupID, *snapshotConsistencyGroup.LifecycleState)
+		}
+		for _, snapshot := range snapshotConsistencyGroup.Snapshots {
+			ids = append(ids, *snapshot.ID)
+		}
+	} else {
+		ids = flex.ExpandStringList(d.Get(isInstanceRestoreSnapshots).([]interface{}))
+	}
+
+	snapshots := make([]instanceRestoreSnapshot, len(ids))
+	for i := range ids {
+		getSnapshotOptions := &vpcv1.GetSnapshotOptions{
+			ID: &ids[i],
+		}
+		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+		if err != nil {
+			return nil, flex.NewServiceError(fmt.Sprintf("Error getting Snapshot %s", ids[i]), err, response)
+		}
+		if *snapshot.LifecycleState != isSnapshotAvailable {
+			return nil, fmt.Errorf("[ERROR] The snapshot %s is %s, only a stable snapshot can be restored", ids[i], *snapshot.LifecycleState)
+		}
+		snapshots[i] = instanceRestoreSnapshot{
+			id:       *snapshot.ID,
+			bootable: *snapshot.Bootable,
+		}
+		if snapshot.SourceVolume != nil {
+			snapshots[i].sourceVolume = *snapshot.SourceVolume.ID
+		}
+	}
+	if fromGroup {
+		instanceRestoreSortSnapshots(snapshots, instanceRestoreSourceOrder(sess, snapshots))
+	}
+	return snapshots, nil
+}
+
+// instanceRestoreSourceOrder returns the position of each volume of the source instance in the
+// order in which it was attached, found from the source volume of the bootable snapshot. If the
+// source instance or its boot volume no longer exist, nothing is returned and the snapshots keep
+// their order.
+func instanceRestoreSourceOrder(sess *vpcv1.VpcV1, snapshots []instanceRestoreSnapshot) map[string]int {
+	bootVolume := ""
+	for _, snapshot := range snapshots {
+		if snapshot.bootable {
+			bootVolume = snapshot.sourceVolume
+		}
+	}
+	if bootVolume == "" {
+		return nil
+	}
+	volume, response, err := sess.GetVolume(&vpcv1.GetVolumeOptions{ID: &bootVolume})
+	if err != nil {
+		log.Printf("[WARN] Error getting the source volume %s of the boot snapshot, the snapshots are restored in the order of the snapshot consistency group: %s\n%s", bootVolume, err, response)
+		return nil
+	}
+	instanceID := ""
+	for _, attachment := range volume.VolumeAttachments {
+		if attachment.Instance != nil {
+			instanceID = *attachment.Instance.ID
+		}
+	}
+	if instanceID == "" {
+		log.Printf("[WARN] The source volume %s of the boot snapshot is not attached to an instance, the snapshots are restored in the order of the snapshot consistency group", bootVolume)
+		return nil
+	}
+	attachments, response, err := sess.ListInstanceVolumeAttachments(&vpcv1.ListInstanceVolumeAttachmentsOptions{InstanceID: &instanceID})
+	if err != nil {
+		log.Printf("[WARN] Error listing the volume attachments of the source instance %s, the snapshots are restored in the order of the snapshot consistency group: %s\n%s", instanceID, err, response)
+		return nil
+	}
+	sorted := attachments.VolumeAttachments
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return time.Time(*sorted[i].CreatedAt).Before(time.Time(*sorted[j].CreatedAt))
+	})
+	position := make(map[string]int, len(sorted))
+	for _, attachment := range sorted {
+		if attachment.Volume != nil {
+			position[*attachment.Volume.ID] = len(position)
+		}
+	}
+	return position
+}
+
+func resourceIBMISInstanceRestoreRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	id := d.Id()
+	getinsOptions := &vpcv1.GetInstanceOptions{
+		ID: &id,
+	}
+	instance, response, err := sess.GetInstance(getinsOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return flex.NewServiceError("Error Getting Instance", err, response)
+	}
+
+	d.Set(isInstanceName, *instance.Name)
+	d.Set(isInstanceProfile, *instance.Profile.Name)
+	d.Set(isInstanceZone, *instance.Zone.Name)
+	d.Set(isInstanceVPC, *instance.VPC.ID)
+	d.Set(isInstanceStatus, *instance.Status)
+	d.Set(IsInstanceCRN, *instance.CRN)
+	if instance.ResourceGroup != nil {
+		d.Set(isInstanceResourceGroup, *instance.ResourceGroup.ID)
+	}
+	if instance.PrimaryNetworkInterface != nil {
+		nicID := *instance.PrimaryNetworkInterface.ID
+		d.Set(isInstanceRestorePrimaryNetworkInterface, nicID)
+		if instance.PrimaryNetworkInterface.PrimaryIP != nil && instance.PrimaryNetworkInterface.PrimaryIP.Address != nil {
+			d.Set(isInstanceRestorePrimaryIPAddress, *instance.PrimaryNetworkInterface.PrimaryIP.Address)
+		}
+		getnicOptions := &vpcv1.GetInstanceNetworkInterfaceOptions{
+			InstanceID: &id,
+			ID:         &nicID,
+		}
+		nic, response, err := sess.GetInstanceNetworkInterface(getnicOptions)
+		if err != nil {
+			return flex.NewServiceError("Error getting primary network interface", err, response)
+		}
+		d.Set(isInstanceNicSubnet, *nic.Subnet.ID)
+		secgrps := make([]string, len(nic.SecurityGroups))
+		for i, secgrp := range nic.SecurityGroups {
+			secgrps[i] = *secgrp.ID
+		}
+		d.Set(isInstanceNicSecurityGroups, flex.NewStringSet(schema.HashString, secgrps))
+	}
+
+	bootAttachmentID := ""
+	if instance.BootVolumeAttachment != nil {
+		bootAttachmentID = *instance.BootVolumeAttachment.ID
+		bootAttachment, err := instanceRestoreAttachment(sess, instance.BootVolumeAttachment)
+		if err != nil {
+			return err
+		}
+		d.Set(isInstanceRestoreBootVolumeAttachment, []map[string]interface{}{bootAttachment})
+		d.Set(isInstanceRestoreBootSnapshot, bootAttachment[isInstanceRestoreSourceSnapshot])
+	}
+	attachments := []map[string]interface{}{}
+	for i := range instance.VolumeAttachments {
+		if *instance.VolumeAttachments[i].ID == bootAttachmentID {
+			continue
+		}
+		attachment, err := instanceRestoreAttachment(sess, &instance.VolumeAttachments[i])
+		if err != nil {
+			return err
+		}
+		attachments = append(attachments, attachment)
+	}
+	instanceRestoreSortAttachments(attachments, flex.ExpandStringList(d.Get(isInstanceRestoreDataSnapshots).([]interface{})))
+	d.Set(isInstanceRestoreVolumeAttachments, attachments)
+	return nil
+}
+
+// instanceRestoreAttachment returns the attributes of a volume attachment of a restored instance
+func instanceRestoreAttachment(sess *vpcv1.VpcV1, volumeAttachment *vpcv1.VolumeAttachmentReferenceInstanceContext) (map[string]interface{}, error) {
+	attachment := map[string]interface{}{
+		isInstanceRestoreAttachmentID:   *volumeAttachment.ID,
+		isInstanceRestoreAttachmentName: *volumeAttachment.Name,
+		isInstanceRestoreSourceSnapshot: "",
+	}
+	if volumeAttachment.Device != nil && volumeAttachment.Device.ID != nil {
+		attachment[isInstanceRestoreAttachmentDevice] = *volumeAttachment.Device.ID
+	}
+	if volumeAttachment.Volume == nil {
+		return attachment, nil
+	}
+	attachment[isInstanceRestoreVolumeID] = *volumeAttachment.Volume.ID
+	attachment[isInstanceRestoreVolumeName] = *volumeAttachment.Volume.Name
+	getVolumeOptions := &vpcv1.GetVolumeOptions{
+		ID: volumeAttachment.Volume.ID,
+	}
+	volume, response, err := sess.GetVolume(getVolumeOptions)
+	if err != nil {
+		return nil, flex.NewServiceError("Error getting Volume", err, response)
+	}
+	if volume.SourceSnapshot != nil {
+		attachment[isInstanceRestoreSourceSnapshot] = *volume.SourceSnapshot.ID
+	}
+	return attachment, nil
+}
+
+func resourceIBMISInstanceRestoreUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange(isInstanceName) {
+		sess, err := vpcClient(meta)
+		if err != nil {
+			return err
+		}
+		id := d.Id()
+		name := d.Get(isInstanceName).(string)
+		instancePatchModel := &vpcv1.InstancePatch{
+			Name: &name,
+		}
+		instancePatch, err := instancePatchModel.AsPatch()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error calling asPatch for InstancePatch: %s", err)
+		}
+		updateInstanceOptions := &vpcv1.UpdateInstanceOptions{
+			ID:            &id,
+			InstancePatch: instancePatch,
+		}
+		_, response, err := sess.UpdateInstance(updateInstanceOptions)
+		if err != nil {
+			return flex.NewServiceError("Error updating Instance", err, response)
+		}
+	}
+	return resourceIBMISInstanceRestoreRead(d, meta)
+}
+
+func resourceIBMISInstanceRestoreDelete(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	id := d.Id()
+	deleteinstanceOptions := &vpcv1.DeleteInstanceOptions{
+		ID: &id,
+	}
+	response, err := sess.DeleteInstance(deleteinstanceOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return flex.NewServiceError("Error deleting Instance", err, response)
+	}
+	_, err = isWaitForInstanceDelete(sess, d, id)
+	if err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISInstanceRestore_snapshotConsistencyGroup(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	volname := fmt.Sprintf("tf-vol-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instance-%d", acctest.RandIntRange(10, 100))
+	scgname := fmt.Sprintf("tf-snap-cons-grp-%d", acctest.RandIntRange(10, 100))
+	restorename := fmt.Sprintf("tf-instance-restore-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceRestoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceRestoreConfig(vpcname, subnetname, sshname, publicKey, volname, name, scgname, restorename),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_instance_restore.testacc_restore", "name", restorename),
+					resource.TestCheckResourceAttr("ibm_is_instance_restore.testacc_restore", "status", "running"),
+					resource.TestCheckResourceAttrPair("ibm_is_instance_restore.testacc_restore", "boot_snapshot", "ibm_is_snapshot_consistency_group.testacc_scg", "snapshot_reference.0.id"),
+					resource.TestCheckResourceAttr("ibm_is_instance_restore.testacc_restore", "data_snapshots.#", "1"),
+					resource.TestCheckResourceAttr("ibm_is_instance_restore.testacc_restore", "volume_attachments.#", "1"),
+					resource.TestCheckResourceAttrPair("ibm_is_instance_restore.testacc_restore", "volume_attachments.0.source_snapshot", "ibm_is_snapshot_consistency_group.testacc_scg", "snapshot_reference.1.id"),
+					resource.TestCheckResourceAttrSet("ibm_is_instance_restore.testacc_restore", "boot_volume_attachment.0.volume_id"),
+					resource.TestCheckResourceAttrSet("ibm_is_instance_restore.testacc_restore", "primary_ip_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISInstanceRestoreDestroy(s *terraform.State) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_instance_restore" {
+			continue
+		}
+		getinsOptions := &vpcv1.GetInstanceOptions{
+			ID: &rs.Primary.ID,
+		}
+		_, _, err := sess.GetInstance(getinsOptions)
+		if err == nil {
+			return fmt.Errorf("restored instance still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISInstanceRestoreConfig(vpcname, subnetname, sshname, publicKey, volname, name, scgname, restorename string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name                     = "%s"
+		vpc                      = ibm_is_vpc.testacc_vpc.id
+		zone                     = "%s"
+		total_ipv4_address_count = 16
+	}
+
+	resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	}
+
+	resource "ibm_is_volume" "testacc_volume" {
+		name     = "%s"
+		profile  = "general-purpose"
+		zone     = "%s"
+		capacity = 20
+	}
+
+	resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc     = ibm_is_vpc.testacc_vpc.id
+		zone    = "%s"
+		keys    = [ibm_is_ssh_key.testacc_sshkey.id]
+		volumes = [ibm_is_volume.testacc_volume.id]
+	}
+
+	resource "ibm_is_snapshot_consistency_group" "testacc_scg" {
+		name                       = "%s"
+		delete_snapshots_on_delete = true
+		snapshots {
+			source_volume = ibm_is_instance.testacc_instance.boot_volume.0.volume_id
+		}
+		snapshots {
+			source_volume = ibm_is_volume.testacc_volume.id
+		}
+	}
+
+	resource "ibm_is_instance_restore" "testacc_restore" {
+		name                       = "%s"
+		snapshot_consistency_group = ibm_is_snapshot_consistency_group.testacc_scg.id
+		profile                    = "%s"
+		zone                       = "%s"
+		vpc                        = ibm_is_vpc.testacc_vpc.id
+		subnet                     = ibm_is_subnet.testacc_subnet.id
+		keys                       = [ibm_is_ssh_key.testacc_sshkey.id]
+	}
+	`, vpcname, subnetname, acc.ISZoneName, sshname, publicKey, volname, acc.ISZoneName, name, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName, scgname, restorename, acc.InstanceProfileName, acc.ISZoneName)
+}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
@@ -68,10 +69,13 @@ const (
 )
 
 func ResourceIBMISInstanceTemplate() *schema.Resource {
-	return &schema.Resource{
-		Create:   resourceIBMisInstanceTemplateCreate,
-		Read:     resourceIBMisInstanceTemplateRead,
-		Update:   resourceIBMisInstanceTemplateUpdate,
+	var immutableKeys []string
+	resource := &schema.Resource{
+		Create: resourceIBMisInstanceTemplateCreate,
+		Read:   resourceIBMisInstanceTemplateRead,
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceIBMisInstanceTemplateUpdate(d, meta, immutableKeys)
+		},
 		Delete:   resourceIBMisInstanceTemplateDelete,
 		Exists:   resourceIBMisInstanceTemplateExists,
 		Importer: &schema.ResourceImporter{},
@@ -79,7 +83,7 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 
@@ -87,6 +91,11 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceVolumeAttachmentValidate(diff)
 				}),
+
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return instanceTemplateVersionCustomizeDiff(diff, immutableKeys)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -99,11 +108,43 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 			},
 
 			isInstanceTemplateName: {
-				Type:         schema.TypeString,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      false,
+				ConflictsWith: []string{isInstanceTemplateNamePrefix},
+				ValidateFunc:  validate.ValidateISName,
+				Description:   "Instance Template name",
+			},
+
+			isInstanceTemplateNamePrefix: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{isInstanceTemplateName},
+				ValidateFunc:  validate.InvokeValidator("ibm_is_instance_template", isInstanceTemplateNamePrefix),
+				Description:   "Creates a new version of the template named with this prefix on change, instead of replacing the template",
+			},
+
+			isInstanceTemplateVersionRetention: {
+				Type:         schema.TypeInt,
 				Optional:     true,
-				ForceNew:     false,
-				ValidateFunc: validate.ValidateISName,
-				Description:  "Instance Template name",
+				Default:      2,
+				ValidateFunc: validate.InvokeValidator("ibm_is_instance_template", isInstanceTemplateVersionRetention),
+				Description:  "The number of previous versions of a versioned template to keep",
+			},
+
+			isInstanceTemplateLatestVersionID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the latest version of the template. Reference it instead of id, which remains the ID of the first version of a versioned template",
+			},
+
+			isInstanceTemplateVersions: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the versions of a versioned template, newest first",
 			},
 
 			isInstanceTemplateMetadataServiceEnabled: {
@@ -583,6 +624,8 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 			},
 		},
 	}
+	immutableKeys = instanceTemplateClearForceNew(resource.Schema)
+	return resource
 }
 
 func ResourceIBMISInstanceTemplateValidator() *validate.ResourceValidator {
@@ -620,13 +663,48 @@ func ResourceIBMISInstanceTemplateValidator() *validate.ResourceValidator {
 			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
 			MinValueLength:             1,
 			MaxValueLength:             128})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceTemplateNamePrefix,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^[a-z][-a-z0-9]*$`,
+			MinValueLength:             1,
+			MaxValueLength:             63 - len(instanceTemplateVersionTimeFormat)})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceTemplateVersionRetention,
+			ValidateFunctionIdentifier: validate.IntAtLeast,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0"})
 	ibmISInstanceTemplateValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_template", Schema: validateSchema}
 	return &ibmISInstanceTemplateValidator
 }
 
 func resourceIBMisInstanceTemplateCreate(d *schema.ResourceData, meta interface{}) error {
-	profile := d.Get(isInstanceTemplateProfile).(string)
 	name := d.Get(isInstanceTemplateName).(string)
+	if prefix, ok := d.GetOk(isInstanceTemplateNamePrefix); ok {
+		name = instanceTemplateVersionName(prefix.(string), time.Now())
+	}
+	ID, err := instanceTemplateCreateFromConfig(d, meta, name)
+	if err != nil {
+		return err
+	}
+	d.SetId(ID)
+	d.Set(isInstanceTemplateLatestVersionID, ID)
+	if instanceTemplateVersioned(d) {
+		d.Set(isInstanceTemplateVersions, []string{ID})
+	}
+
+	return resourceIBMisInstanceTemplateRead(d, meta)
+}
+
+// instanceTemplateCreateFromConfig creates a template named name from the
+// configuration and returns its ID
+func instanceTemplateCreateFromConfig(d *schema.ResourceData, meta interface{}, name string) (string, error) {
+	profile := d.Get(isInstanceTemplateProfile).(string)
 	vpcID := d.Get(isInstanceTemplateVPC).(string)
 	zone := d.Get(isInstanceTemplateZone).(string)
 	image := d.Get(isInstanceTemplateImage).(string)
@@ -635,30 +713,34 @@ func resourceIBMisInstanceTemplateCreate(d *schema.ResourceData, meta interface{
 		catalogOffering := catalogOfferingOk.([]interface{})[0].(map[string]interface{})
 		offeringCrn, _ := catalogOffering[isInstanceTemplateCatalogOfferingOfferingCrn].(string)
 		versionCrn, _ := catalogOffering[isInstanceTemplateCatalogOfferingVersionCrn].(string)
-		err := instanceTemplateCreateByCatalogOffering(d, meta, profile, name, vpcID, zone, offeringCrn, versionCrn)
-		if err != nil {
-			return err
-		}
-	} else {
-		err := instanceTemplateCreate(d, meta, profile, name, vpcID, zone, image)
-		if err != nil {
-			return err
-		}
+		return instanceTemplateCreateByCatalogOffering(d, meta, profile, name, vpcID, zone, offeringCrn, versionCrn)
 	}
-
-	return resourceIBMisInstanceTemplateRead(d, meta)
+	return instanceTemplateCreate(d, meta, profile, name, vpcID, zone, image)
 }
 
 func resourceIBMisInstanceTemplateRead(d *schema.ResourceData, meta interface{}) error {
-	ID := d.Id()
+	ID := instanceTemplateLatestVersion(d)
 	err := instanceTemplateGet(d, meta, ID)
 	if err != nil {
 		return err
 	}
+	d.Set(isInstanceTemplateLatestVersionID, ID)
 	return nil
 }
 
 func resourceIBMisInstanceTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	if instanceTemplateVersioned(d) {
+		sess, err := vpcClient(meta)
+		if err != nil {
+			return err
+		}
+		for _, version := range instanceTemplateVersionIDs(d) {
+			if err = instanceTemplateDeleteVersion(sess, version); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 
 	ID := d.Id()
 
@@ -669,7 +751,14 @@ func resourceIBMisInstanceTemplateDelete(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceIBMisInstanceTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceTemplateUpdate(d *schema.ResourceData, meta interface{}, immutableKeys []string) error {
+	if instanceTemplateVersioned(d) {
+		err := instanceTemplateVersionUpdate(d, meta, immutableKeys)
+		if err != nil {
+			return err
+		}
+		return resourceIBMisInstanceTemplateRead(d, meta)
+	}
 
 	err := instanceTemplateUpdate(d, meta)
 	if err != nil {
@@ -679,7 +768,7 @@ func resourceIBMisInstanceTemplateUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceIBMisInstanceTemplateExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	ID := d.Id()
+	ID := instanceTemplateLatestVersion(d)
 	ok, err := instanceTemplateExists(d, meta, ID)
 	if err != nil {
 		return false, err
@@ -687,10 +776,10 @@ func resourceIBMisInstanceTemplateExists(d *schema.ResourceData, meta interface{
 	return ok, err
 }
 
-func instanceTemplateCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, offeringCrn, versionCrn string) error {
+func instanceTemplateCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, offeringCrn, versionCrn string) (string, error) {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	instanceproto := &vpcv1.InstanceTemplatePrototypeInstanceTemplateByCatalogOffering{
@@ -959,7 +1048,7 @@ func instanceTemplateCreateByCatalogOffering(d *schema.ResourceData, meta interf
 			}
 		}
 		if PrimaryIpv4Address != "" && reservedIpAddress != "" && PrimaryIpv4Address != reservedIpAddress {
-			return fmt.Errorf("[ERROR] Error creating instance template, primary_network_interface error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", PrimaryIpv4Address, reservedIpAddress)
+			return "", fmt.Errorf("[ERROR] Error creating instance template, primary_network_interface error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", PrimaryIpv4Address, reservedIpAddress)
 		}
 		if reservedIp != "" {
 			primnicobj.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity{
@@ -1052,10 +1141,10 @@ func instanceTemplateCreateByCatalogOffering(d *schema.ResourceData, meta interf
 				// }
 			}
 			if PrimaryIpv4Address != "" && reservedIpAddress != "" && PrimaryIpv4Address != reservedIpAddress {
-				return fmt.Errorf("[ERROR] Error creating instance template, network_interfaces error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", PrimaryIpv4Address, reservedIpAddress)
+				return "", fmt.Errorf("[ERROR] Error creating instance template, network_interfaces error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", PrimaryIpv4Address, reservedIpAddress)
 			}
 			if reservedIp != "" && (PrimaryIpv4Address != "" || reservedIpAddress != "" || reservedIpName != "" || okAuto) {
-				return fmt.Errorf("[ERROR] Error creating instance template, network_interfaces error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
+				return "", fmt.Errorf("[ERROR] Error creating instance template, network_interfaces error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
 			}
 			if reservedIp != "" {
 				nwInterface.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity{
@@ -1118,17 +1207,16 @@ func instanceTemplateCreateByCatalogOffering(d *schema.ResourceData, meta interf
 
 	instanceIntf, response, err := sess.CreateInstanceTemplate(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating InstanceTemplate: %s\n%s", err, response)
+		return "", flex.NewServiceError("Error creating InstanceTemplate", err, response)
 	}
 	instance := instanceIntf.(*vpcv1.InstanceTemplate)
-	d.SetId(*instance.ID)
-	return nil
+	return *instance.ID, nil
 }
 
-func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
+func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) (string, error) {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return "", err
 	}
 	instanceproto := &vpcv1.InstanceTemplatePrototype{
 		Image: &vpcv1.ImageIdentity{
@@ -1382,7 +1470,7 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 			}
 		}
 		if PrimaryIpv4Address != "" && reservedIpAddress != "" && PrimaryIpv4Address != reservedIpAddress {
-			return fmt.Errorf("[ERROR] Error creating instance template, primary_network_interface error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", PrimaryIpv4Address, reservedIpAddress)
+			return "", fmt.Errorf("[ERROR] Error creating instance template, primary_network_interface error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", PrimaryIpv4Address, reservedIpAddress)
 		}
 		if reservedIp != "" {
 			primnicobj.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity{
@@ -1475,10 +1563,10 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 				// }
 			}
 			if PrimaryIpv4Address != "" && reservedIpAddress != "" && PrimaryIpv4Address != reservedIpAddress {
-				return fmt.Errorf("[ERROR] Error creating instance template, network_interfaces error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", PrimaryIpv4Address, reservedIpAddress)
+				return "", fmt.Errorf("[ERROR] Error creating instance template, network_interfaces error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", PrimaryIpv4Address, reservedIpAddress)
 			}
 			if reservedIp != "" && (PrimaryIpv4Address != "" || reservedIpAddress != "" || reservedIpName != "" || okAuto) {
-				return fmt.Errorf("[ERROR] Error creating instance template, network_interfaces error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
+				return "", fmt.Errorf("[ERROR] Error creating instance template, network_interfaces error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
 			}
 			if reservedIp != "" {
 				nwInterface.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentity{
@@ -1541,11 +1629,10 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 
 	instanceIntf, response, err := sess.CreateInstanceTemplate(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating InstanceTemplate: %s\n%s", err, response)
+		return "", flex.NewServiceError("Error creating InstanceTemplate", err, response)
 	}
 	instance := instanceIntf.(*vpcv1.InstanceTemplate)
-	d.SetId(*instance.ID)
-	return nil
+	return *instance.ID, nil
 }
 
 func instanceTemplateGet(d *schema.ResourceData, meta interface{}, ID string) error {
@@ -1558,7 +1645,7 @@ func instanceTemplateGet(d *schema.ResourceData, meta interface{}, ID string) er
 	}
 	instanceIntf, response, err := instanceC.GetInstanceTemplate(getinsOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Instance template: %s\n%s", err, response)
+		return flex.NewServiceError("Error Getting Instance template", err, response)
 	}
 	instance := instanceIntf.(*vpcv1.InstanceTemplate)
 	d.Set(isInstanceTemplateName, *instance.Name)
@@ -1907,7 +1994,7 @@ func instanceTemplateExists(d *schema.ResourceData, meta interface{}, ID string)
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting InstanceTemplate: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error Getting InstanceTemplate", err, response)
 	}
 	return true, nil
 }
@@ -233,6 +233,61 @@ func TestAccIBMISInstanceTemplate_WithVolumeAttachmentUserTag(t *testing.T) {
 	})
 }
 
+func TestAccIBMISInstanceTemplate_versioned(t *testing.T) {
+	randInt := acctest.RandIntRange(10, 100)
+
+	publicKey := strings.TrimSpace(`
+	ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQDVtuCfWKVGKaRmaRG6JQZY8YdxnDgGzVOK93IrV9R5Hl0JP1oiLLWlZQS2reAKb8lBqyDVEREpaoRUDjqDqXG8J/kR42FKN51su914pjSBc86wJ02VtT1Wm1zRbSg67kT+g8/T1jCgB5XBODqbcICHVP8Z1lXkgbiHLwlUrbz6OZkGJHo/M/kD1Eme8lctceIYNz/Ilm7ewMXZA4fsidpto9AjyarrJLufrOBl4MRVcZTDSJ7rLP982aHpu9pi5eJAjOZc7Og7n4ns3NFppiCwgVMCVUQbN5GBlWhZ1OsT84ZiTf+Zy8ew+Yg5T7Il8HuC7loWnz+esQPf0s3xhC/kTsGgZreIDoh/rxJfD67wKXetNSh5RH/n5BqjaOuXPFeNXmMhKlhj9nJ8scayx/wsvOGuocEIkbyJSLj3sLUU403OafgatEdnJOwbqg6rUNNF5RIjpJpL7eEWlKIi1j9LyhmPJ+fEO7TmOES82VpCMHpLbe4gf/MhhJ/Xy8DKh9s= root@ffd8363b1226
+	`)
+	vpcName := fmt.Sprintf("tf-testvpc%d", randInt)
+	subnetName := fmt.Sprintf("tf-testsubnet%d", randInt)
+	namePrefix := fmt.Sprintf("tf-testtemplate%d-", randInt)
+	sshKeyName := fmt.Sprintf("tf-testsshkey%d", randInt)
+	instanceGroupName := fmt.Sprintf("tf-testinstancegroup%d", randInt)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceTemplateVersionedConfig(vpcName, subnetName, sshKeyName, publicKey, namePrefix, "bx2-2x8", 1, instanceGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance_template.instancetemplate1", "latest_version_id", "ibm_is_instance_template.instancetemplate1", "id"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_template.instancetemplate1", "versions.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance_group.instance_group", "instance_template", "ibm_is_instance_template.instancetemplate1", "latest_version_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstanceTemplateVersionedConfig(vpcName, subnetName, sshKeyName, publicKey, namePrefix, "bx2-4x16", 1, instanceGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_template.instancetemplate1", "profile", "bx2-4x16"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_template.instancetemplate1", "versions.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance_template.instancetemplate1", "latest_version_id", "ibm_is_instance_template.instancetemplate1", "versions.0"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance_group.instance_group", "instance_template", "ibm_is_instance_template.instancetemplate1", "latest_version_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstanceTemplateVersionedConfig(vpcName, subnetName, sshKeyName, publicKey, namePrefix, "bx2-8x32", 0, instanceGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_template.instancetemplate1", "profile", "bx2-8x32"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_template.instancetemplate1", "versions.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance_group.instance_group", "instance_template", "ibm_is_instance_template.instancetemplate1", "latest_version_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISInstanceTemplateDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -240,18 +295,66 @@ func testAccCheckIBMISInstanceTemplateDestroy(s *terraform.State) error {
 			continue
 		}
 
-		getInstanceTemplateOptions := vpcv1.GetInstanceTemplateOptions{
-			ID: &rs.Primary.ID,
+		ids := []string{rs.Primary.ID}
+		if latest := rs.Primary.Attributes["latest_version_id"]; latest != "" && latest != rs.Primary.ID {
+			ids = append(ids, latest)
 		}
-		_, _, err := sess.GetInstanceTemplate(&getInstanceTemplateOptions)
+		for i := range ids {
+			getInstanceTemplateOptions := vpcv1.GetInstanceTemplateOptions{
+				ID: &ids[i],
+			}
+			_, _, err := sess.GetInstanceTemplate(&getInstanceTemplateOptions)
 
-		if err == nil {
-			return fmt.Errorf("instance template still exists: %s", rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("instance template still exists: %s", ids[i])
+			}
 		}
 	}
 	return nil
 }
 
+func testAccCheckIBMISInstanceTemplateVersionedConfig(vpcName, subnetName, sshKeyName, publicKey, namePrefix, profile string, versionRetention int, instanceGroupName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "vpc2" {
+	  name = "%s"
+	}
+
+	resource "ibm_is_subnet" "subnet2" {
+	  name            = "%s"
+	  vpc             = ibm_is_vpc.vpc2.id
+	  zone            = "us-south-2"
+	  ipv4_cidr_block = "10.240.64.0/28"
+	}
+
+	resource "ibm_is_ssh_key" "sshkey" {
+	  name       = "%s"
+	  public_key = "%s"
+	}
+
+	resource "ibm_is_instance_template" "instancetemplate1" {
+	   name_prefix       = "%s"
+	   image             = "%s"
+	   profile           = "%s"
+	   version_retention = %d
+
+	   primary_network_interface {
+		 subnet = ibm_is_subnet.subnet2.id
+	   }
+
+	   vpc       = ibm_is_vpc.vpc2.id
+	   zone      = "us-south-2"
+	   keys      = [ibm_is_ssh_key.sshkey.id]
+	 }
+
+	resource "ibm_is_instance_group" "instance_group" {
+		name              = "%s"
+		instance_template = ibm_is_instance_template.instancetemplate1.latest_version_id
+		instance_count    = 1
+		subnets           = [ibm_is_subnet.subnet2.id]
+	}
+	`, vpcName, subnetName, sshKeyName, publicKey, namePrefix, acc.IsImage, profile, versionRetention, instanceGroupName)
+}
+
 func testAccCheckIBMISInstanceTemplateConfig(vpcName, subnetName, sshKeyName, publicKey, templateName string) string {
 	return fmt.Sprintf(`	
 	resource "ibm_is_vpc" "vpc2" {
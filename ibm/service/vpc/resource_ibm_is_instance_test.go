@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -75,6 +76,107 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 		},
 	})
 }
+func TestAccIBMISInstance_replacementStrategy(t *testing.T) {
+	var instance string
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instnace-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	lbname := fmt.Sprintf("tf-lb-%d", acctest.RandIntRange(10, 100))
+	fipname := fmt.Sprintf("tf-fip-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceReplacementStrategyConfig(vpcname, subnetname, sshname, publicKey, name, lbname, fipname, fmt.Sprintf("%q", acc.IsImage), ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISInstanceExists("ibm_is_instance.testacc_instance", instance),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance.testacc_instance", "image", acc.IsImage),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance.testacc_instance", "replacement_strategy.0.health_check_timeout", "900"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstanceReplacementStrategyConfig(vpcname, subnetname, sshname, publicKey, name, lbname, fipname, "data.ibm_is_image.testacc_image.id", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISInstanceExists("ibm_is_instance.testacc_instance", instance),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance.testacc_instance", "name", name),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance.testacc_instance", "image", "data.ibm_is_image.testacc_image", "id"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_lb_pool_member.testacc_lb_mem", "target_id", "ibm_is_instance.testacc_instance", "id"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_floating_ip.testacc_fip", "target", "ibm_is_instance.testacc_instance", "primary_network_interface.0.id"),
+				),
+			},
+			{
+				// a fixed primary IP is still held by the old instance
+				Config: testAccCheckIBMISInstanceReplacementStrategyConfig(vpcname, subnetname, sshname, publicKey, name, lbname, fipname, fmt.Sprintf("%q", acc.IsImage), `
+			primary_ip {
+				address = cidrhost(ibm_is_subnet.testacc_subnet.ipv4_cidr_block, 10)
+			}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("primary_network_interface.0.primary_ip.0.address cannot be used with replacement_strategy"),
+			},
+		},
+	})
+}
+
+func TestAccIBMISInstance_primaryNetworkAttachment(t *testing.T) {
+	var instance string
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instnace-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	attachmentName := fmt.Sprintf("tf-pna-%d", acctest.RandIntRange(10, 100))
+	attachmentNameUpdate := fmt.Sprintf("tf-pna-update-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstancePrimaryNetworkAttachmentConfig(vpcname, subnetname, sshname, publicKey, name, attachmentName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISInstanceExists("ibm_is_instance.testacc_instance", instance),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance.testacc_instance", "primary_network_attachment.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance.testacc_instance", "primary_network_attachment.0.name", attachmentName),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance.testacc_instance", "primary_network_attachment.0.virtual_network_interface", "ibm_is_virtual_network_interface.testacc_vni", "id"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance.testacc_instance", "primary_network_attachment.0.subnet", "ibm_is_subnet.testacc_subnet", "id"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_instance.testacc_instance", "primary_network_attachment.0.id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstancePrimaryNetworkAttachmentConfig(vpcname, subnetname, sshname, publicKey, name, attachmentNameUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISInstanceExists("ibm_is_instance.testacc_instance", instance),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance.testacc_instance", "primary_network_attachment.0.name", attachmentNameUpdate),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance.testacc_instance", "primary_network_attachment.0.virtual_network_interface", "ibm_is_virtual_network_interface.testacc_vni", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMISInstance_enc_catalog(t *testing.T) {
 	var instance string
 	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
@@ -2140,3 +2242,115 @@ func testAccCheckIBMISInstanceCatalogImageConfig(vpcname, subnetname, sshname, p
 		}
 	  }`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, publicKey, name, acc.InstanceProfileName, userData, acc.ISZoneName)
 }
+
+func testAccCheckIBMISInstanceReplacementStrategyConfig(vpcname, subnetname, sshname, publicKey, name, lbname, fipname, image, primaryIP string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_security_group_rule" "testacc_ssh" {
+		group     = ibm_is_vpc.testacc_vpc.default_security_group
+		direction = "inbound"
+		remote    = "0.0.0.0/0"
+		tcp {
+			port_min = 22
+			port_max = 22
+		}
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	}
+
+	data "ibm_is_image" "testacc_image" {
+		name = "%s"
+	}
+
+	resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s"
+		image   = %s
+		profile = "%s"
+		primary_network_interface {
+			subnet = ibm_is_subnet.testacc_subnet.id%s
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		keys = [ibm_is_ssh_key.testacc_sshkey.id]
+		replacement_strategy {
+			health_check_timeout = 900
+		}
+	}
+
+	resource "ibm_is_floating_ip" "testacc_fip" {
+		name   = "%s"
+		target = ibm_is_instance.testacc_instance.primary_network_interface.0.id
+	}
+
+	resource "ibm_is_lb" "testacc_lb" {
+		name    = "%s"
+		subnets = [ibm_is_subnet.testacc_subnet.id]
+	}
+
+	resource "ibm_is_lb_pool" "testacc_lb_pool" {
+		name           = "%s-pool"
+		lb             = ibm_is_lb.testacc_lb.id
+		algorithm      = "round_robin"
+		protocol       = "tcp"
+		health_delay   = 5
+		health_retries = 2
+		health_timeout = 2
+		health_type    = "tcp"
+	}
+
+	resource "ibm_is_lb_pool_member" "testacc_lb_mem" {
+		lb        = ibm_is_lb.testacc_lb.id
+		pool      = element(split("/", ibm_is_lb_pool.testacc_lb_pool.id), 1)
+		port      = 22
+		target_id = ibm_is_instance.testacc_instance.id
+	}`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, publicKey, acc.IsImageName, name, image, acc.InstanceProfileName, primaryIP, acc.ISZoneName, fipname, lbname, lbname)
+}
+
+func testAccCheckIBMISInstancePrimaryNetworkAttachmentConfig(vpcname, subnetname, sshname, publicKey, name, attachmentName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	}
+
+	resource "ibm_is_virtual_network_interface" "testacc_vni" {
+		subnet = ibm_is_subnet.testacc_subnet.id
+	}
+
+	resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		primary_network_attachment {
+			name                      = "%s"
+			virtual_network_interface = ibm_is_virtual_network_interface.testacc_vni.id
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		keys = [ibm_is_ssh_key.testacc_sshkey.id]
+	}`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, publicKey, name, acc.IsImage, acc.InstanceProfileName, attachmentName, acc.ISZoneName)
+}
@@ -57,7 +57,11 @@ func ResourceIBMISInstanceVolumeAttachment() *schema.Resource {
 				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					// the tags of an existing volume are managed by its own resource
+					if !diff.GetRawConfig().GetAttr(isInstanceVolAttVol).IsNull() {
+						return nil
+					}
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				}),
 		),
 		Schema: map[string]*schema.Schema{
@@ -130,6 +134,14 @@ func ResourceIBMISInstanceVolumeAttachment() *schema.Resource {
 				Description:   "UserTags for the volume instance",
 			},
 
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the volume instance, including the provider default_tags",
+			},
+
 			isInstanceVolProfile: {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -293,23 +305,20 @@ func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, insta
 			volnamestr := volname.(string)
 			volProtoVol.Name = &volnamestr
 		}
-		var userTags *schema.Set
-		if v, ok := d.GetOk(isInstanceVolAttTags); ok {
-			userTags = v.(*schema.Set)
-			if userTags != nil && userTags.Len() != 0 {
-				userTagsArray := make([]string, userTags.Len())
-				for i, userTag := range userTags.List() {
-					userTagStr := userTag.(string)
-					userTagsArray[i] = userTagStr
-				}
-				schematicTags := os.Getenv("IC_ENV_TAGS")
-				var envTags []string
-				if schematicTags != "" {
-					envTags = strings.Split(schematicTags, ",")
-					userTagsArray = append(userTagsArray, envTags...)
-				}
-				volProtoVol.UserTags = userTagsArray
+		if flex.HasTagsToAttach(d, meta, isInstanceVolAttTags) {
+			userTags := d.Get(isInstanceVolAttTags).(*schema.Set)
+			userTagsArray := make([]string, userTags.Len())
+			for i, userTag := range userTags.List() {
+				userTagStr := userTag.(string)
+				userTagsArray[i] = userTagStr
+			}
+			schematicTags := os.Getenv("IC_ENV_TAGS")
+			var envTags []string
+			if schematicTags != "" {
+				envTags = strings.Split(schematicTags, ",")
+				userTagsArray = append(userTagsArray, envTags...)
 			}
+			volProtoVol.UserTags = flex.WithDefaultUserTags(meta, userTagsArray)
 		}
 		volSnapshotStr := ""
 		if volSnapshot, ok := d.GetOk(isInstanceVolumeSnapshot); ok {
@@ -433,7 +442,7 @@ func instanceVolumeAttachmentGet(d *schema.ResourceData, meta interface{}, insta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Instance volume attachment : %s\n%s", err, response)
+		return flex.NewServiceError("Error getting Instance volume attachment", err, response)
 	}
 	d.Set(isInstanceId, instanceId)
 
@@ -476,6 +485,7 @@ func instanceVolumeAttachmentGet(d *schema.ResourceData, meta interface{}, insta
 	if volumeDetail.SourceSnapshot != nil {
 		d.Set(isInstanceVolumeSnapshot, *volumeDetail.SourceSnapshot.ID)
 	}
+	flex.SetTagsAll(d, flex.FilterIgnoredTags(meta, volumeDetail.UserTags))
 	return nil
 }
 
@@ -537,7 +547,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		voloptions.VolumePatch = volumePatch
 		_, response, err := instanceC.UpdateVolume(voloptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating volume name : %s\n%s", err, response)
+			return flex.NewServiceError("Error updating volume name", err, response)
 		}
 	}
 
@@ -548,14 +558,14 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		volId = volIdOk.(string)
 	}
 
-	if volId != "" && (d.HasChange(isInstanceVolIops) || d.HasChange(isInstanceVolProfile) || d.HasChange(isInstanceVolAttTags)) {
+	if volId != "" && (d.HasChange(isInstanceVolIops) || d.HasChange(isInstanceVolProfile) || flex.TagsHasChange(d, isInstanceVolAttTags)) {
 		insId := d.Get(isInstanceId).(string)
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: &insId,
 		}
 		instance, response, err := instanceC.GetInstance(getinsOptions)
 		if err != nil || instance == nil {
-			return fmt.Errorf("[ERROR] Error retrieving Instance (%s) : %s\n%s", insId, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error retrieving Instance (%s)", insId), err, response)
 		}
 
 		if instance != nil && *instance.Status != "running" {
@@ -566,7 +576,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", insId, err, response)
+				return flex.NewServiceError(fmt.Sprintf("Error starting Instance (%s)", insId), err, response)
 			}
 			_, err = isWaitForInstanceAvailable(instanceC, insId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
@@ -590,25 +600,23 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 			iops := int64(d.Get(isVolumeIops).(int))
 			volumeProfilePatchModel.Iops = &iops
 		}
-		if d.HasChange(isInstanceVolAttTags) && !d.IsNewResource() {
-			if v, ok := d.GetOk(isInstanceVolAttTags); ok {
-				userTags := v.(*schema.Set)
-				if userTags != nil && userTags.Len() != 0 {
-					userTagsArray := make([]string, userTags.Len())
-					for i, userTag := range userTags.List() {
-						userTagStr := userTag.(string)
-						userTagsArray[i] = userTagStr
-					}
-					schematicTags := os.Getenv("IC_ENV_TAGS")
-					var envTags []string
-					if schematicTags != "" {
-						envTags = strings.Split(schematicTags, ",")
-						userTagsArray = append(userTagsArray, envTags...)
-					}
-					volumeProfilePatchModel.UserTags = userTagsArray
-				}
+		if flex.TagsHasChange(d, isInstanceVolAttTags) && !d.IsNewResource() {
+			userTags := d.Get(isInstanceVolAttTags).(*schema.Set)
+			userTagsArray := make([]string, userTags.Len())
+			for i, userTag := range userTags.List() {
+				userTagStr := userTag.(string)
+				userTagsArray[i] = userTagStr
+			}
+			schematicTags := os.Getenv("IC_ENV_TAGS")
+			var envTags []string
+			if schematicTags != "" {
+				envTags = strings.Split(schematicTags, ",")
+				userTagsArray = append(userTagsArray, envTags...)
+			}
+			userTagsArray = flex.WithDefaultUserTags(meta, userTagsArray)
+			if len(userTagsArray) != 0 {
+				volumeProfilePatchModel.UserTags = userTagsArray
 			}
-
 		}
 
 		volumeProfilePatch, err := volumeProfilePatchModel.AsPatch()
@@ -620,14 +628,14 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		_, response, err = instanceC.GetVolume(optionsget)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Boot Volume (%s): %s\n%s", id, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error getting Boot Volume (%s)", id), err, response)
 		}
 		eTag := response.Headers.Get("ETag")
 		updateVolumeProfileOptions.IfMatch = &eTag
 		updateVolumeProfileOptions.VolumePatch = volumeProfilePatch
 		_, response, err = instanceC.UpdateVolume(updateVolumeProfileOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating volume profile/iops/userTags: %s\n%s", err, response)
+			return flex.NewServiceError("Error updating volume profile/iops/userTags", err, response)
 		}
 		isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
 	}
@@ -641,7 +649,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		vol, response, err := instanceC.GetVolume(getvolumeoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Getting Volume (%s): %s\n%s", id, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error Getting Volume (%s)", id), err, response)
 		}
 
 		if vol.VolumeAttachments == nil || len(vol.VolumeAttachments) == 0 || *vol.VolumeAttachments[0].Name == "" {
@@ -653,7 +661,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		instance, response, err := instanceC.GetInstance(getinsOptions)
 		if err != nil || instance == nil {
-			return fmt.Errorf("[ERROR] Error retrieving Instance (%s) : %s\n%s", instanceId, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error retrieving Instance (%s)", instanceId), err, response)
 		}
 		if instance != nil && *instance.Status != "running" {
 			actiontype := "start"
@@ -663,10 +671,10 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", instanceId, err, response)
+				return flex.NewServiceError(fmt.Sprintf("Error starting Instance (%s)", instanceId), err, response)
 			}
 			_, err = isWaitForInstanceAvailable(instanceC, instanceId, d.Timeout(schema.TimeoutCreate), d)
-			return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", instanceId, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error starting Instance (%s)", instanceId), err, response)
 		}
 		capacity := int64(d.Get(isVolumeCapacity).(int))
 		updateVolumeOptions := &vpcv1.UpdateVolumeOptions{
@@ -681,7 +689,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 		updateVolumeOptions.VolumePatch = volumeCapacityPatch
 		_, response, err = instanceC.UpdateVolume(updateVolumeOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating volume capacity: %s\n%s", err, response)
+			return flex.NewServiceError("Error updating volume capacity", err, response)
 		}
 		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -730,7 +738,7 @@ func instanceVolAttDelete(d *schema.ResourceData, meta interface{}, instanceId,
 		}
 		response, err := instanceC.DeleteVolume(deleteVolumeOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error while deleting volume : %s\n%s", err, response)
+			return flex.NewServiceError("Error while deleting volume", err, response)
 		}
 		_, err = isWaitForVolumeDeleted(instanceC, volId, d.Timeout(schema.TimeoutDelete))
 		if err != nil {
@@ -787,7 +795,7 @@ func instanceVolAttExists(d *schema.ResourceData, meta interface{}, instanceId,
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Instance volume attachment: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error getting Instance volume attachment", err, response)
 	}
 	return true, nil
 }
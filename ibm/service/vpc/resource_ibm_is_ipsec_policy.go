@@ -234,7 +234,7 @@ func ipsecpCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, e
 	}
 	ipSec, response, err := sess.CreateIpsecPolicy(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] ipSec policy err %s\n%s", err, response)
+		return flex.NewServiceError("Error creating IPSec Policy", err, response)
 	}
 	d.SetId(*ipSec.ID)
 	log.Printf("[INFO] ipSec policy : %s", *ipSec.ID)
@@ -261,7 +261,7 @@ func ipsecpGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting IPSEC Policy(%s)", id), err, response)
 	}
 	d.Set(isIpSecName, *ipSec.Name)
 	d.Set(isIpSecAuthenticationAlg, *ipSec.AuthenticationAlgorithm)
@@ -342,7 +342,7 @@ func ipsecpUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 
 		_, response, err := sess.UpdateIpsecPolicy(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error on update of IPSEC Policy(%s): %s\n%s", id, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error on update of IPSEC Policy(%s)", id), err, response)
 		}
 	}
 	return nil
@@ -368,14 +368,14 @@ func ipsecpDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting IPSEC Policy(%s)", id), err, response)
 	}
 	deleteIpsecPolicyOptions := &vpcv1.DeleteIpsecPolicyOptions{
 		ID: &id,
 	}
 	response, err = sess.DeleteIpsecPolicy(deleteIpsecPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Deleting IPSEC Policy(%s)", id), err, response)
 	}
 	d.SetId("")
 	return nil
@@ -400,7 +400,7 @@ func ipsecpExists(d *schema.ResourceData, meta interface{}, id string) (bool, er
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return false, flex.NewServiceError(fmt.Sprintf("Error getting IPSEC Policy(%s)", id), err, response)
 	}
 	return true, nil
 }
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -64,7 +63,7 @@ func ResourceIBMISLB() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -220,6 +219,20 @@ func ResourceIBMISLB() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_lb", "tags")},
 				Set:      flex.ResourceIBMVPCHash,
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 
 			isLBAccessTags: {
 				Type:        schema.TypeSet,
@@ -459,7 +472,7 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 
 	lb, response, err := sess.CreateLoadBalancer(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating Load Balancer err %s\n%s", err, response)
+		return flex.NewServiceError("Error creating Load Balancer", err, response)
 	}
 	d.SetId(*lb.ID)
 	log.Printf("[INFO] Load Balancer : %s", *lb.ID)
@@ -467,8 +480,7 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isLBTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isLBTags) {
 		oldList, newList := d.GetChange(isLBTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *lb.CRN, "", isUserTagType)
 		if err != nil {
@@ -477,7 +489,7 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 		}
 	}
 
-	if _, ok := d.GetOk(isLBAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isLBAccessTags) {
 		oldList, newList := d.GetChange(isLBAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *lb.CRN, "", isAccessTagType)
 		if err != nil {
@@ -513,7 +525,7 @@ func lbGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Load Balancer : %s\n%s", err, response)
+		return flex.NewServiceError("Error getting Load Balancer", err, response)
 	}
 	dnsList := make([]map[string]interface{}, 0)
 	if lb.Dns != nil {
@@ -622,13 +634,13 @@ func lbGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of resource vpc Load Balancer (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isLBTags, tags)
+	flex.SetResourceTags(d, meta, isLBTags, isUserTagType, tags)
 	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *lb.CRN, "", isAccessTagType)
 	if err != nil {
 		log.Printf(
 			"Error on get of resource load balancer (%s) access tags: %s", d.Id(), err)
 	}
-	d.Set(isLBAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isLBAccessTags, isAccessTagType, accesstags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -684,15 +696,15 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isLBTags) || d.HasChange(isLBAccessTags) {
+	if flex.TagsHasChange(d, isLBTags) || flex.AccessTagsHasChange(d, isLBAccessTags) {
 		getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 			ID: &id,
 		}
 		lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Load Balancer : %s\n%s", err, response)
+			return flex.NewServiceError("Error getting Load Balancer", err, response)
 		}
-		if d.HasChange(isLBTags) {
+		if flex.TagsHasChange(d, isLBTags) {
 			oldList, newList := d.GetChange(isLBTags)
 			err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *lb.CRN, "", isUserTagType)
 			if err != nil {
@@ -700,7 +712,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 					"Error on update of resource vpc Load Balancer (%s) tags: %s", d.Id(), err)
 			}
 		}
-		if d.HasChange(isLBAccessTags) {
+		if flex.AccessTagsHasChange(d, isLBAccessTags) {
 			oldList, newList := d.GetChange(isLBAccessTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *lb.CRN, "", isAccessTagType)
 			if err != nil {
@@ -746,7 +758,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 
 		_, response, err := sess.UpdateLoadBalancer(updateLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating subents in vpc Load Balancer : %s\n%s", err, response)
+			return flex.NewServiceError("Error Updating subents in vpc Load Balancer", err, response)
 		}
 		_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -779,7 +791,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 
 		_, response, err := sess.UpdateLoadBalancer(updateLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating subents in vpc Load Balancer : %s\n%s", err, response)
+			return flex.NewServiceError("Error Updating subents in vpc Load Balancer", err, response)
 		}
 		_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -802,7 +814,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 
 		_, response, err := sess.UpdateLoadBalancer(updateLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating vpc Load Balancer : %s\n%s", err, response)
+			return flex.NewServiceError("Error Updating vpc Load Balancer", err, response)
 		}
 	}
 	if hasChangedLog {
@@ -826,7 +838,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 
 		_, response, err := sess.UpdateLoadBalancer(updateLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating vpc Load Balancer : %s\n%s", err, response)
+			return flex.NewServiceError("Error Updating vpc Load Balancer", err, response)
 		}
 	}
 
@@ -839,7 +851,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 				createSecurityGroupTargetBindingOptions.ID = &id
 				_, response, err := sess.CreateSecurityGroupTargetBinding(createSecurityGroupTargetBindingOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error while creating Security Group Target Binding %s\n%s", err, response)
+					return flex.NewServiceError("Error while creating Security Group Target Binding", err, response)
 				}
 				_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
@@ -859,12 +871,12 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 					if response != nil && response.StatusCode == 404 {
 						continue
 					}
-					return fmt.Errorf("[ERROR] Error Getting Security Group Target for this load balancer (%s): %s\n%s", securityGroupID, err, response)
+					return flex.NewServiceError(fmt.Sprintf("Error Getting Security Group Target for this load balancer (%s)", securityGroupID), err, response)
 				}
 				deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(securityGroupID, id)
 				response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Deleting Security Group Target for this load balancer : %s\n%s", err, response)
+					return flex.NewServiceError("Error Deleting Security Group Target for this load balancer", err, response)
 				}
 				_, err = isWaitForLBAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
@@ -903,7 +915,7 @@ func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting vpc load balancer(%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting vpc load balancer(%s)", id), err, response)
 	}
 
 	deleteLoadBalancerOptions := &vpcv1.DeleteLoadBalancerOptions{
@@ -911,7 +923,7 @@ func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteLoadBalancer(deleteLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting vpc load balancer : %s\n%s", err, response)
+		return flex.NewServiceError("Error Deleting vpc load balancer", err, response)
 	}
 	_, err = isWaitForLBDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -947,7 +959,7 @@ func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) resource.StateRefreshFun
 			if response != nil && response.StatusCode == 404 {
 				return lb, isLBDeleted, nil
 			}
-			return nil, "failed", fmt.Errorf("[ERROR] The vpc load balancer %s failed to delete: %s\n%s", id, err, response)
+			return nil, "failed", flex.NewServiceError(fmt.Sprintf("The vpc load balancer %s failed to delete", id), err, response)
 		}
 		return lb, isLBDeleting, nil
 	}
@@ -974,7 +986,7 @@ func lbExists(d *schema.ResourceData, meta interface{}, id string) (bool, error)
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting vpc load balancer: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error getting vpc load balancer", err, response)
 	}
 	return true, nil
 }
@@ -1002,7 +1014,7 @@ func isLBRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
 		}
 		lb, response, err := sess.GetLoadBalancer(getlboptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting Load Balancer", err, response)
 		}
 
 		if *lb.ProvisioningStatus == "active" || *lb.ProvisioningStatus == "failed" {
@@ -283,7 +283,7 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 	lb, response, err := sess.GetLoadBalancer(getlboptions)
 
 	if err != nil || lb == nil {
-		return fmt.Errorf("[ERROR] Error getting Load Balancer : %s\n%s", err, response)
+		return flex.NewServiceError("Error getting Load Balancer", err, response)
 	}
 	if lb != nil && *lb.RouteMode && lb.Profile != nil && *lb.Profile.Name == "network-fixed" {
 		if portMin > 0 && portMax > 0 && portMin != 1 && portMax != 65535 {
@@ -360,7 +360,7 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 
 	lbListener, response, err := sess.CreateLoadBalancerListener(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating Load Balanacer Listener err %s\n%s", err, response)
+		return flex.NewServiceError("Error creating Load Balancer Listener", err, response)
 	}
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbListener.ID))
 	_, err = isWaitForLBListenerAvailable(sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
@@ -400,7 +400,7 @@ func isLBListenerRefreshFunc(sess *vpcv1.VpcV1, lbID, lbListenerID string) resou
 		}
 		lblis, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer Listener: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting Load Balancer Listener", err, response)
 		}
 
 		if *lblis.ProvisioningStatus == "active" || *lblis.ProvisioningStatus == "failed" {
@@ -444,7 +444,7 @@ func lbListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbListenerID
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Load Balancer Listener : %s\n%s", err, response)
+		return flex.NewServiceError("Error Getting Load Balancer Listener", err, response)
 	}
 	d.Set(isLBListenerLBID, lbID)
 	if lbListener.Port != nil {
@@ -485,7 +485,7 @@ func lbListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbListenerID
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
+		return flex.NewServiceError("Error Getting Load Balancer", err, response)
 	}
 	d.Set(flex.RelatedCRN, *lb.CRN)
 	return nil
@@ -631,7 +631,7 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		}
 		_, response, err := sess.UpdateLoadBalancerListener(updateLoadBalancerListenerOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Load Balancer Listener : %s\n%s", err, response)
+			return flex.NewServiceError("Error Updating Load Balancer Listener", err, response)
 		}
 
 		_, err = isWaitForLBListenerAvailable(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
@@ -687,7 +687,7 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting vpc load balancer listener(%s): %s\n%s", lbListenerID, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting vpc load balancer listener(%s)", lbListenerID), err, response)
 	}
 	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -699,7 +699,7 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 	}
 	response, err = sess.DeleteLoadBalancerListener(deleteLoadBalancerListenerOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Load Balancer Pool : %s\n%s", err, response)
+		return flex.NewServiceError("Error Deleting Load Balancer Pool", err, response)
 	}
 	_, err = isWaitForLBListenerDeleted(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -740,7 +740,7 @@ func isLBListenerDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbListenerID string)
 			if response != nil && response.StatusCode == 404 {
 				return lbLis, isLBListenerDeleted, nil
 			}
-			return nil, "", fmt.Errorf("[ERROR] The vpc load balancer listener %s failed to delete: %s\n%s", lbListenerID, err, response)
+			return nil, "", flex.NewServiceError(fmt.Sprintf("The vpc load balancer listener %s failed to delete", lbListenerID), err, response)
 		}
 		return lbLis, isLBListenerDeleting, nil
 	}
@@ -778,7 +778,7 @@ func lbListenerExists(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Load balancer Listener: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error getting Load balancer Listener", err, response)
 	}
 	return true, nil
 }
@@ -333,7 +333,7 @@ func lbPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorith
 	}
 	lbPool, response, err := sess.CreateLoadBalancerPool(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] lbpool create err: %s\n%s", err, response)
+		return flex.NewServiceError("Error creating Load Balancer Pool", err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbPool.ID))
@@ -386,7 +386,7 @@ func lbPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Load Balancer Pool : %s\n%s", err, response)
+		return flex.NewServiceError("Error Getting Load Balancer Pool", err, response)
 	}
 	d.Set(isLBPoolName, *lbPool.Name)
 	d.Set(isLBPool, lbPoolID)
@@ -429,7 +429,7 @@ func lbPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string)
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
+		return flex.NewServiceError("Error Getting Load Balancer", err, response)
 	}
 	d.Set(flex.RelatedCRN, *lb.CRN)
 	return nil
@@ -558,7 +558,7 @@ func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 
 		_, response, err := sess.UpdateLoadBalancerPool(updateLoadBalancerPoolOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Load Balancer Pool : %s\n%s", err, response)
+			return flex.NewServiceError("Error Updating Load Balancer Pool", err, response)
 		}
 
 		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
@@ -613,7 +613,7 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting vpc load balancer pool(%s): %s\n%s", lbPoolID, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting vpc load balancer pool(%s)", lbPoolID), err, response)
 	}
 	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -631,7 +631,7 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 	}
 	response, err = sess.DeleteLoadBalancerPool(deleteLoadBalancerPoolOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Load Balancer Pool : %s\n%s", err, response)
+		return flex.NewServiceError("Error Deleting Load Balancer Pool", err, response)
 	}
 	_, err = isWaitForLBPoolDeleted(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -679,7 +679,7 @@ func lbPoolExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Load balancer pool: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error getting Load balancer pool", err, response)
 	}
 	return true, nil
 }
@@ -708,7 +708,7 @@ func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.Stat
 		}
 		lbPool, response, err := sess.GetLoadBalancerPool(getlbpOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer Pool: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting Load Balancer Pool", err, response)
 		}
 
 		if *lbPool.ProvisioningStatus == isLBPoolActive || *lbPool.ProvisioningStatus == isLBPoolFailed {
@@ -746,7 +746,7 @@ func isLBPoolDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbId, lbPoolId string) resource
 			if response != nil && response.StatusCode == 404 {
 				return lbPool, isLBPoolDeleteDone, nil
 			}
-			return nil, "", fmt.Errorf("[ERROR] The vpc load balancer pool %s failed to delete: %s\n%s", lbPoolId, err, response)
+			return nil, "", flex.NewServiceError(fmt.Sprintf("The vpc load balancer pool %s failed to delete", lbPoolId), err, response)
 		}
 		return lbPool, isLBPoolDeletePending, nil
 	}
@@ -0,0 +1,523 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isLBPoolMembers                   = "members"
+	isLBPoolMembersShift              = "shift"
+	isLBPoolMembersShiftSteps         = "steps"
+	isLBPoolMembersShiftHealthTimeout = "health_check_timeout"
+)
+
+func ResourceIBMISLBPoolMembers() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMISLBPoolMembersCreate,
+		Read:     resourceIBMISLBPoolMembersRead,
+		Update:   resourceIBMISLBPoolMembersUpdate,
+		Delete:   resourceIBMISLBPoolMembersDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isLBID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Load balancer ID",
+			},
+
+			isLBPoolID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Load balancer pool ID",
+			},
+
+			isLBPoolMembers: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Set:         resourceIBMISLBPoolMembersHash,
+				Description: "The members of the pool. Members of the pool that are not listed are removed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isLBPoolMemberPort: {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The port the member receives load balancer traffic on",
+						},
+						isLBPoolMemberTargetID: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The id of the virtual server instance targeted by the member. Conflicts with target_address.",
+						},
+						isLBPoolMemberTargetAddress: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The IP address targeted by the member. Conflicts with target_id.",
+						},
+						isLBPoolMemberWeight: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      50,
+							ValidateFunc: validate.InvokeValidator("ibm_is_lb_pool_members", isLBPoolMemberWeight),
+							Description:  "The weight of the member, applicable only if the pool algorithm is weighted_round_robin",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the member",
+						},
+						isLBPoolMemberHealth: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The health of the member",
+						},
+						isLBPoolMemberProvisioningStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The provisioning status of the member",
+						},
+					},
+				},
+			},
+
+			isLBPoolMembersShift: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Shifts the weights gradually from the current members to the new members when the members change, waiting for the new members to be healthy between steps",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isLBPoolMembersShiftSteps: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      4,
+							ValidateFunc: validate.InvokeValidator("ibm_is_lb_pool_members", isLBPoolMembersShiftSteps),
+							Description:  "The number of steps the weights are shifted in",
+						},
+						isLBPoolMembersShiftHealthTimeout: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validate.InvokeValidator("ibm_is_lb_pool_members", isLBPoolMembersShiftHealthTimeout),
+							Description:  "The time in seconds to wait for the new members to be healthy after each step before the members are rolled back",
+						},
+					},
+				},
+			},
+
+			flex.RelatedCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The crn of the LB resource",
+			},
+		},
+	}
+}
+
+func ResourceIBMISLBPoolMembersValidator() *validate.ResourceValidator {
+
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBPoolMemberWeight,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "100"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBPoolMembersShiftSteps,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "20"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBPoolMembersShiftHealthTimeout,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "60",
+			MaxValue:                   "3600"})
+
+	ibmISLBPoolMembersResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb_pool_members", Schema: validateSchema}
+	return &ibmISLBPoolMembersResourceValidator
+}
+
+func resourceIBMISLBPoolMembersHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%d-", m[isLBPoolMemberPort].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", m[isLBPoolMemberTargetID].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m[isLBPoolMemberTargetAddress].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", m[isLBPoolMemberWeight].(int)))
+	return conns.String(buf.String())
+}
+
+func resourceIBMISLBPoolMembersCreate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	lbID := d.Get(isLBID).(string)
+	lbPoolID, err := getPoolId(d.Get(isLBPoolID).(string))
+	if err != nil {
+		return err
+	}
+	members, err := expandLBPoolMembers(d.Get(isLBPoolMembers).(*schema.Set))
+	if err != nil {
+		return err
+	}
+
+	isLBKey := "load_balancer_key_" + lbID
+	conns.IbmMutexKV.Lock(isLBKey)
+	defer conns.IbmMutexKV.Unlock(isLBKey)
+
+	err = lbPoolMembersReplace(sess, lbID, lbPoolID, members, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/%s", lbID, lbPoolID))
+
+	return resourceIBMISLBPoolMembersRead(d, meta)
+}
+
+func resourceIBMISLBPoolMembersRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 2 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of lbID/lbPoolID", d.Id())
+	}
+	lbID := parts[0]
+	lbPoolID := parts[1]
+
+	members, response, err := sess.ListLoadBalancerPoolMembers(&vpcv1.ListLoadBalancerPoolMembersOptions{
+		LoadBalancerID: &lbID,
+		PoolID:         &lbPoolID,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return flex.NewServiceError("Error Listing Load Balancer Pool Members", err, response)
+	}
+
+	membersList := make([]interface{}, 0, len(members.Members))
+	for _, member := range members.Members {
+		memberMap := map[string]interface{}{
+			isLBPoolMemberPort:               flex.IntValue(member.Port),
+			isLBPoolMemberTargetID:           "",
+			isLBPoolMemberTargetAddress:      "",
+			isLBPoolMemberWeight:             flex.IntValue(member.Weight),
+			"id":                             *member.ID,
+			isLBPoolMemberHealth:             *member.Health,
+			isLBPoolMemberProvisioningStatus: *member.ProvisioningStatus,
+		}
+		if target, ok := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget); ok {
+			if target.ID != nil {
+				memberMap[isLBPoolMemberTargetID] = *target.ID
+			} else if target.Address != nil {
+				memberMap[isLBPoolMemberTargetAddress] = *target.Address
+			}
+		}
+		membersList = append(membersList, memberMap)
+	}
+
+	d.Set(isLBID, lbID)
+	// keep the pool as configured, it may be the lbID/lbPoolID id of ibm_is_lb_pool
+	if pool, err := getPoolId(d.Get(isLBPoolID).(string)); err != nil || pool != lbPoolID {
+		d.Set(isLBPoolID, lbPoolID)
+	}
+	if err = d.Set(isLBPoolMembers, schema.NewSet(resourceIBMISLBPoolMembersHash, membersList)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting members: %s", err)
+	}
+
+	lb, response, err := sess.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: &lbID})
+	if err != nil {
+		return flex.NewServiceError("Error Getting Load Balancer", err, response)
+	}
+	d.Set(flex.RelatedCRN, *lb.CRN)
+	return nil
+}
+
+func resourceIBMISLBPoolMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+	if !d.HasChange(isLBPoolMembers) {
+		return resourceIBMISLBPoolMembersRead(d, meta)
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	lbID := parts[0]
+	lbPoolID := parts[1]
+
+	oldMembers, newMembers := d.GetChange(isLBPoolMembers)
+	members, err := expandLBPoolMembers(newMembers.(*schema.Set))
+	if err != nil {
+		return err
+	}
+
+	isLBKey := "load_balancer_key_" + lbID
+	conns.IbmMutexKV.Lock(isLBKey)
+	defer conns.IbmMutexKV.Unlock(isLBKey)
+
+	if shift, ok := d.GetOk(isLBPoolMembersShift); ok && oldMembers.(*schema.Set).Len() > 0 {
+		shiftMap := shift.([]interface{})[0].(map[string]interface{})
+		previous, err := expandLBPoolMembers(oldMembers.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		err = lbPoolMembersShift(d, sess, lbID, lbPoolID, previous, members, shiftMap[isLBPoolMembersShiftSteps].(int), time.Duration(shiftMap[isLBPoolMembersShiftHealthTimeout].(int))*time.Second)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = lbPoolMembersReplace(sess, lbID, lbPoolID, members, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMISLBPoolMembersRead(d, meta)
+}
+
+func resourceIBMISLBPoolMembersDelete(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	lbID := parts[0]
+	lbPoolID := parts[1]
+
+	isLBKey := "load_balancer_key_" + lbID
+	conns.IbmMutexKV.Lock(isLBKey)
+	defer conns.IbmMutexKV.Unlock(isLBKey)
+
+	_, response, err := sess.GetLoadBalancerPool(&vpcv1.GetLoadBalancerPoolOptions{
+		LoadBalancerID: &lbID,
+		ID:             &lbPoolID,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return flex.NewServiceError("Error Getting Load Balancer Pool", err, response)
+	}
+	err = lbPoolMembersReplace(sess, lbID, lbPoolID, []vpcv1.LoadBalancerPoolMemberPrototype{}, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func expandLBPoolMembers(set *schema.Set) ([]vpcv1.LoadBalancerPoolMemberPrototype, error) {
+	members := make([]vpcv1.LoadBalancerPoolMemberPrototype, 0, set.Len())
+	for _, v := range set.List() {
+		m := v.(map[string]interface{})
+		port := int64(m[isLBPoolMemberPort].(int))
+		weight := int64(m[isLBPoolMemberWeight].(int))
+		targetID := m[isLBPoolMemberTargetID].(string)
+		targetAddress := m[isLBPoolMemberTargetAddress].(string)
+		if (targetID == "") == (targetAddress == "") {
+			return nil, fmt.Errorf("[ERROR] Exactly one of %s or %s must be set for the member on port %d", isLBPoolMemberTargetID, isLBPoolMemberTargetAddress, port)
+		}
+		members = append(members, lbPoolMemberPrototype(lbPoolMemberWeight{
+			port:          port,
+			targetID:      targetID,
+			targetAddress: targetAddress,
+			weight:        weight,
+		}))
+	}
+	return members, nil
+}
+
+func lbPoolMemberPrototype(member lbPoolMemberWeight) vpcv1.LoadBalancerPoolMemberPrototype {
+	target := &vpcv1.LoadBalancerPoolMemberTargetPrototype{}
+	if member.targetID != "" {
+		target.ID = &member.targetID
+	} else {
+		target.Address = &member.targetAddress
+	}
+	return vpcv1.LoadBalancerPoolMemberPrototype{
+		Port:   &member.port,
+		Target: target,
+		Weight: &member.weight,
+	}
+}
+
+// lbPoolMembersReplace replaces all members of the pool in one request
+func lbPoolMembersReplace(sess *vpcv1.VpcV1, lbID, lbPoolID string, members []vpcv1.LoadBalancerPoolMemberPrototype, timeout time.Duration) error {
+	_, err := isWaitForLBPoolActive(sess, lbID, lbPoolID, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
+	}
+	_, err = isWaitForLBAvailable(sess, lbID, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
+	}
+
+	_, response, err := sess.ReplaceLoadBalancerPoolMembers(&vpcv1.ReplaceLoadBalancerPoolMembersOptions{
+		LoadBalancerID: &lbID,
+		PoolID:         &lbPoolID,
+		Members:        members,
+	})
+	if err != nil {
+		return flex.NewServiceError("Error Replacing Load Balancer Pool Members", err, response)
+	}
+
+	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
+	}
+	_, err = isWaitForLBAvailable(sess, lbID, timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
+	}
+	return nil
+}
+
+// lbPoolMembersShift moves the weights from the previous members to the new
+// members in steps, waiting for the new members to be healthy after each step.
+// If the new members do not become healthy, the previous members are restored.
+func lbPoolMembersShift(d *schema.ResourceData, sess *vpcv1.VpcV1, lbID, lbPoolID string, previous, members []vpcv1.LoadBalancerPoolMemberPrototype, steps int, healthCheckTimeout time.Duration) error {
+	pool, response, err := sess.GetLoadBalancerPool(&vpcv1.GetLoadBalancerPoolOptions{
+		LoadBalancerID: &lbID,
+		ID:             &lbPoolID,
+	})
+	if err != nil {
+		return flex.NewServiceError("Error Getting Load Balancer Pool", err, response)
+	}
+	if *pool.Algorithm != vpcv1.LoadBalancerPoolAlgorithmWeightedRoundRobinConst {
+		return fmt.Errorf("[ERROR] Error shifting members of load balancer pool (%s): %s requires the %s algorithm, the pool uses %s", lbPoolID, isLBPoolMembersShift, vpcv1.LoadBalancerPoolAlgorithmWeightedRoundRobinConst, *pool.Algorithm)
+	}
+
+	oldWeights := lbPoolMemberWeights(previous)
+	newWeights := lbPoolMemberWeights(members)
+	for i, stepWeights := range lbPoolMembersShiftSteps(oldWeights, newWeights, steps) {
+		stepMembers := make([]vpcv1.LoadBalancerPoolMemberPrototype, 0, len(stepWeights))
+		for _, member := range stepWeights {
+			stepMembers = append(stepMembers, lbPoolMemberPrototype(member))
+		}
+		log.Printf("[INFO] Shifting members of load balancer pool %s, step %d", lbPoolID, i)
+		err = lbPoolMembersReplace(sess, lbID, lbPoolID, stepMembers, d.Timeout(schema.TimeoutUpdate))
+		if err == nil {
+			err = isWaitForLBPoolMembersHealthy(sess, lbID, lbPoolID, newWeights, healthCheckTimeout)
+		}
+		if err != nil {
+			log.Printf("[WARN] Restoring members of load balancer pool %s: %s", lbPoolID, err)
+			d.Partial(true)
+			if rollbackErr := lbPoolMembersReplace(sess, lbID, lbPoolID, previous, d.Timeout(schema.TimeoutUpdate)); rollbackErr != nil {
+				return fmt.Errorf("[ERROR] Error shifting members of load balancer pool (%s): %s\nThe members could not be restored: %s", lbPoolID, err, rollbackErr)
+			}
+			return fmt.Errorf("[ERROR] Error shifting members of load balancer pool (%s), the members were restored: %s", lbPoolID, err)
+		}
+	}
+	return nil
+}
+
+// isWaitForLBPoolMembersHealthy waits for the health of the pool members with
+// the targets of the given members to be ok
+func isWaitForLBPoolMembersHealthy(sess *vpcv1.VpcV1, lbID, lbPoolID string, members []lbPoolMemberWeight, timeout time.Duration) error {
+	log.Printf("Waiting for load balancer pool (%s) members to be healthy.", lbPoolID)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.LoadBalancerPoolMemberHealthUnknownConst, vpcv1.LoadBalancerPoolMemberHealthFaultedConst},
+		Target:  []string{vpcv1.LoadBalancerPoolMemberHealthOkConst},
+		Refresh: func() (interface{}, string, error) {
+			poolMembers, response, err := sess.ListLoadBalancerPoolMembers(&vpcv1.ListLoadBalancerPoolMembersOptions{
+				LoadBalancerID: &lbID,
+				PoolID:         &lbPoolID,
+			})
+			if err != nil {
+				return nil, "", flex.NewServiceError("Error Listing Load Balancer Pool Members", err, response)
+			}
+			health := map[string]string{}
+			for _, poolMember := range poolMembers.Members {
+				health[lbPoolMemberWeightOf(poolMember).key()] = *poolMember.Health
+			}
+			for _, member := range members {
+				if h := health[member.key()]; h != vpcv1.LoadBalancerPoolMemberHealthOkConst {
+					if h == "" {
+						h = vpcv1.LoadBalancerPoolMemberHealthUnknownConst
+					}
+					return poolMembers, h, nil
+				}
+			}
+			return poolMembers, vpcv1.LoadBalancerPoolMemberHealthOkConst, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func lbPoolMemberWeights(members []vpcv1.LoadBalancerPoolMemberPrototype) []lbPoolMemberWeight {
+	weights := make([]lbPoolMemberWeight, 0, len(members))
+	for _, member := range members {
+		target := member.Target.(*vpcv1.LoadBalancerPoolMemberTargetPrototype)
+		weight := lbPoolMemberWeight{port: *member.Port, weight: *member.Weight}
+		if target.ID != nil {
+			weight.targetID = *target.ID
+		} else {
+			weight.targetAddress = *target.Address
+		}
+		weights = append(weights, weight)
+	}
+	return weights
+}
+
+func lbPoolMemberWeightOf(member vpcv1.LoadBalancerPoolMember) lbPoolMemberWeight {
+	weight := lbPoolMemberWeight{port: *member.Port}
+	if member.Weight != nil {
+		weight.weight = *member.Weight
+	}
+	if target, ok := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget); ok {
+		if target.ID != nil {
+			weight.targetID = *target.ID
+		} else if target.Address != nil {
+			weight.targetAddress = *target.Address
+		}
+	}
+	return weight
+}
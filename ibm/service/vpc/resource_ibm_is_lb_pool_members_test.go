@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISLBPoolMembers_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tflbpm-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflbpmc-name-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfcreate%d", acctest.RandIntRange(10, 100))
+	poolName := fmt.Sprintf("tflbpoolc%d", acctest.RandIntRange(10, 100))
+	node := "ibm_is_lb_pool_members.testacc_lb_members"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISLBPoolMembersConfig(vpcname, subnetname, name, poolName, "", `
+				members {
+					port           = 8080
+					target_address = "192.168.0.1"
+					weight         = 60
+				}
+				members {
+					port           = 8080
+					target_address = "192.168.0.2"
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "members.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(node, "members.*", map[string]string{
+						"target_address": "192.168.0.1",
+						"weight":         "60",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(node, "members.*", map[string]string{
+						"target_address": "192.168.0.2",
+						"weight":         "50",
+					}),
+				),
+			},
+			{
+				Config: testAccCheckIBMISLBPoolMembersConfig(vpcname, subnetname, name, poolName, `
+				shift {
+					steps                = 2
+					health_check_timeout = 60
+				}`, `
+				members {
+					port           = 8080
+					target_address = "192.168.0.3"
+					weight         = 100
+				}`),
+				// the members are not reachable, so their health stays faulted
+				// and the shift is rolled back
+				ExpectError: regexp.MustCompile("the members were restored"),
+			},
+			{
+				Config: testAccCheckIBMISLBPoolMembersConfig(vpcname, subnetname, name, poolName, "", `
+				members {
+					port           = 8080
+					target_address = "192.168.0.3"
+					weight         = 100
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "members.#", "1"),
+					resource.TestCheckResourceAttr(node, "members.0.target_address", "192.168.0.3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISLBPoolMembersConfig(vpcname, subnetname, name, poolName, shift, members string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_lb" "testacc_LB" {
+		name    = "%s"
+		subnets = [ibm_is_subnet.testacc_subnet.id]
+	}
+
+	resource "ibm_is_lb_pool" "testacc_lb_pool" {
+		name           = "%s"
+		lb             = ibm_is_lb.testacc_LB.id
+		algorithm      = "weighted_round_robin"
+		protocol       = "http"
+		health_delay   = 5
+		health_retries = 2
+		health_timeout = 2
+		health_type    = "tcp"
+	}
+
+	resource "ibm_is_lb_pool_members" "testacc_lb_members" {
+		lb   = ibm_is_lb.testacc_LB.id
+		pool = element(split("/", ibm_is_lb_pool.testacc_lb_pool.id), 1)
+		%s
+		%s
+	}`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, poolName, shift, members)
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 	"strings"
 	"time"
@@ -65,13 +64,17 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return networkACLRulesCustomizeDiff(ctx, diff, v)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -103,6 +106,20 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 
 			isNetworkACLAccessTags: {
 				Type:        schema.TypeSet,
@@ -140,6 +157,8 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 				Computed:    true,
 				Description: "The resource group name in which resource is provisioned",
 			},
+			isRuleAnalysis: ruleAnalysisSchema("ibm_is_network_acl"),
+			isRuleFindings: ruleFindingsSchema(),
 			isNetworkACLRules: {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -397,6 +416,14 @@ func ResourceIBMISNetworkACLValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             128})
 
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isRuleAnalysis,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "default, strict, warn"})
+
 	ibmISNetworkACLResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_network_acl", Schema: validateSchema}
 	return &ibmISNetworkACLResourceValidator
 }
@@ -455,7 +482,7 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 
 	nwacl, response, err := sess.CreateNetworkACL(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG]Error while creating Network ACL err %s\n%s", err, response)
+		return flex.NewServiceError("Error creating Network ACL", err, response)
 	}
 	d.SetId(*nwacl.ID)
 	log.Printf("[INFO] Network ACL : %s", *nwacl.ID)
@@ -471,8 +498,7 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isNetworkACLTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isNetworkACLTags) {
 		oldList, newList := d.GetChange(isNetworkACLTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *nwacl.CRN, "", isUserTagType)
 		if err != nil {
@@ -480,7 +506,7 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 				"Error on create of resource network acl (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isNetworkACLAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isNetworkACLAccessTags) {
 		oldList, newList := d.GetChange(isNetworkACLAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *nwacl.CRN, "", isAccessTagType)
 		if err != nil {
@@ -514,7 +540,7 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Network ACL(%s) : %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting Network ACL(%s)", id), err, response)
 	}
 	d.Set(isNetworkACLName, *nwacl.Name)
 	d.Set(isNetworkACLVPC, *nwacl.VPC.ID)
@@ -534,8 +560,8 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource network acl (%s) access tags: %s", d.Id(), err)
 	}
 
-	d.Set(isNetworkACLTags, tags)
-	d.Set(isNetworkACLAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isNetworkACLTags, isUserTagType, tags)
+	flex.SetResourceTags(d, meta, isNetworkACLAccessTags, isAccessTagType, accesstags)
 	d.Set(isNetworkACLCRN, *nwacl.CRN)
 	rules := make([]interface{}, 0)
 	if len(nwacl.Rules) > 0 {
@@ -618,6 +644,13 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 	}
 	d.Set(isNetworkACLRules, rules)
+	analyzed := make([]analyzedRule, 0, len(nwacl.Rules))
+	for i, ruleItem := range nwacl.Rules {
+		if rule, ok := networkACLRuleFromItem(i, ruleItem); ok {
+			analyzed = append(analyzed, rule)
+		}
+	}
+	d.Set(isRuleFindings, flattenRuleFindings(analyzeNetworkACLRules(analyzed)))
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -666,10 +699,10 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		updateNetworkACLOptions.NetworkACLPatch = networkACLPatch
 		_, response, err := sess.UpdateNetworkACL(updateNetworkACLOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Network ACL(%s) : %s\n%s", id, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error Updating Network ACL(%s)", id), err, response)
 		}
 	}
-	if d.HasChange(isNetworkACLTags) {
+	if flex.TagsHasChange(d, isNetworkACLTags) {
 		oldList, newList := d.GetChange(isNetworkACLTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isNetworkACLCRN).(string), "", isUserTagType)
 		if err != nil {
@@ -677,7 +710,7 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 				"Error on update of resource network acl (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if d.HasChange(isNetworkACLAccessTags) {
+	if flex.AccessTagsHasChange(d, isNetworkACLAccessTags) {
 		oldList, newList := d.GetChange(isNetworkACLAccessTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isNetworkACLCRN).(string), "", isAccessTagType)
 		if err != nil {
@@ -731,7 +764,7 @@ func nwaclDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Network ACL (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Network ACL (%s)", id), err, response)
 	}
 
 	deleteNetworkAclOptions := &vpcv1.DeleteNetworkACLOptions{
@@ -739,7 +772,7 @@ func nwaclDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteNetworkACL(deleteNetworkAclOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Network ACL : %s\n%s", err, response)
+		return flex.NewServiceError("Error Deleting Network ACL", err, response)
 	}
 	d.SetId("")
 	return nil
@@ -764,7 +797,7 @@ func nwaclExists(d *schema.ResourceData, meta interface{}, id string) (bool, err
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Network ACL: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error getting Network ACL", err, response)
 	}
 	return true, nil
 }
@@ -788,7 +821,7 @@ func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
 		}
 		rawrules, response, err := nwaclC.ListNetworkACLRules(listNetworkAclRulesOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Listing network ACL rules : %s\n%s", err, response)
+			return flex.NewServiceError("Error Listing network ACL rules", err, response)
 		}
 		start = flex.GetNext(rawrules.Next)
 		allrecs = append(allrecs, rawrules.Rules...)
@@ -815,7 +848,7 @@ func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
 
 		response, err := nwaclC.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Deleting network ACL rule : %s\n%s", err, response)
+			return flex.NewServiceError("Error Deleting network ACL rule", err, response)
 		}
 	}
 	return nil
@@ -945,7 +978,7 @@ func createInlineRules(nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{})
 		}
 		_, response, err := nwaclC.CreateNetworkACLRule(createNetworkAclRuleOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Creating network ACL rule : %s\n%s", err, response)
+			return flex.NewServiceError("Error Creating network ACL rule", err, response)
 		}
 	}
 	return nil
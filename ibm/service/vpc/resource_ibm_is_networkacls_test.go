@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -108,6 +109,26 @@ func TestNetworkACLGen2(t *testing.T) {
 	})
 }
 
+func TestNetworkACLShadowedRule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISNetworkACLShadowedRuleConfig(""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`rule "allow-ssh" is masked by the all protocol rule "deny-all"`),
+			},
+			{
+				// with rule_analysis = "warn" the finding is only reported
+				Config:             testAccCheckIBMISNetworkACLShadowedRuleConfig("warn"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func checkNetworkACLDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -280,3 +301,38 @@ func testAccCheckIBMISNetworkACLResourceGroupConfig(resourceGroupSelect bool) st
 	  }
 	`, acc.ISZoneName, resourceGroupSelect, acc.IsResourceGroupID)
 }
+
+func testAccCheckIBMISNetworkACLShadowedRuleConfig(ruleAnalysis string) string {
+	if ruleAnalysis != "" {
+		ruleAnalysis = fmt.Sprintf("rule_analysis = %q", ruleAnalysis)
+	}
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "tf-nwacl-vpc"
+	}
+
+	resource "ibm_is_network_acl" "isExampleACL" {
+		name = "is-example-acl"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		%s
+		rules {
+			name        = "deny-all"
+			action      = "deny"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+		}
+		rules {
+			name        = "allow-ssh"
+			action      = "allow"
+			source      = "10.0.0.0/8"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+			tcp {
+				port_min = 22
+				port_max = 22
+			}
+		}
+	}
+	`, ruleAnalysis)
+}
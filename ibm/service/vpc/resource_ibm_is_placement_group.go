@@ -50,7 +50,7 @@ func ResourceIbmIsPlacementGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 		Schema: map[string]*schema.Schema{
@@ -82,6 +82,20 @@ func ResourceIbmIsPlacementGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 			isPlacementGroupAccessTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -193,7 +207,7 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for placement group to be available %s", err))
 	}
-	if _, ok := d.GetOk(isPlacementGroupTags); ok {
+	if flex.HasTagsToAttach(d, meta, isPlacementGroupTags) {
 		oldList, newList := d.GetChange(isPlacementGroupTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *placementGroup.CRN, "", isUserTagType)
 		if err != nil {
@@ -203,7 +217,7 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 		}
 	}
 
-	if _, ok := d.GetOk(isPlacementGroupAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isPlacementGroupAccessTags) {
 		oldList, newList := d.GetChange(isPlacementGroupAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *placementGroup.CRN, "", isAccessTagType)
 		if err != nil {
@@ -273,8 +287,8 @@ func resourceIbmIsPlacementGroupRead(context context.Context, d *schema.Resource
 			"Error getting placement group (%s) access tags: %s", d.Id(), err)
 	}
 
-	d.Set(isPlacementGroupTags, tags)
-	d.Set(isPlacementGroupAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isPlacementGroupTags, isUserTagType, tags)
+	flex.SetResourceTags(d, meta, isPlacementGroupAccessTags, isAccessTagType, accesstags)
 	return nil
 }
 
@@ -309,7 +323,7 @@ func resourceIbmIsPlacementGroupUpdate(context context.Context, d *schema.Resour
 			return diag.FromErr(err)
 		}
 	}
-	if d.HasChange(isPlacementGroupTags) {
+	if flex.TagsHasChange(d, isPlacementGroupTags) {
 		oldList, newList := d.GetChange(isPlacementGroupTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isUserTagType)
 		if err != nil {
@@ -318,7 +332,7 @@ func resourceIbmIsPlacementGroupUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
-	if d.HasChange(isPlacementGroupAccessTags) {
+	if flex.AccessTagsHasChange(d, isPlacementGroupAccessTags) {
 		oldList, newList := d.GetChange(isPlacementGroupAccessTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isAccessTagType)
 		if err != nil {
@@ -347,7 +361,7 @@ func resourceIbmIsPlacementGroupDelete(context context.Context, d *schema.Resour
 				return diag.FromErr(fmt.Errorf("[ERROR] Error deleting PLacementGroup: %s", err))
 			}
 		} else {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting PLacementGroup: %s\n%s", err, response))
+			return flex.NewServiceError("Error deleting PLacementGroup", err, response).Diagnostics()
 		}
 	}
 	_, err = isWaitForPlacementGroupDelete(vpcClient, d, d.Id())
@@ -376,7 +390,7 @@ func isWaitForPlacementGroupDelete(vpcClient *vpcv1.VpcV1, d *schema.ResourceDat
 				} else if response != nil && response.StatusCode == 409 {
 					return placementGroup, *placementGroup.LifecycleState, fmt.Errorf("[ERROR] The  PLacementGroup %s failed to delete: %v", id, err)
 				}
-				return nil, "", fmt.Errorf("[ERROR] Error Getting PLacementGroup: %s\n%s", err, response)
+				return nil, "", flex.NewServiceError("Error Getting PLacementGroup", err, response)
 			}
 			if *placementGroup.LifecycleState == isPlacementGroupFailed {
 				return placementGroup, *placementGroup.LifecycleState, fmt.Errorf("[ERROR] The  PLacementGroup %s failed to delete: %v", id, err)
@@ -408,7 +422,7 @@ func isWaitForPlacementGroupDeleteRetry(vpcClient *vpcv1.VpcV1, d *schema.Resour
 				} else if response != nil && response.StatusCode == 404 {
 					return response, isPlacementGroupDeleteDone, nil
 				}
-				return response, "", fmt.Errorf("[ERROR] Error deleting PLacementGroup: %s\n%s", err, response)
+				return response, "", flex.NewServiceError("Error deleting PLacementGroup", err, response)
 			}
 			return response, isPlacementGroupDeleting, nil
 		},
@@ -442,14 +456,14 @@ func isPlacementGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.Re
 		}
 		placementGroup, response, err := vpcClient.GetPlacementGroup(getinsOptions)
 		if placementGroup == nil || err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting placementGroup : %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error getting placementGroup", err, response)
 		}
 
 		d.Set("lifecycle_state", *placementGroup.LifecycleState)
 
 		if *placementGroup.LifecycleState == isPlacementGroupSuspended || *placementGroup.LifecycleState == isPlacementGroupFailed {
 
-			return placementGroup, *placementGroup.LifecycleState, fmt.Errorf("status of placement group is %s : \n%s", *placementGroup.LifecycleState, response)
+			return placementGroup, *placementGroup.LifecycleState, flex.NewServiceError(fmt.Sprintf("status of placement group is %s", *placementGroup.LifecycleState), nil, response)
 
 		}
 		return placementGroup, *placementGroup.LifecycleState, nil
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -54,7 +53,7 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -115,6 +114,20 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Service tags for the public gateway instance",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 
 			isPublicGatewayAccessTags: {
 				Type:        schema.TypeSet,
@@ -243,7 +256,7 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 
 	publicgw, response, err := sess.CreatePublicGateway(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating Public Gateway %s\n%s", err, response)
+		return flex.NewServiceError("Error while creating Public Gateway", err, response)
 	}
 	d.SetId(*publicgw.ID)
 	log.Printf("[INFO] PublicGateway : %s", *publicgw.ID)
@@ -253,8 +266,7 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isPublicGatewayTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isPublicGatewayTags) {
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *publicgw.CRN, "", isUserTagType)
 		if err != nil {
@@ -263,7 +275,7 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if _, ok := d.GetOk(isPublicGatewayAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isPublicGatewayAccessTags) {
 		oldList, newList := d.GetChange(isPublicGatewayAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *publicgw.CRN, "", isAccessTagType)
 		if err != nil {
@@ -297,7 +309,7 @@ func isPublicGatewayRefreshFunc(publicgwC *vpcv1.VpcV1, id string) resource.Stat
 		}
 		publicgw, response, err := publicgwC.GetPublicGateway(getPublicGatewayOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error getting Public Gateway", err, response)
 		}
 
 		if *publicgw.Status == isPublicGatewayProvisioningDone {
@@ -323,7 +335,7 @@ func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
+		return flex.NewServiceError("Error getting Public Gateway", err, response)
 	}
 	d.Set(isPublicGatewayName, *publicgw.Name)
 	if publicgw.FloatingIP != nil {
@@ -342,7 +354,7 @@ func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) er
 		log.Printf(
 			"Error on get of vpc public gateway (%s) tags: %s", id, err)
 	}
-	d.Set(isPublicGatewayTags, tags)
+	flex.SetResourceTags(d, meta, isPublicGatewayTags, isUserTagType, tags)
 
 	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *publicgw.CRN, "", isAccessTagType)
 	if err != nil {
@@ -350,7 +362,7 @@ func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) er
 			"Error on get of vpc public gateway (%s) access tags: %s", d.Id(), err)
 	}
 
-	d.Set(isPublicGatewayAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isPublicGatewayAccessTags, isAccessTagType, accesstags)
 
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
@@ -382,13 +394,13 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 		name = d.Get(isPublicGatewayName).(string)
 		hasChanged = true
 	}
-	if d.HasChange(isPublicGatewayTags) {
+	if flex.TagsHasChange(d, isPublicGatewayTags) {
 		getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 			ID: &id,
 		}
 		publicgw, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
+			return flex.NewServiceError("Error getting Public Gateway", err, response)
 		}
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *publicgw.CRN, "", isUserTagType)
@@ -398,13 +410,13 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange(isPublicGatewayAccessTags) {
+	if flex.AccessTagsHasChange(d, isPublicGatewayAccessTags) {
 		getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 			ID: &id,
 		}
 		publicgw, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Public Gateway : %s\n%s", err, response)
+			return flex.NewServiceError("Error getting Public Gateway", err, response)
 		}
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *publicgw.CRN, "", isAccessTagType)
@@ -428,7 +440,7 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 		updatePublicGatewayOptions.PublicGatewayPatch = PublicGatewayPatch
 		_, response, err := sess.UpdatePublicGateway(updatePublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Public Gateway  : %s\n%s", err, response)
+			return flex.NewServiceError("Error Updating Public Gateway", err, response)
 		}
 	}
 	return resourceIBMISPublicGatewayRead(d, meta)
@@ -450,7 +462,7 @@ func resourceIBMISPublicGatewayDelete(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Public Gateway (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Public Gateway (%s)", id), err, response)
 	}
 
 	deletePublicGatewayOptions := &vpcv1.DeletePublicGatewayOptions{
@@ -474,15 +486,15 @@ func resourceIBMISPublicGatewayDelete(d *schema.ResourceData, meta interface{})
 						}
 						response, err = sess.DeletePublicGateway(deletePublicGatewayOptions)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error Deleting Public Gateway : %s\n%s", err, response)
+							return flex.NewServiceError("Error Deleting Public Gateway", err, response)
 						}
 					} else {
-						return fmt.Errorf("[ERROR] Error Unsetting Public Gateway : %s\n%s", errSub, res)
+						return flex.NewServiceError("Error Unsetting Public Gateway", errSub, res)
 					}
 				}
 			}
 		} else {
-			return fmt.Errorf("[ERROR] Error Deleting Public Gateway : error is %s\n%s", err, response)
+			return flex.NewServiceError("Error Deleting Public Gateway : error is", err, response)
 		}
 	}
 	_, err = isWaitForPublicGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
@@ -519,7 +531,7 @@ func isPublicGatewayDeleteRefreshFunc(pg *vpcv1.VpcV1, id string) resource.State
 			if response != nil && response.StatusCode == 404 {
 				return pgw, isPublicGatewayDeleted, nil
 			}
-			return nil, "", fmt.Errorf("[ERROR] The Public Gateway %s failed to delete: %s\n%s", id, err, response)
+			return nil, "", flex.NewServiceError(fmt.Sprintf("The Public Gateway %s failed to delete", id), err, response)
 		}
 		return pgw, isPublicGatewayDeleting, nil
 	}
@@ -539,7 +551,7 @@ func resourceIBMISPublicGatewayExists(d *schema.ResourceData, meta interface{})
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Public Gateway: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error getting Public Gateway", err, response)
 	}
 	return true, nil
 }
@@ -570,7 +582,7 @@ func isSubnetPublicGatewayUnsetRefreshFunc(subnetC *vpcv1.VpcV1, id string) reso
 			if response.StatusCode == 404 {
 				return subnetPublicGateway, "done", nil
 			}
-			return subnetPublicGateway, "", fmt.Errorf("[ERROR] Error getting Subnet PublicGateway : %s\n%s", err, response)
+			return subnetPublicGateway, "", flex.NewServiceError("Error getting Subnet PublicGateway", err, response)
 		}
 
 		return subnetPublicGateway, "wait", nil
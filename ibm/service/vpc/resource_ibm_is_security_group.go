@@ -7,12 +7,13 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -42,13 +43,17 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return securityGroupRulesCustomizeDiff(ctx, diff, v)
+				}),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -80,6 +85,20 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 
 			isSecurityGroupAccessTags: {
 				Type:        schema.TypeSet,
@@ -98,13 +117,19 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 
 			isSecurityGroupRules: {
 				Type:        schema.TypeList,
+				Optional:    true,
 				Computed:    true,
-				Description: "Security Rules",
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Description: "Security Rules. When set, the rules are authoritative and any rule not listed is deleted from the security group. Set to an empty list to remove all rules",
 				Elem: &schema.Resource{
 					Schema: makeIBMISSecurityRuleSchema(),
 				},
 			},
 
+			isRuleAnalysis: ruleAnalysisSchema("ibm_is_security_group"),
+
+			isRuleFindings: ruleFindingsSchema(),
+
 			isSecurityGroupResourceGroup: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -173,6 +198,14 @@ func ResourceIBMISSecurityGroupValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             128})
 
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isRuleAnalysis,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "default, strict, warn"})
+
 	ibmISSecurityGroupResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_security_group", Schema: validateSchema}
 	return &ibmISSecurityGroupResourceValidator
 }
@@ -202,11 +235,10 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 	}
 	sg, response, err := sess.CreateSecurityGroup(createSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating Security Group %s\n%s", err, response)
+		return flex.NewServiceError("Error while creating Security Group", err, response)
 	}
 	d.SetId(*sg.ID)
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isSecurityGroupTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isSecurityGroupTags) {
 		oldList, newList := d.GetChange(isSecurityGroupTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *sg.CRN, "", isUserTagType)
 		if err != nil {
@@ -214,7 +246,7 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 				"Error while creating Security Group tags : %s\n%s", *sg.ID, err)
 		}
 	}
-	if _, ok := d.GetOk(isSecurityGroupAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isSecurityGroupAccessTags) {
 		oldList, newList := d.GetChange(isSecurityGroupAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *sg.CRN, "", isAccessTagType)
 		if err != nil {
@@ -222,6 +254,14 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 				"Error on create of Security Group (%s) access tags: %s", d.Id(), err)
 		}
 	}
+	// An explicit `rules = []` is authoritative too, so check the raw config
+	// rather than GetOk, which treats an empty list as unset.
+	if !d.GetRawConfig().GetAttr(isSecurityGroupRules).IsNull() {
+		err = resourceIBMISSecurityGroupSyncRules(d, sess)
+		if err != nil {
+			return err
+		}
+	}
 	return resourceIBMISSecurityGroupRead(d, meta)
 }
 
@@ -241,7 +281,7 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Security Group : %s\n%s", err, response)
+		return flex.NewServiceError("Error getting Security Group", err, response)
 	}
 	tags, err := flex.GetGlobalTagsUsingCRN(meta, *group.CRN, "", isUserTagType)
 	if err != nil {
@@ -253,100 +293,21 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 		log.Printf(
 			"Error on get of Security Group (%s) access tags: %s", d.Id(), err)
 	}
-	d.Set(isSecurityGroupTags, tags)
-	d.Set(isSecurityGroupAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isSecurityGroupTags, isUserTagType, tags)
+	flex.SetResourceTags(d, meta, isSecurityGroupAccessTags, isAccessTagType, accesstags)
 	d.Set(isSecurityGroupCRN, *group.CRN)
 	d.Set(isSecurityGroupName, *group.Name)
 	d.Set(isSecurityGroupVPC, *group.VPC.ID)
-	rules := make([]map[string]interface{}, 0)
-	if len(group.Rules) > 0 {
-		for _, rule := range group.Rules {
-			switch reflect.TypeOf(rule).String() {
-			case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp":
-				{
-					rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp)
-					r := make(map[string]interface{})
-					if rule.Code != nil {
-						r[isSecurityGroupRuleCode] = int(*rule.Code)
-					}
-					if rule.Type != nil {
-						r[isSecurityGroupRuleType] = int(*rule.Type)
-					}
-					r[isSecurityGroupRuleDirection] = *rule.Direction
-					r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
-					if rule.Protocol != nil {
-						r[isSecurityGroupRuleProtocol] = *rule.Protocol
-					}
-					remote, ok := rule.Remote.(*vpcv1.SecurityGroupRuleRemote)
-					if ok {
-						if remote != nil && reflect.ValueOf(remote).IsNil() == false {
-							if remote.ID != nil {
-								r[isSecurityGroupRuleRemote] = remote.ID
-							} else if remote.Address != nil {
-								r[isSecurityGroupRuleRemote] = remote.Address
-							} else if remote.CIDRBlock != nil {
-								r[isSecurityGroupRuleRemote] = remote.CIDRBlock
-							}
-						}
-					}
-					rules = append(rules, r)
-				}
-			case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll":
-				{
-					rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll)
-					r := make(map[string]interface{})
-					r[isSecurityGroupRuleDirection] = *rule.Direction
-					r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
-					if rule.Protocol != nil {
-						r[isSecurityGroupRuleProtocol] = *rule.Protocol
-					}
-					remote, ok := rule.Remote.(*vpcv1.SecurityGroupRuleRemote)
-					if ok {
-						if remote != nil && reflect.ValueOf(remote).IsNil() == false {
-							if remote.ID != nil {
-								r[isSecurityGroupRuleRemote] = remote.ID
-							} else if remote.Address != nil {
-								r[isSecurityGroupRuleRemote] = remote.Address
-							} else if remote.CIDRBlock != nil {
-								r[isSecurityGroupRuleRemote] = remote.CIDRBlock
-							}
-						}
-					}
-					rules = append(rules, r)
-				}
-			case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp":
-				{
-					rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp)
-					r := make(map[string]interface{})
-					if rule.PortMin != nil {
-						r[isSecurityGroupRulePortMin] = int(*rule.PortMin)
-					}
-					if rule.PortMax != nil {
-						r[isSecurityGroupRulePortMax] = int(*rule.PortMax)
-					}
-					r[isSecurityGroupRuleDirection] = *rule.Direction
-					r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
-					if rule.Protocol != nil {
-						r[isSecurityGroupRuleProtocol] = *rule.Protocol
-					}
-					remote, ok := rule.Remote.(*vpcv1.SecurityGroupRuleRemote)
-					if ok {
-						if remote != nil && reflect.ValueOf(remote).IsNil() == false {
-							if remote.ID != nil {
-								r[isSecurityGroupRuleRemote] = remote.ID
-							} else if remote.Address != nil {
-								r[isSecurityGroupRuleRemote] = remote.Address
-							} else if remote.CIDRBlock != nil {
-								r[isSecurityGroupRuleRemote] = remote.CIDRBlock
-							}
-						}
-					}
-					rules = append(rules, r)
-				}
-			}
-		}
+	rules := flattenIBMISSecurityGroupRules(group.Rules)
+	if prior, ok := d.GetOk(isSecurityGroupRules); ok {
+		rules = orderIBMISSecurityGroupRules(prior.([]interface{}), rules)
 	}
 	d.Set(isSecurityGroupRules, rules)
+	analyzed := make([]analyzedRule, 0, len(rules))
+	for i, rule := range rules {
+		analyzed = append(analyzed, securityGroupRuleFromMap(i, rule))
+	}
+	d.Set(isRuleFindings, flattenRuleFindings(analyzeSecurityGroupRules(analyzed)))
 	d.SetId(*group.ID)
 	if group.ResourceGroup != nil {
 		d.Set(isSecurityGroupResourceGroup, group.ResourceGroup.ID)
@@ -371,7 +332,7 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 	name := ""
 	hasChanged := false
 
-	if d.HasChange(isSecurityGroupTags) {
+	if flex.TagsHasChange(d, isSecurityGroupTags) {
 		oldList, newList := d.GetChange(isSecurityGroupTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSecurityGroupCRN).(string), "", isUserTagType)
 		if err != nil {
@@ -379,7 +340,7 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 				"Error Updating Security Group tags: %s\n%s", d.Id(), err)
 		}
 	}
-	if d.HasChange(isSecurityGroupAccessTags) {
+	if flex.AccessTagsHasChange(d, isSecurityGroupAccessTags) {
 		oldList, newList := d.GetChange(isSecurityGroupAccessTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSecurityGroupCRN).(string), "", isAccessTagType)
 		if err != nil {
@@ -390,8 +351,6 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 	if d.HasChange(isSecurityGroupName) {
 		name = d.Get(isSecurityGroupName).(string)
 		hasChanged = true
-	} else {
-		return resourceIBMISSecurityGroupRead(d, meta)
 	}
 
 	if hasChanged {
@@ -408,7 +367,13 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 		updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
 		_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Security Group : %s\n%s", err, response)
+			return flex.NewServiceError("Error Updating Security Group", err, response)
+		}
+	}
+	if d.HasChange(isSecurityGroupRules) {
+		err = resourceIBMISSecurityGroupSyncRules(d, sess)
+		if err != nil {
+			return err
 		}
 	}
 	return resourceIBMISSecurityGroupRead(d, meta)
@@ -430,7 +395,7 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Security Group (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Security Group (%s)", id), err, response)
 	}
 
 	start := ""
@@ -441,7 +406,7 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 
 		groups, response, err := sess.ListSecurityGroupTargets(listSecurityGroupTargetsOptions)
 		if err != nil || groups == nil {
-			return fmt.Errorf("[ERROR] Error Getting Security Group Targets %s\n%s", err, response)
+			return flex.NewServiceError("Error Getting Security Group Targets", err, response)
 		}
 		if *groups.TotalCount == int64(0) {
 			break
@@ -475,7 +440,7 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 							}
 						}
 					} else {
-						return fmt.Errorf("[ERROR] Error deleting security group target binding while deleting security group : %s\n%s", err, response)
+						return flex.NewServiceError("Error deleting security group target binding while deleting security group", err, response)
 					}
 				}
 
@@ -500,13 +465,291 @@ func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 				}
 			}
 		} else {
-			return fmt.Errorf("[ERROR] Error Deleting Security Group : %s\n%s", err, response)
+			return flex.NewServiceError("Error Deleting Security Group", err, response)
 		}
 	}
 	d.SetId("")
 	return nil
 }
 
+// resourceIBMISSecurityGroupSyncRules makes the rules of the security group match
+// the configured rules, deleting rules that are not configured and creating the
+// configured rules that do not exist yet.
+func resourceIBMISSecurityGroupSyncRules(d *schema.ResourceData, sess *vpcv1.VpcV1) error {
+	id := d.Id()
+	isSecurityGroupRuleKey := "security_group_rule_key_" + id
+	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
+	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+
+	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
+		ID: &id,
+	}
+	group, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	if err != nil {
+		return flex.NewServiceError("Error getting Security Group", err, response)
+	}
+
+	desired := make([]map[string]interface{}, 0)
+	for _, ruleIntf := range d.Get(isSecurityGroupRules).([]interface{}) {
+		if rule, ok := ruleIntf.(map[string]interface{}); ok {
+			desired = append(desired, rule)
+		}
+	}
+	wanted := make(map[string]int)
+	for _, rule := range desired {
+		wanted[securityGroupRuleKey(rule)]++
+	}
+
+	existing := make(map[string]int)
+	for _, rule := range flattenIBMISSecurityGroupRules(group.Rules) {
+		key := securityGroupRuleKey(rule)
+		if wanted[key] > 0 {
+			wanted[key]--
+			existing[key]++
+			continue
+		}
+		ruleID := rule[isSecurityGroupRuleID].(string)
+		log.Printf("[DEBUG] Deleting rule (%s) of Security Group (%s) as it is not in the configured rules", ruleID, id)
+		deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
+			SecurityGroupID: &id,
+			ID:              &ruleID,
+		}
+		response, err := sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return flex.NewServiceError("Error Deleting Security Group Rule", err, response)
+		}
+	}
+
+	for _, rule := range desired {
+		key := securityGroupRuleKey(rule)
+		if existing[key] > 0 {
+			existing[key]--
+			continue
+		}
+		sgTemplate, err := expandIBMISSecurityGroupRulePrototype(rule)
+		if err != nil {
+			return err
+		}
+		options := &vpcv1.CreateSecurityGroupRuleOptions{
+			SecurityGroupID:            &id,
+			SecurityGroupRulePrototype: sgTemplate,
+		}
+		_, response, err := sess.CreateSecurityGroupRule(options)
+		if err != nil {
+			return flex.NewServiceError("Error while creating Security Group Rule", err, response)
+		}
+	}
+	return nil
+}
+
+func expandIBMISSecurityGroupRulePrototype(rule map[string]interface{}) (*vpcv1.SecurityGroupRulePrototype, error) {
+	direction := rule[isSecurityGroupRuleDirection].(string)
+	ipVersion := isSecurityGroupRuleIPVersionDefault
+	if v, ok := rule[isSecurityGroupRuleIPVersion].(string); ok && v != "" {
+		ipVersion = v
+	}
+	protocol := "all"
+	if v, ok := rule[isSecurityGroupRuleProtocol].(string); ok && v != "" {
+		protocol = v
+	}
+	sgTemplate := &vpcv1.SecurityGroupRulePrototype{
+		Direction: &direction,
+		IPVersion: &ipVersion,
+		Protocol:  &protocol,
+	}
+
+	if remote, ok := rule[isSecurityGroupRuleRemote].(string); ok && remote != "" {
+		address, cidr, sgID, err := inferRemoteSecurityGroup(remote)
+		if err != nil {
+			return nil, err
+		}
+		remoteTemplate := &vpcv1.SecurityGroupRuleRemotePrototype{}
+		if address != "" {
+			remoteTemplate.Address = &address
+		} else if cidr != "" {
+			remoteTemplate.CIDRBlock = &cidr
+		} else {
+			remoteTemplate.ID = &sgID
+		}
+		sgTemplate.Remote = remoteTemplate
+	}
+
+	switch protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		icmpType, _ := rule[isSecurityGroupRuleType].(int)
+		icmpCode, _ := rule[isSecurityGroupRuleCode].(int)
+		if icmpCode != 0 && icmpType == 0 {
+			return nil, fmt.Errorf("[ERROR] icmp code requires icmp type")
+		}
+		if icmpType != 0 {
+			sgTemplate.Type = core.Int64Ptr(int64(icmpType))
+		}
+		if icmpCode != 0 {
+			sgTemplate.Code = core.Int64Ptr(int64(icmpCode))
+		}
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		portMin, portMax := securityGroupRulePortRange(rule)
+		sgTemplate.PortMin = core.Int64Ptr(int64(portMin))
+		sgTemplate.PortMax = core.Int64Ptr(int64(portMax))
+	}
+	return sgTemplate, nil
+}
+
+// securityGroupRulePortRange returns the port range of a tcp or udp rule. If only
+// one bound is set the range is that single port, and if neither is set the range
+// covers every port, as the API does.
+func securityGroupRulePortRange(rule map[string]interface{}) (int, int) {
+	portMin, _ := rule[isSecurityGroupRulePortMin].(int)
+	portMax, _ := rule[isSecurityGroupRulePortMax].(int)
+	if portMin == 0 && portMax == 0 {
+		return 1, 65535
+	}
+	if portMin == 0 {
+		portMin = portMax
+	}
+	if portMax == 0 {
+		portMax = portMin
+	}
+	return portMin, portMax
+}
+
+// securityGroupRuleKey returns a key identifying what a rule matches, applying the
+// API defaults so that a configured rule and the rule returned by the API compare equal.
+func securityGroupRuleKey(rule map[string]interface{}) string {
+	direction, _ := rule[isSecurityGroupRuleDirection].(string)
+	ipVersion, _ := rule[isSecurityGroupRuleIPVersion].(string)
+	if ipVersion == "" {
+		ipVersion = isSecurityGroupRuleIPVersionDefault
+	}
+	protocol, _ := rule[isSecurityGroupRuleProtocol].(string)
+	if protocol == "" {
+		protocol = "all"
+	}
+	remote, _ := rule[isSecurityGroupRuleRemote].(string)
+	if remote == "" {
+		remote = "0.0.0.0/0"
+	}
+	var first, second int
+	switch protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		first, _ = rule[isSecurityGroupRuleType].(int)
+		second, _ = rule[isSecurityGroupRuleCode].(int)
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		first, second = securityGroupRulePortRange(rule)
+	}
+	return fmt.Sprintf("%s/%s/%s/%s/%d/%d", direction, ipVersion, protocol, remote, first, second)
+}
+
+// orderIBMISSecurityGroupRules keeps the rules in the order they already have in
+// state so that only rules added or removed outside of terraform show up in the plan.
+func orderIBMISSecurityGroupRules(prior []interface{}, rules []map[string]interface{}) []map[string]interface{} {
+	used := make([]bool, len(rules))
+	ordered := make([]map[string]interface{}, 0, len(rules))
+	for _, priorIntf := range prior {
+		priorRule, ok := priorIntf.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		match := -1
+		if ruleID, _ := priorRule[isSecurityGroupRuleID].(string); ruleID != "" {
+			for i, rule := range rules {
+				if !used[i] && rule[isSecurityGroupRuleID] == ruleID {
+					match = i
+					break
+				}
+			}
+		}
+		if match == -1 {
+			key := securityGroupRuleKey(priorRule)
+			for i, rule := range rules {
+				if !used[i] && securityGroupRuleKey(rule) == key {
+					match = i
+					break
+				}
+			}
+		}
+		if match != -1 {
+			used[match] = true
+			ordered = append(ordered, rules[match])
+		}
+	}
+	for i, rule := range rules {
+		if !used[i] {
+			ordered = append(ordered, rule)
+		}
+	}
+	return ordered
+}
+
+func flattenIBMISSecurityGroupRules(sgRules []vpcv1.SecurityGroupRuleIntf) []map[string]interface{} {
+	rules := make([]map[string]interface{}, 0)
+	for _, rule := range sgRules {
+		r := make(map[string]interface{})
+		var remoteIntf vpcv1.SecurityGroupRuleRemoteIntf
+		switch reflect.TypeOf(rule).String() {
+		case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp":
+			{
+				rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp)
+				if rule.Code != nil {
+					r[isSecurityGroupRuleCode] = int(*rule.Code)
+				}
+				if rule.Type != nil {
+					r[isSecurityGroupRuleType] = int(*rule.Type)
+				}
+				r[isSecurityGroupRuleID] = *rule.ID
+				r[isSecurityGroupRuleDirection] = *rule.Direction
+				r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
+				if rule.Protocol != nil {
+					r[isSecurityGroupRuleProtocol] = *rule.Protocol
+				}
+				remoteIntf = rule.Remote
+			}
+		case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll":
+			{
+				rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll)
+				r[isSecurityGroupRuleID] = *rule.ID
+				r[isSecurityGroupRuleDirection] = *rule.Direction
+				r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
+				if rule.Protocol != nil {
+					r[isSecurityGroupRuleProtocol] = *rule.Protocol
+				}
+				remoteIntf = rule.Remote
+			}
+		case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp":
+			{
+				rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp)
+				if rule.PortMin != nil {
+					r[isSecurityGroupRulePortMin] = int(*rule.PortMin)
+				}
+				if rule.PortMax != nil {
+					r[isSecurityGroupRulePortMax] = int(*rule.PortMax)
+				}
+				r[isSecurityGroupRuleID] = *rule.ID
+				r[isSecurityGroupRuleDirection] = *rule.Direction
+				r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
+				if rule.Protocol != nil {
+					r[isSecurityGroupRuleProtocol] = *rule.Protocol
+				}
+				remoteIntf = rule.Remote
+			}
+		default:
+			continue
+		}
+		remote, ok := remoteIntf.(*vpcv1.SecurityGroupRuleRemote)
+		if ok && remote != nil {
+			if remote.ID != nil {
+				r[isSecurityGroupRuleRemote] = *remote.ID
+			} else if remote.Address != nil {
+				r[isSecurityGroupRuleRemote] = *remote.Address
+			} else if remote.CIDRBlock != nil {
+				r[isSecurityGroupRuleRemote] = *remote.CIDRBlock
+			}
+		}
+		rules = append(rules, r)
+	}
+	return rules
+}
+
 func resourceIBMISSecurityGroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -522,7 +765,7 @@ func resourceIBMISSecurityGroupExists(d *schema.ResourceData, meta interface{})
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Security Group: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error getting Security Group", err, response)
 	}
 	return true, nil
 }
@@ -530,47 +773,73 @@ func resourceIBMISSecurityGroupExists(d *schema.ResourceData, meta interface{})
 func makeIBMISSecurityRuleSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 
-		isSecurityGroupRuleDirection: {
+		isSecurityGroupRuleID: {
 			Type:        schema.TypeString,
 			Computed:    true,
-			Description: "Direction of traffic to enforce, either inbound or outbound",
+			Description: "Rule id",
+		},
+
+		isSecurityGroupRuleDirection: {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Direction of traffic to enforce, either inbound or outbound",
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleDirection),
 		},
 
 		isSecurityGroupRuleIPVersion: {
-			Type:        schema.TypeString,
-			Computed:    true,
-			Description: "IP version: ipv4",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "IP version: ipv4",
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleIPVersion),
 		},
 
 		isSecurityGroupRuleRemote: {
-			Type:        schema.TypeString,
-			Computed:    true,
-			Description: "Security group id: an IP address, a CIDR block, or a single security group identifier",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Security group id: an IP address, a CIDR block, or a single security group identifier",
+			ValidateFunc: validate.ValidateSecurityGroupRemote,
 		},
 
 		isSecurityGroupRuleType: {
-			Type:     schema.TypeInt,
-			Computed: true,
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The ICMP traffic type to allow, applicable only when protocol is icmp",
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleType),
 		},
 
 		isSecurityGroupRuleCode: {
-			Type:     schema.TypeInt,
-			Computed: true,
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The ICMP traffic code to allow, applicable only when protocol is icmp",
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleCode),
 		},
 
 		isSecurityGroupRulePortMin: {
-			Type:     schema.TypeInt,
-			Computed: true,
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The inclusive lower bound of the port range, applicable only when protocol is tcp or udp",
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMin),
 		},
 
 		isSecurityGroupRulePortMax: {
-			Type:     schema.TypeInt,
-			Computed: true,
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The inclusive upper bound of the port range, applicable only when protocol is tcp or udp",
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMax),
 		},
 
 		isSecurityGroupRuleProtocol: {
-			Type:     schema.TypeString,
-			Computed: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The protocol to enforce, one of icmp, tcp or udp. If omitted, the rule applies to all protocols",
+			ValidateFunc: validate.ValidateSecurityRuleProtocol,
 		},
 	}
 }
@@ -598,7 +867,7 @@ func isTargetRefreshFunc(client *vpcv1.VpcV1, sgId, targetId string, target vpcv
 		}
 		sgTarget, response, err := client.GetSecurityGroupTarget(targetgetoptions)
 		if err != nil {
-			return target, "", fmt.Errorf("[ERROR] Error getting target(%s): %s\n%s", targetId, err, response)
+			return target, "", flex.NewServiceError(fmt.Sprintf("Error getting target(%s)", targetId), err, response)
 		}
 		if response != nil && response.StatusCode == 404 {
 			return target, "done", nil
@@ -630,7 +899,7 @@ func isSgRefreshFunc(client *vpcv1.VpcV1, sgId string, groups []vpcv1.SecurityGr
 
 			sggroups, response, err := client.ListSecurityGroupTargets(listSecurityGroupTargetsOptions)
 			if err != nil || sggroups == nil {
-				return groups, "", fmt.Errorf("[ERROR] Error Getting Security Group Targets %s\n%s", err, response)
+				return groups, "", flex.NewServiceError("Error Getting Security Group Targets", err, response)
 			}
 			if *sggroups.TotalCount == int64(0) {
 				return groups, "done", nil
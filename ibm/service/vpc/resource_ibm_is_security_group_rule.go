@@ -248,7 +248,7 @@ func resourceIBMISSecurityGroupRuleCreate(d *schema.ResourceData, meta interface
 
 	rule, response, err := sess.CreateSecurityGroupRule(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating Security Group Rule %s\n%s", err, response)
+		return flex.NewServiceError("Error while creating Security Group Rule", err, response)
 	}
 	switch reflect.TypeOf(rule).String() {
 	case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp":
@@ -296,7 +296,7 @@ func resourceIBMISSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Security Group Rule (%s)", ruleID), err, response)
 	}
 	d.Set(isSecurityGroupID, secgrpID)
 	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
@@ -304,7 +304,7 @@ func resourceIBMISSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}
 	}
 	sg, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Security Group : %s\n%s", err, response)
+		return flex.NewServiceError("Error Getting Security Group", err, response)
 	}
 	d.Set(flex.RelatedCRN, *sg.CRN)
 	switch reflect.TypeOf(sgrule).String() {
@@ -421,7 +421,7 @@ func resourceIBMISSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface
 	updateSecurityGroupRuleOptions := sgTemplate
 	_, response, err := sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Security Group Rule : %s\n%s", err, response)
+		return flex.NewServiceError("Error Updating Security Group Rule", err, response)
 	}
 	return resourceIBMISSecurityGroupRuleRead(d, meta)
 }
@@ -451,7 +451,7 @@ func resourceIBMISSecurityGroupRuleDelete(d *schema.ResourceData, meta interface
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Security Group Rule (%s)", ruleID), err, response)
 	}
 
 	deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
@@ -460,7 +460,7 @@ func resourceIBMISSecurityGroupRuleDelete(d *schema.ResourceData, meta interface
 	}
 	response, err = sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
 	if err != nil && response.StatusCode != 404 {
-		return fmt.Errorf("[ERROR] Error Deleting Security Group Rule : %s\n%s", err, response)
+		return flex.NewServiceError("Error Deleting Security Group Rule", err, response)
 	}
 	d.SetId("")
 	return nil
@@ -485,7 +485,7 @@ func resourceIBMISSecurityGroupRuleExists(d *schema.ResourceData, meta interface
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return false, flex.NewServiceError(fmt.Sprintf("Error getting Security Group Rule (%s)", ruleID), err, response)
 	}
 	return true, nil
 }
@@ -599,7 +599,7 @@ func parseIBMISSecurityGroupRuleDictionary(d *schema.ResourceData, tag string, s
 				if res != nil && res.StatusCode == 404 {
 					return nil, nil, nil, err
 				}
-				return nil, nil, nil, fmt.Errorf("Error getting Security Group in remote (%s): %s\n%s", parsed.remoteSecGrpID, err, res)
+				return nil, nil, nil, flex.NewServiceError(fmt.Sprintf("Error getting Security Group in remote (%s)", parsed.remoteSecGrpID), err, res)
 			}
 		}
 		sgTemplate.Remote = remoteTemplate
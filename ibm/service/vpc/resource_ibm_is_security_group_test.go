@@ -6,12 +6,14 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -96,6 +98,60 @@ func TestAccIBMISSecurityGroup_wait(t *testing.T) {
 	})
 }
 
+func TestAccIBMISSecurityGroup_rules(t *testing.T) {
+	var securityGroupID string
+	vpcname := fmt.Sprintf("tfsg-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsg-rules-%d", acctest.RandIntRange(10, 100))
+	terraformTag := "ibm_is_security_group.testacc_security_group"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISsecurityGroupRulesConfig(vpcname, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupStoreID(terraformTag, &securityGroupID),
+					resource.TestCheckResourceAttr(terraformTag, "rules.#", "2"),
+					resource.TestCheckResourceAttr(terraformTag, "rules.0.direction", "inbound"),
+					resource.TestCheckResourceAttr(terraformTag, "rules.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(terraformTag, "rules.0.port_min", "22"),
+					resource.TestCheckResourceAttr(terraformTag, "rules.0.port_max", "22"),
+					resource.TestCheckResourceAttr(terraformTag, "rules.1.direction", "outbound"),
+					resource.TestCheckResourceAttr(terraformTag, "rules.1.protocol", "all"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISsecurityGroupRulesConfig(vpcname, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(terraformTag, "rules.#", "1"),
+					resource.TestCheckResourceAttr(terraformTag, "rules.0.protocol", "tcp"),
+				),
+			},
+			{
+				// a rule added outside of terraform is removed on the next apply
+				PreConfig: func() { testAccIBMISSecurityGroupAddRule(t, securityGroupID) },
+				Config:    testAccCheckIBMISsecurityGroupRulesConfig(vpcname, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(terraformTag, "rules.#", "1"),
+					resource.TestCheckResourceAttr(terraformTag, "rules.0.protocol", "tcp"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMISsecurityGroupRulesRemoteConfig(vpcname, name, "10.0.0.0/33"),
+				ExpectError: regexp.MustCompile("must be a valid ip address, cidr block or security group id"),
+			},
+			{
+				Config: testAccCheckIBMISsecurityGroupEmptyRulesConfig(vpcname, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(terraformTag, "rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISSecurityGroupDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -227,3 +283,89 @@ resource "ibm_is_security_group" "testacc_security_group" {
 }`, vpcname, name)
 
 }
+
+func testAccCheckIBMISsecurityGroupRulesConfig(vpcname, name string, egress bool) string {
+	egressRule := ""
+	if egress {
+		egressRule = `
+	rules {
+		direction = "outbound"
+	}`
+	}
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+	name = "%s"
+}
+
+resource "ibm_is_security_group" "testacc_security_group" {
+	name = "%s"
+	vpc  = ibm_is_vpc.testacc_vpc.id
+	rules {
+		direction = "inbound"
+		remote    = "10.0.0.0/8"
+		protocol  = "tcp"
+		port_min  = 22
+		port_max  = 22
+	}%s
+}`, vpcname, name, egressRule)
+}
+
+func testAccCheckIBMISsecurityGroupRulesRemoteConfig(vpcname, name, remote string) string {
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+	name = "%s"
+}
+
+resource "ibm_is_security_group" "testacc_security_group" {
+	name = "%s"
+	vpc  = ibm_is_vpc.testacc_vpc.id
+	rules {
+		direction = "inbound"
+		remote    = "%s"
+	}
+}`, vpcname, name, remote)
+}
+
+func testAccCheckIBMISsecurityGroupEmptyRulesConfig(vpcname, name string) string {
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+	name = "%s"
+}
+
+resource "ibm_is_security_group" "testacc_security_group" {
+	name  = "%s"
+	vpc   = ibm_is_vpc.testacc_vpc.id
+	rules = []
+}`, vpcname, name)
+}
+
+func testAccCheckIBMISSecurityGroupStoreID(n string, securityGroupID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		*securityGroupID = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccIBMISSecurityGroupAddRule(t *testing.T, securityGroupID string) {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := &vpcv1.CreateSecurityGroupRuleOptions{
+		SecurityGroupID: &securityGroupID,
+		SecurityGroupRulePrototype: &vpcv1.SecurityGroupRulePrototype{
+			Direction: core.StringPtr("inbound"),
+			Protocol:  core.StringPtr("all"),
+			Remote: &vpcv1.SecurityGroupRuleRemotePrototype{
+				CIDRBlock: core.StringPtr("0.0.0.0/0"),
+			},
+		},
+	}
+	if _, _, err := sess.CreateSecurityGroupRule(options); err != nil {
+		t.Fatalf("Error creating out of band security group rule: %s", err)
+	}
+}
@@ -42,7 +42,7 @@ func ResourceIbmIsShare() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -657,6 +657,20 @@ func ResourceIbmIsShare() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "User Tags for the file share",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 			isFileShareAccessTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -904,23 +918,20 @@ func resourceIbmIsShareCreate(context context.Context, d *schema.ResourceData, m
 		}
 		sharePrototype.Zone = zone
 	}
-	var userTags *schema.Set
-	if v, ok := d.GetOk(isFileShareTags); ok {
-		userTags = v.(*schema.Set)
-		if userTags != nil && userTags.Len() != 0 {
-			userTagsArray := make([]string, userTags.Len())
-			for i, userTag := range userTags.List() {
-				userTagStr := userTag.(string)
-				userTagsArray[i] = userTagStr
-			}
-			schematicTags := os.Getenv("IC_ENV_TAGS")
-			var envTags []string
-			if schematicTags != "" {
-				envTags = strings.Split(schematicTags, ",")
-				userTagsArray = append(userTagsArray, envTags...)
-			}
-			sharePrototype.UserTags = userTagsArray
+	if flex.HasTagsToAttach(d, meta, isFileShareTags) {
+		userTags := d.Get(isFileShareTags).(*schema.Set)
+		userTagsArray := make([]string, userTags.Len())
+		for i, userTag := range userTags.List() {
+			userTagStr := userTag.(string)
+			userTagsArray[i] = userTagStr
 		}
+		schematicTags := os.Getenv("IC_ENV_TAGS")
+		var envTags []string
+		if schematicTags != "" {
+			envTags = strings.Split(schematicTags, ",")
+			userTagsArray = append(userTagsArray, envTags...)
+		}
+		sharePrototype.UserTags = flex.WithDefaultUserTags(meta, userTagsArray)
 	}
 	createShareOptions.SetSharePrototype(sharePrototype)
 	share, response, err := vpcClient.CreateShareWithContext(context, createShareOptions)
@@ -931,13 +942,13 @@ func resourceIbmIsShareCreate(context context.Context, d *schema.ResourceData, m
 
 	_, err = isWaitForShareAvailable(context, vpcClient, *share.ID, d, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 
 	if share.ReplicaShare != nil && share.ReplicaShare.ID != nil {
 		_, err = isWaitForShareAvailable(context, vpcClient, *share.ReplicaShare.ID, d, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return flex.DiagFromErr(err)
 		}
 		replicaShareAccessTagsSchema := "replica_share.0.access_tags"
 		if _, ok := d.GetOk(replicaShareAccessTagsSchema); ok {
@@ -951,7 +962,7 @@ func resourceIbmIsShareCreate(context context.Context, d *schema.ResourceData, m
 	}
 	d.SetId(*share.ID)
 
-	if _, ok := d.GetOk(isFileShareAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isFileShareAccessTags) {
 		oldList, newList := d.GetChange(isFileShareAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *share.CRN, "", isAccessTagType)
 		if err != nil {
@@ -1169,13 +1180,10 @@ func resourceIbmIsShareRead(context context.Context, d *schema.ResourceData, met
 		log.Printf(
 			"Error getting shares (%s) access tags: %s", d.Id(), err)
 	}
-	d.Set(isFileShareAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isFileShareAccessTags, isAccessTagType, accesstags)
 	// d.Set(isFileShareTags, tags)
 	if share.UserTags != nil {
-		if err = d.Set(isFileShareTags, share.UserTags); err != nil {
-			log.Printf(
-				"Error setting shares (%s) user tags: %s", d.Id(), err)
-		}
+		flex.SetResourceTags(d, meta, isFileShareTags, "user", share.UserTags)
 	}
 
 	return nil
@@ -1204,7 +1212,7 @@ func resourceIbmIsShareUpdate(context context.Context, d *schema.ResourceData, m
 
 	err = shareUpdate(vpcClient, context, d, meta, "share", d.Id(), eTag)
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 	if d.HasChange("replica_share") {
 		if share.ReplicaShare != nil && share.ReplicaShare.ID != nil {
@@ -1223,11 +1231,11 @@ func resourceIbmIsShareUpdate(context context.Context, d *schema.ResourceData, m
 			eTag := response.Headers.Get("ETag")
 			err = shareUpdate(vpcClient, context, d, meta, "replica_share", *replicaShare.ID, eTag)
 			if err != nil {
-				return diag.FromErr(err)
+				return flex.DiagFromErr(err)
 			}
 		}
 	}
-	if d.HasChange(isFileShareAccessTags) {
+	if flex.AccessTagsHasChange(d, isFileShareAccessTags) {
 		oldList, newList := d.GetChange(isFileShareAccessTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isAccessTagType)
 		if err != nil {
@@ -1274,7 +1282,7 @@ func resourceIbmIsShareDelete(context context.Context, d *schema.ResourceData, m
 				}
 				_, err = isWaitForTargetDelete(context, vpcClient, d, d.Id(), *targetsItem.ID)
 				if err != nil {
-					return diag.FromErr(err)
+					return flex.DiagFromErr(err)
 				}
 			}
 		}
@@ -1311,7 +1319,7 @@ func resourceIbmIsShareDelete(context context.Context, d *schema.ResourceData, m
 						}
 						_, err = isWaitForTargetDelete(context, vpcClient, d, d.Id(), *targetsItem.ID)
 						if err != nil {
-							return diag.FromErr(err)
+							return flex.DiagFromErr(err)
 						}
 					}
 				}
@@ -1337,7 +1345,7 @@ func resourceIbmIsShareDelete(context context.Context, d *schema.ResourceData, m
 
 			_, err = isWaitForShareDelete(context, vpcClient, d, *replicaShare.ID)
 			if err != nil {
-				return diag.FromErr(err)
+				return flex.DiagFromErr(err)
 			}
 		}
 	}
@@ -1363,7 +1371,7 @@ func resourceIbmIsShareDelete(context context.Context, d *schema.ResourceData, m
 
 	_, err = isWaitForShareDelete(context, vpcClient, d, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 
 	d.SetId("")
@@ -1394,7 +1402,7 @@ func isShareRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, shareid
 
 		share, response, err := vpcClient.GetShareWithContext(context, shareOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting share: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting share", err, response)
 		}
 		d.Set("lifecycle_state", *share.LifecycleState)
 		if *share.LifecycleState == "stable" || *share.LifecycleState == "failed" {
@@ -1421,7 +1429,7 @@ func isWaitForShareDelete(context context.Context, vpcClient *vpcv1.VpcV1, d *sc
 				if response != nil && response.StatusCode == 404 {
 					return share, "done", nil
 				}
-				return nil, "", fmt.Errorf("Error Getting Target: %s\n%s", err, response)
+				return nil, "", flex.NewServiceError("Error Getting Target", err, response)
 			}
 			if *share.LifecycleState == isInstanceFailed {
 				return share, *share.LifecycleState, fmt.Errorf("The  target %s failed to delete: %v", shareid, err)
@@ -1610,26 +1618,30 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 		hasChange = true
 	}
 
-	if d.HasChange(shareTagsSchema) {
+	// the provider default_tags only apply to the share, not to its replica
+	if d.HasChange(shareTagsSchema) || (shareType == "share" && d.HasChange(flex.ResourceTagsAll)) {
 		var userTags *schema.Set
 		if v, ok := d.GetOk(shareTagsSchema); ok {
-
 			userTags = v.(*schema.Set)
-			if userTags != nil && userTags.Len() != 0 {
-				userTagsArray := make([]string, userTags.Len())
-				for i, userTag := range userTags.List() {
-					userTagStr := userTag.(string)
-					userTagsArray[i] = userTagStr
-				}
-				schematicTags := os.Getenv("IC_ENV_TAGS")
-				var envTags []string
-				if schematicTags != "" {
-					envTags = strings.Split(schematicTags, ",")
-					userTagsArray = append(userTagsArray, envTags...)
-				}
-
-				sharePatchModel.UserTags = userTagsArray
-			}
+		} else {
+			userTags = &schema.Set{F: schema.HashString}
+		}
+		userTagsArray := make([]string, userTags.Len())
+		for i, userTag := range userTags.List() {
+			userTagStr := userTag.(string)
+			userTagsArray[i] = userTagStr
+		}
+		schematicTags := os.Getenv("IC_ENV_TAGS")
+		var envTags []string
+		if schematicTags != "" {
+			envTags = strings.Split(schematicTags, ",")
+			userTagsArray = append(userTagsArray, envTags...)
+		}
+		if shareType == "share" {
+			userTagsArray = flex.WithDefaultUserTags(meta, userTagsArray)
+		}
+		if len(userTagsArray) != 0 {
+			sharePatchModel.UserTags = userTagsArray
 		}
 		hasChange = true
 	}
@@ -1750,7 +1762,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 						}
 						_, response, err := vpcClient.CreateSecurityGroupTargetBinding(createsgnicoptions)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error while creating security group %q for virtual network interface of share mount target %s\n%s: %q", add[i], d.Id(), err, response)
+							return flex.NewServiceError(fmt.Sprintf("Error while creating security group %q for virtual network interface of share mount target %s", add[i], d.Id()), err, response)
 						}
 						_, err = WaitForVNIAvailable(vpcClient, networkID, d, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
@@ -1772,7 +1784,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 						}
 						response, err := vpcClient.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error while removing security group %q for virtual network interface of share mount target %s\n%s: %q", remove[i], d.Id(), err, response)
+							return flex.NewServiceError(fmt.Sprintf("Error while removing security group %q for virtual network interface of share mount target %s", remove[i], d.Id()), err, response)
 						}
 						_, err = WaitForVNIAvailable(vpcClient, networkID, d, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
@@ -1814,7 +1826,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 				updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 				_, response, err := vpcClient.UpdateSubnetReservedIP(updateripoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error updating instance network interface reserved ip(%s): %s\n%s", ripId, err, response)
+					return flex.NewServiceError(fmt.Sprintf("Error updating instance network interface reserved ip(%s)", ripId), err, response)
 				}
 				_, err = isWaitForReservedIpAvailable(sess, subnetId, ripId, d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
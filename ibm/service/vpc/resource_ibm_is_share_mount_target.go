@@ -70,8 +70,10 @@ func ResourceIBMIsShareMountTarget() *schema.Resource {
 						},
 						"id": {
 							Type:        schema.TypeString,
+							Optional:    true,
 							Computed:    true,
-							Description: "ID of this VNI",
+							ForceNew:    true,
+							Description: "ID of an existing VNI to attach to the mount target. The other arguments of the block describe the VNI to create when it is not set.",
 						},
 						"crn": {
 							Type:        schema.TypeString,
@@ -80,7 +82,8 @@ func ResourceIBMIsShareMountTarget() *schema.Resource {
 						},
 						"name": {
 							Type:        schema.TypeString,
-							Required:    true,
+							Optional:    true,
+							Computed:    true,
 							Description: "Name of this VNI",
 						},
 						"primary_ip": {
@@ -161,6 +164,7 @@ func ResourceIBMIsShareMountTarget() *schema.Resource {
 						"subnet": {
 							Type:     schema.TypeString,
 							Optional: true,
+							Computed: true,
 							//ConflictsWith: []string{"virtual_network_interface.0.primary_ip"},
 							Description: "The associated subnet. Required if primary_ip is not specified.",
 						},
@@ -275,7 +279,7 @@ func resourceIBMIsShareMountTargetCreate(context context.Context, d *schema.Reso
 			if *mountTargets.LifecycleState == "deleting" {
 				_, err = isWaitForTargetDelete(context, vpcClient, d, shareId, *mountTargets.ID)
 				if err != nil {
-					return diag.FromErr(err)
+					return flex.DiagFromErr(err)
 				}
 			}
 		}
@@ -478,7 +482,7 @@ func resourceIBMIsShareMountTargetUpdate(context context.Context, d *schema.Reso
 				}
 				_, response, err := vpcClient.CreateSecurityGroupTargetBinding(createsgnicoptions)
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] Error while creating security group %q for virtual network interface of share mount target %s\n%s: %q", add[i], d.Id(), err, response))
+					return flex.NewServiceError(fmt.Sprintf("Error while creating security group %q for virtual network interface of share mount target %s", add[i], d.Id()), err, response).Diagnostics()
 				}
 				_, err = WaitForVNIAvailable(vpcClient, networkID, d, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
@@ -500,7 +504,7 @@ func resourceIBMIsShareMountTargetUpdate(context context.Context, d *schema.Reso
 				}
 				response, err := vpcClient.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] Error while removing security group %q for virtual network interface of share mount target %s\n%s: %q", remove[i], d.Id(), err, response))
+					return flex.NewServiceError(fmt.Sprintf("Error while removing security group %q for virtual network interface of share mount target %s", remove[i], d.Id()), err, response).Diagnostics()
 				}
 				_, err = WaitForVNIAvailable(vpcClient, networkID, d, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
@@ -542,7 +546,7 @@ func resourceIBMIsShareMountTargetUpdate(context context.Context, d *schema.Reso
 		updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 		_, response, err := vpcClient.UpdateSubnetReservedIP(updateripoptions)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating instance network interface reserved ip(%s): %s\n%s", ripId, err, response))
+			return flex.NewServiceError(fmt.Sprintf("Error updating instance network interface reserved ip(%s)", ripId), err, response).Diagnostics()
 		}
 		_, err = isWaitForReservedIpAvailable(sess, subnetId, ripId, d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
@@ -594,7 +598,7 @@ func resourceIBMIsShareMountTargetDelete(context context.Context, d *schema.Reso
 	}
 	_, err = isWaitForMountTargetDelete(context, vpcClient, d, parts[0], parts[1])
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 
 	d.SetId("")
@@ -626,7 +630,7 @@ func mountTargetRefresh(context context.Context, vpcClient *vpcv1.VpcV1, shareid
 
 		target, response, err := vpcClient.GetShareMountTargetWithContext(context, shareTargetOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting target: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting target", err, response)
 		}
 		d.Set("lifecycle_state", *target.LifecycleState)
 		if *target.LifecycleState == "stable" || *target.LifecycleState == "failed" {
@@ -654,7 +658,7 @@ func isWaitForMountTargetDelete(context context.Context, vpcClient *vpcv1.VpcV1,
 				if response != nil && response.StatusCode == 404 {
 					return target, "done", nil
 				}
-				return nil, "", fmt.Errorf("Error Getting Target: %s\n%s", err, response)
+				return nil, "", flex.NewServiceError("Error Getting Target", err, response)
 			}
 			if *target.LifecycleState == isInstanceFailed {
 				return target, *target.LifecycleState, fmt.Errorf("The  target %s failed to delete: %v", targetid, err)
@@ -716,7 +720,7 @@ func ShareMountTargetVirtualNetworkInterfaceToMap(context context.Context, vpcCl
 		}
 		rIp, response, err := vpcClient.GetSubnetReservedIP(rIpOptions)
 		if err != nil {
-			return nil, fmt.Errorf("[ERROR] Error getting network interface reserved ip(%s) attached to the virtual instance network interface(%s): %s\n%s", *vni.PrimaryIP.ID, *vni.ID, err, response)
+			return nil, flex.NewServiceError(fmt.Sprintf("Error getting network interface reserved ip(%s) attached to the virtual instance network interface(%s)", *vni.PrimaryIP.ID, *vni.ID), err, response)
 		}
 		currentPrimIp["auto_delete"] = rIp.AutoDelete
 
@@ -739,6 +743,10 @@ func ShareMountTargetVirtualNetworkInterfaceToMap(context context.Context, vpcCl
 
 func ShareMountTargetMapToShareMountTargetPrototype(d *schema.ResourceData, vniMap map[string]interface{}) (vpcv1.ShareMountTargetVirtualNetworkInterfacePrototype, error) {
 	vniPrototype := vpcv1.ShareMountTargetVirtualNetworkInterfacePrototype{}
+	if id, _ := vniMap["id"].(string); id != "" {
+		vniPrototype.ID = &id
+		return vniPrototype, nil
+	}
 	name, _ := vniMap["name"].(string)
 	if name != "" {
 		vniPrototype.Name = &name
@@ -813,7 +821,7 @@ func isWaitForTargetDelete(context context.Context, vpcClient *vpcv1.VpcV1, d *s
 				if response != nil && response.StatusCode == 404 {
 					return target, "done", nil
 				}
-				return nil, "", fmt.Errorf("Error Getting Target: %s\n%s", err, response)
+				return nil, "", flex.NewServiceError("Error Getting Target", err, response)
 			}
 			if *target.LifecycleState == isInstanceFailed {
 				return target, *target.LifecycleState, fmt.Errorf("The  target %s failed to delete: %v", targetid, err)
@@ -850,7 +858,7 @@ func VNIRefreshFunc(vpcClient *vpcv1.VpcV1, vniId string, d *schema.ResourceData
 		}
 		vni, response, err := vpcClient.GetVirtualNetworkInterface(getVNIOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting virtual network interface : %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting virtual network interface", err, response)
 		}
 
 		if *vni.LifecycleState == "failed" {
@@ -884,7 +892,7 @@ func mountTargetRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, sha
 
 		target, response, err := vpcClient.GetShareMountTargetWithContext(context, shareTargetOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting target: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting target", err, response)
 		}
 		d.Set("lifecycle_state", *target.LifecycleState)
 		if *target.LifecycleState == "stable" || *target.LifecycleState == "failed" {
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -126,7 +127,7 @@ func resourceIbmIsShareReplicaOperationsCreate(context context.Context, d *schem
 		response, err := vpcClient.FailoverShareWithContext(context, failOverShareOptions)
 		if err != nil {
 			log.Printf("[DEBUG] FailoverShareWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] FailoverShareWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("FailoverShareWithContext failed", err, response).Diagnostics()
 		}
 	} else {
 		deleteShareSourceOptions := &vpcv1.DeleteShareSourceOptions{
@@ -135,7 +136,7 @@ func resourceIbmIsShareReplicaOperationsCreate(context context.Context, d *schem
 		response, err := vpcClient.DeleteShareSourceWithContext(context, deleteShareSourceOptions)
 		if err != nil {
 			log.Printf("[DEBUG] DeleteShareSourceWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] DeleteShareSourceWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("DeleteShareSourceWithContext failed", err, response).Diagnostics()
 		}
 	}
 	_, err = isWaitForShareReplicationJobDone(context, vpcClient, share_id, d, d.Timeout(schema.TimeoutCreate))
@@ -173,7 +174,7 @@ func isShareReplicationJobRefreshFunc(context context.Context, vpcClient *vpcv1.
 
 		share, response, err := vpcClient.GetShareWithContext(context, shareOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting share: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting share", err, response)
 		}
 		if *share.ReplicationStatus == "active" || *share.ReplicationStatus == "none" {
 
@@ -221,7 +222,7 @@ func isShareSplitRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, sh
 
 		share, response, err := vpcClient.GetShareWithContext(context, shareOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting share: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting share", err, response)
 		}
 		d.Set("replication_status", *share.LifecycleState)
 		if *share.LifecycleState == "none" {
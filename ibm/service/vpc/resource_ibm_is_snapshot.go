@@ -68,7 +68,7 @@ func ResourceIBMSnapshot() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -384,6 +384,20 @@ func ResourceIBMSnapshot() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "User Tags for the snapshot",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 
 			isSnapshotBackupPolicyPlan: {
 				Type:        schema.TypeList,
@@ -542,26 +556,24 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	var userTags *schema.Set
-	if v, ok := d.GetOk(isSnapshotUserTags); ok {
-		userTags = v.(*schema.Set)
-		if userTags != nil && userTags.Len() != 0 {
-			userTagsArray := make([]string, userTags.Len())
-			for i, userTag := range userTags.List() {
-				userTagStr := userTag.(string)
-				userTagsArray[i] = userTagStr
-			}
-			schematicTags := os.Getenv("IC_ENV_TAGS")
-			var envTags []string
-			if schematicTags != "" {
-				envTags = strings.Split(schematicTags, ",")
-				userTagsArray = append(userTagsArray, envTags...)
-			}
-			if snapbyVolFlag {
-				snapshotprototypeoptions.UserTags = userTagsArray
-			} else {
-				snapshotprototypeoptionsbysourcesnapshot.UserTags = userTagsArray
-			}
+	if flex.HasTagsToAttach(d, meta, isSnapshotUserTags) {
+		userTags := d.Get(isSnapshotUserTags).(*schema.Set)
+		userTagsArray := make([]string, userTags.Len())
+		for i, userTag := range userTags.List() {
+			userTagStr := userTag.(string)
+			userTagsArray[i] = userTagStr
+		}
+		schematicTags := os.Getenv("IC_ENV_TAGS")
+		var envTags []string
+		if schematicTags != "" {
+			envTags = strings.Split(schematicTags, ",")
+			userTagsArray = append(userTagsArray, envTags...)
+		}
+		userTagsArray = flex.WithDefaultUserTags(meta, userTagsArray)
+		if snapbyVolFlag {
+			snapshotprototypeoptions.UserTags = userTagsArray
+		} else {
+			snapshotprototypeoptionsbysourcesnapshot.UserTags = userTagsArray
 		}
 	}
 
@@ -574,7 +586,7 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 
 	snapshot, response, err := sess.CreateSnapshot(options)
 	if err != nil || snapshot == nil {
-		return fmt.Errorf("[ERROR] Error creating Snapshot %s\n%s", err, response)
+		return flex.NewServiceError("Error creating Snapshot", err, response)
 	}
 
 	d.SetId(*snapshot.ID)
@@ -586,7 +598,7 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	if _, ok := d.GetOk(isSnapshotAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isSnapshotAccessTags) {
 		oldList, newList := d.GetChange(isSubnetAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshot.CRN, "", isAccessTagType)
 		if err != nil {
@@ -619,7 +631,7 @@ func isSnapshotRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFu
 		}
 		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
 		if err != nil {
-			return nil, isSnapshotFailed, fmt.Errorf("[ERROR] Error getting Snapshot : %s\n%s", err, response)
+			return nil, isSnapshotFailed, flex.NewServiceError("Error getting Snapshot", err, response)
 		}
 
 		if *snapshot.LifecycleState == isSnapshotAvailable {
@@ -655,7 +667,7 @@ func snapshotGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Snapshot : %s\n%s", err, response)
+		return flex.NewServiceError("Error getting Snapshot", err, response)
 	}
 
 	d.SetId(*snapshot.ID)
@@ -672,9 +684,7 @@ func snapshotGet(d *schema.ResourceData, meta interface{}, id string) error {
 	d.Set(isSnapshotResourceType, *snapshot.ResourceType)
 	d.Set(isSnapshotBootable, *snapshot.Bootable)
 	if snapshot.UserTags != nil {
-		if err = d.Set(isSnapshotUserTags, snapshot.UserTags); err != nil {
-			return fmt.Errorf("[ERROR] Error setting user tags: %s", err)
-		}
+		flex.SetResourceTags(d, meta, isSnapshotUserTags, "user", snapshot.UserTags)
 	}
 	sourceSnapshotList := []map[string]interface{}{}
 	if snapshot.SourceSnapshot != nil {
@@ -768,7 +778,7 @@ func snapshotGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"[ERROR] Error on get of resource snapshot (%s) access tags: %s", d.Id(), err)
 	}
-	d.Set(isSnapshotAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isSnapshotAccessTags, isAccessTagType, accesstags)
 	return nil
 }
 
@@ -804,7 +814,7 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Snapshot : %s\n%s", err, response)
+		return flex.NewServiceError("Error getting Snapshot", err, response)
 	}
 	eTag := response.Headers.Get("ETag")
 
@@ -814,39 +824,34 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 	updateSnapshotOptions.IfMatch = &eTag
 
 	// user tags update
-	if d.HasChange(isSnapshotUserTags) {
-		var userTags *schema.Set
-		if v, ok := d.GetOk(isSnapshotUserTags); ok {
-
-			userTags = v.(*schema.Set)
-			if userTags != nil && userTags.Len() != 0 {
-				userTagsArray := make([]string, userTags.Len())
-				for i, userTag := range userTags.List() {
-					userTagStr := userTag.(string)
-					userTagsArray[i] = userTagStr
-				}
-				schematicTags := os.Getenv("IC_ENV_TAGS")
-				var envTags []string
-				if schematicTags != "" {
-					envTags = strings.Split(schematicTags, ",")
-					userTagsArray = append(userTagsArray, envTags...)
-				}
-				snapshotPatchModel := &vpcv1.SnapshotPatch{}
-				snapshotPatchModel.UserTags = userTagsArray
-				snapshotPatch, err := snapshotPatchModel.AsPatch()
-				if err != nil {
-					return fmt.Errorf("[ERROR] Error calling asPatch for SnapshotPatch: %s", err)
-				}
-				updateSnapshotOptions.SnapshotPatch = snapshotPatch
-				_, response, err := sess.UpdateSnapshot(updateSnapshotOptions)
-				if err != nil {
-					return fmt.Errorf("[ERROR] Error updating Snapshot : %s\n%s", err, response)
-				}
-				_, err = isWaitForSnapshotUpdate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
-				if err != nil {
-					return err
-				}
-			}
+	if flex.TagsHasChange(d, isSnapshotUserTags) {
+		userTags := d.Get(isSnapshotUserTags).(*schema.Set)
+		userTagsArray := make([]string, userTags.Len())
+		for i, userTag := range userTags.List() {
+			userTagStr := userTag.(string)
+			userTagsArray[i] = userTagStr
+		}
+		schematicTags := os.Getenv("IC_ENV_TAGS")
+		var envTags []string
+		if schematicTags != "" {
+			envTags = strings.Split(schematicTags, ",")
+			userTagsArray = append(userTagsArray, envTags...)
+		}
+		userTagsArray = flex.WithDefaultUserTags(meta, userTagsArray)
+		snapshotPatchModel := &vpcv1.SnapshotPatch{}
+		snapshotPatchModel.UserTags = userTagsArray
+		snapshotPatch, err := snapshotPatchModel.AsPatch()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error calling asPatch for SnapshotPatch: %s", err)
+		}
+		updateSnapshotOptions.SnapshotPatch = snapshotPatch
+		_, response, err := sess.UpdateSnapshot(updateSnapshotOptions)
+		if err != nil {
+			return flex.NewServiceError("Error updating Snapshot", err, response)
+		}
+		_, err = isWaitForSnapshotUpdate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
 		}
 	}
 
@@ -864,7 +869,7 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 		updateSnapshotOptions.SnapshotPatch = snapshotPatch
 		_, response, err := sess.UpdateSnapshot(updateSnapshotOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating Snapshot : %s\n%s", err, response)
+			return flex.NewServiceError("Error updating Snapshot", err, response)
 		}
 		_, err = isWaitForSnapshotUpdate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -915,7 +920,7 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 		}
 	}
 
-	if d.HasChange(isSnapshotAccessTags) {
+	if flex.AccessTagsHasChange(d, isSnapshotAccessTags) {
 		oldList, newList := d.GetChange(isSnapshotAccessTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSnapshotCRN).(string), "", isAccessTagType)
 		if err != nil {
@@ -947,7 +952,7 @@ func isSnapshotUpdateRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRef
 		}
 		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
 		if err != nil {
-			return nil, isSnapshotFailed, fmt.Errorf("[ERROR] Error getting Snapshot : %s\n%s", err, response)
+			return nil, isSnapshotFailed, flex.NewServiceError("Error getting Snapshot", err, response)
 		}
 
 		if *snapshot.LifecycleState == isSnapshotAvailable || *snapshot.LifecycleState == isSnapshotFailed {
@@ -984,7 +989,7 @@ func isSnapshotCloneRefreshFunc(sess *vpcv1.VpcV1, id, zoneName string) resource
 			if response.StatusCode == 404 {
 				return nil, "deleted", nil
 			}
-			return nil, "deleted", fmt.Errorf("[ERROR] Error getting Snapshot clone : %s\n%s", err, response)
+			return nil, "deleted", flex.NewServiceError("Error getting Snapshot clone", err, response)
 		}
 
 		if *clone.Available == true {
@@ -1006,7 +1011,7 @@ func isSnapshotCloneDeleteRefreshFunc(sess *vpcv1.VpcV1, id, zoneName string) re
 			if response.StatusCode == 404 {
 				return clone, "deleted", nil
 			}
-			return clone, "false", fmt.Errorf("[ERROR] Error getting Snapshot clone : %s\n%s", err, response)
+			return clone, "false", flex.NewServiceError("Error getting Snapshot clone", err, response)
 		}
 
 		return clone, "true", nil
@@ -1052,7 +1057,7 @@ func snapshotDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Snapshot (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting Snapshot (%s)", id), err, response)
 	}
 
 	deleteSnapshotOptions := &vpcv1.DeleteSnapshotOptions{
@@ -1060,7 +1065,7 @@ func snapshotDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteSnapshot(deleteSnapshotOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Snapshot : %s\n%s", err, response)
+		return flex.NewServiceError("Error deleting Snapshot", err, response)
 	}
 	_, err = isWaitForSnapshotDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -1096,7 +1101,7 @@ func isSnapshotDeleteRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRef
 			if response != nil && response.StatusCode == 404 {
 				return snapshot, isSnapshotDeleted, nil
 			}
-			return nil, isSnapshotFailed, fmt.Errorf("[ERROR] The Snapshot %s failed to delete: %s\n%s", id, err, response)
+			return nil, isSnapshotFailed, flex.NewServiceError(fmt.Sprintf("The Snapshot %s failed to delete", id), err, response)
 		}
 		return snapshot, *snapshot.LifecycleState, nil
 	}
@@ -1121,7 +1126,7 @@ func snapshotExists(d *schema.ResourceData, meta interface{}, id string) (bool,
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Snapshot: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error getting Snapshot", err, response)
 	}
 	return true, nil
 }
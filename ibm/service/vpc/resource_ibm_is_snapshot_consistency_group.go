@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -30,6 +31,14 @@ func ResourceIBMIsSnapshotConsistencyGroup() *schema.Resource {
 		DeleteContext: resourceIBMIsSnapshotConsistencyGroupDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.All(
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return flex.ResourceTagsCustomizeDiff(diff, v)
+				},
+			),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"delete_snapshots_on_delete": &schema.Schema{
 				Type:        schema.TypeBool,
@@ -245,6 +254,20 @@ func ResourceIBMIsSnapshotConsistencyGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Snapshot Consistency Group tags list",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 			"access_tags": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -354,7 +377,7 @@ func resourceIBMIsSnapshotConsistencyGroupCreate(context context.Context, d *sch
 	snapshotConsistencyGroup, response, err := vpcClient.CreateSnapshotConsistencyGroupWithContext(context, createSnapshotConsistencyGroupOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateSnapshotConsistencyGroupWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateSnapshotConsistencyGroupWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateSnapshotConsistencyGroupWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*snapshotConsistencyGroup.ID)
@@ -362,11 +385,10 @@ func resourceIBMIsSnapshotConsistencyGroupCreate(context context.Context, d *sch
 	_, err = isWaitForSnapshotConsistencyGroupAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, "tags") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshotConsistencyGroup.CRN, "", isUserTagType)
 		if err != nil {
@@ -375,7 +397,7 @@ func resourceIBMIsSnapshotConsistencyGroupCreate(context context.Context, d *sch
 		}
 	}
 
-	if _, ok := d.GetOk("access_tags"); ok {
+	if flex.HasAccessTagsToAttach(d, meta, "access_tags") {
 		oldList, newList := d.GetChange("access_tags")
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshotConsistencyGroup.CRN, "", isAccessTagType)
 		if err != nil {
@@ -411,7 +433,7 @@ func isSnapshotConsistencyGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string) re
 		snapshotConsistencyGroup, response, err := vpcClient.GetSnapshotConsistencyGroup(getSnapshotConsistencyGroupOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetSnapshotConsistencyGroupWithContext failed %s\n%s", err, response)
-			return nil, "failed", fmt.Errorf("[ERROR] Error GetSnapshotConsistencyGroupWithContext failed : %s\n%s", err, response)
+			return nil, "failed", flex.NewServiceError("Error GetSnapshotConsistencyGroupWithContext failed", err, response)
 		}
 
 		if *snapshotConsistencyGroup.LifecycleState == "stable" {
@@ -447,7 +469,7 @@ func isSnapshotUpdateConsistencyGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id stri
 		snapshotConsistencyGroup, response, err := vpcClient.GetSnapshotConsistencyGroup(getSnapshotConsistencyGroupOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetSnapshotConsistencyGroupWithContext failed %s\n%s", err, response)
-			return nil, "failed", fmt.Errorf("[ERROR] Error GetSnapshotConsistencyGroupWithContext failed : %s\n%s", err, response)
+			return nil, "failed", flex.NewServiceError("Error GetSnapshotConsistencyGroupWithContext failed", err, response)
 		}
 
 		if *snapshotConsistencyGroup.LifecycleState == "stable" || *snapshotConsistencyGroup.LifecycleState == "failed" {
@@ -487,7 +509,7 @@ func isSnapshotDeleteConsistencyGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id stri
 			if response != nil && response.StatusCode == 404 {
 				return snapshotConsistencyGroup, "deleted", nil
 			}
-			return nil, "failed", fmt.Errorf("[ERROR] The Snapshot Consistency Group %s failed to delete: %s\n%s", id, err, response)
+			return nil, "failed", flex.NewServiceError(fmt.Sprintf("The Snapshot Consistency Group %s failed to delete", id), err, response)
 		}
 		return snapshotConsistencyGroup, *snapshotConsistencyGroup.LifecycleState, nil
 	}
@@ -509,7 +531,7 @@ func resourceIBMIsSnapshotConsistencyGroupRead(context context.Context, d *schem
 			return nil
 		}
 		log.Printf("[DEBUG] GetSnapshotConsistencyGroupWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSnapshotConsistencyGroupWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSnapshotConsistencyGroupWithContext failed", err, response).Diagnostics()
 	}
 
 	if !core.IsNil(snapshotConsistencyGroup.DeleteSnapshotsOnDelete) {
@@ -574,14 +596,14 @@ func resourceIBMIsSnapshotConsistencyGroupRead(context context.Context, d *schem
 		log.Printf(
 			"Error on get of resource vpc snapshot consistency group (%s) tags: %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	flex.SetResourceTags(d, meta, "tags", isUserTagType, tags)
 
 	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *snapshotConsistencyGroup.CRN, "", isAccessTagType)
 	if err != nil {
 		log.Printf(
 			"Error on get of resource VPC snapshot consistency group (%s) access tags: %s", d.Id(), err)
 	}
-	d.Set("access_tags", accesstags)
+	flex.SetResourceTags(d, meta, "access_tags", isAccessTagType, accesstags)
 	return nil
 }
 
@@ -595,7 +617,7 @@ func resourceIBMIsSnapshotConsistencyGroupUpdate(context context.Context, d *sch
 	updateSnapshotConsistencyGroupOptions.SetID(d.Id())
 	hasChange := false
 
-	if d.HasChange("tags") {
+	if flex.TagsHasChange(d, "tags") {
 		getSnapshotConsistencyGroupOptions := &vpcv1.GetSnapshotConsistencyGroupOptions{}
 		getSnapshotConsistencyGroupOptions.SetID(d.Id())
 
@@ -606,7 +628,7 @@ func resourceIBMIsSnapshotConsistencyGroupUpdate(context context.Context, d *sch
 				return nil
 			}
 			log.Printf("[DEBUG] GetSnapshotConsistencyGroupWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetSnapshotConsistencyGroupWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("GetSnapshotConsistencyGroupWithContext failed", err, response).Diagnostics()
 		}
 
 		oldList, newList := d.GetChange("tags")
@@ -616,7 +638,7 @@ func resourceIBMIsSnapshotConsistencyGroupUpdate(context context.Context, d *sch
 				"Error on update of resource vpc snapshot consistency group (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if d.HasChange("access_tags") {
+	if flex.AccessTagsHasChange(d, "access_tags") {
 		getSnapshotConsistencyGroupOptions := &vpcv1.GetSnapshotConsistencyGroupOptions{}
 		getSnapshotConsistencyGroupOptions.SetID(d.Id())
 
@@ -627,7 +649,7 @@ func resourceIBMIsSnapshotConsistencyGroupUpdate(context context.Context, d *sch
 				return nil
 			}
 			log.Printf("[DEBUG] GetSnapshotConsistencyGroupWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetSnapshotConsistencyGroupWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("GetSnapshotConsistencyGroupWithContext failed", err, response).Diagnostics()
 		}
 
 		oldList, newList := d.GetChange("access_tags")
@@ -660,7 +682,7 @@ func resourceIBMIsSnapshotConsistencyGroupUpdate(context context.Context, d *sch
 			return nil
 		}
 		log.Printf("[DEBUG] GetSnapshotConsistencyGroupWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetSnapshotConsistencyGroupWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetSnapshotConsistencyGroupWithContext failed", err, response).Diagnostics()
 	}
 	updateSnapshotConsistencyGroupOptions.SetIfMatch(response.Headers.Get("Etag"))
 
@@ -669,11 +691,11 @@ func resourceIBMIsSnapshotConsistencyGroupUpdate(context context.Context, d *sch
 		_, response, err := vpcClient.UpdateSnapshotConsistencyGroupWithContext(context, updateSnapshotConsistencyGroupOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateSnapshotConsistencyGroupWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateSnapshotConsistencyGroupWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateSnapshotConsistencyGroupWithContext failed", err, response).Diagnostics()
 		}
 		_, err = isWaitForSnapshotConsistencyGroupUpdate(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return flex.DiagFromErr(err)
 		}
 	}
 
@@ -693,12 +715,12 @@ func resourceIBMIsSnapshotConsistencyGroupDelete(context context.Context, d *sch
 	_, response, err := vpcClient.DeleteSnapshotConsistencyGroupWithContext(context, deleteSnapshotConsistencyGroupOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteSnapshotConsistencyGroupWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteSnapshotConsistencyGroupWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteSnapshotConsistencyGroupWithContext failed", err, response).Diagnostics()
 	}
 
 	_, err = isWaitForSnapshotConsistencyGroupDeleted(vpcClient, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 
 	d.SetId("")
@@ -0,0 +1,337 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isSnapshotCopySourceRegion        = "source_region"
+	isSnapshotCopySourceSnapshotID    = "source_snapshot_id"
+	isSnapshotCopySourceEncryptionKey = "source_encryption_key"
+	isSnapshotCopyEncryptionKeyMap    = "encryption_key_map"
+)
+
+func ResourceIBMISSnapshotCopy() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMISSnapshotCopyCreate,
+		Read:     resourceIBMISSnapshotCopyRead,
+		Update:   resourceIBMISSnapshotCopyUpdate,
+		Delete:   resourceIBMISSnapshotCopyDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return flex.ResourceTagsCustomizeDiff(diff, v)
+				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISSnapshotCopyEncryptionKeyCustomizeDiff(diff)
+				}),
+		),
+
+		Schema: map[string]*schema.Schema{
+			isSnapshotSourceSnapshotCRN: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the snapshot to copy. The snapshot can be in any region of the account.",
+			},
+
+			isSnapshotName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_snapshot", isSnapshotName),
+				Description:  "The name of the copy",
+			},
+
+			isSnapshotEncryptionKey: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{isSnapshotCopyEncryptionKeyMap},
+				Description:   "The CRN of the root key to encrypt the copy with",
+			},
+
+			isSnapshotCopyEncryptionKeyMap: {
+				Type:          schema.TypeMap,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{isSnapshotEncryptionKey},
+				Description:   "The CRNs of the root keys of the region of the copy, by the CRN of the root key of the source snapshot",
+			},
+
+			isSnapshotResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The resource group of the copy",
+			},
+
+			isSnapshotUserTags: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_snapshot", isSnapshotUserTags)},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "User Tags for the copy",
+			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the copy, including the provider default_tags",
+			},
+
+			isSnapshotCopySourceRegion: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region of the source snapshot",
+			},
+
+			isSnapshotCopySourceSnapshotID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the source snapshot",
+			},
+
+			isSnapshotCopySourceEncryptionKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the root key of the source snapshot, empty if the source snapshot is encrypted with a provider managed key",
+			},
+
+			isSnapshotEncryption: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of encryption of the copy, provider_managed or user_managed",
+			},
+
+			isSnapshotBootable: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if a boot volume attachment can be created with a volume created from the copy",
+			},
+
+			isSnapshotMinCapacity: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The minimum capacity of a volume created from the copy",
+			},
+
+			isSnapshotSize: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the copy",
+			},
+
+			isSnapshotLCState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the copy",
+			},
+
+			isSnapshotCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the copy",
+			},
+
+			isSnapshotHref: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the copy",
+			},
+		},
+	}
+}
+
+// resourceIBMISSnapshotCopyEncryptionKeyCustomizeDiff replaces the copy when a change of
+// encryption_key_map changes the key of the copy, a snapshot can not be encrypted again
+func resourceIBMISSnapshotCopyEncryptionKeyCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.HasChange(isSnapshotCopyEncryptionKeyMap) || !diff.NewValueKnown(isSnapshotCopyEncryptionKeyMap) {
+		return nil
+	}
+	if !diff.GetRawConfig().GetAttr(isSnapshotEncryptionKey).IsNull() {
+		return nil
+	}
+	sourceKey := diff.Get(isSnapshotCopySourceEncryptionKey).(string)
+	key, err := snapshotCopyEncryptionKey(sourceKey, "", diff.Get(isSnapshotCopyEncryptionKeyMap).(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+	if key != diff.Get(isSnapshotEncryptionKey).(string) {
+		return diff.ForceNew(isSnapshotCopyEncryptionKeyMap)
+	}
+	return nil
+}
+
+func resourceIBMISSnapshotCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+
+	sourceCRN := d.Get(isSnapshotSourceSnapshotCRN).(string)
+	sourceRegion, sourceID, err := snapshotCRNParts(sourceCRN)
+	if err != nil {
+		return err
+	}
+	sourceSess, err := meta.(conns.ClientSession).VpcV1APIForRegion(sourceRegion)
+	if err != nil {
+		return err
+	}
+	getSnapshotOptions := &vpcv1.GetSnapshotOptions{
+		ID: &sourceID,
+	}
+	source, response, err := sourceSess.GetSnapshot(getSnapshotOptions)
+	if err != nil {
+		return flex.NewServiceError(fmt.Sprintf("Error getting source Snapshot in region %s", sourceRegion), err, response)
+	}
+	if *source.LifecycleState != isSnapshotAvailable {
+		return fmt.Errorf("[ERROR] The source snapshot %s is %s, only a stable snapshot can be copied", sourceCRN, *source.LifecycleState)
+	}
+	sourceKey := ""
+	if source.EncryptionKey != nil && source.EncryptionKey.CRN != nil {
+		sourceKey = *source.EncryptionKey.CRN
+	}
+	encryptionKey, err := snapshotCopyEncryptionKey(sourceKey, d.Get(isSnapshotEncryptionKey).(string), d.Get(isSnapshotCopyEncryptionKeyMap).(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	snapshotPrototype := &vpcv1.SnapshotPrototypeSnapshotBySourceSnapshot{
+		SourceSnapshot: &vpcv1.SnapshotIdentityByCRN{
+			CRN: &sourceCRN,
+		},
+	}
+	if name, ok := d.GetOk(isSnapshotName); ok {
+		namestr := name.(string)
+		snapshotPrototype.Name = &namestr
+	}
+	if encryptionKey != "" {
+		snapshotPrototype.EncryptionKey = &vpcv1.EncryptionKeyIdentity{
+			CRN: &encryptionKey,
+		}
+	}
+	if grp, ok := d.GetOk(isSnapshotResourceGroup); ok {
+		rg := grp.(string)
+		snapshotPrototype.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+			ID: &rg,
+		}
+	}
+	if flex.HasTagsToAttach(d, meta, isSnapshotUserTags) {
+		userTagsArray := flex.ExpandStringList(d.Get(isSnapshotUserTags).(*schema.Set).List())
+		if schematicTags := os.Getenv("IC_ENV_TAGS"); schematicTags != "" {
+			userTagsArray = append(userTagsArray, strings.Split(schematicTags, ",")...)
+		}
+		snapshotPrototype.UserTags = flex.WithDefaultUserTags(meta, userTagsArray)
+	}
+
+	options := &vpcv1.CreateSnapshotOptions{
+		SnapshotPrototype: snapshotPrototype,
+	}
+	snapshot, response, err := sess.CreateSnapshot(options)
+	if err != nil || snapshot == nil {
+		return flex.NewServiceError("Error copying Snapshot", err, response)
+	}
+	d.SetId(*snapshot.ID)
+	log.Printf("[INFO] Snapshot copy : %s of %s", *snapshot.ID, sourceCRN)
+
+	d.Set(isSnapshotCopySourceRegion, sourceRegion)
+	d.Set(isSnapshotCopySourceEncryptionKey, sourceKey)
+	_, err = isWaitForSnapshotAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	return resourceIBMISSnapshotCopyRead(d, meta)
+}
+
+func resourceIBMISSnapshotCopyRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	id := d.Id()
+	getSnapshotOptions := &vpcv1.GetSnapshotOptions{
+		ID: &id,
+	}
+	snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return flex.NewServiceError("Error getting Snapshot", err, response)
+	}
+
+	d.Set(isSnapshotName, *snapshot.Name)
+	d.Set(isSnapshotHref, *snapshot.Href)
+	d.Set(isSnapshotCRN, *snapshot.CRN)
+	d.Set(isSnapshotMinCapacity, *snapshot.MinimumCapacity)
+	d.Set(isSnapshotSize, *snapshot.Size)
+	d.Set(isSnapshotEncryption, *snapshot.Encryption)
+	d.Set(isSnapshotLCState, *snapshot.LifecycleState)
+	d.Set(isSnapshotBootable, *snapshot.Bootable)
+	if snapshot.EncryptionKey != nil && snapshot.EncryptionKey.CRN != nil {
+		d.Set(isSnapshotEncryptionKey, *snapshot.EncryptionKey.CRN)
+	}
+	if snapshot.ResourceGroup != nil && snapshot.ResourceGroup.ID != nil {
+		d.Set(isSnapshotResourceGroup, *snapshot.ResourceGroup.ID)
+	}
+	flex.SetResourceTags(d, meta, isSnapshotUserTags, "user", snapshot.UserTags)
+	if snapshot.SourceSnapshot != nil {
+		if snapshot.SourceSnapshot.CRN != nil {
+			d.Set(isSnapshotSourceSnapshotCRN, *snapshot.SourceSnapshot.CRN)
+		}
+		d.Set(isSnapshotCopySourceSnapshotID, *snapshot.SourceSnapshot.ID)
+		if snapshot.SourceSnapshot.Remote != nil && snapshot.SourceSnapshot.Remote.Region != nil {
+			d.Set(isSnapshotCopySourceRegion, *snapshot.SourceSnapshot.Remote.Region.Name)
+		}
+	}
+	return nil
+}
+
+func resourceIBMISSnapshotCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange(isSnapshotName) || flex.TagsHasChange(d, isSnapshotUserTags) {
+		err := snapshotUpdate(d, meta, d.Id(), d.Get(isSnapshotName).(string), d.HasChange(isSnapshotName))
+		if err != nil {
+			return err
+		}
+	}
+	return resourceIBMISSnapshotCopyRead(d, meta)
+}
+
+func resourceIBMISSnapshotCopyDelete(d *schema.ResourceData, meta interface{}) error {
+	err := snapshotDelete(d, meta, d.Id())
+	if err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISSnapshotCopy_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-snapshot-copy-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-snapshot-copy-update-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSnapshotCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISSnapshotCopyConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_snapshot_copy.testacc_snapshot_copy", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_snapshot_copy.testacc_snapshot_copy", "source_snapshot_crn", acc.ISSnapshotCRN),
+					resource.TestCheckResourceAttr("ibm_is_snapshot_copy.testacc_snapshot_copy", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttrSet("ibm_is_snapshot_copy.testacc_snapshot_copy", "source_region"),
+					resource.TestCheckResourceAttrSet("ibm_is_snapshot_copy.testacc_snapshot_copy", "source_snapshot_id"),
+					resource.TestCheckResourceAttrSet("ibm_is_snapshot_copy.testacc_snapshot_copy", "crn"),
+					resource.TestCheckResourceAttrSet("ibm_is_snapshot_copy.testacc_snapshot_copy", "encryption"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISSnapshotCopyConfig(nameUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_snapshot_copy.testacc_snapshot_copy", "name", nameUpdate),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISSnapshotCopyDestroy(s *terraform.State) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_snapshot_copy" {
+			continue
+		}
+		getSnapshotoptions := &vpcv1.GetSnapshotOptions{
+			ID: &rs.Primary.ID,
+		}
+		snapshot, _, err := sess.GetSnapshot(getSnapshotoptions)
+		if err == nil && *snapshot.LifecycleState != "deleted" {
+			return fmt.Errorf("snapshot copy still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISSnapshotCopyConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_snapshot_copy" "testacc_snapshot_copy" {
+		name                = "%s"
+		source_snapshot_crn = "%s"
+	}
+	`, name, acc.ISSnapshotCRN)
+}
@@ -9,7 +9,6 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -47,7 +46,7 @@ func ResourceIBMISSSHKey() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -100,6 +99,20 @@ func ResourceIBMISSSHKey() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for SSH key",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 
 			isKeyResourceGroup: {
 				Type:        schema.TypeString,
@@ -224,13 +237,12 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 
 	key, response, err := sess.CreateKey(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create SSH Key %s\n%s", err, response)
+		return flex.NewServiceError("Create SSH Key", err, response)
 	}
 	d.SetId(*key.ID)
 	log.Printf("[INFO] Key : %s", *key.ID)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isKeyTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isKeyTags) {
 		oldList, newList := d.GetChange(isKeyTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *key.CRN, "", isKeyUserTagType)
 		if err != nil {
@@ -239,7 +251,7 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 		}
 	}
 
-	if _, ok := d.GetOk(isKeyAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isKeyAccessTags) {
 		oldList, newList := d.GetChange(isKeyAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *key.CRN, "", isKeyAccessTagType)
 		if err != nil {
@@ -275,7 +287,7 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting SSH Key (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting SSH Key (%s)", id), err, response)
 	}
 	d.Set(isKeyName, *key.Name)
 	d.Set(isKeyPublicKey, *key.PublicKey)
@@ -287,13 +299,13 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of vpc SSH Key (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isKeyTags, tags)
+	flex.SetResourceTags(d, meta, isKeyTags, isKeyUserTagType, tags)
 	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *key.CRN, "", isKeyAccessTagType)
 	if err != nil {
 		log.Printf(
 			"Error on get of vpc SSH Key (%s) access tags: %s", d.Id(), err)
 	}
-	d.Set(isKeyAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isKeyAccessTags, isKeyAccessTagType, accesstags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -332,13 +344,13 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isKeyTags) {
+	if flex.TagsHasChange(d, isKeyTags) {
 		options := &vpcv1.GetKeyOptions{
 			ID: &id,
 		}
 		key, response, err := sess.GetKey(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting SSH Key : %s\n%s", err, response)
+			return flex.NewServiceError("Error getting SSH Key", err, response)
 		}
 		oldList, newList := d.GetChange(isKeyTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *key.CRN, "", isKeyUserTagType)
@@ -347,13 +359,13 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 				"Error on update of resource vpc SSH Key (%s) tags: %s", id, err)
 		}
 	}
-	if d.HasChange(isKeyAccessTags) {
+	if flex.AccessTagsHasChange(d, isKeyAccessTags) {
 		options := &vpcv1.GetKeyOptions{
 			ID: &id,
 		}
 		key, response, err := sess.GetKey(options)
 		if err != nil {
-			return fmt.Errorf("Error getting SSH Key : %s\n%s", err, response)
+			return flex.NewServiceError("Error getting SSH Key", err, response)
 		}
 		oldList, newList := d.GetChange(isKeyAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *key.CRN, "", isKeyAccessTagType)
@@ -376,7 +388,7 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		options.KeyPatch = keyPatch
 		_, response, err := sess.UpdateKey(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating vpc SSH Key: %s\n%s", err, response)
+			return flex.NewServiceError("Error updating vpc SSH Key", err, response)
 		}
 	}
 	return nil
@@ -406,7 +418,7 @@ func keyDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting SSH Key (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting SSH Key (%s)", id), err, response)
 	}
 
 	options := &vpcv1.DeleteKeyOptions{
@@ -414,7 +426,7 @@ func keyDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteKey(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting SSH Key : %s\n%s", err, response)
+		return flex.NewServiceError("Error deleting SSH Key", err, response)
 	}
 	d.SetId("")
 	return nil
@@ -440,7 +452,7 @@ func keyExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting SSH Key: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error getting SSH Key", err, response)
 	}
 	return true, nil
 }
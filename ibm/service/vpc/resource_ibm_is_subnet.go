@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -36,6 +35,8 @@ const (
 	isSubnetZone                      = "zone"
 	isSubnetAvailableIpv4AddressCount = "available_ipv4_address_count"
 	isSubnetResourceGroup             = "resource_group"
+	isSubnetAddressPrefix             = "address_prefix"
+	isSubnetPrefixLength              = "prefix_length"
 
 	isSubnetProvisioning     = "provisioning"
 	isSubnetProvisioningDone = "done"
@@ -65,7 +66,7 @@ func ResourceIBMISSubnet() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -79,7 +80,7 @@ func ResourceIBMISSubnet() *schema.Resource {
 				ForceNew:      true,
 				Optional:      true,
 				Computed:      true,
-				ConflictsWith: []string{isSubnetTotalIpv4AddressCount},
+				ConflictsWith: []string{isSubnetTotalIpv4AddressCount, isSubnetAddressPrefix},
 				ValidateFunc:  validate.InvokeValidator("ibm_is_subnet", isSubnetIpv4CidrBlock),
 				Description:   "IPV4 subnet - CIDR block",
 			},
@@ -95,9 +96,27 @@ func ResourceIBMISSubnet() *schema.Resource {
 				ForceNew:      true,
 				Optional:      true,
 				Computed:      true,
-				ConflictsWith: []string{isSubnetIpv4CidrBlock},
+				ConflictsWith: []string{isSubnetIpv4CidrBlock, isSubnetAddressPrefix},
 				Description:   "The total number of IPv4 addresses in this subnet.",
 			},
+
+			isSubnetAddressPrefix: {
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Optional:      true,
+				ConflictsWith: []string{isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount},
+				RequiredWith:  []string{isSubnetPrefixLength},
+				Description:   "The address prefix to allocate the next free IPv4 CIDR block of prefix_length from",
+			},
+
+			isSubnetPrefixLength: {
+				Type:         schema.TypeInt,
+				ForceNew:     true,
+				Optional:     true,
+				RequiredWith: []string{isSubnetAddressPrefix},
+				ValidateFunc: validate.InvokeValidator("ibm_is_subnet", isSubnetPrefixLength),
+				Description:  "The prefix length of the IPv4 CIDR block allocated from address_prefix",
+			},
 			isSubnetIPVersion: {
 				Type:         schema.TypeString,
 				ForceNew:     true,
@@ -123,6 +142,20 @@ func ResourceIBMISSubnet() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 
 			isSubnetAccessTags: {
 				Type:        schema.TypeSet,
@@ -241,6 +274,15 @@ func ResourceIBMISSubnetValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			ForceNew:                   true,
 			Optional:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isSubnetPrefixLength,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			ForceNew:                   true,
+			Optional:                   true,
+			MinValue:                   "8",
+			MaxValue:                   "29"})
 
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
@@ -282,8 +324,12 @@ func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		ipv4addrcount = ipv4addrct.(int)
 		ipv4addrcount64 = int64(ipv4addrcount)
 	}
-	if ipv4cidr == "" && ipv4addrcount == 0 {
-		return fmt.Errorf("%s or %s need to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount)
+	addressPrefix := ""
+	if prefix, ok := d.GetOk(isSubnetAddressPrefix); ok {
+		addressPrefix = prefix.(string)
+	}
+	if ipv4cidr == "" && ipv4addrcount == 0 && addressPrefix == "" {
+		return fmt.Errorf("%s, %s or %s need to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount, isSubnetAddressPrefix)
 	}
 
 	if ipv4cidr != "" && ipv4addrcount != 0 {
@@ -293,6 +339,16 @@ func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	conns.IbmMutexKV.Lock(isSubnetKey)
 	defer conns.IbmMutexKV.Unlock(isSubnetKey)
 
+	// the allocation runs under the zone lock so subnets created in parallel
+	// from the same address prefix are given different blocks
+	if addressPrefix != "" {
+		cidr, err := subnetAllocateCIDR(meta, vpc, zone, addressPrefix, d.Get(isSubnetPrefixLength).(int))
+		if err != nil {
+			return err
+		}
+		ipv4cidr = cidr
+	}
+
 	acl := ""
 	if nwacl, ok := d.GetOk(isSubnetNetworkACL); ok {
 		acl = nwacl.(string)
@@ -369,7 +425,7 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 	subnet, response, err := sess.CreateSubnet(createSubnetOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Subnet err %s\n%s", err, response)
-		return fmt.Errorf("[ERROR] Error while creating Subnet %s\n%v", err, response)
+		return flex.NewServiceError("Error while creating Subnet", err, response)
 	}
 	d.SetId(*subnet.ID)
 	log.Printf("[INFO] Subnet : %s", *subnet.ID)
@@ -377,8 +433,7 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isSubnetTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isSubnetTags) {
 		oldList, newList := d.GetChange(isSubnetTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *subnet.CRN, "", isUserTagType)
 		if err != nil {
@@ -387,7 +442,7 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 		}
 	}
 
-	if _, ok := d.GetOk(isSubnetAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isSubnetAccessTags) {
 		oldList, newList := d.GetChange(isSubnetAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *subnet.CRN, "", isAccessTagType)
 		if err != nil {
@@ -399,6 +454,22 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 	return nil
 }
 
+func subnetAllocateCIDR(meta interface{}, vpc, zone, addressPrefix string, prefixLength int) (string, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return "", err
+	}
+	available, err := listAvailableSubnetCIDRs(context.Background(), sess, vpc, zone, addressPrefix, prefixLength, 1)
+	if err != nil {
+		return "", err
+	}
+	if len(available) == 0 {
+		return "", fmt.Errorf("[ERROR] No free /%d block left in address prefix %s of VPC (%s)", prefixLength, addressPrefix, vpc)
+	}
+	log.Printf("[INFO] Allocated %s from address prefix %s for subnet", available[0].cidr, addressPrefix)
+	return available[0].cidr, nil
+}
+
 func isWaitForSubnetAvailable(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be available.", id)
 
@@ -421,7 +492,7 @@ func isSubnetRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshF
 		}
 		subnet, response, err := subnetC.GetSubnet(getSubnetOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting Subnet : %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error getting Subnet", err, response)
 		}
 
 		if *subnet.Status == "available" || *subnet.Status == "failed" {
@@ -457,7 +528,7 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Subnet (%s)", id), err, response)
 	}
 	d.Set(isSubnetName, *subnet.Name)
 	d.Set(isSubnetIPVersion, *subnet.IPVersion)
@@ -498,8 +569,8 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource subnet (%s) access tags: %s", d.Id(), err)
 	}
 
-	d.Set(isSubnetTags, tags)
-	d.Set(isSubnetAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isSubnetTags, isUserTagType, tags)
+	flex.SetResourceTags(d, meta, isSubnetAccessTags, isAccessTagType, accesstags)
 	d.Set(isSubnetCRN, *subnet.CRN)
 	d.Set(flex.ResourceControllerURL, controller+"/vpc-ext/network/subnets")
 	d.Set(flex.ResourceName, *subnet.Name)
@@ -515,7 +586,7 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 func resourceIBMISSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 	id := d.Id()
 
-	if d.HasChange(isSubnetTags) {
+	if flex.TagsHasChange(d, isSubnetTags) {
 		oldList, newList := d.GetChange(isSubnetTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSubnetCRN).(string), "", isUserTagType)
 		if err != nil {
@@ -524,7 +595,7 @@ func resourceIBMISSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange(isSubnetAccessTags) {
+	if flex.AccessTagsHasChange(d, isSubnetAccessTags) {
 		oldList, newList := d.GetChange(isSubnetAccessTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSubnetCRN).(string), "", isAccessTagType)
 		if err != nil {
@@ -571,7 +642,7 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 			}
 			response, err := sess.UnsetSubnetPublicGateway(unsetSubnetPublicGatewayOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Detaching the public gateway attached to the subnet : %s\n%s", err, response)
+				return flex.NewServiceError("Error Detaching the public gateway attached to the subnet", err, response)
 			}
 			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
@@ -586,7 +657,7 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 			}
 			_, response, err := sess.SetSubnetPublicGateway(setSubnetPublicGatewayOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Attaching public gateway to the subnet : %s\n%s", err, response)
+				return flex.NewServiceError("Error Attaching public gateway to the subnet", err, response)
 			}
 			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
@@ -622,7 +693,7 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		updateSubnetOptions.ID = &id
 		_, response, err := sess.UpdateSubnet(updateSubnetOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating Subnet : %s\n%s", err, response)
+			return flex.NewServiceError("Error Updating Subnet", err, response)
 		}
 	}
 	return nil
@@ -653,7 +724,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Subnet (%s)", id), err, response)
 	}
 	if subnet.PublicGateway != nil {
 		unsetSubnetPublicGatewayOptions := &vpcv1.UnsetSubnetPublicGatewayOptions{
@@ -680,7 +751,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 				return fmt.Errorf("[ERROR] Error Deleting Subnet : %s", err)
 			}
 		} else {
-			return fmt.Errorf("[ERROR] Error Deleting Subnet : %s\n%s", err, response)
+			return flex.NewServiceError("Error Deleting Subnet", err, response)
 		}
 	}
 	_, err = isWaitForSubnetDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
@@ -708,7 +779,7 @@ func isWaitForSubnetDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.
 				} else if response != nil && response.StatusCode == 404 {
 					return response, isSubnetDeleted, nil
 				}
-				return response, "", fmt.Errorf("[ERROR] Error deleting subnet: %s\n%s", err, response)
+				return response, "", flex.NewServiceError("Error deleting subnet", err, response)
 			}
 			return response, isSubnetDeleting, nil
 		},
@@ -748,7 +819,7 @@ func isSubnetDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRe
 			if response != nil && strings.Contains(err.Error(), "please detach all network interfaces from subnet before deleting it") {
 				return subnet, isSubnetDeleting, nil
 			}
-			return subnet, "", fmt.Errorf("[ERROR] The Subnet %s failed to delete: %s\n%s", id, err, response)
+			return subnet, "", flex.NewServiceError(fmt.Sprintf("The Subnet %s failed to delete", id), err, response)
 		}
 		return subnet, isSubnetDeleting, err
 	}
@@ -773,7 +844,7 @@ func subnetExists(d *schema.ResourceData, meta interface{}, id string) (bool, er
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Subnet: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error getting Subnet", err, response)
 	}
 	return true, nil
 }
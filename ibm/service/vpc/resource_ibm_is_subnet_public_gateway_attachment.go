@@ -132,12 +132,12 @@ func resourceIBMISSubnetPublicGatewayAttachmentCreate(context context.Context, d
 
 	if err != nil {
 		log.Printf("[DEBUG] Error while attaching public gateway(%s) to subnet(%s) %s\n%s", publicGateway, subnet, err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] Error while attaching public gateway(%s) to subnet(%s) %s\n%s", publicGateway, subnet, err, response))
+		return flex.NewServiceError(fmt.Sprintf("Error while attaching public gateway(%s) to subnet(%s)", publicGateway, subnet), err, response).Diagnostics()
 	}
 	d.SetId(subnet)
 	_, err = isWaitForSubnetPublicGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 	log.Printf("[INFO] Public Gateway : %s", *pg.ID)
 	log.Printf("[INFO] Subnet ID : %s", subnet)
@@ -162,7 +162,7 @@ func resourceIBMISSubnetPublicGatewayAttachmentRead(context context.Context, d *
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting subnet's (%s) attached public gateway: %s\n%s", id, err, response))
+		return flex.NewServiceError(fmt.Sprintf("Error getting subnet's (%s) attached public gateway", id), err, response).Diagnostics()
 	}
 	d.Set(isPublicGatewayName, pg.Name)
 	d.Set(isSubnetID, id)
@@ -211,7 +211,7 @@ func resourceIBMISSubnetPublicGatewayAttachmentUpdate(context context.Context, d
 
 		if err != nil || pg == nil {
 			log.Printf("[DEBUG] Error while attaching public gateway(%s) to subnet(%s) %s\n%s", publicGateway, subnet, err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] Error while attaching public gateway(%s) to subnet(%s) %s\n%s", publicGateway, subnet, err, response))
+			return flex.NewServiceError(fmt.Sprintf("Error while attaching public gateway(%s) to subnet(%s)", publicGateway, subnet), err, response).Diagnostics()
 		}
 		log.Printf("[INFO] Updated subnet %s with public gateway(%s)", subnet, publicGateway)
 
@@ -238,7 +238,7 @@ func resourceIBMISSubnetPublicGatewayAttachmentDelete(context context.Context, d
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Subnet (%s): %s\n%s", id, err, response))
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Subnet (%s)", id), err, response).Diagnostics()
 	}
 
 	// Construct an instance of the UnsetSubnetPublicGatewayOptions model
@@ -249,11 +249,11 @@ func resourceIBMISSubnetPublicGatewayAttachmentDelete(context context.Context, d
 
 	if err != nil {
 		log.Printf("[DEBUG] Error while detaching public gateway to subnet %s\n%s", err, res)
-		return diag.FromErr(fmt.Errorf("[ERROR] Error while detaching public gateway to subnet %s\n%s", err, res))
+		return flex.NewServiceError("Error while detaching public gateway to subnet", err, res).Diagnostics()
 	}
 	_, err = isWaitForSubnetPublicGatewayDelete(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return flex.DiagFromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -283,13 +283,13 @@ func isSubnetPublicGatewayRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.
 
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
-				return pg, "", fmt.Errorf("[ERROR] Error getting subnet's (%s) attached public gateway: %s\n%s", id, err, response)
+				return pg, "", flex.NewServiceError(fmt.Sprintf("Error getting subnet's (%s) attached public gateway", id), err, response)
 			}
-			return pg, "", fmt.Errorf("[ERROR] Error getting subnet's (%s) attached public gateway: %s\n%s", id, err, response)
+			return pg, "", flex.NewServiceError(fmt.Sprintf("Error getting subnet's (%s) attached public gateway", id), err, response)
 		}
 
 		if *pg.Status == "failed" {
-			return pg, IsPublicGatewayAttachmentFailed, fmt.Errorf("[ERROR] Error subnet (%s) public gateway attachment failed: %s\n%s", id, err, response)
+			return pg, IsPublicGatewayAttachmentFailed, flex.NewServiceError(fmt.Sprintf("Error subnet (%s) public gateway attachment failed", id), err, response)
 		}
 
 		return pg, *pg.Status, nil
@@ -322,11 +322,11 @@ func isSubnetPublicGatewayDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) res
 			if response != nil && response.StatusCode == 404 {
 				return pg, "", nil
 			}
-			return pg, "", fmt.Errorf("[ERROR] Error getting subnet's (%s) attached public gateway: %s\n%s", id, err, response)
+			return pg, "", flex.NewServiceError(fmt.Sprintf("Error getting subnet's (%s) attached public gateway", id), err, response)
 		}
 
 		if *pg.Status == "failed" {
-			return pg, IsPublicGatewayAttachmentFailed, fmt.Errorf("[ERROR] Error subnet (%s) public gateway attachment failed: %s\n%s", id, err, response)
+			return pg, IsPublicGatewayAttachmentFailed, flex.NewServiceError(fmt.Sprintf("Error subnet (%s) public gateway attachment failed", id), err, response)
 		}
 
 		return pg, *pg.Status, nil
@@ -10,8 +10,11 @@ import (
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func ResourceIBMISSubnetRoutingTableAttachment() *schema.Resource {
@@ -144,7 +147,7 @@ func resourceIBMISSubnetRoutingTableAttachmentCreate(context context.Context, d
 
 	if err != nil {
 		log.Printf("[DEBUG] Error while attaching a routing table to a subnet %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] Error while attaching a routing table to a subnet %s\n%s", err, response))
+		return flex.NewServiceError("Error while attaching a routing table to a subnet", err, response).Diagnostics()
 	}
 	d.SetId(subnet)
 	log.Printf("[INFO] Routing Table : %s", *resultRT.ID)
@@ -170,7 +173,7 @@ func resourceIBMISSubnetRoutingTableAttachmentRead(context context.Context, d *s
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting subnet's (%s) attached routing table: %s\n%s", id, err, response))
+		return flex.NewServiceError(fmt.Sprintf("Error getting subnet's (%s) attached routing table", id), err, response).Diagnostics()
 	}
 	d.Set(isRoutingTableName, *subRT.Name)
 	d.Set(isSubnetID, id)
@@ -225,7 +228,7 @@ func resourceIBMISSubnetRoutingTableAttachmentUpdate(context context.Context, d
 
 		if err != nil {
 			log.Printf("[DEBUG] Error while attaching a routing table to a subnet %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] Error while attaching a routing table to a subnet %s\n%s", err, response))
+			return flex.NewServiceError("Error while attaching a routing table to a subnet", err, response).WithAttribute(cty.GetAttrPath(isRoutingTableID)).Diagnostics()
 		}
 		log.Printf("[INFO] Updated subnet %s with Routing Table : %s", subnet, *resultRT.ID)
 
@@ -252,7 +255,7 @@ func resourceIBMISSubnetRoutingTableAttachmentDelete(context context.Context, d
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Subnet (%s): %s\n%s", id, err, response))
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Subnet (%s)", id), err, response).Diagnostics()
 	}
 	// Fetch VPC
 	vpcID := *subnet.VPC.ID
@@ -266,7 +269,7 @@ func resourceIBMISSubnetRoutingTableAttachmentDelete(context context.Context, d
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response))
+		return flex.NewServiceError("Error getting VPC", err, response).Diagnostics()
 	}
 
 	// Fetch default routing table
@@ -284,7 +287,7 @@ func resourceIBMISSubnetRoutingTableAttachmentDelete(context context.Context, d
 
 		if err != nil {
 			log.Printf("[DEBUG] Error while attaching a routing table to a subnet %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] Error while attaching a routing table to a subnet %s\n%s", err, response))
+			return flex.NewServiceError("Error while attaching a routing table to a subnet", err, response).Diagnostics()
 		}
 		log.Printf("[INFO] Updated subnet %s with VPC default Routing Table : %s", id, *resultRT.ID)
 	} else {
@@ -63,6 +63,37 @@ func TestAccIBMISSubnet_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMISSubnet_addressPrefix(t *testing.T) {
+	var subnet string
+	vpcname := fmt.Sprintf("tfsubnet-vpc-%d", acctest.RandIntRange(10, 100))
+	prefixname := fmt.Sprintf("tfsubnet-prefix-%d", acctest.RandIntRange(10, 100))
+	name1 := fmt.Sprintf("tfsubnet-%d", acctest.RandIntRange(10, 100))
+	name2 := fmt.Sprintf("tfsubnet-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISSubnetAddressPrefixConfig(vpcname, prefixname, name1, name2, acc.ISZoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSubnetExists("ibm_is_subnet.testacc_subnet", subnet),
+					testAccCheckIBMISSubnetExists("ibm_is_subnet.testacc_subnet2", subnet),
+					resource.TestCheckResourceAttr(
+						"ibm_is_subnet.testacc_subnet", "ipv4_cidr_block", "10.120.0.0/24"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_subnet.testacc_subnet2", "ipv4_cidr_block", "10.120.1.0/24"),
+				),
+			},
+			{
+				Config:   testAccCheckIBMISSubnetAddressPrefixConfig(vpcname, prefixname, name1, name2, acc.ISZoneName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISSubnetDestroy(s *terraform.State) error {
 
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
@@ -145,3 +176,35 @@ func testAccCheckIBMISSubnetConfigUpdate(vpcname, name, zone, cidr, gwname strin
 		tags = ["tag1"]
 	}`, vpcname, gwname, zone, name, zone, cidr)
 }
+
+func testAccCheckIBMISSubnetAddressPrefixConfig(vpcname, prefixname, name1, name2, zone string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name                      = "%s"
+		address_prefix_management = "manual"
+	}
+
+	resource "ibm_is_vpc_address_prefix" "testacc_prefix" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		cidr = "10.120.0.0/22"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name           = "%s"
+		vpc            = ibm_is_vpc.testacc_vpc.id
+		zone           = "%s"
+		address_prefix = ibm_is_vpc_address_prefix.testacc_prefix.address_prefix
+		prefix_length  = 24
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet2" {
+		name           = "%s"
+		vpc            = ibm_is_vpc.testacc_vpc.id
+		zone           = "%s"
+		address_prefix = ibm_is_vpc_address_prefix.testacc_prefix.address_prefix
+		prefix_length  = 24
+		depends_on     = [ibm_is_subnet.testacc_subnet]
+	}`, vpcname, prefixname, zone, name1, zone, name2, zone)
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -59,7 +58,7 @@ func ResourceIBMISEndpointGateway() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -225,6 +224,20 @@ func ResourceIBMISEndpointGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags for VPE",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 			isVirtualEndpointGatewayAccessTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -349,7 +362,7 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 	endpointGateway, response, err := sess.CreateEndpointGateway(opt)
 	if err != nil {
 		log.Printf("Create Endpoint Gateway failed: %v", response)
-		return fmt.Errorf("[ERROR] Create Endpoint Gateway failed %s\n%s", err, response)
+		return flex.NewServiceError("Create Endpoint Gateway failed", err, response)
 	}
 
 	d.SetId(*endpointGateway.ID)
@@ -358,8 +371,7 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVirtualEndpointGatewayTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isVirtualEndpointGatewayTags) {
 		oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *endpointGateway.CRN, "", isUserTagType)
 		if err != nil {
@@ -368,7 +380,7 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	if _, ok := d.GetOk(isVirtualEndpointGatewayAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isVirtualEndpointGatewayAccessTags) {
 		oldList, newList := d.GetChange(isVirtualEndpointGatewayAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *endpointGateway.CRN, "", isAccessTagType)
 		if err != nil {
@@ -400,7 +412,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 	_, response, err := sess.UpdateEndpointGateway(opt)
 	if err != nil {
 		log.Printf("Update Endpoint Gateway failed: %v", response)
-		return fmt.Errorf("Update Endpoint Gateway failed : %s\n%s", err, response)
+		return flex.NewServiceError("Update Endpoint Gateway failed", err, response)
 	}
 	id := d.Id()
 	var remove, add []string
@@ -417,7 +429,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 				createSecurityGroupTargetBindingOptions.ID = &id
 				_, response, err := sess.CreateSecurityGroupTargetBinding(createSecurityGroupTargetBindingOptions)
 				if err != nil {
-					return fmt.Errorf("Error while creating Security Group Target Binding %s\n%s", err, response)
+					return flex.NewServiceError("Error while creating Security Group Target Binding", err, response)
 				}
 				_, err = isWaitForVirtualEndpointGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
@@ -436,12 +448,12 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 					if response != nil && response.StatusCode == 404 {
 						continue
 					}
-					return fmt.Errorf("Error Getting Security Group Target for this endpoint gateway (%s): %s\n%s", sgId, err, response)
+					return flex.NewServiceError(fmt.Sprintf("Error Getting Security Group Target for this endpoint gateway (%s)", sgId), err, response)
 				}
 				deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(sgId, id)
 				response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
 				if err != nil {
-					return fmt.Errorf("Error Deleting Security Group Target for this endpoint gateway : %s\n%s", err, response)
+					return flex.NewServiceError("Error Deleting Security Group Target for this endpoint gateway", err, response)
 				}
 				_, err = isWaitForVirtualEndpointGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
@@ -451,13 +463,13 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 		}
 
 	}
-	if d.HasChange(isVirtualEndpointGatewayTags) || d.HasChange(isVirtualEndpointGatewayAccessTags) {
+	if flex.TagsHasChange(d, isVirtualEndpointGatewayTags) || flex.AccessTagsHasChange(d, isVirtualEndpointGatewayAccessTags) {
 		opt := sess.NewGetEndpointGatewayOptions(d.Id())
 		endpointGateway, response, err := sess.GetEndpointGateway(opt)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting VPE: %s\n%s", err, response)
+			return flex.NewServiceError("Error getting VPE", err, response)
 		}
-		if d.HasChange(isVirtualEndpointGatewayTags) {
+		if flex.TagsHasChange(d, isVirtualEndpointGatewayTags) {
 			oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *endpointGateway.CRN, "", isUserTagType)
 			if err != nil {
@@ -466,7 +478,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 			}
 		}
 
-		if d.HasChange(isVirtualEndpointGatewayAccessTags) {
+		if flex.AccessTagsHasChange(d, isVirtualEndpointGatewayAccessTags) {
 			oldList, newList := d.GetChange(isVirtualEndpointGatewayAccessTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *endpointGateway.CRN, "", isAccessTagType)
 			if err != nil {
@@ -492,7 +504,7 @@ func resourceIBMisVirtualEndpointGatewayRead(d *schema.ResourceData, meta interf
 			return nil
 		}
 		log.Printf("Get Endpoint Gateway failed: %v", response)
-		return fmt.Errorf("[ERROR] Get Endpoint Gateway failed %s\n%s", err, response)
+		return flex.NewServiceError("Get Endpoint Gateway failed", err, response)
 	}
 	d.Set(isVirtualEndpointGatewayName, endpointGateway.Name)
 	d.Set(isVirtualEndpointGatewayHealthState, endpointGateway.HealthState)
@@ -517,14 +529,14 @@ func resourceIBMisVirtualEndpointGatewayRead(d *schema.ResourceData, meta interf
 		log.Printf(
 			"Error on get of VPE (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isVirtualEndpointGatewayTags, tags)
+	flex.SetResourceTags(d, meta, isVirtualEndpointGatewayTags, isUserTagType, tags)
 
 	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *endpointGateway.CRN, "", isAccessTagType)
 	if err != nil {
 		log.Printf(
 			"Error on get of VPE (%s) access tags: %s", d.Id(), err)
 	}
-	d.Set(isVirtualEndpointGatewayAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isVirtualEndpointGatewayAccessTags, isAccessTagType, accesstags)
 
 	return nil
 }
@@ -562,7 +574,7 @@ func isVirtualEndpointGatewayRefreshFunc(sess *vpcv1.VpcV1, endPointGatewayId st
 		result, response, err := sess.GetEndpointGateway(opt)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
-				return nil, "", fmt.Errorf("Error Getting Virtual Endpoint Gateway : %s\n%s", err, response)
+				return nil, "", flex.NewServiceError("Error Getting Virtual Endpoint Gateway", err, response)
 			}
 		}
 		if *result.LifecycleState == "stable" || *result.LifecycleState == "failed" {
@@ -582,7 +594,7 @@ func resourceIBMisVirtualEndpointGatewayDelete(d *schema.ResourceData, meta inte
 	response, err := sess.DeleteEndpointGateway(opt)
 	if err != nil {
 		log.Printf("Delete Endpoint Gateway failed: %v", response)
-		return fmt.Errorf("Delete Endpoint Gateway failed : %s\n%s", err, response)
+		return flex.NewServiceError("Delete Endpoint Gateway failed", err, response)
 	}
 	return nil
 }
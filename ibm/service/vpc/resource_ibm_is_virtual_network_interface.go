@@ -0,0 +1,682 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func ResourceIBMIsVirtualNetworkInterface() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsVirtualNetworkInterfaceCreate,
+		ReadContext:   resourceIBMIsVirtualNetworkInterfaceRead,
+		UpdateContext: resourceIBMIsVirtualNetworkInterfaceUpdate,
+		DeleteContext: resourceIBMIsVirtualNetworkInterfaceDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_virtual_network_interface", "name"),
+				Description:  "The name for this virtual network interface. The name is unique across all virtual network interfaces in the VPC.",
+			},
+			"subnet": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				AtLeastOneOf: []string{"subnet", "primary_ip.0.reserved_ip"},
+				Description:  "The associated subnet. Required if primary_ip does not specify a reserved IP.",
+			},
+			"primary_ip": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "The primary IP address to bind to the virtual network interface.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"reserved_ip": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"primary_ip.0.address"},
+							Description:   "The unique identifier of an existing reserved IP to bind as the primary IP.",
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The IP address to reserve, which must not already be reserved on the subnet.",
+						},
+						"auto_delete": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+							Description: "Indicates whether this reserved IP member will be automatically deleted when either target is deleted, or the reserved IP is unbound.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The name for this reserved IP. The name is unique across all reserved IPs in a subnet.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this reserved IP.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type.",
+						},
+					},
+				},
+			},
+			"ips": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Set:         hashVirtualNetworkInterfaceIP,
+				Description: "The secondary reserved IPs bound to this virtual network interface.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"reserved_ip": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The unique identifier of the reserved IP, which must be in the subnet of the virtual network interface.",
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this reserved IP.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name for this reserved IP.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type.",
+						},
+					},
+				},
+			},
+			"security_groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The security groups for this virtual network interface.",
+			},
+			"allow_ip_spoofing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether source IP spoofing is allowed on this interface.",
+			},
+			"auto_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether this virtual network interface will be automatically deleted when `target` is deleted.",
+			},
+			"enable_infrastructure_nat": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "If `true`, the VPC infrastructure performs any needed NAT operations. If `false`, the packet is passed unchanged to/from the virtual network interface.",
+			},
+			"resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The resource group for this virtual network interface.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the virtual network interface was created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this virtual network interface.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this virtual network interface.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the virtual network interface.",
+			},
+			"mac_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The MAC address of the virtual network interface. May be absent if `lifecycle_state` is `pending`.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+			"target": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The target of this virtual network interface. If absent, this virtual network interface is not attached to a target.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for the target.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for the target.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name for the target.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type of the target.",
+						},
+					},
+				},
+			},
+			"vpc": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The VPC this virtual network interface resides in.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The zone this virtual network interface resides in.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsVirtualNetworkInterfaceValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9]|[0-9][-a-z0-9]*([a-z]|[-a-z][-a-z0-9]*[a-z0-9]))$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_virtual_network_interface", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsVirtualNetworkInterfaceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createVirtualNetworkInterfaceOptions := &vpcv1.CreateVirtualNetworkInterfaceOptions{}
+	if name, ok := d.GetOk("name"); ok {
+		createVirtualNetworkInterfaceOptions.SetName(name.(string))
+	}
+	if subnet, ok := d.GetOk("subnet"); ok {
+		subnetID := subnet.(string)
+		createVirtualNetworkInterfaceOptions.SetSubnet(&vpcv1.SubnetIdentity{ID: &subnetID})
+	}
+	if _, ok := d.GetOk("primary_ip"); ok {
+		primaryIPPrototype := &vpcv1.VirtualNetworkInterfacePrimaryIPPrototype{}
+		if reservedIP, ok := d.GetOk("primary_ip.0.reserved_ip"); ok {
+			reservedIPID := reservedIP.(string)
+			primaryIPPrototype.ID = &reservedIPID
+		} else {
+			if address, ok := d.GetOk("primary_ip.0.address"); ok {
+				primaryIPPrototype.Address = core.StringPtr(address.(string))
+			}
+			if name, ok := d.GetOk("primary_ip.0.name"); ok {
+				primaryIPPrototype.Name = core.StringPtr(name.(string))
+			}
+			if autoDelete, ok := d.GetOkExists("primary_ip.0.auto_delete"); ok {
+				primaryIPPrototype.AutoDelete = core.BoolPtr(autoDelete.(bool))
+			}
+		}
+		createVirtualNetworkInterfaceOptions.SetPrimaryIP(primaryIPPrototype)
+	}
+	if ips, ok := d.GetOk("ips"); ok {
+		ipPrototypes := []vpcv1.VirtualNetworkInterfaceIPPrototypeIntf{}
+		for _, ip := range ips.(*schema.Set).List() {
+			reservedIPID := ip.(map[string]interface{})["reserved_ip"].(string)
+			ipPrototypes = append(ipPrototypes, &vpcv1.VirtualNetworkInterfaceIPPrototype{ID: &reservedIPID})
+		}
+		createVirtualNetworkInterfaceOptions.SetIps(ipPrototypes)
+	}
+	if securityGroups, ok := d.GetOk("security_groups"); ok {
+		securityGroupIdentities := []vpcv1.SecurityGroupIdentityIntf{}
+		for _, securityGroup := range securityGroups.(*schema.Set).List() {
+			securityGroupID := securityGroup.(string)
+			securityGroupIdentities = append(securityGroupIdentities, &vpcv1.SecurityGroupIdentity{ID: &securityGroupID})
+		}
+		createVirtualNetworkInterfaceOptions.SetSecurityGroups(securityGroupIdentities)
+	}
+	if allowIPSpoofing, ok := d.GetOkExists("allow_ip_spoofing"); ok {
+		createVirtualNetworkInterfaceOptions.SetAllowIPSpoofing(allowIPSpoofing.(bool))
+	}
+	if autoDelete, ok := d.GetOkExists("auto_delete"); ok {
+		createVirtualNetworkInterfaceOptions.SetAutoDelete(autoDelete.(bool))
+	}
+	if enableInfrastructureNat, ok := d.GetOkExists("enable_infrastructure_nat"); ok {
+		createVirtualNetworkInterfaceOptions.SetEnableInfrastructureNat(enableInfrastructureNat.(bool))
+	}
+	if resourceGroup, ok := d.GetOk("resource_group"); ok {
+		resourceGroupID := resourceGroup.(string)
+		createVirtualNetworkInterfaceOptions.SetResourceGroup(&vpcv1.ResourceGroupIdentity{ID: &resourceGroupID})
+	}
+
+	virtualNetworkInterface, response, err := sess.CreateVirtualNetworkInterfaceWithContext(context, createVirtualNetworkInterfaceOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateVirtualNetworkInterfaceWithContext failed %s\n%s", err, response)
+		return flex.NewServiceError("CreateVirtualNetworkInterfaceWithContext failed", err, response).Diagnostics()
+	}
+
+	d.SetId(*virtualNetworkInterface.ID)
+
+	_, err = WaitForVNIAvailable(sess, d.Id(), d, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsVirtualNetworkInterfaceRead(context, d, meta)
+}
+
+func resourceIBMIsVirtualNetworkInterfaceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getVirtualNetworkInterfaceOptions := &vpcv1.GetVirtualNetworkInterfaceOptions{}
+	getVirtualNetworkInterfaceOptions.SetID(d.Id())
+
+	virtualNetworkInterface, response, err := sess.GetVirtualNetworkInterfaceWithContext(context, getVirtualNetworkInterfaceOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetVirtualNetworkInterfaceWithContext failed %s\n%s", err, response)
+		return flex.NewServiceError("GetVirtualNetworkInterfaceWithContext failed", err, response).Diagnostics()
+	}
+
+	if err = d.Set("name", virtualNetworkInterface.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if virtualNetworkInterface.Subnet != nil {
+		if err = d.Set("subnet", virtualNetworkInterface.Subnet.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting subnet: %s", err))
+		}
+	}
+
+	primaryIP := []map[string]interface{}{}
+	primaryIPID := ""
+	if virtualNetworkInterface.PrimaryIP != nil {
+		primaryIPID = *virtualNetworkInterface.PrimaryIP.ID
+		primaryIPMap := map[string]interface{}{
+			"reserved_ip":   primaryIPID,
+			"address":       virtualNetworkInterface.PrimaryIP.Address,
+			"name":          virtualNetworkInterface.PrimaryIP.Name,
+			"href":          virtualNetworkInterface.PrimaryIP.Href,
+			"resource_type": virtualNetworkInterface.PrimaryIP.ResourceType,
+		}
+		if virtualNetworkInterface.Subnet != nil {
+			getSubnetReservedIPOptions := &vpcv1.GetSubnetReservedIPOptions{
+				SubnetID: virtualNetworkInterface.Subnet.ID,
+				ID:       &primaryIPID,
+			}
+			reservedIP, response, err := sess.GetSubnetReservedIPWithContext(context, getSubnetReservedIPOptions)
+			if err != nil {
+				log.Printf("[DEBUG] GetSubnetReservedIPWithContext failed %s\n%s", err, response)
+				return flex.NewServiceError("GetSubnetReservedIPWithContext failed", err, response).Diagnostics()
+			}
+			primaryIPMap["auto_delete"] = reservedIP.AutoDelete
+		}
+		primaryIP = append(primaryIP, primaryIPMap)
+	}
+	if err = d.Set("primary_ip", primaryIP); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting primary_ip: %s", err))
+	}
+
+	ips := []map[string]interface{}{}
+	for _, ip := range virtualNetworkInterface.Ips {
+		if *ip.ID == primaryIPID {
+			continue
+		}
+		ips = append(ips, map[string]interface{}{
+			"reserved_ip":   *ip.ID,
+			"address":       *ip.Address,
+			"href":          *ip.Href,
+			"name":          *ip.Name,
+			"resource_type": *ip.ResourceType,
+		})
+	}
+	if err = d.Set("ips", ips); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting ips: %s", err))
+	}
+
+	securityGroups := []string{}
+	for _, securityGroup := range virtualNetworkInterface.SecurityGroups {
+		securityGroups = append(securityGroups, *securityGroup.ID)
+	}
+	if err = d.Set("security_groups", flex.NewStringSet(schema.HashString, securityGroups)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting security_groups: %s", err))
+	}
+
+	if err = d.Set("allow_ip_spoofing", virtualNetworkInterface.AllowIPSpoofing); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting allow_ip_spoofing: %s", err))
+	}
+	if err = d.Set("auto_delete", virtualNetworkInterface.AutoDelete); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting auto_delete: %s", err))
+	}
+	if err = d.Set("enable_infrastructure_nat", virtualNetworkInterface.EnableInfrastructureNat); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting enable_infrastructure_nat: %s", err))
+	}
+	if virtualNetworkInterface.ResourceGroup != nil {
+		if err = d.Set("resource_group", virtualNetworkInterface.ResourceGroup.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_group: %s", err))
+		}
+	}
+	if err = d.Set("created_at", flex.DateTimeToString(virtualNetworkInterface.CreatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_at: %s", err))
+	}
+	if err = d.Set("crn", virtualNetworkInterface.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting crn: %s", err))
+	}
+	if err = d.Set("href", virtualNetworkInterface.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting href: %s", err))
+	}
+	if err = d.Set("lifecycle_state", virtualNetworkInterface.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting lifecycle_state: %s", err))
+	}
+	if err = d.Set("mac_address", virtualNetworkInterface.MacAddress); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting mac_address: %s", err))
+	}
+	if err = d.Set("resource_type", virtualNetworkInterface.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_type: %s", err))
+	}
+
+	target := []map[string]interface{}{}
+	if virtualNetworkInterface.Target != nil {
+		modelMap, err := dataSourceIBMIsVirtualNetworkInterfaceVirtualNetworkInterfaceTargetToMap(virtualNetworkInterface.Target)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		delete(modelMap, "deleted")
+		target = append(target, modelMap)
+	}
+	if err = d.Set("target", target); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting target: %s", err))
+	}
+	if virtualNetworkInterface.VPC != nil {
+		if err = d.Set("vpc", virtualNetworkInterface.VPC.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting vpc: %s", err))
+		}
+	}
+	if virtualNetworkInterface.Zone != nil {
+		if err = d.Set("zone", virtualNetworkInterface.Zone.Name); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting zone: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMIsVirtualNetworkInterfaceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := d.Id()
+
+	hasChange := false
+	virtualNetworkInterfacePatchModel := &vpcv1.VirtualNetworkInterfacePatch{}
+	if d.HasChange("name") {
+		virtualNetworkInterfacePatchModel.Name = core.StringPtr(d.Get("name").(string))
+		hasChange = true
+	}
+	if d.HasChange("allow_ip_spoofing") {
+		virtualNetworkInterfacePatchModel.AllowIPSpoofing = core.BoolPtr(d.Get("allow_ip_spoofing").(bool))
+		hasChange = true
+	}
+	if d.HasChange("auto_delete") {
+		virtualNetworkInterfacePatchModel.AutoDelete = core.BoolPtr(d.Get("auto_delete").(bool))
+		hasChange = true
+	}
+	if d.HasChange("enable_infrastructure_nat") {
+		virtualNetworkInterfacePatchModel.EnableInfrastructureNat = core.BoolPtr(d.Get("enable_infrastructure_nat").(bool))
+		hasChange = true
+	}
+	if hasChange {
+		virtualNetworkInterfacePatch, err := virtualNetworkInterfacePatchModel.AsPatch()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for VirtualNetworkInterfacePatch: %s", err))
+		}
+		updateVirtualNetworkInterfaceOptions := &vpcv1.UpdateVirtualNetworkInterfaceOptions{
+			ID:                           &id,
+			VirtualNetworkInterfacePatch: virtualNetworkInterfacePatch,
+		}
+		_, response, err := sess.UpdateVirtualNetworkInterfaceWithContext(context, updateVirtualNetworkInterfaceOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateVirtualNetworkInterfaceWithContext failed %s\n%s", err, response)
+			return flex.NewServiceError("UpdateVirtualNetworkInterfaceWithContext failed", err, response).Diagnostics()
+		}
+		_, err = WaitForVNIAvailable(sess, id, d, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("primary_ip.0.name") || d.HasChange("primary_ip.0.auto_delete") {
+		subnetID := d.Get("subnet").(string)
+		reservedIPID := d.Get("primary_ip.0.reserved_ip").(string)
+		reservedIPPatchModel := &vpcv1.ReservedIPPatch{}
+		if d.HasChange("primary_ip.0.name") {
+			reservedIPPatchModel.Name = core.StringPtr(d.Get("primary_ip.0.name").(string))
+		}
+		if d.HasChange("primary_ip.0.auto_delete") {
+			reservedIPPatchModel.AutoDelete = core.BoolPtr(d.Get("primary_ip.0.auto_delete").(bool))
+		}
+		reservedIPPatch, err := reservedIPPatchModel.AsPatch()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for ReservedIPPatch: %s", err))
+		}
+		updateSubnetReservedIPOptions := &vpcv1.UpdateSubnetReservedIPOptions{
+			SubnetID:        &subnetID,
+			ID:              &reservedIPID,
+			ReservedIPPatch: reservedIPPatch,
+		}
+		_, response, err := sess.UpdateSubnetReservedIPWithContext(context, updateSubnetReservedIPOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateSubnetReservedIPWithContext failed %s\n%s", err, response)
+			return flex.NewServiceError("UpdateSubnetReservedIPWithContext failed", err, response).WithAttribute(cty.GetAttrPath("primary_ip").IndexInt(0)).Diagnostics()
+		}
+	}
+
+	if d.HasChange("ips") {
+		o, n := d.GetChange("ips")
+		oldIPs := o.(*schema.Set)
+		newIPs := n.(*schema.Set)
+		for _, ip := range oldIPs.Difference(newIPs).List() {
+			reservedIPID := ip.(map[string]interface{})["reserved_ip"].(string)
+			removeVirtualNetworkInterfaceIPOptions := &vpcv1.RemoveVirtualNetworkInterfaceIPOptions{
+				VirtualNetworkInterfaceID: &id,
+				ID:                        &reservedIPID,
+			}
+			response, err := sess.RemoveVirtualNetworkInterfaceIPWithContext(context, removeVirtualNetworkInterfaceIPOptions)
+			if err != nil {
+				log.Printf("[DEBUG] RemoveVirtualNetworkInterfaceIPWithContext failed %s\n%s", err, response)
+				return flex.NewServiceError("RemoveVirtualNetworkInterfaceIPWithContext failed", err, response).WithAttribute(cty.GetAttrPath("ips")).Diagnostics()
+			}
+		}
+		for _, ip := range newIPs.Difference(oldIPs).List() {
+			reservedIPID := ip.(map[string]interface{})["reserved_ip"].(string)
+			addVirtualNetworkInterfaceIPOptions := &vpcv1.AddVirtualNetworkInterfaceIPOptions{
+				VirtualNetworkInterfaceID: &id,
+				ID:                        &reservedIPID,
+			}
+			_, response, err := sess.AddVirtualNetworkInterfaceIPWithContext(context, addVirtualNetworkInterfaceIPOptions)
+			if err != nil {
+				log.Printf("[DEBUG] AddVirtualNetworkInterfaceIPWithContext failed %s\n%s", err, response)
+				return flex.NewServiceError("AddVirtualNetworkInterfaceIPWithContext failed", err, response).WithAttribute(cty.GetAttrPath("ips")).Diagnostics()
+			}
+		}
+	}
+
+	if d.HasChange("security_groups") {
+		o, n := d.GetChange("security_groups")
+		oldSecurityGroups := o.(*schema.Set)
+		newSecurityGroups := n.(*schema.Set)
+		for _, securityGroupID := range flex.ExpandStringList(newSecurityGroups.Difference(oldSecurityGroups).List()) {
+			securityGroupID := securityGroupID
+			createSecurityGroupTargetBindingOptions := &vpcv1.CreateSecurityGroupTargetBindingOptions{
+				SecurityGroupID: &securityGroupID,
+				ID:              &id,
+			}
+			_, response, err := sess.CreateSecurityGroupTargetBindingWithContext(context, createSecurityGroupTargetBindingOptions)
+			if err != nil {
+				log.Printf("[DEBUG] CreateSecurityGroupTargetBindingWithContext failed %s\n%s", err, response)
+				return flex.NewServiceError("CreateSecurityGroupTargetBindingWithContext failed", err, response).WithAttribute(cty.GetAttrPath("security_groups")).Diagnostics()
+			}
+			_, err = WaitForVNIAvailable(sess, id, d, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		for _, securityGroupID := range flex.ExpandStringList(oldSecurityGroups.Difference(newSecurityGroups).List()) {
+			securityGroupID := securityGroupID
+			deleteSecurityGroupTargetBindingOptions := &vpcv1.DeleteSecurityGroupTargetBindingOptions{
+				SecurityGroupID: &securityGroupID,
+				ID:              &id,
+			}
+			response, err := sess.DeleteSecurityGroupTargetBindingWithContext(context, deleteSecurityGroupTargetBindingOptions)
+			if err != nil {
+				log.Printf("[DEBUG] DeleteSecurityGroupTargetBindingWithContext failed %s\n%s", err, response)
+				return flex.NewServiceError("DeleteSecurityGroupTargetBindingWithContext failed", err, response).WithAttribute(cty.GetAttrPath("security_groups")).Diagnostics()
+			}
+			_, err = WaitForVNIAvailable(sess, id, d, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceIBMIsVirtualNetworkInterfaceRead(context, d, meta)
+}
+
+func resourceIBMIsVirtualNetworkInterfaceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteVirtualNetworkInterfacesOptions := &vpcv1.DeleteVirtualNetworkInterfacesOptions{}
+	deleteVirtualNetworkInterfacesOptions.SetID(d.Id())
+
+	response, err := sess.DeleteVirtualNetworkInterfacesWithContext(context, deleteVirtualNetworkInterfacesOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteVirtualNetworkInterfacesWithContext failed %s\n%s", err, response)
+		return flex.NewServiceError("DeleteVirtualNetworkInterfacesWithContext failed", err, response).Diagnostics()
+	}
+	_, err = isWaitForVirtualNetworkInterfaceDeleted(context, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForVirtualNetworkInterfaceDeleted(context context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for virtual network interface (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"deleting", "stable", "updating"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			getVirtualNetworkInterfaceOptions := &vpcv1.GetVirtualNetworkInterfaceOptions{}
+			getVirtualNetworkInterfaceOptions.SetID(id)
+			virtualNetworkInterface, response, err := sess.GetVirtualNetworkInterfaceWithContext(context, getVirtualNetworkInterfaceOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return virtualNetworkInterface, "done", nil
+				}
+				return nil, "", flex.NewServiceError("Error getting virtual network interface", err, response)
+			}
+			if *virtualNetworkInterface.LifecycleState == "failed" {
+				return virtualNetworkInterface, *virtualNetworkInterface.LifecycleState, fmt.Errorf("[ERROR] The virtual network interface %s failed to delete", id)
+			}
+			return virtualNetworkInterface, "deleting", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+// hashVirtualNetworkInterfaceIP hashes the ips of a virtual network interface by reserved IP, ignoring
+// their computed attributes
+func hashVirtualNetworkInterfaceIP(v interface{}) int {
+	return schema.HashString(v.(map[string]interface{})["reserved_ip"])
+}
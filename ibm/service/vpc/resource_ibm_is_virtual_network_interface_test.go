@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestAccIBMIsVirtualNetworkInterfaceBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	sgname := fmt.Sprintf("tf-sg-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-vni-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-vni-update-%d", acctest.RandIntRange(10, 100))
+	terraformTag := "ibm_is_virtual_network_interface.testacc_vni"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsVirtualNetworkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVirtualNetworkInterfaceConfig(vpcname, subnetname, sgname, name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIsVirtualNetworkInterfaceExists(terraformTag),
+					resource.TestCheckResourceAttr(terraformTag, "name", name),
+					resource.TestCheckResourceAttr(terraformTag, "allow_ip_spoofing", "false"),
+					resource.TestCheckResourceAttr(terraformTag, "auto_delete", "false"),
+					resource.TestCheckResourceAttr(terraformTag, "security_groups.#", "1"),
+					resource.TestCheckResourceAttr(terraformTag, "ips.#", "1"),
+					resource.TestCheckResourceAttrSet(terraformTag, "primary_ip.0.address"),
+					resource.TestCheckResourceAttrSet(terraformTag, "crn"),
+					resource.TestCheckResourceAttrSet(terraformTag, "vpc"),
+					resource.TestCheckResourceAttr(terraformTag, "zone", acc.ISZoneName),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsVirtualNetworkInterfaceConfig(vpcname, subnetname, sgname, nameUpdate, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIsVirtualNetworkInterfaceExists(terraformTag),
+					resource.TestCheckResourceAttr(terraformTag, "name", nameUpdate),
+					resource.TestCheckResourceAttr(terraformTag, "allow_ip_spoofing", "true"),
+				),
+			},
+			{
+				ResourceName:      terraformTag,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsVirtualNetworkInterfaceConfig(vpcname, subnetname, sgname, name string, allowIPSpoofing bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_security_group" "testacc_security_group" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	}
+
+	resource "ibm_is_subnet_reserved_ip" "testacc_reserved_ip" {
+		subnet = ibm_is_subnet.testacc_subnet.id
+	}
+
+	resource "ibm_is_virtual_network_interface" "testacc_vni" {
+		name              = "%s"
+		subnet            = ibm_is_subnet.testacc_subnet.id
+		allow_ip_spoofing = %t
+		auto_delete       = false
+		security_groups   = [ibm_is_security_group.testacc_security_group.id]
+		ips {
+			reserved_ip = ibm_is_subnet_reserved_ip.testacc_reserved_ip.reserved_ip
+		}
+	}
+	`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sgname, name, allowIPSpoofing)
+}
+
+func testAccCheckIBMIsVirtualNetworkInterfaceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+
+		getVirtualNetworkInterfaceOptions := &vpcv1.GetVirtualNetworkInterfaceOptions{}
+		getVirtualNetworkInterfaceOptions.SetID(rs.Primary.ID)
+
+		_, _, err = vpcClient.GetVirtualNetworkInterface(getVirtualNetworkInterfaceOptions)
+		return err
+	}
+}
+
+func testAccCheckIBMIsVirtualNetworkInterfaceDestroy(s *terraform.State) error {
+	vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_virtual_network_interface" {
+			continue
+		}
+
+		getVirtualNetworkInterfaceOptions := &vpcv1.GetVirtualNetworkInterfaceOptions{}
+		getVirtualNetworkInterfaceOptions.SetID(rs.Primary.ID)
+
+		_, response, err := vpcClient.GetVirtualNetworkInterface(getVirtualNetworkInterfaceOptions)
+		if err == nil {
+			return fmt.Errorf("VirtualNetworkInterface still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for VirtualNetworkInterface (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -69,7 +69,7 @@ func ResourceIBMISVolume() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -228,6 +228,20 @@ func ResourceIBMISVolume() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "UserTags for the volume instance",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 			isVolumeAccessTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -439,7 +453,7 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 		}
 		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error fetching snapshot %s\n%s", err, response)
+			return flex.NewServiceError("Error fetching snapshot", err, response)
 		}
 		if (response != nil && response.StatusCode == 404) || snapshot == nil {
 			return fmt.Errorf("[ERROR] No snapshot found with id %s", sourceSnapshot)
@@ -484,28 +498,25 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 		volTemplate.Iops = &iops
 	}
 
-	var userTags *schema.Set
-	if v, ok := d.GetOk(isVolumeTags); ok {
-		userTags = v.(*schema.Set)
-		if userTags != nil && userTags.Len() != 0 {
-			userTagsArray := make([]string, userTags.Len())
-			for i, userTag := range userTags.List() {
-				userTagStr := userTag.(string)
-				userTagsArray[i] = userTagStr
-			}
-			schematicTags := os.Getenv("IC_ENV_TAGS")
-			var envTags []string
-			if schematicTags != "" {
-				envTags = strings.Split(schematicTags, ",")
-				userTagsArray = append(userTagsArray, envTags...)
-			}
-			volTemplate.UserTags = userTagsArray
+	if flex.HasTagsToAttach(d, meta, isVolumeTags) {
+		userTags := d.Get(isVolumeTags).(*schema.Set)
+		userTagsArray := make([]string, userTags.Len())
+		for i, userTag := range userTags.List() {
+			userTagStr := userTag.(string)
+			userTagsArray[i] = userTagStr
 		}
+		schematicTags := os.Getenv("IC_ENV_TAGS")
+		var envTags []string
+		if schematicTags != "" {
+			envTags = strings.Split(schematicTags, ",")
+			userTagsArray = append(userTagsArray, envTags...)
+		}
+		volTemplate.UserTags = flex.WithDefaultUserTags(meta, userTagsArray)
 	}
 
 	vol, response, err := sess.CreateVolume(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create volume err %s\n%s", err, response)
+		return flex.NewServiceError("Error creating Volume", err, response)
 	}
 	d.SetId(*vol.ID)
 	log.Printf("[INFO] Volume : %s", *vol.ID)
@@ -514,7 +525,7 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 		return err
 	}
 
-	if _, ok := d.GetOk(isVolumeAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isVolumeAccessTags) {
 		oldList, newList := d.GetChange(isVolumeAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vol.CRN, "", isVolumeAccessTagType)
 		if err != nil {
@@ -549,7 +560,7 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Volume (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting Volume (%s)", id), err, response)
 	}
 	d.SetId(*vol.ID)
 	d.Set(isVolumeName, *vol.Name)
@@ -589,16 +600,14 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 		d.Set(isVolumeStatusReasons, statusReasonsList)
 	}
 	if vol.UserTags != nil {
-		if err = d.Set(isVolumeTags, vol.UserTags); err != nil {
-			return fmt.Errorf("Error setting user tags: %s", err)
-		}
+		flex.SetResourceTags(d, meta, isVolumeTags, "user", vol.UserTags)
 	}
 	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *vol.CRN, "", isVolumeAccessTagType)
 	if err != nil {
 		log.Printf(
 			"Error on get of resource volume (%s) access tags: %s", d.Id(), err)
 	}
-	d.Set(isVolumeAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isVolumeAccessTags, isVolumeAccessTagType, accesstags)
 	if vol.HealthReasons != nil {
 		healthReasonsList := make([]map[string]interface{}, 0)
 		for _, sr := range vol.HealthReasons {
@@ -668,13 +677,13 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		deleteAllSnapshots(sess, id)
 	}
 
-	if d.HasChange(isVolumeAccessTags) {
+	if flex.AccessTagsHasChange(d, isVolumeAccessTags) {
 		options := &vpcv1.GetVolumeOptions{
 			ID: &id,
 		}
 		vol, response, err := sess.GetVolume(options)
 		if err != nil {
-			return fmt.Errorf("Error getting Volume : %s\n%s", err, response)
+			return flex.NewServiceError("Error getting Volume", err, response)
 		}
 		oldList, newList := d.GetChange(isVolumeAccessTags)
 
@@ -694,7 +703,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Volume (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting Volume (%s)", id), err, response)
 	}
 	eTag := response.Headers.Get("ETag")
 	options := &vpcv1.UpdateVolumeOptions{
@@ -727,7 +736,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		}
 		vol, response, err := sess.GetVolume(getvoloptions)
 		if err != nil || vol == nil {
-			return fmt.Errorf("[ERROR] Error retrieving Volume (%s) details: %s\n%s", volId, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error retrieving Volume (%s)", volId), err, response)
 		}
 		if vol.VolumeAttachments == nil || len(vol.VolumeAttachments) < 1 {
 			return fmt.Errorf("[ERROR] Error updating Volume profile/iops because the specified volume %s is not attached to a virtual server instance ", volId)
@@ -739,7 +748,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		}
 		instance, response, err := sess.GetInstance(getinsOptions)
 		if err != nil || instance == nil {
-			return fmt.Errorf("[ERROR] Error retrieving Instance (%s) to which the volume (%s) is attached : %s\n%s", insId, volId, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error retrieving Instance (%s) to which the volume (%s) is attached", insId, volId), err, response)
 		}
 		if instance != nil && *instance.Status != "running" {
 			actiontype := "start"
@@ -749,7 +758,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			}
 			_, response, err = sess.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error starting Instance (%s) to which the volume (%s) is attached  : %s\n%s", insId, volId, err, response)
+				return flex.NewServiceError(fmt.Sprintf("Error starting Instance (%s) to which the volume (%s) is attached", insId, volId), err, response)
 			}
 			_, err = isWaitForInstanceAvailable(sess, insId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
@@ -794,7 +803,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 				d.SetId("")
 				return nil
 			}
-			return fmt.Errorf("[ERROR] Error Getting Volume (%s): %s\n%s", id, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error Getting Volume (%s)", id), err, response)
 		}
 		if vol.VolumeAttachments == nil || len(vol.VolumeAttachments) == 0 || *vol.VolumeAttachments[0].ID == "" {
 			return fmt.Errorf("[ERROR] Error volume capacity can't be updated since volume %s is not attached to any instance for VolumePatch", id)
@@ -805,7 +814,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		}
 		instance, response, err := sess.GetInstance(getinsOptions)
 		if err != nil || instance == nil {
-			return fmt.Errorf("[ERROR] Error retrieving Instance (%s) : %s\n%s", *insId, err, response)
+			return flex.NewServiceError(fmt.Sprintf("Error retrieving Instance (%s)", *insId), err, response)
 		}
 		if instance != nil && *instance.Status != "running" {
 			actiontype := "start"
@@ -815,7 +824,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			}
 			_, response, err = sess.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error starting Instance (%s) : %s\n%s", *insId, err, response)
+				return flex.NewServiceError(fmt.Sprintf("Error starting Instance (%s)", *insId), err, response)
 			}
 			_, err = isWaitForInstanceAvailable(sess, *insId, d.Timeout(schema.TimeoutCreate), d)
 			if err != nil {
@@ -833,7 +842,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		options.VolumePatch = volumeCapacityPatch
 		_, response, err = sess.UpdateVolume(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating vpc volume: %s\n%s", err, response)
+			return flex.NewServiceError("Error updating vpc volume", err, response)
 		}
 		_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -842,39 +851,35 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 	}
 
 	// user tags update
-	if d.HasChange(isVolumeTags) {
-		var userTags *schema.Set
-		if v, ok := d.GetOk(isVolumeTags); ok {
-			userTags = v.(*schema.Set)
-			if userTags != nil && userTags.Len() != 0 {
-				userTagsArray := make([]string, userTags.Len())
-				for i, userTag := range userTags.List() {
-					userTagStr := userTag.(string)
-					userTagsArray[i] = userTagStr
-				}
-				schematicTags := os.Getenv("IC_ENV_TAGS")
-				var envTags []string
-				if schematicTags != "" {
-					envTags = strings.Split(schematicTags, ",")
-					userTagsArray = append(userTagsArray, envTags...)
-				}
-				volumeNamePatchModel := &vpcv1.VolumePatch{}
-				volumeNamePatchModel.UserTags = userTagsArray
-				volumeNamePatch, err := volumeNamePatchModel.AsPatch()
-				if err != nil {
-					return fmt.Errorf("Error calling asPatch for volumeNamePatch: %s", err)
-				}
-				options.IfMatch = &eTag
-				options.VolumePatch = volumeNamePatch
-				_, response, err := sess.UpdateVolume(options)
-				if err != nil {
-					return fmt.Errorf("Error updating volume : %s\n%s", err, response)
-				}
-				_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
-				if err != nil {
-					return err
-				}
-			}
+	if flex.TagsHasChange(d, isVolumeTags) {
+		userTags := d.Get(isVolumeTags).(*schema.Set)
+		userTagsArray := make([]string, userTags.Len())
+		for i, userTag := range userTags.List() {
+			userTagStr := userTag.(string)
+			userTagsArray[i] = userTagStr
+		}
+		schematicTags := os.Getenv("IC_ENV_TAGS")
+		var envTags []string
+		if schematicTags != "" {
+			envTags = strings.Split(schematicTags, ",")
+			userTagsArray = append(userTagsArray, envTags...)
+		}
+		userTagsArray = flex.WithDefaultUserTags(meta, userTagsArray)
+		volumeNamePatchModel := &vpcv1.VolumePatch{}
+		volumeNamePatchModel.UserTags = userTagsArray
+		volumeNamePatch, err := volumeNamePatchModel.AsPatch()
+		if err != nil {
+			return fmt.Errorf("Error calling asPatch for volumeNamePatch: %s", err)
+		}
+		options.IfMatch = &eTag
+		options.VolumePatch = volumeNamePatch
+		_, response, err := sess.UpdateVolume(options)
+		if err != nil {
+			return flex.NewServiceError("Error updating volume", err, response)
+		}
+		_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
 		}
 	}
 
@@ -905,7 +910,7 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting Volume (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error getting Volume (%s)", id), err, response)
 	}
 
 	if volDetails.VolumeAttachments != nil {
@@ -931,7 +936,7 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteVolume(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting Volume : %s\n%s", err, response)
+		return flex.NewServiceError("Error deleting Volume", err, response)
 	}
 	_, err = isWaitForVolumeDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -966,7 +971,7 @@ func isVolumeDeleteRefreshFunc(vol *vpcv1.VpcV1, id string) resource.StateRefres
 			if response != nil && response.StatusCode == 404 {
 				return vol, isVolumeDeleted, nil
 			}
-			return vol, "", fmt.Errorf("[ERROR] Error getting Volume: %s\n%s", err, response)
+			return vol, "", flex.NewServiceError("Error getting Volume", err, response)
 		}
 		return vol, isVolumeDeleting, err
 	}
@@ -993,7 +998,7 @@ func volExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Volume: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error getting Volume", err, response)
 	}
 	return true, nil
 }
@@ -1020,7 +1025,7 @@ func isVolumeRefreshFunc(client *vpcv1.VpcV1, id string) resource.StateRefreshFu
 		}
 		vol, response, err := client.GetVolume(volgetoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error getting volume: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error getting volume", err, response)
 		}
 
 		if *vol.Status == "available" {
@@ -1036,7 +1041,7 @@ func deleteAllSnapshots(sess *vpcv1.VpcV1, id string) error {
 	delete_all_snapshots.SourceVolumeID = &id
 	response, err := sess.DeleteSnapshots(delete_all_snapshots)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting snapshots from volume %s\n%s", err, response)
+		return flex.NewServiceError("Error deleting snapshots from volume", err, response)
 	}
 	return nil
 }
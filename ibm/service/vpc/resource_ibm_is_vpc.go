@@ -8,7 +8,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 	"strings"
 	"time"
@@ -106,7 +105,7 @@ func ResourceIBMISVPC() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -383,6 +382,20 @@ func ResourceIBMISVPC() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 			isVPCAccessTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -704,7 +717,7 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 
 	vpc, response, err := sess.CreateVPC(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating VPC %s ", flex.BeautifyError(err, response))
+		return flex.NewServiceError("Error while creating VPC", err, response)
 	}
 	d.SetId(*vpc.ID)
 
@@ -742,7 +755,7 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 			_, response, err := sess.CreateVPCDnsResolutionBinding(createDnsBindings)
 			if err != nil {
 				log.Printf("[DEBUG] CreateVPCDnsResolutionBindingWithContext failed %s\n%s", err, response)
-				return fmt.Errorf("[ERROR] CreateVPCDnsResolutionBinding failed in vpc resource %s\n%s", err, response)
+				return flex.NewServiceError("CreateVPCDnsResolutionBinding failed in vpc resource", err, response)
 			}
 			resolverType := "delegated"
 			dnsPatch := &vpcv1.VpcdnsPatch{
@@ -766,7 +779,7 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 			_, response, err = sess.UpdateVPC(updateVpcOptions)
 			if err != nil {
 				log.Printf("[DEBUG] Update vpc with delegated failed %s\n%s", err, response)
-				return fmt.Errorf("[ERROR] Update vpc with delegated failed in vpc resource %s\n%s", err, response)
+				return flex.NewServiceError("Update vpc with delegated failed in vpc resource", err, response)
 			}
 		}
 	}
@@ -778,8 +791,7 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 			deleteDefaultSecurityGroupRules(sess, *vpc.ID)
 		}
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVPCTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isVPCTags) {
 		oldList, newList := d.GetChange(isVPCTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCUserTagType)
 		if err != nil {
@@ -787,7 +799,7 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 				"Error on create of resource vpc (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if _, ok := d.GetOk(isVPCAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isVPCAccessTags) {
 		oldList, newList := d.GetChange(isVPCAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCAccessTagType)
 		if err != nil {
@@ -832,7 +844,7 @@ func deleteDefaultNetworkACLRules(sess *vpcv1.VpcV1, vpcID string) error {
 				_, response, err := sess.GetNetworkACLRule(getNetworkAclRuleOptions)
 
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Getting Network ACL Rule  (%s): %s\n%s", *sourceRuleVal.ID, err, response)
+					return flex.NewServiceError(fmt.Sprintf("Error Getting Network ACL Rule  (%s)", *sourceRuleVal.ID), err, response)
 				}
 
 				deleteNetworkAclRuleOptions := &vpcv1.DeleteNetworkACLRuleOptions{
@@ -841,7 +853,7 @@ func deleteDefaultNetworkACLRules(sess *vpcv1.VpcV1, vpcID string) error {
 				}
 				response, err = sess.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Deleting Network ACL Rule : %s\n%s", err, response)
+					return flex.NewServiceError("Error Deleting Network ACL Rule", err, response)
 				}
 			}
 		}
@@ -868,7 +880,7 @@ func deleteDefaultSecurityGroupRules(sess *vpcv1.VpcV1, vpcID string) error {
 				_, response, err := sess.GetSecurityGroupRule(getSecurityGroupRuleOptions)
 
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Getting Security Group Rule  (%s): %s\n%s", *sourceRuleVal.ID, err, response)
+					return flex.NewServiceError(fmt.Sprintf("Error Getting Security Group Rule  (%s)", *sourceRuleVal.ID), err, response)
 				}
 
 				deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
@@ -877,7 +889,7 @@ func deleteDefaultSecurityGroupRules(sess *vpcv1.VpcV1, vpcID string) error {
 				}
 				response, err = sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error Deleting Security Group Rule : %s\n%s", err, response)
+					return flex.NewServiceError("Error Deleting Security Group Rule", err, response)
 				}
 			}
 		}
@@ -892,7 +904,7 @@ func isVPCRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 		}
 		vpc, response, err := vpc.GetVPC(getvpcOptions)
 		if err != nil {
-			return nil, isVPCFailed, fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
+			return nil, isVPCFailed, flex.NewServiceError("Error getting VPC", err, response)
 		}
 
 		if *vpc.Status == isVPCAvailable || *vpc.Status == isVPCFailed {
@@ -926,7 +938,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
+		return flex.NewServiceError("Error getting VPC", err, response)
 	}
 
 	d.Set(isVPCName, *vpc.Name)
@@ -1018,13 +1030,13 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of resource vpc (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isVPCTags, tags)
+	flex.SetResourceTags(d, meta, isVPCTags, isVPCUserTagType, tags)
 	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *vpc.CRN, "", isVPCAccessTagType)
 	if err != nil {
 		log.Printf(
 			"Error on get of resource vpc (%s) access tags: %s", d.Id(), err)
 	}
-	d.Set(isVPCAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isVPCAccessTags, isVPCAccessTagType, accesstags)
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -1062,7 +1074,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		s, response, err := sess.ListSubnets(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching subnets %s\n%s", err, response)
+			return flex.NewServiceError("Error Fetching subnets", err, response)
 		}
 		start = flex.GetNext(s.Next)
 		allrecs = append(allrecs, s.Subnets...)
@@ -1231,13 +1243,13 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		return err
 	}
 
-	if d.HasChange(isVPCTags) {
+	if flex.TagsHasChange(d, isVPCTags) {
 		getvpcOptions := &vpcv1.GetVPCOptions{
 			ID: &id,
 		}
 		vpc, response, err := sess.GetVPC(getvpcOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting VPC : %s\n%s", err, response)
+			return flex.NewServiceError("Error getting VPC", err, response)
 		}
 		oldList, newList := d.GetChange(isVPCTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCUserTagType)
@@ -1246,13 +1258,13 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 				"Error on update of resource vpc (%s) tags: %s", d.Id(), err)
 		}
 	}
-	if d.HasChange(isVPCAccessTags) {
+	if flex.AccessTagsHasChange(d, isVPCAccessTags) {
 		getvpcOptions := &vpcv1.GetVPCOptions{
 			ID: &id,
 		}
 		vpc, response, err := sess.GetVPC(getvpcOptions)
 		if err != nil {
-			return fmt.Errorf("Error getting VPC : %s\n%s", err, response)
+			return flex.NewServiceError("Error getting VPC", err, response)
 		}
 		oldList, newList := d.GetChange(isVPCAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCAccessTagType)
@@ -1304,7 +1316,7 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 					}
 					_, response, err := sess.GetVPC(getVpcOptions)
 					if err != nil {
-						return fmt.Errorf("[ERROR] Error Getting VPC (%s): %s\n%s", id, err, response)
+						return flex.NewServiceError(fmt.Sprintf("Error Getting VPC (%s)", id), err, response)
 					}
 					isDnsResolverManualServerChange = true
 					isDnsResolverManualServerEtag = response.Headers.Get("ETag") // Getting Etag from the response headers.
@@ -1404,10 +1416,10 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 				updateVpcOptions.IfMatch = nil
 				_, nestedresponse, nestederr := sess.UpdateVPC(updateVpcOptions)
 				if nestederr != nil {
-					return fmt.Errorf("[ERROR] Error Updating VPC on retry : %s\n%s", nestederr, nestedresponse)
+					return flex.NewServiceError("Error Updating VPC on retry", nestederr, nestedresponse)
 				}
 			} else {
-				return fmt.Errorf("[ERROR] Error Updating VPC : %s\n%s", err, response)
+				return flex.NewServiceError("Error Updating VPC", err, response)
 			}
 		}
 		if isDnsResolverVPCCrnNull || isDnsResolverVPCIDNull {
@@ -1452,7 +1464,7 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting VPC (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting VPC (%s)", id), err, response)
 	}
 
 	deletevpcOptions := &vpcv1.DeleteVPCOptions{
@@ -1460,7 +1472,7 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteVPC(deletevpcOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting VPC : %s\n%s", err, response)
+		return flex.NewServiceError("Error Deleting VPC", err, response)
 	}
 	_, err = isWaitForVPCDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -1496,7 +1508,7 @@ func isVPCDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFu
 			if response != nil && response.StatusCode == 404 {
 				return vpc, isVPCDeleted, nil
 			}
-			return nil, isVPCFailed, fmt.Errorf("[ERROR] The VPC %s failed to delete: %s\n%s", id, err, response)
+			return nil, isVPCFailed, flex.NewServiceError(fmt.Sprintf("The VPC %s failed to delete", id), err, response)
 		}
 
 		return vpc, isVPCDeleting, nil
@@ -1522,7 +1534,7 @@ func vpcExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting VPC: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error getting VPC", err, response)
 	}
 	return true, nil
 }
@@ -1548,7 +1560,7 @@ func nwaclNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
 	updateNetworkACLOptions.NetworkACLPatch = networkACLPatch
 	_, response, err := sess.UpdateNetworkACL(updateNetworkACLOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Network ACL(%s) name : %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Updating Network ACL(%s) name", id), err, response)
 	}
 	return nil
 }
@@ -1567,7 +1579,7 @@ func sgNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
 	updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
 	_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Security Group name : %s\n%s", err, response)
+		return flex.NewServiceError("Error Updating Security Group name", err, response)
 	}
 	return nil
 }
@@ -1585,7 +1597,7 @@ func rtNameUpdate(sess *vpcv1.VpcV1, vpcID, id, name string) error {
 	updateVpcRoutingTableOptions.RoutingTablePatch = routingTablePatchModelAsPatch
 	_, response, err := sess.UpdateVPCRoutingTable(updateVpcRoutingTableOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Updating Routing table name %s\n%s", err, response)
+		return flex.NewServiceError("Error Updating Routing table name", err, response)
 	}
 	return nil
 }
@@ -151,7 +151,7 @@ func vpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}, name, zone
 	}
 	addrPrefix, response, err := sess.CreateVPCAddressPrefix(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while creating VPC Address Prefix %s\n%s", err, response)
+		return flex.NewServiceError("Error while creating VPC Address Prefix", err, response)
 	}
 
 	addrPrefixID := *addrPrefix.ID
@@ -191,7 +191,7 @@ func vpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID, addrPr
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting VPC Address Prefix (%s)", addrPrefixID), err, response)
 	}
 	d.Set(isVPCAddressPrefixVPCID, vpcID)
 	d.Set(isVPCAddressPrefixDefault, *addrPrefix.IsDefault)
@@ -207,7 +207,7 @@ func vpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID, addrPr
 	}
 	vpc, response, err := sess.GetVPC(getVPCOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting VPC : %s\n%s", err, response)
+		return flex.NewServiceError("Error Getting VPC", err, response)
 	}
 	d.Set(flex.RelatedCRN, *vpc.CRN)
 
@@ -273,7 +273,7 @@ func vpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{}, vpcID, add
 		updatevpcAddressPrefixoptions.AddressPrefixPatch = addressPrefixPatch
 		_, response, err := sess.UpdateVPCAddressPrefix(updatevpcAddressPrefixoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Updating VPC Address Prefix: %s\n%s", err, response)
+			return flex.NewServiceError("Error Updating VPC Address Prefix", err, response)
 		}
 	}
 	return nil
@@ -316,7 +316,7 @@ func vpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpcID, add
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting VPC Address Prefix (%s)", addrPrefixID), err, response)
 	}
 
 	deletevpcAddressPrefixOptions := &vpcv1.DeleteVPCAddressPrefixOptions{
@@ -328,7 +328,7 @@ func vpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpcID, add
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Deleting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Deleting VPC Address Prefix (%s)", addrPrefixID), err, response)
 	}
 	d.SetId("")
 	return nil
@@ -364,7 +364,7 @@ func vpcAddressPrefixExists(d *schema.ResourceData, meta interface{}, vpcID, add
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting VPC Address Prefix: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error getting VPC Address Prefix", err, response)
 	}
 	return true, nil
 }
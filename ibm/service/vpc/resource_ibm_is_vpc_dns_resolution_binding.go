@@ -265,7 +265,7 @@ func resourceIBMIsVPCDnsResolutionBindingCreate(context context.Context, d *sche
 	vpcdnsResolutionBinding, response, err := sess.CreateVPCDnsResolutionBindingWithContext(context, createVPCDnsResolutionBindingOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateVPCDnsResolutionBindingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateVPCDnsResolutionBindingWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateVPCDnsResolutionBindingWithContext failed", err, response).Diagnostics()
 	}
 	d.SetId(MakeTerraformVPCDNSID(spokeVPCID, *vpcdnsResolutionBinding.ID))
 
@@ -293,7 +293,7 @@ func resourceIBMIsVPCDnsResolutionBindingRead(context context.Context, d *schema
 	if err != nil {
 		log.Printf("[DEBUG] GetVPCDnsResolutionBindingWithContext failed %s\n%s", err, response)
 		if response.StatusCode != 404 {
-			return diag.FromErr(fmt.Errorf("GetVPCDnsResolutionBindingWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("GetVPCDnsResolutionBindingWithContext failed", err, response).Diagnostics()
 		} else {
 			d.SetId("")
 			return nil
@@ -380,7 +380,7 @@ func resourceIBMIsVPCDnsResolutionBindingUpdate(context context.Context, d *sche
 		vpcdnsResolutionBinding, response, err := sess.UpdateVPCDnsResolutionBindingWithContext(context, updateVPCDnsResolutionBindingOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateVPCDnsResolutionBindingWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("UpdateVPCDnsResolutionBindingWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateVPCDnsResolutionBindingWithContext failed", err, response).Diagnostics()
 		}
 		err = resourceIBMIsVPCDnsResolutionBindingGet(vpcdnsResolutionBinding, d)
 		if err != nil {
@@ -408,7 +408,7 @@ func resourceIBMIsVPCDnsResolutionBindingDelete(context context.Context, d *sche
 	if err != nil {
 		log.Printf("[DEBUG] DeleteVPCDnsResolutionBindingWithContext failed %s\n%s", err, response)
 		if response.StatusCode != 404 {
-			return diag.FromErr(fmt.Errorf("DeleteVPCDnsResolutionBindingWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("DeleteVPCDnsResolutionBindingWithContext failed", err, response).Diagnostics()
 		}
 	}
 	d.SetId("")
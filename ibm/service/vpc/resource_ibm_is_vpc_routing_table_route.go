@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -289,7 +290,7 @@ func resourceIBMISVPCRoutingTableRouteRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting VPC Routing table route: %s\n%s", err, response)
+		return flex.NewServiceError("Error Getting VPC Routing table route", err, response)
 	}
 
 	d.Set(rID, *route.ID)
@@ -427,7 +428,7 @@ func resourceIBMISVPCRoutingTableRouteExists(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error Getting VPC Routing table route : %s\n%s", err, response)
+		return false, flex.NewServiceError("Error Getting VPC Routing table route", err, response)
 	}
 	return true, nil
 }
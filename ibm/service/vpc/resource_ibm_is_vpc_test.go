@@ -69,6 +69,37 @@ func TestAccIBMISVPC_basic(t *testing.T) {
 		},
 	})
 }
+func TestAccIBMISVPC_replay(t *testing.T) {
+	var vpc string
+	r := acc.NewReplay(t)
+	name := fmt.Sprintf("tf-replay-vpc-%d", r.RandIntRange(10, 100))
+
+	r.Test(t, resource.TestCase{
+		PreCheck:     func() { r.PreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPCDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCReplayConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCExists("ibm_is_vpc.testacc_vpc", vpc),
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "status", "available"),
+					resource.TestCheckResourceAttrSet("ibm_is_vpc.testacc_vpc", "default_security_group"),
+					resource.TestCheckResourceAttrSet("ibm_is_vpc.testacc_vpc", "crn"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_vpc.testacc_vpc",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"address_prefix_management", "no_sg_acl_rules"},
+			},
+		},
+	})
+}
+
 func TestAccIBMISVPC_dns_manual(t *testing.T) {
 	var vpc string
 	name1 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
@@ -482,6 +513,13 @@ func testAccCheckIBMISVPCConfig(name string) string {
 	}`, name)
 
 }
+func testAccCheckIBMISVPCReplayConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}`, name)
+}
+
 func testAccCheckIBMISVPCDnsSystemConfig(name string, enableHub bool) string {
 	return fmt.Sprintf(`
 	resource "ibm_is_vpc" "testacc_vpc" {
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -60,7 +59,7 @@ func ResourceIBMISVPNGateway() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -163,6 +162,20 @@ func ResourceIBMISVPNGateway() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "VPN Gateway tags list",
 			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of tags attached to the resource, including the provider default_tags",
+			},
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 
 			isVPNGatewayAccessTags: {
 				Type:        schema.TypeSet,
@@ -416,7 +429,7 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 
 	vpnGatewayIntf, response, err := sess.CreateVPNGateway(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create vpc VPN Gateway %s\n%s", err, response)
+		return flex.NewServiceError("Create vpc VPN Gateway", err, response)
 	}
 	vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
@@ -428,8 +441,7 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVPNGatewayTags); ok || v != "" {
+	if flex.HasTagsToAttach(d, meta, isVPNGatewayTags) {
 		oldList, newList := d.GetChange(isVPNGatewayTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN, "", isUserTagType)
 		if err != nil {
@@ -438,7 +450,7 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 		}
 	}
 
-	if _, ok := d.GetOk(isVPNGatewayAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isVPNGatewayAccessTags) {
 		oldList, newList := d.GetChange(isVPNGatewayAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN, "", isAccessTagType)
 		if err != nil {
@@ -472,7 +484,7 @@ func isVpnGatewayRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateR
 		}
 		vpnGatewayIntf, response, err := vpnGateway.GetVPNGateway(getVpnGatewayOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Vpn Gateway: %s\n%s", err, response)
+			return nil, "", flex.NewServiceError("Error Getting Vpn Gateway", err, response)
 		}
 		vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
@@ -508,7 +520,7 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Vpn Gateway (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Vpn Gateway (%s)", id), err, response)
 	}
 	vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
@@ -548,14 +560,14 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of resource vpc VPN Gateway (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isVPNGatewayTags, tags)
+	flex.SetResourceTags(d, meta, isVPNGatewayTags, isUserTagType, tags)
 
 	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *vpnGateway.CRN, "", isAccessTagType)
 	if err != nil {
 		log.Printf(
 			"Error on get of resource VPC VPN Gateway (%s) access tags: %s", d.Id(), err)
 	}
-	d.Set(isVPNGatewayAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isVPNGatewayAccessTags, isAccessTagType, accesstags)
 
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
@@ -621,13 +633,13 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isVPNGatewayTags) {
+	if flex.TagsHasChange(d, isVPNGatewayTags) {
 		getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 			ID: &id,
 		}
 		vpnGatewayIntf, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Volume : %s\n%s", err, response)
+			return flex.NewServiceError("Error getting Volume", err, response)
 		}
 		vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
@@ -638,13 +650,13 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 				"Error on update of resource vpc Vpn Gateway (%s) tags: %s", id, err)
 		}
 	}
-	if d.HasChange(isVPNGatewayAccessTags) {
+	if flex.AccessTagsHasChange(d, isVPNGatewayAccessTags) {
 		getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 			ID: &id,
 		}
 		vpnGatewayIntf, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error getting Volume : %s\n%s", err, response)
+			return flex.NewServiceError("Error getting Volume", err, response)
 		}
 		vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
@@ -669,7 +681,7 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		options.VPNGatewayPatch = vpnGatewayPatch
 		_, response, err := sess.UpdateVPNGateway(options)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating vpc Vpn Gateway: %s\n%s", err, response)
+			return flex.NewServiceError("Error updating vpc Vpn Gateway", err, response)
 		}
 	}
 	return nil
@@ -700,7 +712,7 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Vpn Gateway (%s): %s\n%s", id, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Vpn Gateway (%s)", id), err, response)
 	}
 
 	options := &vpcv1.DeleteVPNGatewayOptions{
@@ -708,7 +720,7 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteVPNGateway(options)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Vpn Gateway : %s\n%s", err, response)
+		return flex.NewServiceError("Error Deleting Vpn Gateway", err, response)
 	}
 	_, err = isWaitForVpnGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -743,7 +755,7 @@ func isVpnGatewayDeleteRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayDeleted, nil
 			}
-			return "", "", fmt.Errorf("[ERROR] Error Getting Vpn Gateway: %s\n%s", err, response)
+			return "", "", flex.NewServiceError("Error Getting Vpn Gateway", err, response)
 		}
 		return vpngw, isVPNGatewayDeleting, err
 	}
@@ -769,7 +781,7 @@ func vpngwExists(d *schema.ResourceData, meta interface{}, id string) (bool, err
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Vpn Gatewa: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error getting Vpn Gatewa", err, response)
 	}
 	return true, nil
 }
@@ -361,7 +361,7 @@ func vpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, p
 
 	vpnGatewayConnectionIntf, response, err := sess.CreateVPNGatewayConnection(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create VPN Gateway Connection err %s\n%s", err, response)
+		return flex.NewServiceError("Error creating VPN Gateway Connection", err, response)
 	}
 	vpnGatewayConnection := vpnGatewayConnectionIntf.(*vpcv1.VPNGatewayConnection)
 	d.SetId(fmt.Sprintf("%s/%s", gatewayID, *vpnGatewayConnection.ID))
@@ -401,7 +401,7 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Vpn Gateway Connection (%s): %s\n%s", gConnID, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Vpn Gateway Connection (%s)", gConnID), err, response)
 	}
 	d.Set(isVPNGatewayConnection, gConnID)
 	vpnGatewayConnection := vpnGatewayConnectionIntf.(*vpcv1.VPNGatewayConnection)
@@ -466,7 +466,7 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 	}
 	vpngatewayIntf, response, err := sess.GetVPNGateway(getVPNGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting VPN Gateway : %s\n%s", err, response)
+		return flex.NewServiceError("Error Getting VPN Gateway", err, response)
 	}
 	vpngateway := vpngatewayIntf.(*vpcv1.VPNGateway)
 	d.Set(flex.RelatedCRN, *vpngateway.CRN)
@@ -577,7 +577,7 @@ func vpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		updateVpnGatewayConnectionOptions.VPNGatewayConnectionPatch = vpnGatewayConnectionPatch
 		_, response, err := sess.UpdateVPNGatewayConnection(updateVpnGatewayConnectionOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating Vpn Gateway Connection: %s\n%s", err, response)
+			return flex.NewServiceError("Error updating Vpn Gateway Connection", err, response)
 		}
 	}
 	return nil
@@ -616,7 +616,7 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[ERROR] Error Getting Vpn Gateway Connection(%s): %s\n%s", gConnID, err, response)
+		return flex.NewServiceError(fmt.Sprintf("Error Getting Vpn Gateway Connection(%s)", gConnID), err, response)
 	}
 
 	deleteVpnGatewayConnectionOptions := &vpcv1.DeleteVPNGatewayConnectionOptions{
@@ -625,7 +625,7 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 	}
 	response, err = sess.DeleteVPNGatewayConnection(deleteVpnGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Deleting Vpn Gateway Connection : %s\n%s", err, response)
+		return flex.NewServiceError("Error Deleting Vpn Gateway Connection", err, response)
 	}
 
 	_, err = isWaitForVPNGatewayConnectionDeleted(sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
@@ -663,7 +663,7 @@ func isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpcv1.VpcV1,
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayConnectionDeleted, nil
 			}
-			return "", "", fmt.Errorf("[ERROR] The Vpn Gateway Connection %s failed to delete: %s\n%s", gConnID, err, response)
+			return "", "", flex.NewServiceError(fmt.Sprintf("The Vpn Gateway Connection %s failed to delete", gConnID), err, response)
 		}
 		return vpngwcon, isVPNGatewayConnectionDeleting, nil
 	}
@@ -700,7 +700,7 @@ func vpngwconExists(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("[ERROR] Error getting Vpn Gateway Connection: %s\n%s", err, response)
+		return false, flex.NewServiceError("Error getting Vpn Gateway Connection", err, response)
 	}
 	return true, nil
 }
@@ -49,6 +49,11 @@ func ResourceIBMIsVPNServer() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return flex.ResourceTagsCustomizeDiff(diff, v)
+				},
+			),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
@@ -364,6 +369,13 @@ func ResourceIBMIsVPNServer() *schema.Resource {
 				Description:  "The type of resource referenced.",
 			},
 
+			"access_tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "List of access management tags attached to the resource, including the provider default_tags",
+			},
 			isVPNServerAccessTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -554,7 +566,7 @@ func resourceIBMIsVPNServerCreate(context context.Context, d *schema.ResourceDat
 	vpnServer, response, err := sess.CreateVPNServerWithContext(context, createVPNServerOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateVPNServerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] CreateVPNServerWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateVPNServerWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(*vpnServer.ID)
@@ -564,7 +576,7 @@ func resourceIBMIsVPNServerCreate(context context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("[ERROR] VPNServer failed %s\n", err))
 	}
 
-	if _, ok := d.GetOk(isVPNServerAccessTags); ok {
+	if flex.HasAccessTagsToAttach(d, meta, isVPNServerAccessTags) {
 		oldList, newList := d.GetChange(isVPNServerAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnServer.CRN, "", isVPNServerAccessTagType)
 		if err != nil {
@@ -590,7 +602,7 @@ func isWaitForVPNServerStable(context context.Context, sess *vpcv1.VpcV1, d *sch
 			vpnServer, response, err := sess.GetVPNServerWithContext(context, getVPNServerOptions)
 			if err != nil {
 				log.Printf("[DEBUG] GetVPNServerWithContext failed %s\n%s", err, response)
-				return vpnServer, "", fmt.Errorf("Error Getting VPC Server: %s\n%s", err, response)
+				return vpnServer, "", flex.NewServiceError("Error Getting VPC Server", err, response)
 			}
 
 			if *vpnServer.LifecycleState == "stable" || *vpnServer.LifecycleState == "failed" {
@@ -623,7 +635,7 @@ func resourceIBMIsVPNServerRead(context context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] GetVPNServerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetVPNServerWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("vpn_server", d.Id()); err != nil {
@@ -770,7 +782,7 @@ func resourceIBMIsVPNServerRead(context context.Context, d *schema.ResourceData,
 		log.Printf(
 			"Error on get of resource vpn server (%s) access tags: %s", d.Id(), err)
 	}
-	d.Set(isVPNServerAccessTags, accesstags)
+	flex.SetResourceTags(d, meta, isVPNServerAccessTags, isVPNServerAccessTagType, accesstags)
 
 	return nil
 }
@@ -932,11 +944,11 @@ func resourceIBMIsVPNServerUpdate(context context.Context, d *schema.ResourceDat
 			return nil
 		}
 		log.Printf("[DEBUG] GetVPNServerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetVPNServerWithContext failed", err, response).Diagnostics()
 	}
 	eTag := response.Headers.Get("ETag") // Getting Etag from the response headers.
 
-	if d.HasChange(isVPNServerAccessTags) {
+	if flex.AccessTagsHasChange(d, isVPNServerAccessTags) {
 		oldList, newList := d.GetChange(isVPNServerAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnServer.CRN, "", isVPNServerAccessTagType)
 		if err != nil {
@@ -964,7 +976,7 @@ func resourceIBMIsVPNServerUpdate(context context.Context, d *schema.ResourceDat
 		_, response, err := sess.UpdateVPNServerWithContext(context, updateVPNServerOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateVPNServerWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] UpdateVPNServerWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateVPNServerWithContext failed", err, response).Diagnostics()
 		}
 		_, err = isWaitForVPNServerStable(context, sess, d, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -990,7 +1002,7 @@ func resourceIBMIsVPNServerDelete(context context.Context, d *schema.ResourceDat
 			return nil
 		}
 		log.Printf("[DEBUG] GetVPNServerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetVPNServerWithContext failed", err, response).Diagnostics()
 	}
 	etag := response.Headers.Get("Etag")
 	deleteVPNServerOptions := &vpcv1.DeleteVPNServerOptions{}
@@ -1000,7 +1012,7 @@ func resourceIBMIsVPNServerDelete(context context.Context, d *schema.ResourceDat
 	response, err = sess.DeleteVPNServerWithContext(context, deleteVPNServerOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteVPNServerWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] DeleteVPNServerWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteVPNServerWithContext failed", err, response).Diagnostics()
 	}
 
 	_, err = isWaitForVPNServerDeleted(context, sess, d)
@@ -1027,7 +1039,7 @@ func isWaitForVPNServerDeleted(context context.Context, sess *vpcv1.VpcV1, d *sc
 				if response != nil && response.StatusCode == 404 {
 					return vpnServer, isVPNServerStatusDeleted, nil
 				}
-				return vpnServer, *vpnServer.LifecycleState, fmt.Errorf("The VPC vpn server %s failed to delete: %s\n%s", d.Id(), err, response)
+				return vpnServer, *vpnServer.LifecycleState, flex.NewServiceError(fmt.Sprintf("The VPC vpn server %s failed to delete", d.Id()), err, response)
 			}
 			return vpnServer, *vpnServer.LifecycleState, nil
 
@@ -74,7 +74,7 @@ func resourceIBMIsVPNServerClientDisconnect(context context.Context, d *schema.R
 			return nil
 		}
 		log.Printf("[DEBUG] GetVPNServerClientWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerClientWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetVPNServerClientWithContext failed", err, response).Diagnostics()
 	}
 
 	var flag bool
@@ -91,7 +91,7 @@ func resourceIBMIsVPNServerClientDisconnect(context context.Context, d *schema.R
 		response, err := sess.DisconnectVPNClientWithContext(context, disconnectVPNServerRouteOptions)
 		if err != nil {
 			log.Printf("[DEBUG] DisconnectVPNClientWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] DisconnectVPNClientWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("DisconnectVPNClientWithContext failed", err, response).Diagnostics()
 		}
 
 		if err = d.Set("status_code", response.StatusCode); err != nil {
@@ -113,7 +113,7 @@ func resourceIBMIsVPNServerClientDisconnect(context context.Context, d *schema.R
 		response, err := sess.DeleteVPNServerClientWithContext(context, deleteVPNServerClientOptions)
 		if err != nil {
 			log.Printf("[DEBUG] DeleteVPNServerClientWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] DeleteVPNServerClientWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("DeleteVPNServerClientWithContext failed", err, response).Diagnostics()
 		}
 
 		if err = d.Set("status_code", response.StatusCode); err != nil {
@@ -161,7 +161,7 @@ func resourceIBMIsVPNServerClientDelete(context context.Context, d *schema.Resou
 			return nil
 		}
 		log.Printf("[DEBUG] GetVPNServerClientWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerClientWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetVPNServerClientWithContext failed", err, response).Diagnostics()
 	}
 
 	deleteVPNServerClientOptions := &vpcv1.DeleteVPNServerClientOptions{}
@@ -171,7 +171,7 @@ func resourceIBMIsVPNServerClientDelete(context context.Context, d *schema.Resou
 	response, err = sess.DeleteVPNServerClientWithContext(context, deleteVPNServerClientOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteVPNServerClientWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] DeleteVPNServerClientWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteVPNServerClientWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId("")
@@ -12,6 +12,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -209,7 +210,7 @@ func resourceIBMIsVPNServerRouteCreate(context context.Context, d *schema.Resour
 	vpnServerRoute, response, err := sess.CreateVPNServerRouteWithContext(context, createVPNServerRouteOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateVPNServerRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] CreateVPNServerRouteWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("CreateVPNServerRouteWithContext failed", err, response).Diagnostics()
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *createVPNServerRouteOptions.VPNServerID, *vpnServerRoute.ID))
@@ -242,7 +243,7 @@ func isWaitForVPNServerRouteStable(context context.Context, sess *vpcv1.VpcV1, d
 			vpnServerRoute, response, err := sess.GetVPNServerRouteWithContext(context, getVPNServerRouteOptions)
 			if err != nil {
 				log.Printf("[DEBUG] GetVPNServerRouteWithContext failed %s\n%s", err, response)
-				return vpnServerRoute, "", fmt.Errorf("Error Getting VPC Server Route: %s\n%s", err, response)
+				return vpnServerRoute, "", flex.NewServiceError("Error Getting VPC Server Route", err, response)
 			}
 
 			if *vpnServerRoute.LifecycleState == "stable" || *vpnServerRoute.LifecycleState == "failed" {
@@ -280,7 +281,7 @@ func resourceIBMIsVPNServerRouteRead(context context.Context, d *schema.Resource
 			return nil
 		}
 		log.Printf("[DEBUG] GetVPNServerRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerRouteWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("GetVPNServerRouteWithContext failed", err, response).Diagnostics()
 	}
 
 	if err = d.Set("vpn_server", getVPNServerRouteOptions.VPNServerID); err != nil {
@@ -358,7 +359,7 @@ func resourceIBMIsVPNServerRouteUpdate(context context.Context, d *schema.Resour
 		_, response, err := sess.UpdateVPNServerRouteWithContext(context, updateVPNServerRouteOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateVPNServerRouteWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] UpdateVPNServerRouteWithContext failed %s\n%s", err, response))
+			return flex.NewServiceError("UpdateVPNServerRouteWithContext failed", err, response).WithAttribute(cty.GetAttrPath("name")).Diagnostics()
 		}
 		_, err = isWaitForVPNServerRouteStable(context, sess, d, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -388,7 +389,7 @@ func resourceIBMIsVPNServerRouteDelete(context context.Context, d *schema.Resour
 	response, err := sess.DeleteVPNServerRouteWithContext(context, deleteVPNServerRouteOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteVPNServerRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("[ERROR] DeleteVPNServerRouteWithContext failed %s\n%s", err, response))
+		return flex.NewServiceError("DeleteVPNServerRouteWithContext failed", err, response).Diagnostics()
 	}
 	_, err = isWaitForVPNServerRouteDeleted(context, sess, d)
 	if err != nil {
@@ -422,7 +423,7 @@ func isWaitForVPNServerRouteDeleted(context context.Context, sess *vpcv1.VpcV1,
 				if response != nil && response.StatusCode == 404 {
 					return vpnServerRoute, isVPNServerRouteStatusDeleted, nil
 				}
-				return vpnServerRoute, *vpnServerRoute.LifecycleState, fmt.Errorf("The VPC route %s failed to delete: %s\n%s", d.Id(), err, response)
+				return vpnServerRoute, *vpnServerRoute.LifecycleState, flex.NewServiceError(fmt.Sprintf("The VPC route %s failed to delete", d.Id()), err, response)
 			}
 			return vpnServerRoute, *vpnServerRoute.LifecycleState, nil
 
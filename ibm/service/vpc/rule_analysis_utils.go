@@ -0,0 +1,532 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"reflect"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ruleFindingError   = "error"
+	ruleFindingWarning = "warning"
+
+	isRuleAnalysis = "rule_analysis"
+	isRuleFindings = "rule_findings"
+
+	// rule_analysis modes: by default only unreachable network ACL rules fail
+	// the plan, strict fails it on any finding and warn never fails it.
+	ruleAnalysisDefault = "default"
+	ruleAnalysisStrict  = "strict"
+	ruleAnalysisWarn    = "warn"
+
+	// ruleAnyValue marks an icmp type or code that matches every value
+	ruleAnyValue = -1
+)
+
+// analyzedRule is the protocol independent form of a network ACL or security
+// group rule used to reason about which traffic the rule matches.
+type analyzedRule struct {
+	index         int
+	name          string
+	action        string
+	direction     string
+	source        string
+	destination   string
+	protocol      string
+	icmpType      int
+	icmpCode      int
+	portMin       int
+	portMax       int
+	sourcePortMin int
+	sourcePortMax int
+}
+
+// ruleFinding describes a problem found in a rule set, for the rule at index.
+type ruleFinding struct {
+	severity string
+	index    int
+	name     string
+	message  string
+}
+
+func newAnalyzedRule(index int, name, action, direction, source, destination, protocol string) analyzedRule {
+	return analyzedRule{
+		index:         index,
+		name:          name,
+		action:        action,
+		direction:     direction,
+		source:        source,
+		destination:   destination,
+		protocol:      protocol,
+		icmpType:      ruleAnyValue,
+		icmpCode:      ruleAnyValue,
+		portMin:       1,
+		portMax:       65535,
+		sourcePortMin: 1,
+		sourcePortMax: 65535,
+	}
+}
+
+func (r analyzedRule) label() string {
+	if r.name != "" {
+		return fmt.Sprintf("%q", r.name)
+	}
+	return fmt.Sprintf("#%d", r.index)
+}
+
+// parseRuleAddress returns the network of an IP address or CIDR block, or nil
+// if the value is neither, such as a security group identifier.
+func parseRuleAddress(s string) *net.IPNet {
+	if _, ipNet, err := net.ParseCIDR(s); err == nil {
+		return ipNet
+	}
+	if ip := net.ParseIP(s); ip != nil {
+		if ip4 := ip.To4(); ip4 != nil {
+			return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
+	}
+	return nil
+}
+
+// addressContains reports whether every address matched by b is matched by a.
+func addressContains(a, b string) bool {
+	netA, netB := parseRuleAddress(a), parseRuleAddress(b)
+	if netA == nil || netB == nil {
+		return a == b
+	}
+	onesA, bitsA := netA.Mask.Size()
+	onesB, bitsB := netB.Mask.Size()
+	return bitsA == bitsB && onesA <= onesB && netA.Contains(netB.IP)
+}
+
+func addressOverlaps(a, b string) bool {
+	netA, netB := parseRuleAddress(a), parseRuleAddress(b)
+	if netA == nil || netB == nil {
+		return a == b
+	}
+	return netA.Contains(netB.IP) || netB.Contains(netA.IP)
+}
+
+// covers reports whether every packet matched by b is also matched by a.
+func (a analyzedRule) covers(b analyzedRule) bool {
+	if a.direction != b.direction || !addressContains(a.source, b.source) || !addressContains(a.destination, b.destination) {
+		return false
+	}
+	if a.protocol == "all" {
+		return true
+	}
+	if a.protocol != b.protocol {
+		return false
+	}
+	switch a.protocol {
+	case "icmp":
+		return (a.icmpType == ruleAnyValue || a.icmpType == b.icmpType) && (a.icmpCode == ruleAnyValue || a.icmpCode == b.icmpCode)
+	case "tcp", "udp":
+		return a.portMin <= b.portMin && a.portMax >= b.portMax && a.sourcePortMin <= b.sourcePortMin && a.sourcePortMax >= b.sourcePortMax
+	}
+	return true
+}
+
+// overlaps reports whether some packet is matched by both a and b.
+func (a analyzedRule) overlaps(b analyzedRule) bool {
+	if a.direction != b.direction || !addressOverlaps(a.source, b.source) || !addressOverlaps(a.destination, b.destination) {
+		return false
+	}
+	if a.protocol == "all" || b.protocol == "all" {
+		return true
+	}
+	if a.protocol != b.protocol {
+		return false
+	}
+	switch a.protocol {
+	case "icmp":
+		return (a.icmpType == ruleAnyValue || b.icmpType == ruleAnyValue || a.icmpType == b.icmpType) &&
+			(a.icmpCode == ruleAnyValue || b.icmpCode == ruleAnyValue || a.icmpCode == b.icmpCode)
+	case "tcp", "udp":
+		return a.portMin <= b.portMax && b.portMin <= a.portMax && a.sourcePortMin <= b.sourcePortMax && b.sourcePortMin <= a.sourcePortMax
+	}
+	return true
+}
+
+func (a analyzedRule) equals(b analyzedRule) bool {
+	return a.covers(b) && b.covers(a) && a.action == b.action
+}
+
+// ruleActionVerb returns the third person and past tense forms of a rule action.
+func ruleActionVerb(action string) (string, string) {
+	if action == "deny" {
+		return "denies", "denied"
+	}
+	return action + "s", action + "ed"
+}
+
+// shadowDescription explains why the earlier rule hides the later one.
+func shadowDescription(earlier, later analyzedRule) string {
+	if earlier.equals(later) {
+		return fmt.Sprintf("duplicates rule %s", earlier.label())
+	}
+	if earlier.protocol == "all" && later.protocol != "all" {
+		return fmt.Sprintf("is masked by the all protocol rule %s", earlier.label())
+	}
+	return fmt.Sprintf("is shadowed by rule %s", earlier.label())
+}
+
+// analyzeNetworkACLRules evaluates an ordered network ACL rule set. A rule that
+// can never be reached because an earlier rule matches all of its traffic is an
+// error when the actions differ, since the configured action never applies, and
+// a warning when it is merely redundant. A rule that partially overlaps an
+// earlier rule with a different action is a warning.
+func analyzeNetworkACLRules(rules []analyzedRule) []ruleFinding {
+	findings := make([]ruleFinding, 0)
+	for j, later := range rules {
+		shadowed := false
+		for _, earlier := range rules[:j] {
+			if !earlier.covers(later) {
+				continue
+			}
+			shadowed = true
+			if earlier.action != later.action {
+				verb, _ := ruleActionVerb(earlier.action)
+				findings = append(findings, ruleFinding{
+					severity: ruleFindingError,
+					index:    later.index,
+					name:     later.name,
+					message: fmt.Sprintf("rule %s %s which %s all of its traffic first, so the rule is unreachable and its %s action never applies",
+						later.label(), shadowDescription(earlier, later), verb, later.action),
+				})
+			} else {
+				findings = append(findings, ruleFinding{
+					severity: ruleFindingWarning,
+					index:    later.index,
+					name:     later.name,
+					message:  fmt.Sprintf("rule %s %s and is never evaluated", later.label(), shadowDescription(earlier, later)),
+				})
+			}
+			break
+		}
+		if shadowed {
+			continue
+		}
+		for _, earlier := range rules[:j] {
+			if earlier.action != later.action && earlier.overlaps(later) {
+				_, earlierVerb := ruleActionVerb(earlier.action)
+				_, laterVerb := ruleActionVerb(later.action)
+				findings = append(findings, ruleFinding{
+					severity: ruleFindingWarning,
+					index:    later.index,
+					name:     later.name,
+					message: fmt.Sprintf("rule %s overlaps rule %s, so the overlapping traffic is %s by rule %s instead of %s",
+						later.label(), earlier.label(), earlierVerb, earlier.label(), laterVerb),
+				})
+				break
+			}
+		}
+	}
+	return findings
+}
+
+// analyzeSecurityGroupRules evaluates a security group rule set. Security group
+// rules are unordered and only allow traffic, so a rule matching a subset of
+// the traffic of another rule is redundant and reported as a warning.
+func analyzeSecurityGroupRules(rules []analyzedRule) []ruleFinding {
+	findings := make([]ruleFinding, 0)
+	for j, rule := range rules {
+		for i, other := range rules {
+			if i == j || !other.covers(rule) {
+				continue
+			}
+			// report identical rules once, on the later one
+			if rule.covers(other) && i > j {
+				continue
+			}
+			findings = append(findings, ruleFinding{
+				severity: ruleFindingWarning,
+				index:    rule.index,
+				name:     rule.name,
+				message:  fmt.Sprintf("rule %s %s and has no effect", rule.label(), shadowDescription(other, rule)),
+			})
+			break
+		}
+	}
+	return findings
+}
+
+// reportRuleFindings logs every finding and returns the ones that fail the
+// plan under the rule_analysis mode as a single error.
+func reportRuleFindings(resource, mode string, findings []ruleFinding) error {
+	errs := make([]string, 0)
+	for _, finding := range findings {
+		if mode == ruleAnalysisStrict || (mode != ruleAnalysisWarn && finding.severity == ruleFindingError) {
+			errs = append(errs, finding.message)
+			continue
+		}
+		log.Printf("[WARN] %s: %s", resource, finding.message)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("[ERROR] %s has conflicting or redundant rules, set %s = \"%s\" to only report them:\n%s", resource, isRuleAnalysis, ruleAnalysisWarn, strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+func flattenRuleFindings(findings []ruleFinding) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(findings))
+	for _, finding := range findings {
+		result = append(result, map[string]interface{}{
+			"severity":  finding.severity,
+			"index":     finding.index,
+			"rule_name": finding.name,
+			"message":   finding.message,
+		})
+	}
+	return result
+}
+
+// ruleAnalysisSchema is the rule_analysis argument shared by ibm_is_network_acl
+// and ibm_is_security_group.
+func ruleAnalysisSchema(resource string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validate.InvokeValidator(resource, isRuleAnalysis),
+		Description:  "How findings of the rule analysis are handled: default fails the plan only on unreachable rules, strict fails it on any finding and warn never fails it",
+	}
+}
+
+// ruleFindingsSchema is the rule_findings attribute shared by ibm_is_network_acl
+// and ibm_is_security_group.
+func ruleFindingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The problems found by the rule analysis, such as duplicate, shadowed or unreachable rules",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"severity": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The severity of the finding, error or warning.",
+				},
+				"index": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The index of the rule in the rules list.",
+				},
+				"rule_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the rule, if any.",
+				},
+				"message": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The description of the finding.",
+				},
+			},
+		},
+	}
+}
+
+// setRuleFindings plans the rule_findings attribute and reports the findings.
+// When some rules are not known yet, the findings are computed on apply.
+func setRuleFindings(diff *schema.ResourceDiff, resource string, findings []ruleFinding, complete bool) error {
+	if complete {
+		if err := diff.SetNew(isRuleFindings, flattenRuleFindings(findings)); err != nil {
+			return err
+		}
+	} else if err := diff.SetNewComputed(isRuleFindings); err != nil {
+		return err
+	}
+	return reportRuleFindings(resource, diff.Get(isRuleAnalysis).(string), findings)
+}
+
+// ruleFieldsKnown reports whether the values of the listed fields of the rule at
+// path are known at plan time. Rules with unknown values are left out of the analysis.
+func ruleFieldsKnown(diff *schema.ResourceDiff, path string, fields ...string) bool {
+	for _, field := range fields {
+		if !diff.NewValueKnown(fmt.Sprintf("%s.%s", path, field)) {
+			return false
+		}
+	}
+	return true
+}
+
+// networkACLRulesCustomizeDiff analyzes the inline rules of ibm_is_network_acl
+// whenever they change.
+func networkACLRulesCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.HasChange(isNetworkACLRules) && !diff.HasChange(isRuleAnalysis) {
+		return nil
+	}
+	if !diff.NewValueKnown(isNetworkACLRules) {
+		return diff.SetNewComputed(isRuleFindings)
+	}
+	complete := true
+	rules := make([]analyzedRule, 0)
+	for i, ruleIntf := range diff.Get(isNetworkACLRules).([]interface{}) {
+		path := fmt.Sprintf("%s.%d", isNetworkACLRules, i)
+		if !ruleFieldsKnown(diff, path, isNetworkACLRuleName, isNetworkACLRuleAction, isNetworkACLRuleDirection, isNetworkACLRuleSource, isNetworkACLRuleDestination, isNetworkACLRuleICMP, isNetworkACLRuleTCP, isNetworkACLRuleUDP) {
+			complete = false
+			continue
+		}
+		rule, ok := ruleIntf.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rules = append(rules, networkACLRuleFromMap(i, rule))
+	}
+	return setRuleFindings(diff, fmt.Sprintf("ibm_is_network_acl %s", diff.Get(isNetworkACLName).(string)), analyzeNetworkACLRules(rules), complete)
+}
+
+// securityGroupRulesCustomizeDiff analyzes the inline rules of ibm_is_security_group
+// whenever they change.
+func securityGroupRulesCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.HasChange(isSecurityGroupRules) && !diff.HasChange(isRuleAnalysis) {
+		return nil
+	}
+	if !diff.NewValueKnown(isSecurityGroupRules) {
+		return diff.SetNewComputed(isRuleFindings)
+	}
+	complete := true
+	rules := make([]analyzedRule, 0)
+	for i, ruleIntf := range diff.Get(isSecurityGroupRules).([]interface{}) {
+		path := fmt.Sprintf("%s.%d", isSecurityGroupRules, i)
+		if !ruleFieldsKnown(diff, path, isSecurityGroupRuleDirection, isSecurityGroupRuleRemote, isSecurityGroupRuleProtocol, isSecurityGroupRuleType, isSecurityGroupRuleCode, isSecurityGroupRulePortMin, isSecurityGroupRulePortMax) {
+			complete = false
+			continue
+		}
+		rule, ok := ruleIntf.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rules = append(rules, securityGroupRuleFromMap(i, rule))
+	}
+	return setRuleFindings(diff, fmt.Sprintf("ibm_is_security_group %s", diff.Get(isSecurityGroupName).(string)), analyzeSecurityGroupRules(rules), complete)
+}
+
+// networkACLRuleFromMap converts an element of the rules attribute of
+// ibm_is_network_acl, applying the same defaults as createInlineRules.
+func networkACLRuleFromMap(index int, rule map[string]interface{}) analyzedRule {
+	name, _ := rule[isNetworkACLRuleName].(string)
+	action, _ := rule[isNetworkACLRuleAction].(string)
+	direction, _ := rule[isNetworkACLRuleDirection].(string)
+	source, _ := rule[isNetworkACLRuleSource].(string)
+	destination, _ := rule[isNetworkACLRuleDestination].(string)
+	r := newAnalyzedRule(index, name, action, strings.ToLower(direction), source, destination, "all")
+
+	icmp, _ := rule[isNetworkACLRuleICMP].([]interface{})
+	tcp, _ := rule[isNetworkACLRuleTCP].([]interface{})
+	udp, _ := rule[isNetworkACLRuleUDP].([]interface{})
+	if len(icmp) > 0 {
+		r.protocol = "icmp"
+		if icmpval, ok := icmp[0].(map[string]interface{}); ok {
+			if val, ok := icmpval[isNetworkACLRuleICMPType].(int); ok {
+				r.icmpType = val
+			}
+			if val, ok := icmpval[isNetworkACLRuleICMPCode].(int); ok {
+				r.icmpCode = val
+			}
+		}
+	} else if len(tcp) > 0 || len(udp) > 0 {
+		ports := tcp
+		r.protocol = "tcp"
+		if len(tcp) == 0 {
+			ports = udp
+			r.protocol = "udp"
+		}
+		if portval, ok := ports[0].(map[string]interface{}); ok {
+			r.portMin = ruleIntValue(portval, isNetworkACLRulePortMin, 1)
+			r.portMax = ruleIntValue(portval, isNetworkACLRulePortMax, 65535)
+			r.sourcePortMin = ruleIntValue(portval, isNetworkACLRuleSourcePortMin, 1)
+			r.sourcePortMax = ruleIntValue(portval, isNetworkACLRuleSourcePortMax, 65535)
+		}
+	}
+	return r
+}
+
+// securityGroupRuleFromMap converts an element of the rules attribute of
+// ibm_is_security_group, applying the same defaults as securityGroupRuleKey.
+func securityGroupRuleFromMap(index int, rule map[string]interface{}) analyzedRule {
+	direction, _ := rule[isSecurityGroupRuleDirection].(string)
+	protocol, _ := rule[isSecurityGroupRuleProtocol].(string)
+	if protocol == "" {
+		protocol = "all"
+	}
+	remote, _ := rule[isSecurityGroupRuleRemote].(string)
+	if remote == "" {
+		remote = "0.0.0.0/0"
+	}
+	r := newAnalyzedRule(index, "", "allow", direction, remote, "0.0.0.0/0", protocol)
+	switch protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		if icmpType, _ := rule[isSecurityGroupRuleType].(int); icmpType != 0 {
+			r.icmpType = icmpType
+		}
+		if icmpCode, _ := rule[isSecurityGroupRuleCode].(int); icmpCode != 0 {
+			r.icmpCode = icmpCode
+		}
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		r.portMin, r.portMax = securityGroupRulePortRange(rule)
+	}
+	return r
+}
+
+func ruleIntValue(m map[string]interface{}, key string, def int) int {
+	if val, ok := m[key].(int); ok && val != 0 {
+		return val
+	}
+	return def
+}
+
+func int64RuleValue(ptr *int64, def int) int {
+	if ptr == nil {
+		return def
+	}
+	return int(*ptr)
+}
+
+// networkACLRuleFromItem converts a network ACL rule returned by the API.
+func networkACLRuleFromItem(index int, ruleItem vpcv1.NetworkACLRuleItemIntf) (analyzedRule, bool) {
+	switch reflect.TypeOf(ruleItem).String() {
+	case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp":
+		rule := ruleItem.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp)
+		r := newAnalyzedRule(index, *rule.Name, *rule.Action, *rule.Direction, *rule.Source, *rule.Destination, "icmp")
+		r.icmpType = int64RuleValue(rule.Type, ruleAnyValue)
+		r.icmpCode = int64RuleValue(rule.Code, ruleAnyValue)
+		return r, true
+	case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp":
+		rule := ruleItem.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp)
+		r := newAnalyzedRule(index, *rule.Name, *rule.Action, *rule.Direction, *rule.Source, *rule.Destination, *rule.Protocol)
+		r.portMin = int64RuleValue(rule.DestinationPortMin, 1)
+		r.portMax = int64RuleValue(rule.DestinationPortMax, 65535)
+		r.sourcePortMin = int64RuleValue(rule.SourcePortMin, 1)
+		r.sourcePortMax = int64RuleValue(rule.SourcePortMax, 65535)
+		return r, true
+	case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll":
+		rule := ruleItem.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll)
+		return newAnalyzedRule(index, *rule.Name, *rule.Action, *rule.Direction, *rule.Source, *rule.Destination, "all"), true
+	}
+	return analyzedRule{}, false
+}
+
+// networkACLRuleID returns the identifier of a network ACL rule returned by the API.
+func networkACLRuleID(ruleItem vpcv1.NetworkACLRuleItemIntf) string {
+	switch reflect.TypeOf(ruleItem).String() {
+	case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp":
+		return *ruleItem.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp).ID
+	case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp":
+		return *ruleItem.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp).ID
+	case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll":
+		return *ruleItem.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll).ID
+	}
+	return ""
+}
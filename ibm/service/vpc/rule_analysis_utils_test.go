@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"strings"
+	"testing"
+)
+
+func testNACLRule(index int, name, action, source, destination, protocol string, portMin, portMax int) analyzedRule {
+	r := newAnalyzedRule(index, name, action, "inbound", source, destination, protocol)
+	if portMin != 0 {
+		r.portMin, r.portMax = portMin, portMax
+	}
+	return r
+}
+
+func TestAnalyzeNetworkACLRules(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rules    []analyzedRule
+		severity []string
+		contains []string
+	}{
+		{
+			name: "allow below deny",
+			rules: []analyzedRule{
+				testNACLRule(0, "deny-all", "deny", "0.0.0.0/0", "0.0.0.0/0", "all", 0, 0),
+				testNACLRule(1, "allow-ssh", "allow", "10.0.0.0/8", "0.0.0.0/0", "tcp", 22, 22),
+			},
+			severity: []string{ruleFindingError},
+			contains: []string{`rule "allow-ssh" is masked by the all protocol rule "deny-all" which denies all of its traffic first`},
+		},
+		{
+			name: "duplicate",
+			rules: []analyzedRule{
+				testNACLRule(0, "allow-https", "allow", "10.0.0.0/8", "0.0.0.0/0", "tcp", 443, 443),
+				testNACLRule(1, "allow-https-2", "allow", "10.0.0.0/8", "0.0.0.0/0", "tcp", 443, 443),
+			},
+			severity: []string{ruleFindingWarning},
+			contains: []string{`duplicates rule "allow-https"`},
+		},
+		{
+			name: "overlapping port range",
+			rules: []analyzedRule{
+				testNACLRule(0, "deny-low", "deny", "0.0.0.0/0", "0.0.0.0/0", "tcp", 1, 1024),
+				testNACLRule(1, "allow-range", "allow", "0.0.0.0/0", "0.0.0.0/0", "tcp", 1000, 2000),
+			},
+			severity: []string{ruleFindingWarning},
+			contains: []string{`rule "allow-range" overlaps rule "deny-low", so the overlapping traffic is denied`},
+		},
+		{
+			name: "disjoint rules",
+			rules: []analyzedRule{
+				testNACLRule(0, "allow-ssh", "allow", "10.0.0.0/8", "0.0.0.0/0", "tcp", 22, 22),
+				testNACLRule(1, "deny-udp", "deny", "0.0.0.0/0", "0.0.0.0/0", "udp", 0, 0),
+				testNACLRule(2, "deny-other-net", "deny", "192.168.0.0/16", "0.0.0.0/0", "all", 0, 0),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			findings := analyzeNetworkACLRules(tc.rules)
+			if len(findings) != len(tc.severity) {
+				t.Fatalf("expected %d findings, got %d: %v", len(tc.severity), len(findings), findings)
+			}
+			for i, finding := range findings {
+				if finding.severity != tc.severity[i] {
+					t.Errorf("expected severity %s, got %s", tc.severity[i], finding.severity)
+				}
+				if !strings.Contains(finding.message, tc.contains[i]) {
+					t.Errorf("expected message to contain %q, got %q", tc.contains[i], finding.message)
+				}
+			}
+		})
+	}
+}
+
+func TestAnalyzeSecurityGroupRules(t *testing.T) {
+	rules := []analyzedRule{
+		securityGroupRuleFromMap(0, map[string]interface{}{"direction": "inbound", "protocol": "tcp", "port_min": 22, "port_max": 22, "remote": "10.0.0.0/8"}),
+		securityGroupRuleFromMap(1, map[string]interface{}{"direction": "inbound", "remote": "10.0.0.0/8"}),
+		securityGroupRuleFromMap(2, map[string]interface{}{"direction": "outbound"}),
+		securityGroupRuleFromMap(3, map[string]interface{}{"direction": "outbound", "remote": "0.0.0.0/0"}),
+	}
+	findings := analyzeSecurityGroupRules(rules)
+	if len(findings) != 2 {
+		t.Fatalf("expected 2 findings, got %d: %v", len(findings), findings)
+	}
+	if findings[0].index != 0 || !strings.Contains(findings[0].message, "is masked by the all protocol rule #1") {
+		t.Errorf("unexpected finding %v", findings[0])
+	}
+	if findings[1].index != 3 || !strings.Contains(findings[1].message, "duplicates rule #2") {
+		t.Errorf("unexpected finding %v", findings[1])
+	}
+	if err := reportRuleFindings("ibm_is_security_group test", "", findings); err != nil {
+		t.Errorf("expected only warnings, got %s", err)
+	}
+}
+
+func TestReportRuleFindings(t *testing.T) {
+	findings := []ruleFinding{
+		{severity: ruleFindingWarning, index: 1, message: "redundant rule"},
+		{severity: ruleFindingError, index: 2, message: "unreachable rule"},
+	}
+	testCases := []struct {
+		mode     string
+		findings []ruleFinding
+		err      string
+	}{
+		{mode: "", findings: findings[:1]},
+		{mode: ruleAnalysisDefault, findings: findings, err: "unreachable rule"},
+		{mode: ruleAnalysisStrict, findings: findings[:1], err: "redundant rule"},
+		{mode: ruleAnalysisWarn, findings: findings},
+	}
+	for _, tc := range testCases {
+		err := reportRuleFindings("ibm_is_network_acl test", tc.mode, tc.findings)
+		if tc.err == "" {
+			if err != nil {
+				t.Errorf("mode %q: unexpected error %s", tc.mode, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("mode %q: expected error containing %q, got %v", tc.mode, tc.err, err)
+		}
+	}
+
+	flattened := flattenRuleFindings(findings)
+	if len(flattened) != 2 || flattened[1]["index"] != 2 || flattened[1]["severity"] != ruleFindingError {
+		t.Errorf("unexpected flattened findings %v", flattened)
+	}
+}
+
+func TestNetworkACLRuleCovers(t *testing.T) {
+	rule := testNACLRule(0, "allow-web", "allow", "0.0.0.0/0", "10.240.0.0/24", "tcp", 80, 443)
+	traffic := testNACLRule(0, "", "", "203.0.113.5", "10.240.0.10", "tcp", 443, 443)
+	if !rule.covers(traffic) {
+		t.Errorf("expected %v to match %v", rule, traffic)
+	}
+	traffic.destination = "10.240.1.10"
+	if rule.covers(traffic) {
+		t.Errorf("expected %v not to match %v", rule, traffic)
+	}
+}
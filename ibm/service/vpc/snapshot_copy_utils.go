@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// snapshotCRNParts returns the region and the ID of a snapshot CRN,
+// crn:v1:<cname>:<ctype>:is:<region>:a/<account>::snapshot:<id>
+func snapshotCRNParts(crn string) (string, string, error) {
+	parts := strings.Split(crn, ":")
+	if len(parts) != 10 || parts[0] != "crn" || parts[4] != "is" || parts[8] != "snapshot" || parts[5] == "" || parts[9] == "" {
+		return "", "", fmt.Errorf("[ERROR] %q is not a snapshot CRN", crn)
+	}
+	return parts[5], parts[9], nil
+}
+
+// snapshotCopyEncryptionKey returns the key of the copy of a snapshot encrypted with sourceKey,
+// which is encryptionKey if set, else the entry of sourceKey in keyMap. A snapshot encrypted
+// with a provider managed key (empty sourceKey) is copied with a provider managed key.
+func snapshotCopyEncryptionKey(sourceKey, encryptionKey string, keyMap map[string]interface{}) (string, error) {
+	if encryptionKey != "" || sourceKey == "" {
+		return encryptionKey, nil
+	}
+	if key, ok := keyMap[sourceKey]; ok && key.(string) != "" {
+		return key.(string), nil
+	}
+	return "", fmt.Errorf("[ERROR] The source snapshot is encrypted with the user managed key %s, set encryption_key or add the key to encryption_key_map", sourceKey)
+}
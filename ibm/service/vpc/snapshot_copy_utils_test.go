@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"testing"
+)
+
+func TestSnapshotCRNParts(t *testing.T) {
+	region, id, err := snapshotCRNParts("crn:v1:bluemix:public:is:eu-de:a/123456::snapshot:r010-4d8b2c5e")
+	if err != nil || region != "eu-de" || id != "r010-4d8b2c5e" {
+		t.Errorf("expected eu-de and r010-4d8b2c5e, got %q and %q (%v)", region, id, err)
+	}
+	for _, crn := range []string{
+		"",
+		"r010-4d8b2c5e",
+		"crn:v1:bluemix:public:is:eu-de:a/123456::volume:r010-4d8b2c5e",
+		"crn:v1:bluemix:public:is::a/123456::snapshot:r010-4d8b2c5e",
+		"crn:v1:bluemix:public:is:eu-de:a/123456::snapshot:",
+	} {
+		if _, _, err := snapshotCRNParts(crn); err == nil {
+			t.Errorf("expected an error for %q", crn)
+		}
+	}
+}
+
+func TestSnapshotCopyEncryptionKey(t *testing.T) {
+	keyMap := map[string]interface{}{"source-key": "target-key"}
+	testCases := []struct {
+		sourceKey     string
+		encryptionKey string
+		expected      string
+		err           bool
+	}{
+		{sourceKey: "", encryptionKey: "", expected: ""},
+		{sourceKey: "", encryptionKey: "key", expected: "key"},
+		{sourceKey: "source-key", encryptionKey: "key", expected: "key"},
+		{sourceKey: "source-key", encryptionKey: "", expected: "target-key"},
+		{sourceKey: "other-key", encryptionKey: "", err: true},
+	}
+	for _, tc := range testCases {
+		key, err := snapshotCopyEncryptionKey(tc.sourceKey, tc.encryptionKey, keyMap)
+		if (err != nil) != tc.err || key != tc.expected {
+			t.Errorf("snapshotCopyEncryptionKey(%q, %q): expected %q (error %t), got %q (%v)", tc.sourceKey, tc.encryptionKey, tc.expected, tc.err, key, err)
+		}
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// vpnPeerConfigPSKPlaceholder is rendered in place of the preshared key unless
+// the key is explicitly requested.
+const vpnPeerConfigPSKPlaceholder = "REPLACE_WITH_PSK"
+
+// vpnPeerIKEProposal and vpnPeerIPsecProposal hold the policy values as the
+// VPC API reports them. A nil proposal means the connection uses
+// auto-negotiation and the peer may offer its defaults.
+type vpnPeerIKEProposal struct {
+	EncryptionAlgorithm     string `json:"encryption_algorithm"`
+	AuthenticationAlgorithm string `json:"authentication_algorithm"`
+	DhGroup                 int    `json:"dh_group"`
+	KeyLifetime             int    `json:"key_lifetime"`
+	NegotiationMode         string `json:"negotiation_mode,omitempty"`
+}
+
+type vpnPeerIPsecProposal struct {
+	EncryptionAlgorithm     string `json:"encryption_algorithm"`
+	AuthenticationAlgorithm string `json:"authentication_algorithm"`
+	Pfs                     string `json:"pfs"`
+	KeyLifetime             int    `json:"key_lifetime"`
+	TransformProtocol       string `json:"transform_protocol,omitempty"`
+	EncapsulationMode       string `json:"encapsulation_mode,omitempty"`
+}
+
+type vpnPeerDeadPeerDetection struct {
+	Action   string `json:"action"`
+	Interval int    `json:"interval"`
+	Timeout  int    `json:"timeout"`
+}
+
+// vpnPeerConfig describes a VPN gateway connection from the point of view of
+// the peer, so local is the on-premises side and remote is the VPN gateway.
+type vpnPeerConfig struct {
+	Name              string                    `json:"name"`
+	Mode              string                    `json:"mode"`
+	IkeVersion        int                       `json:"ike_version"`
+	LocalAddress      string                    `json:"local_address"`
+	LocalCIDRs        []string                  `json:"local_cidrs"`
+	RemoteAddresses   []string                  `json:"remote_addresses"`
+	RemoteCIDRs       []string                  `json:"remote_cidrs"`
+	PSK               string                    `json:"psk"`
+	IKE               *vpnPeerIKEProposal       `json:"ike"`
+	IPsec             *vpnPeerIPsecProposal     `json:"ipsec"`
+	DeadPeerDetection *vpnPeerDeadPeerDetection `json:"dead_peer_detection"`
+}
+
+var vpnPeerDhGroups = map[int]string{
+	2:  "modp1024",
+	5:  "modp1536",
+	14: "modp2048",
+	15: "modp3072",
+	16: "modp4096",
+	17: "modp6144",
+	18: "modp8192",
+	19: "ecp256",
+	20: "ecp384",
+	21: "ecp521",
+	22: "modp1024s160",
+	23: "modp2048s224",
+	24: "modp2048s256",
+	31: "curve25519",
+}
+
+func vpnPeerDhGroupName(group int) string {
+	if name, ok := vpnPeerDhGroups[group]; ok {
+		return name
+	}
+	return fmt.Sprintf("dh%d", group)
+}
+
+// vpnPeerPfsGroup turns an IPsec policy pfs value such as group_14 into its
+// Diffie-Hellman group number, or 0 if PFS is disabled.
+func vpnPeerPfsGroup(pfs string) int {
+	group, err := strconv.Atoi(strings.TrimPrefix(pfs, "group_"))
+	if err != nil {
+		return 0
+	}
+	return group
+}
+
+func vpnPeerIsGCM(encryption string) bool {
+	return strings.HasSuffix(encryption, "gcm16")
+}
+
+func (c vpnPeerConfig) strongswanIKEProposal() string {
+	if c.IKE == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s-%s-%s", c.IKE.EncryptionAlgorithm, c.IKE.AuthenticationAlgorithm, vpnPeerDhGroupName(c.IKE.DhGroup))
+}
+
+func (c vpnPeerConfig) strongswanESPProposal() string {
+	if c.IPsec == nil {
+		return ""
+	}
+	proposal := c.IPsec.EncryptionAlgorithm
+	if !vpnPeerIsGCM(proposal) {
+		proposal += "-" + c.IPsec.AuthenticationAlgorithm
+	}
+	if group := vpnPeerPfsGroup(c.IPsec.Pfs); group != 0 {
+		proposal += "-" + vpnPeerDhGroupName(group)
+	}
+	return proposal
+}
+
+// libreswan spells the SHA-2 family as sha2_N, GCM as aes_gcmN and elliptic
+// curve groups as ecp_N.
+func vpnPeerLibreswanAlgorithm(algorithm string) string {
+	switch {
+	case strings.HasPrefix(algorithm, "sha") && algorithm != "sha1":
+		return "sha2_" + strings.TrimPrefix(algorithm, "sha")
+	case vpnPeerIsGCM(algorithm):
+		return "aes_gcm" + strings.TrimSuffix(strings.TrimPrefix(algorithm, "aes"), "gcm16")
+	case strings.HasPrefix(algorithm, "ecp"):
+		return "ecp_" + strings.TrimPrefix(algorithm, "ecp")
+	}
+	return algorithm
+}
+
+func (c vpnPeerConfig) libreswanIKEProposal() string {
+	if c.IKE == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s-%s-%s", c.IKE.EncryptionAlgorithm, vpnPeerLibreswanAlgorithm(c.IKE.AuthenticationAlgorithm), vpnPeerLibreswanAlgorithm(vpnPeerDhGroupName(c.IKE.DhGroup)))
+}
+
+func (c vpnPeerConfig) libreswanESPProposal() string {
+	if c.IPsec == nil {
+		return ""
+	}
+	proposal := vpnPeerLibreswanAlgorithm(c.IPsec.EncryptionAlgorithm)
+	if vpnPeerIsGCM(c.IPsec.EncryptionAlgorithm) {
+		proposal += "-null"
+	} else {
+		proposal += "-" + vpnPeerLibreswanAlgorithm(c.IPsec.AuthenticationAlgorithm)
+	}
+	if group := vpnPeerPfsGroup(c.IPsec.Pfs); group != 0 {
+		proposal += "-" + vpnPeerLibreswanAlgorithm(vpnPeerDhGroupName(group))
+	}
+	return proposal
+}
+
+// connectionName returns the name of the connection to the nth gateway
+// address, which is only suffixed when the gateway has several addresses.
+func (c vpnPeerConfig) connectionName(n int) string {
+	if len(c.RemoteAddresses) == 1 {
+		return c.Name
+	}
+	return fmt.Sprintf("%s-%d", c.Name, n+1)
+}
+
+// trafficSelectors returns the CIDRs to negotiate. Route mode connections
+// negotiate all traffic and leave the routing to a tunnel interface.
+func (c vpnPeerConfig) trafficSelectors() (local, remote string) {
+	if c.Mode == "route" {
+		return "0.0.0.0/0", "0.0.0.0/0"
+	}
+	return strings.Join(c.LocalCIDRs, ","), strings.Join(c.RemoteCIDRs, ",")
+}
+
+func (c vpnPeerConfig) header(b *strings.Builder) {
+	fmt.Fprintf(b, "# Peer configuration for VPN gateway connection %s\n", c.Name)
+	if c.Mode == "route" {
+		b.WriteString("# Route-based connection: route the VPC CIDRs through a VTI or XFRM interface bound to this tunnel.\n")
+	}
+	if c.IKE == nil || c.IPsec == nil {
+		b.WriteString("# The connection uses auto-negotiation for the policies without explicit proposals.\n")
+	}
+}
+
+func (c vpnPeerConfig) strongswanSwanctlConf() string {
+	var b strings.Builder
+	c.header(&b)
+	localTS, remoteTS := c.trafficSelectors()
+	b.WriteString("connections {\n")
+	for i, remote := range c.RemoteAddresses {
+		name := c.connectionName(i)
+		fmt.Fprintf(&b, "  %s {\n", name)
+		fmt.Fprintf(&b, "    version = %d\n", c.IkeVersion)
+		fmt.Fprintf(&b, "    local_addrs = %s\n", c.LocalAddress)
+		fmt.Fprintf(&b, "    remote_addrs = %s\n", remote)
+		if proposal := c.strongswanIKEProposal(); proposal != "" {
+			fmt.Fprintf(&b, "    proposals = %s\n", proposal)
+			fmt.Fprintf(&b, "    rekey_time = %ds\n", c.IKE.KeyLifetime)
+		}
+		if c.DeadPeerDetection != nil && c.DeadPeerDetection.Action != "none" {
+			fmt.Fprintf(&b, "    dpd_delay = %ds\n", c.DeadPeerDetection.Interval)
+			if c.IkeVersion == 1 {
+				fmt.Fprintf(&b, "    dpd_timeout = %ds\n", c.DeadPeerDetection.Timeout)
+			}
+		}
+		fmt.Fprintf(&b, "    local {\n      auth = psk\n      id = %s\n    }\n", c.LocalAddress)
+		fmt.Fprintf(&b, "    remote {\n      auth = psk\n      id = %s\n    }\n", remote)
+		b.WriteString("    children {\n")
+		fmt.Fprintf(&b, "      %s {\n", name)
+		fmt.Fprintf(&b, "        local_ts = %s\n", localTS)
+		fmt.Fprintf(&b, "        remote_ts = %s\n", remoteTS)
+		if proposal := c.strongswanESPProposal(); proposal != "" {
+			fmt.Fprintf(&b, "        esp_proposals = %s\n", proposal)
+			fmt.Fprintf(&b, "        rekey_time = %ds\n", c.IPsec.KeyLifetime)
+		}
+		if c.DeadPeerDetection != nil {
+			action := c.DeadPeerDetection.Action
+			if action == "hold" {
+				action = "trap"
+			}
+			fmt.Fprintf(&b, "        dpd_action = %s\n", action)
+		}
+		b.WriteString("        start_action = start\n")
+		b.WriteString("      }\n    }\n  }\n")
+	}
+	b.WriteString("}\n\nsecrets {\n")
+	for i, remote := range c.RemoteAddresses {
+		fmt.Fprintf(&b, "  ike-%s {\n    id-1 = %s\n    id-2 = %s\n    secret = %q\n  }\n", c.connectionName(i), c.LocalAddress, remote, c.PSK)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// ipsecConf renders the ipsec.conf style shared by strongSwan and libreswan.
+func (c vpnPeerConfig) ipsecConf(libreswan bool) string {
+	var b strings.Builder
+	c.header(&b)
+	localTS, remoteTS := c.trafficSelectors()
+	for i, remote := range c.RemoteAddresses {
+		fmt.Fprintf(&b, "\nconn %s\n", c.connectionName(i))
+		b.WriteString("  authby=secret\n")
+		switch {
+		case libreswan && c.IkeVersion == 1:
+			b.WriteString("  ikev2=no\n")
+		case libreswan:
+			b.WriteString("  ikev2=insist\n")
+		default:
+			fmt.Fprintf(&b, "  keyexchange=ikev%d\n", c.IkeVersion)
+		}
+		fmt.Fprintf(&b, "  left=%s\n  leftid=%s\n  leftsubnet=%s\n", c.LocalAddress, c.LocalAddress, localTS)
+		fmt.Fprintf(&b, "  right=%s\n  rightid=%s\n  rightsubnet=%s\n", remote, remote, remoteTS)
+		if c.IKE != nil {
+			if libreswan {
+				fmt.Fprintf(&b, "  ike=%s\n", c.libreswanIKEProposal())
+			} else {
+				fmt.Fprintf(&b, "  ike=%s!\n", c.strongswanIKEProposal())
+			}
+			fmt.Fprintf(&b, "  ikelifetime=%ds\n", c.IKE.KeyLifetime)
+		}
+		if c.IPsec != nil {
+			if libreswan {
+				fmt.Fprintf(&b, "  esp=%s\n", c.libreswanESPProposal())
+				if vpnPeerPfsGroup(c.IPsec.Pfs) != 0 {
+					b.WriteString("  pfs=yes\n")
+				} else {
+					b.WriteString("  pfs=no\n")
+				}
+				fmt.Fprintf(&b, "  salifetime=%ds\n", c.IPsec.KeyLifetime)
+			} else {
+				fmt.Fprintf(&b, "  esp=%s!\n", c.strongswanESPProposal())
+				fmt.Fprintf(&b, "  lifetime=%ds\n", c.IPsec.KeyLifetime)
+			}
+		}
+		if c.DeadPeerDetection != nil && c.DeadPeerDetection.Action != "none" {
+			fmt.Fprintf(&b, "  dpddelay=%ds\n  dpdtimeout=%ds\n  dpdaction=%s\n", c.DeadPeerDetection.Interval, c.DeadPeerDetection.Timeout, c.DeadPeerDetection.Action)
+		}
+		b.WriteString("  auto=start\n")
+	}
+	return b.String()
+}
+
+func (c vpnPeerConfig) ipsecSecrets() string {
+	var b strings.Builder
+	for _, remote := range c.RemoteAddresses {
+		fmt.Fprintf(&b, "%s %s : PSK %q\n", c.LocalAddress, remote, c.PSK)
+	}
+	return b.String()
+}
+
+func (c vpnPeerConfig) json() (string, error) {
+	config, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(config), nil
+}
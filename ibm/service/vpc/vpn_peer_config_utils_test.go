@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func testVPNPeerConfig() vpnPeerConfig {
+	return vpnPeerConfig{
+		Name:            "onprem",
+		Mode:            "policy",
+		IkeVersion:      2,
+		LocalAddress:    "203.0.113.10",
+		LocalCIDRs:      []string{"192.168.0.0/16"},
+		RemoteAddresses: []string{"198.51.100.1", "198.51.100.2"},
+		RemoteCIDRs:     []string{"10.240.0.0/24", "10.240.64.0/24"},
+		PSK:             vpnPeerConfigPSKPlaceholder,
+		IKE: &vpnPeerIKEProposal{
+			EncryptionAlgorithm:     "aes256",
+			AuthenticationAlgorithm: "sha384",
+			DhGroup:                 20,
+			KeyLifetime:             28800,
+		},
+		IPsec: &vpnPeerIPsecProposal{
+			EncryptionAlgorithm:     "aes256gcm16",
+			AuthenticationAlgorithm: "disabled",
+			Pfs:                     "group_14",
+			KeyLifetime:             3600,
+		},
+		DeadPeerDetection: &vpnPeerDeadPeerDetection{Action: "restart", Interval: 2, Timeout: 10},
+	}
+}
+
+func TestVPNPeerConfigProposals(t *testing.T) {
+	config := testVPNPeerConfig()
+	proposals := [][2]string{
+		{config.strongswanIKEProposal(), "aes256-sha384-ecp384"},
+		{config.strongswanESPProposal(), "aes256gcm16-modp2048"},
+		{config.libreswanIKEProposal(), "aes256-sha2_384-ecp_384"},
+		{config.libreswanESPProposal(), "aes_gcm256-null-modp2048"},
+	}
+	for _, proposal := range proposals {
+		if proposal[0] != proposal[1] {
+			t.Errorf("expected proposal %s, got %s", proposal[1], proposal[0])
+		}
+	}
+
+	config.IPsec = &vpnPeerIPsecProposal{EncryptionAlgorithm: "aes128", AuthenticationAlgorithm: "sha256", Pfs: "disabled"}
+	if proposal := config.strongswanESPProposal(); proposal != "aes128-sha256" {
+		t.Errorf("expected proposal aes128-sha256, got %s", proposal)
+	}
+	config.IKE, config.IPsec = nil, nil
+	if config.strongswanIKEProposal() != "" || config.libreswanESPProposal() != "" {
+		t.Errorf("expected no proposals for auto-negotiation")
+	}
+}
+
+func TestVPNPeerConfigRender(t *testing.T) {
+	config := testVPNPeerConfig()
+	testCases := []struct {
+		name     string
+		rendered string
+		contains []string
+	}{
+		{
+			name:     "swanctl",
+			rendered: config.strongswanSwanctlConf(),
+			contains: []string{"onprem-2 {", "remote_addrs = 198.51.100.2", "local_ts = 192.168.0.0/16", "remote_ts = 10.240.0.0/24,10.240.64.0/24", "proposals = aes256-sha384-ecp384", "dpd_delay = 2s", "dpd_action = restart", `secret = "REPLACE_WITH_PSK"`},
+		},
+		{
+			name:     "ipsec.conf",
+			rendered: config.ipsecConf(false),
+			contains: []string{"conn onprem-1", "keyexchange=ikev2", "left=203.0.113.10", "rightsubnet=10.240.0.0/24,10.240.64.0/24", "ike=aes256-sha384-ecp384!", "esp=aes256gcm16-modp2048!", "dpdtimeout=10s"},
+		},
+		{
+			name:     "libreswan",
+			rendered: config.ipsecConf(true),
+			contains: []string{"ikev2=insist", "ike=aes256-sha2_384-ecp_384", "esp=aes_gcm256-null-modp2048", "pfs=yes", "salifetime=3600s"},
+		},
+		{
+			name:     "secrets",
+			rendered: config.ipsecSecrets(),
+			contains: []string{`203.0.113.10 198.51.100.1 : PSK "REPLACE_WITH_PSK"`},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, s := range tc.contains {
+				if !strings.Contains(tc.rendered, s) {
+					t.Errorf("expected %q in:\n%s", s, tc.rendered)
+				}
+			}
+		})
+	}
+
+	rendered, err := config.json()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded vpnPeerConfig
+	if err = json.Unmarshal([]byte(rendered), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.IKE.DhGroup != 20 || decoded.DeadPeerDetection.Timeout != 10 {
+		t.Errorf("unexpected json %s", rendered)
+	}
+}
+
+func TestVPNPeerConfigRouteMode(t *testing.T) {
+	config := testVPNPeerConfig()
+	config.Mode = "route"
+	config.RemoteAddresses = config.RemoteAddresses[:1]
+	rendered := config.strongswanSwanctlConf()
+	for _, s := range []string{"# Route-based connection", "  onprem {", "local_ts = 0.0.0.0/0"} {
+		if !strings.Contains(rendered, s) {
+			t.Errorf("expected %q in:\n%s", s, rendered)
+		}
+	}
+}
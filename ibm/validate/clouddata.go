@@ -0,0 +1,264 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// CloudDataType values which are validated against the cloud data catalog.
+// The other types are only used as metadata and are not validated.
+const (
+	CloudDataTypeRegion            = "region"
+	CloudDataTypeZone              = "zone"
+	CloudDataTypeISInstanceProfile = "is_instance_profile"
+	CloudDataTypePISysType         = "pi_sys_type"
+)
+
+// The catalog is bundled with the provider, IC_CLOUD_DATA_FILE points to a refreshed
+// snapshot and IC_CLOUD_DATA_LIVE=true looks up the values missing from the snapshot
+// with the VPC API, using IC_API_KEY and IC_REGION.
+//
+// Refresh the bundled snapshot with:
+//
+//	IC_API_KEY=... go generate ./ibm/validate
+//
+//go:generate go run clouddata_generate.go
+//go:embed clouddata.json
+var bundledCloudData []byte
+
+// CloudData is a snapshot of the regions, zones and profiles of IBM Cloud
+type CloudData struct {
+	GeneratedAt string `json:"generated_at"`
+	// Zones of the multizone regions
+	Regions map[string][]string `json:"regions"`
+	// Locations which are not regions, like global and the datacenters
+	Locations          []string `json:"locations"`
+	ISInstanceProfiles []string `json:"is_instance_profiles"`
+	PISysTypes         []string `json:"pi_sys_types"`
+}
+
+// cloudDataMaxAge is the age after which the snapshot may miss the zones added
+// to a known region, so that an unknown zone is only a warning
+const cloudDataMaxAge = 180 * 24 * time.Hour
+
+// cloudDataNow is replaced in the tests
+var cloudDataNow = time.Now
+
+var (
+	cloudData     *CloudData
+	cloudDataErr  error
+	cloudDataOnce sync.Once
+
+	liveCloudData     *CloudData
+	liveCloudDataOnce sync.Once
+)
+
+func loadCloudData() (*CloudData, error) {
+	cloudDataOnce.Do(func() {
+		content := bundledCloudData
+		if file := os.Getenv("IC_CLOUD_DATA_FILE"); file != "" {
+			content, cloudDataErr = os.ReadFile(file)
+			if cloudDataErr != nil {
+				cloudDataErr = fmt.Errorf("[ERROR] Error reading cloud data file %s: %s", file, cloudDataErr)
+				return
+			}
+		}
+		cloudData = &CloudData{}
+		if err := json.Unmarshal(content, cloudData); err != nil {
+			cloudDataErr = fmt.Errorf("[ERROR] Error parsing cloud data: %s", err)
+		}
+	})
+	return cloudData, cloudDataErr
+}
+
+// lookupLiveCloudData fetches the catalog from the VPC API once, when IC_CLOUD_DATA_LIVE is set
+func lookupLiveCloudData() *CloudData {
+	if live, _ := strconv.ParseBool(os.Getenv("IC_CLOUD_DATA_LIVE")); !live {
+		return nil
+	}
+	liveCloudDataOnce.Do(func() {
+		apiKey := os.Getenv("IC_API_KEY")
+		if apiKey == "" {
+			apiKey = os.Getenv("IBMCLOUD_API_KEY")
+		}
+		if apiKey == "" {
+			log.Printf("[WARN] IC_CLOUD_DATA_LIVE is set but IC_API_KEY is not, using the bundled cloud data")
+			return
+		}
+		region := os.Getenv("IC_REGION")
+		if region == "" {
+			region = "us-south"
+		}
+		data, err := FetchCloudData(&core.IamAuthenticator{ApiKey: apiKey}, fmt.Sprintf("https://%s.iaas.cloud.ibm.com/v1", region))
+		if err != nil {
+			log.Printf("[WARN] Error looking up the cloud data, using the bundled cloud data: %s", err)
+			return
+		}
+		liveCloudData = data
+	})
+	return liveCloudData
+}
+
+// FetchCloudData builds the catalog of regions, zones and instance profiles from the VPC API
+func FetchCloudData(authenticator core.Authenticator, vpcURL string) (*CloudData, error) {
+	vpcClient, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+		Authenticator: authenticator,
+		URL:           vpcURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	data := &CloudData{
+		GeneratedAt: time.Now().UTC().Format("2006-01-02"),
+		Regions:     map[string][]string{},
+	}
+	regions, response, err := vpcClient.ListRegions(&vpcv1.ListRegionsOptions{})
+	if err != nil {
+		return nil, flex.NewServiceError("Error listing regions", err, response)
+	}
+	for _, region := range regions.Regions {
+		zones, response, err := vpcClient.ListRegionZones(&vpcv1.ListRegionZonesOptions{RegionName: region.Name})
+		if err != nil {
+			return nil, flex.NewServiceError(fmt.Sprintf("Error listing zones of region %s", *region.Name), err, response)
+		}
+		for _, zone := range zones.Zones {
+			data.Regions[*region.Name] = append(data.Regions[*region.Name], *zone.Name)
+		}
+		sort.Strings(data.Regions[*region.Name])
+	}
+	profiles, response, err := vpcClient.ListInstanceProfiles(&vpcv1.ListInstanceProfilesOptions{})
+	if err != nil {
+		return nil, flex.NewServiceError("Error listing instance profiles", err, response)
+	}
+	for _, profile := range profiles.Profiles {
+		data.ISInstanceProfiles = append(data.ISInstanceProfiles, *profile.Name)
+	}
+	return data, nil
+}
+
+func (c *CloudData) regionOfZone(zone string) (string, bool) {
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		if _, ok := c.Regions[zone[:i]]; ok {
+			return zone[:i], true
+		}
+	}
+	return "", false
+}
+
+func (c *CloudData) hasZone(zone string) bool {
+	if region, ok := c.regionOfZone(zone); ok {
+		return stringInSlice(zone, c.Regions[region])
+	}
+	return false
+}
+
+// stale reports whether the snapshot is older than cloudDataMaxAge
+func (c *CloudData) stale() bool {
+	generatedAt, err := time.Parse("2006-01-02", c.GeneratedAt)
+	if err != nil {
+		return true
+	}
+	return cloudDataNow().Sub(generatedAt) > cloudDataMaxAge
+}
+
+func (c *CloudData) regionNames() []string {
+	names := make([]string, 0, len(c.Regions))
+	for name := range c.Regions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// cloudDataContains reports whether the value of the given type is in the bundled or the live catalog
+func cloudDataContains(data *CloudData, dataType, value string) bool {
+	for _, c := range []*CloudData{data, lookupLiveCloudData()} {
+		if c == nil {
+			continue
+		}
+		switch dataType {
+		case CloudDataTypeRegion:
+			if _, ok := c.Regions[value]; ok || stringInSlice(value, c.Locations) {
+				return true
+			}
+		case CloudDataTypeZone:
+			if c.hasZone(value) {
+				return true
+			}
+		case CloudDataTypeISInstanceProfile:
+			if stringInSlice(value, c.ISInstanceProfiles) {
+				return true
+			}
+		case CloudDataTypePISysType:
+			if stringInSlice(value, c.PISysTypes) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func validateCloudData(dataType string) schema.SchemaValidateFunc {
+	switch dataType {
+	case CloudDataTypeRegion, CloudDataTypeZone, CloudDataTypeISInstanceProfile, CloudDataTypePISysType:
+	default:
+		return nil
+	}
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok || value == "" {
+			return
+		}
+		data, err := loadCloudData()
+		if err != nil {
+			errors = append(errors, err)
+			return
+		}
+		if cloudDataContains(data, dataType, value) {
+			return
+		}
+		// The catalog may miss the values released after it was taken, so unknown
+		// values are only warnings. A zone where a region is expected and an unknown
+		// zone of a known region are errors, unless the snapshot is stale.
+		switch dataType {
+		case CloudDataTypeRegion:
+			if region, ok := data.regionOfZone(value); ok {
+				errors = append(errors, fmt.Errorf("%q must be a region or location, %s is a zone of region %s", k, value, region))
+				return
+			}
+			ws = append(ws, fmt.Sprintf("%q: %s is not a known region or location (cloud data from %s)", k, value, data.GeneratedAt))
+		case CloudDataTypeZone:
+			if region, ok := data.regionOfZone(value); ok {
+				if !data.stale() || lookupLiveCloudData() != nil {
+					errors = append(errors, fmt.Errorf("%q: %s is not a zone of region %s, expected one of %s", k, value, region, strings.Join(data.Regions[region], ", ")))
+					return
+				}
+				ws = append(ws, fmt.Sprintf("%q: %s is not a known zone of region %s, expected one of %s (cloud data from %s, refresh it with IC_CLOUD_DATA_FILE or IC_CLOUD_DATA_LIVE)", k, value, region, strings.Join(data.Regions[region], ", "), data.GeneratedAt))
+				return
+			}
+			ws = append(ws, fmt.Sprintf("%q: %s is not a known zone of the regions %s (cloud data from %s, refresh it with IC_CLOUD_DATA_FILE or IC_CLOUD_DATA_LIVE)", k, value, strings.Join(data.regionNames(), ", "), data.GeneratedAt))
+		case CloudDataTypeISInstanceProfile:
+			ws = append(ws, fmt.Sprintf("%q: %s is not a known instance profile (cloud data from %s, refresh it with IC_CLOUD_DATA_FILE or IC_CLOUD_DATA_LIVE)", k, value, data.GeneratedAt))
+		case CloudDataTypePISysType:
+			ws = append(ws, fmt.Sprintf("%q: %s is not a known system type, expected one of %s (cloud data from %s)", k, value, strings.Join(data.PISysTypes, ", "), data.GeneratedAt))
+		}
+		return
+	}
+}
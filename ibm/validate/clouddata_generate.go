@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+//go:build ignore
+
+// Refreshes clouddata.json from the VPC API. The locations and the Power system types
+// are not available from the VPC API and are kept from the current snapshot.
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func main() {
+	current := validate.CloudData{}
+	content, err := os.ReadFile("clouddata.json")
+	if err != nil {
+		log.Fatalf("Error reading clouddata.json: %s", err)
+	}
+	if err := json.Unmarshal(content, &current); err != nil {
+		log.Fatalf("Error parsing clouddata.json: %s", err)
+	}
+
+	url := "https://us-south.iaas.cloud.ibm.com/v1"
+	if region := os.Getenv("IC_REGION"); region != "" {
+		url = "https://" + region + ".iaas.cloud.ibm.com/v1"
+	}
+	data, err := validate.FetchCloudData(&core.IamAuthenticator{ApiKey: os.Getenv("IC_API_KEY")}, url)
+	if err != nil {
+		log.Fatal(err)
+	}
+	data.Locations = current.Locations
+	data.PISysTypes = current.PISysTypes
+
+	content, err = json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("clouddata.json", append(content, '\n'), 0644); err != nil {
+		log.Fatalf("Error writing clouddata.json: %s", err)
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateCloudData(t *testing.T) {
+	t.Setenv("IC_CLOUD_DATA_LIVE", "false")
+	data, err := loadCloudData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	generatedAt, _ := time.Parse("2006-01-02", data.GeneratedAt)
+	cloudDataNow = func() time.Time { return generatedAt.AddDate(0, 1, 0) }
+	defer func() { cloudDataNow = time.Now }()
+
+	cases := []struct {
+		dataType string
+		value    string
+		warning  bool
+		error    string
+	}{
+		{dataType: CloudDataTypeZone, value: "us-south-1"},
+		{dataType: CloudDataTypeZone, value: ""},
+		{dataType: CloudDataTypeZone, value: "us-south-4", error: "us-south-4 is not a zone of region us-south"},
+		{dataType: CloudDataTypeZone, value: "us-soth-1", warning: true},
+		{dataType: CloudDataTypeRegion, value: "eu-de"},
+		{dataType: CloudDataTypeRegion, value: "global"},
+		{dataType: CloudDataTypeRegion, value: "dal10"},
+		{dataType: CloudDataTypeRegion, value: "eu-de-1", error: "eu-de-1 is a zone of region eu-de"},
+		{dataType: CloudDataTypeRegion, value: "mars-north", warning: true},
+		{dataType: CloudDataTypeISInstanceProfile, value: "bx2-2x8"},
+		{dataType: CloudDataTypeISInstanceProfile, value: "bx2-2x9", warning: true},
+		{dataType: CloudDataTypePISysType, value: "s922"},
+		{dataType: CloudDataTypePISysType, value: "s923", warning: true},
+	}
+	for _, c := range cases {
+		ws, errs := validateCloudData(c.dataType)(c.value, "attribute")
+		if c.warning != (len(ws) > 0) {
+			t.Errorf("%s %q: expected warning %t, got %v", c.dataType, c.value, c.warning, ws)
+		}
+		if c.error == "" && len(errs) > 0 {
+			t.Errorf("%s %q: expected no error, got %v", c.dataType, c.value, errs)
+		}
+		if c.error != "" && (len(errs) != 1 || !strings.Contains(errs[0].Error(), c.error)) {
+			t.Errorf("%s %q: expected error %q, got %v", c.dataType, c.value, c.error, errs)
+		}
+	}
+
+	// A stale snapshot may miss the new zones of a region
+	cloudDataNow = func() time.Time { return generatedAt.AddDate(1, 0, 0) }
+	if ws, errs := validateCloudData(CloudDataTypeZone)("us-south-4", "attribute"); len(ws) != 1 || len(errs) > 0 {
+		t.Errorf("Expected a warning for an unknown zone with a stale snapshot, got %v %v", ws, errs)
+	}
+
+	if validateCloudData("resource_group") != nil {
+		t.Error("Expected no validation for the cloud data types without a catalog")
+	}
+}
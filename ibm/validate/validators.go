@@ -429,19 +429,26 @@ func validateCIDRAddress() schema.SchemaValidateFunc {
 	}
 }
 
+// ReservedAddressRanges are the address ranges that VPC address prefixes and
+// subnets must not overlap.
+var ReservedAddressRanges = []string{
+	"127.0.0.0/8",
+	"161.26.0.0/16",
+	"166.8.0.0/14",
+	"169.254.0.0/16",
+	"224.0.0.0/4",
+}
+
 // validateOverlappingAddress...
 func validateOverlappingAddress() schema.SchemaValidateFunc {
 	return func(v interface{}, k string) (ws []string, errors []error) {
-		nonOverlappingCIDR := map[string]bool{
-			"127.0.0.0/8":    true,
-			"161.26.0.0/16":  true,
-			"166.8.0.0/14":   true,
-			"169.254.0.0/16": true,
-			"224.0.0.0/4":    true,
-		}
-
 		address := v.(string)
-		_, found := nonOverlappingCIDR[address]
+		found := false
+		for _, reserved := range ReservedAddressRanges {
+			if address == reserved {
+				found = true
+			}
+		}
 		if found {
 			errors = append(errors, fmt.Errorf(
 				"%q the request is overlapping with reserved address ranges",
@@ -892,6 +899,22 @@ func IsSecurityGroupCIDR(s string) bool {
 	return err == nil
 }
 
+// ValidateSecurityGroupRemote accepts an IP address, a CIDR block or a
+// security group identifier. Values that look like an address or CIDR block
+// but do not parse as one are rejected rather than sent as a group ID.
+func ValidateSecurityGroupRemote(v interface{}, k string) (ws []string, errors []error) {
+	remote := v.(string)
+	if remote == "" || IsSecurityGroupAddress(remote) || IsSecurityGroupCIDR(remote) {
+		return
+	}
+	if strings.ContainsAny(remote, "./:") {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a valid ip address, cidr block or security group id, got %q",
+			k, remote))
+	}
+	return
+}
+
 func isSecurityGroupIdentityByCRN(s string) bool {
 	segments := strings.Split(s, ":")
 	return len(segments) == 10 && segments[0] == "crn"
@@ -1280,7 +1303,7 @@ func invokeValidatorInternal(schema ValidateSchema) schema.SchemaValidateFunc {
 	case ValidateOverlappingAddress:
 		return validateOverlappingAddress()
 	case ValidateCloudData:
-		return nil
+		return validateCloudData(schema.CloudDataType)
 
 	default:
 		return nil